@@ -9,6 +9,9 @@ import (
 	"github.com/m6yf/bcwork/utils"
 )
 
+// adsTxtHostedMaxAge is for how long crawlers may cache hosted files, in seconds
+const adsTxtHostedMaxAge = 3600
+
 // AdsTxtMainHandler Get ads.txt main table.
 // @Description Get ads.txt main table.
 // @Tags AdsTxt
@@ -147,6 +150,63 @@ func (o *OMSNewPlatform) AdsTxtUpdateHandler(c *fiber.Ctx) error {
 	return utils.SuccessResponse(c, fiber.StatusOK, "ads.txt successfully updated")
 }
 
+// AdsTxtFileHandler Get recommended ads.txt file of domain.
+// @Description Get recommended ads.txt or app-ads.txt of domain generated from its approved lines.
+// @Tags AdsTxt
+// @Param options body dto.AdsTxtFileRequest true "Options"
+// @Accept json
+// @Produce plain
+// @Success 200 {string} string
+// @Security ApiKeyAuth
+// @Router /ads_txt/file [post]
+func (o *OMSNewPlatform) AdsTxtFileHandler(c *fiber.Ctx) error {
+	data := &dto.AdsTxtFileRequest{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "failed to parse request for getting ads.txt file", err)
+	}
+
+	content, err := o.adsTxtService.GetAdsTxtFile(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "failed to generate ads.txt file", err)
+	}
+
+	c.Set(fiber.HeaderContentType, fiber.MIMETextPlainCharsetUTF8)
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", data.FileName))
+
+	return c.SendString(content)
+}
+
+// AdsTxtHostedHandler Get hosted ads.txt file of domain.
+// @Description Get hosted ads.txt or app-ads.txt of domain. Publishers can redirect their file to this url.
+// @Tags AdsTxt
+// @Param domain path string true "Domain"
+// @Param file path string true "File name: ads.txt or app-ads.txt"
+// @Produce plain
+// @Success 200 {string} string
+// @Router /ads_txt/hosted/{domain}/{file} [get]
+func (o *OMSNewPlatform) AdsTxtHostedHandler(c *fiber.Ctx) error {
+	data := &dto.AdsTxtFileRequest{
+		Domain:   c.Params("domain"),
+		FileName: c.Params("file"),
+	}
+	if data.FileName != dto.AdsTxtFileName && data.FileName != dto.AppAdsTxtFileName {
+		return c.SendStatus(fiber.StatusNotFound)
+	}
+
+	content, err := o.adsTxtService.GetAdsTxtFile(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "failed to generate hosted ads.txt file", err)
+	}
+	if content == "" {
+		return c.SendStatus(fiber.StatusNotFound)
+	}
+
+	c.Set(fiber.HeaderContentType, fiber.MIMETextPlainCharsetUTF8)
+	c.Set(fiber.HeaderCacheControl, fmt.Sprintf("public, max-age=%v", adsTxtHostedMaxAge))
+
+	return c.SendString(content)
+}
+
 // AdsTxtDataForFiltersGetHandler Get available filters with data for ads txt.
 // @Description Get available filters with data for ads txt.
 // @Tags AdsTxt
@@ -0,0 +1,169 @@
+package rest
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/m6yf/bcwork/core"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/utils"
+)
+
+// AlertRuleGetHandler Get alert rules
+// @Description Get alert rules
+// @Tags Alert
+// @Accept json
+// @Produce json
+// @Param options body core.GetAlertRuleOptions true "options"
+// @Success 200 {object} []dto.AlertRule
+// @Security ApiKeyAuth
+// @Router /alert/rule/get [post]
+func (o *OMSNewPlatform) AlertRuleGetHandler(c *fiber.Ctx) error {
+	data := &core.GetAlertRuleOptions{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Request body parsing error", err)
+	}
+
+	rules, err := o.alertService.GetAlertRules(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to retrieve alert rules", err)
+	}
+
+	return c.JSON(rules)
+}
+
+// AlertRuleSetHandler Create alert rule
+// @Description Create alert rule. Query gets start and end of the evaluated window as $1 and $2 and returns "key" and "value" columns.
+// @Tags Alert
+// @Accept json
+// @Produce json
+// @Param options body dto.AlertRule true "Alert rule create Options"
+// @Success 200 {object} utils.BaseResponse
+// @Security ApiKeyAuth
+// @Router /alert/rule/set [post]
+func (o *OMSNewPlatform) AlertRuleSetHandler(c *fiber.Ctx) error {
+	data := &dto.AlertRule{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Alert rule payload parsing error", err)
+	}
+
+	err := o.alertService.CreateAlertRule(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to create alert rule", err)
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Alert rule successfully created")
+}
+
+// AlertRuleUpdateHandler Update alert rule
+// @Description Update alert rule, snoozed_until mutes all notifications of the rule
+// @Tags Alert
+// @Accept json
+// @Produce json
+// @Param options body dto.AlertRule true "Alert rule update Options"
+// @Success 200 {object} utils.BaseResponse
+// @Security ApiKeyAuth
+// @Router /alert/rule/update [post]
+func (o *OMSNewPlatform) AlertRuleUpdateHandler(c *fiber.Ctx) error {
+	data := &dto.AlertRule{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Alert rule payload parsing error", err)
+	}
+
+	err := o.alertService.UpdateAlertRule(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to update alert rule", err)
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Alert rule successfully updated")
+}
+
+// AlertRuleDeleteHandler Delete alert rules
+// @Description Delete alert rules together with their events
+// @Tags Alert
+// @Accept json
+// @Produce json
+// @Param options body []int true "options"
+// @Success 200 {object} utils.BaseResponse
+// @Security ApiKeyAuth
+// @Router /alert/rule/delete [delete]
+func (o *OMSNewPlatform) AlertRuleDeleteHandler(c *fiber.Ctx) error {
+	var ids []int
+	if err := c.BodyParser(&ids); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Failed to parse array of alert rule ids to delete", err)
+	}
+
+	err := o.alertService.DeleteAlertRules(c.Context(), ids)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to delete alert rules", err)
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Alert rules successfully deleted")
+}
+
+// AlertEventGetHandler Get alert events
+// @Description Get firing and resolved alert events
+// @Tags Alert
+// @Accept json
+// @Produce json
+// @Param options body core.GetAlertEventOptions true "options"
+// @Success 200 {object} []dto.AlertEvent
+// @Security ApiKeyAuth
+// @Router /alert/event/get [post]
+func (o *OMSNewPlatform) AlertEventGetHandler(c *fiber.Ctx) error {
+	data := &core.GetAlertEventOptions{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Request body parsing error", err)
+	}
+
+	events, err := o.alertService.GetAlertEvents(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to retrieve alert events", err)
+	}
+
+	return c.JSON(events)
+}
+
+// AlertEventAcknowledgeHandler Acknowledge alert events
+// @Description Acknowledge firing alert events, acknowledged events are not notified until they are resolved
+// @Tags Alert
+// @Accept json
+// @Produce json
+// @Param options body dto.AlertEventAcknowledgeRequest true "options"
+// @Success 200 {object} utils.BaseResponse
+// @Security ApiKeyAuth
+// @Router /alert/event/acknowledge [post]
+func (o *OMSNewPlatform) AlertEventAcknowledgeHandler(c *fiber.Ctx) error {
+	data := &dto.AlertEventAcknowledgeRequest{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Alert event acknowledge payload parsing error", err)
+	}
+
+	err := o.alertService.AcknowledgeAlertEvents(c.Context(), data.IDs)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to acknowledge alert events", err)
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Alert events successfully acknowledged")
+}
+
+// AlertEventSnoozeHandler Snooze alert events
+// @Description Snooze notifications of firing alert events until the given time
+// @Tags Alert
+// @Accept json
+// @Produce json
+// @Param options body dto.AlertEventSnoozeRequest true "options"
+// @Success 200 {object} utils.BaseResponse
+// @Security ApiKeyAuth
+// @Router /alert/event/snooze [post]
+func (o *OMSNewPlatform) AlertEventSnoozeHandler(c *fiber.Ctx) error {
+	data := &dto.AlertEventSnoozeRequest{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Alert event snooze payload parsing error", err)
+	}
+
+	err := o.alertService.SnoozeAlertEvents(c.Context(), data.IDs, data.Until)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to snooze alert events", err)
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Alert events successfully snoozed")
+}
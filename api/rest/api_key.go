@@ -0,0 +1,100 @@
+package rest
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/m6yf/bcwork/core"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/utils"
+)
+
+// APIKeyGetHandler Get api keys
+// @Description Get api keys issued to integrations and workers, keys themselves are never returned
+// @Tags API Key
+// @Accept json
+// @Produce json
+// @Param options body core.GetAPIKeyOptions true "options"
+// @Success 200 {object} []dto.APIKey
+// @Security ApiKeyAuth
+// @Router /api_key/get [post]
+func (o *OMSNewPlatform) APIKeyGetHandler(c *fiber.Ctx) error {
+	data := &core.GetAPIKeyOptions{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Request body parsing error", err)
+	}
+
+	keys, err := o.apiKeyService.GetAPIKeys(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to retrieve api keys", err)
+	}
+
+	return c.JSON(keys)
+}
+
+// APIKeySetHandler Create api key
+// @Description Issue api key acting on behalf of the owner within its scopes (resource:action). The key is returned only once.
+// @Tags API Key
+// @Accept json
+// @Produce json
+// @Param options body dto.APIKey true "API key create Options"
+// @Success 200 {object} dto.APIKeyCreateResponse
+// @Security ApiKeyAuth
+// @Router /api_key/set [post]
+func (o *OMSNewPlatform) APIKeySetHandler(c *fiber.Ctx) error {
+	data := &dto.APIKey{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "API key payload parsing error", err)
+	}
+
+	resp, err := o.apiKeyService.CreateAPIKey(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to create api key", err)
+	}
+
+	return c.JSON(resp)
+}
+
+// APIKeyUpdateHandler Update api key
+// @Description Update name, scopes, allowed ips and expiration of api key
+// @Tags API Key
+// @Accept json
+// @Produce json
+// @Param options body dto.APIKey true "API key update Options"
+// @Success 200 {object} utils.BaseResponse
+// @Security ApiKeyAuth
+// @Router /api_key/update [post]
+func (o *OMSNewPlatform) APIKeyUpdateHandler(c *fiber.Ctx) error {
+	data := &dto.APIKey{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "API key payload parsing error", err)
+	}
+
+	err := o.apiKeyService.UpdateAPIKey(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to update api key", err)
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "API key successfully updated")
+}
+
+// APIKeyRevokeHandler Revoke api key
+// @Description Revoke api key, requests with revoked key are rejected
+// @Tags API Key
+// @Accept json
+// @Produce json
+// @Param options body dto.APIKeyRevokeRequest true "API key revoke Options"
+// @Success 200 {object} utils.BaseResponse
+// @Security ApiKeyAuth
+// @Router /api_key/revoke [post]
+func (o *OMSNewPlatform) APIKeyRevokeHandler(c *fiber.Ctx) error {
+	data := &dto.APIKeyRevokeRequest{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "API key revoke payload parsing error", err)
+	}
+
+	err := o.apiKeyService.RevokeAPIKey(c.Context(), data.ID)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to revoke api key", err)
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "API key successfully revoked")
+}
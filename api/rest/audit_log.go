@@ -0,0 +1,30 @@
+package rest
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/m6yf/bcwork/core"
+	"github.com/m6yf/bcwork/utils"
+)
+
+// AuditLogGetHandler Get audit log
+// @Description Search mutating API requests by user, api key, route and time, from the newest by default
+// @Tags Audit Log
+// @Accept json
+// @Produce json
+// @Param options body core.GetAuditLogOptions true "options"
+// @Success 200 {object} []dto.AuditLog
+// @Security ApiKeyAuth
+// @Router /audit/get [post]
+func (o *OMSNewPlatform) AuditLogGetHandler(c *fiber.Ctx) error {
+	data := &core.GetAuditLogOptions{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Request body parsing error", err)
+	}
+
+	logs, err := o.auditLogService.GetAuditLogs(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to retrieve audit log", err)
+	}
+
+	return c.JSON(logs)
+}
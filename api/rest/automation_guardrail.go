@@ -0,0 +1,47 @@
+package rest
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/utils"
+)
+
+// CircuitBreakerGetHandler Get circuit breakers of automations
+// @Description Get circuit breakers of factor and DPO automations. Tripped circuit breaker stops the automation until it is reset.
+// @Tags Automation
+// @Accept json
+// @Produce json
+// @Success 200 {object} []dto.CircuitBreaker
+// @Security ApiKeyAuth
+// @Router /automation/circuit_breaker/get [post]
+func (o *OMSNewPlatform) CircuitBreakerGetHandler(c *fiber.Ctx) error {
+	circuitBreakers, err := o.automationGuardrailService.GetCircuitBreakers(c.Context())
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "failed to retrieve circuit breakers", err)
+	}
+
+	return c.JSON(circuitBreakers)
+}
+
+// CircuitBreakerResetHandler Reset circuit breaker of automation
+// @Description Reset tripped circuit breaker of automation, the next run of the automation becomes the baseline of input volume checks
+// @Tags Automation
+// @Accept json
+// @Produce json
+// @Param options body dto.CircuitBreakerResetRequest true "options"
+// @Success 200 {object} utils.BaseResponse
+// @Security ApiKeyAuth
+// @Router /automation/circuit_breaker/reset [post]
+func (o *OMSNewPlatform) CircuitBreakerResetHandler(c *fiber.Ctx) error {
+	data := &dto.CircuitBreakerResetRequest{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "failed to parse circuit breaker reset request", err)
+	}
+
+	err := o.automationGuardrailService.ResetCircuitBreaker(c.Context(), data.Automation)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "failed to reset circuit breaker", err)
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "circuit breaker successfully reset")
+}
@@ -0,0 +1,145 @@
+package rest
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/m6yf/bcwork/core"
+	"github.com/m6yf/bcwork/utils"
+)
+
+// FactorLogGetHandler Get factor automation log
+// @Description Get changes of factor automation. Export to file is available through /automation/download with request type "automation/factor/log".
+// @Tags Automation
+// @Param options body core.FactorLogOptions true "options"
+// @Accept json
+// @Produce json
+// @Success 200 {object} []dto.FactorLog
+// @Security ApiKeyAuth
+// @Router /automation/factor/log [post]
+func (o *OMSNewPlatform) FactorLogGetHandler(c *fiber.Ctx) error {
+	data := &core.FactorLogOptions{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "failed to parse request for getting factor automation log", err)
+	}
+
+	logs, err := o.automationLogService.GetFactorLogs(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "failed to get factor automation log", err)
+	}
+
+	return c.JSON(logs)
+}
+
+// FactorLogAggregateHandler Get factor automation log aggregated by domain and day
+// @Description Get number of changes, average factors and revenue of factor automation by publisher, domain and day. Export to file is available through /automation/download with request type "automation/factor/log/aggregate".
+// @Tags Automation
+// @Param options body core.FactorLogOptions true "options"
+// @Accept json
+// @Produce json
+// @Success 200 {object} []dto.AutomationLogAggregate
+// @Security ApiKeyAuth
+// @Router /automation/factor/log/aggregate [post]
+func (o *OMSNewPlatform) FactorLogAggregateHandler(c *fiber.Ctx) error {
+	data := &core.FactorLogOptions{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "failed to parse request for aggregating factor automation log", err)
+	}
+
+	aggregates, err := o.automationLogService.GetFactorLogAggregates(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "failed to aggregate factor automation log", err)
+	}
+
+	return c.JSON(aggregates)
+}
+
+// FactorLogTimelineHandler Get timeline of factor rule
+// @Description Get changes of a single factor rule from the oldest to the newest
+// @Tags Automation
+// @Param options body core.FactorLogTimelineOptions true "options"
+// @Accept json
+// @Produce json
+// @Success 200 {object} []dto.FactorLog
+// @Security ApiKeyAuth
+// @Router /automation/factor/log/timeline [post]
+func (o *OMSNewPlatform) FactorLogTimelineHandler(c *fiber.Ctx) error {
+	data := &core.FactorLogTimelineOptions{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "failed to parse request for getting factor rule timeline", err)
+	}
+
+	logs, err := o.automationLogService.GetFactorLogTimeline(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "failed to get factor rule timeline", err)
+	}
+
+	return c.JSON(logs)
+}
+
+// DpoLogGetHandler Get DPO automation log
+// @Description Get changes of DPO automation. Export to file is available through /automation/download with request type "automation/dpo/log".
+// @Tags Automation
+// @Param options body core.DpoLogOptions true "options"
+// @Accept json
+// @Produce json
+// @Success 200 {object} []dto.DpoLog
+// @Security ApiKeyAuth
+// @Router /automation/dpo/log [post]
+func (o *OMSNewPlatform) DpoLogGetHandler(c *fiber.Ctx) error {
+	data := &core.DpoLogOptions{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "failed to parse request for getting dpo automation log", err)
+	}
+
+	logs, err := o.automationLogService.GetDpoLogs(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "failed to get dpo automation log", err)
+	}
+
+	return c.JSON(logs)
+}
+
+// DpoLogAggregateHandler Get DPO automation log aggregated by domain and day
+// @Description Get number of changes, average factors and revenue of DPO automation by publisher, domain and day. Export to file is available through /automation/download with request type "automation/dpo/log/aggregate".
+// @Tags Automation
+// @Param options body core.DpoLogOptions true "options"
+// @Accept json
+// @Produce json
+// @Success 200 {object} []dto.AutomationLogAggregate
+// @Security ApiKeyAuth
+// @Router /automation/dpo/log/aggregate [post]
+func (o *OMSNewPlatform) DpoLogAggregateHandler(c *fiber.Ctx) error {
+	data := &core.DpoLogOptions{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "failed to parse request for aggregating dpo automation log", err)
+	}
+
+	aggregates, err := o.automationLogService.GetDpoLogAggregates(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "failed to aggregate dpo automation log", err)
+	}
+
+	return c.JSON(aggregates)
+}
+
+// DpoLogTimelineHandler Get timeline of DPO rule
+// @Description Get changes of a single DPO rule from the oldest to the newest
+// @Tags Automation
+// @Param options body core.DpoLogTimelineOptions true "options"
+// @Accept json
+// @Produce json
+// @Success 200 {object} []dto.DpoLog
+// @Security ApiKeyAuth
+// @Router /automation/dpo/log/timeline [post]
+func (o *OMSNewPlatform) DpoLogTimelineHandler(c *fiber.Ctx) error {
+	data := &core.DpoLogTimelineOptions{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "failed to parse request for getting dpo rule timeline", err)
+	}
+
+	logs, err := o.automationLogService.GetDpoLogTimeline(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "failed to get dpo rule timeline", err)
+	}
+
+	return c.JSON(logs)
+}
@@ -0,0 +1,142 @@
+package rest
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/m6yf/bcwork/core"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/modules/rbac"
+	"github.com/m6yf/bcwork/utils"
+)
+
+// DPBidderSchemaGetHandler Get bidder schemas of demand partners
+// @Description Get JSON Schemas of bidder parameters of demand partners
+// @Tags DemandPartner
+// @Accept json
+// @Produce json
+// @Param options body core.GetDPBidderSchemaOptions true "options"
+// @Success 200 {object} []dto.DPBidderSchema
+// @Security ApiKeyAuth
+// @Router /dp/bidder/schema/get [post]
+func (o *OMSNewPlatform) DPBidderSchemaGetHandler(c *fiber.Ctx) error {
+	data := &core.GetDPBidderSchemaOptions{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Request body parsing error", err)
+	}
+
+	schemas, err := o.bidderParamsService.GetSchemas(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to retrieve bidder schemas", err)
+	}
+
+	return c.JSON(schemas)
+}
+
+// DPBidderSchemaSetHandler Set bidder schema of demand partner
+// @Description Create or replace JSON Schema of bidder parameters of demand partner, existing parameters must match the schema
+// @Tags DemandPartner
+// @Accept json
+// @Produce json
+// @Param options body dto.DPBidderSchema true "Bidder schema"
+// @Success 200 {object} utils.BaseResponse
+// @Security ApiKeyAuth
+// @Router /dp/bidder/schema/set [post]
+func (o *OMSNewPlatform) DPBidderSchemaSetHandler(c *fiber.Ctx) error {
+	data := &dto.DPBidderSchema{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Bidder schema payload parsing error", err)
+	}
+
+	err := o.bidderParamsService.SetSchema(c.Context(), data)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, core.ErrInvalidBidderSchema) || errors.Is(err, core.ErrInvalidBidderParams) {
+			return utils.ErrorResponse(c, fiber.StatusBadRequest, "Failed to set bidder schema", err)
+		}
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to set bidder schema", err)
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Bidder schema successfully set")
+}
+
+// DPBidderParamsGetHandler Get bidder params
+// @Description Get bidder parameters of demand partners for publishers and domains
+// @Tags DemandPartner
+// @Accept json
+// @Produce json
+// @Param options body core.GetDPBidderParamsOptions true "options"
+// @Success 200 {object} []dto.DPBidderParams
+// @Security ApiKeyAuth
+// @Router /dp/bidder/params/get [post]
+func (o *OMSNewPlatform) DPBidderParamsGetHandler(c *fiber.Ctx) error {
+	data := &core.GetDPBidderParamsOptions{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Request body parsing error", err)
+	}
+
+	params, err := o.bidderParamsService.GetParams(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to retrieve bidder params", err)
+	}
+
+	return c.JSON(params)
+}
+
+// DPBidderParamsSetHandler Set bidder params
+// @Description Create or replace bidder parameters of demand partner for publisher or domain, domain parameters override publisher ones. Parameters are validated against schema of demand partner and sent to metadata
+// @Tags DemandPartner
+// @Accept json
+// @Produce json
+// @Param options body dto.DPBidderParams true "Bidder params"
+// @Success 200 {object} utils.BaseResponse
+// @Security ApiKeyAuth
+// @Router /dp/bidder/params/set [post]
+func (o *OMSNewPlatform) DPBidderParamsSetHandler(c *fiber.Ctx) error {
+	data := &dto.DPBidderParams{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Bidder params payload parsing error", err)
+	}
+
+	err := o.bidderParamsService.SetParams(c.Context(), data)
+	if err != nil {
+		if errors.Is(err, rbac.ErrForbidden) {
+			return utils.ErrorResponse(c, fiber.StatusForbidden, "Failed to set bidder params", err)
+		}
+		if errors.Is(err, core.ErrNoBidderSchema) || errors.Is(err, core.ErrInvalidBidderParams) {
+			return utils.ErrorResponse(c, fiber.StatusBadRequest, "Failed to set bidder params", err)
+		}
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to set bidder params", err)
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Bidder params successfully set")
+}
+
+// DPBidderParamsDeleteHandler Delete bidder params
+// @Description Delete bidder parameters, deletion is rejected when remaining domain parameters become incomplete
+// @Tags DemandPartner
+// @Accept json
+// @Produce json
+// @Param options body dto.DPBidderParamsDeleteRequest true "Bidder params delete Options"
+// @Success 200 {object} utils.BaseResponse
+// @Security ApiKeyAuth
+// @Router /dp/bidder/params/delete [post]
+func (o *OMSNewPlatform) DPBidderParamsDeleteHandler(c *fiber.Ctx) error {
+	data := &dto.DPBidderParamsDeleteRequest{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Bidder params delete payload parsing error", err)
+	}
+
+	err := o.bidderParamsService.DeleteParams(c.Context(), data.IDs)
+	if err != nil {
+		if errors.Is(err, rbac.ErrForbidden) {
+			return utils.ErrorResponse(c, fiber.StatusForbidden, "Failed to delete bidder params", err)
+		}
+		if errors.Is(err, core.ErrInvalidBidderParams) {
+			return utils.ErrorResponse(c, fiber.StatusBadRequest, "Failed to delete bidder params", err)
+		}
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to delete bidder params", err)
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Bidder params successfully deleted")
+}
@@ -2,8 +2,11 @@ package rest
 
 import (
 	"bytes"
+	"errors"
+	"strings"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/m6yf/bcwork/core"
 	"github.com/m6yf/bcwork/dto"
 	"github.com/m6yf/bcwork/utils"
 	"github.com/valyala/fasttemplate"
@@ -11,7 +14,7 @@ import (
 )
 
 // BlockPostHandler Update bidder addomain and categories blocks
-// @Description Update bidder addomain and categories blocks.
+// @Description Update bidder addomain and categories blocks, provided lists replace current ones.
 // @Tags MetaData
 // @Accept json
 // @Produce json
@@ -34,12 +37,12 @@ func (o *OMSNewPlatform) BlockPostHandler(c *fiber.Ctx) error {
 }
 
 // BlockGetAllHandler Get publisher block list (bcat and badv) setup
-// @Description Get publisher block list (bcat and badv) setup
+// @Description Get current publisher block lists (bcat and badv), all lists are returned when publisher or types are not provided
 // @Tags MetaData
 // @Accept json
 // @Produce json
 // @Param options body dto.BlockGetRequest true "Block update Options"
-// @Success 200 {object} utils.BaseResponse
+// @Success 200 {object} []dto.BlockList
 // @Security ApiKeyAuth
 // @Router /block/get [post]
 func (o *OMSNewPlatform) BlockGetAllHandler(c *fiber.Ctx) error {
@@ -59,7 +62,7 @@ func (o *OMSNewPlatform) BlockGetAllHandler(c *fiber.Ctx) error {
 		p := message.NewPrinter(message.MatchLanguage("en"))
 
 		for _, rec := range blocks {
-			b.WriteString(p.Sprintf(rowBlock, rec.Key, rec.Value, rec.CreatedAt.Format("2006-01-02 15:04"), rec.CommitedInstances))
+			b.WriteString(p.Sprintf(rowBlock, rec.Key, strings.Join(rec.Value, ", "), rec.UpdatedAt.Format("2006-01-02 15:04")))
 		}
 		t := fasttemplate.New(htmlBlock, "{{", "}}")
 		s := t.ExecuteString(map[string]interface{}{
@@ -72,6 +75,135 @@ func (o *OMSNewPlatform) BlockGetAllHandler(c *fiber.Ctx) error {
 	}
 }
 
+// BlockItemsGetHandler Get badv and bcat entries
+// @Description Get badv and bcat entries with filtering, search and pagination
+// @Tags MetaData
+// @Accept json
+// @Produce json
+// @Param options body core.GetBlockItemsOptions true "options"
+// @Success 200 {object} []dto.Block
+// @Security ApiKeyAuth
+// @Router /block/items/get [post]
+func (o *OMSNewPlatform) BlockItemsGetHandler(c *fiber.Ctx) error {
+	data := &core.GetBlockItemsOptions{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Request body parsing error", err)
+	}
+
+	blocks, err := o.blocksService.GetBlockItems(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "failed to retrieve blocks", err)
+	}
+
+	return c.JSON(blocks)
+}
+
+// BlockItemsAddHandler Add badv and bcat entries
+// @Description Add badv and bcat entries to publisher (or domain) lists, existing entries get new reason and expiry.
+// @Tags MetaData
+// @Accept json
+// @Produce json
+// @Param options body dto.BlockItemsRequest true "Block items"
+// @Success 200 {object} utils.BaseResponse
+// @Security ApiKeyAuth
+// @Router /block/items/add [post]
+func (o *OMSNewPlatform) BlockItemsAddHandler(c *fiber.Ctx) error {
+	data := &dto.BlockItemsRequest{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "failed to parse block items payload", err)
+	}
+
+	err := o.blocksService.AddBlockItems(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "failed to add block items", err)
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "block items successfully added")
+}
+
+// BlockItemsRemoveHandler Remove badv and bcat entries
+// @Description Remove badv and bcat entries from publisher (or domain) lists.
+// @Tags MetaData
+// @Accept json
+// @Produce json
+// @Param options body dto.BlockItemsRequest true "Block items"
+// @Success 200 {object} utils.BaseResponse
+// @Security ApiKeyAuth
+// @Router /block/items/remove [delete]
+func (o *OMSNewPlatform) BlockItemsRemoveHandler(c *fiber.Ctx) error {
+	data := &dto.BlockItemsRequest{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "failed to parse block items payload", err)
+	}
+
+	err := o.blocksService.RemoveBlockItems(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "failed to remove block items", err)
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "block items successfully removed")
+}
+
+// BlockUploadHandler Upload badv and bcat entries from csv
+// @Description Upload csv block list (columns: type, value, reason, expires_at) of publisher (or domain). Invalid and duplicate entries are reported and skipped, valid entries are added unless preview is requested.
+// @Tags MetaData
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "csv file"
+// @Param publisher formData string true "publisher id"
+// @Param domain formData string false "domain"
+// @Param preview query bool false "only validate the file"
+// @Success 200 {object} dto.BlockUploadReport
+// @Security ApiKeyAuth
+// @Router /block/upload [post]
+func (o *OMSNewPlatform) BlockUploadHandler(c *fiber.Ctx) error {
+	publisher := c.FormValue("publisher")
+	if publisher == "" {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "failed to upload block list", errors.New("publisher is mandatory"))
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "failed to get block list file", err)
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "failed to open block list file", err)
+	}
+	defer file.Close()
+
+	report, err := o.blocksService.UploadBlockItems(c.Context(), publisher, c.FormValue("domain"), file, c.QueryBool("preview"))
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "failed to upload block list", err)
+	}
+
+	return c.JSON(report)
+}
+
+// BlockTaxonomyGetHandler Get IAB content taxonomy
+// @Description Get IAB content taxonomy categories accepted in bcat (version 1.0, 2.2 or 3.0, latest by default) with equivalent categories in another version.
+// @Tags MetaData
+// @Accept json
+// @Produce json
+// @Param options body dto.TaxonomyRequest true "options"
+// @Success 200 {object} []dto.TaxonomyCategory
+// @Security ApiKeyAuth
+// @Router /block/taxonomy/get [post]
+func (o *OMSNewPlatform) BlockTaxonomyGetHandler(c *fiber.Ctx) error {
+	request := &dto.TaxonomyRequest{}
+	if err := c.BodyParser(&request); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Request body parsing error", err)
+	}
+
+	categories, err := o.blocksService.GetTaxonomyCategories(request)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "failed to retrieve taxonomy", err)
+	}
+
+	return c.JSON(categories)
+}
+
 var htmlBlock = `
 <html>
 <head>
@@ -105,10 +237,7 @@ var htmlBlock = `
                   List
                </th>
                <th scope="col" class="px-6 py-3 text-left text-xs font-medium text-gray-900 uppercase tracking-wider">
-                  Create At
-               </th>
-                <th scope="col" class="px-6 py-3 text-left text-xs font-medium text-gray-900 uppercase tracking-wider">
-                  Committed
+                  Updated At
                </th>
             </tr>
           </thead>
@@ -133,8 +262,5 @@ var rowBlock = `<tr>
                    <td class="px-6 py-4 whitespace-nowrap text-sm text-gray-900">
                      %s
                  </td>
-                 <td class="px-6 py-4 whitespace-nowrap text-sm text-gray-900">
-                     %d
-                 </td>
                         
             </tr>`
@@ -1,8 +1,10 @@
 package rest
 
 import (
+	"bytes"
 	"encoding/json"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -38,12 +40,11 @@ func TestBlockGetAllHandler(t *testing.T) {
 				statusCode: fiber.StatusOK,
 				response: `[` +
 					`{` +
-					`"transaction_id":"c53c4dd2-6f68-5b62-b613-999a5239ad36",` +
 					`"key":"badv:20356:playpilot.com",` +
-					`"version":null,` +
+					`"publisher":"20356",` +
+					`"domain":"playpilot.com",` +
+					`"type":"badv",` +
 					`"value":["fraction-content.com"],` +
-					`"commited_instances":0,` +
-					`"created_at":"2024-09-20T10:10:10.1Z",` +
 					`"updated_at":"2024-09-26T10:10:10.1Z"` +
 					`}` +
 					`]`,
@@ -89,6 +90,83 @@ func TestBlockGetAllHandler(t *testing.T) {
 	}
 }
 
+func TestBlockUploadHandler(t *testing.T) {
+	t.Parallel()
+
+	endpoint := "/test/block/upload?preview=true"
+
+	type want struct {
+		statusCode int
+		response   string
+	}
+
+	tests := []struct {
+		name      string
+		publisher string
+		file      string
+		want      want
+	}{
+		{
+			name:      "preview",
+			publisher: "20360",
+			file:      "type,value\nbadv,https://www.Advertiser.com\nbadv,advertiser.com\nbcat,IAB99\n",
+			want: want{
+				statusCode: fiber.StatusOK,
+				response: `{"committed":false,` +
+					`"valid":[{"type":"badv","value":"advertiser.com","reason":"","expires_at":null}],` +
+					`"invalid":[{"line":4,"type":"bcat","value":"IAB99","error":"bcat [IAB99] is not a category of IAB content taxonomy [1.0 2.2 3.0]"}],` +
+					`"duplicates":[{"line":3,"type":"badv","value":"advertiser.com","error":"duplicate of line 2"}]}`,
+			},
+		},
+		{
+			name:      "missingPublisher",
+			publisher: "",
+			file:      "type,value\nbadv,advertiser.com\n",
+			want: want{
+				statusCode: fiber.StatusBadRequest,
+				response:   `{"status":"error","message":"failed to upload block list","error":"publisher is mandatory"}`,
+			},
+		},
+		{
+			name:      "missingColumn",
+			publisher: "20360",
+			file:      "value\nadvertiser.com\n",
+			want: want{
+				statusCode: fiber.StatusBadRequest,
+				response:   `{"status":"error","message":"failed to upload block list","error":"csv header must contain column [type]"}`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			body := &bytes.Buffer{}
+			writer := multipart.NewWriter(body)
+			assert.NoError(t, writer.WriteField("publisher", tt.publisher))
+			part, err := writer.CreateFormFile("file", "blocks.csv")
+			assert.NoError(t, err)
+			_, err = part.Write([]byte(tt.file))
+			assert.NoError(t, err)
+			assert.NoError(t, writer.Close())
+
+			req := httptest.NewRequest(fiber.MethodPost, endpoint, body)
+			req.Header.Set(fiber.HeaderContentType, writer.FormDataContentType())
+
+			resp, err := appTest.Test(req, -1)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want.statusCode, resp.StatusCode)
+
+			respBody, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			defer resp.Body.Close()
+			assert.Equal(t, tt.want.response, string(respBody))
+		})
+	}
+}
+
 func TestBlockHistory(t *testing.T) {
 	t.Parallel()
 
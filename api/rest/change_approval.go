@@ -0,0 +1,195 @@
+package rest
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/m6yf/bcwork/core/approval"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/utils"
+)
+
+type ChangeRequestPendingResponse struct {
+	utils.BaseResponse
+	ChangeRequestID int `json:"change_request_id"`
+}
+
+// ChangeApprovalMiddleware holds changes covered by approval policy until they are approved.
+// Such request is answered with 202 and the id of created change request.
+func (o *OMSNewPlatform) ChangeApprovalMiddleware(c *fiber.Ctx) error {
+	changeRequest, err := o.changeApprovalService.SubmitIfRequired(c.Context(), c.Route().Path, c.Body())
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to check if change requires approval", err)
+	}
+
+	if changeRequest == nil {
+		return c.Next()
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(ChangeRequestPendingResponse{
+		BaseResponse: utils.BaseResponse{
+			Status:  utils.ResponseStatusSuccess,
+			Message: "change requires approval, change request was created",
+		},
+		ChangeRequestID: changeRequest.ID,
+	})
+}
+
+// ApprovalPolicyGetHandler Get approval policies
+// @Description Get approval policies
+// @Tags Approval
+// @Accept json
+// @Produce json
+// @Param options body approval.GetApprovalPolicyOptions true "options"
+// @Success 200 {object} []dto.ApprovalPolicy
+// @Security ApiKeyAuth
+// @Router /approval/policy/get [post]
+func (o *OMSNewPlatform) ApprovalPolicyGetHandler(c *fiber.Ctx) error {
+	data := &approval.GetApprovalPolicyOptions{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Request body parsing error", err)
+	}
+
+	policies, err := o.changeApprovalService.GetApprovalPolicies(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to retrieve approval policies", err)
+	}
+
+	return c.JSON(policies)
+}
+
+// ApprovalPolicySetHandler Create approval policy
+// @Description Create approval policy
+// @Tags Approval
+// @Accept json
+// @Produce json
+// @Param options body dto.ApprovalPolicy true "Approval policy create Options"
+// @Success 200 {object} utils.BaseResponse
+// @Security ApiKeyAuth
+// @Router /approval/policy/set [post]
+func (o *OMSNewPlatform) ApprovalPolicySetHandler(c *fiber.Ctx) error {
+	data := &dto.ApprovalPolicy{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Approval policy payload parsing error", err)
+	}
+
+	err := o.changeApprovalService.CreateApprovalPolicy(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to create approval policy", err)
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Approval policy successfully created")
+}
+
+// ApprovalPolicyUpdateHandler Update approval policy
+// @Description Update approval policy
+// @Tags Approval
+// @Accept json
+// @Produce json
+// @Param options body dto.ApprovalPolicy true "Approval policy update Options"
+// @Success 200 {object} utils.BaseResponse
+// @Security ApiKeyAuth
+// @Router /approval/policy/update [post]
+func (o *OMSNewPlatform) ApprovalPolicyUpdateHandler(c *fiber.Ctx) error {
+	data := &dto.ApprovalPolicy{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Approval policy payload parsing error", err)
+	}
+
+	err := o.changeApprovalService.UpdateApprovalPolicy(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to update approval policy", err)
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Approval policy successfully updated")
+}
+
+// ApprovalPolicyDeleteHandler Delete approval policies
+// @Description Delete approval policies without pending change requests
+// @Tags Approval
+// @Accept json
+// @Produce json
+// @Param options body []int true "options"
+// @Success 200 {object} utils.BaseResponse
+// @Security ApiKeyAuth
+// @Router /approval/policy/delete [delete]
+func (o *OMSNewPlatform) ApprovalPolicyDeleteHandler(c *fiber.Ctx) error {
+	var ids []int
+	if err := c.BodyParser(&ids); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Failed to parse array of approval policy ids to delete", err)
+	}
+
+	err := o.changeApprovalService.DeleteApprovalPolicies(c.Context(), ids)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to delete approval policies", err)
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Approval policies successfully deleted")
+}
+
+// ChangeRequestGetHandler Get change requests
+// @Description Get change requests waiting for approval or already decided
+// @Tags Approval
+// @Accept json
+// @Produce json
+// @Param options body approval.GetChangeRequestOptions true "options"
+// @Success 200 {object} []dto.ChangeRequest
+// @Security ApiKeyAuth
+// @Router /approval/request/get [post]
+func (o *OMSNewPlatform) ChangeRequestGetHandler(c *fiber.Ctx) error {
+	data := &approval.GetChangeRequestOptions{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Request body parsing error", err)
+	}
+
+	changeRequests, err := o.changeApprovalService.GetChangeRequests(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to retrieve change requests", err)
+	}
+
+	return c.JSON(changeRequests)
+}
+
+// ChangeRequestApproveHandler Approve change request
+// @Description Approve change request and apply it on behalf of the requester
+// @Tags Approval
+// @Accept json
+// @Produce json
+// @Param options body dto.ChangeRequestDecision true "Change request decision"
+// @Success 200 {object} utils.BaseResponse
+// @Security ApiKeyAuth
+// @Router /approval/request/approve [post]
+func (o *OMSNewPlatform) ChangeRequestApproveHandler(c *fiber.Ctx) error {
+	data := &dto.ChangeRequestDecision{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Change request decision parsing error", err)
+	}
+
+	err := o.changeApprovalService.ApproveChangeRequest(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Failed to approve change request", err)
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Change request successfully approved and applied")
+}
+
+// ChangeRequestRejectHandler Reject change request
+// @Description Reject change request
+// @Tags Approval
+// @Accept json
+// @Produce json
+// @Param options body dto.ChangeRequestDecision true "Change request decision"
+// @Success 200 {object} utils.BaseResponse
+// @Security ApiKeyAuth
+// @Router /approval/request/reject [post]
+func (o *OMSNewPlatform) ChangeRequestRejectHandler(c *fiber.Ctx) error {
+	data := &dto.ChangeRequestDecision{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Change request decision parsing error", err)
+	}
+
+	err := o.changeApprovalService.RejectChangeRequest(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Failed to reject change request", err)
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Change request successfully rejected")
+}
@@ -0,0 +1,132 @@
+package rest
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/m6yf/bcwork/core"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/modules/rbac"
+	"github.com/m6yf/bcwork/utils"
+)
+
+// CompassManagerGetHandler Get compass managers mapping
+// @Description Get mapping of manager ids in compass to users
+// @Tags Compass
+// @Accept json
+// @Produce json
+// @Param options body core.GetCompassManagerOptions true "options"
+// @Success 200 {object} []dto.CompassManager
+// @Security ApiKeyAuth
+// @Router /compass/manager/get [post]
+func (o *OMSNewPlatform) CompassManagerGetHandler(c *fiber.Ctx) error {
+	data := &core.GetCompassManagerOptions{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Request body parsing error", err)
+	}
+
+	managers, err := o.compassSyncService.GetManagers(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to retrieve compass managers", err)
+	}
+
+	return c.JSON(managers)
+}
+
+// CompassManagerSetHandler Set compass managers mapping
+// @Description Map manager ids in compass to users, existing mapping of compass id is replaced
+// @Tags Compass
+// @Accept json
+// @Produce json
+// @Param options body []dto.CompassManager true "Compass managers"
+// @Success 200 {object} utils.BaseResponse
+// @Security ApiKeyAuth
+// @Router /compass/manager/set [post]
+func (o *OMSNewPlatform) CompassManagerSetHandler(c *fiber.Ctx) error {
+	var data []*dto.CompassManager
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Compass managers payload parsing error", err)
+	}
+
+	err := o.compassSyncService.SetManagers(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to set compass managers", err)
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Compass managers successfully set")
+}
+
+// CompassManagerDeleteHandler Delete compass managers mapping
+// @Description Delete mapping of manager ids in compass to users
+// @Tags Compass
+// @Accept json
+// @Produce json
+// @Param options body dto.CompassManagerDeleteRequest true "Compass managers delete Options"
+// @Success 200 {object} utils.BaseResponse
+// @Security ApiKeyAuth
+// @Router /compass/manager/delete [post]
+func (o *OMSNewPlatform) CompassManagerDeleteHandler(c *fiber.Ctx) error {
+	data := &dto.CompassManagerDeleteRequest{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Compass managers delete payload parsing error", err)
+	}
+
+	err := o.compassSyncService.DeleteManagers(c.Context(), data.IDs)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to delete compass managers", err)
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Compass managers successfully deleted")
+}
+
+// PublisherSyncGetHandler Get publishers sync states with compass
+// @Description Get states of publishers sync with compass including per field diffs of conflicting publishers
+// @Tags publisher
+// @Accept json
+// @Produce json
+// @Param options body core.GetPublisherCompassSyncOptions true "options"
+// @Success 200 {object} []dto.PublisherCompassSync
+// @Security ApiKeyAuth
+// @Router /publisher/sync/get [post]
+func (o *OMSNewPlatform) PublisherSyncGetHandler(c *fiber.Ctx) error {
+	data := &core.GetPublisherCompassSyncOptions{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Request body parsing error", err)
+	}
+
+	states, err := o.compassSyncService.GetSyncStates(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to retrieve publishers sync states", err)
+	}
+
+	return c.JSON(states)
+}
+
+// PublisherSyncResolveHandler Resolve publisher conflict with compass
+// @Description Resolve publisher changed both locally and in compass by keeping local values, which are pushed to compass then, or compass values
+// @Tags publisher
+// @Accept json
+// @Produce json
+// @Param options body dto.PublisherSyncResolveRequest true "Publisher sync resolve Options"
+// @Success 200 {object} utils.BaseResponse
+// @Security ApiKeyAuth
+// @Router /publisher/sync/resolve [post]
+func (o *OMSNewPlatform) PublisherSyncResolveHandler(c *fiber.Ctx) error {
+	data := &dto.PublisherSyncResolveRequest{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Publisher sync resolve payload parsing error", err)
+	}
+
+	err := o.compassSyncService.ResolveConflict(c.Context(), data)
+	if err != nil {
+		if errors.Is(err, rbac.ErrForbidden) {
+			return utils.ErrorResponse(c, fiber.StatusForbidden, "Failed to resolve publisher conflict", err)
+		}
+		if errors.Is(err, core.ErrNoCompassConflict) {
+			return utils.ErrorResponse(c, fiber.StatusBadRequest, "Failed to resolve publisher conflict", err)
+		}
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to resolve publisher conflict", err)
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Publisher conflict successfully resolved")
+}
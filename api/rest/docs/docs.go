@@ -177,6 +177,44 @@ const docTemplate = `{
                 }
             }
         },
+        "/ads_txt/file": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get recommended ads.txt or app-ads.txt of domain generated from its approved lines.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "AdsTxt"
+                ],
+                "parameters": [
+                    {
+                        "description": "Options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.AdsTxtFileRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
         "/ads_txt/filter": {
             "get": {
                 "security": [
@@ -251,6 +289,41 @@ const docTemplate = `{
                 }
             }
         },
+        "/ads_txt/hosted/{domain}/{file}": {
+            "get": {
+                "description": "Get hosted ads.txt or app-ads.txt of domain. Publishers can redirect their file to this url.",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "AdsTxt"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Domain",
+                        "name": "domain",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "File name: ads.txt or app-ads.txt",
+                        "name": "file",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
         "/ads_txt/main": {
             "post": {
                 "security": [
@@ -368,14 +441,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/bid_caching/delete": {
-            "delete": {
+        "/alert/event/acknowledge": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Delete bid chaching.",
+                "description": "Acknowledge firing alert events, acknowledged events are not notified until they are resolved",
                 "consumes": [
                     "application/json"
                 ],
@@ -383,7 +456,7 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BidCaching"
+                    "Alert"
                 ],
                 "parameters": [
                     {
@@ -392,24 +465,28 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/dto.AlertEventAcknowledgeRequest"
                         }
                     }
                 ],
-                "responses": {}
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.BaseResponse"
+                        }
+                    }
+                }
             }
         },
-        "/bid_caching/get": {
+        "/alert/event/get": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get bid_caching setup",
+                "description": "Get firing and resolved alert events",
                 "consumes": [
                     "application/json"
                 ],
@@ -417,7 +494,7 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BidCaching"
+                    "Alert"
                 ],
                 "parameters": [
                     {
@@ -426,7 +503,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/core.GetBidCachingOptions"
+                            "$ref": "#/definitions/core.GetAlertEventOptions"
                         }
                     }
                 ],
@@ -434,20 +511,23 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.BidCaching"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.AlertEvent"
+                            }
                         }
                     }
                 }
             }
         },
-        "/bid_caching/set": {
+        "/alert/event/snooze": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Create BidCaching setup",
+                "description": "Snooze notifications of firing alert events until the given time",
                 "consumes": [
                     "application/json"
                 ],
@@ -455,16 +535,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BidCaching"
+                    "Alert"
                 ],
                 "parameters": [
                     {
-                        "description": "BidCaching create Options",
+                        "description": "options",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.BidCachingUpdateRequest"
+                            "$ref": "#/definitions/dto.AlertEventSnoozeRequest"
                         }
                     }
                 ],
@@ -472,20 +552,20 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/rest.BidCachingUpdateResponse"
+                            "$ref": "#/definitions/utils.BaseResponse"
                         }
                     }
                 }
             }
         },
-        "/bid_caching/update": {
-            "post": {
+        "/alert/rule/delete": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update BidCaching setup",
+                "description": "Delete alert rules together with their events",
                 "consumes": [
                     "application/json"
                 ],
@@ -493,16 +573,19 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BidCaching"
+                    "Alert"
                 ],
                 "parameters": [
                     {
-                        "description": "BidCaching update Options",
+                        "description": "options",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.BidCachingUpdateRequest"
+                            "type": "array",
+                            "items": {
+                                "type": "integer"
+                            }
                         }
                     }
                 ],
@@ -516,14 +599,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/block": {
+        "/alert/rule/get": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update bidder addomain and categories blocks.",
+                "description": "Get alert rules",
                 "consumes": [
                     "application/json"
                 ],
@@ -531,16 +614,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "MetaData"
+                    "Alert"
                 ],
                 "parameters": [
                     {
-                        "description": "Block update Options",
+                        "description": "options",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.BlockUpdateRequest"
+                            "$ref": "#/definitions/core.GetAlertRuleOptions"
                         }
                     }
                 ],
@@ -548,20 +631,23 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.BaseResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.AlertRule"
+                            }
                         }
                     }
                 }
             }
         },
-        "/block/get": {
+        "/alert/rule/set": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get publisher block list (bcat and badv) setup",
+                "description": "Create alert rule. Query gets start and end of the evaluated window as $1 and $2 and returns \"key\" and \"value\" columns.",
                 "consumes": [
                     "application/json"
                 ],
@@ -569,16 +655,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "MetaData"
+                    "Alert"
                 ],
                 "parameters": [
                     {
-                        "description": "Block update Options",
+                        "description": "Alert rule create Options",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.BlockGetRequest"
+                            "$ref": "#/definitions/dto.AlertRule"
                         }
                     }
                 ],
@@ -592,14 +678,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/bulk/dpo": {
+        "/alert/rule/update": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update DPO setup in bulk (publisher, factor and demand_partner_id fields are mandatory)",
+                "description": "Update alert rule, snoozed_until mutes all notifications of the rule",
                 "consumes": [
                     "application/json"
                 ],
@@ -607,19 +693,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Bulk"
+                    "Alert"
                 ],
                 "parameters": [
                     {
-                        "description": "DPO update Options",
+                        "description": "Alert rule update Options",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dto.DPORuleUpdateRequest"
-                            }
+                            "$ref": "#/definitions/dto.AlertRule"
                         }
                     }
                 ],
@@ -633,14 +716,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/bulk/factor": {
+        "/api_key/get": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update Factor setup in bulk (publisher, factor, device and country fields are mandatory)",
+                "description": "Get api keys issued to integrations and workers, keys themselves are never returned",
                 "consumes": [
                     "application/json"
                 ],
@@ -648,19 +731,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Bulk"
+                    "API Key"
                 ],
                 "parameters": [
                     {
-                        "description": "Factor update Options",
+                        "description": "options",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/bulk.FactorUpdateRequest"
-                            }
+                            "$ref": "#/definitions/core.GetAPIKeyOptions"
                         }
                     }
                 ],
@@ -668,20 +748,23 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.BaseResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.APIKey"
+                            }
                         }
                     }
                 }
             }
         },
-        "/bulk/floor": {
+        "/api_key/revoke": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update Floor setup in bulk (publisher, floor, device and country fields are mandatory)",
+                "description": "Revoke api key, requests with revoked key are rejected",
                 "consumes": [
                     "application/json"
                 ],
@@ -689,19 +772,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Bulk"
+                    "API Key"
                 ],
                 "parameters": [
                     {
-                        "description": "Floor update Options",
+                        "description": "API key revoke Options",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dto.FloorUpdateRequest"
-                            }
+                            "$ref": "#/definitions/dto.APIKeyRevokeRequest"
                         }
                     }
                 ],
@@ -709,20 +789,20 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/bulk.FloorUpdateResponse"
+                            "$ref": "#/definitions/utils.BaseResponse"
                         }
                     }
                 }
             }
         },
-        "/bulk/global/factor": {
+        "/api_key/set": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update Global Factor Fees in bulk",
+                "description": "Issue api key acting on behalf of the owner within its scopes (resource:action). The key is returned only once.",
                 "consumes": [
                     "application/json"
                 ],
@@ -730,19 +810,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Bulk"
+                    "API Key"
                 ],
                 "parameters": [
                     {
-                        "description": "Global Factor update Options",
+                        "description": "API key create Options",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/bulk.GlobalFactorRequest"
-                            }
+                            "$ref": "#/definitions/dto.APIKey"
                         }
                     }
                 ],
@@ -750,20 +827,20 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.BaseResponse"
+                            "$ref": "#/definitions/dto.APIKeyCreateResponse"
                         }
                     }
                 }
             }
         },
-        "/competitor": {
+        "/api_key/update": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update Competitor setup (name is mandatory, url is mandatory)",
+                "description": "Update name, scopes, allowed ips and expiration of api key",
                 "consumes": [
                     "application/json"
                 ],
@@ -771,16 +848,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Competitor"
+                    "API Key"
                 ],
                 "parameters": [
                     {
-                        "description": "Competitor update Options",
+                        "description": "API key update Options",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/core.CompetitorUpdateRequest"
+                            "$ref": "#/definitions/dto.APIKey"
                         }
                     }
                 ],
@@ -788,20 +865,20 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/rest.CompetitorUpdateResponse"
+                            "$ref": "#/definitions/utils.BaseResponse"
                         }
                     }
                 }
             }
         },
-        "/competitor/get": {
-            "post": {
+        "/approval/policy/delete": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get Competitor setup",
+                "description": "Delete approval policies without pending change requests",
                 "consumes": [
                     "application/json"
                 ],
@@ -809,7 +886,7 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Competitor"
+                    "Approval"
                 ],
                 "parameters": [
                     {
@@ -818,7 +895,10 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/core.GetCompetitorOptions"
+                            "type": "array",
+                            "items": {
+                                "type": "integer"
+                            }
                         }
                     }
                 ],
@@ -826,23 +906,20 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/core.Competitor"
-                            }
+                            "$ref": "#/definitions/utils.BaseResponse"
                         }
                     }
                 }
             }
         },
-        "/confiant": {
+        "/approval/policy/get": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update and enable Confiant setup (publisher is mandatory, domain is optional)",
+                "description": "Get approval policies",
                 "consumes": [
                     "application/json"
                 ],
@@ -850,16 +927,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Confiant"
+                    "Approval"
                 ],
                 "parameters": [
                     {
-                        "description": "Confiant update Options",
+                        "description": "options",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.ConfiantUpdateRequest"
+                            "$ref": "#/definitions/approval.GetApprovalPolicyOptions"
                         }
                     }
                 ],
@@ -867,20 +944,23 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.BaseResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.ApprovalPolicy"
+                            }
                         }
                     }
                 }
             }
         },
-        "/confiant/get": {
+        "/approval/policy/set": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get confiant setup",
+                "description": "Create approval policy",
                 "consumes": [
                     "application/json"
                 ],
@@ -888,16 +968,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Confiant"
+                    "Approval"
                 ],
                 "parameters": [
                     {
-                        "description": "options",
+                        "description": "Approval policy create Options",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/core.GetConfiantOptions"
+                            "$ref": "#/definitions/dto.ApprovalPolicy"
                         }
                     }
                 ],
@@ -905,23 +985,20 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dto.Confiant"
-                            }
+                            "$ref": "#/definitions/utils.BaseResponse"
                         }
                     }
                 }
             }
         },
-        "/config": {
+        "/approval/policy/update": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update Factor setup (publisher is mandatory, domain is mandatory)",
+                "description": "Update approval policy",
                 "consumes": [
                     "application/json"
                 ],
@@ -929,16 +1006,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Configuration"
+                    "Approval"
                 ],
                 "parameters": [
                     {
-                        "description": "Factor update Options",
+                        "description": "Approval policy update Options",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/core.ConfigurationRequest"
+                            "$ref": "#/definitions/dto.ApprovalPolicy"
                         }
                     }
                 ],
@@ -952,14 +1029,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/config/get": {
+        "/approval/request/approve": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get all configuration from DB",
+                "description": "Approve change request and apply it on behalf of the requester",
                 "consumes": [
                     "application/json"
                 ],
@@ -967,16 +1044,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Configuration"
+                    "Approval"
                 ],
                 "parameters": [
                     {
-                        "description": "options",
+                        "description": "Change request decision",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/core.ConfigurationPayload"
+                            "$ref": "#/definitions/dto.ChangeRequestDecision"
                         }
                     }
                 ],
@@ -990,14 +1067,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/demand/factor": {
+        "/approval/request/get": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update new bidder demand factor for demand partner.",
+                "description": "Get change requests waiting for approval or already decided",
                 "consumes": [
                     "application/json"
                 ],
@@ -1005,16 +1082,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "MetaData"
+                    "Approval"
                 ],
                 "parameters": [
                     {
-                        "description": "DemandFactor update Options",
+                        "description": "options",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/rest.DemandFactorUpdateRequest"
+                            "$ref": "#/definitions/approval.GetChangeRequestOptions"
                         }
                     }
                 ],
@@ -1022,15 +1099,23 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/rest.DemandFactorUpdateRespose"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.ChangeRequest"
+                            }
                         }
                     }
                 }
             }
         },
-        "/download": {
+        "/approval/request/reject": {
             "post": {
-                "description": "Download body data as file according to format in request. Data should be passed as array of json objects which have same structure",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Reject change request",
                 "consumes": [
                     "application/json"
                 ],
@@ -1038,16 +1123,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Download"
+                    "Approval"
                 ],
                 "parameters": [
                     {
-                        "description": "request",
+                        "description": "Change request decision",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.DownloadRequest"
+                            "$ref": "#/definitions/dto.ChangeRequestDecision"
                         }
                     }
                 ],
@@ -1061,14 +1146,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/dp-api-report": {
+        "/audit/get": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get dpi-report  demand partners report by date range",
+                "description": "Search mutating API requests by user, api key, route and time, from the newest by default",
                 "consumes": [
                     "application/json"
                 ],
@@ -1076,7 +1161,7 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Dp API"
+                    "Audit Log"
                 ],
                 "parameters": [
                     {
@@ -1085,7 +1170,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/core.GetDPApiOptions"
+                            "$ref": "#/definitions/core.GetAuditLogOptions"
                         }
                     }
                 ],
@@ -1095,21 +1180,21 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/dto.DpiApi"
+                                "$ref": "#/definitions/dto.AuditLog"
                             }
                         }
                     }
                 }
             }
         },
-        "/dp-api-report/demandPartners": {
+        "/automation/circuit_breaker/get": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get dpi-report demand partners",
+                "description": "Get circuit breakers of factor and DPO automations. Tripped circuit breaker stops the automation until it is reset.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1117,7 +1202,7 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Dp API"
+                    "Automation"
                 ],
                 "responses": {
                     "200": {
@@ -1125,21 +1210,21 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/dto.DpiApi"
+                                "$ref": "#/definitions/dto.CircuitBreaker"
                             }
                         }
                     }
                 }
             }
         },
-        "/dp/get": {
+        "/automation/circuit_breaker/reset": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get demand partners.",
+                "description": "Reset tripped circuit breaker of automation, the next run of the automation becomes the baseline of input volume checks",
                 "consumes": [
                     "application/json"
                 ],
@@ -1147,7 +1232,7 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "DemandPartner"
+                    "Automation"
                 ],
                 "parameters": [
                     {
@@ -1156,21 +1241,28 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/core.DemandPartnerGetOptions"
+                            "$ref": "#/definitions/dto.CircuitBreakerResetRequest"
                         }
                     }
                 ],
-                "responses": {}
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.BaseResponse"
+                        }
+                    }
+                }
             }
         },
-        "/dp/seat_owner/get": {
+        "/automation/download": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get seat owners for demand partners.",
+                "description": "Download factor and DPO automation log or its aggregates as file according to format in request.\nSupported request types are \"automation/factor/log\", \"automation/factor/log/aggregate\", \"automation/dpo/log\" and \"automation/dpo/log/aggregate\"",
                 "consumes": [
                     "application/json"
                 ],
@@ -1178,30 +1270,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "DemandPartner"
+                    "Automation"
                 ],
                 "parameters": [
                     {
-                        "description": "options",
+                        "description": "request",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/core.SeatOwnerGetOptions"
+                            "$ref": "#/definitions/dto.DownloadRequest"
                         }
                     }
                 ],
-                "responses": {}
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.BaseResponse"
+                        }
+                    }
+                }
             }
         },
-        "/dp/set": {
+        "/automation/dpo/log": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Create new demand partner.",
+                "description": "Get changes of DPO automation. Export to file is available through /automation/download with request type \"automation/dpo/log\".",
                 "consumes": [
                     "application/json"
                 ],
@@ -1209,16 +1308,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "DemandPartner"
+                    "Automation"
                 ],
                 "parameters": [
                     {
-                        "description": "DemandPartner",
-                        "name": "user",
+                        "description": "options",
+                        "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.DemandPartner"
+                            "$ref": "#/definitions/core.DpoLogOptions"
                         }
                     }
                 ],
@@ -1226,20 +1325,23 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.BaseResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.DpoLog"
+                            }
                         }
                     }
                 }
             }
         },
-        "/dp/update": {
+        "/automation/dpo/log/aggregate": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update demand partner.",
+                "description": "Get number of changes, average factors and revenue of DPO automation by publisher, domain and day. Export to file is available through /automation/download with request type \"automation/dpo/log/aggregate\".",
                 "consumes": [
                     "application/json"
                 ],
@@ -1247,16 +1349,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "DemandPartner"
+                    "Automation"
                 ],
                 "parameters": [
                     {
-                        "description": "DemandPartner",
-                        "name": "user",
+                        "description": "options",
+                        "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.DemandPartner"
+                            "$ref": "#/definitions/core.DpoLogOptions"
                         }
                     }
                 ],
@@ -1264,20 +1366,23 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.BaseResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.AutomationLogAggregate"
+                            }
                         }
                     }
                 }
             }
         },
-        "/dpo/delete": {
-            "delete": {
+        "/automation/dpo/log/timeline": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Delete demand partner optimization rule for publisher.",
+                "description": "Get changes of a single DPO rule from the oldest to the newest",
                 "consumes": [
                     "application/json"
                 ],
@@ -1285,7 +1390,7 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "DPO"
+                    "Automation"
                 ],
                 "parameters": [
                     {
@@ -1293,25 +1398,32 @@ const docTemplate = `{
                         "name": "options",
                         "in": "body",
                         "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/core.DpoLogTimelineOptions"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "type": "string"
+                                "$ref": "#/definitions/dto.DpoLog"
                             }
                         }
                     }
-                ],
-                "responses": {}
+                }
             }
         },
-        "/dpo/get": {
+        "/automation/factor/log": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get demand partner optimization rules for publisher.",
+                "description": "Get changes of factor automation. Export to file is available through /automation/download with request type \"automation/factor/log\".",
                 "consumes": [
                     "application/json"
                 ],
@@ -1319,7 +1431,7 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "DPO"
+                    "Automation"
                 ],
                 "parameters": [
                     {
@@ -1328,21 +1440,31 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/core.DPOFactorOptions"
+                            "$ref": "#/definitions/core.FactorLogOptions"
                         }
                     }
                 ],
-                "responses": {}
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.FactorLog"
+                            }
+                        }
+                    }
+                }
             }
         },
-        "/dpo/set": {
+        "/automation/factor/log/aggregate": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update demand partner optimization rule for a publisher.",
+                "description": "Get number of changes, average factors and revenue of factor automation by publisher, domain and day. Export to file is available through /automation/download with request type \"automation/factor/log/aggregate\".",
                 "consumes": [
                     "application/json"
                 ],
@@ -1350,16 +1472,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "DPO"
+                    "Automation"
                 ],
                 "parameters": [
                     {
-                        "description": "Demand Partner Optimization update rule",
+                        "description": "options",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.DPORuleUpdateRequest"
+                            "$ref": "#/definitions/core.FactorLogOptions"
                         }
                     }
                 ],
@@ -1367,53 +1489,23 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.DPORuleUpdateRequest"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.AutomationLogAggregate"
+                            }
                         }
                     }
                 }
             }
         },
-        "/dpo/update": {
-            "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Update demand partner optimization rule by rule id..",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "DPO"
-                ],
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "rule ID",
-                        "name": "rid",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "factor (0-100)",
-                        "name": "factor",
-                        "in": "query",
-                        "required": true
-                    }
-                ],
-                "responses": {}
-            }
-        },
-        "/factor": {
+        "/automation/factor/log/timeline": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update Factor setup (publisher is mandatory, domain is mandatory)",
+                "description": "Get changes of a single factor rule from the oldest to the newest",
                 "consumes": [
                     "application/json"
                 ],
@@ -1421,16 +1513,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Factor"
+                    "Automation"
                 ],
                 "parameters": [
                     {
-                        "description": "Factor update Options",
+                        "description": "options",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.FactorUpdateRequest"
+                            "$ref": "#/definitions/core.FactorLogTimelineOptions"
                         }
                     }
                 ],
@@ -1438,20 +1530,23 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/rest.FactorUpdateResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.FactorLog"
+                            }
                         }
                     }
                 }
             }
         },
-        "/factor/delete": {
+        "/bid_caching/delete": {
             "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "soft delete factor from Factor table and deletes it from metadata_queue table",
+                "description": "Delete bid chaching.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1459,7 +1554,7 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Factor"
+                    "BidCaching"
                 ],
                 "parameters": [
                     {
@@ -1475,24 +1570,17 @@ const docTemplate = `{
                         }
                     }
                 ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/rest.FactorUpdateResponse"
-                        }
-                    }
-                }
+                "responses": {}
             }
         },
-        "/factor/get": {
+        "/bid_caching/get": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get factor setup",
+                "description": "Get bid_caching setup",
                 "consumes": [
                     "application/json"
                 ],
@@ -1500,7 +1588,7 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Factor"
+                    "BidCaching"
                 ],
                 "parameters": [
                     {
@@ -1509,7 +1597,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/core.GetFactorOptions"
+                            "$ref": "#/definitions/core.GetBidCachingOptions"
                         }
                     }
                 ],
@@ -1517,23 +1605,20 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dto.Factor"
-                            }
+                            "$ref": "#/definitions/dto.BidCaching"
                         }
                     }
                 }
             }
         },
-        "/floor": {
+        "/bid_caching/set": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update Floor setup (publisher, floor, device and country fields are mandatory)",
+                "description": "Create BidCaching setup",
                 "consumes": [
                     "application/json"
                 ],
@@ -1541,16 +1626,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Floor"
+                    "BidCaching"
                 ],
                 "parameters": [
                     {
-                        "description": "Floor update Options",
+                        "description": "BidCaching create Options",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.FloorUpdateRequest"
+                            "$ref": "#/definitions/dto.BidCachingUpdateRequest"
                         }
                     }
                 ],
@@ -1558,20 +1643,20 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.BaseResponse"
+                            "$ref": "#/definitions/rest.BidCachingUpdateResponse"
                         }
                     }
                 }
             }
         },
-        "/floor/delete": {
-            "delete": {
+        "/bid_caching/update": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "soft delete floor from Floor table and deletes it from metadata_queue table",
+                "description": "Update BidCaching setup",
                 "consumes": [
                     "application/json"
                 ],
@@ -1579,19 +1664,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Floor"
+                    "BidCaching"
                 ],
                 "parameters": [
                     {
-                        "description": "options",
+                        "description": "BidCaching update Options",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/dto.BidCachingUpdateRequest"
                         }
                     }
                 ],
@@ -1599,20 +1681,20 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/rest.FloorUpdateResponse"
+                            "$ref": "#/definitions/utils.BaseResponse"
                         }
                     }
                 }
             }
         },
-        "/floor/get": {
+        "/block": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get floor setup",
+                "description": "Update bidder addomain and categories blocks, provided lists replace current ones.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1620,16 +1702,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Floor"
+                    "MetaData"
                 ],
                 "parameters": [
                     {
-                        "description": "options",
+                        "description": "Block update Options",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/core.GetFloorOptions"
+                            "$ref": "#/definitions/dto.BlockUpdateRequest"
                         }
                     }
                 ],
@@ -1637,23 +1719,20 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dto.Floor"
-                            }
+                            "$ref": "#/definitions/utils.BaseResponse"
                         }
                     }
                 }
             }
         },
-        "/global/factor": {
+        "/block/get": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update Global Factors Fees",
+                "description": "Get current publisher block lists (bcat and badv), all lists are returned when publisher or types are not provided",
                 "consumes": [
                     "application/json"
                 ],
@@ -1661,16 +1740,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Global Factor"
+                    "MetaData"
                 ],
                 "parameters": [
                     {
-                        "description": "Global Factor update Options",
+                        "description": "Block update Options",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/core.GlobalFactorRequest"
+                            "$ref": "#/definitions/dto.BlockGetRequest"
                         }
                     }
                 ],
@@ -1678,20 +1757,23 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.BaseResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.BlockList"
+                            }
                         }
                     }
                 }
             }
         },
-        "/global/factor/get": {
+        "/block/items/add": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get confiant setup",
+                "description": "Add badv and bcat entries to publisher (or domain) lists, existing entries get new reason and expiry.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1699,16 +1781,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Global Factor"
+                    "MetaData"
                 ],
                 "parameters": [
                     {
-                        "description": "options",
+                        "description": "Block items",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/core.GetGlobalFactorOptions"
+                            "$ref": "#/definitions/dto.BlockItemsRequest"
                         }
                     }
                 ],
@@ -1716,23 +1798,20 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/core.GlobalFactor"
-                            }
+                            "$ref": "#/definitions/utils.BaseResponse"
                         }
                     }
                 }
             }
         },
-        "/history/get": {
+        "/block/items/get": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get history data.",
+                "description": "Get badv and bcat entries with filtering, search and pagination",
                 "consumes": [
                     "application/json"
                 ],
@@ -1740,16 +1819,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "History"
+                    "MetaData"
                 ],
                 "parameters": [
                     {
-                        "description": "Options",
+                        "description": "options",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/core.HistoryOptions"
+                            "$ref": "#/definitions/core.GetBlockItemsOptions"
                         }
                     }
                 ],
@@ -1759,21 +1838,21 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/dto.History"
+                                "$ref": "#/definitions/dto.Block"
                             }
                         }
                     }
                 }
             }
         },
-        "/metadata/update": {
-            "post": {
+        "/block/items/remove": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update new bidder metadata.",
+                "description": "Remove badv and bcat entries from publisher (or domain) lists.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1785,12 +1864,12 @@ const docTemplate = `{
                 ],
                 "parameters": [
                     {
-                        "description": "Metadata update Options",
+                        "description": "Block items",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/core.MetadataUpdateRequest"
+                            "$ref": "#/definitions/dto.BlockItemsRequest"
                         }
                     }
                 ],
@@ -1804,29 +1883,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/ping": {
-            "get": {
-                "description": "Check Health of Service",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "text/html"
-                ],
-                "tags": [
-                    "Health"
-                ],
-                "responses": {}
-            }
-        },
-        "/pixalate": {
+        "/block/taxonomy/get": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update and enable Pixalate setup (publisher is mandatory, domain is optional)",
+                "description": "Get IAB content taxonomy categories accepted in bcat (version 1.0, 2.2 or 3.0, latest by default) with equivalent categories in another version.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1834,16 +1898,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Pixalate"
+                    "MetaData"
                 ],
                 "parameters": [
                     {
-                        "description": "Pixalate update Options",
+                        "description": "options",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.PixalateUpdateRequest"
+                            "$ref": "#/definitions/dto.TaxonomyRequest"
                         }
                     }
                 ],
@@ -1851,54 +1915,78 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.BaseResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.TaxonomyCategory"
+                            }
                         }
                     }
                 }
             }
         },
-        "/pixalate/delete": {
-            "delete": {
+        "/block/upload": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Delete Pixalate - soft delete.",
+                "description": "Upload csv block list (columns: type, value, reason, expires_at) of publisher (or domain). Invalid and duplicate entries are reported and skipped, valid entries are added unless preview is requested.",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Pixalate"
+                    "MetaData"
                 ],
                 "parameters": [
                     {
-                        "description": "options",
-                        "name": "options",
-                        "in": "body",
-                        "required": true,
+                        "type": "file",
+                        "description": "csv file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "publisher id",
+                        "name": "publisher",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "domain",
+                        "name": "domain",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "only validate the file",
+                        "name": "preview",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/dto.BlockUploadReport"
                         }
                     }
-                ],
-                "responses": {}
+                }
             }
         },
-        "/pixalate/get": {
+        "/bulk/dpo": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get Pixalate setup",
+                "description": "Update DPO setup in bulk (publisher, factor and demand_partner_id fields are mandatory)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1906,16 +1994,19 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Pixalate"
+                    "Bulk"
                 ],
                 "parameters": [
                     {
-                        "description": "options",
+                        "description": "DPO update Options",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/core.GetPixalateOptions"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.DPORuleUpdateRequest"
+                            }
                         }
                     }
                 ],
@@ -1923,41 +2014,20 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dto.Pixalate"
-                            }
+                            "$ref": "#/definitions/utils.BaseResponse"
                         }
                     }
                 }
             }
         },
-        "/price/fixed": {
-            "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Get fixed price rates",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "text/html"
-                ],
-                "tags": [
-                    "MetaData"
-                ],
-                "responses": {}
-            },
+        "/bulk/factor": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update javascript tag guaranteed price",
+                "description": "Update Factor setup in bulk (publisher, factor, device and country fields are mandatory)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1965,16 +2035,19 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "MetaData"
+                    "Bulk"
                 ],
                 "parameters": [
                     {
-                        "description": "FixedPrice update Options",
+                        "description": "Factor update Options",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/rest.FixedPriceUpdateRequest"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/bulk.FactorUpdateRequest"
+                            }
                         }
                     }
                 ],
@@ -1982,20 +2055,20 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/rest.FixedPriceUpdateRespose"
+                            "$ref": "#/definitions/utils.BaseResponse"
                         }
                     }
                 }
             }
         },
-        "/price/override": {
+        "/bulk/floor": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "set bid factor per user IP - valid for only 8 hours",
+                "description": "Update Floor setup in bulk (publisher, floor, device and country fields are mandatory)",
                 "consumes": [
                     "application/json"
                 ],
@@ -2003,16 +2076,19 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Price Override"
+                    "Bulk"
                 ],
                 "parameters": [
                     {
-                        "description": "options",
+                        "description": "Floor update Options",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.PriceOverrideRequest"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.FloorUpdateRequest"
+                            }
                         }
                     }
                 ],
@@ -2020,34 +2096,40 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.BaseResponse"
+                            "$ref": "#/definitions/bulk.FloorUpdateResponse"
                         }
                     }
                 }
             }
         },
-        "/publisher/count": {
+        "/bulk/global/factor": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
+                "description": "Update Global Factor Fees in bulk",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "publisher"
+                    "Bulk"
                 ],
-                "summary": "Count publishers",
                 "parameters": [
                     {
-                        "description": "options",
+                        "description": "Global Factor update Options",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/core.GetPublisherOptions"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/bulk.GlobalFactorRequest"
+                            }
                         }
                     }
                 ],
@@ -2055,20 +2137,20 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/rest.PublisherCountResponse"
+                            "$ref": "#/definitions/utils.BaseResponse"
                         }
                     }
                 }
             }
         },
-        "/publisher/demand/get": {
+        "/compass/manager/delete": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get PublisherDemandResponse List",
+                "description": "Delete mapping of manager ids in compass to users",
                 "consumes": [
                     "application/json"
                 ],
@@ -2076,16 +2158,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Publisher Demand Domain"
+                    "Compass"
                 ],
                 "parameters": [
                     {
-                        "description": "options",
+                        "description": "Compass managers delete Options",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/core.GetPublisherDemandOptions"
+                            "$ref": "#/definitions/dto.CompassManagerDeleteRequest"
                         }
                     }
                 ],
@@ -2093,23 +2175,20 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/core.PublisherDemandResponse"
-                            }
+                            "$ref": "#/definitions/utils.BaseResponse"
                         }
                     }
                 }
             }
         },
-        "/publisher/demand/udpate": {
+        "/compass/manager/get": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get PublisherDemandResponse List",
+                "description": "Get mapping of manager ids in compass to users",
                 "consumes": [
                     "application/json"
                 ],
@@ -2117,7 +2196,7 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Publisher Demand Domain"
+                    "Compass"
                 ],
                 "parameters": [
                     {
@@ -2126,7 +2205,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.PublisherDomainRequest"
+                            "$ref": "#/definitions/core.GetCompassManagerOptions"
                         }
                     }
                 ],
@@ -2136,21 +2215,21 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/core.PublisherDemandResponse"
+                                "$ref": "#/definitions/dto.CompassManager"
                             }
                         }
                     }
                 }
             }
         },
-        "/publisher/details/get": {
+        "/compass/manager/set": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get Publishers with information about domains setup",
+                "description": "Map manager ids in compass to users, existing mapping of compass id is replaced",
                 "consumes": [
                     "application/json"
                 ],
@@ -2158,16 +2237,19 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "publisher"
+                    "Compass"
                 ],
                 "parameters": [
                     {
-                        "description": "options",
+                        "description": "Compass managers",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/core.GetPublisherDetailsOptions"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.CompassManager"
+                            }
                         }
                     }
                 ],
@@ -2175,23 +2257,20 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dto.PublisherDetail"
-                            }
+                            "$ref": "#/definitions/utils.BaseResponse"
                         }
                     }
                 }
             }
         },
-        "/publisher/domain": {
+        "/competitor": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update and enable Publisher Domain setup (publisher is mandatory, domain is optional)",
+                "description": "Update Competitor setup (name is mandatory, url is mandatory)",
                 "consumes": [
                     "application/json"
                 ],
@@ -2199,16 +2278,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Publisher Domain"
+                    "Competitor"
                 ],
                 "parameters": [
                     {
-                        "description": "Publisher Domain update Options",
+                        "description": "Competitor update Options",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.PublisherDomainUpdateRequest"
+                            "$ref": "#/definitions/core.CompetitorUpdateRequest"
                         }
                     }
                 ],
@@ -2216,20 +2295,20 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.BaseResponse"
+                            "$ref": "#/definitions/rest.CompetitorUpdateResponse"
                         }
                     }
                 }
             }
         },
-        "/publisher/domain/get": {
+        "/competitor/get": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get Publisher Domain setup",
+                "description": "Get Competitor setup",
                 "consumes": [
                     "application/json"
                 ],
@@ -2237,7 +2316,7 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Publisher Domain"
+                    "Competitor"
                 ],
                 "parameters": [
                     {
@@ -2246,7 +2325,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/core.GetPublisherDomainOptions"
+                            "$ref": "#/definitions/core.GetCompetitorOptions"
                         }
                     }
                 ],
@@ -2256,35 +2335,38 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/dto.PublisherDomain"
+                                "$ref": "#/definitions/core.Competitor"
                             }
                         }
                     }
                 }
             }
         },
-        "/publisher/get": {
+        "/confiant": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
+                "description": "Update and enable Confiant setup (publisher is mandatory, domain is optional)",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "publisher"
+                    "Confiant"
                 ],
-                "summary": "Count publishers",
                 "parameters": [
                     {
-                        "description": "options",
+                        "description": "Confiant update Options",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/core.GetPublisherOptions"
+                            "$ref": "#/definitions/dto.ConfiantUpdateRequest"
                         }
                     }
                 ],
@@ -2292,37 +2374,37 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dto.Publisher"
-                            }
+                            "$ref": "#/definitions/utils.BaseResponse"
                         }
                     }
                 }
             }
         },
-        "/publisher/new": {
+        "/confiant/get": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Create a publisher",
+                "description": "Get confiant setup",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "publisher"
+                    "Confiant"
                 ],
                 "parameters": [
                     {
-                        "description": "create publisher values",
+                        "description": "options",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.PublisherCreateValues"
+                            "$ref": "#/definitions/core.GetConfiantOptions"
                         }
                     }
                 ],
@@ -2330,35 +2412,40 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/rest.PublisherNewResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.Confiant"
+                            }
                         }
                     }
                 }
             }
         },
-        "/publisher/update": {
+        "/config": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Updates publisher fields",
+                "description": "Update Factor setup (publisher is mandatory, domain is mandatory)",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "publisher"
+                    "Configuration"
                 ],
-                "summary": "Update publisher.",
                 "parameters": [
                     {
-                        "description": "Publisher Update Options",
+                        "description": "Factor update Options",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/rest.PublisherUpdateRequest"
+                            "$ref": "#/definitions/core.ConfigurationRequest"
                         }
                     }
                 ],
@@ -2366,20 +2453,20 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/rest.PublisherUpdateResponse"
+                            "$ref": "#/definitions/utils.BaseResponse"
                         }
                     }
                 }
             }
         },
-        "/refresh_cache/delete": {
-            "delete": {
+        "/config/get": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Delete refresh  cache.",
+                "description": "Get all configuration from DB",
                 "consumes": [
                     "application/json"
                 ],
@@ -2387,7 +2474,7 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RefreshCache"
+                    "Configuration"
                 ],
                 "parameters": [
                     {
@@ -2396,24 +2483,28 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/core.ConfigurationPayload"
                         }
                     }
                 ],
-                "responses": {}
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.BaseResponse"
+                        }
+                    }
+                }
             }
         },
-        "/refresh_cache/get": {
+        "/demand/factor": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get refresh cache setup",
+                "description": "Update new bidder demand factor for demand partner.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2421,16 +2512,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RefreshCache"
+                    "MetaData"
                 ],
                 "parameters": [
                     {
-                        "description": "options",
+                        "description": "DemandFactor update Options",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/core.GetRefreshCacheOptions"
+                            "$ref": "#/definitions/rest.DemandFactorUpdateRequest"
                         }
                     }
                 ],
@@ -2438,20 +2529,15 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.RefreshCache"
+                            "$ref": "#/definitions/rest.DemandFactorUpdateRespose"
                         }
                     }
                 }
             }
         },
-        "/refresh_cache/set": {
+        "/download": {
             "post": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Create Refresh Cache setup",
+                "description": "Download body data as file according to format in request. Data should be passed as array of json objects which have same structure",
                 "consumes": [
                     "application/json"
                 ],
@@ -2459,16 +2545,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RefreshCache"
+                    "Download"
                 ],
                 "parameters": [
                     {
-                        "description": "Refresh Cache create Options",
+                        "description": "request",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.RefreshCacheUpdateRequest"
+                            "$ref": "#/definitions/dto.DownloadRequest"
                         }
                     }
                 ],
@@ -2482,14 +2568,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/refresh_cache/update": {
+        "/dp-api-report": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update Refresh Cache setup",
+                "description": "Get dpi-report  demand partners report by date range",
                 "consumes": [
                     "application/json"
                 ],
@@ -2497,16 +2583,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RefreshCache"
+                    "Dp API"
                 ],
                 "parameters": [
                     {
-                        "description": "Refresh Cache update Options",
+                        "description": "options",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.RefreshCacheUpdRequest"
+                            "$ref": "#/definitions/core.GetDPApiOptions"
                         }
                     }
                 ],
@@ -2514,20 +2600,23 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.BaseResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.DpiApi"
+                            }
                         }
                     }
                 }
             }
         },
-        "/report/demand": {
-            "get": {
+        "/dp-api-report/demandPartners": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "fetching challenges based on filter with pagination,order and selected fields",
+                "description": "Get dpi-report demand partners",
                 "consumes": [
                     "application/json"
                 ],
@@ -2535,17 +2624,46 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "DemandReport"
+                    "Dp API"
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.DpiApi"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/dp/approval/decide": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Record approval or rejection of domains by demand partner and update demand status of their ads.txt lines",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "DemandPartner"
                 ],
-                "summary": "Get DemandReports.",
                 "parameters": [
                     {
-                        "description": "DemandReport Get Options",
+                        "description": "Demand partner approval decision Options",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/rest.DemandReportGetRequest"
+                            "$ref": "#/definitions/dto.DPApprovalDecisionRequest"
                         }
                     }
                 ],
@@ -2553,20 +2671,20 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/rest.DemandReportGetResponse"
+                            "$ref": "#/definitions/utils.BaseResponse"
                         }
                     }
                 }
             }
         },
-        "/report/iiq/hourly": {
+        "/dp/approval/get": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "fetching challenges based on filter with pagination,order and selected fields",
+                "description": "Get approval requests of publisher domains by demand partners",
                 "consumes": [
                     "application/json"
                 ],
@@ -2574,17 +2692,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "IiqTesting"
+                    "DemandPartner"
                 ],
-                "summary": "Get IiqTestings.",
                 "parameters": [
                     {
-                        "description": "IiqTesting Get Options",
+                        "description": "options",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/rest.IiqTestingGetRequest"
+                            "$ref": "#/definitions/core.GetDPApprovalRequestOptions"
                         }
                     }
                 ],
@@ -2592,20 +2709,23 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/rest.IiqTestingGetResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.DPApprovalRequest"
+                            }
                         }
                     }
                 }
             }
         },
-        "/report/publisher": {
-            "get": {
+        "/dp/approval/send": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "fetching challenges based on filter with pagination,order and selected fields",
+                "description": "Email domains to point of contact of demand partner with csv attached and mark their approval requests as sent, already approved domains are skipped",
                 "consumes": [
                     "application/json"
                 ],
@@ -2613,17 +2733,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "PublisherReport"
+                    "DemandPartner"
                 ],
-                "summary": "Get PublisherReports.",
                 "parameters": [
                     {
-                        "description": "PublisherReport Get Options",
+                        "description": "Demand partner approval send Options",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/rest.PublisherReportGetRequest"
+                            "$ref": "#/definitions/dto.DPApprovalSendRequest"
                         }
                     }
                 ],
@@ -2631,20 +2750,20 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/rest.PublisherReportGetResponse"
+                            "$ref": "#/definitions/utils.BaseResponse"
                         }
                     }
                 }
             }
         },
-        "/search": {
+        "/dp/bidder/params/delete": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Search for publisher_id, publisher_name, domain, demand partner name.",
+                "description": "Delete bidder parameters, deletion is rejected when remaining domain parameters become incomplete",
                 "consumes": [
                     "application/json"
                 ],
@@ -2652,16 +2771,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Search"
+                    "DemandPartner"
                 ],
                 "parameters": [
                     {
-                        "description": "Request",
-                        "name": "request",
+                        "description": "Bidder params delete Options",
+                        "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.SearchRequest"
+                            "$ref": "#/definitions/dto.DPBidderParamsDeleteRequest"
                         }
                     }
                 ],
@@ -2669,26 +2788,20 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "array",
-                                "items": {
-                                    "$ref": "#/definitions/dto.SearchResult"
-                                }
-                            }
+                            "$ref": "#/definitions/utils.BaseResponse"
                         }
                     }
                 }
             }
         },
-        "/targeting/get": {
+        "/dp/bidder/params/get": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get targeting data.",
+                "description": "Get bidder parameters of demand partners for publishers and domains",
                 "consumes": [
                     "application/json"
                 ],
@@ -2696,16 +2809,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Targeting"
+                    "DemandPartner"
                 ],
                 "parameters": [
                     {
-                        "description": "Options",
+                        "description": "options",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/core.TargetingOptions"
+                            "$ref": "#/definitions/core.GetDPBidderParamsOptions"
                         }
                     }
                 ],
@@ -2715,21 +2828,21 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/dto.Targeting"
+                                "$ref": "#/definitions/dto.DPBidderParams"
                             }
                         }
                     }
                 }
             }
         },
-        "/targeting/set": {
+        "/dp/bidder/params/set": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Create new targeting.",
+                "description": "Create or replace bidder parameters of demand partner for publisher or domain, domain parameters override publisher ones. Parameters are validated against schema of demand partner and sent to metadata",
                 "consumes": [
                     "application/json"
                 ],
@@ -2737,16 +2850,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Targeting"
+                    "DemandPartner"
                 ],
                 "parameters": [
                     {
-                        "description": "Targeting",
+                        "description": "Bidder params",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.Targeting"
+                            "$ref": "#/definitions/dto.DPBidderParams"
                         }
                     }
                 ],
@@ -2760,14 +2873,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/targeting/tags": {
+        "/dp/bidder/schema/get": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Export one or multiple tags.",
+                "description": "Get JSON Schemas of bidder parameters of demand partners",
                 "consumes": [
                     "application/json"
                 ],
@@ -2775,16 +2888,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Targeting"
+                    "DemandPartner"
                 ],
                 "parameters": [
                     {
-                        "description": "Export tags request",
-                        "name": "request",
+                        "description": "options",
+                        "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/core.ExportTagsRequest"
+                            "$ref": "#/definitions/core.GetDPBidderSchemaOptions"
                         }
                     }
                 ],
@@ -2792,20 +2905,23 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/rest.TargetingTagsResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.DPBidderSchema"
+                            }
                         }
                     }
                 }
             }
         },
-        "/targeting/update": {
+        "/dp/bidder/schema/set": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update targeting in terms of cost model, value and status.",
+                "description": "Create or replace JSON Schema of bidder parameters of demand partner, existing parameters must match the schema",
                 "consumes": [
                     "application/json"
                 ],
@@ -2813,16 +2929,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Targeting"
+                    "DemandPartner"
                 ],
                 "parameters": [
                     {
-                        "description": "Targeting",
+                        "description": "Bidder schema",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.Targeting"
+                            "$ref": "#/definitions/dto.DPBidderSchema"
                         }
                     }
                 ],
@@ -2836,14 +2952,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/by_types": {
-            "get": {
+        "/dp/get": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get users by types data.",
+                "description": "Get demand partners.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2851,26 +2967,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "DemandPartner"
                 ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                "parameters": [
+                    {
+                        "description": "options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.UsersByTypes"
+                            "$ref": "#/definitions/core.DemandPartnerGetOptions"
                         }
                     }
-                }
+                ],
+                "responses": {}
             }
         },
-        "/user/get": {
+        "/dp/score/get": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get users data.",
+                "description": "Get scores of demand partners with their components, only the latest calculation is returned unless calculated_at filter is set.\nOverall scores have empty country and device type.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2878,16 +2998,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "DemandPartner"
                 ],
                 "parameters": [
                     {
-                        "description": "Options",
+                        "description": "options",
                         "name": "options",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/core.UserOptions"
+                            "$ref": "#/definitions/core.GetDPScoreOptions"
                         }
                     }
                 ],
@@ -2897,21 +3017,21 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/dto.User"
+                                "$ref": "#/definitions/dto.DPScore"
                             }
                         }
                     }
                 }
             }
         },
-        "/user/info": {
-            "get": {
+        "/dp/seat_owner/get": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get user info.",
+                "description": "Get seat owners for demand partners.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2919,35 +3039,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "DemandPartner"
                 ],
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "User ID",
-                        "name": "id",
-                        "in": "query",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                        "description": "options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.User"
+                            "$ref": "#/definitions/core.SeatOwnerGetOptions"
                         }
                     }
-                }
+                ],
+                "responses": {}
             }
         },
-        "/user/set": {
+        "/dp/set": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Create new user.",
+                "description": "Create new demand partner.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2955,16 +3070,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "DemandPartner"
                 ],
                 "parameters": [
                     {
-                        "description": "User",
+                        "description": "DemandPartner",
                         "name": "user",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.User"
+                            "$ref": "#/definitions/dto.DemandPartner"
                         }
                     }
                 ],
@@ -2978,14 +3093,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/update": {
+        "/dp/update": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update user.",
+                "description": "Update demand partner.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2993,16 +3108,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "DemandPartner"
                 ],
                 "parameters": [
                     {
-                        "description": "User",
+                        "description": "DemandPartner",
                         "name": "user",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.User"
+                            "$ref": "#/definitions/dto.DemandPartner"
                         }
                     }
                 ],
@@ -3015,57 +3130,4980 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "bulk.FactorUpdateRequest": {
-            "type": "object",
-            "properties": {
-                "country": {
-                    "type": "string"
-                },
-                "device": {
-                    "type": "string"
-                },
-                "domain": {
-                    "type": "string"
-                },
-                "factor": {
-                    "type": "number"
-                },
-                "publisher": {
-                    "type": "string"
-                },
-                "rule_id": {
-                    "type": "string"
-                }
-            }
-        },
-        "bulk.FloorUpdateResponse": {
-            "type": "object",
-            "properties": {
-                "status": {
-                    "type": "string"
-                }
-            }
         },
-        "bulk.GlobalFactorRequest": {
-            "type": "object",
-            "properties": {
-                "key": {
-                    "type": "string"
-                },
-                "publisher_id": {
-                    "type": "string"
-                },
-                "value": {
-                    "type": "number"
+        "/dpo/delete": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete demand partner optimization rule for publisher.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "DPO"
+                ],
+                "parameters": [
+                    {
+                        "description": "options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                ],
+                "responses": {}
+            }
+        },
+        "/dpo/get": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get demand partner optimization rules for publisher.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "DPO"
+                ],
+                "parameters": [
+                    {
+                        "description": "options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/core.DPOFactorOptions"
+                        }
+                    }
+                ],
+                "responses": {}
+            }
+        },
+        "/dpo/set": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update demand partner optimization rule for a publisher.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "DPO"
+                ],
+                "parameters": [
+                    {
+                        "description": "Demand Partner Optimization update rule",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.DPORuleUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.DPORuleUpdateRequest"
+                        }
+                    }
+                }
+            }
+        },
+        "/dpo/update": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update demand partner optimization rule by rule id..",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "DPO"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "rule ID",
+                        "name": "rid",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "factor (0-100)",
+                        "name": "factor",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {}
+            }
+        },
+        "/factor": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update Factor setup (publisher is mandatory, domain is mandatory)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Factor"
+                ],
+                "parameters": [
+                    {
+                        "description": "Factor update Options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.FactorUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rest.FactorUpdateResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/factor/delete": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "soft delete factor from Factor table and deletes it from metadata_queue table",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Factor"
+                ],
+                "parameters": [
+                    {
+                        "description": "options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rest.FactorUpdateResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/factor/get": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get factor setup",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Factor"
+                ],
+                "parameters": [
+                    {
+                        "description": "options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/core.GetFactorOptions"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.Factor"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/floor": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update Floor setup (publisher, floor, device and country fields are mandatory)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Floor"
+                ],
+                "parameters": [
+                    {
+                        "description": "Floor update Options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.FloorUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.BaseResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/floor/delete": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "soft delete floor from Floor table and deletes it from metadata_queue table",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Floor"
+                ],
+                "parameters": [
+                    {
+                        "description": "options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rest.FloorUpdateResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/floor/get": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get floor setup",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Floor"
+                ],
+                "parameters": [
+                    {
+                        "description": "options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/core.GetFloorOptions"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.Floor"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/global/factor": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update Global Factors Fees",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Global Factor"
+                ],
+                "parameters": [
+                    {
+                        "description": "Global Factor update Options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/core.GlobalFactorRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.BaseResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/global/factor/get": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get confiant setup",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Global Factor"
+                ],
+                "parameters": [
+                    {
+                        "description": "options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/core.GetGlobalFactorOptions"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/core.GlobalFactor"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/history/get": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get history data.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "History"
+                ],
+                "parameters": [
+                    {
+                        "description": "Options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/core.HistoryOptions"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.History"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/metadata/update": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update new bidder metadata.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MetaData"
+                ],
+                "parameters": [
+                    {
+                        "description": "Metadata update Options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/core.MetadataUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.BaseResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/ping": {
+            "get": {
+                "description": "Check Health of Service",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "text/html"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "responses": {}
+            }
+        },
+        "/pixalate": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update and enable Pixalate setup (publisher is mandatory, domain is optional)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pixalate"
+                ],
+                "parameters": [
+                    {
+                        "description": "Pixalate update Options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.PixalateUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.BaseResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pixalate/delete": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete Pixalate - soft delete.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pixalate"
+                ],
+                "parameters": [
+                    {
+                        "description": "options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                ],
+                "responses": {}
+            }
+        },
+        "/pixalate/get": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get Pixalate setup",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pixalate"
+                ],
+                "parameters": [
+                    {
+                        "description": "options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/core.GetPixalateOptions"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.Pixalate"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/portal/domains": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get domains of publisher with ads.txt status and lines which must be added",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portal"
+                ],
+                "parameters": [
+                    {
+                        "description": "Portal Options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.PortalRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.PortalDomain"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/portal/notification/get": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get notification preferences of publisher",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portal"
+                ],
+                "parameters": [
+                    {
+                        "description": "Portal Options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.PortalRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.PublisherNotificationPreference"
+                        }
+                    }
+                }
+            }
+        },
+        "/portal/notification/set": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Set notification preferences of publisher",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portal"
+                ],
+                "parameters": [
+                    {
+                        "description": "Notification preference Options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.PublisherNotificationPreference"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.BaseResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/portal/report": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get daily or hourly impressions and revenue of publisher by domain",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portal"
+                ],
+                "parameters": [
+                    {
+                        "description": "Portal report Options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.PortalReportRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.PortalReportRow"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/portal/statement": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get monthly impressions and revenue payable to publisher",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portal"
+                ],
+                "parameters": [
+                    {
+                        "description": "Portal statement Options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.PortalStatementRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/monthly.Statement"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/portal/tags": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Export JS tags of publisher targetings",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portal"
+                ],
+                "parameters": [
+                    {
+                        "description": "Portal tags Options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.PortalTagsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.Tags"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/price/fixed": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get fixed price rates",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "text/html"
+                ],
+                "tags": [
+                    "MetaData"
+                ],
+                "responses": {}
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update javascript tag guaranteed price",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MetaData"
+                ],
+                "parameters": [
+                    {
+                        "description": "FixedPrice update Options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/rest.FixedPriceUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rest.FixedPriceUpdateRespose"
+                        }
+                    }
+                }
+            }
+        },
+        "/price/override": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Set bid price per user IP or CIDR range of domain. Entries expire after ttl_hours (8 hours by default), existing entries get new price and expiry.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Price Override"
+                ],
+                "parameters": [
+                    {
+                        "description": "options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.PriceOverrideRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.BaseResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/price/override/delete": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete price overrides, overrides of affected domains are republished",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Price Override"
+                ],
+                "parameters": [
+                    {
+                        "description": "options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "integer"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.BaseResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/price/override/get": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get price overrides with filtering and pagination",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Price Override"
+                ],
+                "parameters": [
+                    {
+                        "description": "options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/core.GetPriceOverrideOptions"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.PriceOverride"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/publisher/count": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "publisher"
+                ],
+                "summary": "Count publishers",
+                "parameters": [
+                    {
+                        "description": "options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/core.GetPublisherOptions"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rest.PublisherCountResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/publisher/demand/get": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get PublisherDemandResponse List",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Publisher Demand Domain"
+                ],
+                "parameters": [
+                    {
+                        "description": "options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/core.GetPublisherDemandOptions"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/core.PublisherDemandResponse"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/publisher/demand/udpate": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get PublisherDemandResponse List",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Publisher Demand Domain"
+                ],
+                "parameters": [
+                    {
+                        "description": "options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.PublisherDomainRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/core.PublisherDemandResponse"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/publisher/details/get": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get Publishers with information about domains setup",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "publisher"
+                ],
+                "parameters": [
+                    {
+                        "description": "options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/core.GetPublisherDetailsOptions"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.PublisherDetail"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/publisher/domain": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update and enable Publisher Domain setup (publisher is mandatory, domain is optional)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Publisher Domain"
+                ],
+                "parameters": [
+                    {
+                        "description": "Publisher Domain update Options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.PublisherDomainUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.BaseResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/publisher/domain/get": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get Publisher Domain setup",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Publisher Domain"
+                ],
+                "parameters": [
+                    {
+                        "description": "options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/core.GetPublisherDomainOptions"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.PublisherDomain"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/publisher/get": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "publisher"
+                ],
+                "summary": "Count publishers",
+                "parameters": [
+                    {
+                        "description": "options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/core.GetPublisherOptions"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.Publisher"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/publisher/lifecycle/report": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get days publishers spent in each lifecycle status (onboarding, active, paused, churned) during the period",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "publisher"
+                ],
+                "parameters": [
+                    {
+                        "description": "options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.PublisherLifecycleReportRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.PublisherTimeInState"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/publisher/new": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create a publisher",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "publisher"
+                ],
+                "parameters": [
+                    {
+                        "description": "create publisher values",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.PublisherCreateValues"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rest.PublisherNewResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/publisher/sync/get": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get states of publishers sync with compass including per field diffs of conflicting publishers",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "publisher"
+                ],
+                "parameters": [
+                    {
+                        "description": "options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/core.GetPublisherCompassSyncOptions"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.PublisherCompassSync"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/publisher/sync/resolve": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Resolve publisher changed both locally and in compass by keeping local values, which are pushed to compass then, or compass values",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "publisher"
+                ],
+                "parameters": [
+                    {
+                        "description": "Publisher sync resolve Options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.PublisherSyncResolveRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.BaseResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/publisher/update": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Updates publisher fields",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "publisher"
+                ],
+                "summary": "Update publisher.",
+                "parameters": [
+                    {
+                        "description": "Publisher Update Options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/rest.PublisherUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rest.PublisherUpdateResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/recommendation/apply": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Apply floors and factors of selected recommendations in one transaction, floor or factor could be omitted to apply only the other one. When factor changes require approval, nothing is applied until the created change request is approved.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Recommendation"
+                ],
+                "parameters": [
+                    {
+                        "description": "options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.RecommendationApplyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.BaseResponse"
+                        }
+                    },
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ChangeRequestPendingResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/recommendation/get": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get floor and factor recommendations per publisher, domain, country and device estimated from historical performance and bid landscape. Recommendations maximize expected GP keeping fill rate within constraints and are sorted by expected GP increase.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Recommendation"
+                ],
+                "parameters": [
+                    {
+                        "description": "options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/recommendation.GetRecommendationOptions"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.Recommendation"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/refresh_cache/delete": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete refresh  cache.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RefreshCache"
+                ],
+                "parameters": [
+                    {
+                        "description": "options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                ],
+                "responses": {}
+            }
+        },
+        "/refresh_cache/get": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get refresh cache setup",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RefreshCache"
+                ],
+                "parameters": [
+                    {
+                        "description": "options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/core.GetRefreshCacheOptions"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.RefreshCache"
+                        }
+                    }
+                }
+            }
+        },
+        "/refresh_cache/set": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create Refresh Cache setup",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RefreshCache"
+                ],
+                "parameters": [
+                    {
+                        "description": "Refresh Cache create Options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.RefreshCacheUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.BaseResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/refresh_cache/update": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update Refresh Cache setup",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RefreshCache"
+                ],
+                "parameters": [
+                    {
+                        "description": "Refresh Cache update Options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.RefreshCacheUpdRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.BaseResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/report/demand": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "fetching challenges based on filter with pagination,order and selected fields",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "DemandReport"
+                ],
+                "summary": "Get DemandReports.",
+                "parameters": [
+                    {
+                        "description": "DemandReport Get Options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/rest.DemandReportGetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rest.DemandReportGetResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/report/iiq/hourly": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "fetching challenges based on filter with pagination,order and selected fields",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "IiqTesting"
+                ],
+                "summary": "Get IiqTestings.",
+                "parameters": [
+                    {
+                        "description": "IiqTesting Get Options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/rest.IiqTestingGetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rest.IiqTestingGetResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/report/publisher": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "fetching challenges based on filter with pagination,order and selected fields",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PublisherReport"
+                ],
+                "summary": "Get PublisherReports.",
+                "parameters": [
+                    {
+                        "description": "PublisherReport Get Options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/rest.PublisherReportGetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rest.PublisherReportGetResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/schain/get": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get supply chain compliance per publisher and demand partner or seat owner ads.txt line",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Schain"
+                ],
+                "parameters": [
+                    {
+                        "description": "options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/core.GetSchainComplianceOptions"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.SchainCompliance"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/schain/summary": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get supply chain compliance aggregated per demand partner or seat owner",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Schain"
+                ],
+                "parameters": [
+                    {
+                        "description": "options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/core.GetSchainComplianceOptions"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.SchainComplianceSummary"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/search": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Search for publisher_id, publisher_name, domain, demand partner name.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Search"
+                ],
+                "parameters": [
+                    {
+                        "description": "Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SearchRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "array",
+                                "items": {
+                                    "$ref": "#/definitions/dto.SearchResult"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/sellers.json": {
+            "get": {
+                "description": "Get the latest published sellers.json. Responds with 304 when If-None-Match header matches its ETag.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SellersJSON"
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/sellersjson.File"
+                        }
+                    }
+                }
+            }
+        },
+        "/sellers_json/diff": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get sellers added, removed and changed in the version comparing to the previous one",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SellersJSON"
+                ],
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Version, the latest when not set",
+                        "name": "version",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SellersJSONDiff"
+                        }
+                    }
+                }
+            }
+        },
+        "/sellers_json/publish": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Build sellers.json from publishers, validate and publish it as a new version when it changed since the latest one",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SellersJSON"
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SellersJSONPublishResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/sellers_json/version/get": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get published sellers.json versions",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SellersJSON"
+                ],
+                "parameters": [
+                    {
+                        "description": "options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/core.GetSellersJSONVersionOptions"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.SellersJSONVersion"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/targeting/get": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get targeting data.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Targeting"
+                ],
+                "parameters": [
+                    {
+                        "description": "Options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/core.TargetingOptions"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.Targeting"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/targeting/set": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create new targeting.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Targeting"
+                ],
+                "parameters": [
+                    {
+                        "description": "Targeting",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.Targeting"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.BaseResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/targeting/tags": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Export one or multiple tags.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Targeting"
+                ],
+                "parameters": [
+                    {
+                        "description": "Export tags request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/core.ExportTagsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rest.TargetingTagsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/targeting/update": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update targeting in terms of cost model, value and status.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Targeting"
+                ],
+                "parameters": [
+                    {
+                        "description": "Targeting",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.Targeting"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.BaseResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/by_types": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get users by types data.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UsersByTypes"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/get": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get users data.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "parameters": [
+                    {
+                        "description": "Options",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/core.UserOptions"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.User"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/user/info": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get user info.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.User"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/publisher/get": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get publishers which user with scoped role (Publisher, Consultant) can see and edit.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "parameters": [
+                    {
+                        "description": "User ID",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserPublishers"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserPublishers"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/publisher/set": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Replace publishers which user with scoped role (Publisher, Consultant) can see and edit.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "parameters": [
+                    {
+                        "description": "User Publishers",
+                        "name": "options",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserPublishers"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.BaseResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/set": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create new user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "parameters": [
+                    {
+                        "description": "User",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.User"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.BaseResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/update": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "parameters": [
+                    {
+                        "description": "User",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.User"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.BaseResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "approval.ApprovalPolicyFilter": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "subject": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "approval.ChangeRequestFilter": {
+            "type": "object",
+            "properties": {
+                "approver_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "id": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "policy_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "requester_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "status": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "subject": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "approval.GetApprovalPolicyOptions": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/approval.ApprovalPolicyFilter"
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "approval.GetChangeRequestOptions": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/approval.ChangeRequestFilter"
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "bulk.FactorUpdateRequest": {
+            "type": "object",
+            "properties": {
+                "country": {
+                    "type": "string"
+                },
+                "device": {
+                    "type": "string"
+                },
+                "domain": {
+                    "type": "string"
+                },
+                "factor": {
+                    "type": "number"
+                },
+                "publisher": {
+                    "type": "string"
+                },
+                "rule_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "bulk.FloorUpdateResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "bulk.GlobalFactorRequest": {
+            "type": "object",
+            "properties": {
+                "key": {
+                    "type": "string"
+                },
+                "publisher_id": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "core.APIKeyFilter": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "name": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "owner_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "core.AdsTxtGetBaseOptions": {
+            "type": "object",
+            "properties": {
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.AdsTxtGetGroupByDPFilter": {
+            "type": "object",
+            "properties": {
+                "account_manager_full_name": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "campaign_manager_full_name": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "demand_manager_full_name": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "demand_partner_name": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "demand_status": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "domain": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "domain_status": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "dp_enabled": {
+                    "type": "boolean"
+                },
+                "media_type": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "mirror_publisher_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "publisher_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "core.AdsTxtGetGroupByDPOptions": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/core.AdsTxtGetGroupByDPFilter"
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.AdsTxtGetMainFilter": {
+            "type": "object",
+            "properties": {
+                "account_manager_full_name": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "campaign_manager_full_name": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "demand_manager_full_name": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "demand_partner_name_extended": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "demand_status": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "domain": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "domain_status": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "is_required": {
+                    "type": "boolean"
+                },
+                "media_type": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "mirror_publisher_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "publisher_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "status": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "core.AdsTxtGetMainOptions": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/core.AdsTxtGetMainFilter"
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.AlertEventFilter": {
+            "type": "object",
+            "properties": {
+                "first_seen_at": {
+                    "$ref": "#/definitions/filter.DatesFilter"
+                },
+                "id": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "key": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "rule_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "severity": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "status": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "core.AlertRuleFilter": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "name": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "severity": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "core.AuditLogFilter": {
+            "type": "object",
+            "properties": {
+                "api_key_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "created_at": {
+                    "$ref": "#/definitions/filter.DatesFilter"
+                },
+                "method": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "request_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "role": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "route": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "status": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "user_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "core.BidCachingFilter": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "country": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "device": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "domain": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "publisher": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "core.BlockItemsFilter": {
+            "type": "object",
+            "properties": {
+                "domain": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "expired": {
+                    "type": "boolean"
+                },
+                "publisher": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "search": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "value": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "core.CompassManagerFilter": {
+            "type": "object",
+            "properties": {
+                "compass_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "user_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "core.Competitor": {
+            "type": "object",
+            "required": [
+                "name",
+                "position",
+                "type",
+                "url"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "position": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.CompetitorFilter": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "position": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "type": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "url": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "core.CompetitorUpdateRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "position",
+                "type",
+                "url"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "position": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.ConfiantFilter": {
+            "type": "object",
+            "properties": {
+                "confiant_key": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "domain": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "publisher_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "rate": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "core.ConfigurationFilter": {
+            "type": "object",
+            "properties": {
+                "key": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "value": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "core.ConfigurationPayload": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/core.ConfigurationFilter"
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.ConfigurationRequest": {
+            "type": "object",
+            "required": [
+                "key",
+                "value"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.DPApiFilter": {
+            "type": "object",
+            "properties": {
+                "demand_partner": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "domain": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "core.DPApprovalRequestFilter": {
+            "type": "object",
+            "properties": {
+                "demand_partner_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "domain": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "publisher_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "status": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "core.DPBidderParamsFilter": {
+            "type": "object",
+            "properties": {
+                "demand_partner_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "domain": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "publisher_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "core.DPBidderSchemaFilter": {
+            "type": "object",
+            "properties": {
+                "demand_partner_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "core.DPOFactorOptions": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/core.DPORuleFilter"
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.DPORuleFilter": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "country": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "demand_partner_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "device_type": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "domain": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "factor": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "publisher": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "rule_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "core.DPScoreFilter": {
+            "type": "object",
+            "properties": {
+                "calculated_at": {
+                    "$ref": "#/definitions/filter.DatesFilter"
+                },
+                "country": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "demand_partner_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "device_type": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "core.DemandPartnerGetFilter": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "automation": {
+                    "type": "boolean"
+                },
+                "demand_partner_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "demand_partner_name": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "core.DemandPartnerGetOptions": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/core.DemandPartnerGetFilter"
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.DpoLogFilter": {
+            "type": "object",
+            "properties": {
+                "country": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "domain": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "dp": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "os": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "publisher": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "reason": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "resp_status": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "time": {
+                    "$ref": "#/definitions/filter.DatesFilter"
+                }
+            }
+        },
+        "core.DpoLogOptions": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/core.DpoLogFilter"
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.DpoLogTimelineOptions": {
+            "type": "object",
+            "required": [
+                "domain",
+                "dp",
+                "publisher"
+            ],
+            "properties": {
+                "country": {
+                    "type": "string"
+                },
+                "domain": {
+                    "type": "string"
+                },
+                "dp": {
+                    "type": "string"
+                },
+                "os": {
+                    "type": "string"
+                },
+                "publisher": {
+                    "type": "string"
+                },
+                "time": {
+                    "$ref": "#/definitions/filter.DatesFilter"
+                }
+            }
+        },
+        "core.ExportTagsRequest": {
+            "type": "object",
+            "properties": {
+                "add_gdpr": {
+                    "type": "boolean"
+                },
+                "ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "core.FactorFilter": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "country": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "device": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "domain": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "publisher": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "core.FactorLogFilter": {
+            "type": "object",
+            "properties": {
+                "country": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "device": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "domain": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "publisher": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "response_status": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "source": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "time": {
+                    "$ref": "#/definitions/filter.DatesFilter"
+                }
+            }
+        },
+        "core.FactorLogOptions": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/core.FactorLogFilter"
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.FactorLogTimelineOptions": {
+            "type": "object",
+            "required": [
+                "domain",
+                "publisher"
+            ],
+            "properties": {
+                "country": {
+                    "type": "string"
+                },
+                "device": {
+                    "type": "string"
+                },
+                "domain": {
+                    "type": "string"
+                },
+                "publisher": {
+                    "type": "string"
+                },
+                "time": {
+                    "$ref": "#/definitions/filter.DatesFilter"
+                }
+            }
+        },
+        "core.FloorFilter": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "country": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "device": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "domain": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "publisher": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "core.GetAPIKeyOptions": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/core.APIKeyFilter"
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.GetAlertEventOptions": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/core.AlertEventFilter"
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.GetAlertRuleOptions": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/core.AlertRuleFilter"
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.GetAuditLogOptions": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/core.AuditLogFilter"
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.GetBidCachingOptions": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/core.BidCachingFilter"
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.GetBlockItemsOptions": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/core.BlockItemsFilter"
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.GetCompassManagerOptions": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/core.CompassManagerFilter"
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.GetCompetitorOptions": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/core.CompetitorFilter"
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.GetConfiantOptions": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/core.ConfiantFilter"
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.GetDPApiOptions": {
+            "type": "object",
+            "properties": {
+                "date_stamp": {
+                    "type": "string"
+                },
+                "demand_partner": {
+                    "type": "string"
+                },
+                "endDate": {
+                    "type": "string"
+                },
+                "filter": {
+                    "$ref": "#/definitions/core.DPApiFilter"
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "startDate": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.GetDPApprovalRequestOptions": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/core.DPApprovalRequestFilter"
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.GetDPBidderParamsOptions": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/core.DPBidderParamsFilter"
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.GetDPBidderSchemaOptions": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/core.DPBidderSchemaFilter"
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.GetDPScoreOptions": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/core.DPScoreFilter"
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.GetFactorOptions": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/core.FactorFilter"
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.GetFloorOptions": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/core.FloorFilter"
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.GetGlobalFactorOptions": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/core.GlobalFactorFilter"
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.GetPixalateOptions": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/core.PixalateFilter"
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.GetPriceOverrideOptions": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/core.PriceOverrideFilter"
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.GetPublisherCompassSyncOptions": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/core.PublisherCompassSyncFilter"
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.GetPublisherDemandOptions": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/core.PublisherDemandFilter"
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.GetPublisherDetailsOptions": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/core.PublisherDetailsFilter"
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.GetPublisherDomainOptions": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/core.PublisherDomainFilter"
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.GetPublisherOptions": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/core.PublisherFilter"
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.GetRefreshCacheOptions": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/core.RefreshCacheFilter"
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.GetSchainComplianceOptions": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/core.SchainComplianceFilter"
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.GetSellersJSONVersionOptions": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/core.SellersJSONVersionFilter"
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.GlobalFactor": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "publisher_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "core.GlobalFactorFilter": {
+            "type": "object",
+            "properties": {
+                "key": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "publisher_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "value": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "core.GlobalFactorRequest": {
+            "type": "object",
+            "properties": {
+                "key": {
+                    "type": "string"
+                },
+                "publisher_id": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "number",
+                    "minimum": 0
+                }
+            }
+        },
+        "core.HistoryFilter": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "api_key_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "demand_partner_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "domain": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "entity_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "item": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "publisher_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "subject": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "user_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "core.HistoryOptions": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/core.HistoryFilter"
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.MetadataUpdateRequest": {
+            "type": "object",
+            "properties": {
+                "data": {},
+                "key": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.PixalateFilter": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "domain": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "pixalate_key": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "publisher_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "rate": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "core.PriceOverrideFilter": {
+            "type": "object",
+            "properties": {
+                "created_by": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "domain": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "expired": {
+                    "type": "boolean"
+                },
+                "ip": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "core.PublisherCompassSyncFilter": {
+            "type": "object",
+            "properties": {
+                "publisher_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "status": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "core.PublisherDemandFilter": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "ads_txt_status": {
+                    "type": "boolean"
+                },
+                "demand": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "domain": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "publisher_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "core.PublisherDemandResponse": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "ads_txt_status": {
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "demand_partner_id": {
+                    "type": "string"
+                },
+                "demand_partner_name": {
+                    "type": "string"
+                },
+                "domain": {
+                    "type": "string"
+                },
+                "publisher_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.PublisherDetailsFilter": {
+            "type": "object",
+            "properties": {
+                "account_manager": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "automation": {
+                    "type": "boolean"
+                },
+                "domain": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "publisher_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "core.PublisherDomainFilter": {
+            "type": "object",
+            "properties": {
+                "automation": {
+                    "type": "boolean"
+                },
+                "domain": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "gpp_target": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "publisher_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "core.PublisherFilter": {
+            "type": "object",
+            "properties": {
+                "account_manager_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "campaign_manager_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "created_at": {
+                    "$ref": "#/definitions/filter.DatesFilter"
+                },
+                "media_buyer_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "office_location": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "publisher_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "search": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.RefreshCacheFilter": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "country": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "device": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "domain": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "publisher": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "core.SchainComplianceFilter": {
+            "type": "object",
+            "properties": {
+                "demand_partner_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "dp_status": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "is_compliant": {
+                    "type": "boolean"
+                },
+                "our_status": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "partner_name": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "publisher_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "core.SeatOwnerGetFilter": {
+            "type": "object",
+            "properties": {
+                "certification_authority_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "publisher_account": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "seat_owner_domain": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "seat_owner_name": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "core.SeatOwnerGetOptions": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/core.SeatOwnerGetFilter"
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.SellersJSONVersionFilter": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "published_at": {
+                    "$ref": "#/definitions/filter.DatesFilter"
+                }
+            }
+        },
+        "core.TargetingFilter": {
+            "type": "object",
+            "properties": {
+                "browser": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "country": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "device_type": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "domain": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "os": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "placement_type": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "price_model": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "publisher_id": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "status": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "unit_size": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "core.AdsTxtGetBaseOptions": {
+        "core.TargetingOptions": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/core.TargetingFilter"
+                },
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.Field"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                },
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "core.UserFilter": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "email": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "first_name": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "last_name": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "organization_name": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "phone": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "role": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "core.UserOptions": {
             "type": "object",
             "properties": {
+                "filter": {
+                    "$ref": "#/definitions/core.UserFilter"
+                },
                 "order": {
                     "type": "array",
                     "items": {
@@ -3075,59 +8113,225 @@ const docTemplate = `{
                 "pagination": {
                     "$ref": "#/definitions/pagination.Pagination"
                 },
-                "selector": {
+                "selector": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.APIKey": {
+            "type": "object",
+            "required": [
+                "name",
+                "owner_id"
+            ],
+            "properties": {
+                "allowed_ips": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "integer"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "key_prefix": {
+                    "type": "string"
+                },
+                "last_used_at": {
+                    "type": "string"
+                },
+                "last_used_ip": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "owner_id": {
+                    "type": "integer"
+                },
+                "revoked_at": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.APIKeyCreateResponse": {
+            "type": "object",
+            "required": [
+                "name",
+                "owner_id"
+            ],
+            "properties": {
+                "allowed_ips": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "integer"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "key_prefix": {
+                    "type": "string"
+                },
+                "last_used_at": {
+                    "type": "string"
+                },
+                "last_used_ip": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "owner_id": {
+                    "type": "integer"
+                },
+                "revoked_at": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.APIKeyRevokeRequest": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.AdjustRequest": {
+            "type": "object",
+            "required": [
+                "domain",
+                "value"
+            ],
+            "properties": {
+                "domain": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.AdsTxt": {
+            "type": "object",
+            "properties": {
+                "account_manager_full_name": {
+                    "$ref": "#/definitions/null.String"
+                },
+                "account_manager_id": {
+                    "$ref": "#/definitions/null.String"
+                },
+                "action": {
+                    "type": "string"
+                },
+                "added": {
+                    "description": "count of added lines",
+                    "type": "integer"
+                },
+                "ads_txt_line": {
                     "type": "string"
-                }
-            }
-        },
-        "core.AdsTxtGetGroupByDPFilter": {
-            "type": "object",
-            "properties": {
-                "account_manager_full_name": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
                 },
                 "campaign_manager_full_name": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                    "$ref": "#/definitions/null.String"
+                },
+                "campaign_manager_id": {
+                    "$ref": "#/definitions/null.String"
+                },
+                "cursor_id": {
+                    "type": "integer"
                 },
                 "demand_manager_full_name": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                    "$ref": "#/definitions/null.String"
+                },
+                "demand_manager_id": {
+                    "$ref": "#/definitions/null.String"
+                },
+                "demand_partner_connection_id": {
+                    "$ref": "#/definitions/null.Int"
+                },
+                "demand_partner_id": {
+                    "type": "string"
                 },
                 "demand_partner_name": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                    "type": "string"
+                },
+                "demand_partner_name_extended": {
+                    "description": "like Amazon - Amazon or OMS - Direct",
+                    "type": "string"
                 },
                 "demand_status": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                    "type": "string"
                 },
                 "domain": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                    "type": "string"
                 },
                 "domain_status": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                    "type": "string"
                 },
                 "dp_enabled": {
+                    "description": "dp is ready to go live",
+                    "type": "boolean"
+                },
+                "error_message": {
+                    "$ref": "#/definitions/null.String"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_demand_partner_active": {
                     "type": "boolean"
                 },
+                "is_required": {
+                    "type": "boolean"
+                },
+                "last_scanned_at": {
+                    "type": "string"
+                },
                 "media_type": {
                     "type": "array",
                     "items": {
@@ -3135,493 +8339,724 @@ const docTemplate = `{
                     }
                 },
                 "mirror_publisher_id": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                    "$ref": "#/definitions/null.String"
+                },
+                "mirror_publisher_name": {
+                    "$ref": "#/definitions/null.String"
                 },
                 "publisher_id": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                    "type": "string"
+                },
+                "publisher_name": {
+                    "type": "string"
+                },
+                "score": {
+                    "type": "integer"
+                },
+                "seat_owner_name": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "total": {
+                    "description": "total amount of lines",
+                    "type": "integer"
                 }
             }
         },
-        "core.AdsTxtGetGroupByDPOptions": {
+        "dto.AdsTxtFileRequest": {
             "type": "object",
+            "required": [
+                "domain"
+            ],
             "properties": {
-                "filter": {
-                    "$ref": "#/definitions/core.AdsTxtGetGroupByDPFilter"
-                },
-                "order": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/order.Field"
-                    }
+                "domain": {
+                    "type": "string"
                 },
-                "pagination": {
-                    "$ref": "#/definitions/pagination.Pagination"
+                "file_name": {
+                    "type": "string"
                 },
-                "selector": {
+                "publisher_id": {
                     "type": "string"
                 }
             }
         },
-        "core.AdsTxtGetMainFilter": {
+        "dto.AdsTxtGroupByDPResponse": {
             "type": "object",
             "properties": {
-                "account_manager_full_name": {
+                "data": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/dto.AdsTxtGroupedByDP"
                     }
                 },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.AdsTxtGroupedByDP": {
+            "type": "object",
+            "properties": {
+                "account_manager_full_name": {
+                    "$ref": "#/definitions/null.String"
+                },
+                "account_manager_id": {
+                    "$ref": "#/definitions/null.String"
+                },
+                "action": {
+                    "type": "string"
+                },
+                "added": {
+                    "description": "count of added lines",
+                    "type": "integer"
+                },
+                "ads_txt_line": {
+                    "type": "string"
+                },
                 "campaign_manager_full_name": {
+                    "$ref": "#/definitions/null.String"
+                },
+                "campaign_manager_id": {
+                    "$ref": "#/definitions/null.String"
+                },
+                "cursor_id": {
+                    "type": "integer"
+                },
+                "demand_manager_full_name": {
+                    "$ref": "#/definitions/null.String"
+                },
+                "demand_manager_id": {
+                    "$ref": "#/definitions/null.String"
+                },
+                "demand_partner_connection_id": {
+                    "$ref": "#/definitions/null.Int"
+                },
+                "demand_partner_id": {
+                    "type": "string"
+                },
+                "demand_partner_name": {
+                    "type": "string"
+                },
+                "demand_partner_name_extended": {
+                    "description": "like Amazon - Amazon or OMS - Direct",
+                    "type": "string"
+                },
+                "demand_status": {
+                    "type": "string"
+                },
+                "domain": {
+                    "type": "string"
+                },
+                "domain_status": {
+                    "type": "string"
+                },
+                "dp_enabled": {
+                    "description": "dp is ready to go live",
+                    "type": "boolean"
+                },
+                "error_message": {
+                    "$ref": "#/definitions/null.String"
+                },
+                "grouped_lines": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/dto.AdsTxt"
                     }
                 },
-                "demand_manager_full_name": {
+                "id": {
+                    "type": "integer"
+                },
+                "is_demand_partner_active": {
+                    "type": "boolean"
+                },
+                "is_required": {
+                    "type": "boolean"
+                },
+                "last_scanned_at": {
+                    "type": "string"
+                },
+                "media_type": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
-                "demand_partner_name_extended": {
+                "mirror_publisher_id": {
+                    "$ref": "#/definitions/null.String"
+                },
+                "mirror_publisher_name": {
+                    "$ref": "#/definitions/null.String"
+                },
+                "publisher_id": {
+                    "type": "string"
+                },
+                "publisher_name": {
+                    "type": "string"
+                },
+                "score": {
+                    "type": "integer"
+                },
+                "seat_owner_name": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "total": {
+                    "description": "total amount of lines",
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.AdsTxtResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/dto.AdsTxt"
                     }
                 },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.AdsTxtUpdateRequest": {
+            "type": "object",
+            "required": [
+                "domain"
+            ],
+            "properties": {
+                "demand_partner_id": {
+                    "type": "string"
+                },
                 "demand_status": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                    "type": "string"
                 },
                 "domain": {
                     "type": "array",
+                    "minItems": 1,
                     "items": {
                         "type": "string"
                     }
                 },
                 "domain_status": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                    "type": "string"
+                }
+            }
+        },
+        "dto.AlertEvent": {
+            "type": "object",
+            "properties": {
+                "acknowledged_at": {
+                    "type": "string"
                 },
-                "is_required": {
-                    "type": "boolean"
+                "acknowledged_by": {
+                    "type": "integer"
                 },
-                "media_type": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "baseline": {
+                    "type": "number"
                 },
-                "mirror_publisher_id": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "first_seen_at": {
+                    "type": "string"
                 },
-                "publisher_id": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "id": {
+                    "type": "integer"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "last_seen_at": {
+                    "type": "string"
+                },
+                "notified_at": {
+                    "type": "string"
+                },
+                "resolved_at": {
+                    "type": "string"
+                },
+                "rule_id": {
+                    "type": "integer"
+                },
+                "severity": {
+                    "type": "string"
+                },
+                "snoozed_until": {
+                    "type": "string"
                 },
                 "status": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.AlertEventAcknowledgeRequest": {
+            "type": "object",
+            "required": [
+                "ids"
+            ],
+            "properties": {
+                "ids": {
                     "type": "array",
+                    "minItems": 1,
                     "items": {
-                        "type": "string"
+                        "type": "integer"
                     }
                 }
             }
         },
-        "core.AdsTxtGetMainOptions": {
+        "dto.AlertEventSnoozeRequest": {
             "type": "object",
+            "required": [
+                "ids",
+                "until"
+            ],
             "properties": {
-                "filter": {
-                    "$ref": "#/definitions/core.AdsTxtGetMainFilter"
-                },
-                "order": {
+                "ids": {
                     "type": "array",
+                    "minItems": 1,
                     "items": {
-                        "$ref": "#/definitions/order.Field"
+                        "type": "integer"
                     }
                 },
-                "pagination": {
-                    "$ref": "#/definitions/pagination.Pagination"
-                },
-                "selector": {
+                "until": {
                     "type": "string"
                 }
             }
         },
-        "core.BidCachingFilter": {
+        "dto.AlertRule": {
             "type": "object",
+            "required": [
+                "name",
+                "query"
+            ],
             "properties": {
                 "active": {
                     "type": "boolean"
                 },
-                "country": {
+                "baseline_offsets": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
-                "device": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "channel": {
+                    "type": "string"
                 },
-                "domain": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "comparison": {
+                    "type": "string"
                 },
-                "publisher": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                }
-            }
-        },
-        "core.Competitor": {
-            "type": "object",
-            "required": [
-                "name",
-                "position",
-                "type",
-                "url"
-            ],
-            "properties": {
-                "name": {
+                "created_at": {
                     "type": "string"
                 },
-                "position": {
+                "cron": {
                     "type": "string"
                 },
-                "type": {
+                "description": {
                     "type": "string"
                 },
-                "url": {
+                "id": {
+                    "type": "integer"
+                },
+                "last_evaluated_at": {
                     "type": "string"
-                }
-            }
-        },
-        "core.CompetitorFilter": {
-            "type": "object",
-            "properties": {
+                },
                 "name": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                    "type": "string"
                 },
-                "position": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "query": {
+                    "type": "string"
                 },
-                "type": {
+                "recipients": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
-                "url": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                }
-            }
-        },
-        "core.CompetitorUpdateRequest": {
-            "type": "object",
-            "required": [
-                "name",
-                "position",
-                "type",
-                "url"
-            ],
-            "properties": {
-                "name": {
+                "severity": {
                     "type": "string"
                 },
-                "position": {
+                "snoozed_until": {
                     "type": "string"
                 },
-                "type": {
+                "source": {
                     "type": "string"
                 },
-                "url": {
+                "threshold": {
+                    "type": "number"
+                },
+                "updated_at": {
                     "type": "string"
+                },
+                "window_minutes": {
+                    "type": "integer"
                 }
             }
         },
-        "core.ConfiantFilter": {
+        "dto.ApprovalPolicy": {
             "type": "object",
+            "required": [
+                "approver_roles",
+                "name"
+            ],
             "properties": {
-                "confiant_key": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "active": {
+                    "type": "boolean"
                 },
-                "domain": {
+                "approver_roles": {
                     "type": "array",
+                    "minItems": 1,
                     "items": {
                         "type": "string"
                     }
                 },
-                "publisher_id": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "min_change_percentage": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "name": {
+                    "type": "string"
+                },
+                "notify_emails": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
-                "rate": {
+                "notify_slack": {
+                    "type": "boolean"
+                },
+                "requester_roles": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
+                },
+                "subject": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
-        "core.ConfigurationFilter": {
+        "dto.AuditLog": {
             "type": "object",
             "properties": {
-                "key": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "api_key_id": {
+                    "type": "integer"
                 },
-                "value": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "ip": {
+                    "type": "string"
+                },
+                "latency_ms": {
+                    "type": "integer"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "path": {
+                    "type": "string"
+                },
+                "request_body": {
+                    "type": "object"
+                },
+                "request_hash": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "request_size": {
+                    "type": "integer"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "route": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "integer"
                 }
             }
         },
-        "core.ConfigurationPayload": {
+        "dto.AutomationLogAggregate": {
             "type": "object",
             "properties": {
-                "filter": {
-                    "$ref": "#/definitions/core.ConfigurationFilter"
+                "avg_new_factor": {
+                    "type": "number"
                 },
-                "order": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/order.Field"
-                    }
+                "avg_old_factor": {
+                    "type": "number"
                 },
-                "pagination": {
-                    "$ref": "#/definitions/pagination.Pagination"
+                "changes": {
+                    "type": "integer"
                 },
-                "selector": {
+                "day": {
+                    "type": "string"
+                },
+                "decreases": {
+                    "type": "integer"
+                },
+                "domain": {
+                    "type": "string"
+                },
+                "failures": {
+                    "type": "integer"
+                },
+                "increases": {
+                    "type": "integer"
+                },
+                "publisher": {
                     "type": "string"
+                },
+                "revenue": {
+                    "type": "number"
                 }
             }
         },
-        "core.ConfigurationRequest": {
+        "dto.BidCaching": {
             "type": "object",
             "required": [
-                "key",
-                "value"
+                "publisher"
             ],
             "properties": {
-                "description": {
+                "active": {
+                    "type": "boolean"
+                },
+                "bid_caching": {
+                    "type": "integer"
+                },
+                "browser": {
                     "type": "string"
                 },
-                "key": {
+                "control_percentage": {
+                    "type": "number"
+                },
+                "country": {
                     "type": "string"
                 },
-                "value": {
+                "created_at": {
+                    "type": "string"
+                },
+                "demand_partner_id": {
+                    "type": "string"
+                },
+                "device": {
                     "type": "string"
-                }
-            }
-        },
-        "core.DPApiFilter": {
-            "type": "object",
-            "properties": {
-                "demand_partner": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
                 },
                 "domain": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                }
-            }
-        },
-        "core.DPOFactorOptions": {
-            "type": "object",
-            "properties": {
-                "filter": {
-                    "$ref": "#/definitions/core.DPORuleFilter"
+                    "type": "string"
                 },
-                "order": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/order.Field"
-                    }
+                "os": {
+                    "type": "string"
                 },
-                "pagination": {
-                    "$ref": "#/definitions/pagination.Pagination"
+                "placement_type": {
+                    "type": "string"
                 },
-                "selector": {
+                "publisher": {
+                    "type": "string"
+                },
+                "rule_id": {
+                    "type": "string"
+                },
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "core.DPORuleFilter": {
+        "dto.BidCachingUpdateRequest": {
             "type": "object",
+            "required": [
+                "rule_id"
+            ],
             "properties": {
-                "active": {
-                    "type": "boolean"
+                "bid_caching": {
+                    "type": "integer"
                 },
-                "country": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "browser": {
+                    "type": "string"
                 },
-                "demand_partner_id": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "control_percentage": {
+                    "type": "number"
                 },
-                "device_type": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "country": {
+                    "type": "string"
+                },
+                "device": {
+                    "type": "string"
                 },
                 "domain": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                    "type": "string"
                 },
-                "factor": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "os": {
+                    "type": "string"
+                },
+                "placement_type": {
+                    "type": "string"
                 },
                 "publisher": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                    "type": "string"
                 },
                 "rule_id": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                    "type": "string"
                 }
             }
         },
-        "core.DemandPartnerGetFilter": {
+        "dto.BidLandscape": {
             "type": "object",
             "properties": {
-                "active": {
-                    "type": "boolean"
+                "bid_responses": {
+                    "type": "integer"
                 },
-                "automation": {
-                    "type": "boolean"
+                "demand_partners": {
+                    "type": "integer"
                 },
-                "demand_partner_id": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "median": {
+                    "type": "number"
                 },
-                "demand_partner_name": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "p25": {
+                    "type": "number"
+                },
+                "p75": {
+                    "type": "number"
+                },
+                "p90": {
+                    "type": "number"
                 }
             }
         },
-        "core.DemandPartnerGetOptions": {
+        "dto.Block": {
             "type": "object",
             "properties": {
-                "filter": {
-                    "$ref": "#/definitions/core.DemandPartnerGetFilter"
+                "created_at": {
+                    "type": "string"
                 },
-                "order": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/order.Field"
-                    }
+                "domain": {
+                    "type": "string"
                 },
-                "pagination": {
-                    "$ref": "#/definitions/pagination.Pagination"
+                "expires_at": {
+                    "type": "string"
                 },
-                "selector": {
+                "id": {
+                    "type": "integer"
+                },
+                "publisher": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "value": {
                     "type": "string"
                 }
             }
         },
-        "core.ExportTagsRequest": {
+        "dto.BlockGetRequest": {
             "type": "object",
             "properties": {
-                "add_gdpr": {
-                    "type": "boolean"
+                "domain": {
+                    "type": "string"
                 },
-                "ids": {
+                "publisher": {
+                    "type": "string"
+                },
+                "types": {
                     "type": "array",
                     "items": {
-                        "type": "integer"
+                        "type": "string"
                     }
                 }
             }
         },
-        "core.FactorFilter": {
+        "dto.BlockItem": {
             "type": "object",
+            "required": [
+                "value"
+            ],
             "properties": {
-                "active": {
-                    "type": "boolean"
+                "expires_at": {
+                    "type": "string"
                 },
-                "country": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "reason": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.BlockItemsRequest": {
+            "type": "object",
+            "required": [
+                "items",
+                "publisher"
+            ],
+            "properties": {
+                "domain": {
+                    "type": "string"
                 },
-                "device": {
+                "items": {
                     "type": "array",
+                    "minItems": 1,
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/dto.BlockItem"
                     }
                 },
+                "publisher": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.BlockList": {
+            "type": "object",
+            "properties": {
                 "domain": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
                 },
                 "publisher": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "value": {
                     "type": "array",
                     "items": {
                         "type": "string"
@@ -3629,1212 +9064,1081 @@ const docTemplate = `{
                 }
             }
         },
-        "core.FloorFilter": {
+        "dto.BlockUpdateRequest": {
             "type": "object",
+            "required": [
+                "publisher"
+            ],
             "properties": {
-                "active": {
-                    "type": "boolean"
-                },
-                "country": {
+                "badv": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
-                "device": {
+                "bcat": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
                 "domain": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                    "type": "string"
                 },
                 "publisher": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                    "type": "string"
                 }
             }
         },
-        "core.GetBidCachingOptions": {
+        "dto.BlockUploadEntry": {
             "type": "object",
             "properties": {
-                "filter": {
-                    "$ref": "#/definitions/core.BidCachingFilter"
+                "error": {
+                    "type": "string"
                 },
-                "order": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/order.Field"
-                    }
+                "line": {
+                    "type": "integer"
                 },
-                "pagination": {
-                    "$ref": "#/definitions/pagination.Pagination"
+                "type": {
+                    "type": "string"
                 },
-                "selector": {
+                "value": {
                     "type": "string"
                 }
             }
         },
-        "core.GetCompetitorOptions": {
+        "dto.BlockUploadReport": {
             "type": "object",
             "properties": {
-                "filter": {
-                    "$ref": "#/definitions/core.CompetitorFilter"
+                "committed": {
+                    "type": "boolean"
                 },
-                "order": {
+                "duplicates": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/order.Field"
+                        "$ref": "#/definitions/dto.BlockUploadEntry"
                     }
                 },
-                "pagination": {
-                    "$ref": "#/definitions/pagination.Pagination"
+                "invalid": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.BlockUploadEntry"
+                    }
                 },
-                "selector": {
-                    "type": "string"
+                "valid": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.BlockItem"
+                    }
                 }
             }
         },
-        "core.GetConfiantOptions": {
+        "dto.BooleanReplacement": {
             "type": "object",
             "properties": {
-                "filter": {
-                    "$ref": "#/definitions/core.ConfiantFilter"
-                },
-                "order": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/order.Field"
-                    }
-                },
-                "pagination": {
-                    "$ref": "#/definitions/pagination.Pagination"
+                "false": {
+                    "type": "string"
                 },
-                "selector": {
+                "true": {
                     "type": "string"
                 }
             }
         },
-        "core.GetDPApiOptions": {
+        "dto.ChangeRequest": {
             "type": "object",
             "properties": {
-                "date_stamp": {
+                "approver_id": {
+                    "type": "integer"
+                },
+                "change_percentage": {
+                    "type": "number"
+                },
+                "comment": {
                     "type": "string"
                 },
-                "demand_partner": {
+                "created_at": {
                     "type": "string"
                 },
-                "endDate": {
+                "decided_at": {
                     "type": "string"
                 },
-                "filter": {
-                    "$ref": "#/definitions/core.DPApiFilter"
+                "error_message": {
+                    "type": "string"
                 },
-                "order": {
+                "id": {
+                    "type": "integer"
+                },
+                "payload": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/order.Field"
+                        "type": "integer"
                     }
                 },
-                "pagination": {
-                    "$ref": "#/definitions/pagination.Pagination"
+                "policy_id": {
+                    "type": "integer"
                 },
-                "startDate": {
+                "requester_id": {
+                    "type": "integer"
+                },
+                "requester_role": {
                     "type": "string"
-                }
-            }
-        },
-        "core.GetFactorOptions": {
-            "type": "object",
-            "properties": {
-                "filter": {
-                    "$ref": "#/definitions/core.FactorFilter"
                 },
-                "order": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/order.Field"
-                    }
+                "route": {
+                    "type": "string"
                 },
-                "pagination": {
-                    "$ref": "#/definitions/pagination.Pagination"
+                "status": {
+                    "type": "string"
                 },
-                "selector": {
+                "subject": {
                     "type": "string"
                 }
             }
         },
-        "core.GetFloorOptions": {
+        "dto.ChangeRequestDecision": {
             "type": "object",
+            "required": [
+                "id"
+            ],
             "properties": {
-                "filter": {
-                    "$ref": "#/definitions/core.FloorFilter"
-                },
-                "order": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/order.Field"
-                    }
-                },
-                "pagination": {
-                    "$ref": "#/definitions/pagination.Pagination"
-                },
-                "selector": {
+                "comment": {
                     "type": "string"
+                },
+                "id": {
+                    "type": "integer"
                 }
             }
         },
-        "core.GetGlobalFactorOptions": {
+        "dto.Changes": {
             "type": "object",
             "properties": {
-                "filter": {
-                    "$ref": "#/definitions/core.GlobalFactorFilter"
-                },
-                "order": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/order.Field"
-                    }
-                },
-                "pagination": {
-                    "$ref": "#/definitions/pagination.Pagination"
+                "id": {
+                    "type": "string"
                 },
-                "selector": {
+                "new_value": {},
+                "old_value": {},
+                "property": {
                     "type": "string"
                 }
             }
         },
-        "core.GetPixalateOptions": {
+        "dto.CircuitBreaker": {
             "type": "object",
             "properties": {
-                "filter": {
-                    "$ref": "#/definitions/core.PixalateFilter"
+                "automation": {
+                    "type": "string"
                 },
-                "order": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/order.Field"
-                    }
+                "last_input_records": {
+                    "type": "integer"
                 },
-                "pagination": {
-                    "$ref": "#/definitions/pagination.Pagination"
+                "last_input_volume": {
+                    "type": "number"
                 },
-                "selector": {
+                "reason": {
                     "type": "string"
-                }
-            }
-        },
-        "core.GetPublisherDemandOptions": {
-            "type": "object",
-            "properties": {
-                "filter": {
-                    "$ref": "#/definitions/core.PublisherDemandFilter"
                 },
-                "order": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/order.Field"
-                    }
+                "reset_at": {
+                    "type": "string"
                 },
-                "pagination": {
-                    "$ref": "#/definitions/pagination.Pagination"
+                "reset_by": {
+                    "type": "integer"
                 },
-                "selector": {
+                "tripped": {
+                    "type": "boolean"
+                },
+                "tripped_at": {
+                    "type": "string"
+                },
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "core.GetPublisherDetailsOptions": {
+        "dto.CircuitBreakerResetRequest": {
             "type": "object",
             "properties": {
-                "filter": {
-                    "$ref": "#/definitions/core.PublisherDetailsFilter"
-                },
-                "order": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/order.Field"
-                    }
-                },
-                "pagination": {
-                    "$ref": "#/definitions/pagination.Pagination"
-                },
-                "selector": {
+                "automation": {
                     "type": "string"
                 }
             }
         },
-        "core.GetPublisherDomainOptions": {
+        "dto.Column": {
             "type": "object",
             "properties": {
-                "filter": {
-                    "$ref": "#/definitions/core.PublisherDomainFilter"
+                "boolean_replacement": {
+                    "$ref": "#/definitions/dto.BooleanReplacement"
                 },
-                "order": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/order.Field"
-                    }
+                "display_name": {
+                    "type": "string"
                 },
-                "pagination": {
-                    "$ref": "#/definitions/pagination.Pagination"
+                "multiply": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
                 },
-                "selector": {
+                "style": {
                     "type": "string"
                 }
             }
         },
-        "core.GetPublisherOptions": {
+        "dto.CompassManager": {
             "type": "object",
+            "required": [
+                "compass_id",
+                "user_id"
+            ],
             "properties": {
-                "filter": {
-                    "$ref": "#/definitions/core.PublisherFilter"
+                "compass_id": {
+                    "type": "string"
                 },
-                "order": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/order.Field"
-                    }
+                "created_at": {
+                    "type": "string"
                 },
-                "pagination": {
-                    "$ref": "#/definitions/pagination.Pagination"
+                "id": {
+                    "type": "integer"
                 },
-                "selector": {
+                "updated_at": {
                     "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
                 }
             }
         },
-        "core.GetRefreshCacheOptions": {
+        "dto.CompassManagerDeleteRequest": {
             "type": "object",
             "properties": {
-                "filter": {
-                    "$ref": "#/definitions/core.RefreshCacheFilter"
-                },
-                "order": {
+                "ids": {
                     "type": "array",
+                    "minItems": 1,
                     "items": {
-                        "$ref": "#/definitions/order.Field"
+                        "type": "integer"
                     }
-                },
-                "pagination": {
-                    "$ref": "#/definitions/pagination.Pagination"
-                },
-                "selector": {
-                    "type": "string"
                 }
             }
         },
-        "core.GlobalFactor": {
+        "dto.Confiant": {
             "type": "object",
             "properties": {
+                "confiant_key": {
+                    "type": "string"
+                },
                 "created_at": {
                     "type": "string"
                 },
-                "key": {
+                "domain": {
                     "type": "string"
                 },
                 "publisher_id": {
                     "type": "string"
                 },
+                "rate": {
+                    "type": "number"
+                },
                 "updated_at": {
                     "type": "string"
+                }
+            }
+        },
+        "dto.ConfiantUpdateRequest": {
+            "type": "object",
+            "required": [
+                "publisher_id"
+            ],
+            "properties": {
+                "confiant_key": {
+                    "type": "string"
                 },
-                "value": {
+                "domain": {
+                    "type": "string"
+                },
+                "publisher_id": {
+                    "type": "string"
+                },
+                "rate": {
                     "type": "number"
                 }
             }
         },
-        "core.GlobalFactorFilter": {
+        "dto.DPApprovalDecisionRequest": {
             "type": "object",
+            "required": [
+                "demand_partner_id"
+            ],
             "properties": {
-                "key": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "comment": {
+                    "type": "string"
                 },
-                "publisher_id": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "demand_partner_id": {
+                    "type": "string"
                 },
-                "value": {
+                "domains": {
                     "type": "array",
+                    "minItems": 1,
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/dto.DPApprovalDomain"
                     }
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "approved",
+                        "rejected"
+                    ]
                 }
             }
         },
-        "core.GlobalFactorRequest": {
+        "dto.DPApprovalDomain": {
             "type": "object",
+            "required": [
+                "domain",
+                "publisher_id"
+            ],
             "properties": {
-                "key": {
+                "domain": {
                     "type": "string"
                 },
                 "publisher_id": {
                     "type": "string"
-                },
-                "value": {
-                    "type": "number",
-                    "minimum": 0
                 }
             }
         },
-        "core.HistoryFilter": {
+        "dto.DPApprovalRequest": {
             "type": "object",
             "properties": {
-                "action": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "comment": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "decided_at": {
+                    "type": "string"
+                },
+                "decided_by": {
+                    "type": "integer"
                 },
                 "demand_partner_id": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                    "type": "string"
                 },
                 "domain": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                    "type": "string"
                 },
-                "entity_id": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "id": {
+                    "type": "integer"
                 },
-                "item": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "last_reminded_at": {
+                    "type": "string"
                 },
                 "publisher_id": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                    "type": "string"
                 },
-                "subject": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "reminders": {
+                    "type": "integer"
                 },
-                "user_id": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
+                "sent_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
-        "core.HistoryOptions": {
+        "dto.DPApprovalSendRequest": {
             "type": "object",
+            "required": [
+                "demand_partner_id"
+            ],
             "properties": {
-                "filter": {
-                    "$ref": "#/definitions/core.HistoryFilter"
+                "demand_partner_id": {
+                    "type": "string"
                 },
-                "order": {
+                "domains": {
                     "type": "array",
+                    "minItems": 1,
                     "items": {
-                        "$ref": "#/definitions/order.Field"
+                        "$ref": "#/definitions/dto.DPApprovalDomain"
                     }
-                },
-                "pagination": {
-                    "$ref": "#/definitions/pagination.Pagination"
-                },
-                "selector": {
-                    "type": "string"
                 }
             }
         },
-        "core.MetadataUpdateRequest": {
+        "dto.DPBidderParams": {
             "type": "object",
+            "required": [
+                "demand_partner_id",
+                "params",
+                "publisher_id"
+            ],
             "properties": {
-                "data": {},
-                "key": {
+                "created_at": {
                     "type": "string"
                 },
-                "version": {
+                "demand_partner_id": {
                     "type": "string"
-                }
-            }
-        },
-        "core.PixalateFilter": {
-            "type": "object",
-            "properties": {
-                "active": {
-                    "type": "boolean"
                 },
                 "domain": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                    "type": "string"
                 },
-                "pixalate_key": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "id": {
+                    "type": "integer"
+                },
+                "params": {
+                    "type": "object"
                 },
                 "publisher_id": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                    "type": "string"
                 },
-                "rate": {
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.DPBidderParamsDeleteRequest": {
+            "type": "object",
+            "properties": {
+                "ids": {
                     "type": "array",
+                    "minItems": 1,
                     "items": {
-                        "type": "string"
+                        "type": "integer"
                     }
                 }
             }
         },
-        "core.PublisherDemandFilter": {
+        "dto.DPBidderSchema": {
             "type": "object",
+            "required": [
+                "demand_partner_id",
+                "schema"
+            ],
             "properties": {
-                "active": {
-                    "type": "boolean"
+                "created_at": {
+                    "type": "string"
                 },
-                "ads_txt_status": {
-                    "type": "boolean"
+                "demand_partner_id": {
+                    "type": "string"
                 },
-                "demand": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "schema": {
+                    "type": "object"
                 },
-                "domain": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.DPOAutomationConfig": {
+            "type": "object",
+            "properties": {
+                "exploration_every_hours": {
+                    "description": "ExplorationEveryHours is the time after which held throttling is lowered to ExplorationFactor for one run, 0 disables exploration",
+                    "type": "integer"
+                },
+                "exploration_factor": {
+                    "type": "number"
                 },
-                "publisher_id": {
+                "hysteresis": {
+                    "description": "Hysteresis is the eRPM ratio above the level boundary required to relax throttling",
+                    "type": "number"
+                },
+                "levels": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/dto.DPOThrottleLevel"
                     }
+                },
+                "min_hold_hours": {
+                    "description": "MinHoldHours is the minimal time between automation changes of the same rule",
+                    "type": "integer"
                 }
             }
         },
-        "core.PublisherDemandResponse": {
+        "dto.DPORuleUpdateRequest": {
             "type": "object",
+            "required": [
+                "factor"
+            ],
             "properties": {
                 "active": {
                     "type": "boolean"
                 },
-                "ads_txt_status": {
-                    "type": "boolean"
+                "browser": {
+                    "type": "string"
                 },
-                "created_at": {
+                "country": {
                     "type": "string"
                 },
                 "demand_partner_id": {
                     "type": "string"
                 },
-                "demand_partner_name": {
+                "device_type": {
                     "type": "string"
                 },
                 "domain": {
                     "type": "string"
                 },
-                "publisher_id": {
-                    "type": "string"
+                "factor": {
+                    "type": "number",
+                    "minimum": 0
                 },
-                "updated_at": {
+                "os": {
                     "type": "string"
-                }
-            }
-        },
-        "core.PublisherDetailsFilter": {
-            "type": "object",
-            "properties": {
-                "account_manager": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
                 },
-                "automation": {
-                    "type": "boolean"
+                "placement_type": {
+                    "type": "string"
                 },
-                "domain": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "publisher": {
+                    "type": "string"
                 },
-                "publisher_id": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "rule_id": {
+                    "type": "string"
                 }
             }
         },
-        "core.PublisherDomainFilter": {
+        "dto.DPOThrottleLevel": {
             "type": "object",
             "properties": {
-                "automation": {
-                    "type": "boolean"
-                },
-                "domain": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "gpp_target": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "factor": {
+                    "type": "number"
                 },
-                "publisher_id": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "max_erpm_ratio": {
+                    "type": "number"
                 }
             }
         },
-        "core.PublisherFilter": {
+        "dto.DPScore": {
             "type": "object",
             "properties": {
-                "account_manager_id": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "calculated_at": {
+                    "type": "string"
                 },
-                "campaign_manager_id": {
+                "components": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/dto.DPScoreComponent"
                     }
                 },
-                "created_at": {
-                    "$ref": "#/definitions/filter.DatesFilter"
-                },
-                "media_buyer_id": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "country": {
+                    "type": "string"
                 },
-                "name": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "demand_partner_id": {
+                    "type": "string"
                 },
-                "office_location": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "device_type": {
+                    "type": "string"
                 },
-                "publisher_id": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "id": {
+                    "type": "integer"
                 },
-                "search": {
-                    "type": "string"
+                "score": {
+                    "type": "integer"
                 }
             }
         },
-        "core.RefreshCacheFilter": {
+        "dto.DPScoreComponent": {
             "type": "object",
             "properties": {
-                "active": {
-                    "type": "boolean"
+                "name": {
+                    "type": "string"
                 },
-                "country": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "points": {
+                    "type": "integer"
                 },
-                "device": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "score": {
+                    "type": "number"
                 },
-                "domain": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "value": {
+                    "type": "number"
                 },
-                "publisher": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "weight": {
+                    "type": "number"
                 }
             }
         },
-        "core.SeatOwnerGetFilter": {
+        "dto.DemandPartner": {
             "type": "object",
+            "required": [
+                "demand_partner_name",
+                "manager_id"
+            ],
             "properties": {
-                "certification_authority_id": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "id": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "active": {
+                    "type": "boolean"
                 },
-                "publisher_account": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "approval_before_going_live": {
+                    "type": "boolean"
                 },
-                "seat_owner_domain": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "approval_process": {
+                    "type": "string"
                 },
-                "seat_owner_name": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                }
-            }
-        },
-        "core.SeatOwnerGetOptions": {
-            "type": "object",
-            "properties": {
-                "filter": {
-                    "$ref": "#/definitions/core.SeatOwnerGetFilter"
+                "automation": {
+                    "type": "boolean"
                 },
-                "order": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/order.Field"
-                    }
+                "automation_config": {
+                    "$ref": "#/definitions/dto.DPOAutomationConfig"
                 },
-                "pagination": {
-                    "$ref": "#/definitions/pagination.Pagination"
+                "automation_name": {
+                    "type": "string"
                 },
-                "selector": {
+                "comments": {
                     "type": "string"
-                }
-            }
-        },
-        "core.TargetingFilter": {
-            "type": "object",
-            "properties": {
-                "browser": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
                 },
-                "country": {
+                "connections": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/dto.DemandPartnerConnection"
                     }
                 },
-                "device_type": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "created_at": {
+                    "type": "string"
                 },
-                "domain": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "demand_partner_id": {
+                    "type": "string"
                 },
-                "os": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "demand_partner_name": {
+                    "type": "string"
                 },
-                "placement_type": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "dp_blocks": {
+                    "type": "string"
                 },
-                "price_model": {
+                "integration_type": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
-                "publisher_id": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "is_approval_needed": {
+                    "type": "boolean"
                 },
-                "status": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "is_include": {
+                    "type": "boolean"
                 },
-                "unit_size": {
+                "manager_full_name": {
+                    "type": "string"
+                },
+                "manager_id": {
+                    "type": "integer"
+                },
+                "media_type_list": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
+                },
+                "poc_email": {
+                    "type": "string"
+                },
+                "poc_name": {
+                    "type": "string"
+                },
+                "score": {
+                    "type": "integer"
+                },
+                "seat_owner_id": {
+                    "type": "integer"
+                },
+                "seat_owner_name": {
+                    "type": "string"
+                },
+                "threshold": {
+                    "type": "number"
+                },
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
-        "core.TargetingOptions": {
+        "dto.DemandPartnerChild": {
             "type": "object",
+            "required": [
+                "dp_child_name",
+                "dp_domain",
+                "publisher_account"
+            ],
             "properties": {
-                "filter": {
-                    "$ref": "#/definitions/core.TargetingFilter"
+                "ads_txt_line": {
+                    "type": "string"
                 },
-                "order": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/order.Field"
-                    }
+                "certification_authority_id": {
+                    "type": "string"
                 },
-                "pagination": {
-                    "$ref": "#/definitions/pagination.Pagination"
+                "created_at": {
+                    "type": "string"
                 },
-                "selector": {
+                "dp_child_name": {
+                    "type": "string"
+                },
+                "dp_connection_id": {
+                    "type": "integer"
+                },
+                "dp_domain": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_direct": {
+                    "type": "boolean"
+                },
+                "is_required_for_ads_txt": {
+                    "type": "boolean"
+                },
+                "line_name": {
+                    "type": "string"
+                },
+                "publisher_account": {
+                    "type": "string"
+                },
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "core.UserFilter": {
+        "dto.DemandPartnerConnection": {
             "type": "object",
+            "required": [
+                "dp_domain",
+                "publisher_account"
+            ],
             "properties": {
-                "address": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "ads_txt_line": {
+                    "type": "string"
                 },
-                "email": {
+                "certification_authority_id": {
+                    "type": "string"
+                },
+                "children": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/dto.DemandPartnerChild"
                     }
                 },
-                "enabled": {
-                    "type": "boolean"
+                "created_at": {
+                    "type": "string"
                 },
-                "first_name": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "demand_partner_id": {
+                    "type": "string"
                 },
-                "last_name": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "dp_domain": {
+                    "type": "string"
                 },
-                "organization_name": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "id": {
+                    "type": "integer"
                 },
-                "phone": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "is_direct": {
+                    "type": "boolean"
                 },
-                "role": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "is_required_for_ads_txt": {
+                    "type": "boolean"
                 },
-                "types": {
+                "line_name": {
+                    "type": "string"
+                },
+                "media_type": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
+                },
+                "publisher_account": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
-        "core.UserOptions": {
+        "dto.DomainOnboarding": {
             "type": "object",
             "properties": {
-                "filter": {
-                    "$ref": "#/definitions/core.UserFilter"
+                "attempts": {
+                    "type": "integer"
                 },
-                "order": {
+                "checks": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/order.Field"
+                        "$ref": "#/definitions/dto.DomainOnboardingCheck"
                     }
                 },
-                "pagination": {
-                    "$ref": "#/definitions/pagination.Pagination"
+                "created_at": {
+                    "type": "string"
                 },
-                "selector": {
+                "last_checked_at": {
+                    "type": "string"
+                },
+                "status": {
                     "type": "string"
                 }
             }
         },
-        "dto.AdjustRequest": {
+        "dto.DomainOnboardingCheck": {
             "type": "object",
-            "required": [
-                "domain",
-                "value"
-            ],
             "properties": {
-                "domain": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "checked_at": {
+                    "type": "string"
                 },
-                "value": {
-                    "type": "number"
+                "message": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
                 }
             }
         },
-        "dto.AdsTxt": {
+        "dto.DownloadRequest": {
             "type": "object",
             "properties": {
-                "account_manager_full_name": {
-                    "$ref": "#/definitions/null.String"
+                "columns": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.Column"
+                    }
                 },
-                "account_manager_id": {
-                    "$ref": "#/definitions/null.String"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        }
+                    }
                 },
-                "action": {
+                "file_format": {
                     "type": "string"
                 },
-                "added": {
-                    "description": "count of added lines",
-                    "type": "integer"
-                },
-                "ads_txt_line": {
+                "filename_prefix": {
                     "type": "string"
                 },
-                "campaign_manager_full_name": {
-                    "$ref": "#/definitions/null.String"
-                },
-                "campaign_manager_id": {
-                    "$ref": "#/definitions/null.String"
-                },
-                "cursor_id": {
+                "request": {
+                    "$ref": "#/definitions/dto.Request"
+                }
+            }
+        },
+        "dto.DpiApi": {
+            "type": "object",
+            "properties": {
+                "counter": {
                     "type": "integer"
                 },
-                "demand_manager_full_name": {
-                    "$ref": "#/definitions/null.String"
-                },
-                "demand_manager_id": {
-                    "$ref": "#/definitions/null.String"
-                },
-                "demand_partner_connection_id": {
-                    "$ref": "#/definitions/null.Int"
-                },
-                "demand_partner_id": {
-                    "type": "string"
-                },
-                "demand_partner_name": {
-                    "type": "string"
-                },
-                "demand_partner_name_extended": {
-                    "description": "like Amazon - Amazon or OMS - Direct",
+                "date_stamp": {
                     "type": "string"
                 },
-                "demand_status": {
+                "demand_partner": {
                     "type": "string"
                 },
                 "domain": {
                     "type": "string"
                 },
-                "domain_status": {
+                "last_full_day": {
                     "type": "string"
                 },
-                "dp_enabled": {
-                    "description": "dp is ready to go live",
-                    "type": "boolean"
+                "last_updated": {
+                    "type": "string"
                 },
-                "error_message": {
-                    "$ref": "#/definitions/null.String"
+                "revenue": {
+                    "type": "number"
                 },
-                "id": {
+                "sold_imps": {
                     "type": "integer"
                 },
-                "is_demand_partner_active": {
-                    "type": "boolean"
-                },
-                "is_required": {
-                    "type": "boolean"
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.DpoLog": {
+            "type": "object",
+            "properties": {
+                "bid_request": {
+                    "type": "integer"
                 },
-                "last_scanned_at": {
+                "country": {
                     "type": "string"
                 },
-                "media_type": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "domain": {
+                    "type": "string"
                 },
-                "mirror_publisher_id": {
-                    "$ref": "#/definitions/null.String"
+                "dp": {
+                    "type": "string"
                 },
-                "mirror_publisher_name": {
-                    "$ref": "#/definitions/null.String"
+                "erpm": {
+                    "type": "number"
                 },
-                "publisher_id": {
+                "eval_time": {
                     "type": "string"
                 },
-                "publisher_name": {
-                    "type": "string"
+                "new_factor": {
+                    "type": "number"
                 },
-                "score": {
-                    "type": "integer"
+                "old_factor": {
+                    "type": "number"
                 },
-                "seat_owner_name": {
+                "os": {
                     "type": "string"
                 },
-                "status": {
+                "publisher": {
                     "type": "string"
                 },
-                "total": {
-                    "description": "total amount of lines",
-                    "type": "integer"
-                }
-            }
-        },
-        "dto.AdsTxtGroupByDPResponse": {
-            "type": "object",
-            "properties": {
-                "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.AdsTxtGroupedByDP"
-                    }
+                "reason": {
+                    "type": "string"
                 },
-                "total": {
+                "resp_status": {
                     "type": "integer"
+                },
+                "revenue": {
+                    "type": "number"
+                },
+                "time": {
+                    "type": "string"
                 }
             }
         },
-        "dto.AdsTxtGroupedByDP": {
+        "dto.Factor": {
             "type": "object",
             "properties": {
-                "account_manager_full_name": {
-                    "$ref": "#/definitions/null.String"
-                },
-                "account_manager_id": {
-                    "$ref": "#/definitions/null.String"
+                "active": {
+                    "type": "boolean"
                 },
-                "action": {
+                "browser": {
                     "type": "string"
                 },
-                "added": {
-                    "description": "count of added lines",
-                    "type": "integer"
-                },
-                "ads_txt_line": {
+                "country": {
                     "type": "string"
                 },
-                "campaign_manager_full_name": {
-                    "$ref": "#/definitions/null.String"
+                "device": {
+                    "type": "string"
                 },
-                "campaign_manager_id": {
-                    "$ref": "#/definitions/null.String"
+                "domain": {
+                    "type": "string"
                 },
-                "cursor_id": {
-                    "type": "integer"
+                "factor": {
+                    "type": "number"
                 },
-                "demand_manager_full_name": {
-                    "$ref": "#/definitions/null.String"
+                "os": {
+                    "type": "string"
                 },
-                "demand_manager_id": {
-                    "$ref": "#/definitions/null.String"
+                "placement_type": {
+                    "type": "string"
                 },
-                "demand_partner_connection_id": {
-                    "$ref": "#/definitions/null.Int"
+                "publisher": {
+                    "type": "string"
                 },
-                "demand_partner_id": {
+                "publisher_name": {
                     "type": "string"
                 },
-                "demand_partner_name": {
+                "rule_id": {
                     "type": "string"
+                }
+            }
+        },
+        "dto.FactorLog": {
+            "type": "object",
+            "properties": {
+                "cost": {
+                    "type": "number"
                 },
-                "demand_partner_name_extended": {
-                    "description": "like Amazon - Amazon or OMS - Direct",
+                "country": {
                     "type": "string"
                 },
-                "demand_status": {
+                "device": {
                     "type": "string"
                 },
                 "domain": {
                     "type": "string"
                 },
-                "domain_status": {
+                "eval_time": {
                     "type": "string"
                 },
-                "dp_enabled": {
-                    "description": "dp is ready to go live",
-                    "type": "boolean"
-                },
-                "error_message": {
-                    "$ref": "#/definitions/null.String"
+                "gp": {
+                    "type": "number"
                 },
-                "grouped_lines": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.AdsTxt"
-                    }
+                "gpp": {
+                    "type": "number"
                 },
-                "id": {
-                    "type": "integer"
+                "new_factor": {
+                    "type": "number"
                 },
-                "is_demand_partner_active": {
-                    "type": "boolean"
+                "old_factor": {
+                    "type": "number"
                 },
-                "is_required": {
-                    "type": "boolean"
+                "pubimps": {
+                    "type": "integer"
                 },
-                "last_scanned_at": {
+                "publisher": {
                     "type": "string"
                 },
-                "media_type": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "mirror_publisher_id": {
-                    "$ref": "#/definitions/null.String"
-                },
-                "mirror_publisher_name": {
-                    "$ref": "#/definitions/null.String"
-                },
-                "publisher_id": {
-                    "type": "string"
+                "response_status": {
+                    "type": "integer"
                 },
-                "publisher_name": {
-                    "type": "string"
+                "revenue": {
+                    "type": "number"
                 },
-                "score": {
+                "soldimps": {
                     "type": "integer"
                 },
-                "seat_owner_name": {
+                "source": {
                     "type": "string"
                 },
-                "status": {
+                "time": {
                     "type": "string"
-                },
-                "total": {
-                    "description": "total amount of lines",
-                    "type": "integer"
                 }
             }
         },
-        "dto.AdsTxtResponse": {
+        "dto.FactorUpdateRequest": {
             "type": "object",
             "properties": {
-                "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.AdsTxt"
-                    }
+                "browser": {
+                    "type": "string"
                 },
-                "total": {
-                    "type": "integer"
-                }
-            }
-        },
-        "dto.AdsTxtUpdateRequest": {
-            "type": "object",
-            "required": [
-                "domain"
-            ],
-            "properties": {
-                "demand_partner_id": {
+                "country": {
                     "type": "string"
                 },
-                "demand_status": {
+                "device": {
                     "type": "string"
                 },
                 "domain": {
-                    "type": "array",
-                    "minItems": 1,
-                    "items": {
-                        "type": "string"
-                    }
+                    "type": "string"
                 },
-                "domain_status": {
+                "factor": {
+                    "type": "number"
+                },
+                "os": {
+                    "type": "string"
+                },
+                "placement_type": {
+                    "type": "string"
+                },
+                "publisher": {
                     "type": "string"
                 }
             }
         },
-        "dto.BidCaching": {
+        "dto.Floor": {
             "type": "object",
-            "required": [
-                "publisher"
-            ],
             "properties": {
                 "active": {
                     "type": "boolean"
                 },
-                "bid_caching": {
-                    "type": "integer"
-                },
                 "browser": {
                     "type": "string"
                 },
-                "control_percentage": {
-                    "type": "number"
-                },
                 "country": {
                     "type": "string"
                 },
-                "created_at": {
-                    "type": "string"
-                },
-                "demand_partner_id": {
-                    "type": "string"
-                },
                 "device": {
                     "type": "string"
                 },
                 "domain": {
                     "type": "string"
                 },
+                "floor": {
+                    "type": "number"
+                },
                 "os": {
                     "type": "string"
                 },
@@ -4844,29 +10148,23 @@ const docTemplate = `{
                 "publisher": {
                     "type": "string"
                 },
-                "rule_id": {
+                "publisher_name": {
                     "type": "string"
                 },
-                "updated_at": {
+                "rule_id": {
                     "type": "string"
                 }
             }
         },
-        "dto.BidCachingUpdateRequest": {
-            "type": "object",
-            "required": [
-                "rule_id"
-            ],
+        "dto.FloorUpdateRequest": {
+            "type": "object",
             "properties": {
-                "bid_caching": {
-                    "type": "integer"
+                "active": {
+                    "type": "boolean"
                 },
                 "browser": {
                     "type": "string"
                 },
-                "control_percentage": {
-                    "type": "number"
-                },
                 "country": {
                     "type": "string"
                 },
@@ -4876,6 +10174,9 @@ const docTemplate = `{
                 "domain": {
                     "type": "string"
                 },
+                "floor": {
+                    "type": "number"
+                },
                 "os": {
                     "type": "string"
                 },
@@ -4890,98 +10191,66 @@ const docTemplate = `{
                 }
             }
         },
-        "dto.BlockGetRequest": {
+        "dto.History": {
             "type": "object",
             "properties": {
-                "domain": {
+                "action": {
                     "type": "string"
                 },
-                "publisher": {
+                "api_key_name": {
                     "type": "string"
                 },
-                "types": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                }
-            }
-        },
-        "dto.BlockUpdateRequest": {
-            "type": "object",
-            "required": [
-                "publisher"
-            ],
-            "properties": {
-                "badv": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "approver_full_name": {
+                    "type": "string"
                 },
-                "bcat": {
+                "children": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/dto.Changes"
                     }
                 },
-                "domain": {
+                "date": {
                     "type": "string"
                 },
-                "publisher": {
-                    "type": "string"
-                }
-            }
-        },
-        "dto.BooleanReplacement": {
-            "type": "object",
-            "properties": {
-                "false": {
+                "demand_partner_name": {
                     "type": "string"
                 },
-                "true": {
-                    "type": "string"
-                }
-            }
-        },
-        "dto.Changes": {
-            "type": "object",
-            "properties": {
                 "id": {
+                    "type": "integer"
+                },
+                "item": {
                     "type": "string"
                 },
-                "new_value": {},
-                "old_value": {},
-                "property": {
+                "subject": {
+                    "type": "string"
+                },
+                "user_full_name": {
                     "type": "string"
                 }
             }
         },
-        "dto.Column": {
+        "dto.Ips": {
             "type": "object",
             "properties": {
-                "boolean_replacement": {
-                    "$ref": "#/definitions/dto.BooleanReplacement"
-                },
-                "display_name": {
+                "date": {
                     "type": "string"
                 },
-                "multiply": {
-                    "type": "number"
-                },
-                "name": {
+                "expires_at": {
                     "type": "string"
                 },
-                "style": {
+                "ip": {
                     "type": "string"
+                },
+                "price": {
+                    "type": "number"
                 }
             }
         },
-        "dto.Confiant": {
+        "dto.Pixalate": {
             "type": "object",
             "properties": {
-                "confiant_key": {
-                    "type": "string"
+                "active": {
+                    "type": "boolean"
                 },
                 "created_at": {
                     "type": "string"
@@ -4989,6 +10258,9 @@ const docTemplate = `{
                 "domain": {
                     "type": "string"
                 },
+                "pixalate_key": {
+                    "type": "string"
+                },
                 "publisher_id": {
                     "type": "string"
                 },
@@ -5000,14 +10272,14 @@ const docTemplate = `{
                 }
             }
         },
-        "dto.ConfiantUpdateRequest": {
+        "dto.PixalateUpdateRequest": {
             "type": "object",
             "required": [
                 "publisher_id"
             ],
             "properties": {
-                "confiant_key": {
-                    "type": "string"
+                "active": {
+                    "type": "boolean"
                 },
                 "domain": {
                     "type": "string"
@@ -5020,577 +10292,560 @@ const docTemplate = `{
                 }
             }
         },
-        "dto.DPORuleUpdateRequest": {
+        "dto.PortalAdsTxtStatus": {
             "type": "object",
-            "required": [
-                "factor"
-            ],
             "properties": {
-                "active": {
-                    "type": "boolean"
-                },
-                "browser": {
-                    "type": "string"
-                },
-                "country": {
-                    "type": "string"
-                },
-                "demand_partner_id": {
+                "error_message": {
                     "type": "string"
                 },
-                "device_type": {
+                "file_name": {
                     "type": "string"
                 },
-                "domain": {
-                    "type": "string"
+                "found": {
+                    "type": "integer"
                 },
-                "factor": {
-                    "type": "number",
-                    "minimum": 0
+                "mismatch": {
+                    "type": "integer"
                 },
-                "os": {
-                    "type": "string"
+                "missing": {
+                    "type": "integer"
                 },
-                "placement_type": {
-                    "type": "string"
+                "records": {
+                    "type": "integer"
                 },
-                "publisher": {
+                "scanned_at": {
                     "type": "string"
                 },
-                "rule_id": {
+                "url": {
                     "type": "string"
                 }
             }
         },
-        "dto.DemandPartner": {
+        "dto.PortalDomain": {
             "type": "object",
-            "required": [
-                "demand_partner_name",
-                "manager_id"
-            ],
             "properties": {
-                "active": {
-                    "type": "boolean"
-                },
-                "approval_before_going_live": {
-                    "type": "boolean"
-                },
-                "approval_process": {
-                    "type": "string"
-                },
-                "automation": {
-                    "type": "boolean"
-                },
-                "automation_name": {
-                    "type": "string"
-                },
-                "comments": {
-                    "type": "string"
-                },
-                "connections": {
+                "ads_txt": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.DemandPartnerConnection"
+                        "$ref": "#/definitions/dto.PortalAdsTxtStatus"
                     }
                 },
-                "created_at": {
-                    "type": "string"
-                },
-                "demand_partner_id": {
-                    "type": "string"
-                },
-                "demand_partner_name": {
-                    "type": "string"
-                },
-                "dp_blocks": {
+                "domain": {
                     "type": "string"
                 },
-                "integration_type": {
+                "missing_lines": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
-                },
-                "is_approval_needed": {
-                    "type": "boolean"
-                },
-                "is_include": {
-                    "type": "boolean"
-                },
-                "manager_full_name": {
-                    "type": "string"
-                },
-                "manager_id": {
-                    "type": "integer"
-                },
-                "media_type_list": {
+                }
+            }
+        },
+        "dto.PortalReportRequest": {
+            "type": "object",
+            "required": [
+                "from",
+                "publisher_id",
+                "to"
+            ],
+            "properties": {
+                "domain": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
-                "poc_email": {
+                "from": {
                     "type": "string"
                 },
-                "poc_name": {
+                "granularity": {
                     "type": "string"
                 },
-                "score": {
-                    "type": "integer"
+                "publisher_id": {
+                    "type": "string"
                 },
-                "seat_owner_id": {
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.PortalReportRow": {
+            "type": "object",
+            "properties": {
+                "domain": {
+                    "type": "string"
+                },
+                "impressions": {
                     "type": "integer"
                 },
-                "seat_owner_name": {
-                    "type": "string"
+                "revenue": {
+                    "type": "number"
                 },
-                "threshold": {
+                "rpm": {
                     "type": "number"
                 },
-                "updated_at": {
+                "time": {
                     "type": "string"
                 }
             }
         },
-        "dto.DemandPartnerChild": {
+        "dto.PortalRequest": {
             "type": "object",
             "required": [
-                "dp_child_name",
-                "dp_domain",
-                "publisher_account"
+                "publisher_id"
             ],
             "properties": {
-                "ads_txt_line": {
-                    "type": "string"
-                },
-                "certification_authority_id": {
-                    "type": "string"
-                },
-                "created_at": {
-                    "type": "string"
-                },
-                "dp_child_name": {
-                    "type": "string"
-                },
-                "dp_connection_id": {
-                    "type": "integer"
-                },
-                "dp_domain": {
+                "publisher_id": {
                     "type": "string"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "is_direct": {
-                    "type": "boolean"
-                },
-                "is_required_for_ads_txt": {
-                    "type": "boolean"
-                },
-                "line_name": {
+                }
+            }
+        },
+        "dto.PortalStatementRequest": {
+            "type": "object",
+            "required": [
+                "from",
+                "publisher_id",
+                "to"
+            ],
+            "properties": {
+                "from": {
                     "type": "string"
                 },
-                "publisher_account": {
+                "publisher_id": {
                     "type": "string"
                 },
-                "updated_at": {
+                "to": {
                     "type": "string"
                 }
             }
         },
-        "dto.DemandPartnerConnection": {
+        "dto.PortalTagsRequest": {
             "type": "object",
             "required": [
-                "dp_domain",
-                "publisher_account"
+                "ids",
+                "publisher_id"
             ],
             "properties": {
-                "ads_txt_line": {
-                    "type": "string"
-                },
-                "certification_authority_id": {
-                    "type": "string"
+                "add_gdpr": {
+                    "type": "boolean"
                 },
-                "children": {
+                "ids": {
                     "type": "array",
+                    "minItems": 1,
                     "items": {
-                        "$ref": "#/definitions/dto.DemandPartnerChild"
+                        "type": "integer"
                     }
                 },
+                "publisher_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.PriceOverride": {
+            "type": "object",
+            "properties": {
                 "created_at": {
                     "type": "string"
                 },
-                "demand_partner_id": {
+                "created_by": {
+                    "type": "integer"
+                },
+                "domain": {
                     "type": "string"
                 },
-                "dp_domain": {
+                "expires_at": {
                     "type": "string"
                 },
                 "id": {
                     "type": "integer"
                 },
-                "is_direct": {
-                    "type": "boolean"
-                },
-                "is_required_for_ads_txt": {
-                    "type": "boolean"
-                },
-                "line_name": {
+                "ip": {
                     "type": "string"
                 },
-                "media_type": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "publisher_account": {
-                    "type": "string"
+                "price": {
+                    "type": "number"
                 },
                 "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "dto.DownloadRequest": {
+        "dto.PriceOverrideRequest": {
             "type": "object",
+            "required": [
+                "domain",
+                "ips"
+            ],
             "properties": {
-                "columns": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.Column"
-                    }
+                "domain": {
+                    "type": "string"
                 },
-                "data": {
+                "ips": {
                     "type": "array",
+                    "minItems": 1,
                     "items": {
-                        "type": "array",
-                        "items": {
-                            "type": "integer"
-                        }
+                        "$ref": "#/definitions/dto.Ips"
                     }
                 },
-                "file_format": {
-                    "type": "string"
-                },
-                "filename_prefix": {
-                    "type": "string"
-                },
-                "request": {
-                    "$ref": "#/definitions/dto.Request"
+                "ttl_hours": {
+                    "type": "integer",
+                    "maximum": 720,
+                    "minimum": 1
                 }
             }
         },
-        "dto.DpiApi": {
+        "dto.Publisher": {
             "type": "object",
             "properties": {
-                "counter": {
-                    "type": "integer"
-                },
-                "date_stamp": {
+                "account_manager_full_name": {
                     "type": "string"
                 },
-                "demand_partner": {
+                "account_manager_id": {
                     "type": "string"
                 },
-                "domain": {
+                "bid_caching": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.BidCaching"
+                    }
+                },
+                "campaign_manager_full_name": {
                     "type": "string"
                 },
-                "last_full_day": {
+                "campaign_manager_id": {
                     "type": "string"
                 },
-                "last_updated": {
+                "confiant": {
+                    "$ref": "#/definitions/dto.Confiant"
+                },
+                "created_at": {
                     "type": "string"
                 },
-                "revenue": {
-                    "type": "number"
+                "domains": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "sold_imps": {
-                    "type": "integer"
+                "integration_type": {
+                    "description": "validate:\"integrationType\"",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "updated_at": {
-                    "type": "string"
-                }
-            }
-        },
-        "dto.Factor": {
-            "type": "object",
-            "properties": {
-                "active": {
+                "is_confidential": {
                     "type": "boolean"
                 },
-                "browser": {
-                    "type": "string"
+                "is_direct": {
+                    "type": "boolean"
                 },
-                "country": {
-                    "type": "string"
+                "latest_timestamp": {
+                    "type": "integer"
                 },
-                "device": {
+                "media_buyer_full_name": {
                     "type": "string"
                 },
-                "domain": {
+                "media_buyer_id": {
                     "type": "string"
                 },
-                "factor": {
-                    "type": "number"
+                "media_type": {
+                    "description": "validate:\"mediaType\"",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "os": {
+                "name": {
                     "type": "string"
                 },
-                "placement_type": {
+                "office_location": {
                     "type": "string"
                 },
-                "publisher": {
-                    "type": "string"
+                "pause_timestamp": {
+                    "type": "integer"
                 },
-                "publisher_name": {
-                    "type": "string"
+                "pixalate": {
+                    "$ref": "#/definitions/dto.Pixalate"
                 },
-                "rule_id": {
-                    "type": "string"
-                }
-            }
-        },
-        "dto.FactorUpdateRequest": {
-            "type": "object",
-            "properties": {
-                "browser": {
+                "publisher_id": {
                     "type": "string"
                 },
-                "country": {
-                    "type": "string"
+                "reactivate_timestamp": {
+                    "type": "integer"
                 },
-                "device": {
-                    "type": "string"
+                "refresh_cache": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.RefreshCache"
+                    }
                 },
-                "domain": {
+                "seller_domain": {
                     "type": "string"
                 },
-                "factor": {
-                    "type": "number"
-                },
-                "os": {
+                "seller_type": {
                     "type": "string"
                 },
-                "placement_type": {
-                    "type": "string"
+                "start_timestamp": {
+                    "type": "integer"
                 },
-                "publisher": {
+                "status": {
                     "type": "string"
                 }
             }
         },
-        "dto.Floor": {
+        "dto.PublisherCompassSync": {
             "type": "object",
             "properties": {
-                "active": {
-                    "type": "boolean"
-                },
-                "browser": {
-                    "type": "string"
+                "diffs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PublisherFieldDiff"
+                    }
                 },
-                "country": {
+                "error_message": {
                     "type": "string"
                 },
-                "device": {
-                    "type": "string"
+                "latest_timestamp": {
+                    "type": "integer"
                 },
-                "domain": {
+                "local_changed_at": {
                     "type": "string"
                 },
-                "floor": {
-                    "type": "number"
-                },
-                "os": {
+                "publisher_id": {
                     "type": "string"
                 },
-                "placement_type": {
+                "pulled_at": {
                     "type": "string"
                 },
-                "publisher": {
+                "pushed_at": {
                     "type": "string"
                 },
-                "publisher_name": {
+                "status": {
                     "type": "string"
                 },
-                "rule_id": {
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "dto.FloorUpdateRequest": {
+        "dto.PublisherCreateValues": {
             "type": "object",
+            "required": [
+                "name"
+            ],
             "properties": {
-                "active": {
-                    "type": "boolean"
-                },
-                "browser": {
+                "account_manager_id": {
                     "type": "string"
                 },
-                "country": {
+                "campaign_manager_id": {
                     "type": "string"
                 },
-                "device": {
-                    "type": "string"
+                "integration_type": {
+                    "description": "validate:\"integrationType\"",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "domain": {
+                "is_confidential": {
+                    "type": "boolean"
+                },
+                "is_direct": {
+                    "type": "boolean"
+                },
+                "media_buyer_id": {
                     "type": "string"
                 },
-                "floor": {
-                    "type": "number"
+                "media_type": {
+                    "description": "validate:\"mediaType\"",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string"
                 },
-                "os": {
+                "office_location": {
                     "type": "string"
                 },
-                "placement_type": {
+                "seller_domain": {
                     "type": "string"
                 },
-                "publisher": {
+                "seller_type": {
                     "type": "string"
                 },
-                "rule_id": {
+                "status": {
                     "type": "string"
                 }
             }
         },
-        "dto.History": {
+        "dto.PublisherDetail": {
             "type": "object",
             "properties": {
-                "action": {
+                "account_manager_full_name": {
                     "type": "string"
                 },
-                "children": {
+                "account_manager_id": {
+                    "type": "string"
+                },
+                "activity_status": {
+                    "type": "string"
+                },
+                "automation": {
+                    "type": "boolean"
+                },
+                "bid_caching": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.Changes"
+                        "$ref": "#/definitions/dto.BidCaching"
                     }
                 },
-                "date": {
-                    "type": "string"
+                "confiant": {
+                    "$ref": "#/definitions/dto.Confiant"
                 },
-                "demand_partner_name": {
+                "domain": {
                     "type": "string"
                 },
-                "id": {
-                    "type": "integer"
+                "gpp_target": {
+                    "type": "number"
                 },
-                "item": {
+                "name": {
                     "type": "string"
                 },
-                "subject": {
-                    "type": "string"
+                "onboarding": {
+                    "$ref": "#/definitions/dto.DomainOnboarding"
                 },
-                "user_full_name": {
-                    "type": "string"
-                }
-            }
-        },
-        "dto.Ips": {
-            "type": "object",
-            "properties": {
-                "date": {
-                    "type": "string"
+                "pixalate": {
+                    "$ref": "#/definitions/dto.Pixalate"
                 },
-                "ip": {
+                "publisher_id": {
                     "type": "string"
                 },
-                "price": {
-                    "type": "number"
+                "refresh_cache": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.RefreshCache"
+                    }
                 }
             }
         },
-        "dto.Pixalate": {
+        "dto.PublisherDomain": {
             "type": "object",
             "properties": {
-                "active": {
+                "automation": {
                     "type": "boolean"
                 },
+                "automation_params": {
+                    "type": "object"
+                },
+                "automation_strategy": {
+                    "type": "string"
+                },
+                "bid_caching": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.BidCaching"
+                    }
+                },
+                "confiant": {
+                    "$ref": "#/definitions/dto.Confiant"
+                },
                 "created_at": {
                     "type": "string"
                 },
                 "domain": {
                     "type": "string"
                 },
-                "pixalate_key": {
+                "gpp_target": {
+                    "type": "number"
+                },
+                "integration_type": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "is_direct": {
+                    "type": "boolean"
+                },
+                "is_direct_publisher": {
+                    "type": "boolean"
+                },
+                "mirror_publisher_id": {
                     "type": "string"
                 },
+                "pixalate": {
+                    "$ref": "#/definitions/dto.Pixalate"
+                },
                 "publisher_id": {
                     "type": "string"
                 },
-                "rate": {
-                    "type": "number"
+                "publisher_name": {
+                    "type": "string"
+                },
+                "refresh_cache": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.RefreshCache"
+                    }
                 },
                 "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "dto.PixalateUpdateRequest": {
+        "dto.PublisherDomainData": {
             "type": "object",
-            "required": [
-                "publisher_id"
-            ],
             "properties": {
-                "active": {
+                "ads_txt_status": {
                     "type": "boolean"
                 },
                 "domain": {
                     "type": "string"
                 },
-                "publisher_id": {
+                "pubId": {
                     "type": "string"
-                },
-                "rate": {
-                    "type": "number"
                 }
             }
         },
-        "dto.PriceOverrideRequest": {
+        "dto.PublisherDomainRequest": {
             "type": "object",
             "properties": {
-                "domain": {
-                    "type": "string"
-                },
-                "ips": {
+                "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.Ips"
+                        "$ref": "#/definitions/dto.PublisherDomainData"
                     }
+                },
+                "demand_partner_id": {
+                    "type": "string"
                 }
             }
         },
-        "dto.Publisher": {
+        "dto.PublisherDomainUpdateRequest": {
             "type": "object",
+            "required": [
+                "domain",
+                "publisher_id"
+            ],
             "properties": {
-                "account_manager_full_name": {
-                    "type": "string"
-                },
-                "account_manager_id": {
-                    "type": "string"
-                },
-                "bid_caching": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.BidCaching"
-                    }
+                "automation": {
+                    "type": "boolean"
                 },
-                "campaign_manager_full_name": {
-                    "type": "string"
+                "automation_params": {
+                    "type": "object"
                 },
-                "campaign_manager_id": {
+                "automation_strategy": {
+                    "description": "AutomationStrategy is the factor automation strategy of the domain, default strategy is used when empty.\nCurrent strategy and params of the domain are kept when they are omitted.",
                     "type": "string"
                 },
-                "confiant": {
-                    "$ref": "#/definitions/dto.Confiant"
-                },
-                "created_at": {
+                "domain": {
                     "type": "string"
                 },
-                "domains": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "gpp_target": {
+                    "type": "number"
                 },
                 "integration_type": {
                     "description": "validate:\"integrationType\"",
@@ -5602,388 +10857,553 @@ const docTemplate = `{
                 "is_direct": {
                     "type": "boolean"
                 },
-                "latest_timestamp": {
-                    "type": "integer"
+                "mirror_publisher_id": {
+                    "type": "string"
                 },
-                "media_buyer_full_name": {
+                "publisher_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.PublisherFieldDiff": {
+            "type": "object",
+            "properties": {
+                "compass": {},
+                "field": {
                     "type": "string"
                 },
-                "media_buyer_id": {
+                "local": {}
+            }
+        },
+        "dto.PublisherLifecycleReportRequest": {
+            "type": "object",
+            "required": [
+                "from",
+                "to"
+            ],
+            "properties": {
+                "from": {
                     "type": "string"
                 },
-                "media_type": {
-                    "description": "validate:\"mediaType\"",
+                "publisher_ids": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
-                "name": {
+                "to": {
                     "type": "string"
+                }
+            }
+        },
+        "dto.PublisherNotificationPreference": {
+            "type": "object",
+            "required": [
+                "publisher_id"
+            ],
+            "properties": {
+                "ads_txt_alerts": {
+                    "type": "boolean"
                 },
-                "office_location": {
-                    "type": "string"
+                "daily_report": {
+                    "type": "boolean"
                 },
-                "pause_timestamp": {
-                    "type": "integer"
+                "emails": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "pixalate": {
-                    "$ref": "#/definitions/dto.Pixalate"
+                "monthly_statement": {
+                    "type": "boolean"
                 },
                 "publisher_id": {
                     "type": "string"
                 },
-                "reactivate_timestamp": {
-                    "type": "integer"
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.PublisherSyncResolveRequest": {
+            "type": "object",
+            "required": [
+                "publisher_id"
+            ],
+            "properties": {
+                "keep": {
+                    "type": "string",
+                    "enum": [
+                        "local",
+                        "compass"
+                    ]
                 },
-                "refresh_cache": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.RefreshCache"
+                "publisher_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.PublisherTimeInState": {
+            "type": "object",
+            "properties": {
+                "days": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "number"
                     }
                 },
-                "start_timestamp": {
-                    "type": "integer"
+                "publisher_id": {
+                    "type": "string"
                 },
                 "status": {
                     "type": "string"
+                },
+                "transitions": {
+                    "type": "integer"
                 }
             }
         },
-        "dto.PublisherCreateValues": {
+        "dto.Recommendation": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
-                "account_manager_id": {
-                    "type": "string"
+                "changed": {
+                    "type": "boolean"
                 },
-                "campaign_manager_id": {
+                "country": {
                     "type": "string"
                 },
-                "integration_type": {
-                    "description": "validate:\"integrationType\"",
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "current": {
+                    "$ref": "#/definitions/dto.RecommendationOutcome"
+                },
+                "current_factor": {
+                    "type": "number"
                 },
-                "is_direct": {
-                    "type": "boolean"
+                "current_floor": {
+                    "type": "number"
                 },
-                "media_buyer_id": {
+                "device": {
                     "type": "string"
                 },
-                "media_type": {
-                    "description": "validate:\"mediaType\"",
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "name": {
+                "domain": {
                     "type": "string"
                 },
-                "office_location": {
+                "expected": {
+                    "$ref": "#/definitions/dto.RecommendationOutcome"
+                },
+                "impact": {
+                    "$ref": "#/definitions/dto.RecommendationImpact"
+                },
+                "landscape": {
+                    "$ref": "#/definitions/dto.BidLandscape"
+                },
+                "publisher": {
                     "type": "string"
                 },
-                "status": {
+                "reason": {
                     "type": "string"
+                },
+                "recommended_factor": {
+                    "type": "number"
+                },
+                "recommended_floor": {
+                    "type": "number"
                 }
             }
         },
-        "dto.PublisherDetail": {
+        "dto.RecommendationApplyItem": {
             "type": "object",
+            "required": [
+                "domain",
+                "publisher"
+            ],
             "properties": {
-                "account_manager_full_name": {
+                "country": {
                     "type": "string"
                 },
-                "account_manager_id": {
+                "device": {
                     "type": "string"
                 },
-                "activity_status": {
+                "domain": {
                     "type": "string"
                 },
-                "automation": {
-                    "type": "boolean"
+                "factor": {
+                    "type": "number"
                 },
-                "bid_caching": {
+                "floor": {
+                    "type": "number"
+                },
+                "publisher": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.RecommendationApplyRequest": {
+            "type": "object",
+            "required": [
+                "items"
+            ],
+            "properties": {
+                "items": {
                     "type": "array",
+                    "minItems": 1,
                     "items": {
-                        "$ref": "#/definitions/dto.BidCaching"
+                        "$ref": "#/definitions/dto.RecommendationApplyItem"
                     }
+                }
+            }
+        },
+        "dto.RecommendationImpact": {
+            "type": "object",
+            "properties": {
+                "fill_rate": {
+                    "type": "number"
                 },
-                "confiant": {
-                    "$ref": "#/definitions/dto.Confiant"
+                "gp": {
+                    "type": "number"
                 },
-                "domain": {
-                    "type": "string"
+                "revenue": {
+                    "type": "number"
                 },
-                "gpp_target": {
+                "sold_impressions": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.RecommendationOutcome": {
+            "type": "object",
+            "properties": {
+                "cost": {
                     "type": "number"
                 },
-                "name": {
-                    "type": "string"
+                "fill_rate": {
+                    "type": "number"
                 },
-                "pixalate": {
-                    "$ref": "#/definitions/dto.Pixalate"
+                "gp": {
+                    "type": "number"
                 },
-                "publisher_id": {
-                    "type": "string"
+                "gpp": {
+                    "type": "number"
                 },
-                "refresh_cache": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.RefreshCache"
-                    }
+                "revenue": {
+                    "type": "number"
+                },
+                "sold_impressions": {
+                    "type": "number"
                 }
             }
         },
-        "dto.PublisherDomain": {
+        "dto.RefreshCache": {
             "type": "object",
             "properties": {
-                "automation": {
+                "active": {
                     "type": "boolean"
                 },
-                "bid_caching": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.BidCaching"
-                    }
+                "browser": {
+                    "type": "string"
                 },
-                "confiant": {
-                    "$ref": "#/definitions/dto.Confiant"
+                "country": {
+                    "type": "string"
                 },
                 "created_at": {
                     "type": "string"
                 },
-                "domain": {
+                "demand_partner_id": {
                     "type": "string"
                 },
-                "gpp_target": {
-                    "type": "number"
+                "device": {
+                    "type": "string"
                 },
-                "integration_type": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "domain": {
+                    "type": "string"
                 },
-                "is_direct": {
-                    "type": "boolean"
+                "os": {
+                    "type": "string"
                 },
-                "is_direct_publisher": {
-                    "type": "boolean"
+                "placement_type": {
+                    "type": "string"
                 },
-                "mirror_publisher_id": {
+                "publisher": {
                     "type": "string"
                 },
-                "pixalate": {
-                    "$ref": "#/definitions/dto.Pixalate"
+                "refresh_cache": {
+                    "type": "integer"
                 },
-                "publisher_id": {
+                "rule_id": {
                     "type": "string"
                 },
-                "publisher_name": {
+                "updated_at": {
                     "type": "string"
-                },
+                }
+            }
+        },
+        "dto.RefreshCacheUpdRequest": {
+            "type": "object",
+            "properties": {
                 "refresh_cache": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.RefreshCache"
-                    }
+                    "type": "integer"
                 },
-                "updated_at": {
+                "rule_id": {
                     "type": "string"
                 }
             }
         },
-        "dto.PublisherDomainData": {
+        "dto.RefreshCacheUpdateRequest": {
             "type": "object",
             "properties": {
-                "ads_txt_status": {
-                    "type": "boolean"
+                "browser": {
+                    "type": "string"
+                },
+                "country": {
+                    "type": "string"
+                },
+                "device": {
+                    "type": "string"
                 },
                 "domain": {
                     "type": "string"
                 },
-                "pubId": {
+                "os": {
+                    "type": "string"
+                },
+                "placement_type": {
+                    "type": "string"
+                },
+                "publisher": {
+                    "type": "string"
+                },
+                "refresh_cache": {
+                    "type": "integer"
+                },
+                "rule_id": {
                     "type": "string"
                 }
             }
         },
-        "dto.PublisherDomainRequest": {
+        "dto.Request": {
             "type": "object",
             "properties": {
-                "data": {
+                "body": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.PublisherDomainData"
+                        "type": "integer"
                     }
                 },
-                "demand_partner_id": {
+                "type": {
                     "type": "string"
                 }
             }
         },
-        "dto.PublisherDomainUpdateRequest": {
+        "dto.SchainCompliance": {
             "type": "object",
-            "required": [
-                "domain",
-                "publisher_id"
-            ],
             "properties": {
-                "automation": {
-                    "type": "boolean"
-                },
-                "domain": {
+                "ads_txt_domain": {
                     "type": "string"
                 },
-                "gpp_target": {
-                    "type": "number"
+                "checked_at": {
+                    "type": "string"
                 },
-                "integration_type": {
-                    "description": "validate:\"integrationType\"",
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "demand_partner_id": {
+                    "type": "string"
                 },
-                "is_direct": {
-                    "type": "boolean"
+                "dp_seller_type": {
+                    "type": "string"
                 },
-                "mirror_publisher_id": {
+                "dp_status": {
                     "type": "string"
                 },
-                "publisher_id": {
+                "error_message": {
                     "type": "string"
-                }
-            }
-        },
-        "dto.RefreshCache": {
-            "type": "object",
-            "properties": {
-                "active": {
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_compliant": {
                     "type": "boolean"
                 },
-                "browser": {
+                "our_status": {
                     "type": "string"
                 },
-                "country": {
+                "partner_name": {
                     "type": "string"
                 },
-                "created_at": {
+                "publisher_id": {
                     "type": "string"
                 },
-                "demand_partner_id": {
+                "relationship": {
                     "type": "string"
                 },
-                "device": {
-                    "type": "string"
+                "seat_owner_id": {
+                    "type": "integer"
                 },
-                "domain": {
+                "seller_id": {
                     "type": "string"
                 },
-                "os": {
+                "sellers_json_url": {
                     "type": "string"
-                },
-                "placement_type": {
+                }
+            }
+        },
+        "dto.SchainComplianceSummary": {
+            "type": "object",
+            "properties": {
+                "checked_at": {
                     "type": "string"
                 },
-                "publisher": {
+                "compliant": {
+                    "type": "integer"
+                },
+                "demand_partner_id": {
                     "type": "string"
                 },
-                "refresh_cache": {
+                "dp_missing": {
                     "type": "integer"
                 },
-                "rule_id": {
-                    "type": "string"
+                "dp_type_mismatch": {
+                    "type": "integer"
                 },
-                "updated_at": {
+                "dp_unavailable": {
+                    "type": "integer"
+                },
+                "our_missing": {
+                    "type": "integer"
+                },
+                "partner_name": {
                     "type": "string"
+                },
+                "publishers": {
+                    "type": "integer"
                 }
             }
         },
-        "dto.RefreshCacheUpdRequest": {
+        "dto.SearchRequest": {
             "type": "object",
             "properties": {
-                "refresh_cache": {
-                    "type": "integer"
+                "query": {
+                    "type": "string"
                 },
-                "rule_id": {
+                "section_type": {
                     "type": "string"
                 }
             }
         },
-        "dto.RefreshCacheUpdateRequest": {
+        "dto.SearchResult": {
             "type": "object",
             "properties": {
-                "browser": {
-                    "type": "string"
-                },
-                "country": {
-                    "type": "string"
-                },
-                "device": {
-                    "type": "string"
-                },
                 "domain": {
                     "type": "string"
                 },
-                "os": {
+                "publisher_id": {
                     "type": "string"
                 },
-                "placement_type": {
+                "publisher_name": {
                     "type": "string"
+                }
+            }
+        },
+        "dto.SellersJSONDiff": {
+            "type": "object",
+            "properties": {
+                "added": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "publisher": {
-                    "type": "string"
+                "changed": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.SellersJSONSellerChange"
+                    }
                 },
-                "refresh_cache": {
+                "previous_version": {
                     "type": "integer"
                 },
-                "rule_id": {
-                    "type": "string"
+                "removed": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "version": {
+                    "type": "integer"
                 }
             }
         },
-        "dto.Request": {
+        "dto.SellersJSONPublishResponse": {
             "type": "object",
             "properties": {
-                "body": {
+                "diff": {
+                    "$ref": "#/definitions/dto.SellersJSONDiff"
+                },
+                "is_published": {
+                    "description": "false when nothing changed since the latest version",
+                    "type": "boolean"
+                },
+                "skipped": {
                     "type": "array",
                     "items": {
-                        "type": "integer"
+                        "$ref": "#/definitions/dto.SellersJSONSkippedSeller"
                     }
                 },
-                "type": {
-                    "type": "string"
+                "version": {
+                    "$ref": "#/definitions/dto.SellersJSONVersion"
                 }
             }
         },
-        "dto.SearchRequest": {
+        "dto.SellersJSONSellerChange": {
             "type": "object",
             "properties": {
-                "query": {
+                "field": {
                     "type": "string"
                 },
-                "section_type": {
+                "new_value": {
+                    "type": "string"
+                },
+                "old_value": {
+                    "type": "string"
+                },
+                "seller_id": {
                     "type": "string"
                 }
             }
         },
-        "dto.SearchResult": {
+        "dto.SellersJSONSkippedSeller": {
             "type": "object",
             "properties": {
-                "domain": {
+                "publisher_id": {
                     "type": "string"
                 },
-                "publisher_id": {
+                "reason": {
                     "type": "string"
+                }
+            }
+        },
+        "dto.SellersJSONVersion": {
+            "type": "object",
+            "properties": {
+                "added": {
+                    "type": "integer"
                 },
-                "publisher_name": {
+                "changed": {
+                    "type": "integer"
+                },
+                "etag": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "published_at": {
                     "type": "string"
+                },
+                "published_by": {
+                    "type": "integer"
+                },
+                "removed": {
+                    "type": "integer"
+                },
+                "sellers": {
+                    "type": "integer"
                 }
             }
         },
@@ -6071,6 +11491,40 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.TaxonomyCategory": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "mapped": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.TaxonomyRequest": {
+            "type": "object",
+            "properties": {
+                "map_to": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "string"
+                }
+            }
+        },
         "dto.UpdatePublisherValues": {
             "type": "object",
             "properties": {
@@ -6087,6 +11541,9 @@ const docTemplate = `{
                         "type": "string"
                     }
                 },
+                "is_confidential": {
+                    "type": "boolean"
+                },
                 "is_direct": {
                     "type": "boolean"
                 },
@@ -6112,6 +11569,12 @@ const docTemplate = `{
                 "reactivate_timestamp": {
                     "type": "integer"
                 },
+                "seller_domain": {
+                    "type": "string"
+                },
+                "seller_type": {
+                    "type": "string"
+                },
                 "start_timestamp": {
                     "type": "integer"
                 },
@@ -6169,6 +11632,24 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.UserPublishers": {
+            "type": "object",
+            "required": [
+                "publisher_ids",
+                "user_id"
+            ],
+            "properties": {
+                "publisher_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
         "dto.UsersByTypes": {
             "type": "object",
             "properties": {
@@ -6214,6 +11695,23 @@ const docTemplate = `{
                 }
             }
         },
+        "monthly.Statement": {
+            "type": "object",
+            "properties": {
+                "impressions": {
+                    "type": "integer"
+                },
+                "month": {
+                    "type": "string"
+                },
+                "revenue": {
+                    "type": "number"
+                },
+                "rpm": {
+                    "type": "number"
+                }
+            }
+        },
         "null.Int": {
             "type": "object",
             "properties": {
@@ -6259,6 +11757,76 @@ const docTemplate = `{
                 }
             }
         },
+        "recommendation.GetRecommendationOptions": {
+            "type": "object",
+            "properties": {
+                "elasticity": {
+                    "description": "Elasticity is how sold impressions react to the factor change (sold impressions ~ factor^elasticity), 0.5 by default",
+                    "type": "number",
+                    "maximum": 3,
+                    "minimum": 0
+                },
+                "filter": {
+                    "$ref": "#/definitions/recommendation.RecommendationFilter"
+                },
+                "lookback_days": {
+                    "description": "LookbackDays is the period of historical performance, 7 days by default",
+                    "type": "integer",
+                    "maximum": 90,
+                    "minimum": 1
+                },
+                "max_fill_rate_drop": {
+                    "description": "MaxFillRateDrop is the largest relative fill rate decrease recommendation may lead to, 0.1 by default",
+                    "type": "number",
+                    "maximum": 1,
+                    "minimum": 0
+                },
+                "min_fill_rate": {
+                    "description": "MinFillRate is the lowest fill rate (sold impressions / bid requests) recommendation may lead to",
+                    "type": "number",
+                    "maximum": 1,
+                    "minimum": 0
+                },
+                "min_impressions": {
+                    "description": "MinImpressions is the sold impressions segment needs in the period to get a recommendation, 1000 by default",
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "only_changed": {
+                    "description": "OnlyChanged omits segments which current settings are already the best",
+                    "type": "boolean"
+                }
+            }
+        },
+        "recommendation.RecommendationFilter": {
+            "type": "object",
+            "properties": {
+                "country": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "device": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "domain": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "publisher": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "rest.BidCachingUpdateResponse": {
             "type": "object",
             "properties": {
@@ -6267,6 +11835,20 @@ const docTemplate = `{
                 }
             }
         },
+        "rest.ChangeRequestPendingResponse": {
+            "type": "object",
+            "properties": {
+                "change_request_id": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
         "rest.CompetitorUpdateResponse": {
             "type": "object",
             "properties": {
@@ -6413,6 +11995,63 @@ const docTemplate = `{
                 }
             }
         },
+        "sellersjson.File": {
+            "type": "object",
+            "properties": {
+                "contact_address": {
+                    "type": "string"
+                },
+                "contact_email": {
+                    "type": "string"
+                },
+                "identifiers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/sellersjson.Identifier"
+                    }
+                },
+                "sellers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/sellersjson.Seller"
+                    }
+                },
+                "version": {
+                    "type": "string"
+                }
+            }
+        },
+        "sellersjson.Identifier": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "sellersjson.Seller": {
+            "type": "object",
+            "properties": {
+                "domain": {
+                    "type": "string"
+                },
+                "is_confidential": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "seller_id": {
+                    "type": "string"
+                },
+                "seller_type": {
+                    "type": "string"
+                }
+            }
+        },
         "utils.BaseResponse": {
             "type": "object",
             "properties": {
@@ -14,8 +14,12 @@ import (
 )
 
 const (
-	downloadRequestTypeAdsTxtMainKey      = "ads_txt/main"
-	downloadRequestTypeAdsTxtGroupByDPKey = "ads_txt/group_by_dp"
+	downloadRequestTypeAdsTxtMainKey         = "ads_txt/main"
+	downloadRequestTypeAdsTxtGroupByDPKey    = "ads_txt/group_by_dp"
+	downloadRequestTypeFactorLogKey          = "automation/factor/log"
+	downloadRequestTypeFactorLogAggregateKey = "automation/factor/log/aggregate"
+	downloadRequestTypeDpoLogKey             = "automation/dpo/log"
+	downloadRequestTypeDpoLogAggregateKey    = "automation/dpo/log/aggregate"
 )
 
 // DownloadHandler Download body data as file according to format in request
@@ -27,13 +31,33 @@ const (
 // @Success 200 {object} utils.BaseResponse
 // @Router /download [post]
 func (o *OMSNewPlatform) DownloadHandler(c *fiber.Ctx) error {
+	return o.download(c, o.getDataForFile)
+}
+
+// AutomationDownloadHandler Download automation log as file according to format in request
+// @Description Download factor and DPO automation log or its aggregates as file according to format in request.
+// @Description Supported request types are "automation/factor/log", "automation/factor/log/aggregate", "automation/dpo/log" and "automation/dpo/log/aggregate"
+// @Tags Automation
+// @Accept json
+// @Produce json
+// @Param options body dto.DownloadRequest true "request"
+// @Success 200 {object} utils.BaseResponse
+// @Security ApiKeyAuth
+// @Router /automation/download [post]
+func (o *OMSNewPlatform) AutomationDownloadHandler(c *fiber.Ctx) error {
+	return o.download(c, o.getAutomationDataForFile)
+}
+
+type getDataForFileFunc func(ctx context.Context, requestType string, requestBody []byte) ([]json.RawMessage, error)
+
+func (o *OMSNewPlatform) download(c *fiber.Ctx, getData getDataForFileFunc) error {
 	var req *dto.DownloadRequest
 	if err := c.BodyParser(&req); err != nil {
 		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Error parsing download request", err)
 	}
 
 	if req.Request.Type != "" {
-		data, err := o.getDataForFile(c.Context(), req.Request.Type, req.Request.Body)
+		data, err := getData(c.Context(), req.Request.Type, req.Request.Body)
 		if err != nil {
 			return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Error getting data for file by request", err)
 		}
@@ -63,43 +87,91 @@ func (o *OMSNewPlatform) getDataForFile(ctx context.Context, requestType string,
 			return nil, err
 		}
 
-		result := make([]json.RawMessage, 0, len(data.Data))
-		for _, row := range data.Data {
-			byteRow, err := json.Marshal(row)
+		return toRawMessages(data.Data)
+	case downloadRequestTypeAdsTxtGroupByDPKey:
+		var ops *core.AdsTxtGetGroupByDPOptions
+		err := json.Unmarshal(requestBody, &ops)
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := o.adsTxtService.GetGroupByDPAdsTxtTable(ctx, ops)
+		if err != nil {
+			return nil, err
+		}
+
+		return toRawMessages(data.Data)
+	}
+
+	return nil, fmt.Errorf("unknown request type [%v]", requestType)
+}
+
+// getAutomationDataForFile gets automation log data, it's served only by authenticated route
+// since logs contain revenue and factors history
+func (o *OMSNewPlatform) getAutomationDataForFile(ctx context.Context, requestType string, requestBody []byte) ([]json.RawMessage, error) {
+	switch requestType {
+	case downloadRequestTypeFactorLogKey, downloadRequestTypeFactorLogAggregateKey:
+		var ops *core.FactorLogOptions
+		err := json.Unmarshal(requestBody, &ops)
+		if err != nil {
+			return nil, err
+		}
+
+		if requestType == downloadRequestTypeFactorLogAggregateKey {
+			data, err := o.automationLogService.GetFactorLogAggregates(ctx, ops)
 			if err != nil {
 				return nil, err
 			}
-			result = append(result, byteRow)
+
+			return toRawMessages(data)
 		}
 
-		return result, nil
-	case downloadRequestTypeAdsTxtGroupByDPKey:
-		var ops *core.AdsTxtGetGroupByDPOptions
-		err := json.Unmarshal(requestBody, &ops)
+		data, err := o.automationLogService.GetFactorLogs(ctx, ops)
 		if err != nil {
 			return nil, err
 		}
 
-		data, err := o.adsTxtService.GetGroupByDPAdsTxtTable(ctx, ops)
+		return toRawMessages(data)
+	case downloadRequestTypeDpoLogKey, downloadRequestTypeDpoLogAggregateKey:
+		var ops *core.DpoLogOptions
+		err := json.Unmarshal(requestBody, &ops)
 		if err != nil {
 			return nil, err
 		}
 
-		result := make([]json.RawMessage, 0, len(data.Data))
-		for _, row := range data.Data {
-			byteRow, err := json.Marshal(row)
+		if requestType == downloadRequestTypeDpoLogAggregateKey {
+			data, err := o.automationLogService.GetDpoLogAggregates(ctx, ops)
 			if err != nil {
 				return nil, err
 			}
-			result = append(result, byteRow)
+
+			return toRawMessages(data)
+		}
+
+		data, err := o.automationLogService.GetDpoLogs(ctx, ops)
+		if err != nil {
+			return nil, err
 		}
 
-		return result, nil
+		return toRawMessages(data)
 	}
 
 	return nil, fmt.Errorf("unknown request type [%v]", requestType)
 }
 
+func toRawMessages[T any](rows []T) ([]json.RawMessage, error) {
+	result := make([]json.RawMessage, 0, len(rows))
+	for _, row := range rows {
+		byteRow, err := json.Marshal(row)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, byteRow)
+	}
+
+	return result, nil
+}
+
 func sendFile(c *fiber.Ctx, filenamePrefix string, data []byte, format dto.DownloadFormat) error {
 	filename := fmt.Sprintf("%v.%v.%v", filenamePrefix, time.Now().Format("2006_01_02_15_04_05"), format)
 	c.Set(constant.HeaderContentDescription, "File Transfer")
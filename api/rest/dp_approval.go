@@ -0,0 +1,86 @@
+package rest
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/m6yf/bcwork/core"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/utils"
+)
+
+// DPApprovalGetHandler Get demand partner approval requests
+// @Description Get approval requests of publisher domains by demand partners
+// @Tags DemandPartner
+// @Accept json
+// @Produce json
+// @Param options body core.GetDPApprovalRequestOptions true "options"
+// @Success 200 {object} []dto.DPApprovalRequest
+// @Security ApiKeyAuth
+// @Router /dp/approval/get [post]
+func (o *OMSNewPlatform) DPApprovalGetHandler(c *fiber.Ctx) error {
+	data := &core.GetDPApprovalRequestOptions{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Request body parsing error", err)
+	}
+
+	requests, err := o.dpApprovalService.GetApprovalRequests(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to retrieve demand partner approval requests", err)
+	}
+
+	return c.JSON(requests)
+}
+
+// DPApprovalSendHandler Send domains for demand partner approval
+// @Description Email domains to point of contact of demand partner with csv attached and mark their approval requests as sent, already approved domains are skipped
+// @Tags DemandPartner
+// @Accept json
+// @Produce json
+// @Param options body dto.DPApprovalSendRequest true "Demand partner approval send Options"
+// @Success 200 {object} utils.BaseResponse
+// @Security ApiKeyAuth
+// @Router /dp/approval/send [post]
+func (o *OMSNewPlatform) DPApprovalSendHandler(c *fiber.Ctx) error {
+	data := &dto.DPApprovalSendRequest{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Demand partner approval send payload parsing error", err)
+	}
+
+	err := o.dpApprovalService.SendForApproval(c.Context(), data)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, core.ErrDPApprovalNotNeeded) || errors.Is(err, core.ErrDPApprovalNoPOC) {
+			return utils.ErrorResponse(c, fiber.StatusBadRequest, "Failed to send domains for approval", err)
+		}
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to send domains for approval", err)
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Domains successfully sent for approval")
+}
+
+// DPApprovalDecisionHandler Record demand partner decision
+// @Description Record approval or rejection of domains by demand partner and update demand status of their ads.txt lines
+// @Tags DemandPartner
+// @Accept json
+// @Produce json
+// @Param options body dto.DPApprovalDecisionRequest true "Demand partner approval decision Options"
+// @Success 200 {object} utils.BaseResponse
+// @Security ApiKeyAuth
+// @Router /dp/approval/decide [post]
+func (o *OMSNewPlatform) DPApprovalDecisionHandler(c *fiber.Ctx) error {
+	data := &dto.DPApprovalDecisionRequest{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Demand partner approval decision payload parsing error", err)
+	}
+
+	err := o.dpApprovalService.Decide(c.Context(), data)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return utils.ErrorResponse(c, fiber.StatusBadRequest, "Failed to record demand partner decision", err)
+		}
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to record demand partner decision", err)
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Demand partner decision successfully recorded")
+}
@@ -0,0 +1,31 @@
+package rest
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/m6yf/bcwork/core"
+	"github.com/m6yf/bcwork/utils"
+)
+
+// DPScoreGetHandler Get scores of demand partners
+// @Description Get scores of demand partners with their components, only the latest calculation is returned unless calculated_at filter is set.
+// @Description Overall scores have empty country and device type.
+// @Tags DemandPartner
+// @Accept json
+// @Produce json
+// @Param options body core.GetDPScoreOptions true "options"
+// @Success 200 {object} []dto.DPScore
+// @Security ApiKeyAuth
+// @Router /dp/score/get [post]
+func (o *OMSNewPlatform) DPScoreGetHandler(c *fiber.Ctx) error {
+	data := &core.GetDPScoreOptions{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Request body parsing error", err)
+	}
+
+	scores, err := o.dpScoreService.GetScores(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to retrieve demand partner scores", err)
+	}
+
+	return c.JSON(scores)
+}
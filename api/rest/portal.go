@@ -0,0 +1,156 @@
+package rest
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/modules/rbac"
+	"github.com/m6yf/bcwork/utils"
+)
+
+// PortalReportHandler Get publisher report
+// @Description Get daily or hourly impressions and revenue of publisher by domain
+// @Tags Portal
+// @Accept json
+// @Produce json
+// @Param options body dto.PortalReportRequest true "Portal report Options"
+// @Success 200 {object} []dto.PortalReportRow
+// @Security ApiKeyAuth
+// @Router /portal/report [post]
+func (o *OMSNewPlatform) PortalReportHandler(c *fiber.Ctx) error {
+	data := &dto.PortalReportRequest{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Request body parsing error", err)
+	}
+
+	rows, err := o.portalService.GetReport(c.Context(), data)
+	if err != nil {
+		return portalErrorResponse(c, "Failed to retrieve publisher report", err)
+	}
+
+	return c.JSON(rows)
+}
+
+// PortalDomainsHandler Get publisher domains
+// @Description Get domains of publisher with ads.txt status and lines which must be added
+// @Tags Portal
+// @Accept json
+// @Produce json
+// @Param options body dto.PortalRequest true "Portal Options"
+// @Success 200 {object} []dto.PortalDomain
+// @Security ApiKeyAuth
+// @Router /portal/domains [post]
+func (o *OMSNewPlatform) PortalDomainsHandler(c *fiber.Ctx) error {
+	data := &dto.PortalRequest{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Request body parsing error", err)
+	}
+
+	domains, err := o.portalService.GetDomains(c.Context(), data.PublisherID)
+	if err != nil {
+		return portalErrorResponse(c, "Failed to retrieve publisher domains", err)
+	}
+
+	return c.JSON(domains)
+}
+
+// PortalTagsHandler Export publisher JS tags
+// @Description Export JS tags of publisher targetings
+// @Tags Portal
+// @Accept json
+// @Produce json
+// @Param options body dto.PortalTagsRequest true "Portal tags Options"
+// @Success 200 {object} []dto.Tags
+// @Security ApiKeyAuth
+// @Router /portal/tags [post]
+func (o *OMSNewPlatform) PortalTagsHandler(c *fiber.Ctx) error {
+	data := &dto.PortalTagsRequest{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Request body parsing error", err)
+	}
+
+	tags, err := o.portalService.ExportTags(c.Context(), data)
+	if err != nil {
+		return portalErrorResponse(c, "Failed to export tags", err)
+	}
+
+	return c.JSON(tags)
+}
+
+// PortalStatementHandler Get publisher monthly statements
+// @Description Get monthly impressions and revenue payable to publisher
+// @Tags Portal
+// @Accept json
+// @Produce json
+// @Param options body dto.PortalStatementRequest true "Portal statement Options"
+// @Success 200 {object} []monthly.Statement
+// @Security ApiKeyAuth
+// @Router /portal/statement [post]
+func (o *OMSNewPlatform) PortalStatementHandler(c *fiber.Ctx) error {
+	data := &dto.PortalStatementRequest{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Request body parsing error", err)
+	}
+
+	statements, err := o.portalService.GetStatements(c.Context(), data)
+	if err != nil {
+		return portalErrorResponse(c, "Failed to retrieve publisher statements", err)
+	}
+
+	return c.JSON(statements)
+}
+
+// PortalNotificationGetHandler Get publisher notification preferences
+// @Description Get notification preferences of publisher
+// @Tags Portal
+// @Accept json
+// @Produce json
+// @Param options body dto.PortalRequest true "Portal Options"
+// @Success 200 {object} dto.PublisherNotificationPreference
+// @Security ApiKeyAuth
+// @Router /portal/notification/get [post]
+func (o *OMSNewPlatform) PortalNotificationGetHandler(c *fiber.Ctx) error {
+	data := &dto.PortalRequest{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Request body parsing error", err)
+	}
+
+	preference, err := o.portalService.GetNotificationPreference(c.Context(), data.PublisherID)
+	if err != nil {
+		return portalErrorResponse(c, "Failed to retrieve notification preferences", err)
+	}
+
+	return c.JSON(preference)
+}
+
+// PortalNotificationSetHandler Set publisher notification preferences
+// @Description Set notification preferences of publisher
+// @Tags Portal
+// @Accept json
+// @Produce json
+// @Param options body dto.PublisherNotificationPreference true "Notification preference Options"
+// @Success 200 {object} utils.BaseResponse
+// @Security ApiKeyAuth
+// @Router /portal/notification/set [post]
+func (o *OMSNewPlatform) PortalNotificationSetHandler(c *fiber.Ctx) error {
+	data := &dto.PublisherNotificationPreference{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Request body parsing error", err)
+	}
+
+	err := o.portalService.SetNotificationPreference(c.Context(), data)
+	if err != nil {
+		return portalErrorResponse(c, "Failed to set notification preferences", err)
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Notification preferences successfully updated")
+}
+
+func portalErrorResponse(c *fiber.Ctx, message string, err error) error {
+	if errors.Is(err, rbac.ErrForbidden) {
+		return utils.ErrorResponse(c, fiber.StatusForbidden, message, err)
+	}
+
+	return utils.ErrorResponse(c, fiber.StatusInternalServerError, message, err)
+}
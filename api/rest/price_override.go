@@ -1,15 +1,16 @@
 package rest
 
 import (
+	"strings"
+
 	"github.com/gofiber/fiber/v2"
 	"github.com/m6yf/bcwork/core"
 	"github.com/m6yf/bcwork/dto"
 	"github.com/m6yf/bcwork/utils"
-	"strings"
 )
 
-// PriceOverrideHandler set bid factor per user IP - valid for only 8 hours
-// @Description set bid factor per user IP - valid for only 8 hours
+// PriceOverrideHandler set bid price per user IP or CIDR range
+// @Description Set bid price per user IP or CIDR range of domain. Entries expire after ttl_hours (8 hours by default), existing entries get new price and expiry.
 // @Tags Price Override
 // @Accept json
 // @Produce json
@@ -17,16 +18,63 @@ import (
 // @Success 200 {object} utils.BaseResponse
 // @Security ApiKeyAuth
 // @Router /price/override [post]
-func PriceOverrideHandler(c *fiber.Ctx) error {
+func (o *OMSNewPlatform) PriceOverrideHandler(c *fiber.Ctx) error {
 	data := &dto.PriceOverrideRequest{}
 	if err := c.BodyParser(&data); err != nil {
 		return utils.ErrorResponse(c, fiber.StatusBadRequest, "failed to parse price override payload", err)
 	}
 	data.Domain = strings.ToLower(data.Domain)
-	err := core.UpdateMetaDataQueue(c.Context(), data)
+
+	err := o.priceOverrideService.SetPriceOverrides(c.Context(), data)
 	if err != nil {
-		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "failed to update metadata_queue with new price by IPs", err)
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "failed to update price overrides", err)
 	}
 
 	return utils.SuccessResponse(c, fiber.StatusOK, "override prices successfully updated")
 }
+
+// PriceOverrideGetHandler Get price overrides
+// @Description Get price overrides with filtering and pagination
+// @Tags Price Override
+// @Accept json
+// @Produce json
+// @Param options body core.GetPriceOverrideOptions true "options"
+// @Success 200 {object} []dto.PriceOverride
+// @Security ApiKeyAuth
+// @Router /price/override/get [post]
+func (o *OMSNewPlatform) PriceOverrideGetHandler(c *fiber.Ctx) error {
+	data := &core.GetPriceOverrideOptions{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Request body parsing error", err)
+	}
+
+	overrides, err := o.priceOverrideService.GetPriceOverrides(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "failed to retrieve price overrides", err)
+	}
+
+	return c.JSON(overrides)
+}
+
+// PriceOverrideDeleteHandler Delete price overrides
+// @Description Delete price overrides, overrides of affected domains are republished
+// @Tags Price Override
+// @Accept json
+// @Produce json
+// @Param options body []int true "options"
+// @Success 200 {object} utils.BaseResponse
+// @Security ApiKeyAuth
+// @Router /price/override/delete [delete]
+func (o *OMSNewPlatform) PriceOverrideDeleteHandler(c *fiber.Ctx) error {
+	var ids []int
+	if err := c.BodyParser(&ids); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "failed to parse array of price override ids to delete", err)
+	}
+
+	err := o.priceOverrideService.DeletePriceOverrides(c.Context(), ids)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "failed to delete price overrides", err)
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "price overrides successfully deleted")
+}
@@ -0,0 +1,90 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPriceOverrideLifecycle(t *testing.T) {
+	const (
+		setEndpoint    = "/test/price/override"
+		getEndpoint    = "/test/price/override/get"
+		deleteEndpoint = "/test/price/override/delete"
+		getRequest     = `{"filter": {"domain": ["override.com"]}, "order": [{"name": "ip"}]}`
+	)
+
+	getOverrides := func(t *testing.T) []dto.PriceOverride {
+		req := httptest.NewRequest(fiber.MethodPost, getEndpoint, strings.NewReader(getRequest))
+		req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+		resp, err := appTest.Test(req, -1)
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+		body, err := io.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		var overrides []dto.PriceOverride
+		assert.NoError(t, json.Unmarshal(body, &overrides))
+
+		return overrides
+	}
+
+	// set
+	before := time.Now().UTC()
+	req := httptest.NewRequest(
+		fiber.MethodPost,
+		setEndpoint,
+		strings.NewReader(`{"domain": "Override.com", "ttl_hours": 24, "ips": [{"ip": "10.0.0.17/24", "price": 1.5}, {"ip": "192.168.1.1", "price": 2}]}`),
+	)
+	req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	resp, err := appTest.Test(req, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	overrides := getOverrides(t)
+	assert.Len(t, overrides, 2)
+	assert.Equal(t, "10.0.0.0/24", overrides[0].IP)
+	assert.Equal(t, 1.5, overrides[0].Price)
+	assert.Equal(t, "192.168.1.1", overrides[1].IP)
+	assert.Equal(t, 2.0, overrides[1].Price)
+	assert.True(t, overrides[0].ExpiresAt.After(before.Add(23*time.Hour)))
+
+	// update of existing entry
+	req = httptest.NewRequest(
+		fiber.MethodPost,
+		setEndpoint,
+		strings.NewReader(`{"domain": "override.com", "ips": [{"ip": "192.168.1.1", "price": 3}]}`),
+	)
+	req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	resp, err = appTest.Test(req, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	overrides = getOverrides(t)
+	assert.Len(t, overrides, 2)
+	assert.Equal(t, 3.0, overrides[1].Price)
+	assert.True(t, overrides[1].ExpiresAt.Before(before.Add(9*time.Hour)))
+
+	// delete
+	req = httptest.NewRequest(
+		fiber.MethodDelete,
+		deleteEndpoint,
+		strings.NewReader(fmt.Sprintf(`[%v, %v]`, overrides[0].ID, overrides[1].ID)),
+	)
+	req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	resp, err = appTest.Test(req, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	assert.Empty(t, getOverrides(t))
+}
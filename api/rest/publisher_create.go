@@ -1,8 +1,11 @@
 package rest
 
 import (
+	"errors"
+
 	"github.com/gofiber/fiber/v2"
 	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/modules/rbac"
 	"github.com/m6yf/bcwork/utils"
 )
 
@@ -28,6 +31,9 @@ func (o *OMSNewPlatform) PublisherNewHandler(c *fiber.Ctx) error {
 
 	publisherID, err := o.publisherService.CreatePublisher(c.Context(), *data)
 	if err != nil {
+		if errors.Is(err, rbac.ErrForbidden) {
+			return utils.ErrorResponse(c, fiber.StatusForbidden, "failed to create publisher", err)
+		}
 		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "failed to create publisher", err)
 	}
 
@@ -103,7 +103,7 @@ func TestPublisherNewHistory(t *testing.T) {
 						{Property: "name", OldValue: nil, NewValue: "publisher_new"},
 						{Property: "office_location", OldValue: nil, NewValue: "LATAM"},
 						{Property: "publisher_id", OldValue: nil, NewValue: ""}, // ignore publisher_id because it's dynamic
-						{Property: "status", OldValue: nil, NewValue: "Active"},
+						{Property: "status", OldValue: nil, NewValue: "active"},
 					},
 				},
 			},
@@ -1,9 +1,12 @@
 package rest
 
 import (
+	"errors"
+
 	"github.com/gofiber/fiber/v2"
 	"github.com/m6yf/bcwork/core"
 	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/modules/rbac"
 	"github.com/m6yf/bcwork/utils"
 )
 
@@ -48,6 +51,9 @@ func (o *OMSNewPlatform) PublisherDomainPostHandler(c *fiber.Ctx) error {
 
 	err = o.domainService.UpdatePublisherDomain(c.Context(), data)
 	if err != nil {
+		if errors.Is(err, rbac.ErrForbidden) {
+			return utils.ErrorResponse(c, fiber.StatusForbidden, "Failed to update Publisher Domain table", err)
+		}
 		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to update Publisher Domain table", err)
 	}
 
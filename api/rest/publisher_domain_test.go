@@ -36,7 +36,7 @@ func TestPublisherDomainGetHandler(t *testing.T) {
 			requestBody: `{"filter": {"domain": ["direct.com"]}}`,
 			want: want{
 				statusCode: fiber.StatusOK,
-				response:   `[{"publisher_id":"666","publisher_name":"direct_publisher","domain":"direct.com","automation":true,"gpp_target":0.5,"integration_type":[],"created_at":"2024-10-01T13:51:28.407Z","confiant":{},"pixalate":{},"bid_caching":[],"refresh_cache":[],"updated_at":"2024-10-01T13:51:28.407Z","is_direct":true,"is_direct_publisher":true}]`,
+				response:   `[{"publisher_id":"666","publisher_name":"direct_publisher","domain":"direct.com","automation":true,"gpp_target":0.5,"integration_type":[],"created_at":"2024-10-01T13:51:28.407Z","confiant":{},"pixalate":{},"bid_caching":[],"refresh_cache":[],"updated_at":"2024-10-01T13:51:28.407Z","is_direct":true,"is_direct_publisher":true,"automation_strategy":null}]`,
 			},
 		},
 		{
@@ -0,0 +1,30 @@
+package rest
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/utils"
+)
+
+// PublisherLifecycleReportHandler Get time publishers spent in each lifecycle status
+// @Description Get days publishers spent in each lifecycle status (onboarding, active, paused, churned) during the period
+// @Tags publisher
+// @Accept json
+// @Produce json
+// @Param options body dto.PublisherLifecycleReportRequest true "options"
+// @Success 200 {object} []dto.PublisherTimeInState
+// @Security ApiKeyAuth
+// @Router /publisher/lifecycle/report [post]
+func (o *OMSNewPlatform) PublisherLifecycleReportHandler(c *fiber.Ctx) error {
+	data := &dto.PublisherLifecycleReportRequest{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Request body parsing error", err)
+	}
+
+	report, err := o.publisherService.GetLifecycleReport(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to retrieve publisher lifecycle report", err)
+	}
+
+	return c.JSON(report)
+}
@@ -1,8 +1,12 @@
 package rest
 
 import (
+	"errors"
+
 	"github.com/gofiber/fiber/v2"
+	"github.com/m6yf/bcwork/core"
 	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/modules/rbac"
 	"github.com/m6yf/bcwork/utils"
 )
 
@@ -34,6 +38,12 @@ func (o *OMSNewPlatform) PublisherUpdateHandler(c *fiber.Ctx) error {
 
 	err := o.publisherService.UpdatePublisher(c.Context(), data.PublisherID, data.Options)
 	if err != nil {
+		if errors.Is(err, rbac.ErrForbidden) {
+			return utils.ErrorResponse(c, fiber.StatusForbidden, "failed to update publisher fields", err)
+		}
+		if errors.Is(err, core.ErrPublisherStatusTransition) {
+			return utils.ErrorResponse(c, fiber.StatusBadRequest, "failed to update publisher fields", err)
+		}
 		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "failed to update publisher fields", err)
 	}
 
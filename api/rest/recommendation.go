@@ -0,0 +1,65 @@
+package rest
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/m6yf/bcwork/core/recommendation"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/utils"
+)
+
+// RecommendationGetHandler Get floor and factor recommendations
+// @Description Get floor and factor recommendations per publisher, domain, country and device estimated from historical performance and bid landscape. Recommendations maximize expected GP keeping fill rate within constraints and are sorted by expected GP increase.
+// @Tags Recommendation
+// @Accept json
+// @Produce json
+// @Param options body recommendation.GetRecommendationOptions true "options"
+// @Success 200 {object} []dto.Recommendation
+// @Security ApiKeyAuth
+// @Router /recommendation/get [post]
+func (o *OMSNewPlatform) RecommendationGetHandler(c *fiber.Ctx) error {
+	data := &recommendation.GetRecommendationOptions{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Request body parsing error", err)
+	}
+
+	recommendations, err := o.recommendationService.GetRecommendations(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "failed to calculate recommendations", err)
+	}
+
+	return c.JSON(recommendations)
+}
+
+// RecommendationApplyHandler Apply floor and factor recommendations
+// @Description Apply floors and factors of selected recommendations in one transaction, floor or factor could be omitted to apply only the other one. When factor changes require approval, nothing is applied until the created change request is approved.
+// @Tags Recommendation
+// @Accept json
+// @Produce json
+// @Param options body dto.RecommendationApplyRequest true "options"
+// @Success 200 {object} utils.BaseResponse
+// @Success 202 {object} ChangeRequestPendingResponse
+// @Security ApiKeyAuth
+// @Router /recommendation/apply [post]
+func (o *OMSNewPlatform) RecommendationApplyHandler(c *fiber.Ctx) error {
+	data := &dto.RecommendationApplyRequest{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "failed to parse recommendations to apply", err)
+	}
+
+	changeRequest, err := o.recommendationService.ApplyRecommendations(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "failed to apply recommendations", err)
+	}
+
+	if changeRequest != nil {
+		return c.Status(fiber.StatusAccepted).JSON(ChangeRequestPendingResponse{
+			BaseResponse: utils.BaseResponse{
+				Status:  utils.ResponseStatusSuccess,
+				Message: "recommended factors require approval, change request was created",
+			},
+			ChangeRequestID: changeRequest.ID,
+		})
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "recommendations successfully applied")
+}
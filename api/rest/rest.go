@@ -4,7 +4,9 @@ import (
 	"context"
 
 	"github.com/m6yf/bcwork/core"
+	"github.com/m6yf/bcwork/core/approval"
 	"github.com/m6yf/bcwork/core/bulk"
+	"github.com/m6yf/bcwork/core/recommendation"
 	adstxt "github.com/m6yf/bcwork/modules/ads_txt"
 	"github.com/m6yf/bcwork/modules/compass"
 	"github.com/m6yf/bcwork/modules/export"
@@ -13,28 +15,43 @@ import (
 )
 
 type OMSNewPlatform struct {
-	userService          *core.UserService
-	targetingService     *core.TargetingService
-	domainService        *core.DomainService
-	historyService       *core.HistoryService
-	publisherService     *core.PublisherService
-	globalFactorService  *core.GlobalFactorService
-	bulkService          bulk.Bulker
-	confiantService      *core.ConfiantService
-	pixalateService      *core.PixalateService
-	blocksService        *core.BlocksService
-	floorService         *core.FloorService
-	factorService        *core.FactorService
-	demandPartnerService *core.DemandPartnerService
-	dpoService           *core.DPOService
-	adjustService        bulk.Adjuster
-	searchService        *core.SearchService
-	bidCachingService    *core.BidCachingService
-	refreshCacheService  *core.RefreshCacheService
-	emailService         *core.EmailService
-	downloadService      *core.DownloadService
-	adsTxtService        *core.AdsTxtService
-	dpApiService         *core.DpAPIService
+	userService                *core.UserService
+	targetingService           *core.TargetingService
+	domainService              *core.DomainService
+	historyService             *core.HistoryService
+	publisherService           *core.PublisherService
+	globalFactorService        *core.GlobalFactorService
+	bulkService                bulk.Bulker
+	confiantService            *core.ConfiantService
+	pixalateService            *core.PixalateService
+	blocksService              *core.BlocksService
+	floorService               *core.FloorService
+	factorService              *core.FactorService
+	demandPartnerService       *core.DemandPartnerService
+	dpoService                 *core.DPOService
+	adjustService              bulk.Adjuster
+	searchService              *core.SearchService
+	bidCachingService          *core.BidCachingService
+	refreshCacheService        *core.RefreshCacheService
+	emailService               *core.EmailService
+	downloadService            *core.DownloadService
+	adsTxtService              *core.AdsTxtService
+	dpApiService               *core.DpAPIService
+	changeApprovalService      *approval.ChangeApprovalService
+	priceOverrideService       *core.PriceOverrideService
+	recommendationService      *recommendation.RecommendationService
+	automationGuardrailService *core.AutomationGuardrailService
+	automationLogService       *core.AutomationLogService
+	alertService               *core.AlertService
+	sellersJSONService         *core.SellersJSONService
+	schainService              *core.SchainService
+	apiKeyService              *core.APIKeyService
+	portalService              *core.PortalService
+	auditLogService            *core.AuditLogService
+	compassSyncService         *core.CompassSyncService
+	dpApprovalService          *core.DPApprovalService
+	bidderParamsService        *core.BidderParamsService
+	dpScoreService             *core.DPScoreService
 }
 
 func NewOMSNewPlatform(
@@ -66,28 +83,58 @@ func NewOMSNewPlatform(
 	emailService := core.NewEmailService(ctx)
 	downloadService := core.NewDownloadService(exportModule)
 	adsTxtService := core.NewAdsTxtService(ctx, historyModule, compassModule, adstxtModule)
+	changeApprovalService := approval.NewChangeApprovalService(historyModule, bulkService, globalFactorService)
+	priceOverrideService := core.NewPriceOverrideService(historyModule)
+	recommendationService := recommendation.NewRecommendationService(bulkService, changeApprovalService)
+	automationGuardrailService := core.NewAutomationGuardrailService()
+	automationLogService := core.NewAutomationLogService()
+	alertService := core.NewAlertService(historyModule)
+	sellersJSONService := core.NewSellersJSONService()
+	schainService := core.NewSchainService()
+	apiKeyService := core.NewAPIKeyService(historyModule)
+	portalService := core.NewPortalService(historyModule, targetingService)
+	auditLogService := core.NewAuditLogService()
+	compassSyncService := core.NewCompassSyncService(historyModule, compassModule)
+	dpApprovalService := core.NewDPApprovalService(historyModule, adstxtModule)
+	bidderParamsService := core.NewBidderParamsService(historyModule)
+	dpScoreService := core.NewDPScoreService()
 
 	return &OMSNewPlatform{
-		userService:          userService,
-		targetingService:     targetingService,
-		domainService:        domainService,
-		historyService:       historyService,
-		publisherService:     publisherService,
-		globalFactorService:  globalFactorService,
-		bulkService:          bulkService,
-		confiantService:      confiantService,
-		pixalateService:      pixalateService,
-		blocksService:        blocksService,
-		floorService:         floorService,
-		factorService:        factorService,
-		demandPartnerService: demandPartnerService,
-		dpoService:           dpoService,
-		searchService:        searchService,
-		bidCachingService:    bidCachingService,
-		refreshCacheService:  refreshCacheService,
-		adjustService:        bulkService,
-		emailService:         emailService,
-		downloadService:      downloadService,
-		adsTxtService:        adsTxtService,
+		userService:                userService,
+		targetingService:           targetingService,
+		domainService:              domainService,
+		historyService:             historyService,
+		publisherService:           publisherService,
+		globalFactorService:        globalFactorService,
+		bulkService:                bulkService,
+		confiantService:            confiantService,
+		pixalateService:            pixalateService,
+		blocksService:              blocksService,
+		floorService:               floorService,
+		factorService:              factorService,
+		demandPartnerService:       demandPartnerService,
+		dpoService:                 dpoService,
+		searchService:              searchService,
+		bidCachingService:          bidCachingService,
+		refreshCacheService:        refreshCacheService,
+		adjustService:              bulkService,
+		emailService:               emailService,
+		downloadService:            downloadService,
+		adsTxtService:              adsTxtService,
+		changeApprovalService:      changeApprovalService,
+		priceOverrideService:       priceOverrideService,
+		recommendationService:      recommendationService,
+		automationGuardrailService: automationGuardrailService,
+		automationLogService:       automationLogService,
+		alertService:               alertService,
+		sellersJSONService:         sellersJSONService,
+		schainService:              schainService,
+		apiKeyService:              apiKeyService,
+		portalService:              portalService,
+		auditLogService:            auditLogService,
+		compassSyncService:         compassSyncService,
+		dpApprovalService:          dpApprovalService,
+		bidderParamsService:        bidderParamsService,
+		dpScoreService:             dpScoreService,
 	}
 }
@@ -90,6 +90,11 @@ func TestMain(m *testing.M) {
 	appTest.Post("/test/dp/update", omsNPTest.DemandPartnerUpdateHandler)
 	// block
 	appTest.Post("/test/block/get", omsNPTest.BlockGetAllHandler)
+	appTest.Post("/test/block/upload", omsNPTest.BlockUploadHandler)
+	// price override
+	appTest.Post("/test/price/override", omsNPTest.PriceOverrideHandler)
+	appTest.Post("/test/price/override/get", omsNPTest.PriceOverrideGetHandler)
+	appTest.Delete("/test/price/override/delete", omsNPTest.PriceOverrideDeleteHandler)
 	// targeting
 	appTest.Post("/test/targeting/get", omsNPTest.TargetingGetHandler)
 	appTest.Post("/test/targeting/set", validations.ValidateTargeting, omsNPTest.TargetingSetHandler)
@@ -186,6 +191,8 @@ func createDBTables(db *sqlx.DB, client supertokens_module.TokenManagementSystem
 	createPublisherTable(db)
 	createTargetingTable(db)
 	createMetaDataTable(db)
+	createBlocksTable(db)
+	createPriceOverrideTable(db)
 	createHistoryTable(db)
 	createConfiantTable(db)
 	createPixalateTable(db)
@@ -362,6 +369,10 @@ func createPublisherTable(db *sqlx.DB) {
 			"integration_type" varchar(64)[] NULL,
 			"media_type" varchar(64)[] NULL,
 			is_direct bool not null default true,
+			seller_domain varchar(256) NULL,
+			seller_type varchar(16) NULL,
+			is_confidential bool not null default false,
+			status_changed_at timestamp NULL,
 			CONSTRAINT publisher_name_key UNIQUE (name),
 			CONSTRAINT publisher_pkey PRIMARY KEY (publisher_id)
 		);`,
@@ -386,6 +397,46 @@ func createPublisherTable(db *sqlx.DB) {
 		(publisher_id, name, status, office_location, created_at, account_manager_id, media_buyer_id, campaign_manager_id, is_direct)
 		VALUES('666', 'direct_publisher', 'Active', 'IL', '2024-10-01 13:46:41.302', '1', '2', '3', TRUE);
 	`)
+	tx.MustExec(`
+		CREATE TABLE public.publisher_status_transition (
+			id serial PRIMARY KEY,
+			publisher_id varchar(64) NOT NULL REFERENCES publisher(publisher_id),
+			from_status varchar(64) NULL,
+			to_status varchar(64) NOT NULL,
+			source varchar(16) NOT NULL,
+			created_by int NULL,
+			created_at timestamp NOT NULL,
+			updated_at timestamp NULL
+		);`,
+	)
+	tx.MustExec(`
+		CREATE TABLE public.publisher_paused_rule (
+			id serial PRIMARY KEY,
+			publisher_id varchar(64) NOT NULL REFERENCES publisher(publisher_id),
+			rule_type varchar(16) NOT NULL,
+			rule_id varchar(36) NOT NULL,
+			created_at timestamp NOT NULL,
+			updated_at timestamp NULL,
+			UNIQUE (rule_type, rule_id)
+		);`,
+	)
+	tx.MustExec(`
+		CREATE TABLE public.publisher_compass_sync (
+			id serial PRIMARY KEY,
+			publisher_id varchar(64) NOT NULL UNIQUE REFERENCES publisher(publisher_id),
+			status varchar(16) NOT NULL,
+			latest_timestamp bigint NULL,
+			local_changed_at timestamp NULL,
+			pulled_at timestamp NULL,
+			pushed_at timestamp NULL,
+			diffs jsonb NULL,
+			compass_values jsonb NULL,
+			error_message varchar(1024) NULL,
+			created_at timestamp NOT NULL,
+			updated_at timestamp NULL,
+			local_changed_columns jsonb NULL
+		);`,
+	)
 	tx.Commit()
 }
 
@@ -445,6 +496,46 @@ func createMetaDataTable(db *sqlx.DB) {
 	tx.Commit()
 }
 
+func createBlocksTable(db *sqlx.DB) {
+	tx := db.MustBegin()
+	tx.MustExec("create table blocks" +
+		"(" +
+		"id serial primary key," +
+		"publisher varchar(64) not null," +
+		"domain varchar(256) not null default ''," +
+		"type varchar(16) not null," +
+		"value varchar(256) not null," +
+		"reason text," +
+		"expires_at timestamp," +
+		"created_at timestamp not null," +
+		"updated_at timestamp" +
+		");",
+	)
+	tx.MustExec("create unique index idx_blocks_unique on blocks(publisher, domain, type, value);")
+	tx.MustExec("INSERT INTO blocks (publisher, domain, type, value, created_at, updated_at) "+
+		"VALUES ($1, $2, $3, $4, $5, $6)",
+		"20356", "playpilot.com", "badv", "fraction-content.com", "2024-09-20T10:10:10.100", "2024-09-26T10:10:10.100")
+	tx.Commit()
+}
+
+func createPriceOverrideTable(db *sqlx.DB) {
+	tx := db.MustBegin()
+	tx.MustExec("create table price_override" +
+		"(" +
+		"id serial primary key," +
+		"domain varchar(256) not null," +
+		"ip varchar(64) not null," +
+		"price float8 not null," +
+		"expires_at timestamp not null," +
+		"created_by int," +
+		"created_at timestamp not null," +
+		"updated_at timestamp" +
+		");",
+	)
+	tx.MustExec("create unique index idx_price_override_domain_ip on price_override(domain, ip);")
+	tx.Commit()
+}
+
 func createHistoryTable(db *sqlx.DB) {
 	tx := db.MustBegin()
 	tx.MustExec("create table history" +
@@ -461,7 +552,27 @@ func createHistoryTable(db *sqlx.DB) {
 		"old_value jsonb," +
 		"new_value jsonb," +
 		"changes jsonb," +
-		"date timestamp not null" +
+		"date timestamp not null," +
+		"approver_id int," +
+		"api_key_id int" +
+		");",
+	)
+	tx.MustExec("create table api_key" +
+		"(" +
+		"id serial primary key," +
+		"name varchar(128) not null," +
+		"key_prefix varchar(16) not null," +
+		"key_hash varchar(64) not null unique," +
+		"owner_id int not null," +
+		"scopes varchar(64)[] not null," +
+		"allowed_ips varchar(64)[]," +
+		"expires_at timestamp," +
+		"last_used_at timestamp," +
+		"last_used_ip varchar(64)," +
+		"revoked_at timestamp," +
+		"created_by int not null," +
+		"created_at timestamp not null," +
+		"updated_at timestamp" +
 		");",
 	)
 	tx.MustExec(`INSERT INTO public.history ` +
@@ -523,6 +634,8 @@ func createPublisherDomainTable(db *sqlx.DB) {
 			"integration_type" varchar(64)[] NULL,
 			mirror_publisher_id varchar(36) references publisher (publisher_id), 
 			is_direct bool, 
+			automation_strategy varchar(64),
+			automation_params jsonb,
 			CONSTRAINT publisher_domain_pkey1 PRIMARY KEY (domain, publisher_id)
 		);
 	`)
@@ -534,6 +647,21 @@ func createPublisherDomainTable(db *sqlx.DB) {
 			('test.com', '22222222', TRUE, 0.5, '2024-10-01 13:51:28.407', '2024-10-01 13:51:28.407', '1111111', FALSE),
 			('direct.com', '666', TRUE, 0.5, '2024-10-01 13:51:28.407', '2024-10-01 13:51:28.407', NULL, TRUE);
 	`)
+	tx.MustExec(`
+		CREATE TABLE public.domain_onboarding (
+			id serial PRIMARY KEY,
+			publisher_id varchar(64) NOT NULL,
+			"domain" varchar(256) NOT NULL,
+			status varchar(16) NOT NULL,
+			checks jsonb NULL,
+			attempts int DEFAULT 0 NOT NULL,
+			notified_status varchar(16) NULL,
+			last_checked_at timestamp NULL,
+			created_at timestamp NOT NULL,
+			updated_at timestamp NULL,
+			UNIQUE (publisher_id, "domain")
+		);
+	`)
 	tx.Commit()
 }
 
@@ -0,0 +1,53 @@
+package rest
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/m6yf/bcwork/core"
+	"github.com/m6yf/bcwork/utils"
+)
+
+// SchainComplianceGetHandler Get supply chain compliance
+// @Description Get supply chain compliance per publisher and demand partner or seat owner ads.txt line
+// @Tags Schain
+// @Accept json
+// @Produce json
+// @Param options body core.GetSchainComplianceOptions true "options"
+// @Success 200 {object} []dto.SchainCompliance
+// @Security ApiKeyAuth
+// @Router /schain/get [post]
+func (o *OMSNewPlatform) SchainComplianceGetHandler(c *fiber.Ctx) error {
+	data := &core.GetSchainComplianceOptions{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Request body parsing error", err)
+	}
+
+	compliance, err := o.schainService.GetSchainCompliance(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to retrieve supply chain compliance", err)
+	}
+
+	return c.JSON(compliance)
+}
+
+// SchainComplianceSummaryHandler Get supply chain compliance summary
+// @Description Get supply chain compliance aggregated per demand partner or seat owner
+// @Tags Schain
+// @Accept json
+// @Produce json
+// @Param options body core.GetSchainComplianceOptions true "options"
+// @Success 200 {object} []dto.SchainComplianceSummary
+// @Security ApiKeyAuth
+// @Router /schain/summary [post]
+func (o *OMSNewPlatform) SchainComplianceSummaryHandler(c *fiber.Ctx) error {
+	data := &core.GetSchainComplianceOptions{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Request body parsing error", err)
+	}
+
+	summary, err := o.schainService.GetSchainComplianceSummary(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to retrieve supply chain compliance summary", err)
+	}
+
+	return c.JSON(summary)
+}
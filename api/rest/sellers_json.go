@@ -0,0 +1,95 @@
+package rest
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/m6yf/bcwork/core"
+	sellersjson "github.com/m6yf/bcwork/modules/sellers_json"
+	"github.com/m6yf/bcwork/utils"
+)
+
+// SellersJSONHandler Get our sellers.json
+// @Description Get the latest published sellers.json. Responds with 304 when If-None-Match header matches its ETag.
+// @Tags SellersJSON
+// @Produce json
+// @Success 200 {object} sellersjson.File
+// @Router /sellers.json [get]
+func (o *OMSNewPlatform) SellersJSONHandler(c *fiber.Ctx) error {
+	mod, err := o.sellersJSONService.GetLatestSellersJSON(c.Context())
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to retrieve sellers.json", err)
+	}
+	if mod == nil {
+		return c.SendStatus(fiber.StatusNotFound)
+	}
+
+	c.Set(fiber.HeaderETag, mod.Etag)
+	c.Set(fiber.HeaderCacheControl, "public, max-age=0, must-revalidate")
+	if c.Get(fiber.HeaderIfNoneMatch) == mod.Etag {
+		return c.SendStatus(fiber.StatusNotModified)
+	}
+
+	c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSONCharsetUTF8)
+
+	return c.SendString(mod.Content)
+}
+
+// SellersJSONPublishHandler Publish sellers.json
+// @Description Build sellers.json from publishers, validate and publish it as a new version when it changed since the latest one
+// @Tags SellersJSON
+// @Produce json
+// @Success 200 {object} dto.SellersJSONPublishResponse
+// @Security ApiKeyAuth
+// @Router /sellers_json/publish [post]
+func (o *OMSNewPlatform) SellersJSONPublishHandler(c *fiber.Ctx) error {
+	resp, err := o.sellersJSONService.PublishSellersJSON(c.Context())
+	if err != nil {
+		if errors.Is(err, sellersjson.ErrInvalid) {
+			return utils.ErrorResponse(c, fiber.StatusBadRequest, "Failed to validate sellers.json", err)
+		}
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to publish sellers.json", err)
+	}
+
+	return c.JSON(resp)
+}
+
+// SellersJSONVersionGetHandler Get published sellers.json versions
+// @Description Get published sellers.json versions
+// @Tags SellersJSON
+// @Accept json
+// @Produce json
+// @Param options body core.GetSellersJSONVersionOptions true "options"
+// @Success 200 {object} []dto.SellersJSONVersion
+// @Security ApiKeyAuth
+// @Router /sellers_json/version/get [post]
+func (o *OMSNewPlatform) SellersJSONVersionGetHandler(c *fiber.Ctx) error {
+	data := &core.GetSellersJSONVersionOptions{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "Request body parsing error", err)
+	}
+
+	versions, err := o.sellersJSONService.GetSellersJSONVersions(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to retrieve sellers.json versions", err)
+	}
+
+	return c.JSON(versions)
+}
+
+// SellersJSONDiffHandler Get sellers.json diff report
+// @Description Get sellers added, removed and changed in the version comparing to the previous one
+// @Tags SellersJSON
+// @Produce json
+// @Param version query int false "Version, the latest when not set"
+// @Success 200 {object} dto.SellersJSONDiff
+// @Security ApiKeyAuth
+// @Router /sellers_json/diff [get]
+func (o *OMSNewPlatform) SellersJSONDiffHandler(c *fiber.Ctx) error {
+	diff, err := o.sellersJSONService.GetSellersJSONDiff(c.Context(), c.QueryInt("version"))
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "Failed to retrieve sellers.json diff", err)
+	}
+
+	return c.JSON(diff)
+}
@@ -112,3 +112,49 @@ func (o *OMSNewPlatform) UserUpdateHandler(c *fiber.Ctx) error {
 
 	return utils.SuccessResponse(c, fiber.StatusOK, "user successfully updated")
 }
+
+// UserPublisherGetHandler Get publishers assigned to user.
+// @Description Get publishers which user with scoped role (Publisher, Consultant) can see and edit.
+// @Tags User
+// @Param options body dto.UserPublishers true "User ID"
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.UserPublishers
+// @Security ApiKeyAuth
+// @Router /user/publisher/get [post]
+func (o *OMSNewPlatform) UserPublisherGetHandler(c *fiber.Ctx) error {
+	data := &dto.UserPublishers{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "failed to parse request for getting user publishers", err)
+	}
+
+	resp, err := o.userService.GetUserPublishers(c.Context(), data.UserID)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "failed to retrieve user publishers", err)
+	}
+
+	return c.JSON(resp)
+}
+
+// UserPublisherSetHandler Assign publishers to user.
+// @Description Replace publishers which user with scoped role (Publisher, Consultant) can see and edit.
+// @Tags User
+// @Accept json
+// @Produce json
+// @Param options body dto.UserPublishers true "User Publishers"
+// @Success 200 {object} utils.BaseResponse
+// @Security ApiKeyAuth
+// @Router /user/publisher/set [post]
+func (o *OMSNewPlatform) UserPublisherSetHandler(c *fiber.Ctx) error {
+	data := &dto.UserPublishers{}
+	if err := c.BodyParser(&data); err != nil {
+		return utils.ErrorResponse(c, fiber.StatusBadRequest, "failed to parse request for setting user publishers", err)
+	}
+
+	err := o.userService.SetUserPublishers(c.Context(), data)
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "failed to set user publishers", err)
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "user publishers successfully updated")
+}
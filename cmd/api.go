@@ -13,10 +13,14 @@ import (
 	"github.com/m6yf/bcwork/api/rest/bulk"
 	"github.com/m6yf/bcwork/api/rest/report"
 	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/config"
+	"github.com/m6yf/bcwork/core"
 	adstxt "github.com/m6yf/bcwork/modules/ads_txt"
+	"github.com/m6yf/bcwork/modules/audit"
 	"github.com/m6yf/bcwork/modules/compass"
 	"github.com/m6yf/bcwork/modules/export"
 	"github.com/m6yf/bcwork/modules/history"
+	"github.com/m6yf/bcwork/modules/rbac"
 	supertokens_module "github.com/m6yf/bcwork/modules/supertokens"
 	"github.com/m6yf/bcwork/validations"
 	"github.com/spf13/viper"
@@ -76,6 +80,9 @@ func ApiCmd(cmd *cobra.Command, args []string) {
 		log.Fatal().Err(err).Msg("failed to connect to supertokens")
 	}
 
+	// issued api keys in addition to shared worker keys
+	supertokenClient.SetAPIKeyVerifier(core.NewAPIKeyService(historyModule))
+
 	omsNP := rest.NewOMSNewPlatform(ctx, supertokenClient, historyModule, exportModule, compassModule, adsTxtModule, true)
 
 	app := fiber.New(fiber.Config{ErrorHandler: rest.ErrorHandler})
@@ -108,6 +115,9 @@ func ApiCmd(cmd *cobra.Command, args []string) {
 	app.Get("/ping", rest.PingPong)
 	app.Get("/swagger/*", swagger.HandlerDefault) // default
 	app.Post("/download", validations.ValidateDownload, omsNP.DownloadHandler)
+	// hosted ads.txt files are fetched by crawlers following publishers redirects
+	app.Get("/ads_txt/hosted/:domain/:file", omsNP.AdsTxtHostedHandler)
+	app.Get("/sellers.json", omsNP.SellersJSONHandler)
 
 	users := app.Group("/user")
 	// unsecured endpoints for internal use
@@ -117,6 +127,16 @@ func ApiCmd(cmd *cobra.Command, args []string) {
 	// supertokens middleware + session verification
 	app.Use(adaptor.HTTPMiddleware(supertokens.Middleware))
 	app.Use(adaptor.HTTPMiddleware(supertokenClient.VerifySession))
+	// audit log of mutating requests, registered before permissions check to record rejected requests too
+	auditRecorder := audit.NewRecorder(
+		viper.GetInt(config.AuditBufferSizeKey),
+		viper.GetInt(config.AuditBodySizeLimitKey),
+		viper.GetStringSlice(config.TrustedProxiesKey),
+	)
+	go auditRecorder.Run(ctx)
+	app.Use(auditRecorder.Record)
+	// permissions of user role per route and publishers scoping
+	app.Use(rbac.NewEnforcer(viper.GetDuration("rbac.policy_ttl")).Authorize)
 
 	// debug endpoints for profiling
 	debug := app.Group("/debug")
@@ -152,15 +172,24 @@ func ApiCmd(cmd *cobra.Command, args []string) {
 	app.Get("/demand/factor/get/all", rest.DemandFactorGetAllHandler)
 	app.Post("/publisher/demand/get", rest.PublisherDemandGetHandler)
 	app.Post("/publisher/demand/udpate", validations.ValidateBulkPublisherDemands, rest.PublisherDemandUpdate)
-	app.Post("/price/override", validations.ValidatePriceOverride, rest.PriceOverrideHandler)
+
+	// price override
+	app.Post("/price/override", validations.ValidatePriceOverride, omsNP.PriceOverrideHandler)
+	app.Post("/price/override/get", omsNP.PriceOverrideGetHandler)
+	app.Delete("/price/override/delete", omsNP.PriceOverrideDeleteHandler)
 
 	// global factor
-	app.Post("/global/factor", validations.ValidateGlobalFactor, omsNP.GlobalFactorPostHandler)
+	app.Post("/global/factor", validations.ValidateGlobalFactor, omsNP.ChangeApprovalMiddleware, omsNP.GlobalFactorPostHandler)
 	app.Post("/global/factor/get", omsNP.GlobalFactorGetHandler)
 
 	// block
 	app.Post("/block", validations.ValidateBlocks, omsNP.BlockPostHandler)
 	app.Post("/block/get", omsNP.BlockGetAllHandler)
+	app.Post("/block/items/get", omsNP.BlockItemsGetHandler)
+	app.Post("/block/items/add", validations.ValidateBlockItems, omsNP.BlockItemsAddHandler)
+	app.Delete("/block/items/remove", validations.ValidateBlockItemsRemoval, omsNP.BlockItemsRemoveHandler)
+	app.Post("/block/upload", omsNP.BlockUploadHandler)
+	app.Post("/block/taxonomy/get", omsNP.BlockTaxonomyGetHandler)
 
 	// confiant
 	app.Post("/confiant", validations.ValidateConfiant, omsNP.ConfiantPostHandler)
@@ -177,6 +206,15 @@ func ApiCmd(cmd *cobra.Command, args []string) {
 	dp.Post("/set", omsNP.DemandPartnerSetHandler)       // TODO: add validation - validations.ValidateDemandPartner
 	dp.Post("/update", omsNP.DemandPartnerUpdateHandler) // TODO: add validation - validations.ValidateDemandPartner
 	dp.Post("/seat_owner/get", omsNP.DemandPartnerGetSeatOwnersHandler)
+	dp.Post("/approval/get", omsNP.DPApprovalGetHandler)
+	dp.Post("/approval/send", validations.ValidateDPApprovalSend, omsNP.DPApprovalSendHandler)
+	dp.Post("/approval/decide", validations.ValidateDPApprovalDecision, omsNP.DPApprovalDecisionHandler)
+	dp.Post("/bidder/schema/get", omsNP.DPBidderSchemaGetHandler)
+	dp.Post("/bidder/schema/set", validations.ValidateDPBidderSchema, omsNP.DPBidderSchemaSetHandler)
+	dp.Post("/bidder/params/get", omsNP.DPBidderParamsGetHandler)
+	dp.Post("/bidder/params/set", validations.ValidateDPBidderParams, omsNP.DPBidderParamsSetHandler)
+	dp.Post("/bidder/params/delete", validations.ValidateDPBidderParamsDelete, omsNP.DPBidderParamsDeleteHandler)
+	dp.Post("/score/get", omsNP.DPScoreGetHandler)
 
 	// dpo
 	dpoGroup := app.Group("/dpo")
@@ -194,6 +232,7 @@ func ApiCmd(cmd *cobra.Command, args []string) {
 	adsTxtGroup.Post("/cm", omsNP.AdsTxtCMHandler)
 	adsTxtGroup.Post("/mb", omsNP.AdsTxtMBHandler)
 	adsTxtGroup.Post("/update", validations.AdsTxtUpdateValidation, omsNP.AdsTxtUpdateHandler)
+	adsTxtGroup.Post("/file", validations.AdsTxtFileValidation, omsNP.AdsTxtFileHandler)
 
 	// publisher
 	publisher := app.Group("/publisher")
@@ -202,6 +241,9 @@ func ApiCmd(cmd *cobra.Command, args []string) {
 	publisher.Post("/get", omsNP.PublisherGetHandler)
 	publisher.Post("/count", omsNP.PublisherCountHandler)
 	publisher.Post("/details/get", omsNP.PublisherDetailsGetHandler)
+	publisher.Post("/lifecycle/report", validations.ValidatePublisherLifecycleReport, omsNP.PublisherLifecycleReportHandler)
+	publisher.Post("/sync/get", omsNP.PublisherSyncGetHandler)
+	publisher.Post("/sync/resolve", validations.ValidatePublisherSyncResolve, omsNP.PublisherSyncResolveHandler)
 
 	// domain
 	publisher.Post("/domain/get", omsNP.PublisherDomainGetHandler)
@@ -229,19 +271,35 @@ func ApiCmd(cmd *cobra.Command, args []string) {
 	// floor
 	app.Post("/floor/get", omsNP.FloorGetAllHandler)
 	app.Post("/floor", validations.ValidateFloors, omsNP.FloorPostHandler)
-	app.Delete("/floor/delete", omsNP.FloorDeleteHandler)
+	app.Delete("/floor/delete", omsNP.ChangeApprovalMiddleware, omsNP.FloorDeleteHandler)
 
 	// bulk
 	bulkGroup := app.Group("/bulk")
-	bulkGroup.Post("/factor", validations.ValidateBulkFactors, omsNP.FactorBulkPostHandler)
+	bulkGroup.Post("/factor", validations.ValidateBulkFactors, omsNP.ChangeApprovalMiddleware, omsNP.FactorBulkPostHandler)
 	bulkGroup.Post("/floor", validations.ValidateBulkFloor, bulk.FloorBulkPostHandler)
-	bulkGroup.Post("/dpo", validations.ValidateDPOInBulk, omsNP.DemandPartnerOptimizationBulkPostHandler)
+	bulkGroup.Post("/dpo", validations.ValidateDPOInBulk, omsNP.ChangeApprovalMiddleware, omsNP.DemandPartnerOptimizationBulkPostHandler)
 	bulkGroup.Post("/global/factor", validations.ValidateBulkGlobalFactor, omsNP.GlobalFactorBulkPostHandler)
 
 	// adjuster
 	app.Post("/adjust/floor", validations.ValidateAdjusterURL, omsNP.FloorAdjusterHandler)
 	app.Post("/adjust/factor", validations.ValidateAdjusterURL, omsNP.FactorAdjusterHandler)
 
+	// recommendation
+	app.Post("/recommendation/get", validations.ValidateRecommendationOptions, omsNP.RecommendationGetHandler)
+	app.Post("/recommendation/apply", validations.ValidateRecommendationApply, omsNP.RecommendationApplyHandler)
+
+	// automation
+	automationGroup := app.Group("/automation")
+	automationGroup.Post("/circuit_breaker/get", omsNP.CircuitBreakerGetHandler)
+	automationGroup.Post("/circuit_breaker/reset", validations.ValidateCircuitBreakerReset, omsNP.CircuitBreakerResetHandler)
+	automationGroup.Post("/factor/log", omsNP.FactorLogGetHandler)
+	automationGroup.Post("/factor/log/aggregate", omsNP.FactorLogAggregateHandler)
+	automationGroup.Post("/factor/log/timeline", validations.ValidateFactorLogTimeline, omsNP.FactorLogTimelineHandler)
+	automationGroup.Post("/dpo/log", omsNP.DpoLogGetHandler)
+	automationGroup.Post("/dpo/log/aggregate", omsNP.DpoLogAggregateHandler)
+	automationGroup.Post("/dpo/log/timeline", validations.ValidateDpoLogTimeline, omsNP.DpoLogTimelineHandler)
+	automationGroup.Post("/download", validations.ValidateDownload, omsNP.AutomationDownloadHandler)
+
 	// competitor
 	app.Post("/competitor/get", rest.CompetitorGetAllHandler)
 	app.Post("/competitor", validations.ValidateCompetitorURL, rest.CompetitorPostHandler)
@@ -266,6 +324,65 @@ func ApiCmd(cmd *cobra.Command, args []string) {
 	users.Post("/get", omsNP.UserGetHandler)
 	users.Post("/set", validations.ValidateUser, omsNP.UserSetHandler)
 	users.Post("/update", validations.ValidateUser, omsNP.UserUpdateHandler)
+	users.Post("/publisher/get", omsNP.UserPublisherGetHandler)
+	users.Post("/publisher/set", validations.ValidateUserPublishers, omsNP.UserPublisherSetHandler)
+
+	// api keys management (only for users with 'admin' role)
+	apiKeyGroup := app.Group("/api_key", supertokenClient.AdminRoleRequired)
+	apiKeyGroup.Post("/get", omsNP.APIKeyGetHandler)
+	apiKeyGroup.Post("/set", validations.ValidateAPIKey, omsNP.APIKeySetHandler)
+	apiKeyGroup.Post("/update", validations.ValidateAPIKey, omsNP.APIKeyUpdateHandler)
+	apiKeyGroup.Post("/revoke", validations.ValidateAPIKeyRevoke, omsNP.APIKeyRevokeHandler)
+
+	compassManager := app.Group("/compass/manager", supertokenClient.AdminRoleRequired)
+	compassManager.Post("/get", omsNP.CompassManagerGetHandler)
+	compassManager.Post("/set", validations.ValidateCompassManagers, omsNP.CompassManagerSetHandler)
+	compassManager.Post("/delete", validations.ValidateCompassManagersDelete, omsNP.CompassManagerDeleteHandler)
+
+	// publisher portal, publisher users access only publishers assigned to them
+	portal := app.Group("/portal")
+	portal.Post("/report", validations.ValidatePortalReport, omsNP.PortalReportHandler)
+	portal.Post("/domains", validations.ValidatePortal, omsNP.PortalDomainsHandler)
+	portal.Post("/tags", validations.ValidatePortalTags, omsNP.PortalTagsHandler)
+	portal.Post("/statement", validations.ValidatePortalStatement, omsNP.PortalStatementHandler)
+	portal.Post("/notification/get", validations.ValidatePortal, omsNP.PortalNotificationGetHandler)
+	portal.Post("/notification/set", validations.ValidateNotificationPreference, omsNP.PortalNotificationSetHandler)
+
+	// audit log of api requests (only for users with 'admin' role)
+	auditGroup := app.Group("/audit", supertokenClient.AdminRoleRequired)
+	auditGroup.Post("/get", validations.ValidateAuditLog, omsNP.AuditLogGetHandler)
+
+	// change approval (policies management only for users with 'admin' role)
+	approvalGroup := app.Group("/approval")
+	approvalGroup.Post("/request/get", omsNP.ChangeRequestGetHandler)
+	approvalGroup.Post("/request/approve", omsNP.ChangeRequestApproveHandler)
+	approvalGroup.Post("/request/reject", omsNP.ChangeRequestRejectHandler)
+	approvalPolicyGroup := approvalGroup.Group("/policy", supertokenClient.AdminRoleRequired)
+	approvalPolicyGroup.Post("/get", omsNP.ApprovalPolicyGetHandler)
+	approvalPolicyGroup.Post("/set", validations.ValidateApprovalPolicy, omsNP.ApprovalPolicySetHandler)
+	approvalPolicyGroup.Post("/update", validations.ValidateApprovalPolicy, omsNP.ApprovalPolicyUpdateHandler)
+	approvalPolicyGroup.Delete("/delete", omsNP.ApprovalPolicyDeleteHandler)
+
+	// alert (rules management only for users with 'admin' role)
+	alertGroup := app.Group("/alert")
+	alertGroup.Post("/event/get", omsNP.AlertEventGetHandler)
+	alertGroup.Post("/event/acknowledge", validations.ValidateAlertEventAcknowledge, omsNP.AlertEventAcknowledgeHandler)
+	alertGroup.Post("/event/snooze", validations.ValidateAlertEventSnooze, omsNP.AlertEventSnoozeHandler)
+	alertRuleGroup := alertGroup.Group("/rule", supertokenClient.AdminRoleRequired)
+	alertRuleGroup.Post("/get", omsNP.AlertRuleGetHandler)
+	alertRuleGroup.Post("/set", validations.ValidateAlertRule, omsNP.AlertRuleSetHandler)
+	alertRuleGroup.Post("/update", validations.ValidateAlertRule, omsNP.AlertRuleUpdateHandler)
+	alertRuleGroup.Delete("/delete", omsNP.AlertRuleDeleteHandler)
+
+	// sellers.json (publishing only for users with 'admin' role)
+	sellersJSONGroup := app.Group("/sellers_json")
+	sellersJSONGroup.Post("/version/get", omsNP.SellersJSONVersionGetHandler)
+	sellersJSONGroup.Get("/diff", omsNP.SellersJSONDiffHandler)
+	sellersJSONGroup.Post("/publish", supertokenClient.AdminRoleRequired, omsNP.SellersJSONPublishHandler)
+
+	schainGroup := app.Group("/schain")
+	schainGroup.Post("/get", omsNP.SchainComplianceGetHandler)
+	schainGroup.Post("/summary", omsNP.SchainComplianceSummaryHandler)
 
 	// history
 	app.Post("/history/get", omsNP.HistoryGetHandler)
@@ -0,0 +1,161 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/modules/automation"
+	"github.com/m6yf/bcwork/workers/backtest"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// backtestCmd replays historical automation inputs through the automation strategies
+var backtestCmd = &cobra.Command{
+	Use:   "backtest",
+	Short: "Replay factor and DPO automation over a date range",
+	Long: `Replays price_factor_log windows through the factor strategies and hourly demand reports through
+the DPO rules, starting from the actual factors and rules, and exports simulated trajectories with
+estimated GP next to what actually happened. For example:
+
+work backtest --type factor --from 2025-04-01 --to 2025-04-08 --strategy proportional --params '{"gain": 0.5}'
+work backtest --type dpo --from 2025-04-01 --to 2025-04-02 --revenue_threshold 10 --format xlsx`,
+	Run: runBacktest,
+}
+
+func init() {
+	rootCmd.AddCommand(backtestCmd)
+
+	flags := backtestCmd.Flags()
+	flags.StringSlice("type", []string{backtest.FactorType, backtest.DpoType}, "automations to replay: factor, dpo")
+	flags.String("from", "", "start of the range, date or RFC3339 time (mandatory)")
+	flags.String("to", "", "end of the range (exclusive), date or RFC3339 time, default is now")
+	flags.StringSlice("domain", nil, "domains to replay, default is all")
+	flags.String("format", dto.CSV, "output file format: csv, xlsx")
+	flags.String("output", ".", "output directory")
+
+	flags.String("strategy", "", "factor strategy replacing the configured strategy of all domains")
+	flags.String("params", "", "json parameters of the factor strategy")
+	flags.Float64("gpp_target", 0, "default gpp target in percents, default is the system configuration")
+	flags.Float64("min_factor", 0.5, "minimal factor")
+	flags.Float64("max_factor", 10, "maximal factor")
+	flags.Float64("stoploss", -10, "GP of a window which resets factor to default factor")
+	flags.Float64("default_factor", 0.75, "factor set on stop loss")
+	flags.Float64("elasticity", dto.DefaultRecommendationElasticity, "sold impressions change per factor change")
+
+	flags.Float64("revenue_threshold", 5, "DPO revenue threshold")
+	flags.Float64("dp_revenue_threshold", 0.05, "DPO demand partner revenue share threshold")
+	flags.Float64("placement_revenue_threshold", 0.015, "DPO placement revenue share threshold")
+	flags.Float64("dpo_margin", 0.3, "share of demand partner revenue which is gross profit before tech fee")
+}
+
+func runBacktest(cmd *cobra.Command, args []string) {
+	initLogger("backtest")
+
+	opts, output, err := parseBacktestFlags(cmd)
+	if err != nil {
+		log.Fatal().Err(err).Msg("invalid backtest flags")
+	}
+
+	dbEnv := viper.GetString("database.env")
+	if dbEnv == "" {
+		dbEnv = "local_prod"
+	}
+	err = bcdb.InitDB(dbEnv)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to initialize Postgres DB")
+	}
+
+	reports, err := backtest.Run(context.Background(), opts)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to run backtest")
+	}
+
+	for _, report := range reports {
+		filename := filepath.Join(output, fmt.Sprintf("backtest_%v_%v_%v.%v",
+			report.Type, opts.From.Format(time.DateOnly), opts.To.Format(time.DateOnly), opts.Format))
+
+		err = os.WriteFile(filename, report.Data, 0644)
+		if err != nil {
+			log.Fatal().Err(err).Msgf("failed to write %v", filename)
+		}
+		log.Info().Msgf("%v backtest written to %v", report.Type, filename)
+	}
+}
+
+func parseBacktestFlags(cmd *cobra.Command) (backtest.Options, string, error) {
+	flags := cmd.Flags()
+	opts := backtest.Options{}
+
+	var err error
+	opts.Types, _ = flags.GetStringSlice("type")
+	opts.Domains, _ = flags.GetStringSlice("domain")
+
+	from, _ := flags.GetString("from")
+	opts.From, err = parseBacktestTime(from)
+	if err != nil {
+		return opts, "", fmt.Errorf("invalid from: %w", err)
+	}
+
+	opts.To = time.Now().UTC()
+	if to, _ := flags.GetString("to"); to != "" {
+		opts.To, err = parseBacktestTime(to)
+		if err != nil {
+			return opts, "", fmt.Errorf("invalid to: %w", err)
+		}
+	}
+
+	format, _ := flags.GetString("format")
+	opts.Format = dto.DownloadFormat(format)
+	if opts.Format != dto.CSV && opts.Format != dto.XLSX {
+		return opts, "", fmt.Errorf("unknown format [%v]", format)
+	}
+
+	opts.Factor.Strategy, _ = flags.GetString("strategy")
+	if params, _ := flags.GetString("params"); params != "" {
+		if !json.Valid([]byte(params)) {
+			return opts, "", fmt.Errorf("params [%v] is not a valid json", params)
+		}
+		opts.Factor.Params = json.RawMessage(params)
+	}
+	if opts.Factor.Strategy != "" {
+		err = automation.Validate(opts.Factor.Strategy, opts.Factor.Params)
+		if err != nil {
+			return opts, "", err
+		}
+	}
+	if flags.Changed("gpp_target") {
+		gppTarget, _ := flags.GetFloat64("gpp_target")
+		opts.Factor.GppTarget = &gppTarget
+	}
+	opts.Factor.MinFactor, _ = flags.GetFloat64("min_factor")
+	opts.Factor.MaxFactor, _ = flags.GetFloat64("max_factor")
+	opts.Factor.StopLoss, _ = flags.GetFloat64("stoploss")
+	opts.Factor.DefaultFactor, _ = flags.GetFloat64("default_factor")
+	opts.Factor.Elasticity, _ = flags.GetFloat64("elasticity")
+
+	opts.Dpo.RevenueThreshold, _ = flags.GetFloat64("revenue_threshold")
+	opts.Dpo.DpRevenueThreshold, _ = flags.GetFloat64("dp_revenue_threshold")
+	opts.Dpo.PlacementRevenueThreshold, _ = flags.GetFloat64("placement_revenue_threshold")
+	opts.Dpo.Margin, _ = flags.GetFloat64("dpo_margin")
+
+	output, _ := flags.GetString("output")
+
+	return opts, output, nil
+}
+
+func parseBacktestTime(value string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, value)
+	if err == nil {
+		return t.UTC(), nil
+	}
+
+	return time.Parse(time.DateOnly, value)
+}
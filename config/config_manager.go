@@ -20,7 +20,6 @@ const (
 	DaysBeforeKey       = "days_before"
 	BaseURLKey          = "base_url"
 	TestCasesPathKey    = "test_cases"
-	ManagersMapPathKey  = "managers_map"
 	QuestKey            = "quest"
 	SkipInitRunKey      = "skip_init_run"
 	ChunkSizeKey        = "chunk_size"
@@ -29,12 +28,19 @@ const (
 	EmailBCCKey         = "email_bcc"
 	CreateAdsTxtLineKey = "create_ads_txt_lines"
 
-	APIChunkSizeKey             = "api.chunkSize"
-	CronWorkerAPIKeyKey         = "cron_worker_api_key" //nolint:gosec
-	AWSWorkerAPIKeyKey          = "aws_worker_api_key"  //nolint:gosec
-	LogSizeLimitKey             = "log_size_limit"
-	SearchViewUpdateRateKey     = "search_view_update_rate"
-	AdsTxtMetadataUpdateRateKey = "ads_txt_metadata_update_rate"
+	APIChunkSizeKey              = "api.chunkSize"
+	CronWorkerAPIKeyKey          = "cron_worker_api_key" //nolint:gosec
+	AWSWorkerAPIKeyKey           = "aws_worker_api_key"  //nolint:gosec
+	LogSizeLimitKey              = "log_size_limit"
+	SearchViewUpdateRateKey      = "search_view_update_rate"
+	AdsTxtMetadataUpdateRateKey  = "ads_txt_metadata_update_rate"
+	AdsTxtManagerDomainKey       = "ads_txt_manager_domain"
+	SellersJSONContactEmailKey   = "sellers_json_contact_email"
+	SellersJSONContactAddressKey = "sellers_json_contact_address"
+	SellersJSONTagIDKey          = "sellers_json_tag_id"
+	AuditBufferSizeKey           = "audit_buffer_size"
+	AuditBodySizeLimitKey        = "audit_body_size_limit"
+	TrustedProxiesKey            = "trusted_proxies"
 	// compass
 	CompassModuleKey = "compassModule"
 	CompassURLKey    = "compassURL"
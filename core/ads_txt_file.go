@@ -0,0 +1,148 @@
+package core
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/config"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/models"
+	adstxt "github.com/m6yf/bcwork/modules/ads_txt"
+	"github.com/rotisserie/eris"
+	"github.com/spf13/viper"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"golang.org/x/net/publicsuffix"
+)
+
+// adsTxtRequiredDemandStatuses are demand statuses of lines publisher has to keep in its files.
+// Crawler pauses approved lines missing from the live file, they stay required until publisher adds them back.
+var adsTxtRequiredDemandStatuses = []string{dto.DPStatusApproved, dto.DPStatusApprovedPaused}
+
+// GetAdsTxtFile renders recommended ads.txt or app-ads.txt of the domain from its approved lines.
+// Returns empty content when the domain has no lines.
+func (a *AdsTxtService) GetAdsTxtFile(ctx context.Context, data *dto.AdsTxtFileRequest) (string, error) {
+	domain := strings.ToLower(strings.TrimSpace(data.Domain))
+
+	mods := []qm.QueryMod{
+		models.AdsTXTMainViewWhere.Domain.EQ(null.StringFrom(domain)),
+		models.AdsTXTMainViewWhere.IsDemandPartnerActive.EQ(null.BoolFrom(true)),
+	}
+	if data.PublisherID != "" {
+		mods = append(mods, models.AdsTXTMainViewWhere.PublisherID.EQ(null.StringFrom(data.PublisherID)))
+	}
+
+	views, err := models.AdsTXTMainViews(mods...).All(ctx, bcdb.DB())
+	if err != nil {
+		return "", eris.Wrapf(err, "failed to retrieve ads.txt lines of domain [%v]", domain)
+	}
+
+	lines := getAdsTxtFileLines(views, data.FileName)
+	if len(lines) == 0 {
+		return "", nil
+	}
+
+	ownerDomain, err := getAdsTxtOwnerDomain(ctx, domain, data.FileName)
+	if err != nil {
+		return "", err
+	}
+
+	return adstxt.Render(adstxt.FileOptions{
+		Domain:        domain,
+		FileName:      data.FileName,
+		OwnerDomain:   ownerDomain,
+		ManagerDomain: viper.GetString(config.AdsTxtManagerDomainKey),
+		Lines:         lines,
+	}), nil
+}
+
+// getAdsTxtFileLines returns required lines of the file ordered by group: seat owner lines first,
+// then lines of demand partners with their children
+func getAdsTxtFileLines(views models.AdsTXTMainViewSlice, fileName string) []*adstxt.FileLine {
+	type fileLine struct {
+		isSeatOwner bool
+		line        *adstxt.FileLine
+	}
+
+	lines := make([]*fileLine, 0, len(views))
+	for _, view := range views {
+		if !view.AdsTXTLine.Valid || !isAdsTxtFileMediaType(view.MediaType, fileName) ||
+			!slices.Contains(adsTxtRequiredDemandStatuses, view.DemandStatus.String) {
+			continue
+		}
+
+		isSeatOwner := view.DemandPartnerID.String == ""
+		group := view.DemandPartnerName.String
+		if isSeatOwner {
+			group = view.DemandPartnerNameExtended.String
+		}
+
+		lines = append(lines, &fileLine{
+			isSeatOwner: isSeatOwner,
+			line:        &adstxt.FileLine{Group: group, Line: view.AdsTXTLine.String},
+		})
+	}
+
+	sort.SliceStable(lines, func(i, j int) bool {
+		if lines[i].isSeatOwner != lines[j].isSeatOwner {
+			return lines[i].isSeatOwner
+		}
+		if lines[i].line.Group != lines[j].line.Group {
+			return lines[i].line.Group < lines[j].line.Group
+		}
+
+		return lines[i].line.Line < lines[j].line.Line
+	})
+
+	result := make([]*adstxt.FileLine, 0, len(lines))
+	for _, line := range lines {
+		result = append(result, line.line)
+	}
+
+	return result
+}
+
+// isAdsTxtFileMediaType reports whether line of the media types belongs to the file:
+// in-app lines go to app-ads.txt, other lines go to ads.txt, lines without media type go to both
+func isAdsTxtFileMediaType(mediaTypes []string, fileName string) bool {
+	if len(mediaTypes) == 0 {
+		return true
+	}
+
+	if fileName == dto.AppAdsTxtFileName {
+		return slices.Contains(mediaTypes, dto.InAppMediaType)
+	}
+
+	return slices.ContainsFunc(mediaTypes, func(mediaType string) bool {
+		return mediaType != dto.InAppMediaType
+	})
+}
+
+// getAdsTxtOwnerDomain returns OWNERDOMAIN declared by the domain file when it was scanned,
+// otherwise the root domain
+func getAdsTxtOwnerDomain(ctx context.Context, domain, fileName string) (string, error) {
+	scan, err := models.AdsTXTScans(
+		models.AdsTXTScanWhere.Domain.EQ(domain),
+		models.AdsTXTScanWhere.FileName.EQ(fileName),
+		models.AdsTXTScanWhere.OwnerDomain.IsNotNull(),
+		qm.OrderBy(models.AdsTXTScanColumns.ScannedAt+" desc"),
+	).One(ctx, bcdb.DB())
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return "", eris.Wrapf(err, "failed to retrieve ads.txt scan of domain [%v]", domain)
+	}
+	if scan != nil {
+		return scan.OwnerDomain.String, nil
+	}
+
+	root, err := publicsuffix.EffectiveTLDPlusOne(domain)
+	if err != nil {
+		return domain, nil
+	}
+
+	return root, nil
+}
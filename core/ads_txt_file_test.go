@@ -0,0 +1,49 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/models"
+	adstxt "github.com/m6yf/bcwork/modules/ads_txt"
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v8"
+)
+
+func Test_getAdsTxtFileLines(t *testing.T) {
+	t.Parallel()
+
+	views := models.AdsTXTMainViewSlice{
+		{
+			DemandPartnerID:   null.StringFrom("dp"),
+			DemandPartnerName: null.StringFrom("DP"),
+			AdsTXTLine:        null.StringFrom("dp.com, 1, DIRECT"),
+			DemandStatus:      null.StringFrom(dto.DPStatusApproved),
+		},
+		{
+			// paused by crawler as publisher dropped it from the live file
+			DemandPartnerNameExtended: null.StringFrom("OMS - Direct"),
+			AdsTXTLine:                null.StringFrom("onlinemediasolutions.com, 2, DIRECT"),
+			DemandStatus:              null.StringFrom(dto.DPStatusApprovedPaused),
+		},
+		{
+			DemandPartnerID:   null.StringFrom("dp"),
+			DemandPartnerName: null.StringFrom("DP"),
+			AdsTXTLine:        null.StringFrom("dp.com, 3, RESELLER"),
+			DemandStatus:      null.StringFrom(dto.DPStatusPending),
+		},
+		{
+			DemandPartnerID:   null.StringFrom("dp"),
+			DemandPartnerName: null.StringFrom("DP"),
+			AdsTXTLine:        null.StringFrom("dp.com, 4, DIRECT"),
+			DemandStatus:      null.StringFrom(dto.DPStatusApproved),
+			MediaType:         []string{dto.InAppMediaType},
+		},
+	}
+
+	got := getAdsTxtFileLines(views, dto.AdsTxtFileName)
+	assert.Equal(t, []*adstxt.FileLine{
+		{Group: "OMS - Direct", Line: "onlinemediasolutions.com, 2, DIRECT"},
+		{Group: "DP", Line: "dp.com, 1, DIRECT"},
+	}, got)
+}
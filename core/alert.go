@@ -0,0 +1,276 @@
+package core
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/bcdb/filter"
+	"github.com/m6yf/bcwork/bcdb/order"
+	"github.com/m6yf/bcwork/bcdb/pagination"
+	"github.com/m6yf/bcwork/bcdb/qmods"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/models"
+	"github.com/m6yf/bcwork/modules/history"
+	"github.com/m6yf/bcwork/utils/constant"
+	"github.com/rotisserie/eris"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type AlertService struct {
+	historyModule history.HistoryModule
+}
+
+func NewAlertService(historyModule history.HistoryModule) *AlertService {
+	return &AlertService{
+		historyModule: historyModule,
+	}
+}
+
+type GetAlertRuleOptions struct {
+	Filter     AlertRuleFilter        `json:"filter"`
+	Pagination *pagination.Pagination `json:"pagination"`
+	Order      order.Sort             `json:"order"`
+	Selector   string                 `json:"selector"`
+}
+
+type AlertRuleFilter struct {
+	ID       filter.IntArrayFilter    `json:"id,omitempty"`
+	Name     filter.StringArrayFilter `json:"name,omitempty"`
+	Severity filter.StringArrayFilter `json:"severity,omitempty"`
+	Active   *filter.BoolFilter       `json:"active,omitempty"`
+}
+
+type GetAlertEventOptions struct {
+	Filter     AlertEventFilter       `json:"filter"`
+	Pagination *pagination.Pagination `json:"pagination"`
+	Order      order.Sort             `json:"order"`
+	Selector   string                 `json:"selector"`
+}
+
+type AlertEventFilter struct {
+	ID          filter.IntArrayFilter    `json:"id,omitempty"`
+	RuleID      filter.IntArrayFilter    `json:"rule_id,omitempty"`
+	Key         filter.StringArrayFilter `json:"key,omitempty"`
+	Status      filter.StringArrayFilter `json:"status,omitempty"`
+	Severity    filter.StringArrayFilter `json:"severity,omitempty"`
+	FirstSeenAt *filter.DatesFilter      `json:"first_seen_at,omitempty"`
+}
+
+func (a *AlertService) GetAlertRules(ctx context.Context, ops *GetAlertRuleOptions) ([]*dto.AlertRule, error) {
+	qmods := ops.Filter.queryMod().
+		Order(ops.Order, nil, models.AlertRuleColumns.ID).
+		AddArray(ops.Pagination.Do())
+
+	mods, err := models.AlertRules(qmods...).All(ctx, bcdb.DB())
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, eris.Wrap(err, "failed to retrieve alert rules")
+	}
+
+	rules := make([]*dto.AlertRule, 0, len(mods))
+	for _, mod := range mods {
+		rule := &dto.AlertRule{}
+		rule.FromModel(mod)
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+func (a *AlertService) CreateAlertRule(ctx context.Context, data *dto.AlertRule) error {
+	mod := data.ToModel()
+	mod.CreatedAt = time.Now().UTC()
+
+	err := mod.Insert(ctx, bcdb.DB(), boil.Infer())
+	if err != nil {
+		return eris.Wrap(err, "failed to create alert rule")
+	}
+
+	a.historyModule.SaveAction(ctx, nil, mod, &history.HistoryOptions{Subject: history.AlertRuleSubject})
+
+	return nil
+}
+
+func (a *AlertService) UpdateAlertRule(ctx context.Context, data *dto.AlertRule) error {
+	mod, err := models.FindAlertRule(ctx, bcdb.DB(), data.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("alert rule [%v] not found", data.ID)
+		}
+		return eris.Wrap(err, "failed to retrieve alert rule")
+	}
+
+	oldMod := *mod
+
+	newMod := data.ToModel()
+	newMod.LastEvaluatedAt = mod.LastEvaluatedAt
+	newMod.CreatedAt = mod.CreatedAt
+	newMod.UpdatedAt = null.TimeFrom(time.Now().UTC())
+
+	_, err = newMod.Update(ctx, bcdb.DB(), boil.Infer())
+	if err != nil {
+		return eris.Wrap(err, "failed to update alert rule")
+	}
+
+	a.historyModule.SaveAction(ctx, &oldMod, newMod, &history.HistoryOptions{Subject: history.AlertRuleSubject})
+
+	return nil
+}
+
+// DeleteAlertRules deletes alert rules together with their events
+func (a *AlertService) DeleteAlertRules(ctx context.Context, ids []int) error {
+	mods, err := models.AlertRules(models.AlertRuleWhere.ID.IN(ids)).All(ctx, bcdb.DB())
+	if err != nil {
+		return eris.Wrap(err, "failed to retrieve alert rules for deletion")
+	}
+
+	tx, err := bcdb.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return eris.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	_, err = models.AlertEvents(models.AlertEventWhere.RuleID.IN(ids)).DeleteAll(ctx, tx)
+	if err != nil {
+		return eris.Wrap(err, "failed to delete alert events")
+	}
+
+	_, err = mods.DeleteAll(ctx, tx)
+	if err != nil {
+		return eris.Wrap(err, "failed to delete alert rules")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return eris.Wrap(err, "failed to commit alert rules deletion")
+	}
+
+	oldMods := make([]any, 0, len(mods))
+	newMods := make([]any, 0, len(mods))
+	for _, mod := range mods {
+		oldMods = append(oldMods, mod)
+		newMods = append(newMods, nil)
+	}
+
+	a.historyModule.SaveAction(ctx, oldMods, newMods, &history.HistoryOptions{Subject: history.AlertRuleSubject, IsMultipleValuesExpected: true})
+
+	return nil
+}
+
+func (a *AlertService) GetAlertEvents(ctx context.Context, ops *GetAlertEventOptions) ([]*dto.AlertEvent, error) {
+	qmods := ops.Filter.queryMod().
+		Order(ops.Order, nil, models.AlertEventColumns.ID+" DESC").
+		AddArray(ops.Pagination.Do())
+
+	mods, err := models.AlertEvents(qmods...).All(ctx, bcdb.DB())
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, eris.Wrap(err, "failed to retrieve alert events")
+	}
+
+	events := make([]*dto.AlertEvent, 0, len(mods))
+	for _, mod := range mods {
+		event := &dto.AlertEvent{}
+		event.FromModel(mod)
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// AcknowledgeAlertEvents marks firing events as handled, acknowledged events are not notified again
+func (a *AlertService) AcknowledgeAlertEvents(ctx context.Context, ids []int) error {
+	userID, isUserKnown := ctx.Value(constant.UserIDContextKey).(int)
+	now := time.Now().UTC()
+
+	_, err := models.AlertEvents(
+		models.AlertEventWhere.ID.IN(ids),
+		models.AlertEventWhere.Status.EQ(dto.AlertEventStatusFiring),
+	).UpdateAll(ctx, bcdb.DB(), models.M{
+		models.AlertEventColumns.AcknowledgedBy: null.NewInt(userID, isUserKnown),
+		models.AlertEventColumns.AcknowledgedAt: null.TimeFrom(now),
+		models.AlertEventColumns.UpdatedAt:      null.TimeFrom(now),
+	})
+	if err != nil {
+		return eris.Wrap(err, "failed to acknowledge alert events")
+	}
+
+	return nil
+}
+
+// SnoozeAlertEvents postpones notifications of firing events until the given time
+func (a *AlertService) SnoozeAlertEvents(ctx context.Context, ids []int, until time.Time) error {
+	_, err := models.AlertEvents(
+		models.AlertEventWhere.ID.IN(ids),
+		models.AlertEventWhere.Status.EQ(dto.AlertEventStatusFiring),
+	).UpdateAll(ctx, bcdb.DB(), models.M{
+		models.AlertEventColumns.SnoozedUntil: null.TimeFrom(until.UTC()),
+		models.AlertEventColumns.UpdatedAt:    null.TimeFrom(time.Now().UTC()),
+	})
+	if err != nil {
+		return eris.Wrap(err, "failed to snooze alert events")
+	}
+
+	return nil
+}
+
+func (filter *AlertRuleFilter) queryMod() qmods.QueryModsSlice {
+	mods := make(qmods.QueryModsSlice, 0)
+	if filter == nil {
+		return mods
+	}
+
+	if len(filter.ID) > 0 {
+		mods = append(mods, filter.ID.AndIn(models.AlertRuleColumns.ID))
+	}
+
+	if len(filter.Name) > 0 {
+		mods = append(mods, filter.Name.AndIn(models.AlertRuleColumns.Name))
+	}
+
+	if len(filter.Severity) > 0 {
+		mods = append(mods, filter.Severity.AndIn(models.AlertRuleColumns.Severity))
+	}
+
+	if filter.Active != nil {
+		mods = append(mods, filter.Active.Where(models.AlertRuleColumns.Active))
+	}
+
+	return mods
+}
+
+func (filter *AlertEventFilter) queryMod() qmods.QueryModsSlice {
+	mods := make(qmods.QueryModsSlice, 0)
+	if filter == nil {
+		return mods
+	}
+
+	if len(filter.ID) > 0 {
+		mods = append(mods, filter.ID.AndIn(models.AlertEventColumns.ID))
+	}
+
+	if len(filter.RuleID) > 0 {
+		mods = append(mods, filter.RuleID.AndIn(models.AlertEventColumns.RuleID))
+	}
+
+	if len(filter.Key) > 0 {
+		mods = append(mods, filter.Key.AndIn(models.AlertEventColumns.Key))
+	}
+
+	if len(filter.Status) > 0 {
+		mods = append(mods, filter.Status.AndIn(models.AlertEventColumns.Status))
+	}
+
+	if len(filter.Severity) > 0 {
+		mods = append(mods, filter.Severity.AndIn(models.AlertEventColumns.Severity))
+	}
+
+	if filter.FirstSeenAt != nil {
+		mods = append(mods, filter.FirstSeenAt.AndIn(models.AlertEventColumns.FirstSeenAt))
+	}
+
+	return mods
+}
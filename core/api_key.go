@@ -0,0 +1,256 @@
+package core
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/bcdb/filter"
+	"github.com/m6yf/bcwork/bcdb/order"
+	"github.com/m6yf/bcwork/bcdb/pagination"
+	"github.com/m6yf/bcwork/bcdb/qmods"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/models"
+	"github.com/m6yf/bcwork/modules/apikey"
+	"github.com/m6yf/bcwork/modules/history"
+	"github.com/m6yf/bcwork/modules/logger"
+	supertokens_module "github.com/m6yf/bcwork/modules/supertokens"
+	"github.com/m6yf/bcwork/utils/constant"
+	"github.com/rotisserie/eris"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// lastUsedUpdateInterval limits updates of last usage to one per interval for each key
+const lastUsedUpdateInterval = time.Minute
+
+var errInvalidAPIKey = errors.New("invalid api key")
+
+type APIKeyService struct {
+	historyModule history.HistoryModule
+}
+
+var _ supertokens_module.APIKeyVerifier = (*APIKeyService)(nil)
+
+func NewAPIKeyService(historyModule history.HistoryModule) *APIKeyService {
+	return &APIKeyService{
+		historyModule: historyModule,
+	}
+}
+
+type GetAPIKeyOptions struct {
+	Filter     APIKeyFilter           `json:"filter"`
+	Pagination *pagination.Pagination `json:"pagination"`
+	Order      order.Sort             `json:"order"`
+	Selector   string                 `json:"selector"`
+}
+
+type APIKeyFilter struct {
+	ID      filter.IntArrayFilter    `json:"id,omitempty"`
+	Name    filter.StringArrayFilter `json:"name,omitempty"`
+	OwnerID filter.IntArrayFilter    `json:"owner_id,omitempty"`
+}
+
+func (a *APIKeyService) GetAPIKeys(ctx context.Context, ops *GetAPIKeyOptions) ([]*dto.APIKey, error) {
+	qmods := ops.Filter.queryMod().
+		Order(ops.Order, nil, models.APIKeyColumns.ID).
+		AddArray(ops.Pagination.Do())
+
+	mods, err := models.APIKeys(qmods...).All(ctx, bcdb.DB())
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, eris.Wrap(err, "failed to retrieve api keys")
+	}
+
+	keys := make([]*dto.APIKey, 0, len(mods))
+	for _, mod := range mods {
+		key := &dto.APIKey{}
+		key.FromModel(mod)
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// CreateAPIKey issues new key, only its hash is stored so the key is returned once
+func (a *APIKeyService) CreateAPIKey(ctx context.Context, data *dto.APIKey) (*dto.APIKeyCreateResponse, error) {
+	key, keyPrefix, keyHash, err := apikey.Generate()
+	if err != nil {
+		return nil, err
+	}
+
+	userID, _ := ctx.Value(constant.UserIDContextKey).(int)
+	mod := &models.APIKey{
+		Name:       data.Name,
+		KeyPrefix:  keyPrefix,
+		KeyHash:    keyHash,
+		OwnerID:    data.OwnerID,
+		Scopes:     data.Scopes,
+		AllowedIps: data.AllowedIPs,
+		ExpiresAt:  null.TimeFromPtr(data.ExpiresAt),
+		CreatedBy:  userID,
+		CreatedAt:  time.Now().UTC(),
+	}
+
+	err = mod.Insert(ctx, bcdb.DB(), boil.Infer())
+	if err != nil {
+		return nil, eris.Wrap(err, "failed to create api key")
+	}
+
+	a.historyModule.SaveAction(ctx, nil, withoutKeyHash(mod), &history.HistoryOptions{Subject: history.APIKeySubject})
+
+	resp := &dto.APIKeyCreateResponse{Key: key}
+	resp.FromModel(mod)
+
+	return resp, nil
+}
+
+// UpdateAPIKey updates name, scopes, allowed ips and expiration of the key, owner can't be changed
+func (a *APIKeyService) UpdateAPIKey(ctx context.Context, data *dto.APIKey) error {
+	mod, err := models.FindAPIKey(ctx, bcdb.DB(), data.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("api key [%v] not found", data.ID)
+		}
+		return eris.Wrap(err, "failed to retrieve api key")
+	}
+
+	if mod.RevokedAt.Valid {
+		return fmt.Errorf("api key [%v] is revoked", data.ID)
+	}
+
+	oldMod := withoutKeyHash(mod)
+
+	mod.Name = data.Name
+	mod.Scopes = data.Scopes
+	mod.AllowedIps = data.AllowedIPs
+	mod.ExpiresAt = null.TimeFromPtr(data.ExpiresAt)
+	mod.UpdatedAt = null.TimeFrom(time.Now().UTC())
+
+	_, err = mod.Update(ctx, bcdb.DB(), boil.Whitelist(
+		models.APIKeyColumns.Name,
+		models.APIKeyColumns.Scopes,
+		models.APIKeyColumns.AllowedIps,
+		models.APIKeyColumns.ExpiresAt,
+		models.APIKeyColumns.UpdatedAt,
+	))
+	if err != nil {
+		return eris.Wrap(err, "failed to update api key")
+	}
+
+	a.historyModule.SaveAction(ctx, oldMod, withoutKeyHash(mod), &history.HistoryOptions{Subject: history.APIKeySubject})
+
+	return nil
+}
+
+// RevokeAPIKey permanently disables the key, it's kept for history attribution
+func (a *APIKeyService) RevokeAPIKey(ctx context.Context, id int) error {
+	mod, err := models.FindAPIKey(ctx, bcdb.DB(), id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("api key [%v] not found", id)
+		}
+		return eris.Wrap(err, "failed to retrieve api key")
+	}
+
+	if mod.RevokedAt.Valid {
+		return nil
+	}
+
+	oldMod := withoutKeyHash(mod)
+
+	now := time.Now().UTC()
+	mod.RevokedAt = null.TimeFrom(now)
+	mod.UpdatedAt = null.TimeFrom(now)
+
+	_, err = mod.Update(ctx, bcdb.DB(), boil.Whitelist(models.APIKeyColumns.RevokedAt, models.APIKeyColumns.UpdatedAt))
+	if err != nil {
+		return eris.Wrap(err, "failed to revoke api key")
+	}
+
+	a.historyModule.SaveAction(ctx, oldMod, withoutKeyHash(mod), &history.HistoryOptions{Subject: history.APIKeySubject})
+
+	return nil
+}
+
+// VerifyAPIKey authenticates request by the key and returns its owner with scopes of the key
+func (a *APIKeyService) VerifyAPIKey(ctx context.Context, key, ip string) (*supertokens_module.APIKeyIdentity, error) {
+	if !apikey.IsIssued(key) {
+		return nil, errInvalidAPIKey
+	}
+
+	mod, err := models.APIKeys(models.APIKeyWhere.KeyHash.EQ(apikey.Hash(key))).One(ctx, bcdb.DB())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errInvalidAPIKey
+		}
+		return nil, eris.Wrap(err, "failed to retrieve api key")
+	}
+
+	now := time.Now().UTC()
+	switch {
+	case mod.RevokedAt.Valid:
+		return nil, fmt.Errorf("api key [%v] is revoked", mod.KeyPrefix)
+	case mod.ExpiresAt.Valid && !mod.ExpiresAt.Time.After(now):
+		return nil, fmt.Errorf("api key [%v] is expired", mod.KeyPrefix)
+	case !apikey.IsAllowedIP(mod.AllowedIps, ip):
+		return nil, fmt.Errorf("ip [%v] is not allowed for api key [%v]", ip, mod.KeyPrefix)
+	}
+
+	owner, err := models.FindUser(ctx, bcdb.DB(), mod.OwnerID)
+	if err != nil {
+		return nil, eris.Wrapf(err, "failed to retrieve owner of api key [%v]", mod.KeyPrefix)
+	}
+
+	if !owner.Enabled {
+		return nil, fmt.Errorf("owner of api key [%v] is disabled", mod.KeyPrefix)
+	}
+
+	if !mod.LastUsedAt.Valid || now.Sub(mod.LastUsedAt.Time) >= lastUsedUpdateInterval || mod.LastUsedIP.String != ip {
+		mod.LastUsedAt = null.TimeFrom(now)
+		mod.LastUsedIP = null.StringFrom(ip)
+		_, err := mod.Update(ctx, bcdb.DB(), boil.Whitelist(models.APIKeyColumns.LastUsedAt, models.APIKeyColumns.LastUsedIP))
+		if err != nil {
+			logger.Logger(ctx).Warn().Err(err).Msgf("failed to update last usage of api key [%v]", mod.KeyPrefix)
+		}
+	}
+
+	return &supertokens_module.APIKeyIdentity{
+		ID:      mod.ID,
+		OwnerID: owner.ID,
+		Email:   owner.Email,
+		Role:    owner.Role,
+		Scopes:  mod.Scopes,
+	}, nil
+}
+
+func (filter *APIKeyFilter) queryMod() qmods.QueryModsSlice {
+	mods := make(qmods.QueryModsSlice, 0)
+	if filter == nil {
+		return mods
+	}
+
+	if len(filter.ID) > 0 {
+		mods = append(mods, filter.ID.AndIn(models.APIKeyColumns.ID))
+	}
+
+	if len(filter.Name) > 0 {
+		mods = append(mods, filter.Name.AndIn(models.APIKeyColumns.Name))
+	}
+
+	if len(filter.OwnerID) > 0 {
+		mods = append(mods, filter.OwnerID.AndIn(models.APIKeyColumns.OwnerID))
+	}
+
+	return mods
+}
+
+// withoutKeyHash returns copy of the key safe to be saved in history
+func withoutKeyHash(mod *models.APIKey) *models.APIKey {
+	clone := *mod
+	clone.KeyHash = ""
+
+	return &clone
+}
@@ -0,0 +1,367 @@
+package approval
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/bcdb/filter"
+	"github.com/m6yf/bcwork/bcdb/order"
+	"github.com/m6yf/bcwork/bcdb/pagination"
+	"github.com/m6yf/bcwork/bcdb/qmods"
+	"github.com/m6yf/bcwork/core"
+	"github.com/m6yf/bcwork/core/bulk"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/models"
+	"github.com/m6yf/bcwork/modules/history"
+	"github.com/m6yf/bcwork/modules/messager"
+	supertokens_module "github.com/m6yf/bcwork/modules/supertokens"
+	"github.com/m6yf/bcwork/utils/constant"
+	"github.com/rotisserie/eris"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type ChangeApprovalService struct {
+	historyModule       history.HistoryModule
+	bulkService         bulk.Bulker
+	globalFactorService *core.GlobalFactorService
+	routes              map[string]approvalRoute
+	slack               messager.Messager
+	slackMu             sync.Mutex
+}
+
+func NewChangeApprovalService(
+	historyModule history.HistoryModule,
+	bulkService bulk.Bulker,
+	globalFactorService *core.GlobalFactorService,
+) *ChangeApprovalService {
+	service := &ChangeApprovalService{
+		historyModule:       historyModule,
+		bulkService:         bulkService,
+		globalFactorService: globalFactorService,
+	}
+	service.routes = service.buildRoutes()
+
+	return service
+}
+
+type GetApprovalPolicyOptions struct {
+	Filter     ApprovalPolicyFilter   `json:"filter"`
+	Pagination *pagination.Pagination `json:"pagination"`
+	Order      order.Sort             `json:"order"`
+	Selector   string                 `json:"selector"`
+}
+
+type ApprovalPolicyFilter struct {
+	ID      filter.IntArrayFilter    `json:"id,omitempty"`
+	Subject filter.StringArrayFilter `json:"subject,omitempty"`
+	Active  *filter.BoolFilter       `json:"active,omitempty"`
+}
+
+type GetChangeRequestOptions struct {
+	Filter     ChangeRequestFilter    `json:"filter"`
+	Pagination *pagination.Pagination `json:"pagination"`
+	Order      order.Sort             `json:"order"`
+	Selector   string                 `json:"selector"`
+}
+
+type ChangeRequestFilter struct {
+	ID          filter.IntArrayFilter    `json:"id,omitempty"`
+	PolicyID    filter.IntArrayFilter    `json:"policy_id,omitempty"`
+	Subject     filter.StringArrayFilter `json:"subject,omitempty"`
+	Status      filter.StringArrayFilter `json:"status,omitempty"`
+	RequesterID filter.IntArrayFilter    `json:"requester_id,omitempty"`
+	ApproverID  filter.IntArrayFilter    `json:"approver_id,omitempty"`
+}
+
+// SubmitIfRequired checks whether the change sent to route is covered by an active approval policy.
+// If so, the change is stored as pending request and returned, otherwise nil means it could be applied right away.
+func (c *ChangeApprovalService) SubmitIfRequired(ctx context.Context, route string, payload []byte) (*dto.ChangeRequest, error) {
+	route = normalizeRoute(route)
+	approvalRoute, ok := c.routes[route]
+	if !ok {
+		return nil, nil
+	}
+
+	userID, _ := ctx.Value(constant.UserIDContextKey).(int)
+	role, _ := ctx.Value(constant.RoleContextKey).(string)
+	// changes made by workers (api keys) aren't held for approval
+	if userID == supertokens_module.WorkerUserID {
+		return nil, nil
+	}
+
+	policies, err := models.ApprovalPolicies(
+		models.ApprovalPolicyWhere.Subject.EQ(approvalRoute.subject),
+		models.ApprovalPolicyWhere.Active.EQ(true),
+		qm.OrderBy(models.ApprovalPolicyColumns.ID),
+	).All(ctx, bcdb.DB())
+	if err != nil {
+		return nil, eris.Wrap(err, "failed to retrieve approval policies")
+	}
+
+	if len(policies) == 0 {
+		return nil, nil
+	}
+
+	changePercentage, err := approvalRoute.magnitude(ctx, payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate change magnitude: %w", err)
+	}
+
+	policy := getMatchedPolicy(policies, role, changePercentage)
+	if policy == nil {
+		return nil, nil
+	}
+
+	mod := &models.ChangeRequest{
+		PolicyID:         policy.ID,
+		Subject:          approvalRoute.subject,
+		Route:            route,
+		Payload:          payload,
+		ChangePercentage: changePercentage,
+		Status:           dto.ChangeRequestStatusPending,
+		RequesterID:      userID,
+		RequesterRole:    role,
+		CreatedAt:        time.Now().UTC(),
+	}
+
+	err = mod.Insert(ctx, bcdb.DB(), boil.Infer())
+	if err != nil {
+		return nil, eris.Wrap(err, "failed to create change request")
+	}
+
+	c.notifyApprovers(ctx, policy, mod)
+
+	changeRequest := &dto.ChangeRequest{}
+	changeRequest.FromModel(mod)
+
+	return changeRequest, nil
+}
+
+func (c *ChangeApprovalService) GetChangeRequests(ctx context.Context, ops *GetChangeRequestOptions) ([]*dto.ChangeRequest, error) {
+	qmods := ops.Filter.queryMod().
+		Order(ops.Order, nil, models.ChangeRequestColumns.ID).
+		AddArray(ops.Pagination.Do())
+
+	mods, err := models.ChangeRequests(qmods...).All(ctx, bcdb.DB())
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, eris.Wrap(err, "failed to retrieve change requests")
+	}
+
+	changeRequests := make([]*dto.ChangeRequest, 0, len(mods))
+	for _, mod := range mods {
+		changeRequest := &dto.ChangeRequest{}
+		changeRequest.FromModel(mod)
+		changeRequests = append(changeRequests, changeRequest)
+	}
+
+	return changeRequests, nil
+}
+
+// ApproveChangeRequest applies pending change on behalf of its requester.
+// Both requester and approver are stored in history of the applied change.
+func (c *ChangeApprovalService) ApproveChangeRequest(ctx context.Context, data *dto.ChangeRequestDecision) error {
+	mod, policy, err := c.getPendingChangeRequest(ctx, data.ID)
+	if err != nil {
+		return err
+	}
+
+	approverID, _ := ctx.Value(constant.UserIDContextKey).(int)
+	approverRole, _ := ctx.Value(constant.RoleContextKey).(string)
+	err = validateApprover(mod, policy, approverID, approverRole)
+	if err != nil {
+		return err
+	}
+
+	approvalRoute, ok := c.routes[mod.Route]
+	if !ok {
+		return fmt.Errorf("route [%v] doesn't support approval", mod.Route)
+	}
+
+	// claiming request first, so it couldn't be applied twice
+	err = c.decide(ctx, mod, dto.ChangeRequestStatusApproved, approverID, data.Comment)
+	if err != nil {
+		return err
+	}
+
+	applyCtx := context.WithValue(ctx, constant.UserIDContextKey, mod.RequesterID)
+	applyCtx = context.WithValue(applyCtx, constant.ApproverIDContextKey, approverID)
+	applyCtx = context.WithValue(applyCtx, constant.RequestPathContextKey, mod.Route)
+
+	applyErr := approvalRoute.apply(applyCtx, mod.Payload)
+	if applyErr != nil {
+		mod.Status = dto.ChangeRequestStatusFailed
+		mod.ErrorMessage = null.StringFrom(applyErr.Error())
+		mod.UpdatedAt = null.TimeFrom(time.Now().UTC())
+		_, err := mod.Update(ctx, bcdb.DB(), boil.Whitelist(
+			models.ChangeRequestColumns.Status,
+			models.ChangeRequestColumns.ErrorMessage,
+			models.ChangeRequestColumns.UpdatedAt,
+		))
+		if err != nil {
+			return eris.Wrapf(err, "failed to mark change request as failed after apply error: %v", applyErr)
+		}
+	}
+
+	c.notifyRequester(ctx, policy, mod)
+
+	if applyErr != nil {
+		return fmt.Errorf("failed to apply approved change: %w", applyErr)
+	}
+
+	return nil
+}
+
+func (c *ChangeApprovalService) RejectChangeRequest(ctx context.Context, data *dto.ChangeRequestDecision) error {
+	mod, policy, err := c.getPendingChangeRequest(ctx, data.ID)
+	if err != nil {
+		return err
+	}
+
+	approverID, _ := ctx.Value(constant.UserIDContextKey).(int)
+	approverRole, _ := ctx.Value(constant.RoleContextKey).(string)
+	err = validateApprover(mod, policy, approverID, approverRole)
+	if err != nil {
+		return err
+	}
+
+	err = c.decide(ctx, mod, dto.ChangeRequestStatusRejected, approverID, data.Comment)
+	if err != nil {
+		return err
+	}
+
+	c.notifyRequester(ctx, policy, mod)
+
+	return nil
+}
+
+func (c *ChangeApprovalService) getPendingChangeRequest(ctx context.Context, id int) (*models.ChangeRequest, *models.ApprovalPolicy, error) {
+	mod, err := models.FindChangeRequest(ctx, bcdb.DB(), id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, fmt.Errorf("change request [%v] not found", id)
+		}
+		return nil, nil, eris.Wrap(err, "failed to retrieve change request")
+	}
+
+	if mod.Status != dto.ChangeRequestStatusPending {
+		return nil, nil, fmt.Errorf("change request [%v] is already %v", id, mod.Status)
+	}
+
+	policy, err := models.FindApprovalPolicy(ctx, bcdb.DB(), mod.PolicyID)
+	if err != nil {
+		return nil, nil, eris.Wrapf(err, "failed to retrieve approval policy [%v]", mod.PolicyID)
+	}
+
+	return mod, policy, nil
+}
+
+func (c *ChangeApprovalService) decide(ctx context.Context, mod *models.ChangeRequest, status string, approverID int, comment string) error {
+	now := time.Now().UTC()
+	updated, err := models.ChangeRequests(
+		models.ChangeRequestWhere.ID.EQ(mod.ID),
+		models.ChangeRequestWhere.Status.EQ(dto.ChangeRequestStatusPending),
+	).UpdateAll(ctx, bcdb.DB(), models.M{
+		models.ChangeRequestColumns.Status:     status,
+		models.ChangeRequestColumns.ApproverID: approverID,
+		models.ChangeRequestColumns.Comment:    null.NewString(comment, comment != ""),
+		models.ChangeRequestColumns.DecidedAt:  now,
+		models.ChangeRequestColumns.UpdatedAt:  now,
+	})
+	if err != nil {
+		return eris.Wrap(err, "failed to update change request status")
+	}
+
+	if updated == 0 {
+		return fmt.Errorf("change request [%v] was already decided", mod.ID)
+	}
+
+	mod.Status = status
+	mod.ApproverID = null.IntFrom(approverID)
+	mod.Comment = null.NewString(comment, comment != "")
+	mod.DecidedAt = null.TimeFrom(now)
+	mod.UpdatedAt = null.TimeFrom(now)
+
+	return nil
+}
+
+// getMatchedPolicy returns first policy which covers requester role and change magnitude
+func getMatchedPolicy(policies models.ApprovalPolicySlice, role string, changePercentage float64) *models.ApprovalPolicy {
+	for _, policy := range policies {
+		if len(policy.RequesterRoles) > 0 && !slices.Contains(policy.RequesterRoles, role) {
+			continue
+		}
+
+		if policy.MinChangePercentage.Valid && changePercentage < policy.MinChangePercentage.Float64 {
+			continue
+		}
+
+		return policy
+	}
+
+	return nil
+}
+
+func validateApprover(mod *models.ChangeRequest, policy *models.ApprovalPolicy, approverID int, approverRole string) error {
+	if mod.RequesterID == approverID {
+		return errors.New("change request can't be decided by its requester")
+	}
+
+	if !slices.Contains(policy.ApproverRoles, approverRole) {
+		return fmt.Errorf("role [%v] isn't allowed to decide on change request", approverRole)
+	}
+
+	return nil
+}
+
+func (filter *ChangeRequestFilter) queryMod() qmods.QueryModsSlice {
+	mods := make(qmods.QueryModsSlice, 0)
+	if filter == nil {
+		return mods
+	}
+
+	if len(filter.ID) > 0 {
+		mods = append(mods, filter.ID.AndIn(models.ChangeRequestColumns.ID))
+	}
+
+	if len(filter.PolicyID) > 0 {
+		mods = append(mods, filter.PolicyID.AndIn(models.ChangeRequestColumns.PolicyID))
+	}
+
+	if len(filter.Subject) > 0 {
+		mods = append(mods, filter.Subject.AndIn(models.ChangeRequestColumns.Subject))
+	}
+
+	if len(filter.Status) > 0 {
+		mods = append(mods, filter.Status.AndIn(models.ChangeRequestColumns.Status))
+	}
+
+	if len(filter.RequesterID) > 0 {
+		mods = append(mods, filter.RequesterID.AndIn(models.ChangeRequestColumns.RequesterID))
+	}
+
+	if len(filter.ApproverID) > 0 {
+		mods = append(mods, filter.ApproverID.AndIn(models.ChangeRequestColumns.ApproverID))
+	}
+
+	return mods
+}
+
+// normalizeRoute brings path to the form routes are registered with,
+// since routing is case insensitive and ignores trailing slash
+func normalizeRoute(route string) string {
+	route = strings.ToLower(route)
+	if len(route) > 1 {
+		route = strings.TrimSuffix(route, "/")
+	}
+
+	return route
+}
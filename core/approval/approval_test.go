@@ -0,0 +1,178 @@
+package approval
+
+import (
+	"testing"
+
+	"github.com/m6yf/bcwork/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v8"
+)
+
+func Test_getMaxChangePercentage(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		oldValues map[string]float64
+		newValues map[string]float64
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want float64
+	}{
+		{
+			name: "noChanges",
+			args: args{
+				oldValues: map[string]float64{"rule_1": 0.5},
+				newValues: map[string]float64{"rule_1": 0.5},
+			},
+			want: 0,
+		},
+		{
+			name: "maxOfSeveralChanges",
+			args: args{
+				oldValues: map[string]float64{"rule_1": 1, "rule_2": 2},
+				newValues: map[string]float64{"rule_1": 1.1, "rule_2": 1},
+			},
+			want: 50,
+		},
+		{
+			name: "newRule",
+			args: args{
+				oldValues: map[string]float64{"rule_1": 1},
+				newValues: map[string]float64{"rule_1": 1, "rule_2": 0.1},
+			},
+			want: fullChangePercentage,
+		},
+		{
+			name: "changeFromZero",
+			args: args{
+				oldValues: map[string]float64{"rule_1": 0},
+				newValues: map[string]float64{"rule_1": 0.01},
+			},
+			want: fullChangePercentage,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := getMaxChangePercentage(tt.args.oldValues, tt.args.newValues)
+			assert.InDelta(t, tt.want, got, 0.0001)
+		})
+	}
+}
+
+func Test_getMatchedPolicy(t *testing.T) {
+	t.Parallel()
+
+	policies := models.ApprovalPolicySlice{
+		{ID: 1, RequesterRoles: []string{"Member"}, MinChangePercentage: null.Float64From(20)},
+		{ID: 2, MinChangePercentage: null.Float64From(50)},
+	}
+
+	type args struct {
+		role             string
+		changePercentage float64
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want *int
+	}{
+		{
+			name: "matchedByRoleAndMagnitude",
+			args: args{role: "Member", changePercentage: 25},
+			want: func() *int { id := 1; return &id }(),
+		},
+		{
+			name: "roleNotCoveredAndSmallChange",
+			args: args{role: "Admin", changePercentage: 25},
+			want: nil,
+		},
+		{
+			name: "policyForAllRoles",
+			args: args{role: "Admin", changePercentage: 75},
+			want: func() *int { id := 2; return &id }(),
+		},
+		{
+			name: "belowThreshold",
+			args: args{role: "Member", changePercentage: 10},
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := getMatchedPolicy(policies, tt.args.role, tt.args.changePercentage)
+			if tt.want == nil {
+				assert.Nil(t, got)
+				return
+			}
+			assert.Equal(t, *tt.want, got.ID)
+		})
+	}
+}
+
+func Test_validateApprover(t *testing.T) {
+	t.Parallel()
+
+	policy := &models.ApprovalPolicy{ApproverRoles: []string{"Admin"}}
+
+	tests := []struct {
+		name         string
+		requesterID  int
+		approverID   int
+		approverRole string
+		wantErr      bool
+	}{
+		{name: "valid", requesterID: 1, approverID: 2, approverRole: "Admin"},
+		{name: "selfApproval", requesterID: 1, approverID: 1, approverRole: "Admin", wantErr: true},
+		{name: "roleNotAllowed", requesterID: 1, approverID: 2, approverRole: "Member", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateApprover(&models.ChangeRequest{RequesterID: tt.requesterID}, policy, tt.approverID, tt.approverRole)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_normalizeRoute(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		route string
+		want  string
+	}{
+		{name: "registered", route: BulkFactorRoute, want: BulkFactorRoute},
+		{name: "upperCase", route: "/Bulk/Factor", want: BulkFactorRoute},
+		{name: "trailingSlash", route: "/bulk/factor/", want: BulkFactorRoute},
+		{name: "root", route: "/", want: "/"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, normalizeRoute(tt.route))
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package approval
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/models"
+	"github.com/m6yf/bcwork/modules"
+	"github.com/m6yf/bcwork/modules/logger"
+	"github.com/m6yf/bcwork/modules/messager"
+	"github.com/m6yf/bcwork/utils/constant"
+)
+
+func (c *ChangeApprovalService) notifyApprovers(ctx context.Context, policy *models.ApprovalPolicy, mod *models.ChangeRequest) {
+	subject := fmt.Sprintf("Change request #%v for %v is waiting for approval", mod.ID, mod.Subject)
+	message := fmt.Sprintf(
+		"%v: change request #%v (%v, change %.2f%%) by user %v requires approval of %v according to policy [%v]",
+		subject, mod.ID, mod.Route, mod.ChangePercentage, mod.RequesterID, policy.ApproverRoles, policy.Name,
+	)
+
+	innerCtx := context.WithValue(context.Background(), constant.LoggerContextKey, logger.Logger(ctx))
+	go func() {
+		emails := slices.Clone([]string(policy.NotifyEmails))
+		approvers, err := models.Users(
+			models.UserWhere.Role.IN(policy.ApproverRoles),
+			models.UserWhere.Enabled.EQ(true),
+		).All(innerCtx, bcdb.DB())
+		if err != nil {
+			logger.Logger(innerCtx).Error().Err(err).Msg("failed to get approvers for change request notification")
+		}
+
+		for _, approver := range approvers {
+			emails = append(emails, approver.Email)
+		}
+
+		c.notify(innerCtx, policy, emails, subject, message)
+	}()
+}
+
+func (c *ChangeApprovalService) notifyRequester(ctx context.Context, policy *models.ApprovalPolicy, mod *models.ChangeRequest) {
+	subject := fmt.Sprintf("Change request #%v for %v was %v", mod.ID, mod.Subject, mod.Status)
+	message := fmt.Sprintf("%v by user %v", subject, mod.ApproverID.Int)
+	if mod.Comment.Valid {
+		message += ": " + mod.Comment.String
+	}
+	if mod.ErrorMessage.Valid {
+		message += ", error: " + mod.ErrorMessage.String
+	}
+
+	innerCtx := context.WithValue(context.Background(), constant.LoggerContextKey, logger.Logger(ctx))
+	go func() {
+		var emails []string
+		requester, err := models.FindUser(innerCtx, bcdb.DB(), mod.RequesterID)
+		if err != nil {
+			logger.Logger(innerCtx).Error().Err(err).Msg("failed to get requester for change request notification")
+		} else {
+			emails = append(emails, requester.Email)
+		}
+
+		c.notify(innerCtx, policy, emails, subject, message)
+	}()
+}
+
+func (c *ChangeApprovalService) notify(ctx context.Context, policy *models.ApprovalPolicy, emails []string, subject, message string) {
+	if len(emails) > 0 {
+		slices.Sort(emails)
+		err := modules.SendEmail(modules.EmailRequest{
+			To:      slices.Compact(emails),
+			Subject: subject,
+			Body:    message,
+		})
+		if err != nil {
+			logger.Logger(ctx).Error().Err(err).Msg("failed to send change request email")
+		}
+	}
+
+	if !policy.NotifySlack {
+		return
+	}
+
+	c.slackMu.Lock()
+	defer c.slackMu.Unlock()
+
+	if c.slack == nil {
+		slack, err := messager.NewSlackModule()
+		if err != nil {
+			logger.Logger(ctx).Error().Err(err).Msg("failed to init slack module for change request notification")
+			return
+		}
+		c.slack = slack
+	}
+
+	err := c.slack.SendMessage(message)
+	if err != nil {
+		logger.Logger(ctx).Error().Err(err).Msg("failed to send change request slack message")
+	}
+}
@@ -0,0 +1,133 @@
+package approval
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/bcdb/qmods"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/models"
+	"github.com/m6yf/bcwork/modules/history"
+	"github.com/rotisserie/eris"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func (c *ChangeApprovalService) GetApprovalPolicies(ctx context.Context, ops *GetApprovalPolicyOptions) ([]*dto.ApprovalPolicy, error) {
+	qmods := ops.Filter.queryMod().
+		Order(ops.Order, nil, models.ApprovalPolicyColumns.ID).
+		AddArray(ops.Pagination.Do())
+
+	mods, err := models.ApprovalPolicies(qmods...).All(ctx, bcdb.DB())
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, eris.Wrap(err, "failed to retrieve approval policies")
+	}
+
+	policies := make([]*dto.ApprovalPolicy, 0, len(mods))
+	for _, mod := range mods {
+		policy := &dto.ApprovalPolicy{}
+		policy.FromModel(mod)
+		policies = append(policies, policy)
+	}
+
+	return policies, nil
+}
+
+func (c *ChangeApprovalService) CreateApprovalPolicy(ctx context.Context, data *dto.ApprovalPolicy) error {
+	mod := data.ToModel()
+	mod.CreatedAt = time.Now().UTC()
+
+	err := mod.Insert(ctx, bcdb.DB(), boil.Infer())
+	if err != nil {
+		return eris.Wrap(err, "failed to create approval policy")
+	}
+
+	c.historyModule.SaveAction(ctx, nil, mod, &history.HistoryOptions{Subject: history.ApprovalPolicySubject})
+
+	return nil
+}
+
+func (c *ChangeApprovalService) UpdateApprovalPolicy(ctx context.Context, data *dto.ApprovalPolicy) error {
+	mod, err := models.FindApprovalPolicy(ctx, bcdb.DB(), data.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("approval policy [%v] not found", data.ID)
+		}
+		return eris.Wrap(err, "failed to retrieve approval policy")
+	}
+
+	oldMod := *mod
+
+	newMod := data.ToModel()
+	newMod.CreatedAt = mod.CreatedAt
+	newMod.UpdatedAt = null.TimeFrom(time.Now().UTC())
+
+	_, err = newMod.Update(ctx, bcdb.DB(), boil.Infer())
+	if err != nil {
+		return eris.Wrap(err, "failed to update approval policy")
+	}
+
+	c.historyModule.SaveAction(ctx, &oldMod, newMod, &history.HistoryOptions{Subject: history.ApprovalPolicySubject})
+
+	return nil
+}
+
+func (c *ChangeApprovalService) DeleteApprovalPolicies(ctx context.Context, ids []int) error {
+	mods, err := models.ApprovalPolicies(models.ApprovalPolicyWhere.ID.IN(ids)).All(ctx, bcdb.DB())
+	if err != nil {
+		return eris.Wrap(err, "failed to retrieve approval policies for deletion")
+	}
+
+	pending, err := models.ChangeRequests(
+		models.ChangeRequestWhere.PolicyID.IN(ids),
+		models.ChangeRequestWhere.Status.EQ(dto.ChangeRequestStatusPending),
+	).Exists(ctx, bcdb.DB())
+	if err != nil {
+		return eris.Wrap(err, "failed to check pending change requests")
+	}
+
+	if pending {
+		return errors.New("approval policies have pending change requests, deactivate them instead")
+	}
+
+	_, err = mods.DeleteAll(ctx, bcdb.DB())
+	if err != nil {
+		return eris.Wrap(err, "failed to delete approval policies")
+	}
+
+	oldMods := make([]any, 0, len(mods))
+	newMods := make([]any, 0, len(mods))
+	for _, mod := range mods {
+		oldMods = append(oldMods, mod)
+		newMods = append(newMods, nil)
+	}
+
+	c.historyModule.SaveAction(ctx, oldMods, newMods, &history.HistoryOptions{Subject: history.ApprovalPolicySubject, IsMultipleValuesExpected: true})
+
+	return nil
+}
+
+func (filter *ApprovalPolicyFilter) queryMod() qmods.QueryModsSlice {
+	mods := make(qmods.QueryModsSlice, 0)
+	if filter == nil {
+		return mods
+	}
+
+	if len(filter.ID) > 0 {
+		mods = append(mods, filter.ID.AndIn(models.ApprovalPolicyColumns.ID))
+	}
+
+	if len(filter.Subject) > 0 {
+		mods = append(mods, filter.Subject.AndIn(models.ApprovalPolicyColumns.Subject))
+	}
+
+	if filter.Active != nil {
+		mods = append(mods, filter.Active.Where(models.ApprovalPolicyColumns.Active))
+	}
+
+	return mods
+}
@@ -0,0 +1,271 @@
+package approval
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/core"
+	"github.com/m6yf/bcwork/core/bulk"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/models"
+	"github.com/m6yf/bcwork/modules/history"
+)
+
+const (
+	BulkFactorRoute   = "/bulk/factor"
+	BulkDPORoute      = "/bulk/dpo"
+	GlobalFactorRoute = "/global/factor"
+	FloorDeleteRoute  = "/floor/delete"
+	// RecommendationApplyRoute holds factors of applied recommendations together with their floors
+	RecommendationApplyRoute = "/recommendation/apply"
+
+	// new rules and deletions are treated as a full change
+	fullChangePercentage = 100
+)
+
+// approvalRoute describes an endpoint which changes could be held for approval
+type approvalRoute struct {
+	subject string
+	// magnitude returns the largest relative change (in percents) the payload would cause
+	magnitude func(ctx context.Context, payload []byte) (float64, error)
+	// apply executes the payload through the same service the endpoint uses
+	apply func(ctx context.Context, payload []byte) error
+}
+
+func (c *ChangeApprovalService) buildRoutes() map[string]approvalRoute {
+	return map[string]approvalRoute{
+		BulkFactorRoute: {
+			subject:   history.FactorSubject,
+			magnitude: getBulkFactorChangePercentage,
+			apply: func(ctx context.Context, payload []byte) error {
+				var requests []bulk.FactorUpdateRequest
+				if err := json.Unmarshal(payload, &requests); err != nil {
+					return fmt.Errorf("failed to parse factor bulk payload: %w", err)
+				}
+
+				return c.bulkService.BulkInsertFactors(ctx, requests)
+			},
+		},
+		BulkDPORoute: {
+			subject:   history.DPOSubject,
+			magnitude: getBulkDPOChangePercentage,
+			apply: func(ctx context.Context, payload []byte) error {
+				var requests []dto.DPORuleUpdateRequest
+				if err := json.Unmarshal(payload, &requests); err != nil {
+					return fmt.Errorf("failed to parse dpo bulk payload: %w", err)
+				}
+
+				return c.bulkService.BulkInsertDPO(ctx, requests)
+			},
+		},
+		GlobalFactorRoute: {
+			subject:   history.GlobalFactorSubject,
+			magnitude: getGlobalFactorChangePercentage,
+			apply: func(ctx context.Context, payload []byte) error {
+				var request core.GlobalFactorRequest
+				if err := json.Unmarshal(payload, &request); err != nil {
+					return fmt.Errorf("failed to parse global factor payload: %w", err)
+				}
+
+				return c.globalFactorService.UpdateGlobalFactor(ctx, &request)
+			},
+		},
+		RecommendationApplyRoute: {
+			subject:   history.FactorSubject,
+			magnitude: getFactorFloorChangePercentage,
+			apply: func(ctx context.Context, payload []byte) error {
+				var request bulk.FactorFloorUpdateRequest
+				if err := json.Unmarshal(payload, &request); err != nil {
+					return fmt.Errorf("failed to parse factor and floor payload: %w", err)
+				}
+
+				return c.bulkService.BulkInsertFactorsAndFloors(ctx, &request)
+			},
+		},
+		FloorDeleteRoute: {
+			subject:   history.FloorSubject,
+			magnitude: getFloorDeleteChangePercentage,
+			apply: func(ctx context.Context, payload []byte) error {
+				var ids []string
+				if err := json.Unmarshal(payload, &ids); err != nil {
+					return fmt.Errorf("failed to parse floor delete payload: %w", err)
+				}
+
+				return c.bulkService.BulkDeleteFloor(ctx, ids)
+			},
+		},
+	}
+}
+
+// Subjects returns history subjects which could be covered by approval policies
+func Subjects() []string {
+	return []string{history.FactorSubject, history.DPOSubject, history.GlobalFactorSubject, history.FloorSubject}
+}
+
+func getBulkFactorChangePercentage(ctx context.Context, payload []byte) (float64, error) {
+	var requests []bulk.FactorUpdateRequest
+	if err := json.Unmarshal(payload, &requests); err != nil {
+		return 0, fmt.Errorf("failed to parse factor bulk payload: %w", err)
+	}
+
+	return getFactorsChangePercentage(ctx, requests)
+}
+
+func getFactorFloorChangePercentage(ctx context.Context, payload []byte) (float64, error) {
+	var request bulk.FactorFloorUpdateRequest
+	if err := json.Unmarshal(payload, &request); err != nil {
+		return 0, fmt.Errorf("failed to parse factor and floor payload: %w", err)
+	}
+
+	return getFactorsChangePercentage(ctx, request.Factors)
+}
+
+func getFactorsChangePercentage(ctx context.Context, requests []bulk.FactorUpdateRequest) (float64, error) {
+	newValues := make(map[string]float64, len(requests))
+	for _, request := range requests {
+		factor := &dto.Factor{
+			Publisher: request.Publisher,
+			Domain:    request.Domain,
+			Device:    request.Device,
+			Factor:    request.Factor,
+			Country:   request.Country,
+			RuleId:    request.RuleID,
+		}
+		newValues[factor.GetRuleID()] = request.Factor
+	}
+
+	mods, err := models.Factors(models.FactorWhere.RuleID.IN(getKeys(newValues))).All(ctx, bcdb.DB())
+	if err != nil {
+		return 0, fmt.Errorf("failed to get current factors: %w", err)
+	}
+
+	oldValues := make(map[string]float64, len(mods))
+	for _, mod := range mods {
+		if mod.Active {
+			oldValues[mod.RuleID] = mod.Factor
+		}
+	}
+
+	return getMaxChangePercentage(oldValues, newValues), nil
+}
+
+func getBulkDPOChangePercentage(ctx context.Context, payload []byte) (float64, error) {
+	var requests []dto.DPORuleUpdateRequest
+	if err := json.Unmarshal(payload, &requests); err != nil {
+		return 0, fmt.Errorf("failed to parse dpo bulk payload: %w", err)
+	}
+
+	newValues := make(map[string]float64, len(requests))
+	for _, request := range requests {
+		rule := core.DemandPartnerOptimizationRule{
+			DemandPartner: request.DemandPartner,
+			Publisher:     request.Publisher,
+			Domain:        request.Domain,
+			Country:       request.Country,
+			OS:            request.OS,
+			DeviceType:    request.DeviceType,
+			PlacementType: request.PlacementType,
+			Browser:       request.Browser,
+			Factor:        request.Factor,
+			RuleID:        request.RuleId,
+		}
+		newValues[rule.GetRuleID()] = request.Factor
+	}
+
+	mods, err := models.DpoRules(models.DpoRuleWhere.RuleID.IN(getKeys(newValues))).All(ctx, bcdb.DB())
+	if err != nil {
+		return 0, fmt.Errorf("failed to get current dpo rules: %w", err)
+	}
+
+	oldValues := make(map[string]float64, len(mods))
+	for _, mod := range mods {
+		if mod.Active {
+			oldValues[mod.RuleID] = mod.Factor
+		}
+	}
+
+	return getMaxChangePercentage(oldValues, newValues), nil
+}
+
+func getGlobalFactorChangePercentage(ctx context.Context, payload []byte) (float64, error) {
+	var request core.GlobalFactorRequest
+	if err := json.Unmarshal(payload, &request); err != nil {
+		return 0, fmt.Errorf("failed to parse global factor payload: %w", err)
+	}
+
+	mods, err := models.GlobalFactors(
+		models.GlobalFactorWhere.PublisherID.EQ(request.Publisher),
+		models.GlobalFactorWhere.Key.EQ(request.Key),
+	).All(ctx, bcdb.DB())
+	if err != nil {
+		return 0, fmt.Errorf("failed to get current global factor: %w", err)
+	}
+
+	oldValues := make(map[string]float64, len(mods))
+	for _, mod := range mods {
+		oldValues[request.Key] = mod.Value.Float64
+	}
+
+	return getMaxChangePercentage(oldValues, map[string]float64{request.Key: request.Value}), nil
+}
+
+func getFloorDeleteChangePercentage(ctx context.Context, payload []byte) (float64, error) {
+	var ids []string
+	if err := json.Unmarshal(payload, &ids); err != nil {
+		return 0, fmt.Errorf("failed to parse floor delete payload: %w", err)
+	}
+
+	exists, err := models.Floors(
+		models.FloorWhere.RuleID.IN(ids),
+		models.FloorWhere.Active.EQ(true),
+	).Exists(ctx, bcdb.DB())
+	if err != nil {
+		return 0, fmt.Errorf("failed to check floors for deletion: %w", err)
+	}
+
+	if !exists {
+		return 0, nil
+	}
+
+	return fullChangePercentage, nil
+}
+
+// getMaxChangePercentage compares new values with current ones by rule id.
+// Rule without current value is a new one and counts as a full change.
+func getMaxChangePercentage(oldValues, newValues map[string]float64) float64 {
+	var maxChangePercentage float64
+	for ruleID, newValue := range newValues {
+		changePercentage := float64(fullChangePercentage)
+		if oldValue, ok := oldValues[ruleID]; ok {
+			changePercentage = getChangePercentage(oldValue, newValue)
+		}
+
+		maxChangePercentage = math.Max(maxChangePercentage, changePercentage)
+	}
+
+	return maxChangePercentage
+}
+
+func getChangePercentage(oldValue, newValue float64) float64 {
+	if oldValue == newValue {
+		return 0
+	}
+
+	if oldValue == 0 {
+		return fullChangePercentage
+	}
+
+	return math.Abs(newValue-oldValue) / math.Abs(oldValue) * 100
+}
+
+func getKeys(values map[string]float64) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
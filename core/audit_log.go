@@ -0,0 +1,102 @@
+package core
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/bcdb/filter"
+	"github.com/m6yf/bcwork/bcdb/order"
+	"github.com/m6yf/bcwork/bcdb/pagination"
+	"github.com/m6yf/bcwork/bcdb/qmods"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/models"
+	"github.com/rotisserie/eris"
+)
+
+type AuditLogService struct{}
+
+func NewAuditLogService() *AuditLogService {
+	return &AuditLogService{}
+}
+
+type GetAuditLogOptions struct {
+	Filter     AuditLogFilter         `json:"filter"`
+	Pagination *pagination.Pagination `json:"pagination"`
+	Order      order.Sort             `json:"order"`
+	Selector   string                 `json:"selector"`
+}
+
+// AuditLogFilter selects requests, created_at is required to limit the search to relevant partitions
+type AuditLogFilter struct {
+	UserID    filter.IntArrayFilter    `json:"user_id,omitempty"`
+	Role      filter.StringArrayFilter `json:"role,omitempty"`
+	APIKeyID  filter.IntArrayFilter    `json:"api_key_id,omitempty"`
+	Method    filter.StringArrayFilter `json:"method,omitempty"`
+	Route     filter.StringArrayFilter `json:"route,omitempty"`
+	Status    filter.IntArrayFilter    `json:"status,omitempty"`
+	RequestID filter.StringArrayFilter `json:"request_id,omitempty"`
+	CreatedAt *filter.DatesFilter      `json:"created_at,omitempty"`
+}
+
+func (a *AuditLogService) GetAuditLogs(ctx context.Context, ops *GetAuditLogOptions) ([]*dto.AuditLog, error) {
+	qmods := ops.Filter.queryMod().
+		Order(automationLogSort(ops.Order, models.AuditLogColumns.CreatedAt), nil, models.AuditLogColumns.ID).
+		AddArray(ops.Pagination.Do())
+
+	mods, err := models.AuditLogs(qmods...).All(ctx, bcdb.DB())
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, eris.Wrap(err, "failed to retrieve audit log")
+	}
+
+	logs := make([]*dto.AuditLog, 0, len(mods))
+	for _, mod := range mods {
+		log := &dto.AuditLog{}
+		log.FromModel(mod)
+		logs = append(logs, log)
+	}
+
+	return logs, nil
+}
+
+func (filter *AuditLogFilter) queryMod() qmods.QueryModsSlice {
+	mods := make(qmods.QueryModsSlice, 0)
+	if filter == nil {
+		return mods
+	}
+
+	if len(filter.UserID) > 0 {
+		mods = append(mods, filter.UserID.AndIn(models.AuditLogColumns.UserID))
+	}
+
+	if len(filter.Role) > 0 {
+		mods = append(mods, filter.Role.AndIn(models.AuditLogColumns.Role))
+	}
+
+	if len(filter.APIKeyID) > 0 {
+		mods = append(mods, filter.APIKeyID.AndIn(models.AuditLogColumns.APIKeyID))
+	}
+
+	if len(filter.Method) > 0 {
+		mods = append(mods, filter.Method.AndIn(models.AuditLogColumns.Method))
+	}
+
+	if len(filter.Route) > 0 {
+		mods = append(mods, filter.Route.AndIn(models.AuditLogColumns.Route))
+	}
+
+	if len(filter.Status) > 0 {
+		mods = append(mods, filter.Status.AndIn(models.AuditLogColumns.Status))
+	}
+
+	if len(filter.RequestID) > 0 {
+		mods = append(mods, filter.RequestID.AndIn(models.AuditLogColumns.RequestID))
+	}
+
+	if filter.CreatedAt != nil {
+		mods = append(mods, filter.CreatedAt.AndIn(models.AuditLogColumns.CreatedAt))
+	}
+
+	return mods
+}
@@ -0,0 +1,190 @@
+package core
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/models"
+	"github.com/m6yf/bcwork/modules/automation"
+	"github.com/m6yf/bcwork/utils/constant"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// ErrCircuitBreakerOpen is returned for runs of automation which circuit breaker was tripped and not reset yet
+var ErrCircuitBreakerOpen = errors.New("automation circuit breaker is open")
+
+type AutomationGuardrailService struct{}
+
+func NewAutomationGuardrailService() *AutomationGuardrailService {
+	return &AutomationGuardrailService{}
+}
+
+func (a *AutomationGuardrailService) GetCircuitBreakers(ctx context.Context) ([]*dto.CircuitBreaker, error) {
+	mods, err := models.AutomationCircuitBreakers(qm.OrderBy(models.AutomationCircuitBreakerColumns.Automation)).All(ctx, bcdb.DB())
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve circuit breakers: %w", err)
+	}
+
+	circuitBreakers := make([]*dto.CircuitBreaker, 0, len(mods))
+	for _, mod := range mods {
+		circuitBreaker := new(dto.CircuitBreaker)
+		circuitBreaker.FromModel(mod)
+		circuitBreakers = append(circuitBreakers, circuitBreaker)
+	}
+
+	return circuitBreakers, nil
+}
+
+// ResetCircuitBreaker closes circuit breaker of automation. Input volume of the previous run is cleared,
+// so the next run becomes the new baseline for volume checks.
+func (a *AutomationGuardrailService) ResetCircuitBreaker(ctx context.Context, automationName string) error {
+	mod, err := getCircuitBreaker(ctx, automationName)
+	if err != nil {
+		return err
+	}
+
+	resetBy, isUserKnown := ctx.Value(constant.UserIDContextKey).(int)
+	now := time.Now().UTC()
+
+	mod.Tripped = false
+	mod.ResetBy = null.NewInt(resetBy, isUserKnown)
+	mod.ResetAt = null.TimeFrom(now)
+	mod.LastInputRecords = null.Int{}
+	mod.LastInputVolume = null.Float64{}
+	mod.UpdatedAt = null.TimeFrom(now)
+
+	_, err = mod.Update(ctx, bcdb.DB(), boil.Whitelist(
+		models.AutomationCircuitBreakerColumns.Tripped,
+		models.AutomationCircuitBreakerColumns.ResetBy,
+		models.AutomationCircuitBreakerColumns.ResetAt,
+		models.AutomationCircuitBreakerColumns.LastInputRecords,
+		models.AutomationCircuitBreakerColumns.LastInputVolume,
+		models.AutomationCircuitBreakerColumns.UpdatedAt,
+	))
+	if err != nil {
+		return fmt.Errorf("failed to reset circuit breaker of [%v]: %w", automationName, err)
+	}
+
+	return nil
+}
+
+// AutomationGuard applies guardrails to runs of an automation worker and trips its circuit breaker on violation
+type AutomationGuard struct {
+	Automation string
+	Guardrails automation.Guardrails
+}
+
+func NewAutomationGuard(automationName string, guardrails automation.Guardrails) *AutomationGuard {
+	return &AutomationGuard{
+		Automation: automationName,
+		Guardrails: guardrails,
+	}
+}
+
+// Begin checks that circuit breaker is closed and input of the run is in line with the previous run
+func (g *AutomationGuard) Begin(ctx context.Context, records int, volume float64) error {
+	mod, err := getCircuitBreaker(ctx, g.Automation)
+	if err != nil {
+		return err
+	}
+
+	if mod.Tripped {
+		return fmt.Errorf("%w: tripped at %v: %v", ErrCircuitBreakerOpen, mod.TrippedAt.Time.Format(time.RFC3339), mod.Reason.String)
+	}
+
+	err = g.Guardrails.CheckVolume(records, volume, mod.LastInputVolume.Ptr())
+	if err != nil {
+		return g.trip(ctx, mod, err)
+	}
+
+	return nil
+}
+
+// CheckChanges checks the number of changed rules of the run against the change budget
+func (g *AutomationGuard) CheckChanges(ctx context.Context, changed, total int) error {
+	err := g.Guardrails.CheckChanges(changed, total)
+	if err == nil {
+		return nil
+	}
+
+	mod, getErr := getCircuitBreaker(ctx, g.Automation)
+	if getErr != nil {
+		return errors.Join(err, getErr)
+	}
+
+	return g.trip(ctx, mod, err)
+}
+
+// Commit stores input of the successful run as the baseline of the next run
+func (g *AutomationGuard) Commit(ctx context.Context, records int, volume float64) error {
+	mod, err := getCircuitBreaker(ctx, g.Automation)
+	if err != nil {
+		return err
+	}
+
+	mod.LastInputRecords = null.IntFrom(records)
+	mod.LastInputVolume = null.Float64From(volume)
+	mod.UpdatedAt = null.TimeFrom(time.Now().UTC())
+
+	_, err = mod.Update(ctx, bcdb.DB(), boil.Whitelist(
+		models.AutomationCircuitBreakerColumns.LastInputRecords,
+		models.AutomationCircuitBreakerColumns.LastInputVolume,
+		models.AutomationCircuitBreakerColumns.UpdatedAt,
+	))
+	if err != nil {
+		return fmt.Errorf("failed to store input volume of [%v]: %w", g.Automation, err)
+	}
+
+	return nil
+}
+
+func (g *AutomationGuard) trip(ctx context.Context, mod *models.AutomationCircuitBreaker, reason error) error {
+	now := time.Now().UTC()
+
+	mod.Tripped = true
+	mod.Reason = null.StringFrom(reason.Error())
+	mod.TrippedAt = null.TimeFrom(now)
+	mod.UpdatedAt = null.TimeFrom(now)
+
+	_, err := mod.Update(ctx, bcdb.DB(), boil.Whitelist(
+		models.AutomationCircuitBreakerColumns.Tripped,
+		models.AutomationCircuitBreakerColumns.Reason,
+		models.AutomationCircuitBreakerColumns.TrippedAt,
+		models.AutomationCircuitBreakerColumns.UpdatedAt,
+	))
+	if err != nil {
+		return errors.Join(reason, fmt.Errorf("failed to trip circuit breaker of [%v]: %w", g.Automation, err))
+	}
+
+	return reason
+}
+
+// getCircuitBreaker returns circuit breaker of automation, creating closed one on the first use
+func getCircuitBreaker(ctx context.Context, automationName string) (*models.AutomationCircuitBreaker, error) {
+	mod, err := models.AutomationCircuitBreakers(models.AutomationCircuitBreakerWhere.Automation.EQ(automationName)).One(ctx, bcdb.DB())
+	if err == nil {
+		return mod, nil
+	}
+
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to get circuit breaker of [%v]: %w", automationName, err)
+	}
+
+	mod = &models.AutomationCircuitBreaker{
+		Automation: automationName,
+		CreatedAt:  time.Now().UTC(),
+	}
+	err = mod.Insert(ctx, bcdb.DB(), boil.Infer())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create circuit breaker of [%v]: %w", automationName, err)
+	}
+
+	return mod, nil
+}
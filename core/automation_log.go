@@ -0,0 +1,327 @@
+package core
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/bcdb/filter"
+	"github.com/m6yf/bcwork/bcdb/order"
+	"github.com/m6yf/bcwork/bcdb/pagination"
+	"github.com/m6yf/bcwork/bcdb/qmods"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/models"
+	"github.com/rotisserie/eris"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const automationLogDayColumn = "day"
+
+type AutomationLogService struct{}
+
+func NewAutomationLogService() *AutomationLogService {
+	return &AutomationLogService{}
+}
+
+type FactorLogOptions struct {
+	Filter     FactorLogFilter        `json:"filter"`
+	Pagination *pagination.Pagination `json:"pagination"`
+	Order      order.Sort             `json:"order"`
+	Selector   string                 `json:"selector"`
+}
+
+type FactorLogFilter struct {
+	Publisher      filter.StringArrayFilter `json:"publisher,omitempty"`
+	Domain         filter.StringArrayFilter `json:"domain,omitempty"`
+	Country        filter.StringArrayFilter `json:"country,omitempty"`
+	Device         filter.StringArrayFilter `json:"device,omitempty"`
+	Source         filter.StringArrayFilter `json:"source,omitempty"`
+	ResponseStatus filter.IntArrayFilter    `json:"response_status,omitempty"`
+	Time           *filter.DatesFilter      `json:"time,omitempty"`
+}
+
+// FactorLogTimelineOptions select log of a single factor rule, empty country or device match rules without them
+type FactorLogTimelineOptions struct {
+	Publisher string              `json:"publisher" validate:"required"`
+	Domain    string              `json:"domain" validate:"required"`
+	Country   string              `json:"country"`
+	Device    string              `json:"device"`
+	Time      *filter.DatesFilter `json:"time,omitempty"`
+}
+
+type DpoLogOptions struct {
+	Filter     DpoLogFilter           `json:"filter"`
+	Pagination *pagination.Pagination `json:"pagination"`
+	Order      order.Sort             `json:"order"`
+	Selector   string                 `json:"selector"`
+}
+
+type DpoLogFilter struct {
+	DP         filter.StringArrayFilter `json:"dp,omitempty"`
+	Publisher  filter.StringArrayFilter `json:"publisher,omitempty"`
+	Domain     filter.StringArrayFilter `json:"domain,omitempty"`
+	Os         filter.StringArrayFilter `json:"os,omitempty"`
+	Country    filter.StringArrayFilter `json:"country,omitempty"`
+	Reason     filter.StringArrayFilter `json:"reason,omitempty"`
+	RespStatus filter.IntArrayFilter    `json:"resp_status,omitempty"`
+	Time       *filter.DatesFilter      `json:"time,omitempty"`
+}
+
+// DpoLogTimelineOptions select log of a single DPO rule, empty os or country match rules without them
+type DpoLogTimelineOptions struct {
+	DP        string              `json:"dp" validate:"required"`
+	Publisher string              `json:"publisher" validate:"required"`
+	Domain    string              `json:"domain" validate:"required"`
+	Os        string              `json:"os"`
+	Country   string              `json:"country"`
+	Time      *filter.DatesFilter `json:"time,omitempty"`
+}
+
+func (a *AutomationLogService) GetFactorLogs(ctx context.Context, ops *FactorLogOptions) ([]*dto.FactorLog, error) {
+	qmods := ops.Filter.queryMod().
+		Order(automationLogSort(ops.Order, models.PriceFactorLogColumns.Time), nil, models.PriceFactorLogColumns.Time).
+		AddArray(ops.Pagination.Do())
+
+	mods, err := models.PriceFactorLogs(qmods...).All(ctx, bcdb.DB())
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, eris.Wrap(err, "failed to retrieve factor automation log")
+	}
+
+	return factorLogsFromModels(mods), nil
+}
+
+// GetFactorLogAggregates summarizes factor automation log by publisher, domain and day
+func (a *AutomationLogService) GetFactorLogAggregates(ctx context.Context, ops *FactorLogOptions) ([]*dto.AutomationLogAggregate, error) {
+	qmods := ops.Filter.queryMod().
+		Order(automationLogSort(ops.Order, automationLogDayColumn), nil, automationLogAggregateGroupBy).
+		AddArray(ops.Pagination.Do()).
+		Add(
+			qm.Select(automationLogAggregateSelect(
+				models.PriceFactorLogColumns.Time,
+				models.PriceFactorLogColumns.ResponseStatus,
+				models.PriceFactorLogColumns.Revenue,
+			)),
+			qm.From(models.TableNames.PriceFactorLog),
+			qm.GroupBy(automationLogAggregateGroupBy),
+		)
+
+	var aggregates []*dto.AutomationLogAggregate
+	err := models.NewQuery(qmods...).Bind(ctx, bcdb.DB(), &aggregates)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, eris.Wrap(err, "failed to aggregate factor automation log")
+	}
+
+	return aggregates, nil
+}
+
+// GetFactorLogTimeline returns changes of a single factor rule from the oldest to the newest
+func (a *AutomationLogService) GetFactorLogTimeline(ctx context.Context, ops *FactorLogTimelineOptions) ([]*dto.FactorLog, error) {
+	mods := qmods.QueryModsSlice{
+		models.PriceFactorLogWhere.Publisher.EQ(ops.Publisher),
+		models.PriceFactorLogWhere.Domain.EQ(ops.Domain),
+		models.PriceFactorLogWhere.Country.EQ(ops.Country),
+		models.PriceFactorLogWhere.Device.EQ(ops.Device),
+		qm.OrderBy(models.PriceFactorLogColumns.Time),
+	}
+
+	if ops.Time != nil {
+		mods = append(mods, ops.Time.AndIn(models.PriceFactorLogColumns.Time))
+	}
+
+	logs, err := models.PriceFactorLogs(mods...).All(ctx, bcdb.DB())
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, eris.Wrap(err, "failed to retrieve factor rule timeline")
+	}
+
+	return factorLogsFromModels(logs), nil
+}
+
+func (a *AutomationLogService) GetDpoLogs(ctx context.Context, ops *DpoLogOptions) ([]*dto.DpoLog, error) {
+	qmods := ops.Filter.queryMod().
+		Order(automationLogSort(ops.Order, models.DpoAutomationLogColumns.Time), nil, models.DpoAutomationLogColumns.Time).
+		AddArray(ops.Pagination.Do())
+
+	mods, err := models.DpoAutomationLogs(qmods...).All(ctx, bcdb.DB())
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, eris.Wrap(err, "failed to retrieve dpo automation log")
+	}
+
+	return dpoLogsFromModels(mods), nil
+}
+
+// GetDpoLogAggregates summarizes DPO automation log by publisher, domain and day
+func (a *AutomationLogService) GetDpoLogAggregates(ctx context.Context, ops *DpoLogOptions) ([]*dto.AutomationLogAggregate, error) {
+	qmods := ops.Filter.queryMod().
+		Order(automationLogSort(ops.Order, automationLogDayColumn), nil, automationLogAggregateGroupBy).
+		AddArray(ops.Pagination.Do()).
+		Add(
+			qm.Select(automationLogAggregateSelect(
+				models.DpoAutomationLogColumns.Time,
+				models.DpoAutomationLogColumns.RespStatus,
+				models.DpoAutomationLogColumns.Revenue,
+			)),
+			qm.From(models.TableNames.DpoAutomationLog),
+			qm.GroupBy(automationLogAggregateGroupBy),
+		)
+
+	var aggregates []*dto.AutomationLogAggregate
+	err := models.NewQuery(qmods...).Bind(ctx, bcdb.DB(), &aggregates)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, eris.Wrap(err, "failed to aggregate dpo automation log")
+	}
+
+	return aggregates, nil
+}
+
+// GetDpoLogTimeline returns changes of a single DPO rule from the oldest to the newest
+func (a *AutomationLogService) GetDpoLogTimeline(ctx context.Context, ops *DpoLogTimelineOptions) ([]*dto.DpoLog, error) {
+	mods := qmods.QueryModsSlice{
+		models.DpoAutomationLogWhere.DP.EQ(ops.DP),
+		models.DpoAutomationLogWhere.Publisher.EQ(ops.Publisher),
+		models.DpoAutomationLogWhere.Domain.EQ(ops.Domain),
+		models.DpoAutomationLogWhere.Os.EQ(ops.Os),
+		models.DpoAutomationLogWhere.Country.EQ(ops.Country),
+		qm.OrderBy(models.DpoAutomationLogColumns.Time),
+	}
+
+	if ops.Time != nil {
+		mods = append(mods, ops.Time.AndIn(models.DpoAutomationLogColumns.Time))
+	}
+
+	logs, err := models.DpoAutomationLogs(mods...).All(ctx, bcdb.DB())
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, eris.Wrap(err, "failed to retrieve dpo rule timeline")
+	}
+
+	return dpoLogsFromModels(logs), nil
+}
+
+func (filter *FactorLogFilter) queryMod() qmods.QueryModsSlice {
+	mods := make(qmods.QueryModsSlice, 0)
+	if filter == nil {
+		return mods
+	}
+
+	if len(filter.Publisher) > 0 {
+		mods = append(mods, filter.Publisher.AndIn(models.PriceFactorLogColumns.Publisher))
+	}
+
+	if len(filter.Domain) > 0 {
+		mods = append(mods, filter.Domain.AndIn(models.PriceFactorLogColumns.Domain))
+	}
+
+	if len(filter.Country) > 0 {
+		mods = append(mods, filter.Country.AndIn(models.PriceFactorLogColumns.Country))
+	}
+
+	if len(filter.Device) > 0 {
+		mods = append(mods, filter.Device.AndIn(models.PriceFactorLogColumns.Device))
+	}
+
+	if len(filter.Source) > 0 {
+		mods = append(mods, filter.Source.AndIn(models.PriceFactorLogColumns.Source))
+	}
+
+	if len(filter.ResponseStatus) > 0 {
+		mods = append(mods, filter.ResponseStatus.AndIn(models.PriceFactorLogColumns.ResponseStatus))
+	}
+
+	if filter.Time != nil {
+		mods = append(mods, filter.Time.AndIn(models.PriceFactorLogColumns.Time))
+	}
+
+	return mods
+}
+
+func (filter *DpoLogFilter) queryMod() qmods.QueryModsSlice {
+	mods := make(qmods.QueryModsSlice, 0)
+	if filter == nil {
+		return mods
+	}
+
+	if len(filter.DP) > 0 {
+		mods = append(mods, filter.DP.AndIn(models.DpoAutomationLogColumns.DP))
+	}
+
+	if len(filter.Publisher) > 0 {
+		mods = append(mods, filter.Publisher.AndIn(models.DpoAutomationLogColumns.Publisher))
+	}
+
+	if len(filter.Domain) > 0 {
+		mods = append(mods, filter.Domain.AndIn(models.DpoAutomationLogColumns.Domain))
+	}
+
+	if len(filter.Os) > 0 {
+		mods = append(mods, filter.Os.AndIn(models.DpoAutomationLogColumns.Os))
+	}
+
+	if len(filter.Country) > 0 {
+		mods = append(mods, filter.Country.AndIn(models.DpoAutomationLogColumns.Country))
+	}
+
+	if len(filter.Reason) > 0 {
+		mods = append(mods, filter.Reason.AndIn(models.DpoAutomationLogColumns.Reason))
+	}
+
+	if len(filter.RespStatus) > 0 {
+		mods = append(mods, filter.RespStatus.AndIn(models.DpoAutomationLogColumns.RespStatus))
+	}
+
+	if filter.Time != nil {
+		mods = append(mods, filter.Time.AndIn(models.DpoAutomationLogColumns.Time))
+	}
+
+	return mods
+}
+
+// automation logs share publisher, domain, old_factor and new_factor columns
+const automationLogAggregateGroupBy = "publisher, domain, " + automationLogDayColumn
+
+func automationLogAggregateSelect(timeColumn, statusColumn, revenueColumn string) string {
+	return fmt.Sprintf(`date_trunc('day', %[1]v) AS %[4]v,
+		publisher,
+		domain,
+		count(*) AS changes,
+		count(*) FILTER (WHERE new_factor > old_factor) AS increases,
+		count(*) FILTER (WHERE new_factor < old_factor) AS decreases,
+		count(*) FILTER (WHERE %[2]v != %[5]v) AS failures,
+		avg(old_factor) AS avg_old_factor,
+		avg(new_factor) AS avg_new_factor,
+		sum(%[3]v) AS revenue`,
+		timeColumn, statusColumn, revenueColumn, automationLogDayColumn, http.StatusOK,
+	)
+}
+
+// automationLogSort orders log from the newest entries when no order is requested
+func automationLogSort(sort order.Sort, timeColumn string) order.Sort {
+	if len(sort) > 0 {
+		return sort
+	}
+
+	return order.Sort{{Name: timeColumn, Desc: true}}
+}
+
+func factorLogsFromModels(mods models.PriceFactorLogSlice) []*dto.FactorLog {
+	logs := make([]*dto.FactorLog, 0, len(mods))
+	for _, mod := range mods {
+		log := new(dto.FactorLog)
+		log.FromModel(mod)
+		logs = append(logs, log)
+	}
+
+	return logs
+}
+
+func dpoLogsFromModels(mods models.DpoAutomationLogSlice) []*dto.DpoLog {
+	logs := make([]*dto.DpoLog, 0, len(mods))
+	for _, mod := range mods {
+		log := new(dto.DpoLog)
+		log.FromModel(mod)
+		logs = append(logs, log)
+	}
+
+	return logs
+}
@@ -0,0 +1,561 @@
+package core
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/bcdb/filter"
+	"github.com/m6yf/bcwork/bcdb/order"
+	"github.com/m6yf/bcwork/bcdb/pagination"
+	"github.com/m6yf/bcwork/bcdb/qmods"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/models"
+	"github.com/m6yf/bcwork/modules/history"
+	"github.com/m6yf/bcwork/modules/jsonschema"
+	"github.com/m6yf/bcwork/modules/rbac"
+	"github.com/m6yf/bcwork/utils"
+	"github.com/m6yf/bcwork/utils/bcguid"
+	"github.com/rotisserie/eris"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+var (
+	ErrNoBidderSchema      = errors.New("demand partner has no bidder schema")
+	ErrInvalidBidderSchema = errors.New("invalid bidder schema")
+	ErrInvalidBidderParams = errors.New("invalid bidder params")
+)
+
+// bidderParamsByDP are bidder params by demand partner id
+type bidderParamsByDP map[string]map[string]any
+
+type BidderParamsService struct {
+	historyModule history.HistoryModule
+}
+
+func NewBidderParamsService(historyModule history.HistoryModule) *BidderParamsService {
+	return &BidderParamsService{
+		historyModule: historyModule,
+	}
+}
+
+type GetDPBidderSchemaOptions struct {
+	Filter     DPBidderSchemaFilter   `json:"filter"`
+	Pagination *pagination.Pagination `json:"pagination"`
+	Order      order.Sort             `json:"order"`
+	Selector   string                 `json:"selector"`
+}
+
+type DPBidderSchemaFilter struct {
+	DemandPartnerID filter.StringArrayFilter `json:"demand_partner_id,omitempty"`
+}
+
+type GetDPBidderParamsOptions struct {
+	Filter     DPBidderParamsFilter   `json:"filter"`
+	Pagination *pagination.Pagination `json:"pagination"`
+	Order      order.Sort             `json:"order"`
+	Selector   string                 `json:"selector"`
+}
+
+type DPBidderParamsFilter struct {
+	DemandPartnerID filter.StringArrayFilter `json:"demand_partner_id,omitempty"`
+	PublisherID     filter.StringArrayFilter `json:"publisher_id,omitempty"`
+	Domain          filter.StringArrayFilter `json:"domain,omitempty"`
+}
+
+func (filter *DPBidderSchemaFilter) queryMod() qmods.QueryModsSlice {
+	mods := make(qmods.QueryModsSlice, 0)
+	if filter == nil {
+		return mods
+	}
+
+	if len(filter.DemandPartnerID) > 0 {
+		mods = append(mods, filter.DemandPartnerID.AndIn(models.DPBidderSchemaColumns.DemandPartnerID))
+	}
+
+	return mods
+}
+
+func (filter *DPBidderParamsFilter) queryMod() qmods.QueryModsSlice {
+	mods := make(qmods.QueryModsSlice, 0)
+	if filter == nil {
+		return mods
+	}
+
+	if len(filter.DemandPartnerID) > 0 {
+		mods = append(mods, filter.DemandPartnerID.AndIn(models.DPBidderParamColumns.DemandPartnerID))
+	}
+
+	if len(filter.PublisherID) > 0 {
+		mods = append(mods, filter.PublisherID.AndIn(models.DPBidderParamColumns.PublisherID))
+	}
+
+	if len(filter.Domain) > 0 {
+		mods = append(mods, filter.Domain.AndIn(models.DPBidderParamColumns.Domain))
+	}
+
+	return mods
+}
+
+func (s *BidderParamsService) GetSchemas(ctx context.Context, ops *GetDPBidderSchemaOptions) ([]*dto.DPBidderSchema, error) {
+	qmods := ops.Filter.queryMod().
+		Order(ops.Order, nil, models.DPBidderSchemaColumns.DemandPartnerID).
+		AddArray(ops.Pagination.Do())
+
+	mods, err := models.DPBidderSchemas(qmods...).All(ctx, bcdb.DB())
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, eris.Wrap(err, "failed to retrieve bidder schemas")
+	}
+
+	schemas := make([]*dto.DPBidderSchema, 0, len(mods))
+	for _, mod := range mods {
+		schema := &dto.DPBidderSchema{}
+		schema.FromModel(mod)
+		schemas = append(schemas, schema)
+	}
+
+	return schemas, nil
+}
+
+// SetSchema creates or replaces bidder schema of demand partner,
+// schema is rejected when existing params of demand partner don't match it
+func (s *BidderParamsService) SetSchema(ctx context.Context, data *dto.DPBidderSchema) error {
+	schema, err := parseBidderSchema(data.Schema)
+	if err != nil {
+		return err
+	}
+
+	_, err = models.FindDpo(ctx, bcdb.DB(), data.DemandPartnerID)
+	if err != nil {
+		return eris.Wrapf(err, "failed to retrieve demand partner [%v]", data.DemandPartnerID)
+	}
+
+	paramsMods, err := models.DPBidderParams(
+		models.DPBidderParamWhere.DemandPartnerID.EQ(data.DemandPartnerID),
+		qm.OrderBy(models.DPBidderParamColumns.ID),
+	).All(ctx, bcdb.DB())
+	if err != nil {
+		return eris.Wrapf(err, "failed to retrieve bidder params of demand partner [%v]", data.DemandPartnerID)
+	}
+
+	modsByPublisher := make(map[string]models.DPBidderParamSlice)
+	for _, mod := range paramsMods {
+		modsByPublisher[mod.PublisherID] = append(modsByPublisher[mod.PublisherID], mod)
+	}
+
+	schemas := map[string]*jsonschema.Schema{data.DemandPartnerID: schema}
+	validationErrors := make([]string, 0)
+	for _, mods := range modsByPublisher {
+		errs, err := validateBidderParams(schemas, mods)
+		if err != nil {
+			return err
+		}
+		validationErrors = append(validationErrors, errs...)
+	}
+
+	if len(validationErrors) > 0 {
+		sort.Strings(validationErrors)
+		return fmt.Errorf("%w: existing params don't match schema: %v", ErrInvalidBidderParams, strings.Join(validationErrors, "; "))
+	}
+
+	oldMod, err := models.DPBidderSchemas(models.DPBidderSchemaWhere.DemandPartnerID.EQ(data.DemandPartnerID)).One(ctx, bcdb.DB())
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return eris.Wrapf(err, "failed to retrieve bidder schema of demand partner [%v]", data.DemandPartnerID)
+	}
+
+	if oldMod == nil {
+		mod := &models.DPBidderSchema{
+			DemandPartnerID: data.DemandPartnerID,
+			Schema:          types.JSON(data.Schema),
+			CreatedAt:       time.Now().UTC(),
+		}
+
+		err = mod.Insert(ctx, bcdb.DB(), boil.Infer())
+		if err != nil {
+			return eris.Wrapf(err, "failed to create bidder schema of demand partner [%v]", data.DemandPartnerID)
+		}
+
+		s.historyModule.SaveAction(ctx, nil, mod, &history.HistoryOptions{Subject: history.BidderSchemaSubject})
+		return nil
+	}
+
+	mod := *oldMod
+	mod.Schema = types.JSON(data.Schema)
+	mod.UpdatedAt = null.TimeFrom(time.Now().UTC())
+
+	_, err = mod.Update(ctx, bcdb.DB(), boil.Whitelist(models.DPBidderSchemaColumns.Schema, models.DPBidderSchemaColumns.UpdatedAt))
+	if err != nil {
+		return eris.Wrapf(err, "failed to update bidder schema of demand partner [%v]", data.DemandPartnerID)
+	}
+
+	s.historyModule.SaveAction(ctx, oldMod, &mod, &history.HistoryOptions{Subject: history.BidderSchemaSubject})
+
+	return nil
+}
+
+func (s *BidderParamsService) GetParams(ctx context.Context, ops *GetDPBidderParamsOptions) ([]*dto.DPBidderParams, error) {
+	qmods := ops.Filter.queryMod().
+		AddArray(rbac.PublisherScopeMods(ctx, models.DPBidderParamColumns.PublisherID)).
+		Order(ops.Order, nil, models.DPBidderParamColumns.ID).
+		AddArray(ops.Pagination.Do())
+
+	mods, err := models.DPBidderParams(qmods...).All(ctx, bcdb.DB())
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, eris.Wrap(err, "failed to retrieve bidder params")
+	}
+
+	params := make([]*dto.DPBidderParams, 0, len(mods))
+	for _, mod := range mods {
+		param := &dto.DPBidderParams{}
+		param.FromModel(mod)
+		params = append(params, param)
+	}
+
+	return params, nil
+}
+
+// SetParams creates or replaces bidder params of demand partner for publisher or domain,
+// params are validated against schema of demand partner and resolved params of publisher are sent to metadata
+func (s *BidderParamsService) SetParams(ctx context.Context, data *dto.DPBidderParams) error {
+	err := rbac.CheckPublisher(ctx, data.PublisherID)
+	if err != nil {
+		return err
+	}
+
+	schemas, err := loadBidderSchemas(ctx, bcdb.DB(), []string{data.DemandPartnerID})
+	if err != nil {
+		return err
+	}
+
+	if _, ok := schemas[data.DemandPartnerID]; !ok {
+		return fmt.Errorf("%w [%v]", ErrNoBidderSchema, data.DemandPartnerID)
+	}
+
+	tx, err := bcdb.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return eris.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	oldMods, err := getPublisherBidderParams(ctx, tx, data.PublisherID)
+	if err != nil {
+		return err
+	}
+
+	var (
+		oldMod *models.DPBidderParam
+		mod    = &models.DPBidderParam{
+			DemandPartnerID: data.DemandPartnerID,
+			PublisherID:     data.PublisherID,
+			Domain:          data.Domain,
+			Params:          types.JSON(data.Params),
+			CreatedAt:       time.Now().UTC(),
+		}
+		newMods = make(models.DPBidderParamSlice, 0, len(oldMods)+1)
+	)
+	for _, existing := range oldMods {
+		if existing.DemandPartnerID == data.DemandPartnerID && existing.Domain == data.Domain {
+			oldMod = existing
+			continue
+		}
+		newMods = append(newMods, existing)
+	}
+	newMods = append(newMods, mod)
+
+	errs, err := validateBidderParams(schemas, newMods)
+	if err != nil {
+		return err
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %v", ErrInvalidBidderParams, strings.Join(errs, "; "))
+	}
+
+	if oldMod == nil {
+		err = mod.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return eris.Wrap(err, "failed to create bidder params")
+		}
+	} else {
+		mod.ID = oldMod.ID
+		mod.CreatedAt = oldMod.CreatedAt
+		mod.UpdatedAt = null.TimeFrom(time.Now().UTC())
+
+		_, err = mod.Update(ctx, tx, boil.Whitelist(models.DPBidderParamColumns.Params, models.DPBidderParamColumns.UpdatedAt))
+		if err != nil {
+			return eris.Wrapf(err, "failed to update bidder params [%v]", mod.ID)
+		}
+	}
+
+	err = updateBidderParamsMetadata(ctx, tx, data.PublisherID, oldMods, newMods)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return eris.Wrap(err, "failed to commit bidder params")
+	}
+
+	s.historyModule.SaveAction(ctx, oldMod, mod, &history.HistoryOptions{Subject: history.BidderParamsSubject})
+
+	return nil
+}
+
+// DeleteParams deletes bidder params, deletion is rejected when remaining domain params become incomplete
+func (s *BidderParamsService) DeleteParams(ctx context.Context, ids []int) error {
+	mods, err := models.DPBidderParams(models.DPBidderParamWhere.ID.IN(ids)).All(ctx, bcdb.DB())
+	if err != nil {
+		return eris.Wrap(err, "failed to retrieve bidder params")
+	}
+
+	deletedIDs := make(map[int]struct{}, len(mods))
+	demandPartnerIDs := make([]string, 0, len(mods))
+	publisherIDs := make([]string, 0, len(mods))
+	for _, mod := range mods {
+		err := rbac.CheckPublisher(ctx, mod.PublisherID)
+		if err != nil {
+			return err
+		}
+
+		deletedIDs[mod.ID] = struct{}{}
+		demandPartnerIDs = append(demandPartnerIDs, mod.DemandPartnerID)
+		if !slices.Contains(publisherIDs, mod.PublisherID) {
+			publisherIDs = append(publisherIDs, mod.PublisherID)
+		}
+	}
+
+	schemas, err := loadBidderSchemas(ctx, bcdb.DB(), demandPartnerIDs)
+	if err != nil {
+		return err
+	}
+
+	tx, err := bcdb.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return eris.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	for _, publisherID := range publisherIDs {
+		oldMods, err := getPublisherBidderParams(ctx, tx, publisherID)
+		if err != nil {
+			return err
+		}
+
+		newMods := make(models.DPBidderParamSlice, 0, len(oldMods))
+		for _, mod := range oldMods {
+			if _, ok := deletedIDs[mod.ID]; !ok {
+				newMods = append(newMods, mod)
+			}
+		}
+
+		errs, err := validateBidderParams(schemas, newMods)
+		if err != nil {
+			return err
+		}
+
+		if len(errs) > 0 {
+			return fmt.Errorf("%w: remaining params become invalid: %v", ErrInvalidBidderParams, strings.Join(errs, "; "))
+		}
+
+		err = updateBidderParamsMetadata(ctx, tx, publisherID, oldMods, newMods)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = mods.DeleteAll(ctx, tx)
+	if err != nil {
+		return eris.Wrap(err, "failed to delete bidder params")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return eris.Wrap(err, "failed to commit bidder params deletion")
+	}
+
+	for _, mod := range mods {
+		s.historyModule.SaveAction(ctx, mod, nil, &history.HistoryOptions{Subject: history.BidderParamsSubject})
+	}
+
+	return nil
+}
+
+func parseBidderSchema(data []byte) (*jsonschema.Schema, error) {
+	schema, err := jsonschema.Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBidderSchema, err.Error())
+	}
+
+	if schema.Type != jsonschema.TypeObject {
+		return nil, fmt.Errorf("%w: top level type must be %v", ErrInvalidBidderSchema, jsonschema.TypeObject)
+	}
+
+	return schema, nil
+}
+
+func loadBidderSchemas(ctx context.Context, exec boil.ContextExecutor, demandPartnerIDs []string) (map[string]*jsonschema.Schema, error) {
+	mods, err := models.DPBidderSchemas(models.DPBidderSchemaWhere.DemandPartnerID.IN(demandPartnerIDs)).All(ctx, exec)
+	if err != nil {
+		return nil, eris.Wrap(err, "failed to retrieve bidder schemas")
+	}
+
+	schemas := make(map[string]*jsonschema.Schema, len(mods))
+	for _, mod := range mods {
+		schema, err := parseBidderSchema(mod.Schema)
+		if err != nil {
+			return nil, eris.Wrapf(err, "failed to parse bidder schema of demand partner [%v]", mod.DemandPartnerID)
+		}
+		schemas[mod.DemandPartnerID] = schema
+	}
+
+	return schemas, nil
+}
+
+func getPublisherBidderParams(ctx context.Context, exec boil.ContextExecutor, publisherID string) (models.DPBidderParamSlice, error) {
+	mods, err := models.DPBidderParams(
+		models.DPBidderParamWhere.PublisherID.EQ(publisherID),
+		qm.OrderBy(models.DPBidderParamColumns.ID),
+		qm.For("update"),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, eris.Wrapf(err, "failed to retrieve bidder params of publisher [%v]", publisherID)
+	}
+
+	return mods, nil
+}
+
+// resolveBidderParams resolves params of single publisher by domain, empty domain holds publisher params.
+// Domain params are merged over publisher params, domains also get publisher params of demand partners
+// which have no params for the domain
+func resolveBidderParams(mods models.DPBidderParamSlice) (map[string]bidderParamsByDP, error) {
+	publisherParams := make(bidderParamsByDP)
+	domainParams := make(map[string]bidderParamsByDP)
+	for _, mod := range mods {
+		params := make(map[string]any)
+		err := json.Unmarshal(mod.Params, &params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: params must be an object: %v", ErrInvalidBidderParams, err.Error())
+		}
+
+		if mod.Domain == "" {
+			publisherParams[mod.DemandPartnerID] = params
+			continue
+		}
+
+		if _, ok := domainParams[mod.Domain]; !ok {
+			domainParams[mod.Domain] = make(bidderParamsByDP)
+		}
+		domainParams[mod.Domain][mod.DemandPartnerID] = params
+	}
+
+	resolved := map[string]bidderParamsByDP{"": publisherParams}
+	for domain, paramsByDP := range domainParams {
+		resolved[domain] = make(bidderParamsByDP, len(publisherParams)+len(paramsByDP))
+		for demandPartnerID, params := range publisherParams {
+			resolved[domain][demandPartnerID] = params
+		}
+
+		for demandPartnerID, params := range paramsByDP {
+			merged := make(map[string]any, len(params))
+			maps.Copy(merged, publisherParams[demandPartnerID])
+			maps.Copy(merged, params)
+			resolved[domain][demandPartnerID] = merged
+		}
+	}
+
+	return resolved, nil
+}
+
+// validateBidderParams validates resolved params of single publisher against schemas of their demand partners.
+// Every resolved set is sent to metadata as is, publisher params included as domains without own params get them,
+// so all of them must be complete. Params of demand partners which are not in schemas are not validated
+func validateBidderParams(schemas map[string]*jsonschema.Schema, mods models.DPBidderParamSlice) ([]string, error) {
+	resolved, err := resolveBidderParams(mods)
+	if err != nil {
+		return nil, err
+	}
+
+	validationErrors := make([]string, 0)
+	for _, mod := range mods {
+		schema, ok := schemas[mod.DemandPartnerID]
+		if !ok {
+			continue
+		}
+
+		owner := fmt.Sprintf("publisher [%v]", mod.PublisherID)
+		if mod.Domain != "" {
+			owner = fmt.Sprintf("domain [%v:%v]", mod.PublisherID, mod.Domain)
+		}
+
+		for _, msg := range schema.Validate(resolved[mod.Domain][mod.DemandPartnerID]) {
+			validationErrors = append(validationErrors, fmt.Sprintf("%v of %v: %v", mod.DemandPartnerID, owner, msg))
+		}
+	}
+
+	return validationErrors, nil
+}
+
+// updateBidderParamsMetadata sends resolved params of publisher and its domains to metadata,
+// domains which had params before get publisher params once their own params are removed
+func updateBidderParamsMetadata(ctx context.Context, exec boil.ContextExecutor, publisherID string, oldMods, newMods models.DPBidderParamSlice) error {
+	resolved, err := resolveBidderParams(newMods)
+	if err != nil {
+		return err
+	}
+
+	domains := []string{""}
+	for _, mods := range []models.DPBidderParamSlice{oldMods, newMods} {
+		for _, mod := range mods {
+			if !slices.Contains(domains, mod.Domain) {
+				domains = append(domains, mod.Domain)
+			}
+		}
+	}
+	sort.Strings(domains)
+
+	for _, domain := range domains {
+		params, ok := resolved[domain]
+		if !ok {
+			params = resolved[""]
+		}
+
+		value, err := json.Marshal(params)
+		if err != nil {
+			return eris.Wrap(err, "failed to marshal bidder params for metadata")
+		}
+
+		mod := models.MetadataQueue{
+			Key:           buildBidderParamsKey(publisherID, domain),
+			TransactionID: bcguid.NewFromf(publisherID, domain, time.Now()),
+			Value:         value,
+		}
+
+		err = mod.Insert(ctx, exec, boil.Infer())
+		if err != nil {
+			return eris.Wrap(err, "failed to insert bidder params metadata update to queue")
+		}
+	}
+
+	return nil
+}
+
+func buildBidderParamsKey(publisherID, domain string) string {
+	key := utils.BidderParamsMetaDataKeyPrefix + ":" + publisherID
+	if domain != "" {
+		key = key + ":" + domain
+	}
+
+	return key
+}
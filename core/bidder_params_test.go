@@ -0,0 +1,134 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/m6yf/bcwork/models"
+	"github.com/m6yf/bcwork/modules/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+func TestResolveBidderParams(t *testing.T) {
+	t.Parallel()
+
+	mods := models.DPBidderParamSlice{
+		{DemandPartnerID: "dp1", PublisherID: "999", Params: types.JSON(`{"siteId": "1", "placementId": 1}`)},
+		{DemandPartnerID: "dp1", PublisherID: "999", Domain: "example.com", Params: types.JSON(`{"placementId": 2}`)},
+		{DemandPartnerID: "dp2", PublisherID: "999", Domain: "example.org", Params: types.JSON(`{"zone": "a"}`)},
+	}
+
+	got, err := resolveBidderParams(mods)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]bidderParamsByDP{
+		"": {
+			"dp1": {"siteId": "1", "placementId": float64(1)},
+		},
+		"example.com": {
+			"dp1": {"siteId": "1", "placementId": float64(2)},
+		},
+		"example.org": {
+			"dp1": {"siteId": "1", "placementId": float64(1)},
+			"dp2": {"zone": "a"},
+		},
+	}, got)
+}
+
+func TestValidateBidderParams(t *testing.T) {
+	t.Parallel()
+
+	schema, err := jsonschema.Parse([]byte(`{
+		"type": "object",
+		"properties": {"siteId": {"type": "string"}, "placementId": {"type": "integer"}},
+		"required": ["siteId", "placementId"]
+	}`))
+	assert.NoError(t, err)
+	schemas := map[string]*jsonschema.Schema{"dp1": schema}
+
+	tests := []struct {
+		name    string
+		mods    models.DPBidderParamSlice
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "domainCompletedByPublisher",
+			mods: models.DPBidderParamSlice{
+				{DemandPartnerID: "dp1", PublisherID: "999", Params: types.JSON(`{"siteId": "1", "placementId": 1}`)},
+				{DemandPartnerID: "dp1", PublisherID: "999", Domain: "example.com", Params: types.JSON(`{"placementId": 2}`)},
+			},
+			want: []string{},
+		},
+		{
+			name: "partialPublisher",
+			mods: models.DPBidderParamSlice{
+				{DemandPartnerID: "dp1", PublisherID: "999", Params: types.JSON(`{"siteId": "1"}`)},
+				{DemandPartnerID: "dp1", PublisherID: "999", Domain: "example.com", Params: types.JSON(`{"placementId": 2}`)},
+			},
+			want: []string{
+				"dp1 of publisher [999]: placementId: is required",
+			},
+		},
+		{
+			name: "incompleteDomain",
+			mods: models.DPBidderParamSlice{
+				{DemandPartnerID: "dp1", PublisherID: "999", Params: types.JSON(`{"siteId": 1}`)},
+				{DemandPartnerID: "dp1", PublisherID: "999", Domain: "example.com", Params: types.JSON(`{"siteId": "2"}`)},
+			},
+			want: []string{
+				"dp1 of publisher [999]: placementId: is required",
+				"dp1 of publisher [999]: siteId: must be string",
+				"dp1 of domain [999:example.com]: placementId: is required",
+			},
+		},
+		{
+			name: "demandPartnerWithoutSchema",
+			mods: models.DPBidderParamSlice{
+				{DemandPartnerID: "dp2", PublisherID: "999", Domain: "example.com", Params: types.JSON(`{"any": true}`)},
+			},
+			want: []string{},
+		},
+		{
+			name: "notObject",
+			mods: models.DPBidderParamSlice{
+				{DemandPartnerID: "dp1", PublisherID: "999", Params: types.JSON(`[]`)},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := validateBidderParams(schemas, tt.mods)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidBidderParams)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseBidderSchema(t *testing.T) {
+	t.Parallel()
+
+	_, err := parseBidderSchema([]byte(`{"type": "object", "properties": {"siteId": {"type": "string"}}}`))
+	assert.NoError(t, err)
+
+	_, err = parseBidderSchema([]byte(`{"type": "string"}`))
+	assert.ErrorIs(t, err, ErrInvalidBidderSchema)
+
+	_, err = parseBidderSchema([]byte(`{"type": "object", "properties": {"siteId": {"type": "text"}}}`))
+	assert.ErrorIs(t, err, ErrInvalidBidderSchema)
+}
+
+func TestBuildBidderParamsKey(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "bidder:params:999", buildBidderParamsKey("999", ""))
+	assert.Equal(t, "bidder:params:999:example.com", buildBidderParamsKey("999", "example.com"))
+}
@@ -1,25 +1,29 @@
 package core
 
 import (
-	"bytes"
 	"context"
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/bcdb/filter"
+	"github.com/m6yf/bcwork/bcdb/order"
+	"github.com/m6yf/bcwork/bcdb/pagination"
+	"github.com/m6yf/bcwork/bcdb/qmods"
 	"github.com/m6yf/bcwork/dto"
 	"github.com/m6yf/bcwork/models"
 	"github.com/m6yf/bcwork/modules/history"
+	"github.com/m6yf/bcwork/modules/iab"
 	"github.com/m6yf/bcwork/utils/bcguid"
+	"github.com/rotisserie/eris"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
-	"github.com/volatiletech/sqlboiler/v4/queries"
-)
-
-const (
-	blockTypeBADV = "badv"
-	blockTypeBCAT = "bcat"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
 type BlocksService struct {
@@ -32,138 +36,490 @@ func NewBlocksService(historyModule history.HistoryModule) *BlocksService {
 	}
 }
 
-var query = `SELECT metadata_queue.*
-FROM metadata_queue,(select key,max(created_at) created_at FROM metadata_queue WHERE key LIKE 'bcat:%' OR key like 'badv:%' group by key) last
-WHERE last.created_at=metadata_queue.created_at
-    AND last.key=metadata_queue.key `
+// GetBlocks returns current (not expired) lists of requested types for publisher (and domain),
+// all lists are returned when publisher or types are not provided
+func (b *BlocksService) GetBlocks(ctx context.Context, request *dto.BlockGetRequest) ([]*dto.BlockList, error) {
+	now := null.TimeFrom(time.Now().UTC())
+	mods := qmods.QueryModsSlice{
+		qm.Expr(
+			models.BlockWhere.ExpiresAt.IsNull(),
+			qm.Or2(models.BlockWhere.ExpiresAt.GT(now)),
+		),
+	}
 
-var sortQuery = ` ORDER by metadata_queue.key`
+	if request.Publisher != "" && len(request.Types) > 0 {
+		mods = mods.Add(
+			models.BlockWhere.Publisher.EQ(request.Publisher),
+			models.BlockWhere.Domain.EQ(request.Domain),
+			models.BlockWhere.Type.IN(request.Types),
+		)
+	}
 
-func (b *BlocksService) GetBlocks(ctx context.Context, request *dto.BlockGetRequest) (models.MetadataQueueSlice, error) {
-	key := createKeyForQuery(request)
-	records := models.MetadataQueueSlice{}
+	mods = mods.Add(qm.OrderBy(fmt.Sprintf("%v, %v, %v, %v",
+		models.BlockColumns.Type, models.BlockColumns.Publisher, models.BlockColumns.Domain, models.BlockColumns.Value)))
 
-	err := queries.Raw(query+key+sortQuery).Bind(ctx, bcdb.DB(), &records)
+	blocks, err := models.Blocks(mods...).All(ctx, bcdb.DB())
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch all price factors: %w", err)
+		return nil, eris.Wrap(err, "failed to retrieve blocks")
 	}
 
-	return records, nil
+	return toBlockLists(blocks), nil
 }
 
-func (b *BlocksService) UpdateBlocks(ctx context.Context, data *dto.BlockUpdateRequest) error {
-	request := &dto.BlockGetRequest{
-		Types:     []string{blockTypeBADV, blockTypeBCAT},
-		Publisher: data.Publisher,
+// toBlockLists groups ordered entries into lists by type, publisher and domain
+func toBlockLists(mods models.BlockSlice) []*dto.BlockList {
+	lists := make([]*dto.BlockList, 0)
+	var list *dto.BlockList
+	for _, mod := range mods {
+		if list == nil || list.Type != mod.Type || list.Publisher != mod.Publisher || list.Domain != mod.Domain {
+			list = &dto.BlockList{
+				Key:       buildBlockKey(mod.Type, mod.Publisher, mod.Domain),
+				Publisher: mod.Publisher,
+				Domain:    mod.Domain,
+				Type:      mod.Type,
+				Value:     make([]string, 0),
+			}
+			lists = append(lists, list)
+		}
+
+		list.Value = append(list.Value, mod.Value)
+		updatedAt := mod.UpdatedAt.Time
+		if !mod.UpdatedAt.Valid || updatedAt.Before(mod.CreatedAt) {
+			updatedAt = mod.CreatedAt
+		}
+		if updatedAt.After(list.UpdatedAt) {
+			list.UpdatedAt = updatedAt
+		}
 	}
 
-	if data.Domain != "" {
-		request.Domain = data.Domain
+	return lists
+}
+
+func buildBlockKey(blockType, publisher, domain string) string {
+	key := fmt.Sprintf("%s:%s", blockType, publisher)
+	if domain != "" {
+		key += ":" + domain
 	}
 
-	records, err := b.GetBlocks(ctx, request)
+	return key
+}
+
+type GetBlockItemsOptions struct {
+	Filter     BlockItemsFilter       `json:"filter"`
+	Pagination *pagination.Pagination `json:"pagination"`
+	Order      order.Sort             `json:"order"`
+	Selector   string                 `json:"selector"`
+}
+
+type BlockItemsFilter struct {
+	Publisher filter.StringArrayFilter `json:"publisher,omitempty"`
+	Domain    filter.StringArrayFilter `json:"domain,omitempty"`
+	Type      filter.StringArrayFilter `json:"type,omitempty"`
+	Value     filter.StringArrayFilter `json:"value,omitempty"`
+	Search    string                   `json:"search,omitempty"`
+	Expired   *bool                    `json:"expired,omitempty"`
+}
+
+func (b *BlocksService) GetBlockItems(ctx context.Context, ops *GetBlockItemsOptions) ([]*dto.Block, error) {
+	qmods := ops.Filter.queryMod().
+		Order(ops.Order, nil, models.BlockColumns.ID).
+		AddArray(ops.Pagination.Do())
+
+	mods, err := models.Blocks(qmods...).All(ctx, bcdb.DB())
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, eris.Wrap(err, "failed to retrieve blocks")
+	}
+
+	blocks := make([]*dto.Block, 0, len(mods))
+	for _, mod := range mods {
+		block := &dto.Block{}
+		block.FromModel(mod)
+		blocks = append(blocks, block)
+	}
+
+	return blocks, nil
+}
+
+// UpdateBlocks replaces whole lists of provided types for publisher (and domain)
+func (b *BlocksService) UpdateBlocks(ctx context.Context, data *dto.BlockUpdateRequest) error {
+	tx, err := bcdb.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	oldData, err := getBlocksSnapshot(ctx, tx, data.Publisher, data.Domain)
 	if err != nil {
 		return fmt.Errorf("failed to get previous block records: %w", err)
 	}
 
-	var (
-		badv []string
-		bcat []string
-	)
-	for _, record := range records {
-		if strings.HasPrefix(record.Key, blockTypeBADV) {
-			err := json.Unmarshal(record.Value, &badv)
-			if err != nil {
-				return fmt.Errorf("failed to unmarshal badv previous value: %w", err)
-			}
+	types := make([]string, 0, 2)
+	lists := map[string][]string{dto.BlockTypeBADV: data.BADV, dto.BlockTypeBCAT: data.BCAT}
+	for _, blockType := range []string{dto.BlockTypeBADV, dto.BlockTypeBCAT} {
+		if lists[blockType] == nil {
+			continue
 		}
 
-		if strings.HasPrefix(record.Key, blockTypeBCAT) {
-			err := json.Unmarshal(record.Value, &bcat)
-			if err != nil {
-				return fmt.Errorf("failed to unmarshal bcat previous value: %w", err)
-			}
+		values, err := normalizeBlockValues(blockType, lists[blockType])
+		if err != nil {
+			return err
 		}
+
+		err = replaceBlocks(ctx, tx, data.Publisher, data.Domain, blockType, values)
+		if err != nil {
+			return fmt.Errorf("failed to replace blocks %v: %w", blockType, err)
+		}
+		types = append(types, blockType)
+	}
+
+	newData, err := b.publishBlocks(ctx, tx, data.Publisher, data.Domain, types)
+	if err != nil {
+		return err
 	}
 
-	var oldData any
-	if len(records) > 0 {
-		oldData = &dto.BlockUpdateRequest{
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction in blocks update: %w", err)
+	}
+
+	b.historyModule.SaveAction(ctx, toAny(oldData), newData, nil)
+
+	return nil
+}
+
+// AddBlockItems adds entries to publisher (and domain) lists, existing entries get new reason and expiry
+func (b *BlocksService) AddBlockItems(ctx context.Context, data *dto.BlockItemsRequest) error {
+	return b.changeBlockItems(ctx, data, func(tx *sql.Tx, item dto.BlockItem) error {
+		value, err := NormalizeBlockValue(item.Type, item.Value)
+		if err != nil {
+			return err
+		}
+
+		mod := &models.Block{
 			Publisher: data.Publisher,
 			Domain:    data.Domain,
-			BADV:      badv,
-			BCAT:      bcat,
+			Type:      item.Type,
+			Value:     value,
+			Reason:    null.NewString(item.Reason, item.Reason != ""),
+			ExpiresAt: null.TimeFromPtr(item.ExpiresAt),
+			CreatedAt: time.Now().UTC(),
+			UpdatedAt: null.TimeFrom(time.Now().UTC()),
 		}
+
+		return mod.Upsert(
+			ctx,
+			tx,
+			true,
+			[]string{models.BlockColumns.Publisher, models.BlockColumns.Domain, models.BlockColumns.Type, models.BlockColumns.Value},
+			boil.Whitelist(models.BlockColumns.Reason, models.BlockColumns.ExpiresAt, models.BlockColumns.UpdatedAt),
+			boil.Infer(),
+		)
+	})
+}
+
+// RemoveBlockItems removes entries from publisher (and domain) lists.
+// Value is matched as provided and in normalized form, so entries stored before normalization can be removed as well.
+func (b *BlocksService) RemoveBlockItems(ctx context.Context, data *dto.BlockItemsRequest) error {
+	return b.changeBlockItems(ctx, data, func(tx *sql.Tx, item dto.BlockItem) error {
+		values := []string{item.Value}
+		if value, err := NormalizeBlockValue(item.Type, item.Value); err == nil && value != item.Value {
+			values = append(values, value)
+		}
+
+		_, err := models.Blocks(
+			models.BlockWhere.Publisher.EQ(data.Publisher),
+			models.BlockWhere.Domain.EQ(data.Domain),
+			models.BlockWhere.Type.EQ(item.Type),
+			models.BlockWhere.Value.IN(values),
+		).DeleteAll(ctx, tx)
+
+		return err
+	})
+}
+
+// RemoveExpiredBlocks deletes entries which expiry passed and republishes affected lists
+func (b *BlocksService) RemoveExpiredBlocks(ctx context.Context) error {
+	mods, err := models.Blocks(
+		models.BlockWhere.ExpiresAt.LTE(null.TimeFrom(time.Now().UTC())),
+		qm.OrderBy(models.BlockColumns.Publisher+", "+models.BlockColumns.Domain),
+	).All(ctx, bcdb.DB())
+	if err != nil {
+		return eris.Wrap(err, "failed to retrieve expired blocks")
 	}
 
-	if data.BADV != nil {
-		if err := updateDB(ctx, blockTypeBADV, data.Publisher, data.Domain, data.BADV); err != nil {
-			return fmt.Errorf("failed to insert blocks badv metadata update to queue: %w", err)
+	requests := make(map[string]*dto.BlockItemsRequest)
+	for _, mod := range mods {
+		key := mod.Publisher + ":" + mod.Domain
+		if _, ok := requests[key]; !ok {
+			requests[key] = &dto.BlockItemsRequest{Publisher: mod.Publisher, Domain: mod.Domain}
 		}
+		requests[key].Items = append(requests[key].Items, dto.BlockItem{Type: mod.Type, Value: mod.Value})
 	}
 
-	if data.BCAT != nil {
-		if err := updateDB(ctx, blockTypeBCAT, data.Publisher, data.Domain, data.BCAT); err != nil {
-			return fmt.Errorf("failed to insert blocks bcat metadata update to queue: %w", err)
+	for key, request := range requests {
+		err := b.RemoveBlockItems(ctx, request)
+		if err != nil {
+			return fmt.Errorf("failed to remove expired blocks for [%v]: %w", key, err)
 		}
 	}
 
-	b.historyModule.SaveAction(ctx, oldData, data, nil)
+	return nil
+}
+
+func (b *BlocksService) changeBlockItems(ctx context.Context, data *dto.BlockItemsRequest, change func(tx *sql.Tx, item dto.BlockItem) error) error {
+	tx, err := bcdb.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	oldData, err := getBlocksSnapshot(ctx, tx, data.Publisher, data.Domain)
+	if err != nil {
+		return fmt.Errorf("failed to get previous block records: %w", err)
+	}
+
+	types := make([]string, 0, 2)
+	for _, item := range data.Items {
+		err := change(tx, item)
+		if err != nil {
+			return fmt.Errorf("failed to change block item [%v:%v]: %w", item.Type, item.Value, err)
+		}
+
+		if !slices.Contains(types, item.Type) {
+			types = append(types, item.Type)
+		}
+	}
+
+	newData, err := b.publishBlocks(ctx, tx, data.Publisher, data.Domain, types)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction in blocks items change: %w", err)
+	}
+
+	subject := history.BlockPublisherSubject
+	if data.Domain != "" {
+		subject = history.BlockDomainSubject
+	}
+	b.historyModule.SaveAction(ctx, toAny(oldData), newData, &history.HistoryOptions{Subject: subject})
 
 	return nil
 }
 
-func updateDB(ctx context.Context, businessType, publisher, domain string, value interface{}) error {
+// publishBlocks pushes current (not expired) lists of provided types to metadata and returns all lists after change
+func (b *BlocksService) publishBlocks(ctx context.Context, tx *sql.Tx, publisher, domain string, types []string) (*dto.BlockUpdateRequest, error) {
+	now := time.Now().UTC()
+	for _, blockType := range types {
+		mods, err := models.Blocks(
+			models.BlockWhere.Publisher.EQ(publisher),
+			models.BlockWhere.Domain.EQ(domain),
+			models.BlockWhere.Type.EQ(blockType),
+			qm.Expr(
+				models.BlockWhere.ExpiresAt.IsNull(),
+				qm.Or2(models.BlockWhere.ExpiresAt.GT(null.TimeFrom(now))),
+			),
+			qm.OrderBy(models.BlockColumns.Value),
+		).All(ctx, tx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get %v blocks for metadata: %w", blockType, err)
+		}
+
+		values := make([]string, 0, len(mods))
+		for _, mod := range mods {
+			values = append(values, mod.Value)
+		}
+
+		if err := updateDB(ctx, tx, blockType, publisher, domain, values); err != nil {
+			return nil, fmt.Errorf("failed to insert blocks %v metadata update to queue: %w", blockType, err)
+		}
+	}
+
+	newData, err := getBlocksSnapshot(ctx, tx, publisher, domain)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current block records: %w", err)
+	}
+
+	if newData == nil {
+		newData = &dto.BlockUpdateRequest{Publisher: publisher, Domain: domain, BADV: []string{}, BCAT: []string{}}
+	}
+
+	return newData, nil
+}
+
+// NormalizeBlockValue validates advertiser domain (badv) or IAB content category (bcat)
+// and returns value in the form it's stored and published
+func NormalizeBlockValue(blockType, value string) (string, error) {
+	switch blockType {
+	case dto.BlockTypeBADV:
+		return iab.NormalizeAdvertiserDomain(value)
+	case dto.BlockTypeBCAT:
+		value = strings.TrimSpace(value)
+		// legacy ids are case insensitive (IAB1-1)
+		if strings.HasPrefix(strings.ToUpper(value), "IAB") {
+			value = strings.ToUpper(value)
+		}
+
+		if !iab.IsValidCategory(value) {
+			return "", fmt.Errorf("bcat [%v] is not a category of IAB content taxonomy %v", value, iab.Versions())
+		}
+
+		return value, nil
+	}
+
+	return "", fmt.Errorf("unknown block type [%v]", blockType)
+}
+
+// normalizeBlockValues normalizes values of the list and drops duplicates which appear after normalization
+func normalizeBlockValues(blockType string, values []string) ([]string, error) {
+	normalized := make([]string, 0, len(values))
+	for _, value := range values {
+		normalizedValue, err := NormalizeBlockValue(blockType, value)
+		if err != nil {
+			return nil, err
+		}
+
+		if !slices.Contains(normalized, normalizedValue) {
+			normalized = append(normalized, normalizedValue)
+		}
+	}
+
+	return normalized, nil
+}
+
+func replaceBlocks(ctx context.Context, tx *sql.Tx, publisher, domain, blockType string, values []string) error {
+	_, err := models.Blocks(
+		models.BlockWhere.Publisher.EQ(publisher),
+		models.BlockWhere.Domain.EQ(domain),
+		models.BlockWhere.Type.EQ(blockType),
+		models.BlockWhere.Value.NIN(values),
+	).DeleteAll(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("failed to delete blocks: %w", err)
+	}
+
+	for _, value := range values {
+		mod := &models.Block{
+			Publisher: publisher,
+			Domain:    domain,
+			Type:      blockType,
+			Value:     value,
+			CreatedAt: time.Now().UTC(),
+		}
+
+		err := mod.Upsert(
+			ctx,
+			tx,
+			false,
+			[]string{models.BlockColumns.Publisher, models.BlockColumns.Domain, models.BlockColumns.Type, models.BlockColumns.Value},
+			boil.None(),
+			boil.Infer(),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert block [%v]: %w", value, err)
+		}
+	}
+
+	return nil
+}
+
+// getBlocksSnapshot returns all lists of publisher (and domain) in the shape used for history, nil if there are no entries
+func getBlocksSnapshot(ctx context.Context, exec boil.ContextExecutor, publisher, domain string) (*dto.BlockUpdateRequest, error) {
+	mods, err := models.Blocks(
+		models.BlockWhere.Publisher.EQ(publisher),
+		models.BlockWhere.Domain.EQ(domain),
+		qm.OrderBy(models.BlockColumns.Value),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(mods) == 0 {
+		return nil, nil
+	}
+
+	snapshot := &dto.BlockUpdateRequest{
+		Publisher: publisher,
+		Domain:    domain,
+		BADV:      []string{},
+		BCAT:      []string{},
+	}
+	for _, mod := range mods {
+		switch mod.Type {
+		case dto.BlockTypeBADV:
+			snapshot.BADV = append(snapshot.BADV, mod.Value)
+		case dto.BlockTypeBCAT:
+			snapshot.BCAT = append(snapshot.BCAT, mod.Value)
+		}
+	}
+
+	return snapshot, nil
+}
+
+// toAny keeps nil snapshot as untyped nil, so history treats change as creation
+func toAny(snapshot *dto.BlockUpdateRequest) any {
+	if snapshot == nil {
+		return nil
+	}
+
+	return snapshot
+}
+
+func updateDB(ctx context.Context, exec boil.ContextExecutor, businessType, publisher, domain string, value interface{}) error {
 	b, err := json.Marshal(value)
 	if err != nil {
 		return err
 	}
 
 	mod := models.MetadataQueue{
-		Key:           fmt.Sprintf("%s:%s", businessType, publisher),
+		Key:           buildBlockKey(businessType, publisher, domain),
 		TransactionID: bcguid.NewFromf(publisher, domain, businessType, time.Now()),
 		Value:         b,
 	}
 
-	if domain != "" {
-		mod.Key += ":" + domain
-	}
-
-	if err := mod.Insert(ctx, bcdb.DB(), boil.Infer()); err != nil {
+	if err := mod.Insert(ctx, exec, boil.Infer()); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func createKeyForQuery(request *dto.BlockGetRequest) string {
-	types := request.Types
-	publisher := request.Publisher
-	domain := request.Domain
+func (filter *BlockItemsFilter) queryMod() qmods.QueryModsSlice {
+	mods := make(qmods.QueryModsSlice, 0)
+	if filter == nil {
+		return mods
+	}
 
-	var query bytes.Buffer
+	if len(filter.Publisher) > 0 {
+		mods = append(mods, filter.Publisher.AndIn(models.BlockColumns.Publisher))
+	}
 
-	//If no publisher or no business types or empty body than return all
-	if len(publisher) == 0 || len(types) == 0 {
-		query.WriteString(` and 1=1 `)
+	if len(filter.Domain) > 0 {
+		mods = append(mods, filter.Domain.AndIn(models.BlockColumns.Domain))
+	}
 
-		return query.String()
+	if len(filter.Type) > 0 {
+		mods = append(mods, filter.Type.AndIn(models.BlockColumns.Type))
 	}
 
-	for index, btype := range types {
-		if index == 0 {
-			query.WriteString("AND (")
-		}
-		if len(publisher) != 0 && len(domain) != 0 {
-			query.WriteString(" (metadata_queue.key = '" + btype + ":" + publisher + ":" + domain + "')")
-		} else if len(publisher) != 0 {
-			query.WriteString(" (metadata_queue.key = '" + btype + ":" + publisher + "')")
-		}
-		if index < len(types)-1 {
-			query.WriteString(" OR")
+	if len(filter.Value) > 0 {
+		mods = append(mods, filter.Value.AndIn(models.BlockColumns.Value))
+	}
+
+	if filter.Search != "" {
+		mods = append(mods, qm.Where(models.BlockColumns.Value+" ILIKE ?", "%"+filter.Search+"%"))
+	}
+
+	if filter.Expired != nil {
+		if *filter.Expired {
+			mods = append(mods, models.BlockWhere.ExpiresAt.LTE(null.TimeFrom(time.Now().UTC())))
+		} else {
+			mods = append(mods, qm.Expr(
+				models.BlockWhere.ExpiresAt.IsNull(),
+				qm.Or2(models.BlockWhere.ExpiresAt.GT(null.TimeFrom(time.Now().UTC()))),
+			))
 		}
 	}
-	query.WriteString(")")
 
-	return query.String()
+	return mods
 }
@@ -1,47 +1,102 @@
 package core
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v8"
 )
 
-func TestCreateKeyForQuery(t *testing.T) {
-	testCases := []struct {
-		name     string
-		request  *dto.BlockGetRequest
-		expected string
+func Test_toBlockLists(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	updatedAt := time.Date(2025, 4, 2, 0, 0, 0, 0, time.UTC)
+
+	mods := models.BlockSlice{
+		{Publisher: "999", Type: dto.BlockTypeBADV, Value: "a.com", CreatedAt: createdAt},
+		{Publisher: "999", Type: dto.BlockTypeBADV, Value: "b.com", CreatedAt: createdAt, UpdatedAt: null.TimeFrom(updatedAt)},
+		{Publisher: "999", Domain: "example.com", Type: dto.BlockTypeBADV, Value: "c.com", CreatedAt: createdAt},
+		{Publisher: "999", Type: dto.BlockTypeBCAT, Value: "IAB1", CreatedAt: createdAt},
+	}
+
+	got := toBlockLists(mods)
+	assert.Equal(t, []*dto.BlockList{
+		{Key: "badv:999", Publisher: "999", Type: dto.BlockTypeBADV, Value: []string{"a.com", "b.com"}, UpdatedAt: updatedAt},
+		{Key: "badv:999:example.com", Publisher: "999", Domain: "example.com", Type: dto.BlockTypeBADV, Value: []string{"c.com"}, UpdatedAt: createdAt},
+		{Key: "bcat:999", Publisher: "999", Type: dto.BlockTypeBCAT, Value: []string{"IAB1"}, UpdatedAt: createdAt},
+	}, got)
+	assert.Equal(t, []*dto.BlockList{}, toBlockLists(nil))
+}
+
+func Test_parseBlockItemsCSV(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	expiry := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		file      string
+		want      *dto.BlockUploadReport
+		wantLines map[string]int
+		wantErr   bool
 	}{
 		{
-			name:     "Empty request",
-			request:  &dto.BlockGetRequest{},
-			expected: " and 1=1 ",
+			name: "validInvalidAndDuplicates",
+			file: "Type,Value,Reason,Expires_At\n" +
+				"badv,https://www.Advertiser.com/,complaint,2025-05-01\n" +
+				"bcat,iab7-39,,\n" +
+				"badv,advertiser.com,,\n" +
+				",,,\n" +
+				"bcat,gambling,,\n" +
+				"badv,other.com,,2025-03-01\n" +
+				"bapp,com.app,,\n",
+			want: &dto.BlockUploadReport{
+				Valid: []dto.BlockItem{
+					{Type: "badv", Value: "advertiser.com", Reason: "complaint", ExpiresAt: &expiry},
+					{Type: "bcat", Value: "IAB7-39"},
+				},
+				Invalid: []dto.BlockUploadEntry{
+					{Line: 6, Type: "bcat", Value: "gambling", Error: "bcat [gambling] is not a category of IAB content taxonomy [1.0 2.2 3.0]"},
+					{Line: 7, Type: "badv", Value: "other.com", Error: "expires_at [2025-03-01] is in the past"},
+					{Line: 8, Type: "bapp", Value: "com.app", Error: "block type must be 'badv' or 'bcat'"},
+				},
+				Duplicates: []dto.BlockUploadEntry{
+					{Line: 4, Type: "badv", Value: "advertiser.com", Error: "duplicate of line 2"},
+				},
+			},
+			wantLines: map[string]int{"badv:advertiser.com": 2, "bcat:IAB7-39": 3},
 		},
 		{
-			name: "Publisher and types provided",
-			request: &dto.BlockGetRequest{
-				Types:     []string{"badv", "bcat"},
-				Publisher: "publisher",
-				Domain:    "domain",
-			},
-			expected: "AND ( (metadata_queue.key = 'badv:publisher:domain') OR (metadata_queue.key = 'bcat:publisher:domain'))",
+			name:    "missingValueColumn",
+			file:    "type,reason\nbadv,complaint\n",
+			wantErr: true,
 		},
 		{
-			name: "Publisher provided, no domain",
-			request: &dto.BlockGetRequest{
-				Types:     []string{"badv", "cbat"},
-				Publisher: "publisher",
-			},
-			expected: "AND ( (metadata_queue.key = 'badv:publisher') OR (metadata_queue.key = 'cbat:publisher'))",
+			name:    "empty",
+			file:    "",
+			wantErr: true,
 		},
 	}
 
-	for _, tc := range testCases {
-		t.Run(tc.name, func(t *testing.T) {
-			result := createKeyForQuery(tc.request)
-			if result != tc.expected {
-				t.Errorf("Test %s failed: expected '%s', got '%s'", tc.name, tc.expected, result)
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, lines, err := parseBlockItemsCSV(strings.NewReader(tt.file), now)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
 			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantLines, lines)
 		})
 	}
 }
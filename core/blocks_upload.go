@@ -0,0 +1,226 @@
+package core
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/models"
+	"github.com/m6yf/bcwork/modules/iab"
+)
+
+const (
+	blockUploadTypeColumn      = "type"
+	blockUploadValueColumn     = "value"
+	blockUploadReasonColumn    = "reason"
+	blockUploadExpiresAtColumn = "expires_at"
+)
+
+// UploadBlockItems parses csv block list of publisher (and domain) with columns type, value and optional reason, expires_at.
+// Invalid entries and duplicates (in file or already blocked) are reported and skipped,
+// valid entries are added unless preview is requested.
+func (b *BlocksService) UploadBlockItems(ctx context.Context, publisher, domain string, file io.Reader, preview bool) (*dto.BlockUploadReport, error) {
+	report, lines, err := parseBlockItemsCSV(file, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := models.Blocks(
+		models.BlockWhere.Publisher.EQ(publisher),
+		models.BlockWhere.Domain.EQ(domain),
+	).All(ctx, bcdb.DB())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current blocks: %w", err)
+	}
+
+	blocked := make(map[string]struct{}, len(existing))
+	for _, mod := range existing {
+		blocked[mod.Type+":"+mod.Value] = struct{}{}
+	}
+	markAlreadyBlocked(report, lines, blocked)
+
+	if preview || len(report.Valid) == 0 {
+		return report, nil
+	}
+
+	err = b.AddBlockItems(ctx, &dto.BlockItemsRequest{Publisher: publisher, Domain: domain, Items: report.Valid})
+	if err != nil {
+		return nil, err
+	}
+	report.Committed = true
+
+	return report, nil
+}
+
+// parseBlockItemsCSV returns report of file entries and lines of valid entries by type and value
+func parseBlockItemsCSV(file io.Reader, now time.Time) (*dto.BlockUploadReport, map[string]int, error) {
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil, errors.New("csv file is empty")
+		}
+		return nil, nil, fmt.Errorf("failed to read csv header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		columns[strings.ToLower(strings.TrimSpace(column))] = i
+	}
+	for _, column := range []string{blockUploadTypeColumn, blockUploadValueColumn} {
+		if _, ok := columns[column]; !ok {
+			return nil, nil, fmt.Errorf("csv header must contain column [%v]", column)
+		}
+	}
+
+	report := &dto.BlockUploadReport{
+		Valid:      []dto.BlockItem{},
+		Invalid:    []dto.BlockUploadEntry{},
+		Duplicates: []dto.BlockUploadEntry{},
+	}
+	seen := make(map[string]int)
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read csv line %v: %w", line, err)
+		}
+
+		get := func(column string) string {
+			idx, ok := columns[column]
+			if !ok || idx >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[idx])
+		}
+
+		entry := dto.BlockUploadEntry{
+			Line:  line,
+			Type:  strings.ToLower(get(blockUploadTypeColumn)),
+			Value: get(blockUploadValueColumn),
+		}
+		if entry.Type == "" && entry.Value == "" {
+			continue
+		}
+
+		item, err := parseBlockUploadEntry(entry, get(blockUploadReasonColumn), get(blockUploadExpiresAtColumn), now)
+		if err != nil {
+			entry.Error = err.Error()
+			report.Invalid = append(report.Invalid, entry)
+			continue
+		}
+
+		key := item.Type + ":" + item.Value
+		if firstLine, ok := seen[key]; ok {
+			entry.Error = fmt.Sprintf("duplicate of line %v", firstLine)
+			report.Duplicates = append(report.Duplicates, entry)
+			continue
+		}
+		seen[key] = line
+		report.Valid = append(report.Valid, item)
+	}
+
+	return report, seen, nil
+}
+
+func parseBlockUploadEntry(entry dto.BlockUploadEntry, reason, expiresAt string, now time.Time) (dto.BlockItem, error) {
+	if !slices.Contains([]string{dto.BlockTypeBADV, dto.BlockTypeBCAT}, entry.Type) {
+		return dto.BlockItem{}, fmt.Errorf("block type must be '%v' or '%v'", dto.BlockTypeBADV, dto.BlockTypeBCAT)
+	}
+
+	value, err := NormalizeBlockValue(entry.Type, entry.Value)
+	if err != nil {
+		return dto.BlockItem{}, err
+	}
+
+	item := dto.BlockItem{Type: entry.Type, Value: value, Reason: reason}
+	if expiresAt != "" {
+		expiry, err := parseBlockExpiry(expiresAt)
+		if err != nil {
+			return dto.BlockItem{}, err
+		}
+		if !expiry.After(now) {
+			return dto.BlockItem{}, fmt.Errorf("expires_at [%v] is in the past", expiresAt)
+		}
+		item.ExpiresAt = &expiry
+	}
+
+	return item, nil
+}
+
+func parseBlockExpiry(value string) (time.Time, error) {
+	for _, layout := range []string{time.RFC3339, time.DateOnly} {
+		expiry, err := time.Parse(layout, value)
+		if err == nil {
+			return expiry.UTC(), nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("expires_at [%v] must be in format YYYY-MM-DD or RFC3339", value)
+}
+
+// markAlreadyBlocked moves valid entries which are already in the lists to duplicates
+func markAlreadyBlocked(report *dto.BlockUploadReport, lines map[string]int, blocked map[string]struct{}) {
+	valid := make([]dto.BlockItem, 0, len(report.Valid))
+	for _, item := range report.Valid {
+		key := item.Type + ":" + item.Value
+		if _, ok := blocked[key]; ok {
+			report.Duplicates = append(report.Duplicates, dto.BlockUploadEntry{
+				Line:  lines[key],
+				Type:  item.Type,
+				Value: item.Value,
+				Error: "already blocked",
+			})
+			continue
+		}
+		valid = append(valid, item)
+	}
+	report.Valid = valid
+}
+
+// GetTaxonomyCategories returns IAB content taxonomy used for bcat validation (latest version by default)
+// with equivalent categories in another version if requested
+func (b *BlocksService) GetTaxonomyCategories(request *dto.TaxonomyRequest) ([]*dto.TaxonomyCategory, error) {
+	version := iab.ContentTaxonomyV3
+	if request.Version != "" {
+		version = iab.TaxonomyVersion(request.Version)
+	}
+
+	categories, err := iab.GetCategories(version)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*dto.TaxonomyCategory, 0, len(categories))
+	for _, category := range categories {
+		taxonomyCategory := &dto.TaxonomyCategory{
+			ID:       category.ID,
+			ParentID: category.ParentID,
+			Name:     category.Name,
+			Version:  string(category.Version),
+		}
+
+		if request.MapTo != "" {
+			taxonomyCategory.Mapped, err = iab.MapCategory(category.ID, version, iab.TaxonomyVersion(request.MapTo))
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		result = append(result, taxonomyCategory)
+	}
+
+	return result, nil
+}
@@ -15,9 +15,12 @@ import (
 type Bulker interface {
 	BulkInsertDPO(ctx context.Context, requests []dto.DPORuleUpdateRequest) error
 	BulkInsertFactors(ctx context.Context, requests []FactorUpdateRequest) error
+	BulkInsertFactorsAndFloors(ctx context.Context, request *FactorFloorUpdateRequest) error
 	BulkInsertGlobalFactors(ctx context.Context, requests []GlobalFactorRequest) error
 	BulkDeleteFactor(ctx context.Context, ids []string) error
 	BulkDeleteFloor(ctx context.Context, ids []string) error
+	PausePublishersRules(ctx context.Context, publisherIDs []string) error
+	ResumePublishersRules(ctx context.Context, publisherIDs []string) error
 }
 
 type Adjuster interface {
@@ -70,7 +70,9 @@ func createTables(db *sqlx.DB) {
 		"old_value jsonb," +
 		"new_value jsonb," +
 		"changes jsonb," +
-		"date timestamp not null" +
+		"date timestamp not null," +
+		"approver_id int," +
+		"api_key_id int" +
 		");",
 	)
 	tx.MustExec("CREATE TABLE IF NOT EXISTS metadata_queue (transaction_id varchar(36) primary key not null, key varchar(256), version varchar(16),value varchar(512),commited_instances integer, created_at timestamp, updated_at timestamp)")
@@ -99,5 +101,8 @@ func createTables(db *sqlx.DB) {
 		"VALUES ($1,$2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)",
 		"1234", "finkiel.com", "gb", "mobile", 2, "2024-12-01 14:24:33.100", "2024-12-01 14:24:33.100", "e81337e9-983c-50f9-9fca-e1f2131c5ed0", "", nil, nil, nil, true)
 
+	tx.MustExec("CREATE TABLE IF NOT EXISTS publisher_paused_rule (id serial primary key, publisher_id varchar(64) not null, rule_type varchar(16) not null, rule_id varchar(36) not null, created_at timestamp not null, updated_at timestamp)")
+	tx.MustExec("CREATE UNIQUE INDEX IF NOT EXISTS publisher_paused_rule_rule_idx on publisher_paused_rule (rule_type, rule_id)")
+
 	tx.Commit()
 }
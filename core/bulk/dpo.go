@@ -173,7 +173,10 @@ func prepareDPODataForMetadata(ctx context.Context, demandPartners map[string]st
 	var metaDataQueue []models.MetadataQueue
 
 	for demandPartner := range demandPartners {
-		modDpos, err := models.DpoRules(models.DpoRuleWhere.DemandPartnerID.EQ(demandPartner)).All(ctx, tx)
+		modDpos, err := models.DpoRules(
+			models.DpoRuleWhere.DemandPartnerID.EQ(demandPartner),
+			models.DpoRuleWhere.Active.EQ(true),
+		).All(ctx, tx)
 		if err != nil {
 			return nil, fmt.Errorf("cannot get dpo rules for demand partner id [%v]: %w", demandPartner, err)
 		}
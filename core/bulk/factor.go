@@ -49,13 +49,7 @@ func (b *BulkService) BulkInsertFactors(ctx context.Context, requests []FactorUp
 	}
 	defer tx.Rollback()
 
-	pubDomains := make(map[string]struct{})
-	oldMods, newMods, err := handleBulkFactor(ctx, tx, chunks, pubDomains, len(requests))
-	if err != nil {
-		return err
-	}
-
-	err = handleMetaDataFactorRules(ctx, pubDomains, tx)
+	oldMods, newMods, err := insertFactors(ctx, tx, chunks, len(requests))
 	if err != nil {
 		return err
 	}
@@ -69,6 +63,21 @@ func (b *BulkService) BulkInsertFactors(ctx context.Context, requests []FactorUp
 	return nil
 }
 
+func insertFactors(ctx context.Context, tx *sql.Tx, chunks [][]FactorUpdateRequest, requestsAmount int) ([]any, []any, error) {
+	pubDomains := make(map[string]struct{})
+	oldMods, newMods, err := handleBulkFactor(ctx, tx, chunks, pubDomains, requestsAmount)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = handleMetaDataFactorRules(ctx, pubDomains, tx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return oldMods, newMods, nil
+}
+
 func (f *BulkService) BulkDeleteFactor(ctx context.Context, ids []string) error {
 	mods, err := models.Factors(models.FactorWhere.RuleID.IN(ids)).All(ctx, bcdb.DB())
 	if err != nil {
@@ -92,6 +101,11 @@ func (f *BulkService) BulkDeleteFactor(ctx context.Context, ids []string) error
 		return fmt.Errorf("failed soft deleting factor rules: %w", err)
 	}
 
+	err = deletePausedRules(ctx, bcdb.DB(), pausedRuleTypeFactor, ids)
+	if err != nil {
+		return err
+	}
+
 	err = updateFactorInMetaData(ctx, pubDomains)
 	if err != nil {
 		return err
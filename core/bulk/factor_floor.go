@@ -0,0 +1,60 @@
+package bulk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/modules/history"
+)
+
+// FactorFloorUpdateRequest is a set of factor and floor updates which must be applied together
+type FactorFloorUpdateRequest struct {
+	Factors []FactorUpdateRequest    `json:"factors"`
+	Floors  []dto.FloorUpdateRequest `json:"floors"`
+}
+
+// BulkInsertFactorsAndFloors applies factor and floor updates in one transaction, so either all of them are applied or none
+func (b *BulkService) BulkInsertFactorsAndFloors(ctx context.Context, request *FactorFloorUpdateRequest) error {
+	factorChunks, err := makeChunksFactor(request.Factors)
+	if err != nil {
+		return fmt.Errorf("failed to create chunks for factor updates: %w", err)
+	}
+
+	floorChunks, err := makeChunksFloor(request.Floors)
+	if err != nil {
+		return fmt.Errorf("failed to create chunks for floors updates: %w", err)
+	}
+
+	tx, err := bcdb.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var oldMods, newMods []any
+	if len(request.Factors) > 0 {
+		oldMods, newMods, err = insertFactors(ctx, tx, factorChunks, len(request.Factors))
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(request.Floors) > 0 {
+		err = insertFloors(ctx, tx, floorChunks)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction in factor and floor bulk update: %w", err)
+	}
+
+	if len(request.Factors) > 0 {
+		b.historyModule.SaveAction(ctx, oldMods, newMods, &history.HistoryOptions{Subject: history.FactorSubject, IsMultipleValuesExpected: true})
+	}
+
+	return nil
+}
@@ -37,13 +37,7 @@ func BulkInsertFloors(ctx context.Context, requests []dto.FloorUpdateRequest) er
 	}
 	defer tx.Rollback()
 
-	pubDomains := make(map[string]struct{})
-	err = handleBulkFloor(ctx, chunks, pubDomains, tx)
-	if err != nil {
-		return err
-	}
-
-	err = handleMetaDataFloorRules(ctx, pubDomains, tx)
+	err = insertFloors(ctx, tx, chunks)
 	if err != nil {
 		return err
 	}
@@ -55,6 +49,16 @@ func BulkInsertFloors(ctx context.Context, requests []dto.FloorUpdateRequest) er
 	return nil
 }
 
+func insertFloors(ctx context.Context, tx *sql.Tx, chunks [][]dto.FloorUpdateRequest) error {
+	pubDomains := make(map[string]struct{})
+	err := handleBulkFloor(ctx, chunks, pubDomains, tx)
+	if err != nil {
+		return err
+	}
+
+	return handleMetaDataFloorRules(ctx, pubDomains, tx)
+}
+
 func (f *BulkService) BulkDeleteFloor(ctx context.Context, ids []string) error {
 	mods, err := models.Floors(models.FloorWhere.RuleID.IN(ids)).All(ctx, bcdb.DB())
 	if err != nil {
@@ -78,6 +82,11 @@ func (f *BulkService) BulkDeleteFloor(ctx context.Context, ids []string) error {
 		return fmt.Errorf("failed soft deleting floor rules: %w", err)
 	}
 
+	err = deletePausedRules(ctx, bcdb.DB(), pausedRuleTypeFloor, ids)
+	if err != nil {
+		return err
+	}
+
 	err = updateFloorInMetaData(ctx, pubDomains)
 	if err != nil {
 		return err
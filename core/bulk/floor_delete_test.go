@@ -30,11 +30,21 @@ func TestDeleteFloorMechanism(t *testing.T) {
 	assert.Equal(t, len(rules.Rule), 2)
 	assert.Equal(t, len(floors), 2)
 
+	//floor paused together with its publisher
+	_, err = bcdb.DB().Exec("INSERT INTO publisher_paused_rule (publisher_id, rule_type, rule_id, created_at) VALUES ($1, $2, $3, now())",
+		"1234", pausedRuleTypeFloor, "80ecfa53-2a28-548b-a371-743dbb22c439")
+	assert.NoError(t, err)
+
 	//delete 1 floor
 	ids := []string{"80ecfa53-2a28-548b-a371-743dbb22c439"}
 	err = service.BulkDeleteFloor(ctx, ids)
 	assert.NoError(t, err)
 
+	//checking that deleted floor won't be enabled back by resume of publisher
+	pausedRulesCount, err := models.PublisherPausedRules().Count(ctx, bcdb.DB())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), pausedRulesCount)
+
 	//checking that we have only 1 floor in metadata_queue
 	metaDataFloor, _ := models.MetadataQueues(models.MetadataQueueWhere.Key.EQ("price:floor:v2:1234:finkiel.com"), qm.OrderBy("updated_at desc")).One(ctx, bcdb.DB())
 	err = json.Unmarshal(metaDataFloor.Value, &rules)
@@ -0,0 +1,359 @@
+package bulk
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/models"
+	"github.com/m6yf/bcwork/utils/constant"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+const (
+	pausedRuleTypeFloor  = "floor"
+	pausedRuleTypeFactor = "factor"
+	pausedRuleTypeDPO    = "dpo"
+)
+
+// PausePublishersRules disables active floor, factor and dpo rules of publishers and republishes their metadata.
+// Disabled rules are remembered, so resume enables back only them while rules disabled before the pause stay disabled.
+// Rest of publishers metadata (e.g. bidder params, blocks and demand settings) isn't touched by the pause.
+func (b *BulkService) PausePublishersRules(ctx context.Context, publisherIDs []string) error {
+	if len(publisherIDs) == 0 {
+		return nil
+	}
+
+	tx, err := bcdb.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction for pausing publishers rules: %w", err)
+	}
+	defer tx.Rollback()
+
+	floors, err := models.Floors(
+		models.FloorWhere.Publisher.IN(publisherIDs),
+		models.FloorWhere.Active.EQ(true),
+	).All(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("failed getting floors for pausing: %w", err)
+	}
+
+	factors, err := models.Factors(
+		models.FactorWhere.Publisher.IN(publisherIDs),
+		models.FactorWhere.Active.EQ(true),
+	).All(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("failed getting factors for pausing: %w", err)
+	}
+
+	dpos, err := models.DpoRules(
+		models.DpoRuleWhere.Publisher.IN(publisherIDs),
+		models.DpoRuleWhere.Active.EQ(true),
+	).All(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("failed getting dpo rules for pausing: %w", err)
+	}
+
+	if len(floors) == 0 && len(factors) == 0 && len(dpos) == 0 {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	pausedRules := make([]*models.PublisherPausedRule, 0, len(floors)+len(factors)+len(dpos))
+
+	floorIDs := make([]string, 0, len(floors))
+	floorPubDomains := make(map[string]struct{})
+	for _, floor := range floors {
+		floorIDs = append(floorIDs, floor.RuleID)
+		floorPubDomains[floor.Publisher+":"+floor.Domain] = struct{}{}
+		pausedRules = append(pausedRules, &models.PublisherPausedRule{
+			PublisherID: floor.Publisher,
+			RuleType:    pausedRuleTypeFloor,
+			RuleID:      floor.RuleID,
+			CreatedAt:   now,
+		})
+	}
+
+	factorIDs := make([]string, 0, len(factors))
+	factorPubDomains := make(map[string]struct{})
+	for _, factor := range factors {
+		factorIDs = append(factorIDs, factor.RuleID)
+		factorPubDomains[factor.Publisher+":"+factor.Domain] = struct{}{}
+		pausedRules = append(pausedRules, &models.PublisherPausedRule{
+			PublisherID: factor.Publisher,
+			RuleType:    pausedRuleTypeFactor,
+			RuleID:      factor.RuleID,
+			CreatedAt:   now,
+		})
+	}
+
+	dpoIDs := make([]string, 0, len(dpos))
+	demandPartners := make(map[string]struct{})
+	for _, dpo := range dpos {
+		dpoIDs = append(dpoIDs, dpo.RuleID)
+		demandPartners[dpo.DemandPartnerID] = struct{}{}
+		pausedRules = append(pausedRules, &models.PublisherPausedRule{
+			PublisherID: dpo.Publisher.String,
+			RuleType:    pausedRuleTypeDPO,
+			RuleID:      dpo.RuleID,
+			CreatedAt:   now,
+		})
+	}
+
+	err = setPublisherRulesActive(ctx, tx, floorIDs, factorIDs, dpoIDs, false, now)
+	if err != nil {
+		return err
+	}
+
+	if err := bulkInsert(ctx, tx, prepareBulkInsertPausedRulesRequest(pausedRules)); err != nil {
+		return err
+	}
+
+	err = handlePublisherRulesMetaData(ctx, tx, floorPubDomains, factorPubDomains, demandPartners)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction for pausing publishers rules: %w", err)
+	}
+
+	return nil
+}
+
+// ResumePublishersRules enables back rules disabled by pause of publishers and republishes their metadata
+func (b *BulkService) ResumePublishersRules(ctx context.Context, publisherIDs []string) error {
+	if len(publisherIDs) == 0 {
+		return nil
+	}
+
+	tx, err := bcdb.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction for resuming publishers rules: %w", err)
+	}
+	defer tx.Rollback()
+
+	pausedRules, err := models.PublisherPausedRules(models.PublisherPausedRuleWhere.PublisherID.IN(publisherIDs)).All(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("failed getting paused rules of publishers: %w", err)
+	}
+
+	if len(pausedRules) == 0 {
+		return nil
+	}
+
+	var floorIDs, factorIDs, dpoIDs []string
+	for _, rule := range pausedRules {
+		switch rule.RuleType {
+		case pausedRuleTypeFloor:
+			floorIDs = append(floorIDs, rule.RuleID)
+		case pausedRuleTypeFactor:
+			factorIDs = append(factorIDs, rule.RuleID)
+		case pausedRuleTypeDPO:
+			dpoIDs = append(dpoIDs, rule.RuleID)
+		}
+	}
+
+	err = setPublisherRulesActive(ctx, tx, floorIDs, factorIDs, dpoIDs, true, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+
+	_, err = pausedRules.DeleteAll(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("failed deleting paused rules of publishers: %w", err)
+	}
+
+	floorPubDomains, err := getFloorPubDomains(ctx, tx, floorIDs)
+	if err != nil {
+		return err
+	}
+
+	factorPubDomains, err := getFactorPubDomains(ctx, tx, factorIDs)
+	if err != nil {
+		return err
+	}
+
+	demandPartners, err := getDPODemandPartners(ctx, tx, dpoIDs)
+	if err != nil {
+		return err
+	}
+
+	err = handlePublisherRulesMetaData(ctx, tx, floorPubDomains, factorPubDomains, demandPartners)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction for resuming publishers rules: %w", err)
+	}
+
+	return nil
+}
+
+func setPublisherRulesActive(ctx context.Context, tx *sql.Tx, floorIDs, factorIDs, dpoIDs []string, active bool, now time.Time) error {
+	if len(floorIDs) > 0 {
+		_, err := models.Floors(models.FloorWhere.RuleID.IN(floorIDs)).UpdateAll(ctx, tx, models.M{
+			models.FloorColumns.Active:    active,
+			models.FloorColumns.UpdatedAt: now,
+		})
+		if err != nil {
+			return fmt.Errorf("failed updating floors active to [%v]: %w", active, err)
+		}
+	}
+
+	if len(factorIDs) > 0 {
+		_, err := models.Factors(models.FactorWhere.RuleID.IN(factorIDs)).UpdateAll(ctx, tx, models.M{
+			models.FactorColumns.Active:    active,
+			models.FactorColumns.UpdatedAt: now,
+		})
+		if err != nil {
+			return fmt.Errorf("failed updating factors active to [%v]: %w", active, err)
+		}
+	}
+
+	if len(dpoIDs) > 0 {
+		_, err := models.DpoRules(models.DpoRuleWhere.RuleID.IN(dpoIDs)).UpdateAll(ctx, tx, models.M{
+			models.DpoRuleColumns.Active:    active,
+			models.DpoRuleColumns.UpdatedAt: now,
+		})
+		if err != nil {
+			return fmt.Errorf("failed updating dpo rules active to [%v]: %w", active, err)
+		}
+	}
+
+	return nil
+}
+
+// deletePausedRules forgets paused state of deleted rules, so resume of their publisher doesn't enable them back
+func deletePausedRules(ctx context.Context, exec boil.ContextExecutor, ruleType string, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	_, err := models.PublisherPausedRules(
+		models.PublisherPausedRuleWhere.RuleType.EQ(ruleType),
+		models.PublisherPausedRuleWhere.RuleID.IN(ids),
+	).DeleteAll(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("failed deleting paused state of %v rules: %w", ruleType, err)
+	}
+
+	return nil
+}
+
+func getFloorPubDomains(ctx context.Context, tx *sql.Tx, ids []string) (map[string]struct{}, error) {
+	pubDomains := make(map[string]struct{})
+	if len(ids) == 0 {
+		return pubDomains, nil
+	}
+
+	mods, err := models.Floors(models.FloorWhere.RuleID.IN(ids)).All(ctx, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting resumed floors: %w", err)
+	}
+
+	for _, mod := range mods {
+		pubDomains[mod.Publisher+":"+mod.Domain] = struct{}{}
+	}
+
+	return pubDomains, nil
+}
+
+func getFactorPubDomains(ctx context.Context, tx *sql.Tx, ids []string) (map[string]struct{}, error) {
+	pubDomains := make(map[string]struct{})
+	if len(ids) == 0 {
+		return pubDomains, nil
+	}
+
+	mods, err := models.Factors(models.FactorWhere.RuleID.IN(ids)).All(ctx, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting resumed factors: %w", err)
+	}
+
+	for _, mod := range mods {
+		pubDomains[mod.Publisher+":"+mod.Domain] = struct{}{}
+	}
+
+	return pubDomains, nil
+}
+
+func getDPODemandPartners(ctx context.Context, tx *sql.Tx, ids []string) (map[string]struct{}, error) {
+	demandPartners := make(map[string]struct{})
+	if len(ids) == 0 {
+		return demandPartners, nil
+	}
+
+	mods, err := models.DpoRules(models.DpoRuleWhere.RuleID.IN(ids)).All(ctx, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting resumed dpo rules: %w", err)
+	}
+
+	for _, mod := range mods {
+		demandPartners[mod.DemandPartnerID] = struct{}{}
+	}
+
+	return demandPartners, nil
+}
+
+func handlePublisherRulesMetaData(ctx context.Context, tx *sql.Tx, floorPubDomains, factorPubDomains, demandPartners map[string]struct{}) error {
+	if len(floorPubDomains) > 0 {
+		if err := handleMetaDataFloorRules(ctx, floorPubDomains, tx); err != nil {
+			return fmt.Errorf("failed to update RT metadata for publishers floors: %w", err)
+		}
+	}
+
+	if len(factorPubDomains) > 0 {
+		if err := handleMetaDataFactorRules(ctx, factorPubDomains, tx); err != nil {
+			return fmt.Errorf("failed to update RT metadata for publishers factors: %w", err)
+		}
+	}
+
+	if len(demandPartners) > 0 {
+		if err := handleMetaDataRules(ctx, demandPartners, tx); err != nil {
+			return fmt.Errorf("failed to update RT metadata for publishers dpo rules: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func prepareBulkInsertPausedRulesRequest(rules []*models.PublisherPausedRule) *bulkInsertRequest {
+	req := &bulkInsertRequest{
+		tableName: models.TableNames.PublisherPausedRule,
+		columns: []string{
+			models.PublisherPausedRuleColumns.PublisherID,
+			models.PublisherPausedRuleColumns.RuleType,
+			models.PublisherPausedRuleColumns.RuleID,
+			models.PublisherPausedRuleColumns.CreatedAt,
+		},
+		conflictColumns: []string{
+			models.PublisherPausedRuleColumns.RuleType,
+			models.PublisherPausedRuleColumns.RuleID,
+		},
+		updateColumns: []string{
+			models.PublisherPausedRuleColumns.PublisherID,
+		},
+		valueStrings: make([]string, 0, len(rules)),
+	}
+
+	multiplier := len(req.columns)
+	req.args = make([]interface{}, 0, len(rules)*multiplier)
+
+	for i, rule := range rules {
+		offset := i * multiplier
+		req.valueStrings = append(req.valueStrings,
+			fmt.Sprintf("($%v, $%v, $%v, $%v)", offset+1, offset+2, offset+3, offset+4),
+		)
+		req.args = append(req.args,
+			rule.PublisherID,
+			rule.RuleType,
+			rule.RuleID,
+			constant.PostgresCurrentTime,
+		)
+	}
+
+	return req
+}
@@ -0,0 +1,746 @@
+package core
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/bcdb/filter"
+	"github.com/m6yf/bcwork/bcdb/order"
+	"github.com/m6yf/bcwork/bcdb/pagination"
+	"github.com/m6yf/bcwork/bcdb/qmods"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/models"
+	"github.com/m6yf/bcwork/modules/compass"
+	"github.com/m6yf/bcwork/modules/history"
+	"github.com/m6yf/bcwork/modules/logger"
+	"github.com/m6yf/bcwork/modules/rbac"
+	"github.com/rotisserie/eris"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+const compassPublisherPath = "/publisher/%s"
+
+var ErrNoCompassConflict = errors.New("publisher has no conflict with compass")
+
+// compassSyncedColumns are publisher columns which are kept in sync with compass
+var compassSyncedColumns = []string{
+	models.PublisherColumns.Name,
+	models.PublisherColumns.AccountManagerID,
+	models.PublisherColumns.MediaBuyerID,
+	models.PublisherColumns.CampaignManagerID,
+	models.PublisherColumns.OfficeLocation,
+	models.PublisherColumns.PauseTimestamp,
+	models.PublisherColumns.StartTimestamp,
+	models.PublisherColumns.ReactivateTimestamp,
+}
+
+type CompassSyncService struct {
+	historyModule history.HistoryModule
+	compassModule compass.CompassModule
+}
+
+func NewCompassSyncService(historyModule history.HistoryModule, compassModule compass.CompassModule) *CompassSyncService {
+	return &CompassSyncService{
+		historyModule: historyModule,
+		compassModule: compassModule,
+	}
+}
+
+type GetCompassManagerOptions struct {
+	Filter     CompassManagerFilter   `json:"filter"`
+	Pagination *pagination.Pagination `json:"pagination"`
+	Order      order.Sort             `json:"order"`
+	Selector   string                 `json:"selector"`
+}
+
+type CompassManagerFilter struct {
+	CompassID filter.StringArrayFilter `json:"compass_id,omitempty"`
+	UserID    filter.IntArrayFilter    `json:"user_id,omitempty"`
+}
+
+type GetPublisherCompassSyncOptions struct {
+	Filter     PublisherCompassSyncFilter `json:"filter"`
+	Pagination *pagination.Pagination     `json:"pagination"`
+	Order      order.Sort                 `json:"order"`
+	Selector   string                     `json:"selector"`
+}
+
+type PublisherCompassSyncFilter struct {
+	PublisherID filter.StringArrayFilter `json:"publisher_id,omitempty"`
+	Status      filter.StringArrayFilter `json:"status,omitempty"`
+}
+
+func (filter *CompassManagerFilter) queryMod() qmods.QueryModsSlice {
+	mods := make(qmods.QueryModsSlice, 0)
+	if filter == nil {
+		return mods
+	}
+
+	if len(filter.CompassID) > 0 {
+		mods = append(mods, filter.CompassID.AndIn(models.CompassManagerColumns.CompassID))
+	}
+
+	if len(filter.UserID) > 0 {
+		mods = append(mods, filter.UserID.AndIn(models.CompassManagerColumns.UserID))
+	}
+
+	return mods
+}
+
+func (filter *PublisherCompassSyncFilter) queryMod() qmods.QueryModsSlice {
+	mods := make(qmods.QueryModsSlice, 0)
+	if filter == nil {
+		return mods
+	}
+
+	if len(filter.PublisherID) > 0 {
+		mods = append(mods, filter.PublisherID.AndIn(models.PublisherCompassSyncColumns.PublisherID))
+	}
+
+	if len(filter.Status) > 0 {
+		mods = append(mods, filter.Status.AndIn(models.PublisherCompassSyncColumns.Status))
+	}
+
+	return mods
+}
+
+// compassPublisher is a publisher in the format accepted by compass
+type compassPublisher struct {
+	Name            string               `json:"name"`
+	AccountManager  *compassPublisherRef `json:"accountManager,omitempty"`
+	MediaBuyer      *compassPublisherRef `json:"mediaBuyer,omitempty"`
+	CampaignManager *compassPublisherRef `json:"campaignManager,omitempty"`
+	OfficeLocation  string               `json:"officeLocation,omitempty"`
+	PausedDate      int64                `json:"pausedDate,omitempty"`
+	StartDate       int64                `json:"startDate,omitempty"`
+	ReactivatedDate int64                `json:"reactivatedDate,omitempty"`
+}
+
+type compassPublisherRef struct {
+	Id string `json:"id"`
+}
+
+func (s *CompassSyncService) GetManagers(ctx context.Context, ops *GetCompassManagerOptions) ([]*dto.CompassManager, error) {
+	qmods := ops.Filter.queryMod().
+		Order(ops.Order, nil, models.CompassManagerColumns.ID).
+		AddArray(ops.Pagination.Do())
+
+	mods, err := models.CompassManagers(qmods...).All(ctx, bcdb.DB())
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, eris.Wrap(err, "failed to retrieve compass managers")
+	}
+
+	managers := make([]*dto.CompassManager, 0, len(mods))
+	for _, mod := range mods {
+		manager := &dto.CompassManager{}
+		manager.FromModel(mod)
+		managers = append(managers, manager)
+	}
+
+	return managers, nil
+}
+
+// SetManagers maps compass managers to users, existing mapping of compass id is replaced
+func (s *CompassSyncService) SetManagers(ctx context.Context, data []*dto.CompassManager) error {
+	for _, manager := range data {
+		oldMod, err := models.CompassManagers(models.CompassManagerWhere.CompassID.EQ(manager.CompassID)).One(ctx, bcdb.DB())
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return eris.Wrapf(err, "failed to retrieve compass manager [%v]", manager.CompassID)
+		}
+
+		if oldMod == nil {
+			mod := &models.CompassManager{
+				CompassID: manager.CompassID,
+				UserID:    manager.UserID,
+				CreatedAt: time.Now().UTC(),
+			}
+
+			err = mod.Insert(ctx, bcdb.DB(), boil.Infer())
+			if err != nil {
+				return eris.Wrapf(err, "failed to create compass manager [%v]", manager.CompassID)
+			}
+
+			s.historyModule.SaveAction(ctx, nil, mod, &history.HistoryOptions{Subject: history.CompassManagerSubject})
+			continue
+		}
+
+		if oldMod.UserID == manager.UserID {
+			continue
+		}
+
+		mod := *oldMod
+		mod.UserID = manager.UserID
+		mod.UpdatedAt = null.TimeFrom(time.Now().UTC())
+
+		_, err = mod.Update(ctx, bcdb.DB(), boil.Whitelist(models.CompassManagerColumns.UserID, models.CompassManagerColumns.UpdatedAt))
+		if err != nil {
+			return eris.Wrapf(err, "failed to update compass manager [%v]", manager.CompassID)
+		}
+
+		s.historyModule.SaveAction(ctx, oldMod, &mod, &history.HistoryOptions{Subject: history.CompassManagerSubject})
+	}
+
+	return nil
+}
+
+func (s *CompassSyncService) DeleteManagers(ctx context.Context, ids []int) error {
+	mods, err := models.CompassManagers(models.CompassManagerWhere.ID.IN(ids)).All(ctx, bcdb.DB())
+	if err != nil {
+		return eris.Wrap(err, "failed to retrieve compass managers")
+	}
+
+	_, err = mods.DeleteAll(ctx, bcdb.DB())
+	if err != nil {
+		return eris.Wrap(err, "failed to delete compass managers")
+	}
+
+	for _, mod := range mods {
+		s.historyModule.SaveAction(ctx, mod, nil, &history.HistoryOptions{Subject: history.CompassManagerSubject})
+	}
+
+	return nil
+}
+
+// GetManagersMap returns user ids by ids of managers in compass
+func (s *CompassSyncService) GetManagersMap(ctx context.Context) (map[string]string, error) {
+	mods, err := models.CompassManagers().All(ctx, bcdb.DB())
+	if err != nil {
+		return nil, eris.Wrap(err, "failed to retrieve compass managers")
+	}
+
+	managersMap := make(map[string]string, len(mods))
+	for _, mod := range mods {
+		managersMap[mod.CompassID] = strconv.Itoa(mod.UserID)
+	}
+
+	return managersMap, nil
+}
+
+func (s *CompassSyncService) GetSyncStates(ctx context.Context, ops *GetPublisherCompassSyncOptions) ([]*dto.PublisherCompassSync, error) {
+	qmods := ops.Filter.queryMod().
+		AddArray(rbac.PublisherScopeMods(ctx, models.PublisherCompassSyncColumns.PublisherID)).
+		Order(ops.Order, nil, models.PublisherCompassSyncColumns.PublisherID).
+		AddArray(ops.Pagination.Do())
+
+	mods, err := models.PublisherCompassSyncs(qmods...).All(ctx, bcdb.DB())
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, eris.Wrap(err, "failed to retrieve publishers sync states")
+	}
+
+	states := make([]*dto.PublisherCompassSync, 0, len(mods))
+	for _, mod := range mods {
+		state := &dto.PublisherCompassSync{}
+		err := state.FromModel(mod)
+		if err != nil {
+			return nil, err
+		}
+		states = append(states, state)
+	}
+
+	return states, nil
+}
+
+// CheckPulledPublisher compares publisher loaded from compass with the local one and returns columns
+// which must keep local values. Columns changed locally which weren't pushed yet win over compass unless compass
+// changed any of them as well, i.e. its value differs from the one before the local change, then publisher is
+// marked as conflicting until the conflict is resolved.
+func (s *CompassSyncService) CheckPulledPublisher(ctx context.Context, compassMod *models.Publisher, skipColumns []string) ([]string, error) {
+	localMod, err := models.FindPublisher(ctx, bcdb.DB(), compassMod.PublisherID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// new publisher, it's created by pull and sync state is created on the next one
+			return nil, nil
+		}
+		return nil, eris.Wrapf(err, "failed to retrieve publisher [%v]", compassMod.PublisherID)
+	}
+
+	state, err := getCompassSyncState(ctx, bcdb.DB(), compassMod.PublisherID)
+	if err != nil {
+		return nil, err
+	}
+
+	localChanges, err := getCompassLocalChanges(state)
+	if err != nil {
+		return nil, err
+	}
+
+	keepColumns := make([]string, 0, len(localChanges))
+	for _, column := range compassSyncedColumns {
+		if _, ok := localChanges[column]; ok {
+			keepColumns = append(keepColumns, column)
+		}
+	}
+	if len(keepColumns) == 0 {
+		// local change was marked before changed columns were recorded
+		keepColumns = compassSyncedColumns
+	}
+
+	columns := make([]string, 0, len(keepColumns))
+	for _, column := range keepColumns {
+		if !slices.Contains(skipColumns, column) {
+			columns = append(columns, column)
+		}
+	}
+
+	diffs := DiffPublishers(localMod, compassMod, columns)
+	now := time.Now().UTC()
+
+	state.PulledAt = null.TimeFrom(now)
+	state.CompassValues = null.JSON{}
+	switch {
+	case !state.LocalChangedAt.Valid:
+		keepColumns = nil
+		state.Status = dto.CompassSyncStatusSynced
+		state.LatestTimestamp = null.Int64From(getCompassLatestTimestamp(compassMod))
+		// values of compass are applied as is, so there is nothing to report
+		diffs = nil
+	case len(diffs) == 0 || (state.Status != dto.CompassSyncStatusConflict && len(getCompassChangedDiffs(diffs, localChanges)) == 0):
+		state.Status = dto.CompassSyncStatusPending
+	default:
+		state.Status = dto.CompassSyncStatusConflict
+		values, err := json.Marshal(compassMod)
+		if err != nil {
+			return nil, eris.Wrap(err, "failed to marshal publisher from compass")
+		}
+		state.CompassValues = null.JSONFrom(values)
+	}
+
+	err = setCompassSyncDiffs(state, diffs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = upsertCompassSyncState(ctx, bcdb.DB(), state)
+	if err != nil {
+		return nil, err
+	}
+
+	if state.Status == dto.CompassSyncStatusConflict {
+		logger.Logger(ctx).Warn().Msgf("publisher [%v] was changed both locally and in compass", compassMod.PublisherID)
+	}
+
+	return keepColumns, nil
+}
+
+// PushPendingPublishers sends local changes of publishers to compass, conflicting publishers are skipped
+func (s *CompassSyncService) PushPendingPublishers(ctx context.Context) (int, error) {
+	states, err := models.PublisherCompassSyncs(
+		models.PublisherCompassSyncWhere.LocalChangedAt.IsNotNull(),
+		models.PublisherCompassSyncWhere.Status.NEQ(dto.CompassSyncStatusConflict),
+	).All(ctx, bcdb.DB())
+	if err != nil {
+		return 0, eris.Wrap(err, "failed to retrieve publishers pending push to compass")
+	}
+
+	if len(states) == 0 {
+		return 0, nil
+	}
+
+	managersMap, err := s.GetManagersMap(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	compassIDs := make(map[string]string, len(managersMap))
+	for compassID, userID := range managersMap {
+		compassIDs[userID] = compassID
+	}
+
+	var pushed int
+	for _, state := range states {
+		err := s.pushPublisher(ctx, state.PublisherID, compassIDs)
+		if err != nil {
+			logger.Logger(ctx).Error().Err(err).Msgf("failed to push publisher [%v] to compass", state.PublisherID)
+			state.Status = dto.CompassSyncStatusFailed
+			state.ErrorMessage = null.StringFrom(err.Error())
+		} else {
+			pushed++
+			state.Status = dto.CompassSyncStatusSynced
+			state.PushedAt = null.TimeFrom(time.Now().UTC())
+			state.LocalChangedAt = null.Time{}
+			state.LocalChangedColumns = null.JSON{}
+			state.ErrorMessage = null.String{}
+		}
+
+		err = upsertCompassSyncState(ctx, bcdb.DB(), state)
+		if err != nil {
+			return pushed, err
+		}
+	}
+
+	return pushed, nil
+}
+
+func (s *CompassSyncService) pushPublisher(ctx context.Context, publisherID string, compassIDs map[string]string) error {
+	mod, err := models.FindPublisher(ctx, bcdb.DB(), publisherID)
+	if err != nil {
+		return eris.Wrapf(err, "failed to retrieve publisher [%v]", publisherID)
+	}
+
+	body, err := json.Marshal(toCompassPublisher(mod, compassIDs))
+	if err != nil {
+		return eris.Wrap(err, "failed to marshal publisher for compass")
+	}
+
+	_, err = s.compassModule.Request(fmt.Sprintf(compassPublisherPath, publisherID), http.MethodPut, body, false)
+	if err != nil {
+		return eris.Wrap(err, "compass request failed")
+	}
+
+	return nil
+}
+
+// ResolveConflict resolves conflicting publisher by keeping either local values, which are pushed to compass then,
+// or values loaded from compass
+func (s *CompassSyncService) ResolveConflict(ctx context.Context, data *dto.PublisherSyncResolveRequest) error {
+	err := rbac.CheckPublisher(ctx, data.PublisherID)
+	if err != nil {
+		return err
+	}
+
+	state, err := getCompassSyncState(ctx, bcdb.DB(), data.PublisherID)
+	if err != nil {
+		return err
+	}
+
+	if state.Status != dto.CompassSyncStatusConflict || !state.CompassValues.Valid {
+		return fmt.Errorf("%w: publisher [%v]", ErrNoCompassConflict, data.PublisherID)
+	}
+
+	compassMod := &models.Publisher{}
+	err = json.Unmarshal(state.CompassValues.JSON, compassMod)
+	if err != nil {
+		return eris.Wrap(err, "failed to unmarshal publisher from compass")
+	}
+
+	state.LatestTimestamp = null.Int64From(getCompassLatestTimestamp(compassMod))
+	state.CompassValues = null.JSON{}
+	state.Diffs = null.JSON{}
+
+	if data.Keep == dto.CompassSyncKeepLocal {
+		state.Status = dto.CompassSyncStatusPending
+
+		// values of compass are known now, so only their further changes are conflicting
+		localChanges, err := getCompassLocalChanges(state)
+		if err != nil {
+			return err
+		}
+
+		for column := range localChanges {
+			localChanges[column], err = json.Marshal(publisherColumnValue(compassMod, column))
+			if err != nil {
+				return eris.Wrapf(err, "failed to marshal compass value of [%v]", column)
+			}
+		}
+
+		err = setCompassLocalChanges(state, localChanges)
+		if err != nil {
+			return err
+		}
+
+		return upsertCompassSyncState(ctx, bcdb.DB(), state)
+	}
+
+	localMod, err := models.FindPublisher(ctx, bcdb.DB(), data.PublisherID)
+	if err != nil {
+		return eris.Wrapf(err, "failed to retrieve publisher [%v]", data.PublisherID)
+	}
+
+	oldMod := *localMod
+	columns := applyPublisherColumns(localMod, compassMod, compassSyncedColumns)
+
+	tx, err := bcdb.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return eris.Wrap(err, "failed to begin transaction for conflict resolution")
+	}
+	defer tx.Rollback()
+
+	if len(columns) > 0 {
+		_, err = localMod.Update(ctx, tx, boil.Whitelist(columns...))
+		if err != nil {
+			return eris.Wrapf(err, "failed to update publisher [%v] by compass values", data.PublisherID)
+		}
+	}
+
+	state.Status = dto.CompassSyncStatusSynced
+	state.LocalChangedAt = null.Time{}
+	state.LocalChangedColumns = null.JSON{}
+	err = upsertCompassSyncState(ctx, tx, state)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return eris.Wrap(err, "failed to commit conflict resolution")
+	}
+
+	s.historyModule.SaveAction(ctx, &oldMod, localMod, &history.HistoryOptions{Subject: history.PublisherSubject})
+
+	return nil
+}
+
+// DiffPublishers returns fields with different values in local and compass publishers
+func DiffPublishers(localMod, compassMod *models.Publisher, columns []string) []dto.PublisherFieldDiff {
+	diffs := make([]dto.PublisherFieldDiff, 0)
+	for _, column := range columns {
+		localValue := publisherColumnValue(localMod, column)
+		compassValue := publisherColumnValue(compassMod, column)
+		if !reflect.DeepEqual(localValue, compassValue) {
+			diffs = append(diffs, dto.PublisherFieldDiff{Field: column, Local: localValue, Compass: compassValue})
+		}
+	}
+
+	return diffs
+}
+
+func publisherColumnValue(mod *models.Publisher, column string) any {
+	switch column {
+	case models.PublisherColumns.Name:
+		return mod.Name
+	case models.PublisherColumns.AccountManagerID:
+		return mod.AccountManagerID.Ptr()
+	case models.PublisherColumns.MediaBuyerID:
+		return mod.MediaBuyerID.Ptr()
+	case models.PublisherColumns.CampaignManagerID:
+		return mod.CampaignManagerID.Ptr()
+	case models.PublisherColumns.OfficeLocation:
+		return mod.OfficeLocation.Ptr()
+	case models.PublisherColumns.PauseTimestamp:
+		return mod.PauseTimestamp.Ptr()
+	case models.PublisherColumns.StartTimestamp:
+		return mod.StartTimestamp.Ptr()
+	case models.PublisherColumns.ReactivateTimestamp:
+		return mod.ReactivateTimestamp.Ptr()
+	}
+
+	return nil
+}
+
+// applyPublisherColumns copies columns with different values from source to target and returns copied columns
+func applyPublisherColumns(target, source *models.Publisher, columns []string) []string {
+	diffs := DiffPublishers(target, source, columns)
+
+	applied := make([]string, 0, len(diffs))
+	for _, diff := range diffs {
+		switch diff.Field {
+		case models.PublisherColumns.Name:
+			target.Name = source.Name
+		case models.PublisherColumns.AccountManagerID:
+			target.AccountManagerID = source.AccountManagerID
+		case models.PublisherColumns.MediaBuyerID:
+			target.MediaBuyerID = source.MediaBuyerID
+		case models.PublisherColumns.CampaignManagerID:
+			target.CampaignManagerID = source.CampaignManagerID
+		case models.PublisherColumns.OfficeLocation:
+			target.OfficeLocation = source.OfficeLocation
+		case models.PublisherColumns.PauseTimestamp:
+			target.PauseTimestamp = source.PauseTimestamp
+		case models.PublisherColumns.StartTimestamp:
+			target.StartTimestamp = source.StartTimestamp
+		case models.PublisherColumns.ReactivateTimestamp:
+			target.ReactivateTimestamp = source.ReactivateTimestamp
+		}
+		applied = append(applied, diff.Field)
+	}
+
+	return applied
+}
+
+func toCompassPublisher(mod *models.Publisher, compassIDs map[string]string) *compassPublisher {
+	ref := func(userID null.String) *compassPublisherRef {
+		if !userID.Valid || userID.String == "" {
+			return nil
+		}
+
+		// users without mapping are sent as is, the same way they were loaded
+		id, ok := compassIDs[userID.String]
+		if !ok {
+			id = userID.String
+		}
+
+		return &compassPublisherRef{Id: id}
+	}
+
+	return &compassPublisher{
+		Name:            mod.Name,
+		AccountManager:  ref(mod.AccountManagerID),
+		MediaBuyer:      ref(mod.MediaBuyerID),
+		CampaignManager: ref(mod.CampaignManagerID),
+		OfficeLocation:  mod.OfficeLocation.String,
+		PausedDate:      mod.PauseTimestamp.Int64,
+		StartDate:       mod.StartTimestamp.Int64,
+		ReactivatedDate: mod.ReactivateTimestamp.Int64,
+	}
+}
+
+// markCompassLocalChange marks publisher to be pushed to compass when columns synced with compass were updated.
+// Changed columns are recorded with their values before the first local change to detect changes of them in compass.
+func markCompassLocalChange(ctx context.Context, exec boil.ContextExecutor, oldMod, mod *models.Publisher, columns []string) error {
+	syncedColumns := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if slices.Contains(compassSyncedColumns, column) {
+			syncedColumns = append(syncedColumns, column)
+		}
+	}
+
+	diffs := DiffPublishers(oldMod, mod, syncedColumns)
+	if len(diffs) == 0 {
+		return nil
+	}
+
+	state, err := getCompassSyncState(ctx, exec, mod.PublisherID)
+	if err != nil {
+		return err
+	}
+
+	localChanges, err := getCompassLocalChanges(state)
+	if err != nil {
+		return err
+	}
+
+	for _, diff := range diffs {
+		if _, ok := localChanges[diff.Field]; ok {
+			continue
+		}
+
+		localChanges[diff.Field], err = json.Marshal(diff.Local)
+		if err != nil {
+			return eris.Wrapf(err, "failed to marshal value of [%v] before local change", diff.Field)
+		}
+	}
+
+	err = setCompassLocalChanges(state, localChanges)
+	if err != nil {
+		return err
+	}
+
+	state.LocalChangedAt = null.TimeFrom(time.Now().UTC())
+	if state.Status != dto.CompassSyncStatusConflict {
+		state.Status = dto.CompassSyncStatusPending
+	}
+
+	return upsertCompassSyncState(ctx, exec, state)
+}
+
+// getCompassChangedDiffs returns diffs of locally changed columns which were changed in compass as well
+func getCompassChangedDiffs(diffs []dto.PublisherFieldDiff, localChanges map[string]json.RawMessage) []dto.PublisherFieldDiff {
+	changed := make([]dto.PublisherFieldDiff, 0)
+	for _, diff := range diffs {
+		before, ok := localChanges[diff.Field]
+		if !ok {
+			// value before the local change is unknown, so any difference could be a change in compass
+			changed = append(changed, diff)
+			continue
+		}
+
+		compassValue, err := json.Marshal(diff.Compass)
+		if err != nil || string(compassValue) != string(before) {
+			changed = append(changed, diff)
+		}
+	}
+
+	return changed
+}
+
+// getCompassLocalChanges returns values before the local change by changed columns
+func getCompassLocalChanges(state *models.PublisherCompassSync) (map[string]json.RawMessage, error) {
+	localChanges := make(map[string]json.RawMessage)
+	if !state.LocalChangedColumns.Valid {
+		return localChanges, nil
+	}
+
+	err := json.Unmarshal(state.LocalChangedColumns.JSON, &localChanges)
+	if err != nil {
+		return nil, eris.Wrapf(err, "failed to unmarshal local changes of publisher [%v]", state.PublisherID)
+	}
+
+	return localChanges, nil
+}
+
+func setCompassLocalChanges(state *models.PublisherCompassSync, localChanges map[string]json.RawMessage) error {
+	if len(localChanges) == 0 {
+		state.LocalChangedColumns = null.JSON{}
+		return nil
+	}
+
+	data, err := json.Marshal(localChanges)
+	if err != nil {
+		return eris.Wrapf(err, "failed to marshal local changes of publisher [%v]", state.PublisherID)
+	}
+	state.LocalChangedColumns = null.JSONFrom(data)
+
+	return nil
+}
+
+func getCompassSyncState(ctx context.Context, exec boil.ContextExecutor, publisherID string) (*models.PublisherCompassSync, error) {
+	mod, err := models.PublisherCompassSyncs(models.PublisherCompassSyncWhere.PublisherID.EQ(publisherID)).One(ctx, exec)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, eris.Wrapf(err, "failed to retrieve sync state of publisher [%v]", publisherID)
+		}
+		// latest timestamp is taken from the publisher, otherwise the next pull is treated as a change in compass
+		publisher, err := models.FindPublisher(ctx, exec, publisherID,
+			models.PublisherColumns.PublisherID, models.PublisherColumns.StartTimestamp, models.PublisherColumns.ReactivateTimestamp)
+		if err != nil {
+			return nil, eris.Wrapf(err, "failed to retrieve publisher [%v]", publisherID)
+		}
+
+		mod = &models.PublisherCompassSync{
+			PublisherID:     publisherID,
+			Status:          dto.CompassSyncStatusSynced,
+			LatestTimestamp: null.Int64From(getCompassLatestTimestamp(publisher)),
+			CreatedAt:       time.Now().UTC(),
+		}
+	}
+
+	return mod, nil
+}
+
+// getCompassLatestTimestamp returns the latest change of publisher status known to compass
+func getCompassLatestTimestamp(mod *models.Publisher) int64 {
+	return max(mod.StartTimestamp.Int64, mod.ReactivateTimestamp.Int64)
+}
+
+func upsertCompassSyncState(ctx context.Context, exec boil.ContextExecutor, state *models.PublisherCompassSync) error {
+	state.UpdatedAt = null.TimeFrom(time.Now().UTC())
+
+	err := state.Upsert(
+		ctx,
+		exec,
+		true,
+		[]string{models.PublisherCompassSyncColumns.PublisherID},
+		boil.Blacklist(models.PublisherCompassSyncColumns.ID, models.PublisherCompassSyncColumns.CreatedAt),
+		boil.Infer(),
+	)
+	if err != nil {
+		return eris.Wrapf(err, "failed to save sync state of publisher [%v]", state.PublisherID)
+	}
+
+	return nil
+}
+
+func setCompassSyncDiffs(state *models.PublisherCompassSync, diffs []dto.PublisherFieldDiff) error {
+	if len(diffs) == 0 {
+		state.Diffs = null.JSON{}
+		return nil
+	}
+
+	data, err := json.Marshal(diffs)
+	if err != nil {
+		return eris.Wrapf(err, "failed to marshal diffs of publisher [%v]", state.PublisherID)
+	}
+	state.Diffs = null.JSONFrom(data)
+
+	return nil
+}
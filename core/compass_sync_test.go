@@ -0,0 +1,164 @@
+package core
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestDiffPublishers(t *testing.T) {
+	t.Parallel()
+
+	local := &models.Publisher{
+		PublisherID:      "1",
+		Name:             "local",
+		AccountManagerID: null.StringFrom("10"),
+		StartTimestamp:   null.Int64From(1000),
+	}
+
+	tests := []struct {
+		name    string
+		compass *models.Publisher
+		columns []string
+		want    []dto.PublisherFieldDiff
+	}{
+		{
+			name:    "noDiffs",
+			compass: &models.Publisher{PublisherID: "1", Name: "local", AccountManagerID: null.StringFrom("10"), StartTimestamp: null.Int64From(1000)},
+			columns: compassSyncedColumns,
+			want:    []dto.PublisherFieldDiff{},
+		},
+		{
+			name:    "changedFields",
+			compass: &models.Publisher{PublisherID: "1", Name: "compass", AccountManagerID: null.StringFrom("11"), StartTimestamp: null.Int64From(1000)},
+			columns: compassSyncedColumns,
+			want: []dto.PublisherFieldDiff{
+				{Field: models.PublisherColumns.Name, Local: "local", Compass: "compass"},
+				{Field: models.PublisherColumns.AccountManagerID, Local: func() *string { v := "10"; return &v }(), Compass: func() *string { v := "11"; return &v }()},
+			},
+		},
+		{
+			name:    "nullInCompass",
+			compass: &models.Publisher{PublisherID: "1", Name: "local", AccountManagerID: null.StringFrom("10")},
+			columns: compassSyncedColumns,
+			want: []dto.PublisherFieldDiff{
+				{Field: models.PublisherColumns.StartTimestamp, Local: func() *int64 { v := int64(1000); return &v }(), Compass: (*int64)(nil)},
+			},
+		},
+		{
+			name:    "skippedColumns",
+			compass: &models.Publisher{PublisherID: "1", Name: "compass", AccountManagerID: null.StringFrom("10"), StartTimestamp: null.Int64From(1000)},
+			columns: []string{models.PublisherColumns.AccountManagerID},
+			want:    []dto.PublisherFieldDiff{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, DiffPublishers(local, tt.compass, tt.columns))
+		})
+	}
+}
+
+func Test_toCompassPublisher(t *testing.T) {
+	t.Parallel()
+
+	mod := &models.Publisher{
+		PublisherID:         "1",
+		Name:                "publisher",
+		AccountManagerID:    null.StringFrom("10"),
+		CampaignManagerID:   null.StringFrom("20"),
+		ReactivateTimestamp: null.Int64From(1000),
+	}
+
+	want := &compassPublisher{
+		Name:            "publisher",
+		AccountManager:  &compassPublisherRef{Id: "5f1b"},
+		CampaignManager: &compassPublisherRef{Id: "20"},
+		ReactivatedDate: 1000,
+	}
+
+	assert.Equal(t, want, toCompassPublisher(mod, map[string]string{"10": "5f1b"}))
+}
+
+func TestGetCompassLatestTimestamp(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		mod  *models.Publisher
+		want int64
+	}{
+		{name: "noTimestamps", mod: &models.Publisher{}, want: 0},
+		{name: "started", mod: &models.Publisher{StartTimestamp: null.Int64From(1000)}, want: 1000},
+		{name: "reactivated", mod: &models.Publisher{StartTimestamp: null.Int64From(1000), ReactivateTimestamp: null.Int64From(2000)}, want: 2000},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, getCompassLatestTimestamp(tt.mod))
+		})
+	}
+}
+
+func Test_getCompassChangedDiffs(t *testing.T) {
+	t.Parallel()
+
+	nameDiff := dto.PublisherFieldDiff{Field: models.PublisherColumns.Name, Local: "local", Compass: "compass"}
+	startDiff := dto.PublisherFieldDiff{
+		Field:   models.PublisherColumns.StartTimestamp,
+		Local:   func() *int64 { v := int64(2000); return &v }(),
+		Compass: func() *int64 { v := int64(1000); return &v }(),
+	}
+
+	tests := []struct {
+		name         string
+		diffs        []dto.PublisherFieldDiff
+		localChanges map[string]json.RawMessage
+		want         []dto.PublisherFieldDiff
+	}{
+		{
+			name:  "compassKeptValuesBeforeLocalChange",
+			diffs: []dto.PublisherFieldDiff{nameDiff, startDiff},
+			localChanges: map[string]json.RawMessage{
+				models.PublisherColumns.Name:           json.RawMessage(`"compass"`),
+				models.PublisherColumns.StartTimestamp: json.RawMessage(`1000`),
+			},
+			want: []dto.PublisherFieldDiff{},
+		},
+		{
+			name:  "compassChangedLocallyChangedColumn",
+			diffs: []dto.PublisherFieldDiff{nameDiff, startDiff},
+			localChanges: map[string]json.RawMessage{
+				models.PublisherColumns.Name:           json.RawMessage(`"before"`),
+				models.PublisherColumns.StartTimestamp: json.RawMessage(`1000`),
+			},
+			want: []dto.PublisherFieldDiff{nameDiff},
+		},
+		{
+			name:         "valueBeforeLocalChangeUnknown",
+			diffs:        []dto.PublisherFieldDiff{startDiff},
+			localChanges: map[string]json.RawMessage{},
+			want:         []dto.PublisherFieldDiff{startDiff},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, getCompassChangedDiffs(tt.diffs, tt.localChanges))
+		})
+	}
+}
@@ -66,7 +66,9 @@ func createTables(db *sqlx.DB) {
 		"old_value jsonb," +
 		"new_value jsonb," +
 		"changes jsonb," +
-		"date timestamp not null" +
+		"date timestamp not null," +
+		"approver_id int," +
+		"api_key_id int" +
 		");",
 	)
 	tx.MustExec("CREATE TABLE IF NOT EXISTS metadata_queue (transaction_id varchar(36) primary key not null, key varchar(256), version varchar(16),value varchar(512),commited_instances integer, created_at timestamp, updated_at timestamp)")
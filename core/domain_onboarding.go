@@ -0,0 +1,83 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/models"
+	"github.com/rotisserie/eris"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// StartDomainOnboarding creates checklist of new publisher domain, checks are run by domain onboarding worker
+func StartDomainOnboarding(ctx context.Context, exec boil.ContextExecutor, publisherID, domain string) error {
+	mod := &models.DomainOnboarding{
+		PublisherID: publisherID,
+		Domain:      domain,
+		Status:      dto.DomainOnboardingStatusPending,
+		CreatedAt:   time.Now().UTC(),
+	}
+
+	err := mod.Insert(ctx, exec, boil.Infer())
+	if err != nil {
+		return eris.Wrapf(err, "failed to start onboarding of domain [%v:%v]", publisherID, domain)
+	}
+
+	return nil
+}
+
+// LoadDomainOnboardingByPublisherAndDomain returns onboarding of domains by publisher_id:domain key
+func LoadDomainOnboardingByPublisherAndDomain(ctx context.Context, pubDom models.PublisherDomainSlice) (map[string]*models.DomainOnboarding, error) {
+	onboardingMap := make(map[string]*models.DomainOnboarding)
+	if len(pubDom) == 0 {
+		return onboardingMap, nil
+	}
+
+	args := make([]interface{}, 0, len(pubDom)*2)
+	for _, mod := range pubDom {
+		args = append(args, mod.PublisherID, mod.Domain)
+	}
+
+	mods, err := models.DomainOnboardings(
+		qm.WhereIn("("+models.DomainOnboardingColumns.PublisherID+", "+models.DomainOnboardingColumns.Domain+") IN ?", args...),
+	).All(ctx, bcdb.DB())
+	if err != nil {
+		return nil, eris.Wrap(err, "failed to retrieve domains onboarding")
+	}
+
+	for _, mod := range mods {
+		onboardingMap[mod.PublisherID+":"+mod.Domain] = mod
+	}
+
+	return onboardingMap, nil
+}
+
+func addDomainOnboarding(ctx context.Context, details dto.PublisherDetailsSlice) error {
+	pubDom := make(models.PublisherDomainSlice, 0, len(details))
+	for _, detail := range details {
+		pubDom = append(pubDom, &models.PublisherDomain{PublisherID: detail.PublisherID, Domain: detail.Domain})
+	}
+
+	onboardingMap, err := LoadDomainOnboardingByPublisherAndDomain(ctx, pubDom)
+	if err != nil {
+		return err
+	}
+
+	for _, detail := range details {
+		mod, ok := onboardingMap[detail.PublisherID+":"+detail.Domain]
+		if !ok {
+			continue
+		}
+
+		detail.Onboarding = &dto.DomainOnboarding{}
+		err := detail.Onboarding.FromModel(mod)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
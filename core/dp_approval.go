@@ -0,0 +1,490 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/bcdb/filter"
+	"github.com/m6yf/bcwork/bcdb/order"
+	"github.com/m6yf/bcwork/bcdb/pagination"
+	"github.com/m6yf/bcwork/bcdb/qmods"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/models"
+	"github.com/m6yf/bcwork/modules"
+	adstxt "github.com/m6yf/bcwork/modules/ads_txt"
+	"github.com/m6yf/bcwork/modules/history"
+	"github.com/m6yf/bcwork/modules/rbac"
+	"github.com/m6yf/bcwork/utils/constant"
+	"github.com/rotisserie/eris"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+var (
+	ErrDPApprovalNotNeeded = errors.New("approval is not needed for demand partner")
+	ErrDPApprovalNoPOC     = errors.New("demand partner has no point of contact email")
+)
+
+// adsTxtLinesOfDemandPartner selects ads.txt lines of demand partner connections and their children
+const adsTxtLinesOfDemandPartner = `(
+	demand_partner_connection_id in (select id from demand_partner_connection where demand_partner_id = ?)
+	or demand_partner_child_id in (
+		select dpc.id from demand_partner_child dpc
+			join demand_partner_connection dpc2 on dpc2.id = dpc.dp_connection_id
+		where dpc2.demand_partner_id = ?
+	)
+)`
+
+type DPApprovalService struct {
+	historyModule history.HistoryModule
+	adstxtModule  adstxt.AdsTxtManager
+}
+
+func NewDPApprovalService(historyModule history.HistoryModule, adstxtModule adstxt.AdsTxtManager) *DPApprovalService {
+	return &DPApprovalService{
+		historyModule: historyModule,
+		adstxtModule:  adstxtModule,
+	}
+}
+
+type GetDPApprovalRequestOptions struct {
+	Filter     DPApprovalRequestFilter `json:"filter"`
+	Pagination *pagination.Pagination  `json:"pagination"`
+	Order      order.Sort              `json:"order"`
+	Selector   string                  `json:"selector"`
+}
+
+type DPApprovalRequestFilter struct {
+	DemandPartnerID filter.StringArrayFilter `json:"demand_partner_id,omitempty"`
+	PublisherID     filter.StringArrayFilter `json:"publisher_id,omitempty"`
+	Domain          filter.StringArrayFilter `json:"domain,omitempty"`
+	Status          filter.StringArrayFilter `json:"status,omitempty"`
+}
+
+func (filter *DPApprovalRequestFilter) queryMod() qmods.QueryModsSlice {
+	mods := make(qmods.QueryModsSlice, 0)
+	if filter == nil {
+		return mods
+	}
+
+	if len(filter.DemandPartnerID) > 0 {
+		mods = append(mods, filter.DemandPartnerID.AndIn(models.DPApprovalRequestColumns.DemandPartnerID))
+	}
+
+	if len(filter.PublisherID) > 0 {
+		mods = append(mods, filter.PublisherID.AndIn(models.DPApprovalRequestColumns.PublisherID))
+	}
+
+	if len(filter.Domain) > 0 {
+		mods = append(mods, filter.Domain.AndIn(models.DPApprovalRequestColumns.Domain))
+	}
+
+	if len(filter.Status) > 0 {
+		mods = append(mods, filter.Status.AndIn(models.DPApprovalRequestColumns.Status))
+	}
+
+	return mods
+}
+
+func (s *DPApprovalService) GetApprovalRequests(ctx context.Context, ops *GetDPApprovalRequestOptions) ([]*dto.DPApprovalRequest, error) {
+	qmods := ops.Filter.queryMod().
+		AddArray(rbac.PublisherScopeMods(ctx, models.DPApprovalRequestColumns.PublisherID)).
+		Order(ops.Order, nil, models.DPApprovalRequestColumns.ID).
+		AddArray(ops.Pagination.Do())
+
+	mods, err := models.DPApprovalRequests(qmods...).All(ctx, bcdb.DB())
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, eris.Wrap(err, "failed to retrieve demand partner approval requests")
+	}
+
+	requests := make([]*dto.DPApprovalRequest, 0, len(mods))
+	for _, mod := range mods {
+		request := &dto.DPApprovalRequest{}
+		request.FromModel(mod)
+		requests = append(requests, request)
+	}
+
+	return requests, nil
+}
+
+// SendForApproval emails domains to point of contact of demand partner with csv attached,
+// already approved domains are skipped
+func (s *DPApprovalService) SendForApproval(ctx context.Context, data *dto.DPApprovalSendRequest) error {
+	dp, err := models.FindDpo(ctx, bcdb.DB(), data.DemandPartnerID)
+	if err != nil {
+		return eris.Wrapf(err, "failed to retrieve demand partner [%v]", data.DemandPartnerID)
+	}
+
+	if !dp.IsApprovalNeeded {
+		return fmt.Errorf("%w [%v]", ErrDPApprovalNotNeeded, dp.DemandPartnerID)
+	}
+
+	if dp.PocEmail == "" {
+		return fmt.Errorf("%w [%v]", ErrDPApprovalNoPOC, dp.DemandPartnerID)
+	}
+
+	mods, err := s.getOrCreateRequests(ctx, dp.DemandPartnerID, data.Domains)
+	if err != nil {
+		return err
+	}
+
+	toSend := make(models.DPApprovalRequestSlice, 0, len(mods))
+	for _, mod := range mods {
+		if mod.Status != dto.DPApprovalStatusApproved {
+			toSend = append(toSend, mod)
+		}
+	}
+
+	if len(toSend) == 0 {
+		return nil
+	}
+
+	err = sendDPApprovalEmail(ctx, dp, toSend, false)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	tx, err := bcdb.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return eris.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	oldMods := make(models.DPApprovalRequestSlice, 0, len(toSend))
+	for _, mod := range toSend {
+		oldMod := *mod
+		oldMods = append(oldMods, &oldMod)
+
+		mod.Status = dto.DPApprovalStatusSent
+		mod.SentAt = null.TimeFrom(now)
+		mod.Reminders = 0
+		mod.LastRemindedAt = null.Time{}
+		mod.DecidedAt = null.Time{}
+		mod.DecidedBy = null.Int{}
+		mod.Comment = null.String{}
+		mod.UpdatedAt = null.TimeFrom(now)
+
+		_, err = mod.Update(ctx, tx, boil.Infer())
+		if err != nil {
+			return eris.Wrapf(err, "failed to update approval request [%v]", mod.ID)
+		}
+	}
+
+	// lines which were already sent or decided outside of approval requests keep their status
+	err = updateDPApprovalDemandStatus(ctx, tx, dp.DemandPartnerID, toSend, dto.DPStatusPending, dto.DPStatusNotSent)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return eris.Wrap(err, "failed to commit approval requests")
+	}
+
+	for i := range toSend {
+		s.historyModule.SaveAction(ctx, oldMods[i], toSend[i], &history.HistoryOptions{Subject: history.DPApprovalSubject})
+	}
+
+	go s.adstxtModule.UpdateAdsTxtMaterializedViews(ctx)
+
+	return nil
+}
+
+// Decide records decision of demand partner about domains and updates demand status of their ads.txt lines
+func (s *DPApprovalService) Decide(ctx context.Context, data *dto.DPApprovalDecisionRequest) error {
+	_, err := models.FindDpo(ctx, bcdb.DB(), data.DemandPartnerID)
+	if err != nil {
+		return eris.Wrapf(err, "failed to retrieve demand partner [%v]", data.DemandPartnerID)
+	}
+
+	mods, err := s.getOrCreateRequests(ctx, data.DemandPartnerID, data.Domains)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	decidedBy := null.Int{}
+	if userID, isUserKnown := ctx.Value(constant.UserIDContextKey).(int); isUserKnown {
+		decidedBy = null.IntFrom(userID)
+	}
+
+	tx, err := bcdb.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return eris.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	oldMods := make(models.DPApprovalRequestSlice, 0, len(mods))
+	for _, mod := range mods {
+		oldMod := *mod
+		oldMods = append(oldMods, &oldMod)
+
+		mod.Status = data.Status
+		mod.DecidedAt = null.TimeFrom(now)
+		mod.DecidedBy = decidedBy
+		mod.Comment = null.NewString(data.Comment, data.Comment != "")
+		mod.UpdatedAt = null.TimeFrom(now)
+
+		_, err = mod.Update(ctx, tx, boil.Infer())
+		if err != nil {
+			return eris.Wrapf(err, "failed to update approval request [%v]", mod.ID)
+		}
+	}
+
+	err = updateDPApprovalDemandStatus(ctx, tx, data.DemandPartnerID, mods, dto.DPApprovalDemandStatuses[data.Status])
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return eris.Wrap(err, "failed to commit approval decision")
+	}
+
+	for i := range mods {
+		s.historyModule.SaveAction(ctx, oldMods[i], mods[i], &history.HistoryOptions{Subject: history.DPApprovalSubject})
+	}
+
+	go s.adstxtModule.UpdateAdsTxtMaterializedViews(ctx)
+
+	return nil
+}
+
+// RemindStaleRequests emails reminders about requests which were sent or reminded
+// before staleAfter and have less than maxReminders, returns amount of reminded requests
+func (s *DPApprovalService) RemindStaleRequests(ctx context.Context, staleAfter time.Duration, maxReminders int) (int, error) {
+	now := time.Now().UTC()
+	mods, err := models.DPApprovalRequests(
+		models.DPApprovalRequestWhere.Status.EQ(dto.DPApprovalStatusSent),
+		models.DPApprovalRequestWhere.Reminders.LT(maxReminders),
+		qm.Where(
+			fmt.Sprintf("coalesce(%v, %v) < ?", models.DPApprovalRequestColumns.LastRemindedAt, models.DPApprovalRequestColumns.SentAt),
+			now.Add(-staleAfter),
+		),
+		qm.OrderBy(models.DPApprovalRequestColumns.ID),
+	).All(ctx, bcdb.DB())
+	if err != nil {
+		return 0, eris.Wrap(err, "failed to retrieve stale approval requests")
+	}
+
+	modsByDP := make(map[string]models.DPApprovalRequestSlice)
+	for _, mod := range mods {
+		modsByDP[mod.DemandPartnerID] = append(modsByDP[mod.DemandPartnerID], mod)
+	}
+
+	var reminded int
+	for demandPartnerID, dpMods := range modsByDP {
+		dp, err := models.FindDpo(ctx, bcdb.DB(), demandPartnerID)
+		if err != nil {
+			return reminded, eris.Wrapf(err, "failed to retrieve demand partner [%v]", demandPartnerID)
+		}
+
+		if dp.PocEmail == "" {
+			continue
+		}
+
+		err = sendDPApprovalEmail(ctx, dp, dpMods, true)
+		if err != nil {
+			return reminded, err
+		}
+
+		for _, mod := range dpMods {
+			mod.Reminders++
+			mod.LastRemindedAt = null.TimeFrom(now)
+			mod.UpdatedAt = null.TimeFrom(now)
+
+			_, err = mod.Update(ctx, bcdb.DB(), boil.Whitelist(
+				models.DPApprovalRequestColumns.Reminders,
+				models.DPApprovalRequestColumns.LastRemindedAt,
+				models.DPApprovalRequestColumns.UpdatedAt,
+			))
+			if err != nil {
+				return reminded, eris.Wrapf(err, "failed to update reminded approval request [%v]", mod.ID)
+			}
+
+			reminded++
+		}
+	}
+
+	return reminded, nil
+}
+
+// getOrCreateRequests returns approval requests of demand partner for domains,
+// missing requests are created as not sent
+func (s *DPApprovalService) getOrCreateRequests(ctx context.Context, demandPartnerID string, domains []dto.DPApprovalDomain) (models.DPApprovalRequestSlice, error) {
+	args := make([]interface{}, 0, len(domains)*2)
+	for _, domain := range domains {
+		args = append(args, domain.PublisherID, domain.Domain)
+	}
+
+	existing, err := models.DPApprovalRequests(
+		models.DPApprovalRequestWhere.DemandPartnerID.EQ(demandPartnerID),
+		qm.WhereIn("("+models.DPApprovalRequestColumns.PublisherID+", "+models.DPApprovalRequestColumns.Domain+") IN ?", args...),
+	).All(ctx, bcdb.DB())
+	if err != nil {
+		return nil, eris.Wrap(err, "failed to retrieve approval requests")
+	}
+
+	existingMap := make(map[string]*models.DPApprovalRequest, len(existing))
+	for _, mod := range existing {
+		existingMap[mod.PublisherID+":"+mod.Domain] = mod
+	}
+
+	mods := make(models.DPApprovalRequestSlice, 0, len(domains))
+	for _, domain := range domains {
+		key := domain.PublisherID + ":" + domain.Domain
+		if mod, ok := existingMap[key]; ok {
+			mods = append(mods, mod)
+			continue
+		}
+
+		mod := &models.DPApprovalRequest{
+			DemandPartnerID: demandPartnerID,
+			PublisherID:     domain.PublisherID,
+			Domain:          domain.Domain,
+			Status:          dto.DPApprovalStatusNotSent,
+			CreatedAt:       time.Now().UTC(),
+		}
+
+		err := mod.Insert(ctx, bcdb.DB(), boil.Infer())
+		if err != nil {
+			return nil, eris.Wrapf(err, "failed to create approval request of domain [%v]", key)
+		}
+
+		s.historyModule.SaveAction(ctx, nil, mod, &history.HistoryOptions{Subject: history.DPApprovalSubject})
+		existingMap[key] = mod
+		mods = append(mods, mod)
+	}
+
+	return mods, nil
+}
+
+// updateDPApprovalDemandStatus sets demand status of ads.txt lines of demand partner in domains of requests,
+// only lines in one of fromStatuses are updated when they are provided
+func updateDPApprovalDemandStatus(
+	ctx context.Context,
+	exec boil.ContextExecutor,
+	demandPartnerID string,
+	mods models.DPApprovalRequestSlice,
+	status string,
+	fromStatuses ...string,
+) error {
+	args := make([]interface{}, 0, len(mods)*2)
+	for _, mod := range mods {
+		args = append(args, mod.PublisherID, mod.Domain)
+	}
+
+	queryMods := qmods.QueryModsSlice{
+		qm.WhereIn("("+models.AdsTXTColumns.PublisherID+", "+models.AdsTXTColumns.Domain+") IN ?", args...),
+		qm.Where(adsTxtLinesOfDemandPartner, demandPartnerID, demandPartnerID),
+	}
+	if len(fromStatuses) > 0 {
+		queryMods = append(queryMods, models.AdsTXTWhere.DemandStatus.IN(fromStatuses))
+	}
+
+	_, err := models.AdsTXTS(queryMods...).UpdateAll(ctx, exec, models.M{
+		models.AdsTXTColumns.DemandStatus: status,
+		models.AdsTXTColumns.UpdatedAt:    time.Now().UTC(),
+	})
+	if err != nil {
+		return eris.Wrapf(err, "failed to update demand status of ads txt lines of demand partner [%v]", demandPartnerID)
+	}
+
+	return nil
+}
+
+func sendDPApprovalEmail(ctx context.Context, dp *models.Dpo, mods models.DPApprovalRequestSlice, isReminder bool) error {
+	publisherIDs := make([]string, 0, len(mods))
+	for _, mod := range mods {
+		publisherIDs = append(publisherIDs, mod.PublisherID)
+	}
+
+	publishers, err := models.Publishers(
+		qm.Select(models.PublisherColumns.PublisherID, models.PublisherColumns.Name),
+		models.PublisherWhere.PublisherID.IN(publisherIDs),
+	).All(ctx, bcdb.DB())
+	if err != nil {
+		return eris.Wrap(err, "failed to retrieve publishers of approval requests")
+	}
+
+	publisherNames := make(map[string]string, len(publishers))
+	for _, publisher := range publishers {
+		publisherNames[publisher.PublisherID] = publisher.Name
+	}
+
+	attachment, err := dpApprovalCSV(mods, publisherNames)
+	if err != nil {
+		return err
+	}
+
+	subject, body := dpApprovalEmailContent(dp, len(mods), isReminder)
+	err = modules.SendEmail(modules.EmailRequest{
+		To:       []string{dp.PocEmail},
+		Subject:  subject,
+		Body:     body,
+		Attach:   attachment,
+		Filename: fmt.Sprintf("approval_request_%v_%v.csv", dp.DemandPartnerID, time.Now().UTC().Format("2006_01_02")),
+	})
+	if err != nil {
+		return eris.Wrapf(err, "failed to send approval request to demand partner [%v]", dp.DemandPartnerID)
+	}
+
+	return nil
+}
+
+func dpApprovalEmailContent(dp *models.Dpo, amount int, isReminder bool) (string, string) {
+	subject := fmt.Sprintf("Approval request for %v domains", amount)
+	if isReminder {
+		subject = "Reminder: " + subject
+	}
+
+	greeting := "Hi"
+	if dp.PocName != "" {
+		greeting += " " + dp.PocName
+	}
+
+	lines := []string{
+		greeting + ",",
+		"",
+		fmt.Sprintf("Please review the attached list of %v domains for approval on %v.", amount, dp.DemandPartnerName),
+	}
+	if isReminder {
+		lines = append(lines, "We haven't received your decision yet and would appreciate your update.")
+	}
+	lines = append(lines, "", "Thank you")
+
+	return subject, strings.Join(lines, "\n")
+}
+
+func dpApprovalCSV(mods models.DPApprovalRequestSlice, publisherNames map[string]string) (*bytes.Buffer, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	err := writer.Write([]string{"Publisher ID", "Publisher Name", "Domain"})
+	if err != nil {
+		return nil, eris.Wrap(err, "failed to write approval requests csv header")
+	}
+
+	for _, mod := range mods {
+		err := writer.Write([]string{mod.PublisherID, publisherNames[mod.PublisherID], mod.Domain})
+		if err != nil {
+			return nil, eris.Wrap(err, "failed to write approval requests csv row")
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, eris.Wrap(err, "failed to write approval requests csv")
+	}
+
+	return &buf, nil
+}
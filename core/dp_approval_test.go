@@ -0,0 +1,58 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/m6yf/bcwork/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_dpApprovalCSV(t *testing.T) {
+	t.Parallel()
+
+	mods := models.DPApprovalRequestSlice{
+		{PublisherID: "999", Domain: "example.com"},
+		{PublisherID: "1000", Domain: "example.org"},
+	}
+
+	got, err := dpApprovalCSV(mods, map[string]string{"999": "Publisher, Inc"})
+	assert.NoError(t, err)
+	assert.Equal(t, "Publisher ID,Publisher Name,Domain\n999,\"Publisher, Inc\",example.com\n1000,,example.org\n", got.String())
+}
+
+func Test_dpApprovalEmailContent(t *testing.T) {
+	t.Parallel()
+
+	dp := &models.Dpo{DemandPartnerName: "DP", PocName: "John"}
+
+	tests := []struct {
+		name        string
+		isReminder  bool
+		wantSubject string
+		wantBody    string
+	}{
+		{
+			name:        "request",
+			wantSubject: "Approval request for 2 domains",
+			wantBody:    "Hi John,\n\nPlease review the attached list of 2 domains for approval on DP.\n\nThank you",
+		},
+		{
+			name:        "reminder",
+			isReminder:  true,
+			wantSubject: "Reminder: Approval request for 2 domains",
+			wantBody: "Hi John,\n\nPlease review the attached list of 2 domains for approval on DP.\n" +
+				"We haven't received your decision yet and would appreciate your update.\n\nThank you",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			subject, body := dpApprovalEmailContent(dp, 2, tt.isReminder)
+			assert.Equal(t, tt.wantSubject, subject)
+			assert.Equal(t, tt.wantBody, body)
+		})
+	}
+}
@@ -0,0 +1,570 @@
+package core
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/bcdb/filter"
+	"github.com/m6yf/bcwork/bcdb/order"
+	"github.com/m6yf/bcwork/bcdb/pagination"
+	"github.com/m6yf/bcwork/bcdb/qmods"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/models"
+	"github.com/m6yf/bcwork/modules/logger"
+	"github.com/m6yf/bcwork/utils"
+	"github.com/m6yf/bcwork/utils/bcguid"
+	"github.com/rotisserie/eris"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+// DPScoreWeights are weights of score components by component name
+type DPScoreWeights map[string]float64
+
+var (
+	ErrInvalidDPScoreWeights = errors.New("invalid demand partner score weights")
+
+	DefaultDPScoreWeights = DPScoreWeights{
+		dto.DPScoreComponentWinRate:        0.2,
+		dto.DPScoreComponentERPM:           0.25,
+		dto.DPScoreComponentTimeoutRate:    0.15,
+		dto.DPScoreComponentNoResponseRate: 0.1,
+		dto.DPScoreComponentBidCacheRate:   0.1,
+		dto.DPScoreComponentRevenueShare:   0.2,
+	}
+
+	dpScoreComponents = []string{
+		dto.DPScoreComponentWinRate,
+		dto.DPScoreComponentERPM,
+		dto.DPScoreComponentTimeoutRate,
+		dto.DPScoreComponentNoResponseRate,
+		dto.DPScoreComponentBidCacheRate,
+		dto.DPScoreComponentRevenueShare,
+	}
+
+	// rates where lower value is better
+	dpScoreInvertedComponents = map[string]bool{
+		dto.DPScoreComponentTimeoutRate:    true,
+		dto.DPScoreComponentNoResponseRate: true,
+	}
+)
+
+// Validate checks that weights are known and not negative and at least one of them is positive
+func (w DPScoreWeights) Validate() error {
+	var total float64
+	for name, weight := range w {
+		if _, ok := DefaultDPScoreWeights[name]; !ok {
+			return fmt.Errorf("%w: unknown component [%v]", ErrInvalidDPScoreWeights, name)
+		}
+		if weight < 0 {
+			return fmt.Errorf("%w: weight of [%v] is negative", ErrInvalidDPScoreWeights, name)
+		}
+		total += weight
+	}
+
+	if total <= 0 {
+		return fmt.Errorf("%w: total weight must be positive", ErrInvalidDPScoreWeights)
+	}
+
+	return nil
+}
+
+type DPScoreService struct{}
+
+func NewDPScoreService() *DPScoreService {
+	return &DPScoreService{}
+}
+
+type GetDPScoreOptions struct {
+	Filter     DPScoreFilter          `json:"filter"`
+	Pagination *pagination.Pagination `json:"pagination"`
+	Order      order.Sort             `json:"order"`
+	Selector   string                 `json:"selector"`
+}
+
+// DPScoreFilter filters demand partner scores, only the latest calculation is returned unless calculated at is set
+type DPScoreFilter struct {
+	DemandPartnerID filter.StringArrayFilter `json:"demand_partner_id,omitempty"`
+	Country         filter.StringArrayFilter `json:"country,omitempty"`
+	DeviceType      filter.StringArrayFilter `json:"device_type,omitempty"`
+	CalculatedAt    *filter.DatesFilter      `json:"calculated_at,omitempty"`
+}
+
+func (filter *DPScoreFilter) queryMod() qmods.QueryModsSlice {
+	mods := make(qmods.QueryModsSlice, 0)
+	if filter == nil {
+		return mods
+	}
+
+	if len(filter.DemandPartnerID) > 0 {
+		mods = append(mods, filter.DemandPartnerID.AndIn(models.DPScoreColumns.DemandPartnerID))
+	}
+
+	if len(filter.Country) > 0 {
+		mods = append(mods, filter.Country.AndIn(models.DPScoreColumns.Country))
+	}
+
+	if len(filter.DeviceType) > 0 {
+		mods = append(mods, filter.DeviceType.AndIn(models.DPScoreColumns.DeviceType))
+	}
+
+	if filter.CalculatedAt != nil {
+		mods = append(mods, filter.CalculatedAt.AndIn(models.DPScoreColumns.CalculatedAt))
+	} else {
+		mods = append(mods, qm.Where(fmt.Sprintf("%v = (SELECT max(%v) FROM %v)",
+			models.DPScoreColumns.CalculatedAt, models.DPScoreColumns.CalculatedAt, models.TableNames.DPScore)))
+	}
+
+	return mods
+}
+
+func (s *DPScoreService) GetScores(ctx context.Context, ops *GetDPScoreOptions) ([]*dto.DPScore, error) {
+	if len(ops.Order) == 0 {
+		ops.Order = order.Sort{
+			{Name: models.DPScoreColumns.CalculatedAt, Desc: true},
+			{Name: models.DPScoreColumns.Score, Desc: true},
+		}
+	}
+
+	qmods := ops.Filter.queryMod().
+		Order(ops.Order, nil, models.DPScoreColumns.ID).
+		AddArray(ops.Pagination.Do())
+
+	mods, err := models.DPScores(qmods...).All(ctx, bcdb.DB())
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, eris.Wrap(err, "failed to retrieve demand partner scores")
+	}
+
+	scores := make([]*dto.DPScore, 0, len(mods))
+	for _, mod := range mods {
+		score := &dto.DPScore{}
+		err := score.FromModel(mod)
+		if err != nil {
+			return nil, eris.Wrapf(err, "failed to parse components of demand partner score [%v]", mod.ID)
+		}
+		scores = append(scores, score)
+	}
+
+	return scores, nil
+}
+
+// dpScoreStats are performance stats of demand partner for a period
+type dpScoreStats struct {
+	BidRequests        int64
+	BidResponses       int64
+	AuctionWins        int64
+	Revenue            float64
+	SoldImpressions    int64
+	PubImpressions     int64
+	NoResponseRequests float64
+}
+
+type dpDemandStatsRow struct {
+	DemandPartnerID string  `boil:"demand_partner_id"`
+	Country         string  `boil:"country"`
+	DeviceType      string  `boil:"device_type"`
+	BidRequests     int64   `boil:"bid_requests"`
+	BidResponses    int64   `boil:"bid_responses"`
+	AuctionWins     int64   `boil:"auction_wins"`
+	Revenue         float64 `boil:"revenue"`
+}
+
+type dpImpressionStatsRow struct {
+	DemandPartnerID string `boil:"demand_partner_id"`
+	Country         string `boil:"country"`
+	DeviceType      string `boil:"device_type"`
+	SoldImpressions int64  `boil:"sold_impressions"`
+	PubImpressions  int64  `boil:"pub_impressions"`
+}
+
+type dpNoResponseStatsRow struct {
+	DemandPartnerID string  `boil:"demand_partner_id"`
+	BidRequests     float64 `boil:"bid_requests"`
+}
+
+const (
+	dpDemandStatsQuery = `
+		SELECT
+			demand_partner_id,
+			country,
+			device_type,
+			sum(bid_requests)::bigint AS bid_requests,
+			sum(bid_responses)::bigint AS bid_responses,
+			sum(auction_wins)::bigint AS auction_wins,
+			sum(revenue) AS revenue
+		FROM nb_demand_hourly
+		WHERE "time" >= $1 AND "time" < $2
+		GROUP BY demand_partner_id, country, device_type`
+
+	dpImpressionStatsQuery = `
+		SELECT
+			demand_partner_id,
+			country,
+			device_type,
+			sum(sold_impressions)::bigint AS sold_impressions,
+			sum(pub_impressions)::bigint AS pub_impressions
+		FROM impression_log_daily
+		WHERE "time" >= $1 AND "time" < $2
+		GROUP BY demand_partner_id, country, device_type`
+
+	dpNoResponseStatsQuery = `
+		SELECT
+			demand_partner_id,
+			sum(bid_requests) AS bid_requests
+		FROM no_dp_response_report
+		WHERE "time" >= $1 AND "time" < $2
+		GROUP BY demand_partner_id`
+)
+
+// CalculateScores calculates scores of demand partners overall and by country and device type
+// from their performance between from and to, demand partners and segments with less than min bid requests aren't scored
+func (s *DPScoreService) CalculateScores(ctx context.Context, from, to time.Time, weights DPScoreWeights, minBidRequests int64) ([]*dto.DPScore, error) {
+	err := weights.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	var demandRows []*dpDemandStatsRow
+	err = queries.Raw(dpDemandStatsQuery, from, to).Bind(ctx, bcdb.DB(), &demandRows)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, eris.Wrap(err, "failed to retrieve demand partners bidding stats")
+	}
+
+	var impressionRows []*dpImpressionStatsRow
+	err = queries.Raw(dpImpressionStatsQuery, from, to).Bind(ctx, bcdb.DB(), &impressionRows)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, eris.Wrap(err, "failed to retrieve demand partners impressions stats")
+	}
+
+	var noResponseRows []*dpNoResponseStatsRow
+	err = queries.Raw(dpNoResponseStatsQuery, from.Format(time.DateOnly), to.Format(time.DateOnly)).Bind(ctx, bcdb.DB(), &noResponseRows)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, eris.Wrap(err, "failed to retrieve demand partners no response stats")
+	}
+
+	overall, segments := groupDPScoreStats(demandRows, impressionRows, noResponseRows)
+
+	scores := calculateDPScores(overall, weights, minBidRequests)
+	for segment, stats := range segments {
+		for _, score := range calculateDPScores(stats, weights, minBidRequests) {
+			score.Country = segment.country
+			score.DeviceType = segment.deviceType
+			scores = append(scores, score)
+		}
+	}
+
+	return scores, nil
+}
+
+// SaveScores stores scores to history, updates score of demand partners by their overall scores
+// and publishes ranking of demand partners to metadata. Scores of demand partners unknown to dpo table
+// are skipped. Saved scores are returned.
+func (s *DPScoreService) SaveScores(ctx context.Context, scores []*dto.DPScore, calculatedAt time.Time) ([]*dto.DPScore, error) {
+	scores, err := filterKnownDPScores(ctx, scores)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := bcdb.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return nil, eris.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	now := time.Now().UTC()
+	for _, score := range scores {
+		components, err := json.Marshal(score.Components)
+		if err != nil {
+			return nil, eris.Wrap(err, "failed to marshal demand partner score components")
+		}
+
+		mod := &models.DPScore{
+			DemandPartnerID: score.DemandPartnerID,
+			Country:         score.Country,
+			DeviceType:      score.DeviceType,
+			Score:           score.Score,
+			Components:      types.JSON(components),
+			CalculatedAt:    calculatedAt,
+			CreatedAt:       now,
+		}
+
+		err = mod.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return nil, eris.Wrapf(err, "failed to save score of demand partner [%v]", score.DemandPartnerID)
+		}
+		score.ID = mod.ID
+		score.CalculatedAt = calculatedAt
+
+		if !score.IsOverall() {
+			continue
+		}
+
+		_, err = models.Dpos(models.DpoWhere.DemandPartnerID.EQ(score.DemandPartnerID)).
+			UpdateAll(ctx, tx, models.M{models.DpoColumns.Score: score.Score})
+		if err != nil {
+			return nil, eris.Wrapf(err, "failed to update score of demand partner [%v]", score.DemandPartnerID)
+		}
+	}
+
+	value, err := json.Marshal(buildDPRanking(scores, calculatedAt))
+	if err != nil {
+		return nil, eris.Wrap(err, "failed to marshal demand partners ranking")
+	}
+
+	mod := models.MetadataQueue{
+		Key:           utils.DPRankingMetaDataKey,
+		TransactionID: bcguid.NewFromf(utils.DPRankingMetaDataKey, calculatedAt),
+		Value:         value,
+	}
+
+	err = mod.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		return nil, eris.Wrap(err, "failed to insert demand partners ranking to metadata queue")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, eris.Wrap(err, "failed to commit demand partner scores")
+	}
+
+	return scores, nil
+}
+
+// filterKnownDPScores drops scores of demand partners which don't exist in dpo table, e.g. removed ones
+// which still have stats, since scores reference demand partners
+func filterKnownDPScores(ctx context.Context, scores []*dto.DPScore) ([]*dto.DPScore, error) {
+	demandPartnerIDs := make([]string, 0, len(scores))
+	for _, score := range scores {
+		demandPartnerIDs = append(demandPartnerIDs, score.DemandPartnerID)
+	}
+
+	mods, err := models.Dpos(
+		qm.Select(models.DpoColumns.DemandPartnerID),
+		models.DpoWhere.DemandPartnerID.IN(demandPartnerIDs),
+	).All(ctx, bcdb.DB())
+	if err != nil {
+		return nil, eris.Wrap(err, "failed to retrieve demand partners of scores")
+	}
+
+	known := make(map[string]struct{}, len(mods))
+	for _, mod := range mods {
+		known[mod.DemandPartnerID] = struct{}{}
+	}
+
+	filtered := make([]*dto.DPScore, 0, len(scores))
+	skipped := make(map[string]struct{})
+	for _, score := range scores {
+		if _, ok := known[score.DemandPartnerID]; !ok {
+			skipped[score.DemandPartnerID] = struct{}{}
+			continue
+		}
+		filtered = append(filtered, score)
+	}
+
+	for demandPartnerID := range skipped {
+		logger.Logger(ctx).Warn().Msgf("skipped scores of unknown demand partner [%v]", demandPartnerID)
+	}
+
+	return filtered, nil
+}
+
+type dpScoreSegment struct {
+	country    string
+	deviceType string
+}
+
+func (s dpScoreSegment) String() string {
+	return s.country + ":" + s.deviceType
+}
+
+// groupDPScoreStats sums stats by demand partner overall and by country and device type,
+// no response report has no country and device type so segments get no response rate of demand partner
+func groupDPScoreStats(
+	demandRows []*dpDemandStatsRow,
+	impressionRows []*dpImpressionStatsRow,
+	noResponseRows []*dpNoResponseStatsRow,
+) (map[string]*dpScoreStats, map[dpScoreSegment]map[string]*dpScoreStats) {
+	overall := make(map[string]*dpScoreStats)
+	segments := make(map[dpScoreSegment]map[string]*dpScoreStats)
+
+	add := func(segment dpScoreSegment, demandPartnerID string, update func(stats *dpScoreStats)) {
+		update(getDPScoreStats(overall, demandPartnerID))
+
+		// rows without country and device type count only in overall stats
+		if segment == (dpScoreSegment{}) {
+			return
+		}
+
+		if _, ok := segments[segment]; !ok {
+			segments[segment] = make(map[string]*dpScoreStats)
+		}
+		update(getDPScoreStats(segments[segment], demandPartnerID))
+	}
+
+	for _, row := range demandRows {
+		add(dpScoreSegment{country: row.Country, deviceType: row.DeviceType}, row.DemandPartnerID, func(stats *dpScoreStats) {
+			stats.BidRequests += row.BidRequests
+			stats.BidResponses += row.BidResponses
+			stats.AuctionWins += row.AuctionWins
+			stats.Revenue += row.Revenue
+		})
+	}
+
+	for _, row := range impressionRows {
+		add(dpScoreSegment{country: row.Country, deviceType: row.DeviceType}, row.DemandPartnerID, func(stats *dpScoreStats) {
+			stats.SoldImpressions += row.SoldImpressions
+			stats.PubImpressions += row.PubImpressions
+		})
+	}
+
+	for _, row := range noResponseRows {
+		if stats, ok := overall[row.DemandPartnerID]; ok {
+			stats.NoResponseRequests += row.BidRequests
+		}
+	}
+
+	for _, statsByDP := range segments {
+		for demandPartnerID, stats := range statsByDP {
+			dpStats := overall[demandPartnerID]
+			if dpStats.BidRequests > 0 {
+				stats.NoResponseRequests = dpStats.NoResponseRequests * float64(stats.BidRequests) / float64(dpStats.BidRequests)
+			}
+		}
+	}
+
+	return overall, segments
+}
+
+func getDPScoreStats(statsByDP map[string]*dpScoreStats, demandPartnerID string) *dpScoreStats {
+	stats, ok := statsByDP[demandPartnerID]
+	if !ok {
+		stats = &dpScoreStats{}
+		statsByDP[demandPartnerID] = stats
+	}
+
+	return stats
+}
+
+// calculateDPScores scores demand partners against each other, every component is normalized to [0, 1]:
+// win rate, eRPM, bid cache rate and revenue share by the best demand partner, timeout and no response rates are inverted.
+// Timeouts aren't reported separately from no bids, so timeout rate is a share of bid requests left without bid response
+func calculateDPScores(statsByDP map[string]*dpScoreStats, weights DPScoreWeights, minBidRequests int64) []*dto.DPScore {
+	values := make(map[string]map[string]float64)
+	var totalRevenue float64
+	for demandPartnerID, stats := range statsByDP {
+		if stats.BidRequests == 0 || stats.BidRequests < minBidRequests {
+			continue
+		}
+
+		values[demandPartnerID] = map[string]float64{
+			dto.DPScoreComponentWinRate:        ratio(float64(stats.AuctionWins), float64(stats.BidResponses)),
+			dto.DPScoreComponentERPM:           stats.Revenue / float64(stats.BidRequests) * 1000,
+			dto.DPScoreComponentTimeoutRate:    ratio(float64(stats.BidRequests-stats.BidResponses), float64(stats.BidRequests)),
+			dto.DPScoreComponentNoResponseRate: ratio(stats.NoResponseRequests, float64(stats.BidRequests)),
+			dto.DPScoreComponentBidCacheRate:   ratio(float64(stats.SoldImpressions-stats.PubImpressions), float64(stats.SoldImpressions)),
+		}
+		totalRevenue += stats.Revenue
+	}
+
+	best := make(map[string]float64)
+	for demandPartnerID, dpValues := range values {
+		dpValues[dto.DPScoreComponentRevenueShare] = ratio(statsByDP[demandPartnerID].Revenue, totalRevenue)
+		for name, value := range dpValues {
+			best[name] = math.Max(best[name], value)
+		}
+	}
+
+	var totalWeight float64
+	for _, name := range dpScoreComponents {
+		totalWeight += weights[name]
+	}
+
+	scores := make([]*dto.DPScore, 0, len(values))
+	for demandPartnerID, dpValues := range values {
+		score := &dto.DPScore{
+			DemandPartnerID: demandPartnerID,
+			Components:      make([]dto.DPScoreComponent, 0, len(dpScoreComponents)),
+		}
+
+		var total float64
+		for _, name := range dpScoreComponents {
+			normalized := ratio(dpValues[name], best[name])
+			if dpScoreInvertedComponents[name] {
+				normalized = 1 - dpValues[name]
+			}
+
+			var points float64
+			if totalWeight > 0 {
+				points = normalized * weights[name] / totalWeight * dto.DPScoreMaxValue
+			}
+			total += points
+
+			score.Components = append(score.Components, dto.DPScoreComponent{
+				Name:   name,
+				Value:  dpValues[name],
+				Score:  normalized,
+				Weight: weights[name],
+				Points: int(math.Round(points)),
+			})
+		}
+		score.Score = int(math.Round(total))
+		scores = append(scores, score)
+	}
+
+	sortDPScores(scores)
+
+	return scores
+}
+
+// ratio returns share of value in total limited to [0, 1]
+func ratio(value, total float64) float64 {
+	if total <= 0 || value <= 0 {
+		return 0
+	}
+
+	return math.Min(value/total, 1)
+}
+
+func sortDPScores(scores []*dto.DPScore) {
+	sort.SliceStable(scores, func(i, j int) bool {
+		if scores[i].Score != scores[j].Score {
+			return scores[i].Score > scores[j].Score
+		}
+
+		return scores[i].DemandPartnerID < scores[j].DemandPartnerID
+	})
+}
+
+func buildDPRanking(scores []*dto.DPScore, calculatedAt time.Time) *dto.DPRanking {
+	ranked := make([]*dto.DPScore, len(scores))
+	copy(ranked, scores)
+	sortDPScores(ranked)
+
+	ranking := &dto.DPRanking{
+		DemandPartners: make([]dto.DPRankingItem, 0),
+		Segments:       make(map[string][]dto.DPRankingItem),
+		CalculatedAt:   calculatedAt,
+	}
+
+	for _, score := range ranked {
+		item := dto.DPRankingItem{DemandPartnerID: score.DemandPartnerID, Score: score.Score}
+		if score.IsOverall() {
+			ranking.DemandPartners = append(ranking.DemandPartners, item)
+			continue
+		}
+
+		segment := dpScoreSegment{country: score.Country, deviceType: score.DeviceType}.String()
+		ranking.Segments[segment] = append(ranking.Segments[segment], item)
+	}
+
+	return ranking
+}
@@ -0,0 +1,132 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m6yf/bcwork/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalculateDPScores(t *testing.T) {
+	t.Parallel()
+
+	stats := map[string]*dpScoreStats{
+		"dp1": {BidRequests: 1000, BidResponses: 800, AuctionWins: 400, Revenue: 2, SoldImpressions: 100, PubImpressions: 80},
+		"dp2": {BidRequests: 1000, BidResponses: 500, AuctionWins: 100, Revenue: 1, SoldImpressions: 50, PubImpressions: 50, NoResponseRequests: 100},
+		"dp3": {BidRequests: 10, BidResponses: 10, AuctionWins: 10, Revenue: 10},
+	}
+
+	got := calculateDPScores(stats, DefaultDPScoreWeights, 100)
+	assert.Len(t, got, 2)
+
+	assert.Equal(t, "dp1", got[0].DemandPartnerID)
+	assert.Equal(t, 1940, got[0].Score)
+	assert.Equal(t, "dp2", got[1].DemandPartnerID)
+	assert.Equal(t, 940, got[1].Score)
+
+	want := []dto.DPScoreComponent{
+		{Name: dto.DPScoreComponentWinRate, Value: 0.2, Score: 0.4, Weight: 0.2, Points: 160},
+		{Name: dto.DPScoreComponentERPM, Value: 1, Score: 0.5, Weight: 0.25, Points: 250},
+		{Name: dto.DPScoreComponentTimeoutRate, Value: 0.5, Score: 0.5, Weight: 0.15, Points: 150},
+		{Name: dto.DPScoreComponentNoResponseRate, Value: 0.1, Score: 0.9, Weight: 0.1, Points: 180},
+		{Name: dto.DPScoreComponentBidCacheRate, Value: 0, Score: 0, Weight: 0.1, Points: 0},
+		{Name: dto.DPScoreComponentRevenueShare, Value: 1.0 / 3, Score: 0.5, Weight: 0.2, Points: 200},
+	}
+	assert.Len(t, got[1].Components, len(want))
+	for i, component := range got[1].Components {
+		assert.Equal(t, want[i].Name, component.Name)
+		assert.InDelta(t, want[i].Value, component.Value, 1e-9)
+		assert.InDelta(t, want[i].Score, component.Score, 1e-9)
+		assert.Equal(t, want[i].Weight, component.Weight)
+		assert.Equal(t, want[i].Points, component.Points)
+	}
+}
+
+func TestGroupDPScoreStats(t *testing.T) {
+	t.Parallel()
+
+	demandRows := []*dpDemandStatsRow{
+		{DemandPartnerID: "dp1", Country: "us", DeviceType: "mobile", BidRequests: 300, BidResponses: 30, Revenue: 1},
+		{DemandPartnerID: "dp1", Country: "il", DeviceType: "desktop", BidRequests: 100, BidResponses: 10, Revenue: 2},
+		{DemandPartnerID: "dp1", BidRequests: 600},
+	}
+	impressionRows := []*dpImpressionStatsRow{
+		{DemandPartnerID: "dp1", Country: "us", DeviceType: "mobile", SoldImpressions: 20, PubImpressions: 10},
+	}
+	noResponseRows := []*dpNoResponseStatsRow{
+		{DemandPartnerID: "dp1", BidRequests: 50},
+		{DemandPartnerID: "dp2", BidRequests: 50},
+	}
+
+	overall, segments := groupDPScoreStats(demandRows, impressionRows, noResponseRows)
+	assert.Equal(t, map[string]*dpScoreStats{
+		"dp1": {BidRequests: 1000, BidResponses: 40, Revenue: 3, SoldImpressions: 20, PubImpressions: 10, NoResponseRequests: 50},
+	}, overall)
+	assert.Equal(t, map[dpScoreSegment]map[string]*dpScoreStats{
+		{country: "us", deviceType: "mobile"}: {
+			"dp1": {BidRequests: 300, BidResponses: 30, Revenue: 1, SoldImpressions: 20, PubImpressions: 10, NoResponseRequests: 15},
+		},
+		{country: "il", deviceType: "desktop"}: {
+			"dp1": {BidRequests: 100, BidResponses: 10, Revenue: 2, NoResponseRequests: 5},
+		},
+	}, segments)
+}
+
+func TestBuildDPRanking(t *testing.T) {
+	t.Parallel()
+
+	calculatedAt := time.Date(2025, 5, 30, 0, 0, 0, 0, time.UTC)
+	scores := []*dto.DPScore{
+		{DemandPartnerID: "dp2", Score: 900},
+		{DemandPartnerID: "dp1", Country: "us", DeviceType: "mobile", Score: 800},
+		{DemandPartnerID: "dp1", Score: 1200},
+		{DemandPartnerID: "dp2", Country: "us", DeviceType: "mobile", Score: 1500},
+	}
+
+	got := buildDPRanking(scores, calculatedAt)
+	assert.Equal(t, &dto.DPRanking{
+		DemandPartners: []dto.DPRankingItem{
+			{DemandPartnerID: "dp1", Score: 1200},
+			{DemandPartnerID: "dp2", Score: 900},
+		},
+		Segments: map[string][]dto.DPRankingItem{
+			"us:mobile": {
+				{DemandPartnerID: "dp2", Score: 1500},
+				{DemandPartnerID: "dp1", Score: 800},
+			},
+		},
+		CalculatedAt: calculatedAt,
+	}, got)
+	assert.Equal(t, "dp2", scores[0].DemandPartnerID)
+}
+
+func TestDPScoreWeightsValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		weights DPScoreWeights
+		wantErr bool
+	}{
+		{name: "default", weights: DefaultDPScoreWeights},
+		{name: "partial", weights: DPScoreWeights{dto.DPScoreComponentERPM: 1}},
+		{name: "unknownComponent", weights: DPScoreWeights{"fill_rate": 1}, wantErr: true},
+		{name: "negative", weights: DPScoreWeights{dto.DPScoreComponentERPM: 1, dto.DPScoreComponentWinRate: -1}, wantErr: true},
+		{name: "zeroTotal", weights: DPScoreWeights{dto.DPScoreComponentERPM: 0}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.weights.Validate()
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidDPScoreWeights)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -458,6 +458,15 @@ func (d *DPOService) DeleteDPORule(ctx context.Context, dpoRules []string) error
 		return fmt.Errorf("failed soft deleting dpo rules: %w", err)
 	}
 
+	// deleted rules mustn't be enabled back by resume of their paused publisher
+	_, err = models.PublisherPausedRules(
+		models.PublisherPausedRuleWhere.RuleType.EQ("dpo"),
+		models.PublisherPausedRuleWhere.RuleID.IN(dpoRules),
+	).DeleteAll(ctx, bcdb.DB())
+	if err != nil {
+		return fmt.Errorf("failed deleting paused state of dpo rules: %w", err)
+	}
+
 	updateDataInMetaData(mods)
 	d.historyModule.SaveAction(ctx, oldMods, newMods, nil)
 
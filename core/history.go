@@ -38,6 +38,7 @@ type HistoryFilter struct {
 	Domain          filter.StringArrayFilter `json:"domain,omitempty"`
 	DemandPartnerID filter.StringArrayFilter `json:"demand_partner_id,omitempty"`
 	EntityID        filter.StringArrayFilter `json:"entity_id,omitempty"`
+	APIKeyID        filter.IntArrayFilter    `json:"api_key_id,omitempty"`
 }
 
 func (h *HistoryService) GetHistory(ctx context.Context, ops *HistoryOptions) ([]*dto.History, error) {
@@ -48,7 +49,10 @@ func (h *HistoryService) GetHistory(ctx context.Context, ops *HistoryOptions) ([
 			models.TableNames.History + ".*, " +
 				models.TableNames.Dpo + "." + models.DpoColumns.DemandPartnerName + ", " +
 				`"` + models.TableNames.User + `".` + models.UserColumns.FirstName + ", " +
-				`"` + models.TableNames.User + `".` + models.UserColumns.LastName)).
+				`"` + models.TableNames.User + `".` + models.UserColumns.LastName + ", " +
+				"approver." + models.UserColumns.FirstName + " AS approver_first_name, " +
+				"approver." + models.UserColumns.LastName + " AS approver_last_name, " +
+				models.TableNames.APIKey + "." + models.APIKeyColumns.Name + " AS api_key_name")).
 		Add(qm.From(models.TableNames.History)).
 		Add(qm.LeftOuterJoin(
 			models.TableNames.Dpo + " ON " +
@@ -58,6 +62,13 @@ func (h *HistoryService) GetHistory(ctx context.Context, ops *HistoryOptions) ([
 		Add(qm.LeftOuterJoin(`"` + models.TableNames.User + `" ON ` +
 			models.TableNames.History + "." + models.HistoryColumns.UserID + " = " +
 			`"` + models.TableNames.User + `".` + models.UserColumns.ID,
+		)).
+		Add(qm.LeftOuterJoin(`"` + models.TableNames.User + `" AS approver ON ` +
+			models.TableNames.History + "." + models.HistoryColumns.ApproverID + " = approver." + models.UserColumns.ID,
+		)).
+		Add(qm.LeftOuterJoin(models.TableNames.APIKey + " ON " +
+			models.TableNames.History + "." + models.HistoryColumns.APIKeyID + " = " +
+			models.TableNames.APIKey + "." + models.APIKeyColumns.ID,
 		))
 
 	var mods []*dto.HistoryModelExtended
@@ -117,5 +128,9 @@ func (filter *HistoryFilter) queryMod() qmods.QueryModsSlice {
 		mods = append(mods, filter.EntityID.AndIn(models.HistoryColumns.EntityID))
 	}
 
+	if len(filter.APIKeyID) > 0 {
+		mods = append(mods, filter.APIKeyID.AndIn(models.TableNames.History+"."+models.HistoryColumns.APIKeyID))
+	}
+
 	return mods
 }
@@ -0,0 +1,263 @@
+package core
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/core/report/monthly"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/models"
+	"github.com/m6yf/bcwork/modules/history"
+	"github.com/m6yf/bcwork/modules/rbac"
+	"github.com/rotisserie/eris"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// PortalService serves publisher portal. Each method checks that the user can access the requested publisher,
+// so publisher users see only data of publishers assigned to them.
+type PortalService struct {
+	historyModule    history.HistoryModule
+	targetingService *TargetingService
+}
+
+func NewPortalService(historyModule history.HistoryModule, targetingService *TargetingService) *PortalService {
+	return &PortalService{
+		historyModule:    historyModule,
+		targetingService: targetingService,
+	}
+}
+
+// portalReportQuery is formatted with the table, publisher_daily or publisher_hourly
+const portalReportQuery = `select time, domain, sum(publisher_impressions) impressions, sum(supply_total) revenue
+	from %v where publisher_id=$1 and time>=$2 and time<$3 and (cardinality($4::varchar[]) = 0 or domain = any($4))
+	group by time, domain order by time asc, domain asc`
+
+func (p *PortalService) GetReport(ctx context.Context, data *dto.PortalReportRequest) ([]*dto.PortalReportRow, error) {
+	if err := rbac.CheckPublisher(ctx, data.PublisherID); err != nil {
+		return nil, err
+	}
+
+	table := models.TableNames.PublisherDaily
+	if data.Granularity == dto.PortalGranularityHourly {
+		table = models.TableNames.PublisherHourly
+	}
+
+	domains := data.Domain
+	if domains == nil {
+		domains = []string{}
+	}
+
+	rows := make([]*dto.PortalReportRow, 0)
+	err := queries.Raw(fmt.Sprintf(portalReportQuery, table), data.PublisherID, data.From, data.To, domains).Bind(ctx, bcdb.DB(), &rows)
+	if err != nil {
+		return nil, eris.Wrapf(err, "failed to retrieve report of publisher [%v]", data.PublisherID)
+	}
+
+	for _, row := range rows {
+		if row.Impressions > 0 {
+			row.RPM = row.Revenue / float64(row.Impressions) * 1000
+		}
+	}
+
+	return rows, nil
+}
+
+// GetDomains returns domains of the publisher with results of the last ads.txt scans
+// and approved lines which are not added to ads.txt yet
+func (p *PortalService) GetDomains(ctx context.Context, publisherID string) ([]*dto.PortalDomain, error) {
+	if err := rbac.CheckPublisher(ctx, publisherID); err != nil {
+		return nil, err
+	}
+
+	domainMods, err := models.PublisherDomains(
+		models.PublisherDomainWhere.PublisherID.EQ(publisherID),
+		qm.OrderBy(models.PublisherDomainColumns.Domain),
+	).All(ctx, bcdb.DB())
+	if err != nil {
+		return nil, eris.Wrapf(err, "failed to retrieve domains of publisher [%v]", publisherID)
+	}
+
+	scans, err := models.AdsTXTScans(
+		models.AdsTXTScanWhere.PublisherID.EQ(publisherID),
+		qm.OrderBy(models.AdsTXTScanColumns.FileName),
+	).All(ctx, bcdb.DB())
+	if err != nil {
+		return nil, eris.Wrapf(err, "failed to retrieve ads.txt scans of publisher [%v]", publisherID)
+	}
+
+	lines, err := models.AdsTXTMainViews(
+		models.AdsTXTMainViewWhere.PublisherID.EQ(null.StringFrom(publisherID)),
+		models.AdsTXTMainViewWhere.DemandStatus.IN(adsTxtRequiredDemandStatuses),
+		models.AdsTXTMainViewWhere.IsDemandPartnerActive.EQ(null.BoolFrom(true)),
+		models.AdsTXTMainViewWhere.Status.NEQ(null.StringFrom(dto.AdsTxtStatusAdded)),
+	).All(ctx, bcdb.DB())
+	if err != nil {
+		return nil, eris.Wrapf(err, "failed to retrieve ads.txt lines of publisher [%v]", publisherID)
+	}
+
+	domains := make([]*dto.PortalDomain, 0, len(domainMods))
+	byDomain := make(map[string]*dto.PortalDomain, len(domainMods))
+	for _, mod := range domainMods {
+		domain := &dto.PortalDomain{
+			Domain:       mod.Domain,
+			AdsTxt:       make([]*dto.PortalAdsTxtStatus, 0),
+			MissingLines: make([]string, 0),
+		}
+		domains = append(domains, domain)
+		byDomain[strings.ToLower(mod.Domain)] = domain
+	}
+
+	for _, scan := range scans {
+		domain, ok := byDomain[strings.ToLower(scan.Domain)]
+		if !ok {
+			continue
+		}
+
+		domain.AdsTxt = append(domain.AdsTxt, &dto.PortalAdsTxtStatus{
+			FileName:     scan.FileName,
+			URL:          scan.URL.Ptr(),
+			Records:      scan.Records,
+			Found:        scan.Found,
+			Missing:      scan.Missing,
+			Mismatch:     scan.Mismatch,
+			ErrorMessage: scan.ErrorMessage.Ptr(),
+			ScannedAt:    scan.ScannedAt,
+		})
+	}
+
+	for _, line := range lines {
+		domain, ok := byDomain[strings.ToLower(line.Domain.String)]
+		if !ok || !line.AdsTXTLine.Valid || slices.Contains(domain.MissingLines, line.AdsTXTLine.String) {
+			continue
+		}
+
+		domain.MissingLines = append(domain.MissingLines, line.AdsTXTLine.String)
+	}
+
+	return domains, nil
+}
+
+// ExportTags exports JS tags of targetings, all targetings must belong to the publisher
+func (p *PortalService) ExportTags(ctx context.Context, data *dto.PortalTagsRequest) ([]dto.Tags, error) {
+	if err := rbac.CheckPublisher(ctx, data.PublisherID); err != nil {
+		return nil, err
+	}
+
+	count, err := models.Targetings(
+		models.TargetingWhere.ID.IN(data.IDs),
+		models.TargetingWhere.PublisherID.EQ(data.PublisherID),
+	).Count(ctx, bcdb.DB())
+	if err != nil {
+		return nil, eris.Wrapf(err, "failed to retrieve targetings of publisher [%v]", data.PublisherID)
+	}
+
+	ids := slices.Clone(data.IDs)
+	slices.Sort(ids)
+	if int(count) != len(slices.Compact(ids)) {
+		return nil, fmt.Errorf("%w: targetings %v don't belong to publisher [%v]", rbac.ErrForbidden, data.IDs, data.PublisherID)
+	}
+
+	return p.targetingService.ExportTags(ctx, &ExportTagsRequest{IDs: data.IDs, AddGDPR: data.AddGDPR})
+}
+
+func (p *PortalService) GetStatements(ctx context.Context, data *dto.PortalStatementRequest) ([]*monthly.Statement, error) {
+	if err := rbac.CheckPublisher(ctx, data.PublisherID); err != nil {
+		return nil, err
+	}
+
+	return monthly.PublisherStatements(ctx, data.PublisherID, data.From, data.To)
+}
+
+// GetNotificationPreference returns default preferences when the publisher didn't set them yet
+func (p *PortalService) GetNotificationPreference(ctx context.Context, publisherID string) (*dto.PublisherNotificationPreference, error) {
+	if err := rbac.CheckPublisher(ctx, publisherID); err != nil {
+		return nil, err
+	}
+
+	mod, err := models.PublisherNotificationPreferences(
+		models.PublisherNotificationPreferenceWhere.PublisherID.EQ(publisherID),
+	).One(ctx, bcdb.DB())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.DefaultPublisherNotificationPreference(publisherID), nil
+		}
+		return nil, eris.Wrapf(err, "failed to retrieve notification preference of publisher [%v]", publisherID)
+	}
+
+	preference := &dto.PublisherNotificationPreference{}
+	preference.FromModel(mod)
+
+	return preference, nil
+}
+
+func (p *PortalService) SetNotificationPreference(ctx context.Context, data *dto.PublisherNotificationPreference) error {
+	if err := rbac.CheckPublisher(ctx, data.PublisherID); err != nil {
+		return err
+	}
+
+	mod, err := models.PublisherNotificationPreferences(
+		models.PublisherNotificationPreferenceWhere.PublisherID.EQ(data.PublisherID),
+	).One(ctx, bcdb.DB())
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return eris.Wrapf(err, "failed to retrieve notification preference of publisher [%v]", data.PublisherID)
+	}
+
+	now := time.Now().UTC()
+	if mod == nil {
+		mod = &models.PublisherNotificationPreference{
+			PublisherID: data.PublisherID,
+			CreatedAt:   now,
+		}
+		setNotificationPreferenceFields(mod, data)
+
+		err = mod.Insert(ctx, bcdb.DB(), boil.Infer())
+		if err != nil {
+			return eris.Wrapf(err, "failed to create notification preference of publisher [%v]", data.PublisherID)
+		}
+
+		p.historyModule.SaveAction(ctx, nil, mod, &history.HistoryOptions{Subject: history.NotificationSubject})
+
+		return nil
+	}
+
+	oldMod := *mod
+	setNotificationPreferenceFields(mod, data)
+	mod.UpdatedAt = null.TimeFrom(now)
+
+	_, err = mod.Update(ctx, bcdb.DB(), boil.Infer())
+	if err != nil {
+		return eris.Wrapf(err, "failed to update notification preference of publisher [%v]", data.PublisherID)
+	}
+
+	p.historyModule.SaveAction(ctx, &oldMod, mod, &history.HistoryOptions{Subject: history.NotificationSubject})
+
+	return nil
+}
+
+func setNotificationPreferenceFields(mod *models.PublisherNotificationPreference, data *dto.PublisherNotificationPreference) {
+	mod.Emails = normalizeEmails(data.Emails)
+	mod.DailyReport = data.DailyReport
+	mod.MonthlyStatement = data.MonthlyStatement
+	mod.AdsTXTAlerts = data.AdsTxtAlerts
+}
+
+func normalizeEmails(emails []string) []string {
+	normalized := make([]string, 0, len(emails))
+	for _, email := range emails {
+		email = strings.ToLower(strings.TrimSpace(email))
+		if email != "" && !slices.Contains(normalized, email) {
+			normalized = append(normalized, email)
+		}
+	}
+
+	return normalized
+}
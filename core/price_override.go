@@ -2,96 +2,343 @@ package core
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/netip"
+	"strings"
 	"time"
 
 	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/bcdb/filter"
+	"github.com/m6yf/bcwork/bcdb/order"
+	"github.com/m6yf/bcwork/bcdb/pagination"
+	"github.com/m6yf/bcwork/bcdb/qmods"
 	"github.com/m6yf/bcwork/dto"
 	"github.com/m6yf/bcwork/models"
+	"github.com/m6yf/bcwork/modules/history"
 	"github.com/m6yf/bcwork/utils/bcguid"
+	"github.com/m6yf/bcwork/utils/constant"
+	"github.com/rotisserie/eris"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
-	"github.com/volatiletech/sqlboiler/v4/types"
 )
 
-func UpdateMetaDataQueue(ctx context.Context, data *dto.PriceOverrideRequest) error {
-	var value types.JSON
-	var err error
+const priceOverrideKeyPrefix = "price:override:"
 
-	priceOverride, err := models.MetadataQueues(
-		models.MetadataQueueWhere.Key.EQ("price:override:"+data.Domain),
-		qm.OrderBy("updated_at desc"),
-	).
-		One(ctx, bcdb.DB())
+type PriceOverrideService struct {
+	historyModule history.HistoryModule
+}
+
+func NewPriceOverrideService(historyModule history.HistoryModule) *PriceOverrideService {
+	return &PriceOverrideService{
+		historyModule: historyModule,
+	}
+}
+
+type GetPriceOverrideOptions struct {
+	Filter     PriceOverrideFilter    `json:"filter"`
+	Pagination *pagination.Pagination `json:"pagination"`
+	Order      order.Sort             `json:"order"`
+	Selector   string                 `json:"selector"`
+}
+
+type PriceOverrideFilter struct {
+	Domain    filter.StringArrayFilter `json:"domain,omitempty"`
+	IP        filter.StringArrayFilter `json:"ip,omitempty"`
+	CreatedBy filter.IntArrayFilter    `json:"created_by,omitempty"`
+	Expired   *bool                    `json:"expired,omitempty"`
+}
+
+func (p *PriceOverrideService) GetPriceOverrides(ctx context.Context, ops *GetPriceOverrideOptions) ([]*dto.PriceOverride, error) {
+	qmods := ops.Filter.queryMod().
+		Order(ops.Order, nil, models.PriceOverrideColumns.ID).
+		AddArray(ops.Pagination.Do())
+
+	mods, err := models.PriceOverrides(qmods...).All(ctx, bcdb.DB())
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, eris.Wrap(err, "failed to retrieve price overrides")
+	}
+
+	overrides := make([]*dto.PriceOverride, 0, len(mods))
+	for _, mod := range mods {
+		override := &dto.PriceOverride{}
+		override.FromModel(mod)
+		overrides = append(overrides, override)
+	}
 
-	if priceOverride == nil || priceOverride.UpdatedAt.Time.Before(time.Now().Add(-8*time.Hour)) {
-		value, err = buildPriceOvverideValue(data)
+	return overrides, nil
+}
+
+// SetPriceOverrides adds entries of domain or updates price and expiry of existing ones, then publishes domain overrides
+func (p *PriceOverrideService) SetPriceOverrides(ctx context.Context, data *dto.PriceOverrideRequest) error {
+	ttlHours := data.TTLHours
+	if ttlHours == 0 {
+		ttlHours = dto.DefaultPriceOverrideTTLHours
+	}
+
+	now := time.Now().UTC()
+	expiresAt := now.Add(time.Duration(ttlHours) * time.Hour)
+	createdBy, isUserKnown := ctx.Value(constant.UserIDContextKey).(int)
+
+	// entries with the same ip after normalization are merged, the last one wins
+	prices := make(map[string]float64, len(data.Ips))
+	ips := make([]string, 0, len(data.Ips))
+	for _, entry := range data.Ips {
+		ip, err := NormalizeOverrideIP(entry.IP)
 		if err != nil {
-			return fmt.Errorf("failed to build price override value: %w", err)
+			return err
 		}
-	} else {
-		value, err = addNewIpToValue(priceOverride.Value, data)
-		if err != nil {
-			return fmt.Errorf("failed to add new ip to value: %w", err)
+
+		if _, ok := prices[ip]; !ok {
+			ips = append(ips, ip)
 		}
+		prices[ip] = entry.Price
 	}
 
-	mod := models.MetadataQueue{
-		Key:           "price:override:" + data.Domain,
-		TransactionID: bcguid.NewFromf(data.Domain, time.Now()),
-		Value:         value,
+	tx, err := bcdb.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
+	defer tx.Rollback()
 
-	err = mod.Insert(ctx, bcdb.DB(), boil.Infer())
+	oldMods, err := models.PriceOverrides(
+		models.PriceOverrideWhere.Domain.EQ(data.Domain),
+		models.PriceOverrideWhere.IP.IN(ips),
+	).All(ctx, tx)
 	if err != nil {
-		return fmt.Errorf("failed to insert metadata update to queue: %w", err)
+		return fmt.Errorf("failed to get current price overrides: %w", err)
 	}
 
+	oldModsMap := make(map[string]*models.PriceOverride, len(oldMods))
+	for _, mod := range oldMods {
+		oldModsMap[mod.IP] = mod
+	}
+
+	oldValues := make([]any, 0, len(ips))
+	newValues := make([]any, 0, len(ips))
+	for _, ip := range ips {
+		mod := &models.PriceOverride{
+			Domain:    data.Domain,
+			IP:        ip,
+			Price:     prices[ip],
+			ExpiresAt: expiresAt,
+			CreatedBy: null.NewInt(createdBy, isUserKnown),
+			CreatedAt: now,
+			UpdatedAt: null.TimeFrom(now),
+		}
+
+		err := mod.Upsert(
+			ctx,
+			tx,
+			true,
+			[]string{models.PriceOverrideColumns.Domain, models.PriceOverrideColumns.IP},
+			boil.Whitelist(models.PriceOverrideColumns.Price, models.PriceOverrideColumns.ExpiresAt, models.PriceOverrideColumns.UpdatedAt),
+			boil.Infer(),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to upsert price override for ip [%v]: %w", ip, err)
+		}
+
+		var oldValue any
+		if oldMod, ok := oldModsMap[ip]; ok {
+			oldValue = oldMod
+			mod.CreatedBy = oldMod.CreatedBy
+			mod.CreatedAt = oldMod.CreatedAt
+		}
+		oldValues = append(oldValues, oldValue)
+		newValues = append(newValues, mod)
+	}
+
+	if err := publishPriceOverrides(ctx, tx, data.Domain); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction in price overrides update: %w", err)
+	}
+
+	p.historyModule.SaveAction(ctx, oldValues, newValues, &history.HistoryOptions{
+		Subject:                  history.PriceOverrideSubject,
+		IsMultipleValuesExpected: true,
+	})
+
 	return nil
 }
 
-func addNewIpToValue(value types.JSON, data *dto.PriceOverrideRequest) (types.JSON, error) {
-	var metaDataValue []dto.Ips
-	err := json.Unmarshal([]byte(value), &metaDataValue)
+// DeletePriceOverrides removes entries and republishes overrides of affected domains
+func (p *PriceOverrideService) DeletePriceOverrides(ctx context.Context, ids []int) error {
+	tx, err := bcdb.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	mods, err := models.PriceOverrides(
+		models.PriceOverrideWhere.ID.IN(ids),
+		qm.OrderBy(models.PriceOverrideColumns.Domain),
+	).All(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("failed to get price overrides to delete: %w", err)
+	}
+
+	if len(mods) == 0 {
+		return nil
+	}
+
+	_, err = mods.DeleteAll(ctx, tx)
 	if err != nil {
-		return nil, fmt.Errorf("unable to unmarshal metadata value for price override: %w", err)
+		return fmt.Errorf("failed to delete price overrides: %w", err)
+	}
+
+	oldValues := make([]any, 0, len(mods))
+	newValues := make([]any, 0, len(mods))
+	domains := make([]string, 0)
+	for _, mod := range mods {
+		oldValues = append(oldValues, mod)
+		newValues = append(newValues, nil)
+		if len(domains) == 0 || domains[len(domains)-1] != mod.Domain {
+			domains = append(domains, mod.Domain)
+		}
 	}
 
-	currentTime := time.Now()
-	for _, userData := range data.Ips {
-		ipPriceDate := dto.Ips{
-			IP:    userData.IP,
-			Date:  currentTime,
-			Price: userData.Price,
+	for _, domain := range domains {
+		if err := publishPriceOverrides(ctx, tx, domain); err != nil {
+			return err
 		}
-		metaDataValue = append(metaDataValue, ipPriceDate)
 	}
 
-	val, err := json.Marshal(metaDataValue)
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction in price overrides deletion: %w", err)
+	}
+
+	p.historyModule.SaveAction(ctx, oldValues, newValues, &history.HistoryOptions{
+		Subject:                  history.PriceOverrideSubject,
+		IsMultipleValuesExpected: true,
+	})
+
+	return nil
+}
+
+// RemoveExpiredPriceOverrides deletes entries which expiry passed and republishes affected domains
+func (p *PriceOverrideService) RemoveExpiredPriceOverrides(ctx context.Context) error {
+	mods, err := models.PriceOverrides(
+		models.PriceOverrideWhere.ExpiresAt.LTE(time.Now().UTC()),
+	).All(ctx, bcdb.DB())
 	if err != nil {
-		return nil, fmt.Errorf("price override failed to parse hash value: %w", err)
+		return eris.Wrap(err, "failed to retrieve expired price overrides")
 	}
 
-	return val, nil
+	if len(mods) == 0 {
+		return nil
+	}
+
+	ids := make([]int, 0, len(mods))
+	for _, mod := range mods {
+		ids = append(ids, mod.ID)
+	}
+
+	return p.DeletePriceOverrides(ctx, ids)
 }
 
-func buildPriceOvverideValue(data *dto.PriceOverrideRequest) (types.JSON, error) {
-	ips := []dto.Ips{}
-	currentTime := time.Now()
-	for _, userData := range data.Ips {
-		ipPriceDate := dto.Ips{
-			IP:    userData.IP,
-			Date:  currentTime,
-			Price: userData.Price,
+// NormalizeOverrideIP returns canonical form of ip address or CIDR range (host bits of range are cleared)
+func NormalizeOverrideIP(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if strings.Contains(value, "/") {
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil {
+			return "", fmt.Errorf("invalid CIDR [%v]: %w", value, err)
 		}
-		ips = append(ips, ipPriceDate)
+
+		return prefix.Masked().String(), nil
 	}
 
-	val, err := json.Marshal(ips)
+	addr, err := netip.ParseAddr(value)
 	if err != nil {
-		return nil, fmt.Errorf("price override failed to parse hash value: %w", err)
+		return "", fmt.Errorf("invalid ip [%v]: %w", value, err)
+	}
+
+	return addr.String(), nil
+}
+
+// publishPriceOverrides pushes current (not expired) overrides of domain to metadata
+func publishPriceOverrides(ctx context.Context, exec boil.ContextExecutor, domain string) error {
+	mods, err := models.PriceOverrides(
+		models.PriceOverrideWhere.Domain.EQ(domain),
+		models.PriceOverrideWhere.ExpiresAt.GT(time.Now().UTC()),
+		qm.OrderBy(models.PriceOverrideColumns.IP),
+	).All(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("failed to get price overrides for metadata: %w", err)
+	}
+
+	value, err := json.Marshal(buildPriceOverrideValue(mods))
+	if err != nil {
+		return fmt.Errorf("price override failed to parse hash value: %w", err)
+	}
+
+	mod := models.MetadataQueue{
+		Key:           priceOverrideKeyPrefix + domain,
+		TransactionID: bcguid.NewFromf(domain, time.Now()),
+		Value:         value,
+	}
+
+	err = mod.Insert(ctx, exec, boil.Infer())
+	if err != nil {
+		return fmt.Errorf("failed to insert metadata update to queue: %w", err)
+	}
+
+	return nil
+}
+
+func buildPriceOverrideValue(mods models.PriceOverrideSlice) []dto.Ips {
+	ips := make([]dto.Ips, 0, len(mods))
+	for _, mod := range mods {
+		// date is the time of the last change
+		date := mod.CreatedAt
+		if mod.UpdatedAt.Valid {
+			date = mod.UpdatedAt.Time
+		}
+
+		expiresAt := mod.ExpiresAt
+		ips = append(ips, dto.Ips{
+			IP:        mod.IP,
+			Date:      date,
+			Price:     mod.Price,
+			ExpiresAt: &expiresAt,
+		})
+	}
+
+	return ips
+}
+
+func (filter *PriceOverrideFilter) queryMod() qmods.QueryModsSlice {
+	mods := make(qmods.QueryModsSlice, 0)
+	if filter == nil {
+		return mods
+	}
+
+	if len(filter.Domain) > 0 {
+		mods = append(mods, filter.Domain.AndIn(models.PriceOverrideColumns.Domain))
+	}
+
+	if len(filter.IP) > 0 {
+		mods = append(mods, filter.IP.AndIn(models.PriceOverrideColumns.IP))
+	}
+
+	if len(filter.CreatedBy) > 0 {
+		mods = append(mods, filter.CreatedBy.AndIn(models.PriceOverrideColumns.CreatedBy))
+	}
+
+	if filter.Expired != nil {
+		if *filter.Expired {
+			mods = append(mods, models.PriceOverrideWhere.ExpiresAt.LTE(time.Now().UTC()))
+		} else {
+			mods = append(mods, models.PriceOverrideWhere.ExpiresAt.GT(time.Now().UTC()))
+		}
 	}
 
-	return val, err
+	return mods
 }
@@ -1,119 +1,65 @@
 package core
 
 import (
-	"encoding/json"
+	"testing"
+	"time"
+
 	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/models"
 	"github.com/stretchr/testify/assert"
-	"github.com/volatiletech/sqlboiler/v4/types"
-	"testing"
+	"github.com/volatiletech/null/v8"
 )
 
-func TestBuildPriceOverrideValue(t *testing.T) {
-	t.Run("Success", func(t *testing.T) {
-		data := &dto.PriceOverrideRequest{
-			Ips: []dto.Ips{
-				{IP: "192.168.1.1", Price: 100.50},
-				{IP: "10.0.0.1", Price: 200.75},
-			},
-		}
-
-		result, err := buildPriceOvverideValue(data)
-
-		assert.NoError(t, err)
-
-		var ips []dto.Ips
-		err = json.Unmarshal(result, &ips)
-		assert.NoError(t, err)
-
-		assert.Equal(t, 2, len(ips))
-		assert.Equal(t, "192.168.1.1", ips[0].IP)
-		assert.Equal(t, 100.50, ips[0].Price)
-		assert.Equal(t, "10.0.0.1", ips[1].IP)
-		assert.Equal(t, 200.75, ips[1].Price)
-	})
-
-	t.Run("Empty Input", func(t *testing.T) {
-		data := &dto.PriceOverrideRequest{
-			Ips: []dto.Ips{},
-		}
-
-		result, err := buildPriceOvverideValue(data)
-
-		assert.NoError(t, err)
-
-		var ips []dto.Ips
-		err = json.Unmarshal(result, &ips)
-		assert.NoError(t, err)
-
-		assert.Equal(t, 0, len(ips))
-	})
-
-	t.Run("Special Characters in IP", func(t *testing.T) {
-		data := &dto.PriceOverrideRequest{
-			Ips: []dto.Ips{
-				{IP: "255.255.255.255", Price: 0.0},
-				{IP: "localhost", Price: 300.99},
-			},
-		}
-
-		result, err := buildPriceOvverideValue(data)
-		assert.NoError(t, err)
-
-		var ips []dto.Ips
-		err = json.Unmarshal(result, &ips)
-		assert.NoError(t, err)
-
-		assert.Equal(t, 2, len(ips))
-		assert.Equal(t, "255.255.255.255", ips[0].IP)
-		assert.Equal(t, 0.0, ips[0].Price)
-		assert.Equal(t, "localhost", ips[1].IP)
-		assert.Equal(t, 300.99, ips[1].Price)
-	})
+func TestNormalizeOverrideIP(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{name: "ipv4", value: " 192.168.1.1 ", want: "192.168.1.1"},
+		{name: "ipv6", value: "2001:DB8::1", want: "2001:db8::1"},
+		{name: "cidr", value: "10.0.0.0/24", want: "10.0.0.0/24"},
+		{name: "cidrWithHostBits", value: "10.0.0.17/24", want: "10.0.0.0/24"},
+		{name: "invalidIP", value: "localhost", wantErr: true},
+		{name: "invalidCIDR", value: "10.0.0.0/33", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NormalizeOverrideIP(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
 }
 
-func TestAddNewIpToValue(t *testing.T) {
-	t.Run("Success Case", func(t *testing.T) {
-		// Prepare initial JSON value and request data
-		initialValue := `[{"ip":"192.168.0.1","date":"2025-01-01T12:00:00Z","price":100}]`
-		value := types.JSON(initialValue)
-
-		requestData := &dto.PriceOverrideRequest{
-			Ips: []dto.Ips{
-				{IP: "10.0.0.1", Price: 200.50},
-				{IP: "172.16.0.1", Price: 300.75},
-			},
-		}
-
-		result, err := addNewIpToValue(value, requestData)
-
-		assert.NoError(t, err)
-
-		var resultData []dto.Ips
-		err = json.Unmarshal(result, &resultData)
-		assert.NoError(t, err)
-
-		assert.Equal(t, 3, len(resultData))
-		assert.Equal(t, "192.168.0.1", resultData[0].IP)
-		assert.Equal(t, "10.0.0.1", resultData[1].IP)
-		assert.Equal(t, 200.50, resultData[1].Price)
-		assert.Equal(t, "172.16.0.1", resultData[2].IP)
-		assert.Equal(t, 300.75, resultData[2].Price)
-	})
+func TestBuildPriceOverrideValue(t *testing.T) {
+	t.Parallel()
 
-	t.Run("Unmarshal Error", func(t *testing.T) {
-		invalidValue := `invalid json`
-		value := types.JSON(invalidValue)
+	createdAt := time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC)
+	updatedAt := time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC)
+	expiresAt := time.Date(2025, 4, 1, 20, 0, 0, 0, time.UTC)
 
-		requestData := &dto.PriceOverrideRequest{
-			Ips: []dto.Ips{
-				{IP: "10.0.0.1", Price: 200.50},
-			},
-		}
+	mods := models.PriceOverrideSlice{
+		{IP: "10.0.0.0/24", Price: 1.5, ExpiresAt: expiresAt, CreatedAt: createdAt},
+		{IP: "192.168.1.1", Price: 2, ExpiresAt: expiresAt, CreatedAt: createdAt, UpdatedAt: null.TimeFrom(updatedAt)},
+	}
 
-		result, err := addNewIpToValue(value, requestData)
+	got := buildPriceOverrideValue(mods)
+	assert.Equal(t, []dto.Ips{
+		{IP: "10.0.0.0/24", Date: createdAt, Price: 1.5, ExpiresAt: &expiresAt},
+		{IP: "192.168.1.1", Date: updatedAt, Price: 2, ExpiresAt: &expiresAt},
+	}, got)
 
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "unable to unmarshal metadata value")
-		assert.Nil(t, result)
-	})
+	assert.Equal(t, []dto.Ips{}, buildPriceOverrideValue(nil))
 }
@@ -19,6 +19,7 @@ import (
 	"github.com/m6yf/bcwork/models"
 	"github.com/m6yf/bcwork/modules/compass"
 	"github.com/m6yf/bcwork/modules/history"
+	"github.com/m6yf/bcwork/modules/rbac"
 	"github.com/rotisserie/eris"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
@@ -103,7 +104,10 @@ type GetPublisherOptions struct {
 }
 
 func (p *PublisherService) GetPublisher(ctx context.Context, ops *GetPublisherOptions) (dto.PublisherSlice, error) {
-	qmods := ops.Filter.QueryMod().Order(ops.Order, nil, models.PublisherColumns.PublisherID).AddArray(ops.Pagination.Do())
+	qmods := ops.Filter.QueryMod().
+		AddArray(rbac.PublisherScopeMods(ctx, models.PublisherColumns.PublisherID)).
+		Order(ops.Order, nil, models.PublisherColumns.PublisherID).
+		AddArray(ops.Pagination.Do())
 
 	if ops.Selector == "id" {
 		qmods = qmods.Add(qm.Select("DISTINCT " + models.PublisherColumns.PublisherID))
@@ -145,6 +149,11 @@ func (p *PublisherService) UpdatePublisher(ctx context.Context, publisherID stri
 		return fmt.Errorf("publisher_id is mandatory when updating a publisher")
 	}
 
+	err := rbac.CheckPublisher(ctx, publisherID)
+	if err != nil {
+		return err
+	}
+
 	modPublisher, err := models.Publishers(models.PublisherWhere.PublisherID.EQ(publisherID)).One(ctx, bcdb.DB())
 	if err != nil {
 		return eris.Wrap(err, fmt.Sprintf("failed to get publisher with id [%v] to update", publisherID))
@@ -194,9 +203,13 @@ func (p *PublisherService) UpdatePublisher(ctx context.Context, publisherID stri
 		cols = append(cols, models.PublisherColumns.ReactivateTimestamp)
 	}
 
+	var transition *models.PublisherStatusTransition
 	if vals.Status != nil {
-		modPublisher.Status = null.StringFromPtr(vals.Status)
-		cols = append(cols, models.PublisherColumns.Status)
+		transition, err = changePublisherStatus(ctx, modPublisher, *vals.Status, dto.PublisherStatusSourceManual)
+		if err != nil {
+			return err
+		}
+		cols = append(cols, models.PublisherColumns.Status, models.PublisherColumns.StatusChangedAt)
 	}
 
 	if vals.IntegrationType != nil {
@@ -214,11 +227,32 @@ func (p *PublisherService) UpdatePublisher(ctx context.Context, publisherID stri
 		cols = append(cols, models.PublisherColumns.IsDirect)
 	}
 
+	if vals.SellerDomain != nil {
+		modPublisher.SellerDomain = null.NewString(*vals.SellerDomain, *vals.SellerDomain != "")
+		cols = append(cols, models.PublisherColumns.SellerDomain)
+	}
+
+	if vals.SellerType != nil {
+		modPublisher.SellerType = null.NewString(*vals.SellerType, *vals.SellerType != "")
+		cols = append(cols, models.PublisherColumns.SellerType)
+	}
+
+	if vals.IsConfidential != nil {
+		modPublisher.IsConfidential = *vals.IsConfidential
+		cols = append(cols, models.PublisherColumns.IsConfidential)
+	}
+
 	if len(cols) == 0 {
 		return fmt.Errorf("applicaiton payload contains no vals for update (publisher_id:%s)", modPublisher.PublisherID)
 	}
 
-	count, err := modPublisher.Update(ctx, bcdb.DB(), boil.Whitelist(cols...))
+	tx, err := bcdb.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return eris.Wrap(err, "failed to begin transaction for publisher update")
+	}
+	defer tx.Rollback()
+
+	count, err := modPublisher.Update(ctx, tx, boil.Whitelist(cols...))
 	if err != nil {
 		return eris.Wrap(err, fmt.Sprintf("failed to update publisher (publisher_id:%s)", modPublisher.PublisherID))
 	}
@@ -226,12 +260,33 @@ func (p *PublisherService) UpdatePublisher(ctx context.Context, publisherID stri
 		return eris.Wrap(err, fmt.Sprintf("wrong publisher_id when updating publisher,verify publisher_id really exists (unit_id:%s)", modPublisher.PublisherID))
 	}
 
+	if transition != nil {
+		err = transition.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return eris.Wrapf(err, "failed to save status transition of publisher [%v]", modPublisher.PublisherID)
+		}
+	}
+
+	err = markCompassLocalChange(ctx, tx, &oldModPublisher, modPublisher, cols)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return eris.Wrap(err, "failed to commit publisher update")
+	}
+
 	p.historyModule.SaveAction(ctx, &oldModPublisher, modPublisher, nil)
 
 	return nil
 }
 
 func (p *PublisherService) CreatePublisher(ctx context.Context, vals dto.PublisherCreateValues) (string, error) {
+	if _, ok := rbac.PublisherScope(ctx); ok {
+		return "", fmt.Errorf("%w: user limited to assigned publishers can't create new ones", rbac.ErrForbidden)
+	}
+
 	maxAge, err := calculatePublisherKey()
 	if err != nil {
 		return "", eris.Wrapf(err, "failed to calculate publisher key")
@@ -244,24 +299,53 @@ func (p *PublisherService) CreatePublisher(ctx context.Context, vals dto.Publish
 		MediaBuyerID:      null.StringFrom(vals.MediaBuyerID),
 		CampaignManagerID: null.StringFrom(vals.CampaignManagerID),
 		OfficeLocation:    null.StringFrom(vals.OfficeLocation),
-		Status:            null.StringFrom(vals.Status),
 		IntegrationType:   vals.IntegrationType,
 		MediaType:         vals.MediaType,
 		IsDirect:          vals.IsDirect,
+		SellerDomain:      null.NewString(vals.SellerDomain, vals.SellerDomain != ""),
+		SellerType:        null.NewString(vals.SellerType, vals.SellerType != ""),
+		IsConfidential:    vals.IsConfidential,
+	}
+
+	status := vals.Status
+	if status == "" {
+		status = dto.PublisherStatusOnboarding
+	}
+
+	transition, err := changePublisherStatus(ctx, modPublisher, status, dto.PublisherStatusSourceManual)
+	if err != nil {
+		return "", err
 	}
 
-	err = modPublisher.Insert(ctx, bcdb.DB(), boil.Infer())
+	tx, err := bcdb.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return "", eris.Wrap(err, "failed to begin transaction for publisher creation")
+	}
+	defer tx.Rollback()
+
+	err = modPublisher.Insert(ctx, tx, boil.Infer())
 	if err != nil {
 		return "", eris.Wrapf(err, "failed to insert publisher")
 	}
 
+	err = transition.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		return "", eris.Wrapf(err, "failed to save status transition of publisher [%v]", modPublisher.PublisherID)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return "", eris.Wrap(err, "failed to commit publisher creation")
+	}
+
 	p.historyModule.SaveAction(ctx, nil, modPublisher, nil)
 
 	return modPublisher.PublisherID, nil
 }
 
 func (p *PublisherService) PublisherCount(ctx context.Context, filter *PublisherFilter) (int64, error) {
-	c, err := models.Publishers(filter.QueryMod()...).Count(ctx, bcdb.DB())
+	qmods := filter.QueryMod().AddArray(rbac.PublisherScopeMods(ctx, models.PublisherColumns.PublisherID))
+	c, err := models.Publishers(qmods...).Count(ctx, bcdb.DB())
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
 		return 0, eris.Wrapf(err, "failed to fetch all publishers")
 	}
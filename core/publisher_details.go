@@ -13,6 +13,7 @@ import (
 	"github.com/m6yf/bcwork/bcdb/qmods"
 	"github.com/m6yf/bcwork/dto"
 	"github.com/m6yf/bcwork/models"
+	"github.com/m6yf/bcwork/modules/rbac"
 	"github.com/rotisserie/eris"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
@@ -39,6 +40,7 @@ func (p *PublisherService) GetPublisherDetails(
 	qmods := ops.Filter.QueryMod().
 		Order(updateFieldNames(ops.Order), nil, models.TableNames.Publisher+"."+models.PublisherColumns.PublisherID).
 		AddArray(ops.Pagination.Do()).
+		AddArray(rbac.PublisherScopeMods(ctx, models.TableNames.Publisher+"."+models.PublisherColumns.PublisherID)).
 		Add(
 			qm.Select(
 				models.TableNames.Publisher+"."+models.PublisherColumns.Name,
@@ -83,6 +85,11 @@ func (p *PublisherService) GetPublisherDetails(
 		return nil, fmt.Errorf("failed to map publisher details: %w", err)
 	}
 
+	err = addDomainOnboarding(ctx, res)
+	if err != nil {
+		return nil, err
+	}
+
 	return res, nil
 }
 
@@ -3,6 +3,7 @@ package core
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/m6yf/bcwork/dto"
 	"github.com/m6yf/bcwork/models"
 	"github.com/m6yf/bcwork/modules/history"
+	"github.com/m6yf/bcwork/modules/rbac"
 	"github.com/rotisserie/eris"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
@@ -46,6 +48,7 @@ type PublisherDomainFilter struct {
 
 func (d *DomainService) GetPublisherDomain(ctx context.Context, ops *GetPublisherDomainOptions) (dto.PublisherDomainSlice, error) {
 	qmods := ops.Filter.QueryMod().
+		AddArray(rbac.PublisherScopeMods(ctx, models.PublisherDomainColumns.PublisherID)).
 		Order(ops.Order, nil, models.PublisherDomainColumns.PublisherID).
 		AddArray(ops.Pagination.Do()).
 		Add(qm.Select("DISTINCT *"))
@@ -116,6 +119,11 @@ func (filter *PublisherDomainFilter) QueryMod() qmods.QueryModsSlice {
 }
 
 func (d *DomainService) UpdatePublisherDomain(ctx context.Context, data *dto.PublisherDomainUpdateRequest) error {
+	err := rbac.CheckPublisher(ctx, data.PublisherID)
+	if err != nil {
+		return err
+	}
+
 	var oldModPointer any
 	mod, err := models.PublisherDomains(
 		models.PublisherDomainWhere.PublisherID.EQ(data.PublisherID),
@@ -127,19 +135,37 @@ func (d *DomainService) UpdatePublisherDomain(ctx context.Context, data *dto.Pub
 
 	if mod == nil {
 		mod = &models.PublisherDomain{
-			Domain:            data.Domain,
-			PublisherID:       data.PublisherID,
-			Automation:        data.Automation,
-			GPPTarget:         null.Float64FromPtr(data.GppTarget),
-			IntegrationType:   data.IntegrationType,
-			MirrorPublisherID: null.StringFromPtr(data.MirrorPublisherID),
-			IsDirect:          null.BoolFromPtr(data.IsDirect),
+			Domain:             data.Domain,
+			PublisherID:        data.PublisherID,
+			Automation:         data.Automation,
+			GPPTarget:          null.Float64FromPtr(data.GppTarget),
+			IntegrationType:    data.IntegrationType,
+			MirrorPublisherID:  null.StringFromPtr(data.MirrorPublisherID),
+			IsDirect:           null.BoolFromPtr(data.IsDirect),
+			AutomationStrategy: null.StringFromPtr(data.AutomationStrategy),
+			AutomationParams:   null.NewJSON(data.AutomationParams, hasAutomationParams(data.AutomationParams)),
+		}
+
+		tx, err := bcdb.DB().BeginTx(ctx, nil)
+		if err != nil {
+			return eris.Wrap(err, "failed to begin transaction for publisher domain creation")
+		}
+		defer tx.Rollback()
+
+		err = mod.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
 		}
 
-		err := mod.Insert(ctx, bcdb.DB(), boil.Infer())
+		err = StartDomainOnboarding(ctx, tx, mod.PublisherID, mod.Domain)
 		if err != nil {
 			return err
 		}
+
+		err = tx.Commit()
+		if err != nil {
+			return eris.Wrap(err, "failed to commit publisher domain creation")
+		}
 	} else {
 		oldMod := *mod
 		oldModPointer = &oldMod
@@ -150,6 +176,13 @@ func (d *DomainService) UpdatePublisherDomain(ctx context.Context, data *dto.Pub
 		mod.UpdatedAt = null.TimeFrom(time.Now().UTC())
 		mod.MirrorPublisherID = null.StringFromPtr(data.MirrorPublisherID)
 		mod.IsDirect = null.BoolFromPtr(data.IsDirect)
+		// strategy and its params are kept when they aren't sent, empty strategy and null params clear them
+		if data.AutomationStrategy != nil {
+			mod.AutomationStrategy = null.StringFromPtr(data.AutomationStrategy)
+		}
+		if data.AutomationParams != nil {
+			mod.AutomationParams = null.NewJSON(data.AutomationParams, hasAutomationParams(data.AutomationParams))
+		}
 
 		_, err := mod.Update(ctx, bcdb.DB(), boil.Infer())
 		if err != nil {
@@ -161,3 +194,7 @@ func (d *DomainService) UpdatePublisherDomain(ctx context.Context, data *dto.Pub
 
 	return nil
 }
+
+func hasAutomationParams(params json.RawMessage) bool {
+	return len(params) > 0 && string(params) != "null"
+}
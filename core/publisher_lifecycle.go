@@ -0,0 +1,226 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/models"
+	"github.com/m6yf/bcwork/modules/history"
+	"github.com/m6yf/bcwork/modules/logger"
+	"github.com/m6yf/bcwork/modules/rbac"
+	"github.com/m6yf/bcwork/utils/constant"
+	"github.com/rotisserie/eris"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+var ErrPublisherStatusTransition = errors.New("publisher status transition is not allowed")
+
+// changePublisherStatus moves publisher to the status and returns transition to be saved along with publisher,
+// nil transition is returned when publisher already has the status
+func changePublisherStatus(ctx context.Context, mod *models.Publisher, status, source string) (*models.PublisherStatusTransition, error) {
+	status = dto.NormalizePublisherStatus(status)
+	current := dto.NormalizePublisherStatus(mod.Status.String)
+	if !dto.CanTransitPublisherStatus(current, status) {
+		return nil, fmt.Errorf("%w: from [%v] to [%v]", ErrPublisherStatusTransition, mod.Status.String, status)
+	}
+
+	fromStatus := mod.Status
+	mod.Status = null.StringFrom(status)
+	if current == status {
+		return nil, nil
+	}
+
+	now := time.Now().UTC()
+	mod.StatusChangedAt = null.TimeFrom(now)
+
+	transition := &models.PublisherStatusTransition{
+		PublisherID: mod.PublisherID,
+		FromStatus:  null.NewString(fromStatus.String, fromStatus.String != ""),
+		ToStatus:    status,
+		Source:      source,
+		CreatedAt:   now,
+	}
+	if userID, ok := ctx.Value(constant.UserIDContextKey).(int); ok {
+		transition.CreatedBy = null.IntFrom(userID)
+	}
+
+	return transition, nil
+}
+
+// ApplyScheduledStatuses moves publishers by their pause, start and reactivation dates and returns moved publishers
+func (p *PublisherService) ApplyScheduledStatuses(ctx context.Context, now time.Time) (models.PublisherSlice, error) {
+	mods, err := models.Publishers(
+		models.PublisherWhere.Status.IN([]string{
+			dto.PublisherStatusOnboarding, dto.PublisherStatusActive, dto.PublisherStatusPaused,
+		}),
+	).All(ctx, bcdb.DB())
+	if err != nil {
+		return nil, eris.Wrap(err, "failed to retrieve publishers for scheduled status changes")
+	}
+
+	moved := make(models.PublisherSlice, 0)
+	for _, mod := range mods {
+		status := dto.ScheduledPublisherStatus(mod, now)
+		if status == "" {
+			continue
+		}
+
+		err := p.applyScheduledStatus(ctx, mod, status)
+		if err != nil {
+			logger.Logger(ctx).Error().Err(err).Msgf("failed to move publisher [%v] to status [%v]", mod.PublisherID, status)
+			continue
+		}
+
+		moved = append(moved, mod)
+	}
+
+	return moved, nil
+}
+
+func (p *PublisherService) applyScheduledStatus(ctx context.Context, mod *models.Publisher, status string) error {
+	oldMod := *mod
+
+	transition, err := changePublisherStatus(ctx, mod, status, dto.PublisherStatusSourceSchedule)
+	if err != nil {
+		return err
+	}
+
+	tx, err := bcdb.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return eris.Wrap(err, "failed to begin transaction for publisher status change")
+	}
+	defer tx.Rollback()
+
+	_, err = mod.Update(ctx, tx, boil.Whitelist(models.PublisherColumns.Status, models.PublisherColumns.StatusChangedAt))
+	if err != nil {
+		return eris.Wrap(err, "failed to update publisher status")
+	}
+
+	if transition != nil {
+		err = transition.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return eris.Wrap(err, "failed to save publisher status transition")
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return eris.Wrap(err, "failed to commit publisher status change")
+	}
+
+	p.historyModule.SaveAction(ctx, &oldMod, mod, &history.HistoryOptions{Subject: history.PublisherSubject})
+
+	return nil
+}
+
+// GetLifecycleReport returns time publishers spent in each status during the period
+func (p *PublisherService) GetLifecycleReport(ctx context.Context, data *dto.PublisherLifecycleReportRequest) ([]*dto.PublisherTimeInState, error) {
+	qmods := rbac.PublisherScopeMods(ctx, models.PublisherColumns.PublisherID)
+	if len(data.PublisherIDs) > 0 {
+		qmods = append(qmods, models.PublisherWhere.PublisherID.IN(data.PublisherIDs))
+	}
+	qmods = append(qmods, qm.OrderBy(models.PublisherColumns.PublisherID))
+
+	publishers, err := models.Publishers(qmods...).All(ctx, bcdb.DB())
+	if err != nil {
+		return nil, eris.Wrap(err, "failed to retrieve publishers for lifecycle report")
+	}
+
+	if len(publishers) == 0 {
+		return []*dto.PublisherTimeInState{}, nil
+	}
+
+	publisherIDs := make([]string, 0, len(publishers))
+	for _, publisher := range publishers {
+		publisherIDs = append(publisherIDs, publisher.PublisherID)
+	}
+
+	transitions, err := models.PublisherStatusTransitions(
+		models.PublisherStatusTransitionWhere.PublisherID.IN(publisherIDs),
+		models.PublisherStatusTransitionWhere.CreatedAt.LT(data.To),
+		qm.OrderBy(models.PublisherStatusTransitionColumns.CreatedAt+" ASC, "+models.PublisherStatusTransitionColumns.ID+" ASC"),
+	).All(ctx, bcdb.DB())
+	if err != nil {
+		return nil, eris.Wrap(err, "failed to retrieve publisher status transitions")
+	}
+
+	transitionsByPublisher := make(map[string]models.PublisherStatusTransitionSlice, len(publishers))
+	for _, transition := range transitions {
+		transitionsByPublisher[transition.PublisherID] = append(transitionsByPublisher[transition.PublisherID], transition)
+	}
+
+	to := data.To
+	if now := time.Now().UTC(); to.After(now) {
+		to = now
+	}
+
+	report := make([]*dto.PublisherTimeInState, 0, len(publishers))
+	for _, publisher := range publishers {
+		durations, count := timeInState(transitionsByPublisher[publisher.PublisherID], publisher.Status.String, data.From, to)
+
+		days := make(map[string]float64, len(durations))
+		for status, duration := range durations {
+			days[status] = duration.Hours() / 24
+		}
+
+		report = append(report, &dto.PublisherTimeInState{
+			PublisherID: publisher.PublisherID,
+			Status:      publisher.Status.String,
+			Days:        days,
+			Transitions: count,
+		})
+	}
+
+	return report, nil
+}
+
+// timeInState sums durations of statuses between from and to by sorted transitions which happened before to.
+// Status before the first transition is taken from it, if there are no transitions current status is used.
+// Number of transitions inside the period is returned as well.
+func timeInState(transitions []*models.PublisherStatusTransition, current string, from, to time.Time) (map[string]time.Duration, int) {
+	durations := make(map[string]time.Duration)
+	if !to.After(from) {
+		return durations, 0
+	}
+
+	var (
+		status string
+		known  bool
+		count  int
+	)
+	cursor := from
+	for _, transition := range transitions {
+		if !transition.CreatedAt.After(from) {
+			status, known = transition.ToStatus, true
+			continue
+		}
+
+		if !known {
+			// until the first transition of the period publisher was in the status it moved from
+			status, known = transition.FromStatus.String, transition.FromStatus.Valid
+		}
+
+		if known {
+			durations[status] += transition.CreatedAt.Sub(cursor)
+		}
+
+		cursor = transition.CreatedAt
+		status, known = transition.ToStatus, true
+		count++
+	}
+
+	if !known {
+		status = current
+	}
+	if status != "" {
+		durations[status] += to.Sub(cursor)
+	}
+
+	return durations, count
+}
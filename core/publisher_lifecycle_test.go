@@ -0,0 +1,91 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v8"
+)
+
+func Test_timeInState(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 10)
+	day := 24 * time.Hour
+
+	transition := func(fromStatus, toStatus string, at time.Time) *models.PublisherStatusTransition {
+		return &models.PublisherStatusTransition{
+			FromStatus: null.NewString(fromStatus, fromStatus != ""),
+			ToStatus:   toStatus,
+			CreatedAt:  at,
+		}
+	}
+
+	tests := []struct {
+		name          string
+		transitions   []*models.PublisherStatusTransition
+		current       string
+		wantDurations map[string]time.Duration
+		wantCount     int
+	}{
+		{
+			name:          "noTransitions",
+			current:       dto.PublisherStatusActive,
+			wantDurations: map[string]time.Duration{dto.PublisherStatusActive: 10 * day},
+		},
+		{
+			name: "statusBeforePeriod",
+			transitions: []*models.PublisherStatusTransition{
+				transition(dto.PublisherStatusOnboarding, dto.PublisherStatusActive, from.AddDate(0, -1, 0)),
+				transition(dto.PublisherStatusActive, dto.PublisherStatusPaused, from.Add(4*day)),
+			},
+			current: dto.PublisherStatusPaused,
+			wantDurations: map[string]time.Duration{
+				dto.PublisherStatusActive: 4 * day,
+				dto.PublisherStatusPaused: 6 * day,
+			},
+			wantCount: 1,
+		},
+		{
+			name: "statusFromFirstTransition",
+			transitions: []*models.PublisherStatusTransition{
+				transition(dto.PublisherStatusActive, dto.PublisherStatusPaused, from.Add(2*day)),
+				transition(dto.PublisherStatusPaused, dto.PublisherStatusActive, from.Add(5*day)),
+			},
+			current: dto.PublisherStatusActive,
+			wantDurations: map[string]time.Duration{
+				dto.PublisherStatusActive: 7 * day,
+				dto.PublisherStatusPaused: 3 * day,
+			},
+			wantCount: 2,
+		},
+		{
+			name: "createdInsidePeriod",
+			transitions: []*models.PublisherStatusTransition{
+				transition("", dto.PublisherStatusOnboarding, from.Add(day)),
+				transition(dto.PublisherStatusOnboarding, dto.PublisherStatusActive, from.Add(3*day)),
+			},
+			current: dto.PublisherStatusActive,
+			wantDurations: map[string]time.Duration{
+				dto.PublisherStatusOnboarding: 2 * day,
+				dto.PublisherStatusActive:     7 * day,
+			},
+			wantCount: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			durations, count := timeInState(tt.transitions, tt.current, from, to)
+			assert.Equal(t, tt.wantDurations, durations)
+			assert.Equal(t, tt.wantCount, count)
+		})
+	}
+}
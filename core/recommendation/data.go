@@ -0,0 +1,322 @@
+package recommendation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/models"
+	"github.com/m6yf/bcwork/utils/constant"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	publisherIDColumn = "publisher_id"
+	domainColumn      = "domain"
+	countryColumn     = "country"
+	deviceTypeColumn  = "device_type"
+
+	segmentColumns = "publisher_id, domain, country, device_type"
+	segmentGroupBy = "1, 2, 3, 4"
+)
+
+type segmentKey struct {
+	Publisher string
+	Domain    string
+	Country   string
+	Device    string
+}
+
+// segmentRecord holds segment columns of aggregation queries
+type segmentRecord struct {
+	PublisherID string `boil:"publisher_id"`
+	Domain      string `boil:"domain"`
+	Country     string `boil:"country"`
+	DeviceType  string `boil:"device_type"`
+}
+
+func (r segmentRecord) key() segmentKey {
+	return segmentKey{Publisher: r.PublisherID, Domain: r.Domain, Country: r.Country, Device: r.DeviceType}
+}
+
+type segment struct {
+	segmentKey
+	perf           performance
+	bids           []bidPoint
+	demandPartners map[string]struct{}
+}
+
+// rule is a floor or factor rule reduced to the dimensions of segment, empty value matches any
+type rule struct {
+	domain  string
+	country string
+	device  string
+	value   float64
+}
+
+type recommendationData struct {
+	segments       []*segment
+	floors         map[string][]rule
+	factors        map[string][]rule
+	dataFeeRates   map[string]float64
+	fees           fees
+	consultantFees map[string]float64
+}
+
+type performanceRecord struct {
+	segmentRecord    `boil:",bind"`
+	SoldImpressions  float64 `boil:"sold_impressions"`
+	Revenue          float64 `boil:"revenue"`
+	Cost             float64 `boil:"cost"`
+	DemandPartnerFee float64 `boil:"demand_partner_fee"`
+}
+
+type bidRequestsRecord struct {
+	segmentRecord `boil:",bind"`
+	BidRequests   float64 `boil:"bid_requests"`
+}
+
+type bidRecord struct {
+	segmentRecord   `boil:",bind"`
+	DemandPartnerID string  `boil:"demand_partner_id"`
+	Price           float64 `boil:"price"`
+	BidResponses    float64 `boil:"bid_responses"`
+}
+
+type dataFeeRecord struct {
+	Publisher string  `boil:"publisher_id"`
+	Domain    string  `boil:"domain"`
+	Revenue   float64 `boil:"revenue"`
+	DataFee   float64 `boil:"data_fee"`
+}
+
+// fetchData loads performance (impression_log_hourly), bid requests (publisher_hourly), bid landscape (nb_demand_hourly),
+// data fees (demand_hourly), current floors, factors and global fees
+func fetchData(ctx context.Context, filter *RecommendationFilter, from time.Time) (*recommendationData, error) {
+	segments := make(map[segmentKey]*segment)
+	getSegment := func(key segmentKey) *segment {
+		s, ok := segments[key]
+		if !ok {
+			s = &segment{segmentKey: key, demandPartners: make(map[string]struct{})}
+			segments[key] = s
+		}
+		return s
+	}
+
+	var performanceRecords []*performanceRecord
+	err := models.NewQuery(append(filter.queryMod(true),
+		qm.Select(segmentColumns+", sum(sold_impressions) sold_impressions, sum(revenue) revenue, sum(cost) cost, sum(demand_partner_fees) demand_partner_fee"),
+		qm.From(models.TableNames.ImpressionLogHourly),
+		qm.Where("time >= ?", from),
+		qm.GroupBy(segmentGroupBy),
+	)...).Bind(ctx, bcdb.DB(), &performanceRecords)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get segments performance: %w", err)
+	}
+
+	for _, record := range performanceRecords {
+		if !isRuleSegment(record.key()) {
+			continue
+		}
+
+		s := getSegment(record.key())
+		s.perf.SoldImpressions = record.SoldImpressions
+		s.perf.Revenue = record.Revenue
+		s.perf.Cost = record.Cost
+		s.perf.DemandPartnerFee = record.DemandPartnerFee
+	}
+
+	var bidRequestsRecords []*bidRequestsRecord
+	err = models.NewQuery(append(filter.queryMod(true),
+		qm.Select(segmentColumns+", sum(bid_requests) bid_requests"),
+		qm.From(models.TableNames.PublisherHourly),
+		qm.Where("time >= ?", from),
+		qm.GroupBy(segmentGroupBy),
+	)...).Bind(ctx, bcdb.DB(), &bidRequestsRecords)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get segments bid requests: %w", err)
+	}
+
+	for _, record := range bidRequestsRecords {
+		if s, ok := segments[record.key()]; ok {
+			s.perf.BidRequests = record.BidRequests
+		}
+	}
+
+	// bid prices are rounded to cents to keep the landscape compact
+	var bidRecords []*bidRecord
+	err = models.NewQuery(append(filter.queryMod(true),
+		qm.Select(segmentColumns+", demand_partner_id, round(avg_bid_price::numeric, 2)::float8 price, sum(bid_responses) bid_responses"),
+		qm.From(models.TableNames.NBDemandHourly),
+		qm.Where("time >= ? and bid_responses > 0", from),
+		qm.GroupBy(segmentGroupBy+", 5, 6"),
+	)...).Bind(ctx, bcdb.DB(), &bidRecords)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get segments bid landscape: %w", err)
+	}
+
+	for _, record := range bidRecords {
+		if s, ok := segments[record.key()]; ok {
+			s.bids = append(s.bids, bidPoint{price: record.Price, weight: record.BidResponses})
+			s.demandPartners[record.DemandPartnerID] = struct{}{}
+		}
+	}
+
+	var dataFeeRecords []*dataFeeRecord
+	err = models.NewQuery(append(filter.queryMod(false),
+		qm.Select("publisher_id, domain, sum(revenue) revenue, sum(data_fee) data_fee"),
+		qm.From(models.TableNames.DemandHourly),
+		qm.Where("time >= ?", from),
+		qm.GroupBy("1, 2"),
+	)...).Bind(ctx, bcdb.DB(), &dataFeeRecords)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get data fees: %w", err)
+	}
+
+	data := &recommendationData{
+		segments:       make([]*segment, 0, len(segments)),
+		dataFeeRates:   make(map[string]float64, len(dataFeeRecords)),
+		consultantFees: make(map[string]float64),
+	}
+	for _, record := range dataFeeRecords {
+		if record.Revenue > 0 {
+			data.dataFeeRates[record.Publisher+":"+record.Domain] = record.DataFee / record.Revenue
+		}
+	}
+
+	publishers := make([]string, 0)
+	seenPublishers := make(map[string]struct{})
+	for _, s := range segments {
+		data.segments = append(data.segments, s)
+		if _, ok := seenPublishers[s.Publisher]; !ok {
+			seenPublishers[s.Publisher] = struct{}{}
+			publishers = append(publishers, s.Publisher)
+		}
+	}
+
+	if err := data.fetchRules(ctx, publishers); err != nil {
+		return nil, err
+	}
+
+	if err := data.fetchFees(ctx); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+func (d *recommendationData) fetchRules(ctx context.Context, publishers []string) error {
+	floors, err := models.Floors(
+		models.FloorWhere.Publisher.IN(publishers),
+		models.FloorWhere.Active.EQ(true),
+	).All(ctx, bcdb.DB())
+	if err != nil {
+		return fmt.Errorf("failed to get current floors: %w", err)
+	}
+
+	d.floors = make(map[string][]rule)
+	for _, mod := range floors {
+		// rules narrowed by dimensions segment doesn't have can't be compared with it
+		if mod.DemandPartnerID != "" || mod.Os.String != "" || mod.Browser.String != "" || mod.PlacementType.String != "" {
+			continue
+		}
+		d.floors[mod.Publisher] = append(d.floors[mod.Publisher], rule{
+			domain:  mod.Domain,
+			country: mod.Country.String,
+			device:  mod.Device.String,
+			value:   mod.Floor,
+		})
+	}
+
+	factors, err := models.Factors(
+		models.FactorWhere.Publisher.IN(publishers),
+		models.FactorWhere.Active.EQ(true),
+	).All(ctx, bcdb.DB())
+	if err != nil {
+		return fmt.Errorf("failed to get current factors: %w", err)
+	}
+
+	d.factors = make(map[string][]rule)
+	for _, mod := range factors {
+		if mod.DemandPartnerID != "" || mod.Os.String != "" || mod.Browser.String != "" || mod.PlacementType.String != "" {
+			continue
+		}
+		d.factors[mod.Publisher] = append(d.factors[mod.Publisher], rule{
+			domain:  mod.Domain,
+			country: mod.Country.String,
+			device:  mod.Device.String,
+			value:   mod.Factor,
+		})
+	}
+
+	return nil
+}
+
+func (d *recommendationData) fetchFees(ctx context.Context) error {
+	mods, err := models.GlobalFactors().All(ctx, bcdb.DB())
+	if err != nil {
+		return fmt.Errorf("failed to get global fees: %w", err)
+	}
+
+	for _, mod := range mods {
+		switch mod.Key {
+		case "tam_fee":
+			d.fees.tamFee = mod.Value.Float64
+		case "tech_fee":
+			d.fees.techFee = mod.Value.Float64
+		case "consultant_fee":
+			if mod.PublisherID != "" {
+				d.consultantFees[mod.PublisherID] = mod.Value.Float64
+			}
+		}
+	}
+
+	return nil
+}
+
+// matchRule returns value of the most specific rule matching segment, domain weighs more than country and country more than device
+func matchRule(rules []rule, key segmentKey) (float64, bool) {
+	var value float64
+	bestScore := -1
+	for _, r := range rules {
+		score := 0
+		for _, dimension := range []struct {
+			ruleValue    string
+			segmentValue string
+			weight       int
+		}{
+			{ruleValue: r.domain, segmentValue: key.Domain, weight: 4},
+			{ruleValue: r.country, segmentValue: key.Country, weight: 2},
+			{ruleValue: r.device, segmentValue: key.Device, weight: 1},
+		} {
+			if isWildcard(dimension.ruleValue) {
+				continue
+			}
+			if dimension.ruleValue != dimension.segmentValue {
+				score = -1
+				break
+			}
+			score += dimension.weight
+		}
+
+		if score > bestScore {
+			bestScore = score
+			value = r.value
+		}
+	}
+
+	return value, bestScore >= 0
+}
+
+func isWildcard(value string) bool {
+	return value == "" || value == "all"
+}
+
+// isRuleSegment reports if floor and factor rules could be set for the segment
+func isRuleSegment(key segmentKey) bool {
+	_, isCountryAllowed := constant.AllowedCountries[key.Country]
+	_, isDeviceAllowed := constant.AllowedDevices[key.Device]
+
+	return key.Publisher != "" && key.Domain != "" && isCountryAllowed && isDeviceAllowed
+}
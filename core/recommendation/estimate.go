@@ -0,0 +1,278 @@
+package recommendation
+
+import (
+	"math"
+	"sort"
+
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/utils/constant"
+)
+
+const (
+	// minGPImprovement keeps current settings when the difference is a rounding noise
+	minGPImprovement = 0.01
+	// floorDecreaseVolumeGainShare is the share of volume gained by lowering the floor which is counted,
+	// as the landscape under the current floor is mostly unseen
+	floorDecreaseVolumeGainShare = 0.5
+)
+
+var (
+	// floors are proposed at quantiles of the bid landscape
+	floorCandidateQuantiles = []float64{0.05, 0.1, 0.15, 0.2, 0.25, 0.3, 0.35, 0.4, 0.45, 0.5}
+	// factors are proposed as multipliers of the current factor
+	factorCandidateMultipliers = []float64{0.5, 0.6, 0.7, 0.8, 0.9, 1.1, 1.2, 1.3, 1.4, 1.5}
+)
+
+type bidPoint struct {
+	price  float64
+	weight float64
+}
+
+// bidLandscape is the empirical distribution of bid prices weighted by the number of bid responses
+type bidLandscape struct {
+	points []bidPoint
+	total  float64
+}
+
+func newBidLandscape(points []bidPoint) *bidLandscape {
+	landscape := &bidLandscape{points: make([]bidPoint, 0, len(points))}
+	for _, point := range points {
+		if point.price <= 0 || point.weight <= 0 {
+			continue
+		}
+		landscape.points = append(landscape.points, point)
+		landscape.total += point.weight
+	}
+
+	sort.Slice(landscape.points, func(i, j int) bool {
+		return landscape.points[i].price < landscape.points[j].price
+	})
+
+	return landscape
+}
+
+// survival returns share of bids with price not lower than floor
+func (l *bidLandscape) survival(floor float64) float64 {
+	if l.total == 0 {
+		return 0
+	}
+
+	var weight float64
+	for _, point := range l.points {
+		if point.price >= floor {
+			weight += point.weight
+		}
+	}
+
+	return weight / l.total
+}
+
+// meanAbove returns weighted mean price of bids not lower than floor
+func (l *bidLandscape) meanAbove(floor float64) float64 {
+	return l.clearingPrice(floor, 1)
+}
+
+// clearingPrice returns expected price paid for impression with floor, bids below it are lost
+// and the rest pay their shaded price but not less than floor
+func (l *bidLandscape) clearingPrice(floor, shading float64) float64 {
+	var weight, sum float64
+	for _, point := range l.points {
+		if point.price >= floor {
+			weight += point.weight
+			sum += math.Max(point.price*shading, floor) * point.weight
+		}
+	}
+
+	if weight == 0 {
+		return 0
+	}
+
+	return sum / weight
+}
+
+// quantile returns the lowest price which at least q share of bids does not exceed
+func (l *bidLandscape) quantile(q float64) float64 {
+	if l.total == 0 {
+		return 0
+	}
+
+	var weight float64
+	for _, point := range l.points {
+		weight += point.weight
+		if weight/l.total >= q {
+			return point.price
+		}
+	}
+
+	return l.points[len(l.points)-1].price
+}
+
+func (l *bidLandscape) summary(demandPartners int) dto.BidLandscape {
+	return dto.BidLandscape{
+		BidResponses:   int64(l.total),
+		DemandPartners: demandPartners,
+		P25:            roundFloat(l.quantile(0.25)),
+		Median:         roundFloat(l.quantile(0.5)),
+		P75:            roundFloat(l.quantile(0.75)),
+		P90:            roundFloat(l.quantile(0.9)),
+	}
+}
+
+// performance is the observed performance of segment over the lookback period
+type performance struct {
+	BidRequests      float64
+	SoldImpressions  float64
+	Revenue          float64
+	Cost             float64
+	DemandPartnerFee float64
+	DataFee          float64
+}
+
+type fees struct {
+	tamFee        float64
+	techFee       float64
+	consultantFee float64
+}
+
+type settings struct {
+	floor  float64
+	factor float64
+}
+
+type constraints struct {
+	minFillRate     float64
+	maxFillRateDrop float64
+	elasticity      float64
+}
+
+// estimate predicts performance of segment with candidate settings from the observed one.
+// Bids below the floor are lost, so volume follows the share of bids above the floor. Impressions are paid
+// at a share of the bid (calibrated as observed eCPM to mean bid) but not less than the floor, so the floor
+// lifts the price of bids close to it. Bids under the current floor are rarely seen, so lowering it is estimated
+// conservatively: only a share of the volume gain is counted while the price drop is counted in full. Factor is the share of revenue paid to the publisher: cost per impression is proportional to it
+// and volume changes with elasticity (sold impressions ~ factor^elasticity) as publisher side competitiveness changes.
+func estimate(perf performance, landscape *bidLandscape, fees fees, current, candidate settings, elasticity float64) dto.RecommendationOutcome {
+	volumeRatio, priceRatio, factorRatio := 1.0, 1.0, 1.0
+	if candidate.floor != current.floor {
+		shading := clearingShading(perf, landscape, current.floor)
+		currentSurvival := landscape.survival(current.floor)
+		currentPrice := landscape.clearingPrice(current.floor, shading)
+		if currentSurvival > 0 && currentPrice > 0 {
+			volumeRatio = landscape.survival(candidate.floor) / currentSurvival
+			priceRatio = landscape.clearingPrice(candidate.floor, shading) / currentPrice
+			if candidate.floor < current.floor && volumeRatio > 1 {
+				volumeRatio = 1 + (volumeRatio-1)*floorDecreaseVolumeGainShare
+			}
+		}
+	}
+
+	if current.factor > 0 && candidate.factor > 0 {
+		factorRatio = candidate.factor / current.factor
+		volumeRatio *= math.Pow(factorRatio, elasticity)
+	}
+
+	revenueRatio := volumeRatio * priceRatio
+	outcome := dto.RecommendationOutcome{
+		SoldImpressions: perf.SoldImpressions * volumeRatio,
+		Revenue:         perf.Revenue * revenueRatio,
+		Cost:            perf.Cost * revenueRatio * factorRatio,
+	}
+
+	outcome.GP = outcome.Revenue - outcome.Cost -
+		perf.DemandPartnerFee*revenueRatio - perf.DataFee*revenueRatio -
+		fees.tamFee*outcome.Cost - fees.techFee*perf.BidRequests/1000000 - fees.consultantFee*outcome.Cost
+	if outcome.Revenue != 0 {
+		outcome.GPP = outcome.GP / outcome.Revenue
+	}
+	if perf.BidRequests != 0 {
+		outcome.FillRate = outcome.SoldImpressions / perf.BidRequests
+	}
+
+	return outcome
+}
+
+// clearingShading returns observed eCPM to mean bid ratio, impressions are never paid more than bid
+func clearingShading(perf performance, landscape *bidLandscape, floor float64) float64 {
+	meanBid := landscape.meanAbove(floor)
+	if perf.SoldImpressions == 0 || meanBid == 0 {
+		return 1
+	}
+
+	return math.Min(1, perf.Revenue/perf.SoldImpressions*1000/meanBid)
+}
+
+// optimize returns settings with the highest expected GP which keep fill rate within constraints,
+// current settings are returned if no candidate improves GP
+func optimize(perf performance, landscape *bidLandscape, fees fees, current settings, hasFactor bool, limits constraints) (settings, dto.RecommendationOutcome, dto.RecommendationOutcome) {
+	baseline := estimate(perf, landscape, fees, current, current, limits.elasticity)
+
+	// fill rate may drop by the allowed share but not below the minimum,
+	// segments already below the minimum must not lose fill rate at all
+	minFillRate := baseline.FillRate * (1 - limits.maxFillRateDrop)
+	if limits.minFillRate > minFillRate {
+		minFillRate = math.Min(limits.minFillRate, baseline.FillRate)
+	}
+
+	factors := []float64{current.factor}
+	if hasFactor {
+		factors = factorCandidates(current.factor)
+	}
+
+	best, bestOutcome := current, baseline
+	for _, floor := range floorCandidates(landscape, current.floor) {
+		for _, factor := range factors {
+			candidate := settings{floor: floor, factor: factor}
+			outcome := estimate(perf, landscape, fees, current, candidate, limits.elasticity)
+			if outcome.FillRate < minFillRate {
+				continue
+			}
+
+			if outcome.GP > bestOutcome.GP+minGPImprovement {
+				best, bestOutcome = candidate, outcome
+			}
+		}
+	}
+
+	return best, baseline, bestOutcome
+}
+
+func floorCandidates(landscape *bidLandscape, current float64) []float64 {
+	candidates := []float64{current, 0}
+	for _, q := range floorCandidateQuantiles {
+		candidates = append(candidates, roundFloat(landscape.quantile(q)))
+	}
+
+	return uniqueSorted(candidates)
+}
+
+func factorCandidates(current float64) []float64 {
+	candidates := []float64{current}
+	for _, multiplier := range factorCandidateMultipliers {
+		factor := roundFloat(current * multiplier)
+		factor = math.Max(constant.MinFactorValue, math.Min(constant.MaxFactorValue, factor))
+		candidates = append(candidates, factor)
+	}
+
+	return uniqueSorted(candidates)
+}
+
+func uniqueSorted(values []float64) []float64 {
+	sort.Float64s(values)
+
+	result := make([]float64, 0, len(values))
+	for i, value := range values {
+		if i == 0 || value != values[i-1] {
+			result = append(result, value)
+		}
+	}
+
+	return result
+}
+
+func roundFloat(value float64) float64 {
+	return math.Round(value*100) / 100
+}
+
+func roundRate(value float64) float64 {
+	return math.Round(value*10000) / 10000
+}
@@ -0,0 +1,238 @@
+package recommendation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testLandscape() *bidLandscape {
+	return newBidLandscape([]bidPoint{
+		{price: 5, weight: 25},
+		{price: 0.5, weight: 40},
+		{price: 2, weight: 35},
+		{price: 0, weight: 100},
+		{price: 3, weight: 0},
+	})
+}
+
+func Test_bidLandscape(t *testing.T) {
+	t.Parallel()
+
+	landscape := testLandscape()
+
+	assert.Equal(t, float64(100), landscape.total)
+	assert.Equal(t, 1.0, landscape.survival(0.5))
+	assert.Equal(t, 0.6, landscape.survival(1))
+	assert.Equal(t, float64(0), landscape.survival(6))
+	assert.InDelta(t, 2.15, landscape.meanAbove(0), 1e-9)
+	assert.InDelta(t, 3.25, landscape.meanAbove(2), 1e-9)
+	assert.InDelta(t, (35*2+25*2.5)/60.0, landscape.clearingPrice(2, 0.5), 1e-9)
+	assert.Equal(t, 0.5, landscape.quantile(0.4))
+	assert.Equal(t, 2.0, landscape.quantile(0.5))
+	assert.Equal(t, 5.0, landscape.quantile(1))
+	assert.Equal(t, float64(0), newBidLandscape(nil).quantile(0.5))
+}
+
+func Test_estimate(t *testing.T) {
+	t.Parallel()
+
+	// eCPM is half of mean bid
+	perf := performance{BidRequests: 100000, SoldImpressions: 10000, Revenue: 10.75, Cost: 7.5}
+	current := settings{floor: 0, factor: 0.7}
+
+	tests := []struct {
+		name      string
+		candidate settings
+		want      func(t *testing.T, got, baseline float64, fillRate, baselineFillRate float64)
+	}{
+		{
+			name:      "currentSettingsGiveObservedPerformance",
+			candidate: current,
+			want: func(t *testing.T, got, baseline float64, fillRate, baselineFillRate float64) {
+				assert.InDelta(t, 3.25, got, 1e-9)
+				assert.InDelta(t, 0.1, fillRate, 1e-9)
+			},
+		},
+		{
+			name:      "floorBelowAllBidsLiftsPriceWithoutVolumeLoss",
+			candidate: settings{floor: 0.5, factor: 0.7},
+			want: func(t *testing.T, got, baseline float64, fillRate, baselineFillRate float64) {
+				assert.Greater(t, got, baseline)
+				assert.Equal(t, baselineFillRate, fillRate)
+			},
+		},
+		{
+			name:      "highFloorLosesVolume",
+			candidate: settings{floor: 2, factor: 0.7},
+			want: func(t *testing.T, got, baseline float64, fillRate, baselineFillRate float64) {
+				assert.InDelta(t, baselineFillRate*0.6, fillRate, 1e-9)
+			},
+		},
+		{
+			name:      "lowerFactorReducesCostAndVolume",
+			candidate: settings{floor: 0, factor: 0.63},
+			want: func(t *testing.T, got, baseline float64, fillRate, baselineFillRate float64) {
+				assert.Greater(t, got, baseline)
+				assert.Less(t, fillRate, baselineFillRate)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			landscape := testLandscape()
+			baseline := estimate(perf, landscape, fees{}, current, current, 0.5)
+			got := estimate(perf, landscape, fees{}, current, tt.candidate, 0.5)
+			tt.want(t, got.GP, baseline.GP, got.FillRate, baseline.FillRate)
+		})
+	}
+}
+
+func Test_estimateLoweringFloor(t *testing.T) {
+	t.Parallel()
+
+	perf := performance{BidRequests: 100000, SoldImpressions: 6000, Revenue: 10, Cost: 7}
+	current := settings{floor: 2, factor: 0.7}
+	candidate := settings{floor: 0.5, factor: 0.7}
+	landscape := testLandscape()
+
+	baseline := estimate(perf, landscape, fees{}, current, current, 0.5)
+	got := estimate(perf, landscape, fees{}, current, candidate, 0.5)
+
+	// all bids survive the lower floor against 60% of them now, only half of the gain is counted
+	fullVolumeRatio := landscape.survival(candidate.floor) / landscape.survival(current.floor)
+	assert.InDelta(t, baseline.SoldImpressions*(1+(fullVolumeRatio-1)*floorDecreaseVolumeGainShare), got.SoldImpressions, 1e-9)
+	assert.Less(t, got.SoldImpressions, baseline.SoldImpressions*fullVolumeRatio)
+}
+
+func Test_optimize(t *testing.T) {
+	t.Parallel()
+
+	perf := performance{BidRequests: 100000, SoldImpressions: 10000, Revenue: 10.75, Cost: 7.5}
+
+	type want struct {
+		settings settings
+		changed  bool
+	}
+
+	tests := []struct {
+		name      string
+		current   settings
+		hasFactor bool
+		limits    constraints
+		fees      fees
+		want      want
+	}{
+		{
+			name:      "fillRateDropLimitsFloorAndFactor",
+			current:   settings{floor: 0, factor: 0.7},
+			hasFactor: true,
+			limits:    constraints{maxFillRateDrop: 0.1, elasticity: 0.5},
+			want:      want{settings: settings{floor: 0.5, factor: 0.63}, changed: true},
+		},
+		{
+			name:      "looseConstraintsAllowHighFloorAndLowFactor",
+			current:   settings{floor: 0, factor: 0.7},
+			hasFactor: true,
+			limits:    constraints{maxFillRateDrop: 1, elasticity: 0.5},
+			want:      want{settings: settings{floor: 2, factor: 0.35}, changed: true},
+		},
+		{
+			name:      "withoutFactorRuleOnlyFloorChanges",
+			current:   settings{floor: 0},
+			hasFactor: false,
+			limits:    constraints{maxFillRateDrop: 0.1, elasticity: 0.5},
+			want:      want{settings: settings{floor: 0.5}, changed: true},
+		},
+		{
+			name:      "minFillRateKeepsFillRateOfSegmentBelowIt",
+			current:   settings{floor: 0.5, factor: 0.7},
+			hasFactor: true,
+			limits:    constraints{minFillRate: 0.2, maxFillRateDrop: 0.5, elasticity: 0.5},
+			want:      want{settings: settings{floor: 0.5, factor: 0.7}, changed: false},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			best, baseline, expected := optimize(perf, testLandscape(), tt.fees, tt.current, tt.hasFactor, tt.limits)
+			assert.Equal(t, tt.want.settings, best)
+			assert.Equal(t, tt.want.changed, best != tt.current)
+			assert.GreaterOrEqual(t, expected.GP, baseline.GP)
+		})
+	}
+}
+
+func Test_matchRule(t *testing.T) {
+	t.Parallel()
+
+	rules := []rule{
+		{value: 0.5},
+		{domain: "example.com", value: 0.6},
+		{domain: "example.com", country: "us", value: 0.7},
+		{domain: "example.com", device: "mobile", value: 0.8},
+		{domain: "example.com", country: "us", device: "mobile", value: 0.9},
+		{domain: "other.com", country: "us", device: "mobile", value: 1},
+	}
+
+	tests := []struct {
+		name      string
+		rules     []rule
+		key       segmentKey
+		want      float64
+		wantFound bool
+	}{
+		{
+			name:      "exactRule",
+			rules:     rules,
+			key:       segmentKey{Domain: "example.com", Country: "us", Device: "mobile"},
+			want:      0.9,
+			wantFound: true,
+		},
+		{
+			name:      "countryWeighsMoreThanDevice",
+			rules:     rules[:4],
+			key:       segmentKey{Domain: "example.com", Country: "us", Device: "mobile"},
+			want:      0.7,
+			wantFound: true,
+		},
+		{
+			name:      "domainRule",
+			rules:     rules,
+			key:       segmentKey{Domain: "example.com", Country: "il", Device: "desktop"},
+			want:      0.6,
+			wantFound: true,
+		},
+		{
+			name:      "publisherRule",
+			rules:     rules,
+			key:       segmentKey{Domain: "unknown.com", Country: "us", Device: "mobile"},
+			want:      0.5,
+			wantFound: true,
+		},
+		{
+			name:      "noMatch",
+			rules:     rules[5:],
+			key:       segmentKey{Domain: "example.com", Country: "us", Device: "mobile"},
+			wantFound: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, found := matchRule(tt.rules, tt.key)
+			assert.Equal(t, tt.wantFound, found)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
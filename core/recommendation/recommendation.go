@@ -0,0 +1,251 @@
+package recommendation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/m6yf/bcwork/bcdb/filter"
+	"github.com/m6yf/bcwork/bcdb/qmods"
+	"github.com/m6yf/bcwork/core/approval"
+	"github.com/m6yf/bcwork/core/bulk"
+	"github.com/m6yf/bcwork/dto"
+)
+
+type RecommendationService struct {
+	bulkService           bulk.Bulker
+	changeApprovalService *approval.ChangeApprovalService
+}
+
+func NewRecommendationService(bulkService bulk.Bulker, changeApprovalService *approval.ChangeApprovalService) *RecommendationService {
+	return &RecommendationService{
+		bulkService:           bulkService,
+		changeApprovalService: changeApprovalService,
+	}
+}
+
+type GetRecommendationOptions struct {
+	Filter RecommendationFilter `json:"filter"`
+	// LookbackDays is the period of historical performance, 7 days by default
+	LookbackDays int `json:"lookback_days" validate:"omitempty,min=1,max=90"`
+	// MinImpressions is the sold impressions segment needs in the period to get a recommendation, 1000 by default
+	MinImpressions int64 `json:"min_impressions" validate:"omitempty,min=1"`
+	// MinFillRate is the lowest fill rate (sold impressions / bid requests) recommendation may lead to
+	MinFillRate float64 `json:"min_fill_rate" validate:"omitempty,min=0,max=1"`
+	// MaxFillRateDrop is the largest relative fill rate decrease recommendation may lead to, 0.1 by default
+	MaxFillRateDrop *float64 `json:"max_fill_rate_drop" validate:"omitempty,min=0,max=1"`
+	// Elasticity is how sold impressions react to the factor change (sold impressions ~ factor^elasticity), 0.5 by default
+	Elasticity *float64 `json:"elasticity" validate:"omitempty,min=0,max=3"`
+	// OnlyChanged omits segments which current settings are already the best
+	OnlyChanged bool `json:"only_changed"`
+}
+
+type RecommendationFilter struct {
+	Publisher filter.StringArrayFilter `json:"publisher,omitempty"`
+	Domain    filter.StringArrayFilter `json:"domain,omitempty"`
+	Country   filter.StringArrayFilter `json:"country,omitempty"`
+	Device    filter.StringArrayFilter `json:"device,omitempty"`
+}
+
+// GetRecommendations estimates bid landscape of every publisher, domain, country and device segment
+// and proposes floor and factor maximizing expected GP within fill rate constraints.
+// Recommendations are sorted by expected GP increase.
+func (r *RecommendationService) GetRecommendations(ctx context.Context, ops *GetRecommendationOptions) ([]*dto.Recommendation, error) {
+	lookbackDays := ops.LookbackDays
+	if lookbackDays == 0 {
+		lookbackDays = dto.DefaultRecommendationLookbackDays
+	}
+
+	limits := constraints{
+		minFillRate:     ops.MinFillRate,
+		maxFillRateDrop: dto.DefaultRecommendationMaxFillRateDrop,
+		elasticity:      dto.DefaultRecommendationElasticity,
+	}
+	if ops.MaxFillRateDrop != nil {
+		limits.maxFillRateDrop = *ops.MaxFillRateDrop
+	}
+	if ops.Elasticity != nil {
+		limits.elasticity = *ops.Elasticity
+	}
+
+	minImpressions := ops.MinImpressions
+	if minImpressions == 0 {
+		minImpressions = dto.DefaultRecommendationMinImpressions
+	}
+
+	from := time.Now().UTC().Truncate(time.Hour).AddDate(0, 0, -lookbackDays)
+	data, err := fetchData(ctx, &ops.Filter, from)
+	if err != nil {
+		return nil, err
+	}
+
+	recommendations := make([]*dto.Recommendation, 0, len(data.segments))
+	for _, s := range data.segments {
+		if s.perf.SoldImpressions < float64(minImpressions) || s.perf.BidRequests == 0 {
+			continue
+		}
+
+		landscape := newBidLandscape(s.bids)
+		if landscape.total == 0 {
+			continue
+		}
+
+		recommendation := data.recommend(s, landscape, limits)
+		if ops.OnlyChanged && !recommendation.Changed {
+			continue
+		}
+
+		recommendations = append(recommendations, recommendation)
+	}
+
+	sort.SliceStable(recommendations, func(i, j int) bool {
+		return recommendations[i].Impact.GP > recommendations[j].Impact.GP
+	})
+
+	return recommendations, nil
+}
+
+func (d *recommendationData) recommend(s *segment, landscape *bidLandscape, limits constraints) *dto.Recommendation {
+	current := settings{}
+	currentFloor, _ := matchRule(d.floors[s.Publisher], s.segmentKey)
+	current.floor = currentFloor
+	currentFactor, hasFactor := matchRule(d.factors[s.Publisher], s.segmentKey)
+	current.factor = currentFactor
+
+	fees := d.fees
+	fees.consultantFee = d.consultantFees[s.Publisher]
+
+	perf := s.perf
+	perf.DataFee = perf.Revenue * d.dataFeeRates[s.Publisher+":"+s.Domain]
+
+	best, baseline, expected := optimize(perf, landscape, fees, current, hasFactor, limits)
+
+	recommendation := &dto.Recommendation{
+		Publisher:        s.Publisher,
+		Domain:           s.Domain,
+		Country:          s.Country,
+		Device:           s.Device,
+		CurrentFloor:     current.floor,
+		RecommendedFloor: best.floor,
+		Landscape:        landscape.summary(len(s.demandPartners)),
+		Current:          roundOutcome(baseline),
+		Expected:         roundOutcome(expected),
+		Changed:          best != current,
+		Impact: dto.RecommendationImpact{
+			GP:              roundFloat(expected.GP - baseline.GP),
+			Revenue:         roundFloat(expected.Revenue - baseline.Revenue),
+			SoldImpressions: roundFloat(expected.SoldImpressions - baseline.SoldImpressions),
+			FillRate:        roundRate(expected.FillRate - baseline.FillRate),
+		},
+	}
+
+	if hasFactor {
+		recommendation.CurrentFactor = &current.factor
+		recommendation.RecommendedFactor = &best.factor
+	} else {
+		recommendation.Reason = "no factor rule matches the segment, only floor is recommended"
+	}
+
+	if !recommendation.Changed {
+		recommendation.Reason = "current settings are expected to give the highest GP within fill rate constraints"
+	}
+
+	return recommendation
+}
+
+// ApplyRecommendations updates floors and factors of the items in one transaction.
+// Factor changes covered by approval policy hold the whole set of items, then the created change request is returned.
+func (r *RecommendationService) ApplyRecommendations(ctx context.Context, request *dto.RecommendationApplyRequest) (*dto.ChangeRequest, error) {
+	update := &bulk.FactorFloorUpdateRequest{
+		Factors: make([]bulk.FactorUpdateRequest, 0, len(request.Items)),
+		Floors:  make([]dto.FloorUpdateRequest, 0, len(request.Items)),
+	}
+	for _, item := range request.Items {
+		if item.Factor != nil {
+			update.Factors = append(update.Factors, bulk.FactorUpdateRequest{
+				Publisher: item.Publisher,
+				Domain:    item.Domain,
+				Country:   item.Country,
+				Device:    item.Device,
+				Factor:    *item.Factor,
+			})
+		}
+
+		if item.Floor != nil {
+			update.Floors = append(update.Floors, dto.FloorUpdateRequest{
+				Publisher: item.Publisher,
+				Domain:    item.Domain,
+				Country:   item.Country,
+				Device:    item.Device,
+				Floor:     *item.Floor,
+				Active:    true,
+			})
+		}
+	}
+
+	if len(update.Factors) > 0 {
+		payload, err := json.Marshal(update)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal recommended factors and floors: %w", err)
+		}
+
+		changeRequest, err := r.changeApprovalService.SubmitIfRequired(ctx, approval.RecommendationApplyRoute, payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check if recommended factors require approval: %w", err)
+		}
+
+		if changeRequest != nil {
+			return changeRequest, nil
+		}
+	}
+
+	if err := r.bulkService.BulkInsertFactorsAndFloors(ctx, update); err != nil {
+		return nil, fmt.Errorf("failed to apply recommended factors and floors: %w", err)
+	}
+
+	return nil, nil
+}
+
+func roundOutcome(outcome dto.RecommendationOutcome) dto.RecommendationOutcome {
+	return dto.RecommendationOutcome{
+		SoldImpressions: roundFloat(outcome.SoldImpressions),
+		FillRate:        roundRate(outcome.FillRate),
+		Revenue:         roundFloat(outcome.Revenue),
+		Cost:            roundFloat(outcome.Cost),
+		GP:              roundFloat(outcome.GP),
+		GPP:             roundRate(outcome.GPP),
+	}
+}
+
+// queryMod returns filter mods for table with publisher_id and domain columns,
+// country and device are applied only to tables which have them
+func (filter *RecommendationFilter) queryMod(withCountryAndDevice bool) qmods.QueryModsSlice {
+	mods := make(qmods.QueryModsSlice, 0)
+	if filter == nil {
+		return mods
+	}
+
+	if len(filter.Publisher) > 0 {
+		mods = append(mods, filter.Publisher.AndIn(publisherIDColumn))
+	}
+
+	if len(filter.Domain) > 0 {
+		mods = append(mods, filter.Domain.AndIn(domainColumn))
+	}
+
+	if !withCountryAndDevice {
+		return mods
+	}
+
+	if len(filter.Country) > 0 {
+		mods = append(mods, filter.Country.AndIn(countryColumn))
+	}
+
+	if len(filter.Device) > 0 {
+		mods = append(mods, filter.Device.AndIn(deviceTypeColumn))
+	}
+
+	return mods
+}
@@ -0,0 +1,55 @@
+package monthly
+
+import (
+	"context"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// Statement is a monthly summary of publisher earnings, revenue is the amount payable to the publisher
+type Statement struct {
+	Month       string  `boil:"month" json:"month"`
+	Impressions int64   `boil:"impressions" json:"impressions"`
+	Revenue     float64 `boil:"revenue" json:"revenue"`
+	RPM         float64 `boil:"-" json:"rpm"`
+}
+
+// PublisherStatements returns statements of the publisher between months in YYYYMM format, both inclusive
+func PublisherStatements(ctx context.Context, publisherID string, fromMonth string, toMonth string) ([]*Statement, error) {
+	sql := `select to_char(date_trunc('month', time), 'YYYYMM') as month, sum(publisher_impressions) impressions, sum(supply_total) revenue
+		from publisher_daily where publisher_id=$1 and time>=$2 and time<$3 group by 1 order by 1 asc`
+
+	from, err := time.Parse("20060102", fromMonth+"01")
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse 'from' month")
+	}
+
+	to, err := time.Parse("20060102", toMonth+"01")
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse 'to' month")
+	}
+	to = to.AddDate(0, 1, 0)
+
+	statements := make([]*Statement, 0)
+	err = queries.Raw(sql, publisherID, from, to).Bind(ctx, bcdb.DB(), &statements)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch statements of publisher [%v]", publisherID)
+	}
+
+	for _, statement := range statements {
+		statement.RPM = rpm(statement.Revenue, statement.Impressions)
+	}
+
+	return statements, nil
+}
+
+func rpm(revenue float64, impressions int64) float64 {
+	if impressions == 0 {
+		return 0
+	}
+
+	return revenue / float64(impressions) * 1000
+}
@@ -0,0 +1,124 @@
+package core
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/bcdb/filter"
+	"github.com/m6yf/bcwork/bcdb/order"
+	"github.com/m6yf/bcwork/bcdb/pagination"
+	"github.com/m6yf/bcwork/bcdb/qmods"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/models"
+	"github.com/rotisserie/eris"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// schainSummarySelect aggregates supply chain compliance by demand partner or seat owner
+const schainSummarySelect = `
+	coalesce(demand_partner_id, '') as demand_partner_id,
+	partner_name,
+	count(distinct publisher_id) as publishers,
+	count(*) filter (where is_compliant) as compliant,
+	count(*) filter (where dp_status = 'missing') as dp_missing,
+	count(*) filter (where dp_status = 'type_mismatch') as dp_type_mismatch,
+	count(*) filter (where dp_status = 'unavailable') as dp_unavailable,
+	count(*) filter (where our_status = 'missing') as our_missing,
+	max(checked_at) as checked_at`
+
+type SchainService struct{}
+
+func NewSchainService() *SchainService {
+	return &SchainService{}
+}
+
+type GetSchainComplianceOptions struct {
+	Filter     SchainComplianceFilter `json:"filter"`
+	Pagination *pagination.Pagination `json:"pagination"`
+	Order      order.Sort             `json:"order"`
+	Selector   string                 `json:"selector"`
+}
+
+type SchainComplianceFilter struct {
+	PublisherID     filter.StringArrayFilter `json:"publisher_id,omitempty"`
+	DemandPartnerID filter.StringArrayFilter `json:"demand_partner_id,omitempty"`
+	PartnerName     filter.StringArrayFilter `json:"partner_name,omitempty"`
+	DPStatus        filter.StringArrayFilter `json:"dp_status,omitempty"`
+	OurStatus       filter.StringArrayFilter `json:"our_status,omitempty"`
+	IsCompliant     *filter.BoolFilter       `json:"is_compliant,omitempty"`
+}
+
+// GetSchainCompliance returns supply chain compliance per publisher and demand partner line
+func (s *SchainService) GetSchainCompliance(ctx context.Context, ops *GetSchainComplianceOptions) ([]*dto.SchainCompliance, error) {
+	qmods := ops.Filter.queryMod().
+		Order(ops.Order, nil, models.SchainComplianceColumns.PublisherID+", "+models.SchainComplianceColumns.PartnerName).
+		AddArray(ops.Pagination.Do())
+
+	mods, err := models.SchainCompliances(qmods...).All(ctx, bcdb.DB())
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, eris.Wrap(err, "failed to retrieve supply chain compliance")
+	}
+
+	result := make([]*dto.SchainCompliance, 0, len(mods))
+	for _, mod := range mods {
+		compliance := &dto.SchainCompliance{}
+		compliance.FromModel(mod)
+		result = append(result, compliance)
+	}
+
+	return result, nil
+}
+
+// GetSchainComplianceSummary returns supply chain compliance per demand partner and seat owner
+func (s *SchainService) GetSchainComplianceSummary(ctx context.Context, ops *GetSchainComplianceOptions) ([]*dto.SchainComplianceSummary, error) {
+	qmods := ops.Filter.queryMod().
+		Add(
+			qm.Select(schainSummarySelect),
+			qm.GroupBy(models.SchainComplianceColumns.DemandPartnerID+", "+models.SchainComplianceColumns.PartnerName),
+		).
+		Order(ops.Order, nil, models.SchainComplianceColumns.PartnerName).
+		AddArray(ops.Pagination.Do())
+
+	summary := make([]*dto.SchainComplianceSummary, 0)
+	err := models.SchainCompliances(qmods...).Bind(ctx, bcdb.DB(), &summary)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, eris.Wrap(err, "failed to retrieve supply chain compliance summary")
+	}
+
+	return summary, nil
+}
+
+func (filter *SchainComplianceFilter) queryMod() qmods.QueryModsSlice {
+	mods := make(qmods.QueryModsSlice, 0)
+	if filter == nil {
+		return mods
+	}
+
+	if len(filter.PublisherID) > 0 {
+		mods = append(mods, filter.PublisherID.AndIn(models.SchainComplianceColumns.PublisherID))
+	}
+
+	if len(filter.DemandPartnerID) > 0 {
+		mods = append(mods, filter.DemandPartnerID.AndIn(models.SchainComplianceColumns.DemandPartnerID))
+	}
+
+	if len(filter.PartnerName) > 0 {
+		mods = append(mods, filter.PartnerName.AndIn(models.SchainComplianceColumns.PartnerName))
+	}
+
+	if len(filter.DPStatus) > 0 {
+		mods = append(mods, filter.DPStatus.AndIn(models.SchainComplianceColumns.DPStatus))
+	}
+
+	if len(filter.OurStatus) > 0 {
+		mods = append(mods, filter.OurStatus.AndIn(models.SchainComplianceColumns.OurStatus))
+	}
+
+	if filter.IsCompliant != nil {
+		mods = append(mods, filter.IsCompliant.Where(models.SchainComplianceColumns.IsCompliant))
+	}
+
+	return mods
+}
@@ -0,0 +1,318 @@
+package core
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/bcdb/filter"
+	"github.com/m6yf/bcwork/bcdb/order"
+	"github.com/m6yf/bcwork/bcdb/pagination"
+	"github.com/m6yf/bcwork/bcdb/qmods"
+	"github.com/m6yf/bcwork/config"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/models"
+	sellersjson "github.com/m6yf/bcwork/modules/sellers_json"
+	"github.com/m6yf/bcwork/utils/constant"
+	"github.com/rotisserie/eris"
+	"github.com/spf13/viper"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	sellersJSONTagIDName = "TAG-ID"
+
+	sellersJSONNoDomainReason = "publisher has no domain"
+)
+
+type SellersJSONService struct{}
+
+func NewSellersJSONService() *SellersJSONService {
+	return &SellersJSONService{}
+}
+
+type GetSellersJSONVersionOptions struct {
+	Filter     SellersJSONVersionFilter `json:"filter"`
+	Pagination *pagination.Pagination   `json:"pagination"`
+	Order      order.Sort               `json:"order"`
+	Selector   string                   `json:"selector"`
+}
+
+type SellersJSONVersionFilter struct {
+	ID          filter.IntArrayFilter `json:"id,omitempty"`
+	PublishedAt *filter.DatesFilter   `json:"published_at,omitempty"`
+}
+
+// PublishSellersJSON builds sellers.json from publishers, validates it and saves it as a new version.
+// New version is not created when the content didn't change since the latest one.
+// Publishers which can't be listed are skipped and reported in the response.
+func (s *SellersJSONService) PublishSellersJSON(ctx context.Context) (*dto.SellersJSONPublishResponse, error) {
+	file, skipped, err := buildSellersJSON(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = file.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	content, etag, err := file.Marshal()
+	if err != nil {
+		return nil, eris.Wrap(err, "failed to marshal sellers.json")
+	}
+
+	latest, err := getLatestSellersJSONVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if latest != nil && latest.Etag == etag {
+		version := &dto.SellersJSONVersion{}
+		version.FromModel(latest)
+		diff := sellersjson.Diff(file, file)
+		diff.Version = latest.ID
+
+		return &dto.SellersJSONPublishResponse{
+			Version: version,
+			Diff:    diff,
+			Skipped: skipped,
+		}, nil
+	}
+
+	var previous *sellersjson.File
+	if latest != nil {
+		previous, err = sellersjson.Parse([]byte(latest.Content))
+		if err != nil {
+			return nil, eris.Wrapf(err, "failed to parse sellers.json version [%v]", latest.ID)
+		}
+	}
+	diff := sellersjson.Diff(previous, file)
+
+	userID, isUserKnown := ctx.Value(constant.UserIDContextKey).(int)
+	now := time.Now().UTC()
+	mod := &models.SellersJSONVersion{
+		Content:     string(content),
+		Etag:        etag,
+		Sellers:     len(file.Sellers),
+		Added:       len(diff.Added),
+		Removed:     len(diff.Removed),
+		Changed:     sellersjson.ChangedSellers(diff),
+		PublishedBy: null.NewInt(userID, isUserKnown),
+		PublishedAt: now,
+		CreatedAt:   now,
+	}
+
+	err = mod.Insert(ctx, bcdb.DB(), boil.Infer())
+	if err != nil {
+		return nil, eris.Wrap(err, "failed to save sellers.json version")
+	}
+
+	version := &dto.SellersJSONVersion{}
+	version.FromModel(mod)
+	diff.Version = mod.ID
+	if latest != nil {
+		diff.PreviousVersion = latest.ID
+	}
+
+	return &dto.SellersJSONPublishResponse{
+		Version:     version,
+		IsPublished: true,
+		Diff:        diff,
+		Skipped:     skipped,
+	}, nil
+}
+
+// GetLatestSellersJSON returns the latest published sellers.json, nil when nothing was published yet
+func (s *SellersJSONService) GetLatestSellersJSON(ctx context.Context) (*models.SellersJSONVersion, error) {
+	return getLatestSellersJSONVersion(ctx)
+}
+
+func (s *SellersJSONService) GetSellersJSONVersions(ctx context.Context, ops *GetSellersJSONVersionOptions) ([]*dto.SellersJSONVersion, error) {
+	qmods := ops.Filter.queryMod().
+		Order(ops.Order, nil, models.SellersJSONVersionColumns.ID+" DESC").
+		AddArray(ops.Pagination.Do()).
+		Add(qm.Select(sellersJSONVersionColumnsWithoutContent()...))
+
+	mods, err := models.SellersJSONVersions(qmods...).All(ctx, bcdb.DB())
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, eris.Wrap(err, "failed to retrieve sellers.json versions")
+	}
+
+	versions := make([]*dto.SellersJSONVersion, 0, len(mods))
+	for _, mod := range mods {
+		version := &dto.SellersJSONVersion{}
+		version.FromModel(mod)
+		versions = append(versions, version)
+	}
+
+	return versions, nil
+}
+
+// GetSellersJSONDiff returns report of what changed in the version comparing to the previous one,
+// the latest version is used when id is not set
+func (s *SellersJSONService) GetSellersJSONDiff(ctx context.Context, id int) (*dto.SellersJSONDiff, error) {
+	mods := []qm.QueryMod{qm.OrderBy(models.SellersJSONVersionColumns.ID + " DESC")}
+	if id > 0 {
+		mods = append(mods, models.SellersJSONVersionWhere.ID.EQ(id))
+	}
+
+	mod, err := models.SellersJSONVersions(mods...).One(ctx, bcdb.DB())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("sellers.json version [%v] not found", id)
+		}
+		return nil, eris.Wrapf(err, "failed to retrieve sellers.json version [%v]", id)
+	}
+	id = mod.ID
+
+	current, err := sellersjson.Parse([]byte(mod.Content))
+	if err != nil {
+		return nil, eris.Wrapf(err, "failed to parse sellers.json version [%v]", id)
+	}
+
+	previousMod, err := models.SellersJSONVersions(
+		models.SellersJSONVersionWhere.ID.LT(id),
+		qm.OrderBy(models.SellersJSONVersionColumns.ID+" DESC"),
+	).One(ctx, bcdb.DB())
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, eris.Wrapf(err, "failed to retrieve sellers.json version previous to [%v]", id)
+	}
+
+	var previous *sellersjson.File
+	if previousMod != nil {
+		previous, err = sellersjson.Parse([]byte(previousMod.Content))
+		if err != nil {
+			return nil, eris.Wrapf(err, "failed to parse sellers.json version [%v]", previousMod.ID)
+		}
+	}
+
+	diff := sellersjson.Diff(previous, current)
+	diff.Version = mod.ID
+	if previousMod != nil {
+		diff.PreviousVersion = previousMod.ID
+	}
+
+	return diff, nil
+}
+
+// buildSellersJSON builds sellers.json of publishers, skipped publishers are returned as well
+func buildSellersJSON(ctx context.Context) (*sellersjson.File, []*dto.SellersJSONSkippedSeller, error) {
+	publishers, err := models.Publishers().All(ctx, bcdb.DB())
+	if err != nil {
+		return nil, nil, eris.Wrap(err, "failed to retrieve publishers for sellers.json")
+	}
+
+	domains, err := models.PublisherDomains(
+		qm.OrderBy(models.PublisherDomainColumns.CreatedAt+", "+models.PublisherDomainColumns.Domain),
+	).All(ctx, bcdb.DB())
+	if err != nil {
+		return nil, nil, eris.Wrap(err, "failed to retrieve publisher domains for sellers.json")
+	}
+
+	firstDomains := make(map[string]string, len(publishers))
+	for _, domain := range domains {
+		if _, ok := firstDomains[domain.PublisherID]; !ok {
+			firstDomains[domain.PublisherID] = domain.Domain
+		}
+	}
+
+	sellers, skipped := buildSellers(publishers, firstDomains)
+
+	var identifiers []*sellersjson.Identifier
+	if tagID := viper.GetString(config.SellersJSONTagIDKey); tagID != "" {
+		identifiers = append(identifiers, &sellersjson.Identifier{Name: sellersJSONTagIDName, Value: tagID})
+	}
+
+	return sellersjson.NewFile(
+		viper.GetString(config.SellersJSONContactEmailKey),
+		viper.GetString(config.SellersJSONContactAddressKey),
+		identifiers,
+		sellers,
+	), skipped, nil
+}
+
+// buildSellers builds sellers of publishers which aren't churned. Publisher without seller domain
+// is listed with its first domain, not confidential publisher without any domain is skipped.
+func buildSellers(publishers models.PublisherSlice, firstDomains map[string]string) ([]*sellersjson.Seller, []*dto.SellersJSONSkippedSeller) {
+	sellers := make([]*sellersjson.Seller, 0, len(publishers))
+	skipped := make([]*dto.SellersJSONSkippedSeller, 0)
+	for _, publisher := range publishers {
+		if dto.NormalizePublisherStatus(publisher.Status.String) == dto.PublisherStatusChurned {
+			continue
+		}
+
+		domain := publisher.SellerDomain.String
+		if domain == "" {
+			domain = firstDomains[publisher.PublisherID]
+		}
+
+		if domain == "" && !publisher.IsConfidential {
+			skipped = append(skipped, &dto.SellersJSONSkippedSeller{
+				PublisherID: publisher.PublisherID,
+				Reason:      sellersJSONNoDomainReason,
+			})
+			continue
+		}
+
+		sellers = append(sellers, sellersjson.NewSeller(sellersjson.SellerOptions{
+			PublisherID:    publisher.PublisherID,
+			Name:           publisher.Name,
+			Domain:         domain,
+			SellerType:     publisher.SellerType.String,
+			IsDirect:       publisher.IsDirect,
+			IsConfidential: publisher.IsConfidential,
+		}))
+	}
+
+	return sellers, skipped
+}
+
+func getLatestSellersJSONVersion(ctx context.Context) (*models.SellersJSONVersion, error) {
+	mod, err := models.SellersJSONVersions(
+		qm.OrderBy(models.SellersJSONVersionColumns.ID+" DESC"),
+	).One(ctx, bcdb.DB())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, eris.Wrap(err, "failed to retrieve latest sellers.json version")
+	}
+
+	return mod, nil
+}
+
+func sellersJSONVersionColumnsWithoutContent() []string {
+	return []string{
+		models.SellersJSONVersionColumns.ID,
+		models.SellersJSONVersionColumns.Etag,
+		models.SellersJSONVersionColumns.Sellers,
+		models.SellersJSONVersionColumns.Added,
+		models.SellersJSONVersionColumns.Removed,
+		models.SellersJSONVersionColumns.Changed,
+		models.SellersJSONVersionColumns.PublishedBy,
+		models.SellersJSONVersionColumns.PublishedAt,
+	}
+}
+
+func (filter *SellersJSONVersionFilter) queryMod() qmods.QueryModsSlice {
+	mods := make(qmods.QueryModsSlice, 0)
+	if filter == nil {
+		return mods
+	}
+
+	if len(filter.ID) > 0 {
+		mods = append(mods, filter.ID.AndIn(models.SellersJSONVersionColumns.ID))
+	}
+
+	if filter.PublishedAt != nil {
+		mods = append(mods, filter.PublishedAt.AndIn(models.SellersJSONVersionColumns.PublishedAt))
+	}
+
+	return mods
+}
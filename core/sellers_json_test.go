@@ -0,0 +1,35 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/models"
+	sellersjson "github.com/m6yf/bcwork/modules/sellers_json"
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v8"
+)
+
+func Test_buildSellers(t *testing.T) {
+	t.Parallel()
+
+	publishers := models.PublisherSlice{
+		{PublisherID: "1", Name: "with seller domain", SellerDomain: null.StringFrom("seller.com"), IsDirect: true, Status: null.StringFrom(dto.PublisherStatusActive)},
+		{PublisherID: "2", Name: "with first domain", Status: null.StringFrom(dto.PublisherStatusPaused)},
+		{PublisherID: "3", Name: "churned", SellerDomain: null.StringFrom("churned.com"), Status: null.StringFrom(dto.PublisherStatusChurned)},
+		{PublisherID: "4", Name: "without domain", Status: null.StringFrom(dto.PublisherStatusOnboarding)},
+		{PublisherID: "5", Name: "confidential", IsConfidential: true},
+	}
+	firstDomains := map[string]string{"2": "first.com", "3": "churned.org"}
+
+	sellers, skipped := buildSellers(publishers, firstDomains)
+
+	assert.Equal(t, []*sellersjson.Seller{
+		{SellerID: "1", SellerType: sellersjson.SellerTypePublisher, Name: "with seller domain", Domain: "seller.com"},
+		{SellerID: "2", SellerType: sellersjson.SellerTypeIntermediary, Name: "with first domain", Domain: "first.com"},
+		{SellerID: "5", SellerType: sellersjson.SellerTypeIntermediary, IsConfidential: 1},
+	}, sellers)
+	assert.Equal(t, []*dto.SellersJSONSkippedSeller{
+		{PublisherID: "4", Reason: sellersJSONNoDomainReason},
+	}, skipped)
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/rotisserie/eris"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
 type UserService struct {
@@ -393,3 +394,57 @@ func sendRegistrationEmail(email, password, signInLink, passwordChangelink strin
 		IsHTML:  true,
 	})
 }
+
+func (u *UserService) GetUserPublishers(ctx context.Context, userID int) (*dto.UserPublishers, error) {
+	mods, err := models.UserPublishers(
+		models.UserPublisherWhere.UserID.EQ(userID),
+		qm.OrderBy(models.UserPublisherColumns.PublisherID),
+	).All(ctx, bcdb.DB())
+	if err != nil {
+		return nil, eris.Wrapf(err, "failed to retrieve publishers of user [%v]", userID)
+	}
+
+	res := &dto.UserPublishers{UserID: userID, PublisherIDs: make([]string, 0, len(mods))}
+	for _, mod := range mods {
+		res.PublisherIDs = append(res.PublisherIDs, mod.PublisherID)
+	}
+
+	return res, nil
+}
+
+// SetUserPublishers replaces publishers assigned to the user
+func (u *UserService) SetUserPublishers(ctx context.Context, data *dto.UserPublishers) error {
+	tx, err := bcdb.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return eris.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	_, err = models.UserPublishers(models.UserPublisherWhere.UserID.EQ(data.UserID)).DeleteAll(ctx, tx)
+	if err != nil {
+		return eris.Wrapf(err, "failed to delete publishers of user [%v]", data.UserID)
+	}
+
+	publisherIDs := slices.Clone(data.PublisherIDs)
+	slices.Sort(publisherIDs)
+
+	now := time.Now().UTC()
+	for _, publisherID := range slices.Compact(publisherIDs) {
+		mod := &models.UserPublisher{
+			UserID:      data.UserID,
+			PublisherID: publisherID,
+			CreatedAt:   now,
+		}
+		err := mod.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return eris.Wrapf(err, "failed to assign publisher [%v] to user [%v]", publisherID, data.UserID)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return eris.Wrap(err, "failed to commit publishers of user")
+	}
+
+	return nil
+}
@@ -32,6 +32,13 @@ const (
 	AdsTxtStatusDeleted    = "deleted"
 	AdsTxtStatusNotScanned = "not_scanned"
 	AdsTxtStatusNo         = "no"
+	// ads.txt lines scan results
+	AdsTxtScanResultFound    = "found"
+	AdsTxtScanResultMissing  = "missing"
+	AdsTxtScanResultMismatch = "mismatch"
+	// ads.txt file names
+	AdsTxtFileName    = "ads.txt"
+	AppAdsTxtFileName = "app-ads.txt"
 	// ads.txt actions
 	AdsTxtActionAdd           = "add"
 	AdsTxtActionFix           = "fix"
@@ -118,6 +125,14 @@ type AdsTxtUpdateRequest struct {
 	DemandStatus    *string  `json:"demand_status,omitempty" validate:"adsTxtDemandStatus"`
 }
 
+// AdsTxtFileRequest requests recommended ads.txt or app-ads.txt of domain,
+// lines of all publishers of the domain are included when publisher is not set
+type AdsTxtFileRequest struct {
+	PublisherID string `json:"publisher_id"`
+	Domain      string `json:"domain" validate:"required"`
+	FileName    string `json:"file_name" validate:"adsTxtFileName"`
+}
+
 type AdsTxtResponse struct {
 	Data  []*AdsTxt `json:"data"`
 	Total int64     `json:"total"`
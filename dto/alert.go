@@ -0,0 +1,152 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/m6yf/bcwork/models"
+	"github.com/volatiletech/null/v8"
+)
+
+// sources of alert rule metric query
+const (
+	AlertSourcePostgres = "postgres"
+	AlertSourceQuest    = "quest"
+)
+
+// comparisons of alert rule metric, ratio comparisons are relative to values of baseline windows
+const (
+	AlertComparisonAbove      = "above"
+	AlertComparisonBelow      = "below"
+	AlertComparisonAboveRatio = "above_ratio"
+	AlertComparisonBelowRatio = "below_ratio"
+)
+
+const (
+	AlertSeverityInfo     = "info"
+	AlertSeverityWarning  = "warning"
+	AlertSeverityCritical = "critical"
+)
+
+const (
+	AlertChannelSlack = "slack"
+	AlertChannelEmail = "email"
+)
+
+const (
+	AlertEventStatusFiring   = "firing"
+	AlertEventStatusResolved = "resolved"
+)
+
+// AlertRule defines metric query evaluated on schedule. Query gets start and end of the window as $1 and $2
+// and returns "key" and "value" columns, every key which value breaches the threshold fires an alert event.
+// Query must be a single SELECT statement, postgres queries run in read only transaction.
+type AlertRule struct {
+	ID              int        `json:"id"`
+	Name            string     `json:"name" validate:"required"`
+	Description     *string    `json:"description"`
+	Source          string     `json:"source" validate:"alertSource"`
+	Query           string     `json:"query" validate:"required,alertQuery"`
+	Comparison      string     `json:"comparison" validate:"alertComparison"`
+	Threshold       float64    `json:"threshold"`
+	WindowMinutes   int        `json:"window_minutes" validate:"gt=0"`
+	BaselineOffsets []string   `json:"baseline_offsets" validate:"dive,alertOffset"`
+	Severity        string     `json:"severity" validate:"alertSeverity"`
+	Channel         string     `json:"channel" validate:"alertChannel"`
+	Recipients      []string   `json:"recipients" validate:"dive,email"`
+	Cron            string     `json:"cron" validate:"alertCron"`
+	Active          bool       `json:"active"`
+	SnoozedUntil    *time.Time `json:"snoozed_until"`
+	LastEvaluatedAt *time.Time `json:"last_evaluated_at"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       *time.Time `json:"updated_at"`
+}
+
+func (r *AlertRule) FromModel(mod *models.AlertRule) {
+	r.ID = mod.ID
+	r.Name = mod.Name
+	r.Description = mod.Description.Ptr()
+	r.Source = mod.Source
+	r.Query = mod.Query
+	r.Comparison = mod.Comparison
+	r.Threshold = mod.Threshold
+	r.WindowMinutes = mod.WindowMinutes
+	r.BaselineOffsets = getStringSliceOrEmpty(mod.BaselineOffsets)
+	r.Severity = mod.Severity
+	r.Channel = mod.Channel
+	r.Recipients = getStringSliceOrEmpty(mod.Recipients)
+	r.Cron = mod.Cron
+	r.Active = mod.Active
+	r.SnoozedUntil = mod.SnoozedUntil.Ptr()
+	r.LastEvaluatedAt = mod.LastEvaluatedAt.Ptr()
+	r.CreatedAt = mod.CreatedAt
+	r.UpdatedAt = mod.UpdatedAt.Ptr()
+}
+
+func (r *AlertRule) ToModel() *models.AlertRule {
+	return &models.AlertRule{
+		ID:              r.ID,
+		Name:            r.Name,
+		Description:     null.StringFromPtr(r.Description),
+		Source:          r.Source,
+		Query:           r.Query,
+		Comparison:      r.Comparison,
+		Threshold:       r.Threshold,
+		WindowMinutes:   r.WindowMinutes,
+		BaselineOffsets: r.BaselineOffsets,
+		Severity:        r.Severity,
+		Channel:         r.Channel,
+		Recipients:      r.Recipients,
+		Cron:            r.Cron,
+		Active:          r.Active,
+		SnoozedUntil:    null.TimeFromPtr(r.SnoozedUntil),
+	}
+}
+
+// IsRatio reports whether the rule compares the value with baseline windows
+func (r *AlertRule) IsRatio() bool {
+	return r.Comparison == AlertComparisonAboveRatio || r.Comparison == AlertComparisonBelowRatio
+}
+
+type AlertEvent struct {
+	ID             int        `json:"id"`
+	RuleID         int        `json:"rule_id"`
+	Key            string     `json:"key"`
+	Status         string     `json:"status"`
+	Severity       string     `json:"severity"`
+	Value          float64    `json:"value"`
+	Baseline       *float64   `json:"baseline"`
+	FirstSeenAt    time.Time  `json:"first_seen_at"`
+	LastSeenAt     time.Time  `json:"last_seen_at"`
+	ResolvedAt     *time.Time `json:"resolved_at"`
+	NotifiedAt     *time.Time `json:"notified_at"`
+	AcknowledgedBy *int       `json:"acknowledged_by"`
+	AcknowledgedAt *time.Time `json:"acknowledged_at"`
+	SnoozedUntil   *time.Time `json:"snoozed_until"`
+}
+
+func (e *AlertEvent) FromModel(mod *models.AlertEvent) {
+	e.ID = mod.ID
+	e.RuleID = mod.RuleID
+	e.Key = mod.Key
+	e.Status = mod.Status
+	e.Severity = mod.Severity
+	e.Value = mod.Value
+	e.Baseline = mod.Baseline.Ptr()
+	e.FirstSeenAt = mod.FirstSeenAt
+	e.LastSeenAt = mod.LastSeenAt
+	e.ResolvedAt = mod.ResolvedAt.Ptr()
+	e.NotifiedAt = mod.NotifiedAt.Ptr()
+	e.AcknowledgedBy = mod.AcknowledgedBy.Ptr()
+	e.AcknowledgedAt = mod.AcknowledgedAt.Ptr()
+	e.SnoozedUntil = mod.SnoozedUntil.Ptr()
+}
+
+type AlertEventAcknowledgeRequest struct {
+	IDs []int `json:"ids" validate:"required,min=1"`
+}
+
+// AlertEventSnoozeRequest mutes notifications of firing events until the given time
+type AlertEventSnoozeRequest struct {
+	IDs   []int     `json:"ids" validate:"required,min=1"`
+	Until time.Time `json:"until" validate:"required"`
+}
@@ -0,0 +1,51 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/m6yf/bcwork/models"
+)
+
+// APIKey is a key issued to integration or worker. It acts on behalf of its owner
+// and is limited by scopes in resource:action format, e.g. floor:write or report:*
+type APIKey struct {
+	ID         int        `json:"id"`
+	Name       string     `json:"name" validate:"required"`
+	KeyPrefix  string     `json:"key_prefix"`
+	OwnerID    int        `json:"owner_id" validate:"required"`
+	Scopes     []string   `json:"scopes" validate:"min=1,dive,permission"`
+	AllowedIPs []string   `json:"allowed_ips" validate:"dive,ipOrCidr"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	LastUsedIP *string    `json:"last_used_ip"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+	CreatedBy  int        `json:"created_by"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  *time.Time `json:"updated_at"`
+}
+
+// APIKeyCreateResponse contains the key itself, it is returned only once on creation
+type APIKeyCreateResponse struct {
+	APIKey
+	Key string `json:"key"`
+}
+
+type APIKeyRevokeRequest struct {
+	ID int `json:"id"`
+}
+
+func (k *APIKey) FromModel(mod *models.APIKey) {
+	k.ID = mod.ID
+	k.Name = mod.Name
+	k.KeyPrefix = mod.KeyPrefix
+	k.OwnerID = mod.OwnerID
+	k.Scopes = getStringSliceOrEmpty(mod.Scopes)
+	k.AllowedIPs = getStringSliceOrEmpty(mod.AllowedIps)
+	k.ExpiresAt = mod.ExpiresAt.Ptr()
+	k.LastUsedAt = mod.LastUsedAt.Ptr()
+	k.LastUsedIP = mod.LastUsedIP.Ptr()
+	k.RevokedAt = mod.RevokedAt.Ptr()
+	k.CreatedBy = mod.CreatedBy
+	k.CreatedAt = mod.CreatedAt
+	k.UpdatedAt = mod.UpdatedAt.Ptr()
+}
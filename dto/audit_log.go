@@ -0,0 +1,48 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/m6yf/bcwork/models"
+)
+
+// AuditLog is a mutating API request, sensitive values of request body are redacted
+// and the body is omitted when it's not JSON or too large
+type AuditLog struct {
+	ID          int             `json:"id"`
+	RequestID   *string         `json:"request_id"`
+	UserID      *int            `json:"user_id"`
+	Role        *string         `json:"role"`
+	APIKeyID    *int            `json:"api_key_id"`
+	Method      string          `json:"method"`
+	Route       string          `json:"route"`
+	Path        string          `json:"path"`
+	Status      int             `json:"status"`
+	LatencyMS   int             `json:"latency_ms"`
+	IP          *string         `json:"ip"`
+	RequestHash string          `json:"request_hash"`
+	RequestBody json.RawMessage `json:"request_body" swaggertype:"object"`
+	RequestSize int             `json:"request_size"`
+	CreatedAt   time.Time       `json:"created_at"`
+}
+
+func (a *AuditLog) FromModel(mod *models.AuditLog) {
+	a.ID = mod.ID
+	a.RequestID = mod.RequestID.Ptr()
+	a.UserID = mod.UserID.Ptr()
+	a.Role = mod.Role.Ptr()
+	a.APIKeyID = mod.APIKeyID.Ptr()
+	a.Method = mod.Method
+	a.Route = mod.Route
+	a.Path = mod.Path
+	a.Status = mod.Status
+	a.LatencyMS = mod.LatencyMS
+	a.IP = mod.IP.Ptr()
+	a.RequestHash = mod.RequestHash
+	if mod.RequestBody.Valid {
+		a.RequestBody = json.RawMessage(mod.RequestBody.JSON)
+	}
+	a.RequestSize = mod.RequestSize
+	a.CreatedAt = mod.CreatedAt
+}
@@ -0,0 +1,41 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/m6yf/bcwork/models"
+)
+
+// automations guarded by circuit breaker
+const (
+	FactorAutomation = "factor"
+	DPOAutomation    = "dpo"
+)
+
+type CircuitBreaker struct {
+	Automation       string     `json:"automation"`
+	Tripped          bool       `json:"tripped"`
+	Reason           *string    `json:"reason"`
+	TrippedAt        *time.Time `json:"tripped_at"`
+	LastInputRecords *int       `json:"last_input_records"`
+	LastInputVolume  *float64   `json:"last_input_volume"`
+	ResetBy          *int       `json:"reset_by"`
+	ResetAt          *time.Time `json:"reset_at"`
+	UpdatedAt        *time.Time `json:"updated_at"`
+}
+
+type CircuitBreakerResetRequest struct {
+	Automation string `json:"automation" validate:"automation"`
+}
+
+func (cb *CircuitBreaker) FromModel(mod *models.AutomationCircuitBreaker) {
+	cb.Automation = mod.Automation
+	cb.Tripped = mod.Tripped
+	cb.Reason = mod.Reason.Ptr()
+	cb.TrippedAt = mod.TrippedAt.Ptr()
+	cb.LastInputRecords = mod.LastInputRecords.Ptr()
+	cb.LastInputVolume = mod.LastInputVolume.Ptr()
+	cb.ResetBy = mod.ResetBy.Ptr()
+	cb.ResetAt = mod.ResetAt.Ptr()
+	cb.UpdatedAt = mod.UpdatedAt.Ptr()
+}
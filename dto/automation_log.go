@@ -0,0 +1,93 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/m6yf/bcwork/models"
+)
+
+type FactorLog struct {
+	Time           time.Time `json:"time"`
+	EvalTime       time.Time `json:"eval_time"`
+	Publisher      string    `json:"publisher"`
+	Domain         string    `json:"domain"`
+	Country        string    `json:"country"`
+	Device         string    `json:"device"`
+	Pubimps        int       `json:"pubimps"`
+	Soldimps       int       `json:"soldimps"`
+	Cost           float64   `json:"cost"`
+	Revenue        float64   `json:"revenue"`
+	GP             float64   `json:"gp"`
+	GPP            float64   `json:"gpp"`
+	OldFactor      float64   `json:"old_factor"`
+	NewFactor      float64   `json:"new_factor"`
+	ResponseStatus int       `json:"response_status"`
+	Source         string    `json:"source"`
+}
+
+func (f *FactorLog) FromModel(mod *models.PriceFactorLog) {
+	f.Time = mod.Time
+	f.EvalTime = mod.EvalTime
+	f.Publisher = mod.Publisher
+	f.Domain = mod.Domain
+	f.Country = mod.Country
+	f.Device = mod.Device
+	f.Pubimps = mod.Pubimps
+	f.Soldimps = mod.Soldimps
+	f.Cost = mod.Cost
+	f.Revenue = mod.Revenue
+	f.GP = mod.GP
+	f.GPP = mod.GPP
+	f.OldFactor = mod.OldFactor
+	f.NewFactor = mod.NewFactor
+	f.ResponseStatus = mod.ResponseStatus
+	f.Source = mod.Source
+}
+
+type DpoLog struct {
+	Time       time.Time `json:"time"`
+	EvalTime   time.Time `json:"eval_time"`
+	DP         string    `json:"dp"`
+	Publisher  string    `json:"publisher"`
+	Domain     string    `json:"domain"`
+	Os         string    `json:"os"`
+	Country    string    `json:"country"`
+	BidRequest int       `json:"bid_request"`
+	Revenue    float64   `json:"revenue"`
+	Erpm       float64   `json:"erpm"`
+	OldFactor  float64   `json:"old_factor"`
+	NewFactor  float64   `json:"new_factor"`
+	RespStatus int       `json:"resp_status"`
+	Reason     string    `json:"reason"`
+}
+
+func (d *DpoLog) FromModel(mod *models.DpoAutomationLog) {
+	d.Time = mod.Time
+	d.EvalTime = mod.EvalTime
+	d.DP = mod.DP
+	d.Publisher = mod.Publisher
+	d.Domain = mod.Domain
+	d.Os = mod.Os
+	d.Country = mod.Country
+	d.BidRequest = mod.BidRequest
+	d.Revenue = mod.Revenue
+	d.Erpm = mod.Erpm
+	d.OldFactor = mod.OldFactor
+	d.NewFactor = mod.NewFactor
+	d.RespStatus = mod.RespStatus
+	d.Reason = mod.Reason.String
+}
+
+// AutomationLogAggregate summarizes changes of automation rules of a domain in a day
+type AutomationLogAggregate struct {
+	Day          time.Time `boil:"day" json:"day"`
+	Publisher    string    `boil:"publisher" json:"publisher"`
+	Domain       string    `boil:"domain" json:"domain"`
+	Changes      int       `boil:"changes" json:"changes"`
+	Increases    int       `boil:"increases" json:"increases"`
+	Decreases    int       `boil:"decreases" json:"decreases"`
+	Failures     int       `boil:"failures" json:"failures"`
+	AvgOldFactor float64   `boil:"avg_old_factor" json:"avg_old_factor"`
+	AvgNewFactor float64   `boil:"avg_new_factor" json:"avg_new_factor"`
+	Revenue      float64   `boil:"revenue" json:"revenue"`
+}
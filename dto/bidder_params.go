@@ -0,0 +1,49 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/m6yf/bcwork/models"
+)
+
+// DPBidderSchema is a JSON Schema of bidder parameters of demand partner
+type DPBidderSchema struct {
+	DemandPartnerID string          `json:"demand_partner_id" validate:"required"`
+	Schema          json.RawMessage `json:"schema" validate:"required" swaggertype:"object"`
+	CreatedAt       time.Time       `json:"created_at"`
+	UpdatedAt       *time.Time      `json:"updated_at"`
+}
+
+func (s *DPBidderSchema) FromModel(mod *models.DPBidderSchema) {
+	s.DemandPartnerID = mod.DemandPartnerID
+	s.Schema = json.RawMessage(mod.Schema)
+	s.CreatedAt = mod.CreatedAt
+	s.UpdatedAt = mod.UpdatedAt.Ptr()
+}
+
+// DPBidderParams are bidder parameters of demand partner for publisher or domain,
+// domain values override publisher values of the same parameters
+type DPBidderParams struct {
+	ID              int             `json:"id"`
+	DemandPartnerID string          `json:"demand_partner_id" validate:"required"`
+	PublisherID     string          `json:"publisher_id" validate:"required"`
+	Domain          string          `json:"domain"`
+	Params          json.RawMessage `json:"params" validate:"required" swaggertype:"object"`
+	CreatedAt       time.Time       `json:"created_at"`
+	UpdatedAt       *time.Time      `json:"updated_at"`
+}
+
+func (p *DPBidderParams) FromModel(mod *models.DPBidderParam) {
+	p.ID = mod.ID
+	p.DemandPartnerID = mod.DemandPartnerID
+	p.PublisherID = mod.PublisherID
+	p.Domain = mod.Domain
+	p.Params = json.RawMessage(mod.Params)
+	p.CreatedAt = mod.CreatedAt
+	p.UpdatedAt = mod.UpdatedAt.Ptr()
+}
+
+type DPBidderParamsDeleteRequest struct {
+	IDs []int `json:"ids" validate:"min=1"`
+}
@@ -1,10 +1,21 @@
 package dto
 
+import (
+	"time"
+
+	"github.com/m6yf/bcwork/models"
+)
+
+const (
+	BlockTypeBADV = "badv"
+	BlockTypeBCAT = "bcat"
+)
+
 type BlockUpdateRequest struct {
 	Publisher string   `json:"publisher" validate:"required"`
 	Domain    string   `json:"domain"`
-	BCAT      []string `json:"bcat"`
-	BADV      []string `json:"badv"`
+	BCAT      []string `json:"bcat" validate:"dive,bcat"`
+	BADV      []string `json:"badv" validate:"dive,badv"`
 }
 
 type BlockGetRequest struct {
@@ -12,3 +23,80 @@ type BlockGetRequest struct {
 	Publisher string   `json:"publisher"`
 	Domain    string   `json:"domain"`
 }
+
+// BlockList is a current list of publisher (or domain) entries of block type as it's published to metadata
+type BlockList struct {
+	Key       string    `json:"key"`
+	Publisher string    `json:"publisher"`
+	Domain    string    `json:"domain"`
+	Type      string    `json:"type"`
+	Value     []string  `json:"value"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type Block struct {
+	ID        int        `json:"id"`
+	Publisher string     `json:"publisher"`
+	Domain    string     `json:"domain"`
+	Type      string     `json:"type"`
+	Value     string     `json:"value"`
+	Reason    *string    `json:"reason"`
+	ExpiresAt *time.Time `json:"expires_at"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at"`
+}
+
+func (b *Block) FromModel(mod *models.Block) {
+	b.ID = mod.ID
+	b.Publisher = mod.Publisher
+	b.Domain = mod.Domain
+	b.Type = mod.Type
+	b.Value = mod.Value
+	b.Reason = mod.Reason.Ptr()
+	b.ExpiresAt = mod.ExpiresAt.Ptr()
+	b.CreatedAt = mod.CreatedAt
+	b.UpdatedAt = mod.UpdatedAt.Ptr()
+}
+
+// BlockItemsRequest adds or removes single entries without replacing the whole list
+type BlockItemsRequest struct {
+	Publisher string      `json:"publisher" validate:"required"`
+	Domain    string      `json:"domain"`
+	Items     []BlockItem `json:"items" validate:"required,min=1,dive"`
+}
+
+type BlockItem struct {
+	Type      string     `json:"type" validate:"blockType"`
+	Value     string     `json:"value" validate:"required"`
+	Reason    string     `json:"reason"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// BlockUploadReport shows how uploaded entries were treated, only valid ones are committed
+type BlockUploadReport struct {
+	Committed  bool               `json:"committed"`
+	Valid      []BlockItem        `json:"valid"`
+	Invalid    []BlockUploadEntry `json:"invalid"`
+	Duplicates []BlockUploadEntry `json:"duplicates"`
+}
+
+type BlockUploadEntry struct {
+	Line  int    `json:"line"`
+	Type  string `json:"type"`
+	Value string `json:"value"`
+	Error string `json:"error"`
+}
+
+type TaxonomyRequest struct {
+	Version string `json:"version"`
+	MapTo   string `json:"map_to"`
+}
+
+// TaxonomyCategory is IAB content category, mapped holds equivalent ids in requested version
+type TaxonomyCategory struct {
+	ID       string   `json:"id"`
+	ParentID string   `json:"parent_id"`
+	Name     string   `json:"name"`
+	Version  string   `json:"version"`
+	Mapped   []string `json:"mapped"`
+}
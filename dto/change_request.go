@@ -0,0 +1,106 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/m6yf/bcwork/models"
+	"github.com/volatiletech/null/v8"
+)
+
+const (
+	// change request statuses
+	ChangeRequestStatusPending  = "pending"
+	ChangeRequestStatusApproved = "approved"
+	ChangeRequestStatusRejected = "rejected"
+	ChangeRequestStatusFailed   = "failed"
+)
+
+type ApprovalPolicy struct {
+	ID                  int        `json:"id"`
+	Name                string     `json:"name" validate:"required"`
+	Subject             string     `json:"subject" validate:"approvalSubject"`
+	MinChangePercentage *float64   `json:"min_change_percentage" validate:"omitempty,gte=0"`
+	RequesterRoles      []string   `json:"requester_roles" validate:"dive,userRole"`
+	ApproverRoles       []string   `json:"approver_roles" validate:"required,min=1,dive,userRole"`
+	NotifyEmails        []string   `json:"notify_emails" validate:"dive,email"`
+	NotifySlack         bool       `json:"notify_slack"`
+	Active              bool       `json:"active"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           *time.Time `json:"updated_at"`
+}
+
+func (p *ApprovalPolicy) FromModel(mod *models.ApprovalPolicy) {
+	p.ID = mod.ID
+	p.Name = mod.Name
+	p.Subject = mod.Subject
+	p.MinChangePercentage = mod.MinChangePercentage.Ptr()
+	p.RequesterRoles = getStringSliceOrEmpty(mod.RequesterRoles)
+	p.ApproverRoles = getStringSliceOrEmpty(mod.ApproverRoles)
+	p.NotifyEmails = getStringSliceOrEmpty(mod.NotifyEmails)
+	p.NotifySlack = mod.NotifySlack
+	p.Active = mod.Active
+	p.CreatedAt = mod.CreatedAt
+	p.UpdatedAt = mod.UpdatedAt.Ptr()
+}
+
+func (p *ApprovalPolicy) ToModel() *models.ApprovalPolicy {
+	return &models.ApprovalPolicy{
+		ID:                  p.ID,
+		Name:                p.Name,
+		Subject:             p.Subject,
+		MinChangePercentage: null.Float64FromPtr(p.MinChangePercentage),
+		RequesterRoles:      p.RequesterRoles,
+		ApproverRoles:       p.ApproverRoles,
+		NotifyEmails:        p.NotifyEmails,
+		NotifySlack:         p.NotifySlack,
+		Active:              p.Active,
+	}
+}
+
+type ChangeRequest struct {
+	ID               int             `json:"id"`
+	PolicyID         int             `json:"policy_id"`
+	Subject          string          `json:"subject"`
+	Route            string          `json:"route"`
+	Payload          json.RawMessage `json:"payload"`
+	ChangePercentage float64         `json:"change_percentage"`
+	Status           string          `json:"status"`
+	RequesterID      int             `json:"requester_id"`
+	RequesterRole    string          `json:"requester_role"`
+	ApproverID       *int            `json:"approver_id"`
+	Comment          *string         `json:"comment"`
+	ErrorMessage     *string         `json:"error_message"`
+	CreatedAt        time.Time       `json:"created_at"`
+	DecidedAt        *time.Time      `json:"decided_at"`
+}
+
+func (r *ChangeRequest) FromModel(mod *models.ChangeRequest) {
+	r.ID = mod.ID
+	r.PolicyID = mod.PolicyID
+	r.Subject = mod.Subject
+	r.Route = mod.Route
+	r.Payload = json.RawMessage(mod.Payload)
+	r.ChangePercentage = mod.ChangePercentage
+	r.Status = mod.Status
+	r.RequesterID = mod.RequesterID
+	r.RequesterRole = mod.RequesterRole
+	r.ApproverID = mod.ApproverID.Ptr()
+	r.Comment = mod.Comment.Ptr()
+	r.ErrorMessage = mod.ErrorMessage.Ptr()
+	r.CreatedAt = mod.CreatedAt
+	r.DecidedAt = mod.DecidedAt.Ptr()
+}
+
+type ChangeRequestDecision struct {
+	ID      int    `json:"id" validate:"required"`
+	Comment string `json:"comment"`
+}
+
+func getStringSliceOrEmpty(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+
+	return values
+}
@@ -0,0 +1,86 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/m6yf/bcwork/models"
+	"github.com/rotisserie/eris"
+)
+
+const (
+	CompassSyncStatusSynced   = "synced"
+	CompassSyncStatusPending  = "pending"
+	CompassSyncStatusConflict = "conflict"
+	CompassSyncStatusFailed   = "failed"
+
+	CompassSyncKeepLocal   = "local"
+	CompassSyncKeepCompass = "compass"
+)
+
+// CompassManager maps id of manager in compass to user id
+type CompassManager struct {
+	ID        int        `json:"id"`
+	CompassID string     `json:"compass_id" validate:"required"`
+	UserID    int        `json:"user_id" validate:"required"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at"`
+}
+
+func (m *CompassManager) FromModel(mod *models.CompassManager) {
+	m.ID = mod.ID
+	m.CompassID = mod.CompassID
+	m.UserID = mod.UserID
+	m.CreatedAt = mod.CreatedAt
+	m.UpdatedAt = mod.UpdatedAt.Ptr()
+}
+
+type CompassManagerDeleteRequest struct {
+	IDs []int `json:"ids" validate:"min=1"`
+}
+
+// PublisherFieldDiff is a field of publisher which has different values locally and in compass
+type PublisherFieldDiff struct {
+	Field   string `json:"field"`
+	Local   any    `json:"local"`
+	Compass any    `json:"compass"`
+}
+
+// PublisherCompassSync is a state of publisher sync with compass
+type PublisherCompassSync struct {
+	PublisherID     string               `json:"publisher_id"`
+	Status          string               `json:"status"`
+	LatestTimestamp *int64               `json:"latest_timestamp"`
+	LocalChangedAt  *time.Time           `json:"local_changed_at"`
+	PulledAt        *time.Time           `json:"pulled_at"`
+	PushedAt        *time.Time           `json:"pushed_at"`
+	Diffs           []PublisherFieldDiff `json:"diffs"`
+	ErrorMessage    *string              `json:"error_message"`
+	UpdatedAt       *time.Time           `json:"updated_at"`
+}
+
+func (s *PublisherCompassSync) FromModel(mod *models.PublisherCompassSync) error {
+	s.PublisherID = mod.PublisherID
+	s.Status = mod.Status
+	s.LatestTimestamp = mod.LatestTimestamp.Ptr()
+	s.LocalChangedAt = mod.LocalChangedAt.Ptr()
+	s.PulledAt = mod.PulledAt.Ptr()
+	s.PushedAt = mod.PushedAt.Ptr()
+	s.ErrorMessage = mod.ErrorMessage.Ptr()
+	s.UpdatedAt = mod.UpdatedAt.Ptr()
+
+	s.Diffs = []PublisherFieldDiff{}
+	if mod.Diffs.Valid {
+		err := json.Unmarshal(mod.Diffs.JSON, &s.Diffs)
+		if err != nil {
+			return eris.Wrapf(err, "failed to unmarshal diffs of publisher [%v]", mod.PublisherID)
+		}
+	}
+
+	return nil
+}
+
+type PublisherSyncResolveRequest struct {
+	PublisherID string `json:"publisher_id" validate:"required"`
+	Keep        string `json:"keep" validate:"oneof=local compass"`
+}
@@ -1,6 +1,7 @@
 package dto
 
 import (
+	"encoding/json"
 	"fmt"
 	"sort"
 	"strings"
@@ -48,6 +49,7 @@ type DemandPartner struct {
 	Automation              bool                       `json:"automation"`
 	AutomationName          string                     `json:"automation_name"`
 	Threshold               float64                    `json:"threshold" validate:"dpThreshold"`
+	AutomationConfig        *DPOAutomationConfig       `json:"automation_config" validate:"dpoAutomationConfig"`
 	Score                   int                        `json:"score"`
 	Comments                *string                    `json:"comments"`
 	CreatedAt               time.Time                  `json:"created_at"`
@@ -109,6 +111,12 @@ func (dp *DemandPartner) FromModel(mod *models.Dpo) {
 	} else {
 		dp.Threshold = 0.0
 	}
+	if mod.AutomationConfig.Valid {
+		config := new(DPOAutomationConfig)
+		if err := json.Unmarshal(mod.AutomationConfig.JSON, config); err == nil {
+			dp.AutomationConfig = config
+		}
+	}
 	dp.CreatedAt = mod.CreatedAt
 	dp.UpdatedAt = mod.UpdatedAt.Ptr()
 }
@@ -145,6 +153,18 @@ func (dp *DemandPartner) ToModel(id string) *models.Dpo {
 
 			return null.Float64From(dp.Threshold)
 		}(),
+		AutomationConfig: func() null.JSON {
+			if dp.AutomationConfig == nil {
+				return null.JSON{Valid: false, JSON: nil}
+			}
+
+			data, err := json.Marshal(dp.AutomationConfig)
+			if err != nil {
+				return null.JSON{Valid: false, JSON: nil}
+			}
+
+			return null.JSONFrom(data)
+		}(),
 		Score: func() int {
 			if dp.Score == 0 {
 				return DefaultDemandPartnerScoreValue
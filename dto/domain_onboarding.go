@@ -0,0 +1,109 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/m6yf/bcwork/models"
+	"github.com/rotisserie/eris"
+)
+
+const (
+	DomainOnboardingStatusPending = "pending"
+	DomainOnboardingStatusPassed  = "passed"
+	DomainOnboardingStatusFailed  = "failed"
+
+	DomainOnboardingCheckDNS         = "dns"
+	DomainOnboardingCheckHTTPS       = "https"
+	DomainOnboardingCheckAdsTxt      = "ads_txt"
+	DomainOnboardingCheckSellersJSON = "sellers_json"
+	DomainOnboardingCheckPixalate    = "pixalate"
+	DomainOnboardingCheckConfiant    = "confiant"
+)
+
+// DomainOnboardingChecks are checks of new publisher domain in the order they are run
+var DomainOnboardingChecks = []string{
+	DomainOnboardingCheckDNS,
+	DomainOnboardingCheckHTTPS,
+	DomainOnboardingCheckAdsTxt,
+	DomainOnboardingCheckSellersJSON,
+	DomainOnboardingCheckPixalate,
+	DomainOnboardingCheckConfiant,
+}
+
+// DomainOnboardingCheck is a result of single onboarding check
+type DomainOnboardingCheck struct {
+	Name      string     `json:"name"`
+	Status    string     `json:"status"`
+	Message   string     `json:"message,omitempty"`
+	CheckedAt *time.Time `json:"checked_at,omitempty"`
+}
+
+// DomainOnboarding is a checklist of new publisher domain
+type DomainOnboarding struct {
+	Status        string                  `json:"status"`
+	Checks        []DomainOnboardingCheck `json:"checks"`
+	Attempts      int                     `json:"attempts"`
+	LastCheckedAt *time.Time              `json:"last_checked_at"`
+	CreatedAt     time.Time               `json:"created_at"`
+}
+
+func (o *DomainOnboarding) FromModel(mod *models.DomainOnboarding) error {
+	o.Status = mod.Status
+	o.Attempts = mod.Attempts
+	o.LastCheckedAt = mod.LastCheckedAt.Ptr()
+	o.CreatedAt = mod.CreatedAt
+
+	checks, err := DomainOnboardingChecksFromModel(mod)
+	if err != nil {
+		return err
+	}
+	o.Checks = checks
+
+	return nil
+}
+
+// DomainOnboardingChecksFromModel returns checks of onboarding, checks which weren't run yet are pending
+func DomainOnboardingChecksFromModel(mod *models.DomainOnboarding) ([]DomainOnboardingCheck, error) {
+	stored := make([]DomainOnboardingCheck, 0)
+	if mod.Checks.Valid {
+		err := json.Unmarshal(mod.Checks.JSON, &stored)
+		if err != nil {
+			return nil, eris.Wrapf(err, "failed to unmarshal onboarding checks of domain [%v:%v]", mod.PublisherID, mod.Domain)
+		}
+	}
+
+	checks := make([]DomainOnboardingCheck, 0, len(DomainOnboardingChecks))
+	for _, name := range DomainOnboardingChecks {
+		check := DomainOnboardingCheck{Name: name, Status: DomainOnboardingStatusPending}
+		for _, storedCheck := range stored {
+			if storedCheck.Name == name {
+				check = storedCheck
+				break
+			}
+		}
+		checks = append(checks, check)
+	}
+
+	return checks, nil
+}
+
+// DomainOnboardingStatus returns status of onboarding by its checks:
+// failed when any check failed, passed when all checks passed and pending otherwise
+func DomainOnboardingStatus(checks []DomainOnboardingCheck) string {
+	status := DomainOnboardingStatusPassed
+	for _, check := range checks {
+		switch check.Status {
+		case DomainOnboardingStatusFailed:
+			return DomainOnboardingStatusFailed
+		case DomainOnboardingStatusPending:
+			status = DomainOnboardingStatusPending
+		}
+	}
+
+	if len(checks) == 0 {
+		return DomainOnboardingStatusPending
+	}
+
+	return status
+}
@@ -0,0 +1,81 @@
+package dto
+
+import (
+	"testing"
+
+	"github.com/m6yf/bcwork/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestDomainOnboardingStatus(t *testing.T) {
+	t.Parallel()
+
+	check := func(name, status string) DomainOnboardingCheck {
+		return DomainOnboardingCheck{Name: name, Status: status}
+	}
+
+	tests := []struct {
+		name   string
+		checks []DomainOnboardingCheck
+		want   string
+	}{
+		{
+			name: "allPassed",
+			checks: []DomainOnboardingCheck{
+				check(DomainOnboardingCheckDNS, DomainOnboardingStatusPassed),
+				check(DomainOnboardingCheckHTTPS, DomainOnboardingStatusPassed),
+			},
+			want: DomainOnboardingStatusPassed,
+		},
+		{
+			name: "oneFailed",
+			checks: []DomainOnboardingCheck{
+				check(DomainOnboardingCheckDNS, DomainOnboardingStatusPending),
+				check(DomainOnboardingCheckHTTPS, DomainOnboardingStatusFailed),
+			},
+			want: DomainOnboardingStatusFailed,
+		},
+		{
+			name: "notFinished",
+			checks: []DomainOnboardingCheck{
+				check(DomainOnboardingCheckDNS, DomainOnboardingStatusPassed),
+				check(DomainOnboardingCheckHTTPS, DomainOnboardingStatusPending),
+			},
+			want: DomainOnboardingStatusPending,
+		},
+		{
+			name: "noChecks",
+			want: DomainOnboardingStatusPending,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, DomainOnboardingStatus(tt.checks))
+		})
+	}
+}
+
+func TestDomainOnboardingChecksFromModel(t *testing.T) {
+	t.Parallel()
+
+	mod := &models.DomainOnboarding{
+		PublisherID: "1",
+		Domain:      "domain.com",
+		Checks:      null.JSONFrom([]byte(`[{"name":"https","status":"failed","message":"site responded with status code 503"}]`)),
+	}
+
+	checks, err := DomainOnboardingChecksFromModel(mod)
+	assert.NoError(t, err)
+	assert.Len(t, checks, len(DomainOnboardingChecks))
+	assert.Equal(t, DomainOnboardingCheck{Name: DomainOnboardingCheckDNS, Status: DomainOnboardingStatusPending}, checks[0])
+	assert.Equal(t, DomainOnboardingCheck{
+		Name:    DomainOnboardingCheckHTTPS,
+		Status:  DomainOnboardingStatusFailed,
+		Message: "site responded with status code 503",
+	}, checks[1])
+}
@@ -0,0 +1,74 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/m6yf/bcwork/models"
+)
+
+const (
+	DPApprovalStatusNotSent  = "not_sent"
+	DPApprovalStatusSent     = "sent"
+	DPApprovalStatusApproved = "approved"
+	DPApprovalStatusRejected = "rejected"
+)
+
+// DPApprovalDemandStatuses are ads.txt demand statuses of lines by status of approval request
+var DPApprovalDemandStatuses = map[string]string{
+	DPApprovalStatusNotSent:  DPStatusNotSent,
+	DPApprovalStatusSent:     DPStatusPending,
+	DPApprovalStatusApproved: DPStatusApproved,
+	DPApprovalStatusRejected: DPStatusRejected,
+}
+
+// DPApprovalRequest is a request of demand partner approval for publisher domain
+type DPApprovalRequest struct {
+	ID              int        `json:"id"`
+	DemandPartnerID string     `json:"demand_partner_id"`
+	PublisherID     string     `json:"publisher_id"`
+	Domain          string     `json:"domain"`
+	Status          string     `json:"status"`
+	SentAt          *time.Time `json:"sent_at"`
+	Reminders       int        `json:"reminders"`
+	LastRemindedAt  *time.Time `json:"last_reminded_at"`
+	DecidedAt       *time.Time `json:"decided_at"`
+	DecidedBy       *int       `json:"decided_by"`
+	Comment         *string    `json:"comment"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       *time.Time `json:"updated_at"`
+}
+
+func (r *DPApprovalRequest) FromModel(mod *models.DPApprovalRequest) {
+	r.ID = mod.ID
+	r.DemandPartnerID = mod.DemandPartnerID
+	r.PublisherID = mod.PublisherID
+	r.Domain = mod.Domain
+	r.Status = mod.Status
+	r.SentAt = mod.SentAt.Ptr()
+	r.Reminders = mod.Reminders
+	r.LastRemindedAt = mod.LastRemindedAt.Ptr()
+	r.DecidedAt = mod.DecidedAt.Ptr()
+	r.DecidedBy = mod.DecidedBy.Ptr()
+	r.Comment = mod.Comment.Ptr()
+	r.CreatedAt = mod.CreatedAt
+	r.UpdatedAt = mod.UpdatedAt.Ptr()
+}
+
+type DPApprovalDomain struct {
+	PublisherID string `json:"publisher_id" validate:"required"`
+	Domain      string `json:"domain" validate:"required"`
+}
+
+// DPApprovalSendRequest sends domains for approval of demand partner
+type DPApprovalSendRequest struct {
+	DemandPartnerID string             `json:"demand_partner_id" validate:"required"`
+	Domains         []DPApprovalDomain `json:"domains" validate:"min=1,dive"`
+}
+
+// DPApprovalDecisionRequest records decision of demand partner about domains
+type DPApprovalDecisionRequest struct {
+	DemandPartnerID string             `json:"demand_partner_id" validate:"required"`
+	Domains         []DPApprovalDomain `json:"domains" validate:"min=1,dive"`
+	Status          string             `json:"status" validate:"oneof=approved rejected"`
+	Comment         string             `json:"comment"`
+}
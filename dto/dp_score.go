@@ -0,0 +1,76 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/m6yf/bcwork/models"
+)
+
+const (
+	// DPScoreMaxValue keeps default demand partner score in the middle of calculated scores scale
+	DPScoreMaxValue = 2 * DefaultDemandPartnerScoreValue
+	// score components
+	DPScoreComponentWinRate        = "win_rate"
+	DPScoreComponentERPM           = "erpm"
+	DPScoreComponentTimeoutRate    = "timeout_rate"
+	DPScoreComponentNoResponseRate = "no_response_rate"
+	DPScoreComponentBidCacheRate   = "bid_cache_rate"
+	DPScoreComponentRevenueShare   = "revenue_share"
+)
+
+// DPScoreComponent is a part of demand partner score: value is the measured metric,
+// score is the metric normalized to [0, 1] among demand partners and points are its share in the total score
+type DPScoreComponent struct {
+	Name   string  `json:"name"`
+	Value  float64 `json:"value"`
+	Score  float64 `json:"score"`
+	Weight float64 `json:"weight"`
+	Points int     `json:"points"`
+}
+
+// DPScore is a calculated score of demand partner, overall when country and device type are empty
+type DPScore struct {
+	ID              int                `json:"id"`
+	DemandPartnerID string             `json:"demand_partner_id"`
+	Country         string             `json:"country"`
+	DeviceType      string             `json:"device_type"`
+	Score           int                `json:"score"`
+	Components      []DPScoreComponent `json:"components"`
+	CalculatedAt    time.Time          `json:"calculated_at"`
+}
+
+func (s *DPScore) FromModel(mod *models.DPScore) error {
+	s.ID = mod.ID
+	s.DemandPartnerID = mod.DemandPartnerID
+	s.Country = mod.Country
+	s.DeviceType = mod.DeviceType
+	s.Score = mod.Score
+	s.CalculatedAt = mod.CalculatedAt
+
+	s.Components = make([]DPScoreComponent, 0)
+	err := json.Unmarshal(mod.Components, &s.Components)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// IsOverall returns true for score calculated over all countries and device types
+func (s *DPScore) IsOverall() bool {
+	return s.Country == "" && s.DeviceType == ""
+}
+
+type DPRankingItem struct {
+	DemandPartnerID string `json:"demand_partner_id"`
+	Score           int    `json:"score"`
+}
+
+// DPRanking is a metadata value used to prioritize requests to demand partners,
+// segments are ranked demand partners by country and device type ("country:device_type")
+type DPRanking struct {
+	DemandPartners []DPRankingItem            `json:"demand_partners"`
+	Segments       map[string][]DPRankingItem `json:"segments"`
+	CalculatedAt   time.Time                  `json:"calculated_at"`
+}
@@ -0,0 +1,110 @@
+package dto
+
+import (
+	"math"
+	"sort"
+)
+
+const (
+	DefaultDPOHysteresis            = 0.1
+	DefaultDPOMinHoldHours          = 6
+	DefaultDPOExplorationEveryHours = 24
+	DefaultDPOExplorationFactor     = 25
+)
+
+// DPOThrottleLevel throttles Factor percents of demand partner traffic when placement eRPM is below
+// MaxErpmRatio of the demand partner threshold
+type DPOThrottleLevel struct {
+	MaxErpmRatio float64 `json:"max_erpm_ratio"`
+	Factor       float64 `json:"factor"`
+}
+
+// DPOAutomationConfig is the per demand partner configuration of DPO automation, unset fields use defaults
+type DPOAutomationConfig struct {
+	Levels []DPOThrottleLevel `json:"levels,omitempty"`
+	// Hysteresis is the eRPM ratio above the level boundary required to relax throttling
+	Hysteresis *float64 `json:"hysteresis,omitempty"`
+	// MinHoldHours is the minimal time between automation changes of the same rule
+	MinHoldHours *int `json:"min_hold_hours,omitempty"`
+	// ExplorationEveryHours is the time after which held throttling is lowered to ExplorationFactor for one run, 0 disables exploration
+	ExplorationEveryHours *int     `json:"exploration_every_hours,omitempty"`
+	ExplorationFactor     *float64 `json:"exploration_factor,omitempty"`
+}
+
+func defaultDPOThrottleLevels() []DPOThrottleLevel {
+	return []DPOThrottleLevel{
+		{MaxErpmRatio: 0.25, Factor: 90},
+		{MaxErpmRatio: 0.5, Factor: 75},
+		{MaxErpmRatio: 0.75, Factor: 50},
+		{MaxErpmRatio: 1, Factor: 25},
+	}
+}
+
+// WithDefaults returns configuration with defaults for unset fields and levels ordered by eRPM ratio
+func (c *DPOAutomationConfig) WithDefaults() DPOAutomationConfig {
+	config := DPOAutomationConfig{}
+	if c != nil {
+		config = *c
+	}
+
+	if len(config.Levels) == 0 {
+		config.Levels = defaultDPOThrottleLevels()
+	} else {
+		config.Levels = append([]DPOThrottleLevel(nil), config.Levels...)
+		sort.SliceStable(config.Levels, func(i, j int) bool {
+			return config.Levels[i].MaxErpmRatio < config.Levels[j].MaxErpmRatio
+		})
+	}
+	if config.Hysteresis == nil {
+		config.Hysteresis = float64Pointer(DefaultDPOHysteresis)
+	}
+	if config.MinHoldHours == nil {
+		config.MinHoldHours = intPointer(DefaultDPOMinHoldHours)
+	}
+	if config.ExplorationEveryHours == nil {
+		config.ExplorationEveryHours = intPointer(DefaultDPOExplorationEveryHours)
+	}
+	if config.ExplorationFactor == nil {
+		config.ExplorationFactor = float64Pointer(DefaultDPOExplorationFactor)
+	}
+
+	return config
+}
+
+// Level returns throttling factor for placement eRPM to threshold ratio, 0 means no throttling
+func (c *DPOAutomationConfig) Level(erpmRatio float64) float64 {
+	if math.IsNaN(erpmRatio) {
+		return 0
+	}
+
+	var factor float64
+	for _, level := range c.Levels {
+		if erpmRatio < level.MaxErpmRatio {
+			factor = math.Max(factor, level.Factor)
+		}
+	}
+
+	return factor
+}
+
+// IsValid checks levels and hours ranges
+func (c *DPOAutomationConfig) IsValid() bool {
+	for _, level := range c.Levels {
+		if level.MaxErpmRatio <= 0 || level.MaxErpmRatio > 1 || level.Factor <= 0 || level.Factor > 100 {
+			return false
+		}
+	}
+
+	return (c.Hysteresis == nil || (*c.Hysteresis >= 0 && *c.Hysteresis <= 1)) &&
+		(c.MinHoldHours == nil || *c.MinHoldHours >= 0) &&
+		(c.ExplorationEveryHours == nil || *c.ExplorationEveryHours >= 0) &&
+		(c.ExplorationFactor == nil || (*c.ExplorationFactor >= 0 && *c.ExplorationFactor < 100))
+}
+
+func float64Pointer(value float64) *float64 {
+	return &value
+}
+
+func intPointer(value int) *int {
+	return &value
+}
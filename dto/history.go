@@ -15,6 +15,9 @@ type HistoryModelExtended struct {
 	FirstName         null.String `boil:"first_name" json:"first_name"`
 	LastName          null.String `boil:"last_name" json:"last_name"`
 	DemandPartnerName null.String `boil:"demand_partner_name" json:"demand_partner_name"`
+	ApproverFirstName null.String `boil:"approver_first_name" json:"approver_first_name"`
+	ApproverLastName  null.String `boil:"approver_last_name" json:"approver_last_name"`
+	APIKeyName        null.String `boil:"api_key_name" json:"api_key_name"`
 }
 
 type History struct {
@@ -26,6 +29,8 @@ type History struct {
 	Item              string    `json:"item"`
 	Changes           []Changes `json:"children"`
 	DemandPartnerName *string   `json:"demand_partner_name"`
+	ApproverFullName  *string   `json:"approver_full_name,omitempty"`
+	APIKeyName        *string   `json:"api_key_name,omitempty"`
 }
 
 type Changes struct {
@@ -69,5 +74,14 @@ func (h *History) FromModel(mod *HistoryModelExtended) error {
 		h.DemandPartnerName = &mod.DemandPartnerName.String
 	}
 
+	if mod.ApproverID.Valid {
+		approverFullName := mod.ApproverFirstName.String + " " + mod.ApproverLastName.String
+		h.ApproverFullName = &approverFullName
+	}
+
+	if mod.APIKeyID.Valid {
+		h.APIKeyName = &mod.APIKeyName.String
+	}
+
 	return nil
 }
@@ -0,0 +1,93 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/m6yf/bcwork/models"
+)
+
+const (
+	PortalGranularityDaily  = "daily"
+	PortalGranularityHourly = "hourly"
+)
+
+// PortalRequest is a request of publisher portal, publisher users can access only publishers assigned to them
+type PortalRequest struct {
+	PublisherID string `json:"publisher_id" validate:"required"`
+}
+
+type PortalReportRequest struct {
+	PublisherID string    `json:"publisher_id" validate:"required"`
+	Domain      []string  `json:"domain"`
+	Granularity string    `json:"granularity" validate:"portalGranularity"`
+	From        time.Time `json:"from" validate:"required"`
+	To          time.Time `json:"to" validate:"required,gtfield=From"`
+}
+
+// PortalReportRow contains publisher side metrics only, revenue is the amount payable to the publisher
+type PortalReportRow struct {
+	Time        time.Time `boil:"time" json:"time"`
+	Domain      string    `boil:"domain" json:"domain"`
+	Impressions int64     `boil:"impressions" json:"impressions"`
+	Revenue     float64   `boil:"revenue" json:"revenue"`
+	RPM         float64   `boil:"-" json:"rpm"`
+}
+
+type PortalDomain struct {
+	Domain       string                `json:"domain"`
+	AdsTxt       []*PortalAdsTxtStatus `json:"ads_txt"`
+	MissingLines []string              `json:"missing_lines"`
+}
+
+// PortalAdsTxtStatus is a result of the last scan of ads.txt or app-ads.txt of the domain
+type PortalAdsTxtStatus struct {
+	FileName     string    `json:"file_name"`
+	URL          *string   `json:"url"`
+	Records      int       `json:"records"`
+	Found        int       `json:"found"`
+	Missing      int       `json:"missing"`
+	Mismatch     int       `json:"mismatch"`
+	ErrorMessage *string   `json:"error_message"`
+	ScannedAt    time.Time `json:"scanned_at"`
+}
+
+type PortalTagsRequest struct {
+	PublisherID string `json:"publisher_id" validate:"required"`
+	IDs         []int  `json:"ids" validate:"required,min=1"`
+	AddGDPR     bool   `json:"add_gdpr"`
+}
+
+// PortalStatementRequest requests monthly statements between months in YYYYMM format, both inclusive
+type PortalStatementRequest struct {
+	PublisherID string `json:"publisher_id" validate:"required"`
+	From        string `json:"from" validate:"required,datetime=200601"`
+	To          string `json:"to" validate:"required,datetime=200601"`
+}
+
+type PublisherNotificationPreference struct {
+	PublisherID      string     `json:"publisher_id" validate:"required"`
+	Emails           []string   `json:"emails" validate:"dive,email"`
+	DailyReport      bool       `json:"daily_report"`
+	MonthlyStatement bool       `json:"monthly_statement"`
+	AdsTxtAlerts     bool       `json:"ads_txt_alerts"`
+	UpdatedAt        *time.Time `json:"updated_at"`
+}
+
+func (p *PublisherNotificationPreference) FromModel(mod *models.PublisherNotificationPreference) {
+	p.PublisherID = mod.PublisherID
+	p.Emails = getStringSliceOrEmpty(mod.Emails)
+	p.DailyReport = mod.DailyReport
+	p.MonthlyStatement = mod.MonthlyStatement
+	p.AdsTxtAlerts = mod.AdsTXTAlerts
+	p.UpdatedAt = mod.UpdatedAt.Ptr()
+}
+
+// DefaultPublisherNotificationPreference returns preferences of publisher which didn't set them yet
+func DefaultPublisherNotificationPreference(publisherID string) *PublisherNotificationPreference {
+	return &PublisherNotificationPreference{
+		PublisherID:      publisherID,
+		Emails:           []string{},
+		MonthlyStatement: true,
+		AdsTxtAlerts:     true,
+	}
+}
@@ -1,14 +1,46 @@
 package dto
 
-import "time"
+import (
+	"time"
+
+	"github.com/m6yf/bcwork/models"
+)
+
+const DefaultPriceOverrideTTLHours = 8
 
 type PriceOverrideRequest struct {
-	Domain string `json:"domain"`
-	Ips    []Ips  `json:"ips" validate:"duplicateIps,overridePriceKey"`
+	Domain   string `json:"domain" validate:"required"`
+	TTLHours int    `json:"ttl_hours" validate:"omitempty,min=1,max=720"`
+	Ips      []Ips  `json:"ips" validate:"required,min=1,duplicateIps,overridePriceKey,dive"`
 }
 
+// Ips is an override entry, ip is either single address or CIDR range.
+// Same structure is published to metadata with date of the last change and expiry.
 type Ips struct {
-	IP    string    `json:"ip"`
-	Date  time.Time `json:"date"`
-	Price float64   `json:"price"`
+	IP        string     `json:"ip" validate:"ipOrCidr"`
+	Date      time.Time  `json:"date"`
+	Price     float64    `json:"price"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+type PriceOverride struct {
+	ID        int        `json:"id"`
+	Domain    string     `json:"domain"`
+	IP        string     `json:"ip"`
+	Price     float64    `json:"price"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	CreatedBy *int       `json:"created_by"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at"`
+}
+
+func (p *PriceOverride) FromModel(mod *models.PriceOverride) {
+	p.ID = mod.ID
+	p.Domain = mod.Domain
+	p.IP = mod.IP
+	p.Price = mod.Price
+	p.ExpiresAt = mod.ExpiresAt
+	p.CreatedBy = mod.CreatedBy.Ptr()
+	p.CreatedAt = mod.CreatedAt
+	p.UpdatedAt = mod.UpdatedAt.Ptr()
 }
@@ -31,6 +31,9 @@ type Publisher struct {
 	RefreshCache            []RefreshCache `json:"refresh_cache"`
 	LatestTimestamp         int64          `json:"latest_timestamp,omitempty"`
 	IsDirect                bool           `json:"is_direct"`
+	SellerDomain            string         `json:"seller_domain"`
+	SellerType              string         `json:"seller_type"`
+	IsConfidential          bool           `json:"is_confidential"`
 }
 
 func (pub *Publisher) FromModel(mod *models.Publisher, usersMap map[string]string) error {
@@ -62,6 +65,9 @@ func (pub *Publisher) FromModel(mod *models.Publisher, usersMap map[string]strin
 	pub.IntegrationType = integrationType
 	pub.MediaType = mediaType
 	pub.IsDirect = mod.IsDirect
+	pub.SellerDomain = mod.SellerDomain.String
+	pub.SellerType = mod.SellerType.String
+	pub.IsConfidential = mod.IsConfidential
 
 	if mod.R != nil {
 		if len(mod.R.PublisherDomains) > 0 {
@@ -163,10 +169,13 @@ type UpdatePublisherValues struct {
 	PauseTimestamp      *int64   `json:"pause_timestamp,omitempty"`
 	StartTimestamp      *int64   `json:"start_timestamp,omitempty"`
 	ReactivateTimestamp *int64   `json:"reactivate_timestamp,omitempty"`
-	Status              *string  `json:"status,omitempty"`
+	Status              *string  `json:"status,omitempty" validate:"omitempty,publisherStatus"`
 	IntegrationType     []string `json:"integration_type,omitempty"` // validate:"integrationType"
 	MediaType           []string `json:"media_type,omitempty"`       // validate:"mediaType"
 	IsDirect            *bool    `json:"is_direct,omitempty"`
+	SellerDomain        *string  `json:"seller_domain,omitempty"`
+	SellerType          *string  `json:"seller_type,omitempty" validate:"omitempty,sellerType"`
+	IsConfidential      *bool    `json:"is_confidential,omitempty"`
 }
 
 type PublisherCreateValues struct {
@@ -175,8 +184,11 @@ type PublisherCreateValues struct {
 	MediaBuyerID      string   `json:"media_buyer_id"`
 	CampaignManagerID string   `json:"campaign_manager_id"`
 	OfficeLocation    string   `json:"office_location"`
-	Status            string   `json:"status"`
+	Status            string   `json:"status" validate:"omitempty,publisherStatus"`
 	IntegrationType   []string `json:"integration_type"` // validate:"integrationType"
 	MediaType         []string `json:"media_type"`       // validate:"mediaType"
 	IsDirect          bool     `json:"is_direct"`
+	SellerDomain      string   `json:"seller_domain"`
+	SellerType        string   `json:"seller_type" validate:"sellerType"`
+	IsConfidential    bool     `json:"is_confidential"`
 }
@@ -20,18 +20,19 @@ type UserModelCompact struct {
 }
 
 type PublisherDetail struct {
-	Name                   string         `json:"name"`
-	PublisherID            string         `json:"publisher_id"`
-	Domain                 string         `json:"domain"`
-	AccountManagerID       string         `json:"account_manager_id"`
-	AccountManagerFullName string         `json:"account_manager_full_name"`
-	Automation             bool           `json:"automation"`
-	GPPTarget              float64        `json:"gpp_target"`
-	ActivityStatus         string         `json:"activity_status"`
-	Confiant               Confiant       `json:"confiant,omitempty"`
-	Pixalate               Pixalate       `json:"pixalate,omitempty"`
-	BidCaching             []BidCaching   `json:"bid_caching"`
-	RefreshCache           []RefreshCache `json:"refresh_cache" `
+	Name                   string            `json:"name"`
+	PublisherID            string            `json:"publisher_id"`
+	Domain                 string            `json:"domain"`
+	AccountManagerID       string            `json:"account_manager_id"`
+	AccountManagerFullName string            `json:"account_manager_full_name"`
+	Automation             bool              `json:"automation"`
+	GPPTarget              float64           `json:"gpp_target"`
+	ActivityStatus         string            `json:"activity_status"`
+	Confiant               Confiant          `json:"confiant,omitempty"`
+	Pixalate               Pixalate          `json:"pixalate,omitempty"`
+	BidCaching             []BidCaching      `json:"bid_caching"`
+	RefreshCache           []RefreshCache    `json:"refresh_cache" `
+	Onboarding             *DomainOnboarding `json:"onboarding,omitempty"`
 }
 
 func (pd *PublisherDetail) FromModel(mod *PublisherDetailModel, activityStatus map[string]map[string]ActivityStatus,
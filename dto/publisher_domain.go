@@ -1,6 +1,7 @@
 package dto
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/m6yf/bcwork/models"
@@ -15,6 +16,10 @@ type PublisherDomainUpdateRequest struct {
 	Automation        bool     `json:"automation"`
 	MirrorPublisherID *string  `json:"mirror_publisher_id"`
 	IsDirect          *bool    `json:"is_direct"`
+	// AutomationStrategy is the factor automation strategy of the domain, default strategy is used when empty.
+	// Current strategy and params of the domain are kept when they are omitted.
+	AutomationStrategy *string         `json:"automation_strategy"`
+	AutomationParams   json.RawMessage `json:"automation_params" swaggertype:"object"`
 }
 
 type PublisherDomainRequest struct {
@@ -29,21 +34,23 @@ type PublisherDomainData struct {
 }
 
 type PublisherDomain struct {
-	PublisherID       string         `json:"publisher_id"`
-	PublisherName     string         `json:"publisher_name"`
-	Domain            string         `json:"domain,omitempty"`
-	Automation        bool           `json:"automation"`
-	GppTarget         float64        `json:"gpp_target"`
-	IntegrationType   []string       `json:"integration_type"`
-	CreatedAt         time.Time      `json:"created_at"`
-	Confiant          Confiant       `json:"confiant,omitempty"`
-	Pixalate          Pixalate       `json:"pixalate,omitempty"`
-	BidCaching        []BidCaching   `json:"bid_caching"`
-	RefreshCache      []RefreshCache `json:"refresh_cache"`
-	UpdatedAt         *time.Time     `json:"updated_at,omitempty"`
-	MirrorPublisherID *string        `json:"mirror_publisher_id,omitempty"`
-	IsDirect          *bool          `json:"is_direct"`
-	IsDirectPublisher bool           `json:"is_direct_publisher"`
+	PublisherID        string          `json:"publisher_id"`
+	PublisherName      string          `json:"publisher_name"`
+	Domain             string          `json:"domain,omitempty"`
+	Automation         bool            `json:"automation"`
+	GppTarget          float64         `json:"gpp_target"`
+	IntegrationType    []string        `json:"integration_type"`
+	CreatedAt          time.Time       `json:"created_at"`
+	Confiant           Confiant        `json:"confiant,omitempty"`
+	Pixalate           Pixalate        `json:"pixalate,omitempty"`
+	BidCaching         []BidCaching    `json:"bid_caching"`
+	RefreshCache       []RefreshCache  `json:"refresh_cache"`
+	UpdatedAt          *time.Time      `json:"updated_at,omitempty"`
+	MirrorPublisherID  *string         `json:"mirror_publisher_id,omitempty"`
+	IsDirect           *bool           `json:"is_direct"`
+	IsDirectPublisher  bool            `json:"is_direct_publisher"`
+	AutomationStrategy *string         `json:"automation_strategy"`
+	AutomationParams   json.RawMessage `json:"automation_params,omitempty" swaggertype:"object"`
 }
 
 func (pubDom *PublisherDomain) FromModel(
@@ -85,6 +92,10 @@ func (pubDom *PublisherDomain) FromModel(
 
 	pubDom.MirrorPublisherID = mod.MirrorPublisherID.Ptr()
 	pubDom.IsDirect = mod.IsDirect.Ptr()
+	pubDom.AutomationStrategy = mod.AutomationStrategy.Ptr()
+	if mod.AutomationParams.Valid {
+		pubDom.AutomationParams = json.RawMessage(mod.AutomationParams.JSON)
+	}
 
 	return nil
 }
@@ -0,0 +1,142 @@
+package dto
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/m6yf/bcwork/models"
+)
+
+const (
+	PublisherStatusOnboarding = "onboarding"
+	PublisherStatusActive     = "active"
+	PublisherStatusPaused     = "paused"
+	PublisherStatusChurned    = "churned"
+
+	PublisherStatusSourceManual    = "manual"
+	PublisherStatusSourceSchedule  = "schedule"
+	PublisherStatusSourceSync      = "sync"
+	PublisherStatusSourceMigration = "migration"
+)
+
+var PublisherStatuses = []string{
+	PublisherStatusOnboarding, PublisherStatusActive, PublisherStatusPaused, PublisherStatusChurned,
+}
+
+// publisherStatusTransitions are statuses allowed to move to from each status
+var publisherStatusTransitions = map[string][]string{
+	PublisherStatusOnboarding: {PublisherStatusActive, PublisherStatusChurned},
+	PublisherStatusActive:     {PublisherStatusPaused, PublisherStatusChurned},
+	PublisherStatusPaused:     {PublisherStatusActive, PublisherStatusChurned},
+	PublisherStatusChurned:    {PublisherStatusOnboarding},
+}
+
+// NormalizePublisherStatus brings status to lower case, statuses from compass are capitalized
+func NormalizePublisherStatus(status string) string {
+	return strings.ToLower(strings.TrimSpace(status))
+}
+
+func IsPublisherStatus(status string) bool {
+	return slices.Contains(PublisherStatuses, NormalizePublisherStatus(status))
+}
+
+// CanTransitPublisherStatus reports whether publisher can move from one status to another.
+// Publishers with empty or legacy status can move to any status of the lifecycle.
+func CanTransitPublisherStatus(from, to string) bool {
+	from, to = NormalizePublisherStatus(from), NormalizePublisherStatus(to)
+	if !IsPublisherStatus(to) {
+		return false
+	}
+
+	allowed, ok := publisherStatusTransitions[from]
+	if !ok {
+		return true
+	}
+
+	return from == to || slices.Contains(allowed, to)
+}
+
+// ScheduledPublisherStatus returns status publisher has to move to by its compass dates (unix milliseconds).
+// Dates which were already applied, i.e. not after the last status change, are ignored.
+// Empty string is returned when nothing is due.
+func ScheduledPublisherStatus(mod *models.Publisher, now time.Time) string {
+	isDue := func(timestamp int64) bool {
+		if timestamp <= 0 {
+			return false
+		}
+
+		at := time.UnixMilli(timestamp)
+
+		return !at.After(now) && (!mod.StatusChangedAt.Valid || at.After(mod.StatusChangedAt.Time))
+	}
+
+	switch NormalizePublisherStatus(mod.Status.String) {
+	case PublisherStatusOnboarding:
+		if isDue(mod.StartTimestamp.Int64) {
+			return PublisherStatusActive
+		}
+	case PublisherStatusActive:
+		// reactivation later than pause means the pause is over already
+		if isDue(mod.PauseTimestamp.Int64) && mod.PauseTimestamp.Int64 > mod.ReactivateTimestamp.Int64 {
+			return PublisherStatusPaused
+		}
+	case PublisherStatusPaused:
+		if isDue(mod.ReactivateTimestamp.Int64) {
+			return PublisherStatusActive
+		}
+	}
+
+	return ""
+}
+
+// InitialPublisherStatus returns status of the lifecycle for publisher which has no such status yet (e.g. imported from compass)
+// by its compass dates (unix milliseconds): paused while pause is due and not reactivated, active once started, onboarding otherwise.
+func InitialPublisherStatus(mod *models.Publisher, now time.Time) string {
+	isDue := func(timestamp int64) bool {
+		return timestamp > 0 && !time.UnixMilli(timestamp).After(now)
+	}
+
+	switch {
+	case isDue(mod.PauseTimestamp.Int64) && mod.PauseTimestamp.Int64 > mod.ReactivateTimestamp.Int64:
+		return PublisherStatusPaused
+	case isDue(mod.StartTimestamp.Int64):
+		return PublisherStatusActive
+	default:
+		return PublisherStatusOnboarding
+	}
+}
+
+type PublisherStatusTransition struct {
+	ID          int       `json:"id"`
+	PublisherID string    `json:"publisher_id"`
+	FromStatus  string    `json:"from_status"`
+	ToStatus    string    `json:"to_status"`
+	Source      string    `json:"source"`
+	CreatedBy   *int      `json:"created_by"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func (t *PublisherStatusTransition) FromModel(mod *models.PublisherStatusTransition) {
+	t.ID = mod.ID
+	t.PublisherID = mod.PublisherID
+	t.FromStatus = mod.FromStatus.String
+	t.ToStatus = mod.ToStatus
+	t.Source = mod.Source
+	t.CreatedBy = mod.CreatedBy.Ptr()
+	t.CreatedAt = mod.CreatedAt
+}
+
+type PublisherLifecycleReportRequest struct {
+	PublisherIDs []string  `json:"publisher_ids"`
+	From         time.Time `json:"from" validate:"required"`
+	To           time.Time `json:"to" validate:"required,gtfield=From"`
+}
+
+// PublisherTimeInState is time publisher spent in each status during the report period
+type PublisherTimeInState struct {
+	PublisherID string             `json:"publisher_id"`
+	Status      string             `json:"status"`
+	Days        map[string]float64 `json:"days"`
+	Transitions int                `json:"transitions"`
+}
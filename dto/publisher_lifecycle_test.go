@@ -0,0 +1,194 @@
+package dto
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m6yf/bcwork/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestCanTransitPublisherStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		from string
+		to   string
+		want bool
+	}{
+		{name: "onboardingToActive", from: PublisherStatusOnboarding, to: PublisherStatusActive, want: true},
+		{name: "activeToPaused", from: PublisherStatusActive, to: PublisherStatusPaused, want: true},
+		{name: "pausedToActive", from: PublisherStatusPaused, to: PublisherStatusActive, want: true},
+		{name: "pausedToChurned", from: PublisherStatusPaused, to: PublisherStatusChurned, want: true},
+		{name: "churnedToOnboarding", from: PublisherStatusChurned, to: PublisherStatusOnboarding, want: true},
+		{name: "sameStatus", from: PublisherStatusActive, to: PublisherStatusActive, want: true},
+		{name: "caseInsensitive", from: "Active", to: "Paused", want: true},
+		{name: "legacyStatus", from: "Live", to: PublisherStatusPaused, want: true},
+		{name: "emptyStatus", from: "", to: PublisherStatusOnboarding, want: true},
+		{name: "onboardingToPaused", from: PublisherStatusOnboarding, to: PublisherStatusPaused, want: false},
+		{name: "churnedToActive", from: PublisherStatusChurned, to: PublisherStatusActive, want: false},
+		{name: "unknownTarget", from: PublisherStatusActive, to: "Live", want: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, CanTransitPublisherStatus(tt.from, tt.to))
+		})
+	}
+}
+
+func TestScheduledPublisherStatus(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 5, 20, 12, 0, 0, 0, time.UTC)
+	ms := func(at time.Time) null.Int64 {
+		return null.Int64From(at.UnixMilli())
+	}
+
+	tests := []struct {
+		name string
+		mod  *models.Publisher
+		want string
+	}{
+		{
+			name: "startDue",
+			mod: &models.Publisher{
+				Status:         null.StringFrom(PublisherStatusOnboarding),
+				StartTimestamp: ms(now.Add(-time.Hour)),
+			},
+			want: PublisherStatusActive,
+		},
+		{
+			name: "startInFuture",
+			mod: &models.Publisher{
+				Status:         null.StringFrom(PublisherStatusOnboarding),
+				StartTimestamp: ms(now.Add(time.Hour)),
+			},
+			want: "",
+		},
+		{
+			name: "pauseDue",
+			mod: &models.Publisher{
+				Status:          null.StringFrom(PublisherStatusActive),
+				PauseTimestamp:  ms(now.Add(-time.Hour)),
+				StatusChangedAt: null.TimeFrom(now.AddDate(0, -1, 0)),
+			},
+			want: PublisherStatusPaused,
+		},
+		{
+			name: "pauseAppliedBeforeLastChange",
+			mod: &models.Publisher{
+				Status:          null.StringFrom(PublisherStatusActive),
+				PauseTimestamp:  ms(now.Add(-time.Hour)),
+				StatusChangedAt: null.TimeFrom(now.Add(-time.Minute)),
+			},
+			want: "",
+		},
+		{
+			name: "pauseOverByReactivation",
+			mod: &models.Publisher{
+				Status:              null.StringFrom(PublisherStatusActive),
+				PauseTimestamp:      ms(now.Add(-2 * time.Hour)),
+				ReactivateTimestamp: ms(now.Add(-time.Hour)),
+			},
+			want: "",
+		},
+		{
+			name: "reactivationDue",
+			mod: &models.Publisher{
+				Status:              null.StringFrom(PublisherStatusPaused),
+				PauseTimestamp:      ms(now.AddDate(0, 0, -7)),
+				ReactivateTimestamp: ms(now.Add(-time.Hour)),
+				StatusChangedAt:     null.TimeFrom(now.AddDate(0, 0, -7)),
+			},
+			want: PublisherStatusActive,
+		},
+		{
+			name: "churnedIgnored",
+			mod: &models.Publisher{
+				Status:              null.StringFrom(PublisherStatusChurned),
+				ReactivateTimestamp: ms(now.Add(-time.Hour)),
+			},
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, ScheduledPublisherStatus(tt.mod, now))
+		})
+	}
+}
+
+func TestInitialPublisherStatus(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 5, 20, 12, 0, 0, 0, time.UTC)
+	ms := func(at time.Time) null.Int64 {
+		return null.Int64From(at.UnixMilli())
+	}
+
+	tests := []struct {
+		name string
+		mod  *models.Publisher
+		want string
+	}{
+		{
+			name: "noDates",
+			mod:  &models.Publisher{},
+			want: PublisherStatusOnboarding,
+		},
+		{
+			name: "startInFuture",
+			mod:  &models.Publisher{StartTimestamp: ms(now.Add(time.Hour))},
+			want: PublisherStatusOnboarding,
+		},
+		{
+			name: "started",
+			mod:  &models.Publisher{StartTimestamp: ms(now.AddDate(0, -1, 0))},
+			want: PublisherStatusActive,
+		},
+		{
+			name: "paused",
+			mod: &models.Publisher{
+				StartTimestamp: ms(now.AddDate(0, -1, 0)),
+				PauseTimestamp: ms(now.Add(-time.Hour)),
+			},
+			want: PublisherStatusPaused,
+		},
+		{
+			name: "pauseInFuture",
+			mod: &models.Publisher{
+				StartTimestamp: ms(now.AddDate(0, -1, 0)),
+				PauseTimestamp: ms(now.Add(time.Hour)),
+			},
+			want: PublisherStatusActive,
+		},
+		{
+			name: "reactivatedAfterPause",
+			mod: &models.Publisher{
+				StartTimestamp:      ms(now.AddDate(0, -1, 0)),
+				PauseTimestamp:      ms(now.AddDate(0, 0, -7)),
+				ReactivateTimestamp: ms(now.Add(-time.Hour)),
+			},
+			want: PublisherStatusActive,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, InitialPublisherStatus(tt.mod, now))
+		})
+	}
+}
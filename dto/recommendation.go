@@ -0,0 +1,68 @@
+package dto
+
+const (
+	DefaultRecommendationLookbackDays    = 7
+	DefaultRecommendationMaxFillRateDrop = 0.1
+	DefaultRecommendationMinImpressions  = 1000
+	DefaultRecommendationElasticity      = 0.5
+)
+
+// Recommendation is a proposed floor and factor of publisher, domain, country and device
+// with performance of the lookback period and the one expected after applying it
+type Recommendation struct {
+	Publisher         string                `json:"publisher"`
+	Domain            string                `json:"domain"`
+	Country           string                `json:"country"`
+	Device            string                `json:"device"`
+	CurrentFloor      float64               `json:"current_floor"`
+	RecommendedFloor  float64               `json:"recommended_floor"`
+	CurrentFactor     *float64              `json:"current_factor"`
+	RecommendedFactor *float64              `json:"recommended_factor"`
+	Landscape         BidLandscape          `json:"landscape"`
+	Current           RecommendationOutcome `json:"current"`
+	Expected          RecommendationOutcome `json:"expected"`
+	Impact            RecommendationImpact  `json:"impact"`
+	Changed           bool                  `json:"changed"`
+	Reason            string                `json:"reason,omitempty"`
+}
+
+// BidLandscape summarizes bid prices (CPM) of segment weighted by bid responses
+type BidLandscape struct {
+	BidResponses   int64   `json:"bid_responses"`
+	DemandPartners int     `json:"demand_partners"`
+	P25            float64 `json:"p25"`
+	Median         float64 `json:"median"`
+	P75            float64 `json:"p75"`
+	P90            float64 `json:"p90"`
+}
+
+type RecommendationOutcome struct {
+	SoldImpressions float64 `json:"sold_impressions"`
+	FillRate        float64 `json:"fill_rate"`
+	Revenue         float64 `json:"revenue"`
+	Cost            float64 `json:"cost"`
+	GP              float64 `json:"gp"`
+	GPP             float64 `json:"gpp"`
+}
+
+// RecommendationImpact is the expected change over the lookback period
+type RecommendationImpact struct {
+	GP              float64 `json:"gp"`
+	Revenue         float64 `json:"revenue"`
+	SoldImpressions float64 `json:"sold_impressions"`
+	FillRate        float64 `json:"fill_rate"`
+}
+
+type RecommendationApplyRequest struct {
+	Items []RecommendationApplyItem `json:"items" validate:"required,min=1,dive"`
+}
+
+// RecommendationApplyItem is a recommendation to apply, floor and factor are optional to apply only one of them
+type RecommendationApplyItem struct {
+	Publisher string   `json:"publisher" validate:"required"`
+	Domain    string   `json:"domain" validate:"required"`
+	Country   string   `json:"country" validate:"country"`
+	Device    string   `json:"device" validate:"device"`
+	Floor     *float64 `json:"floor" validate:"omitempty,floor"`
+	Factor    *float64 `json:"factor" validate:"omitempty,factor"`
+}
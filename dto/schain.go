@@ -0,0 +1,67 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/m6yf/bcwork/models"
+)
+
+// statuses of seller listing in sellers.json
+const (
+	SchainStatusListed       = "listed"
+	SchainStatusConfidential = "confidential"
+	SchainStatusMissing      = "missing"
+	SchainStatusTypeMismatch = "type_mismatch"
+	SchainStatusUnavailable  = "unavailable"
+)
+
+// SchainCompliance is a result of supply chain check of ads.txt line of publisher:
+// demand partner sellers.json lists the account with the right type and our sellers.json lists the publisher
+type SchainCompliance struct {
+	ID              int       `json:"id"`
+	PublisherID     string    `json:"publisher_id"`
+	DemandPartnerID string    `json:"demand_partner_id"`
+	SeatOwnerID     *int      `json:"seat_owner_id,omitempty"`
+	PartnerName     string    `json:"partner_name"`
+	AdsTxtDomain    string    `json:"ads_txt_domain"`
+	SellerID        string    `json:"seller_id"`
+	Relationship    string    `json:"relationship"`
+	SellersJSONURL  string    `json:"sellers_json_url"`
+	DPStatus        string    `json:"dp_status"`
+	DPSellerType    string    `json:"dp_seller_type"`
+	OurStatus       string    `json:"our_status"`
+	IsCompliant     bool      `json:"is_compliant"`
+	ErrorMessage    string    `json:"error_message"`
+	CheckedAt       time.Time `json:"checked_at"`
+}
+
+func (s *SchainCompliance) FromModel(mod *models.SchainCompliance) {
+	s.ID = mod.ID
+	s.PublisherID = mod.PublisherID
+	s.DemandPartnerID = mod.DemandPartnerID.String
+	s.SeatOwnerID = mod.SeatOwnerID.Ptr()
+	s.PartnerName = mod.PartnerName
+	s.AdsTxtDomain = mod.AdsTXTDomain
+	s.SellerID = mod.SellerID
+	s.Relationship = mod.Relationship
+	s.SellersJSONURL = mod.SellersJSONURL.String
+	s.DPStatus = mod.DPStatus
+	s.DPSellerType = mod.DPSellerType.String
+	s.OurStatus = mod.OurStatus
+	s.IsCompliant = mod.IsCompliant
+	s.ErrorMessage = mod.ErrorMessage.String
+	s.CheckedAt = mod.CheckedAt
+}
+
+// SchainComplianceSummary is a compliance of demand partner or seat owner across publishers
+type SchainComplianceSummary struct {
+	DemandPartnerID string     `boil:"demand_partner_id" json:"demand_partner_id"`
+	PartnerName     string     `boil:"partner_name" json:"partner_name"`
+	Publishers      int        `boil:"publishers" json:"publishers"`
+	Compliant       int        `boil:"compliant" json:"compliant"`
+	DPMissing       int        `boil:"dp_missing" json:"dp_missing"`
+	DPTypeMismatch  int        `boil:"dp_type_mismatch" json:"dp_type_mismatch"`
+	DPUnavailable   int        `boil:"dp_unavailable" json:"dp_unavailable"`
+	OurMissing      int        `boil:"our_missing" json:"our_missing"`
+	CheckedAt       *time.Time `boil:"checked_at" json:"checked_at"`
+}
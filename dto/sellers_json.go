@@ -0,0 +1,61 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/m6yf/bcwork/models"
+)
+
+// SellersJSONVersion is a published copy of our sellers.json
+type SellersJSONVersion struct {
+	ID          int       `json:"id"`
+	Etag        string    `json:"etag"`
+	Sellers     int       `json:"sellers"`
+	Added       int       `json:"added"`
+	Removed     int       `json:"removed"`
+	Changed     int       `json:"changed"`
+	PublishedBy *int      `json:"published_by,omitempty"`
+	PublishedAt time.Time `json:"published_at"`
+}
+
+func (v *SellersJSONVersion) FromModel(mod *models.SellersJSONVersion) {
+	v.ID = mod.ID
+	v.Etag = mod.Etag
+	v.Sellers = mod.Sellers
+	v.Added = mod.Added
+	v.Removed = mod.Removed
+	v.Changed = mod.Changed
+	v.PublishedBy = mod.PublishedBy.Ptr()
+	v.PublishedAt = mod.PublishedAt
+}
+
+// SellersJSONDiff is a report of what changed in sellers.json since the previous version
+type SellersJSONDiff struct {
+	Version         int                        `json:"version"`
+	PreviousVersion int                        `json:"previous_version,omitempty"`
+	Added           []string                   `json:"added"`
+	Removed         []string                   `json:"removed"`
+	Changed         []*SellersJSONSellerChange `json:"changed"`
+}
+
+// SellersJSONSellerChange is a change of a seller field
+type SellersJSONSellerChange struct {
+	SellerID string `json:"seller_id"`
+	Field    string `json:"field"`
+	OldValue string `json:"old_value"`
+	NewValue string `json:"new_value"`
+}
+
+// SellersJSONSkippedSeller is a publisher left out of sellers.json as it can't be listed
+type SellersJSONSkippedSeller struct {
+	PublisherID string `json:"publisher_id"`
+	Reason      string `json:"reason"`
+}
+
+// SellersJSONPublishResponse is a result of sellers.json publishing
+type SellersJSONPublishResponse struct {
+	Version     *SellersJSONVersion         `json:"version"`
+	IsPublished bool                        `json:"is_published"` // false when nothing changed since the latest version
+	Diff        *SellersJSONDiff            `json:"diff"`
+	Skipped     []*SellersJSONSkippedSeller `json:"skipped"`
+}
@@ -100,3 +100,9 @@ func (u User) ToModel() *models.User {
 		CreatedAt:        time.Now(),
 	}
 }
+
+// UserPublishers are publishers which user with scoped role (Publisher, Consultant) can see and edit
+type UserPublishers struct {
+	UserID       int      `json:"user_id" validate:"required"`
+	PublisherIDs []string `json:"publisher_ids" validate:"dive,required"`
+}
@@ -4,14 +4,23 @@ import (
 	"github.com/m6yf/bcwork/workers/email_reports/rpm_decrease"
 	"github.com/m6yf/bcwork/workers/sellers/competitors"
 	"github.com/m6yf/bcwork/workers/sellers/missing_sellers"
+	"github.com/m6yf/bcwork/workers/sellers/schain"
 	"strings"
 
+	"github.com/m6yf/bcwork/workers/ads_txt_crawler"
+	"github.com/m6yf/bcwork/workers/audit_log"
+	"github.com/m6yf/bcwork/workers/blocks_expiry"
 	"github.com/m6yf/bcwork/workers/clean_history"
+	"github.com/m6yf/bcwork/workers/domain_onboarding"
+	"github.com/m6yf/bcwork/workers/dp_approval_reminder"
+	"github.com/m6yf/bcwork/workers/dp_scoring"
 	"github.com/m6yf/bcwork/workers/dpo"
 	"github.com/m6yf/bcwork/workers/email_reports/bid_cache_report"
 	"github.com/m6yf/bcwork/workers/email_reports/looping_ratio_decrease_alert"
 	"github.com/m6yf/bcwork/workers/email_reports/real_time_report"
 	"github.com/m6yf/bcwork/workers/metadata_clean"
+	"github.com/m6yf/bcwork/workers/price_override_expiry"
+	"github.com/m6yf/bcwork/workers/publisher_lifecycle"
 
 	"github.com/m6yf/bcwork/cmd"
 	"github.com/m6yf/bcwork/structs"
@@ -81,4 +90,13 @@ func register() {
 	structs.RegsiterName("rpm_decrease", rpm_decrease.Worker{})
 	structs.RegsiterName("nodpresponse", no_dp_response.Worker{})
 	structs.RegsiterName("missing_sellers", missing_sellers.Worker{})
+	structs.RegsiterName("blocks_expiry", blocks_expiry.Worker{})
+	structs.RegsiterName("price_override_expiry", price_override_expiry.Worker{})
+	structs.RegsiterName("ads_txt_crawler", ads_txt_crawler.Worker{})
+	structs.RegsiterName("schain", schain.Worker{})
+	structs.RegsiterName("audit_log", audit_log.Worker{})
+	structs.RegsiterName("publisher_lifecycle", publisher_lifecycle.Worker{})
+	structs.RegsiterName("domain_onboarding", domain_onboarding.Worker{})
+	structs.RegsiterName("dp_approval_reminder", dp_approval_reminder.Worker{})
+	structs.RegsiterName("dp_scoring", dp_scoring.Worker{})
 }
@@ -0,0 +1,51 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists approval_policy
+(
+    id serial primary key,
+    name varchar(128) not null,
+    subject varchar(64) not null,
+    min_change_percentage float8,
+    requester_roles varchar(64)[],
+    approver_roles varchar(64)[] not null,
+    notify_emails varchar(256)[],
+    notify_slack bool not null default false,
+    active bool not null default true,
+    created_at timestamp not null,
+    updated_at timestamp
+);
+
+create table if not exists change_request
+(
+    id serial primary key,
+    policy_id int not null,
+    subject varchar(64) not null,
+    route varchar(128) not null,
+    payload jsonb not null,
+    change_percentage float8 not null default 0,
+    status varchar(64) not null default 'pending',
+    requester_id int not null,
+    requester_role varchar(64) not null,
+    approver_id int,
+    comment text,
+    error_message text,
+    created_at timestamp not null,
+    updated_at timestamp,
+    decided_at timestamp
+);
+
+create index if not exists idx_change_request_status on change_request(status);
+
+alter table if exists history
+add column if not exists approver_id int;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table if exists history
+drop column if exists approver_id;
+
+drop index if exists idx_change_request_status;
+drop table if exists change_request;
+drop table if exists approval_policy;
+-- +goose StatementEnd
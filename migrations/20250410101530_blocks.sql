@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists blocks
+(
+    id serial primary key,
+    publisher varchar(64) not null,
+    domain varchar(256) not null default '',
+    type varchar(16) not null,
+    value varchar(256) not null,
+    reason text,
+    expires_at timestamp,
+    created_at timestamp not null,
+    updated_at timestamp
+);
+
+create unique index if not exists idx_blocks_unique on blocks(publisher, domain, type, value);
+create index if not exists idx_blocks_expires_at on blocks(expires_at) where expires_at is not null;
+
+-- latest published badv/bcat lists become the initial entries
+insert into blocks (publisher, domain, type, value, created_at)
+select split_part(mq.key, ':', 2), split_part(mq.key, ':', 3), split_part(mq.key, ':', 1), item.value, now()
+from metadata_queue mq
+join (select key, max(created_at) created_at from metadata_queue where key like 'bcat:%' or key like 'badv:%' group by key) last
+    on last.key = mq.key and last.created_at = mq.created_at
+cross join lateral jsonb_array_elements_text(
+    case when jsonb_typeof(mq.value::jsonb) = 'array' then mq.value::jsonb else '[]'::jsonb end
+) as item(value)
+where item.value <> ''
+on conflict do nothing;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists blocks;
+-- +goose StatementEnd
@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists price_override
+(
+    id serial primary key,
+    domain varchar(256) not null,
+    ip varchar(64) not null,
+    price float8 not null,
+    expires_at timestamp not null,
+    created_by int,
+    created_at timestamp not null,
+    updated_at timestamp
+);
+
+create unique index if not exists idx_price_override_domain_ip on price_override(domain, ip);
+create index if not exists idx_price_override_expires_at on price_override(expires_at);
+
+-- overrides which are still live under previous 8 hours rule become the initial entries
+insert into price_override (domain, ip, price, expires_at, created_at)
+select split_part(mq.key, ':', 3), item ->> 'ip', (item ->> 'price')::float8,
+    (item ->> 'date')::timestamptz at time zone 'utc' + interval '8 hours', now()
+from metadata_queue mq
+join (select key, max(created_at) created_at from metadata_queue where key like 'price:override:%' group by key) last
+    on last.key = mq.key and last.created_at = mq.created_at
+cross join lateral jsonb_array_elements(
+    case when jsonb_typeof(mq.value::jsonb) = 'array' then mq.value::jsonb else '[]'::jsonb end
+) as item
+where mq.created_at > now() - interval '8 hours'
+    and coalesce(item ->> 'ip', '') <> ''
+on conflict (domain, ip) do update set price = excluded.price, expires_at = excluded.expires_at;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists price_override;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table if exists publisher_domain add column if not exists automation_strategy varchar(64);
+alter table if exists publisher_domain add column if not exists automation_params jsonb;
+
+-- factor floor of blitz.gg was hard-coded in factors automation worker
+update publisher_domain set automation_params = '{"min_factor": 0.5}' where domain = 'blitz.gg';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table if exists publisher_domain drop column if exists automation_params;
+alter table if exists publisher_domain drop column if exists automation_strategy;
+-- +goose StatementEnd
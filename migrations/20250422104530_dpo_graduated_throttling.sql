@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table if exists dpo add column if not exists automation_config jsonb;
+alter table if exists dpo_automation_log add column if not exists reason varchar(32);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table if exists dpo_automation_log drop column if exists reason;
+alter table if exists dpo drop column if exists automation_config;
+-- +goose StatementEnd
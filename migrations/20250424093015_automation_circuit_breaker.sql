@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists automation_circuit_breaker
+(
+    id serial primary key,
+    automation varchar(32) not null,
+    tripped bool not null default false,
+    reason text,
+    tripped_at timestamp,
+    last_input_records int,
+    last_input_volume float8,
+    reset_by int,
+    reset_at timestamp,
+    created_at timestamp not null,
+    updated_at timestamp
+);
+
+create unique index if not exists idx_automation_circuit_breaker_automation on automation_circuit_breaker(automation);
+
+insert into automation_circuit_breaker (automation, created_at)
+values ('factor', now()), ('dpo', now())
+on conflict (automation) do nothing;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists automation_circuit_breaker;
+-- +goose StatementEnd
@@ -0,0 +1,101 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists alert_rule
+(
+    id serial primary key,
+    name varchar(128) not null,
+    description text,
+    source varchar(16) not null,
+    query text not null,
+    comparison varchar(16) not null,
+    threshold float8 not null,
+    window_minutes int not null,
+    baseline_offsets varchar(32)[],
+    severity varchar(16) not null,
+    channel varchar(16) not null,
+    recipients varchar(256)[],
+    cron varchar(64) not null,
+    active bool not null default true,
+    snoozed_until timestamp,
+    last_evaluated_at timestamp,
+    created_at timestamp not null,
+    updated_at timestamp
+);
+
+create unique index if not exists idx_alert_rule_name on alert_rule(name);
+
+create table if not exists alert_event
+(
+    id serial primary key,
+    rule_id int not null,
+    key varchar(256) not null,
+    status varchar(16) not null,
+    severity varchar(16) not null,
+    value float8 not null,
+    baseline float8,
+    first_seen_at timestamp not null,
+    last_seen_at timestamp not null,
+    resolved_at timestamp,
+    notified_at timestamp,
+    acknowledged_by int,
+    acknowledged_at timestamp,
+    snoozed_until timestamp,
+    created_at timestamp not null,
+    updated_at timestamp
+);
+
+-- only one firing event per rule and key, repeated breaches update it instead of alerting again
+create unique index if not exists idx_alert_event_firing on alert_event(rule_id, key) where status = 'firing';
+create index if not exists idx_alert_event_rule_id on alert_event(rule_id, first_seen_at);
+
+-- rules replacing rpm_decrease and looping_ratio_decrease email reports, disabled until the reports are retired
+insert into alert_rule (name, description, source, query, comparison, threshold, window_minutes, baseline_offsets, severity, channel, cron, active, created_at)
+values
+(
+    'rpm_decrease',
+    'RPM of domain dropped below 40% of both yesterday and the same day last week',
+    'postgres',
+    'select publisher_id || '':'' || domain as key,
+        sum(demand_total) / sum(publisher_impressions) * 1000 as value
+    from publisher_daily
+    where time >= $1 and time < $2
+    group by publisher_id, domain
+    having sum(publisher_impressions) >= 3000',
+    'below_ratio',
+    0.4,
+    1440,
+    '{24h,168h}',
+    'warning',
+    'email',
+    '0 12 * * *',
+    false,
+    now()
+),
+(
+    'looping_ratio_decrease',
+    'Looping ratio of domain dropped below 40% of both yesterday and the same day last week',
+    'postgres',
+    'select publisher_id || '':'' || domain as key,
+        (sum(demand_impressions) + sum(missed_opportunities))::float8 / sum(publisher_impressions) as value
+    from publisher_daily
+    where time >= $1 and time < $2
+    group by publisher_id, domain
+    having sum(publisher_impressions) >= 3000',
+    'below_ratio',
+    0.4,
+    1440,
+    '{24h,168h}',
+    'warning',
+    'email',
+    '0 12 * * *',
+    false,
+    now()
+)
+on conflict (name) do nothing;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists alert_event;
+drop table if exists alert_rule;
+-- +goose StatementEnd
@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table if exists ads_txt
+add column if not exists scan_result varchar(64);
+
+create table if not exists ads_txt_scan
+(
+    id serial primary key,
+    publisher_id varchar(64) not null references publisher(publisher_id),
+    domain varchar(256) not null,
+    file_name varchar(64) not null,
+    url varchar(512),
+    owner_domain varchar(256),
+    manager_domains varchar(256)[],
+    records int not null default 0,
+    found int not null default 0,
+    missing int not null default 0,
+    mismatch int not null default 0,
+    error_message varchar(512),
+    scanned_at timestamp not null,
+    created_at timestamp not null,
+    updated_at timestamp
+);
+
+create unique index if not exists ads_txt_scan_publisher_domain_file_idx on ads_txt_scan (publisher_id, domain, file_name);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists ads_txt_scan;
+
+alter table if exists ads_txt
+drop column if exists scan_result;
+-- +goose StatementEnd
@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table if exists publisher
+add column if not exists seller_domain varchar(256),
+add column if not exists seller_type varchar(16),
+add column if not exists is_confidential bool not null default false;
+
+create table if not exists sellers_json_version
+(
+    id serial primary key,
+    content text not null,
+    etag varchar(64) not null,
+    sellers int not null default 0,
+    added int not null default 0,
+    removed int not null default 0,
+    changed int not null default 0,
+    published_by int,
+    published_at timestamp not null,
+    created_at timestamp not null,
+    updated_at timestamp
+);
+
+create index if not exists sellers_json_version_published_at_idx on sellers_json_version (published_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists sellers_json_version;
+
+alter table if exists publisher
+drop column if exists seller_domain,
+drop column if exists seller_type,
+drop column if exists is_confidential;
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists schain_compliance
+(
+    id serial primary key,
+    publisher_id varchar(64) not null references publisher(publisher_id),
+    demand_partner_id varchar(64),
+    seat_owner_id int,
+    partner_name varchar(256) not null,
+    ads_txt_domain varchar(256) not null,
+    seller_id varchar(256) not null,
+    relationship varchar(16) not null,
+    sellers_json_url varchar(512),
+    dp_status varchar(32) not null,
+    dp_seller_type varchar(32),
+    our_status varchar(32) not null,
+    is_compliant bool not null default false,
+    error_message varchar(512),
+    checked_at timestamp not null,
+    created_at timestamp not null,
+    updated_at timestamp
+);
+
+create unique index if not exists schain_compliance_publisher_line_idx on schain_compliance (publisher_id, ads_txt_domain, seller_id);
+create index if not exists schain_compliance_demand_partner_idx on schain_compliance (demand_partner_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists schain_compliance;
+-- +goose StatementEnd
@@ -0,0 +1,48 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists role_permission
+(
+    id serial primary key,
+    role varchar(64) not null,
+    resource varchar(64) not null,
+    action varchar(16) not null,
+    created_at timestamp not null,
+    updated_at timestamp
+);
+
+create unique index if not exists role_permission_role_resource_action_idx on role_permission (role, resource, action);
+
+create table if not exists user_publisher
+(
+    id serial primary key,
+    user_id int not null references "user"(id),
+    publisher_id varchar(64) not null references publisher(publisher_id),
+    created_at timestamp not null,
+    updated_at timestamp
+);
+
+create unique index if not exists user_publisher_user_publisher_idx on user_publisher (user_id, publisher_id);
+
+insert into role_permission (role, resource, action, created_at)
+values
+    ('Developer', '*', '*', now()),
+    ('Admin', '*', '*', now()),
+    ('Supermember', '*', 'read', now()),
+    ('Supermember', '*', 'write', now()),
+    ('Supermember', '*', 'delete', now()),
+    ('Member', '*', 'read', now()),
+    ('Member', '*', 'write', now()),
+    ('Consultant', 'publisher', 'read', now()),
+    ('Consultant', 'publisher', 'write', now()),
+    ('Consultant', 'domain', 'read', now()),
+    ('Consultant', 'domain', 'write', now()),
+    ('Publisher', 'publisher', 'read', now()),
+    ('Publisher', 'domain', 'read', now())
+on conflict do nothing;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists user_publisher;
+drop table if exists role_permission;
+-- +goose StatementEnd
@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists api_key
+(
+    id serial primary key,
+    name varchar(128) not null,
+    key_prefix varchar(16) not null,
+    key_hash varchar(64) not null,
+    owner_id int not null references "user"(id),
+    scopes varchar(64)[] not null,
+    allowed_ips varchar(64)[],
+    expires_at timestamp,
+    last_used_at timestamp,
+    last_used_ip varchar(64),
+    revoked_at timestamp,
+    created_by int not null,
+    created_at timestamp not null,
+    updated_at timestamp
+);
+
+create unique index if not exists api_key_key_hash_idx on api_key (key_hash);
+create index if not exists api_key_owner_idx on api_key (owner_id);
+
+alter table if exists history
+add column if not exists api_key_id int;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table if exists history
+drop column if exists api_key_id;
+
+drop table if exists api_key;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists publisher_notification_preference
+(
+    id serial primary key,
+    publisher_id varchar(64) not null references publisher(publisher_id),
+    emails varchar(256)[] not null default '{}',
+    daily_report bool not null default false,
+    monthly_statement bool not null default true,
+    ads_txt_alerts bool not null default true,
+    created_at timestamp not null,
+    updated_at timestamp
+);
+
+create unique index if not exists publisher_notification_preference_publisher_idx on publisher_notification_preference (publisher_id);
+
+insert into role_permission (role, resource, action, created_at)
+values
+    ('Publisher', 'portal', 'read', now()),
+    ('Publisher', 'portal', 'write', now())
+on conflict do nothing;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+delete from role_permission where resource = 'portal' and role = 'Publisher';
+
+drop table if exists publisher_notification_preference;
+-- +goose StatementEnd
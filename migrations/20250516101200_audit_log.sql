@@ -0,0 +1,48 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists audit_log
+(
+    id serial,
+    request_id varchar(64),
+    user_id int,
+    role varchar(64),
+    api_key_id int,
+    method varchar(16) not null,
+    route varchar(256) not null,
+    path varchar(512) not null,
+    status int not null,
+    latency_ms int not null,
+    ip varchar(64),
+    request_hash varchar(64) not null,
+    request_body jsonb,
+    request_size int not null default 0,
+    created_at timestamp not null,
+    updated_at timestamp,
+    primary key (id, created_at)
+) partition by range (created_at);
+
+create index if not exists audit_log_user_id_idx on audit_log (user_id, created_at);
+create index if not exists audit_log_route_idx on audit_log (route, created_at);
+
+-- rows are kept in default partition only when monthly partition wasn't created in advance by audit_log worker
+create table if not exists audit_log_default partition of audit_log default;
+
+do $$
+declare
+    month_start date := date_trunc('month', now());
+begin
+    for i in 0..2 loop
+        execute format(
+            'create table if not exists audit_log_y%s partition of audit_log for values from (%L) to (%L)',
+            to_char(month_start + make_interval(months => i), 'YYYY"m"MM'),
+            month_start + make_interval(months => i),
+            month_start + make_interval(months => i + 1)
+        );
+    end loop;
+end $$;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists audit_log;
+-- +goose StatementEnd
@@ -0,0 +1,43 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table publisher add column if not exists status_changed_at timestamp;
+
+update publisher
+set status = lower(status), status_changed_at = now()
+where lower(status) in ('onboarding', 'active', 'paused', 'churned');
+
+create table if not exists publisher_status_transition
+(
+    id serial primary key,
+    publisher_id varchar(64) not null references publisher(publisher_id),
+    from_status varchar(64),
+    to_status varchar(64) not null,
+    source varchar(16) not null,
+    created_by int,
+    created_at timestamp not null,
+    updated_at timestamp
+);
+
+create index if not exists publisher_status_transition_publisher_idx on publisher_status_transition (publisher_id, created_at);
+
+create table if not exists publisher_paused_rule
+(
+    id serial primary key,
+    publisher_id varchar(64) not null references publisher(publisher_id),
+    rule_type varchar(16) not null,
+    rule_id varchar(36) not null,
+    created_at timestamp not null,
+    updated_at timestamp
+);
+
+create unique index if not exists publisher_paused_rule_rule_idx on publisher_paused_rule (rule_type, rule_id);
+create index if not exists publisher_paused_rule_publisher_idx on publisher_paused_rule (publisher_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists publisher_paused_rule;
+drop table if exists publisher_status_transition;
+
+alter table publisher drop column if exists status_changed_at;
+-- +goose StatementEnd
@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists compass_manager
+(
+    id serial primary key,
+    compass_id varchar(64) not null,
+    user_id int not null references "user"(id),
+    created_at timestamp not null,
+    updated_at timestamp
+);
+
+create unique index if not exists compass_manager_compass_id_idx on compass_manager (compass_id);
+
+create table if not exists publisher_compass_sync
+(
+    id serial primary key,
+    publisher_id varchar(64) not null references publisher(publisher_id),
+    status varchar(16) not null,
+    latest_timestamp bigint,
+    local_changed_at timestamp,
+    pulled_at timestamp,
+    pushed_at timestamp,
+    diffs jsonb,
+    compass_values jsonb,
+    error_message varchar(1024),
+    created_at timestamp not null,
+    updated_at timestamp
+);
+
+create unique index if not exists publisher_compass_sync_publisher_idx on publisher_compass_sync (publisher_id);
+create index if not exists publisher_compass_sync_status_idx on publisher_compass_sync (status);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists publisher_compass_sync;
+drop table if exists compass_manager;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists domain_onboarding
+(
+    id serial primary key,
+    publisher_id varchar(64) not null,
+    domain varchar(256) not null,
+    status varchar(16) not null,
+    checks jsonb,
+    attempts int not null default 0,
+    notified_status varchar(16),
+    last_checked_at timestamp,
+    created_at timestamp not null,
+    updated_at timestamp,
+    foreign key (domain, publisher_id) references publisher_domain (domain, publisher_id) on delete cascade
+);
+
+create unique index if not exists domain_onboarding_publisher_domain_idx on domain_onboarding (publisher_id, domain);
+create index if not exists domain_onboarding_status_idx on domain_onboarding (status);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists domain_onboarding;
+-- +goose StatementEnd
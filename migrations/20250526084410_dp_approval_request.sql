@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists dp_approval_request
+(
+    id serial primary key,
+    demand_partner_id varchar(64) not null references dpo (demand_partner_id) on delete cascade,
+    publisher_id varchar(64) not null,
+    domain varchar(256) not null,
+    status varchar(16) not null default 'not_sent',
+    sent_at timestamp,
+    reminders int not null default 0,
+    last_reminded_at timestamp,
+    decided_at timestamp,
+    decided_by int,
+    comment text,
+    created_at timestamp not null,
+    updated_at timestamp,
+    foreign key (domain, publisher_id) references publisher_domain (domain, publisher_id) on delete cascade
+);
+
+create unique index if not exists dp_approval_request_dp_publisher_domain_idx on dp_approval_request (demand_partner_id, publisher_id, domain);
+create index if not exists dp_approval_request_status_idx on dp_approval_request (status);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists dp_approval_request;
+-- +goose StatementEnd
@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists dp_bidder_schema
+(
+    id serial primary key,
+    demand_partner_id varchar(64) not null references dpo (demand_partner_id) on delete cascade,
+    schema jsonb not null,
+    created_at timestamp not null,
+    updated_at timestamp
+);
+
+create unique index if not exists dp_bidder_schema_demand_partner_idx on dp_bidder_schema (demand_partner_id);
+
+create table if not exists dp_bidder_param
+(
+    id serial primary key,
+    demand_partner_id varchar(64) not null references dpo (demand_partner_id) on delete cascade,
+    publisher_id varchar(64) not null references publisher (publisher_id) on delete cascade,
+    domain varchar(256) not null default '',
+    params jsonb not null,
+    created_at timestamp not null,
+    updated_at timestamp
+);
+
+create unique index if not exists dp_bidder_param_dp_publisher_domain_idx on dp_bidder_param (demand_partner_id, publisher_id, domain);
+create index if not exists dp_bidder_param_publisher_idx on dp_bidder_param (publisher_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists dp_bidder_param;
+drop table if exists dp_bidder_schema;
+-- +goose StatementEnd
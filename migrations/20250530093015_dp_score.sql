@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists dp_score
+(
+    id serial primary key,
+    demand_partner_id varchar(64) not null references dpo (demand_partner_id) on delete cascade,
+    country varchar(64) not null default '',
+    device_type varchar(64) not null default '',
+    score int not null,
+    components jsonb not null,
+    calculated_at timestamp not null,
+    created_at timestamp not null,
+    updated_at timestamp
+);
+
+create index if not exists dp_score_calculated_at_idx on dp_score (calculated_at);
+create index if not exists dp_score_demand_partner_calculated_at_idx on dp_score (demand_partner_id, calculated_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists dp_score;
+-- +goose StatementEnd
@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+-- publishers with null or legacy status get status of the lifecycle, so scheduled statuses are applied to them:
+-- legacy churn-like statuses become churned, otherwise status is taken from compass dates (unix milliseconds),
+-- unlike newly synced publishers existing ones without start date are considered active
+with backfilled as (
+    update publisher p
+    set status = case
+            when lower(trim(old.status)) in ('churn', 'inactive', 'terminated', 'closed') then 'churned'
+            when coalesce(old.pause_timestamp, 0) > coalesce(old.reactivate_timestamp, 0)
+                and old.pause_timestamp <= extract(epoch from now()) * 1000 then 'paused'
+            when coalesce(old.start_timestamp, 0) > extract(epoch from now()) * 1000 then 'onboarding'
+            else 'active'
+        end,
+        status_changed_at = now()
+    from publisher old
+    where old.publisher_id = p.publisher_id
+      and (old.status is null or lower(trim(old.status)) not in ('onboarding', 'active', 'paused', 'churned'))
+    returning p.publisher_id, old.status as from_status, p.status as to_status
+)
+insert into publisher_status_transition (publisher_id, from_status, to_status, source, created_at)
+select publisher_id, nullif(from_status, ''), to_status, 'migration', now()
+from backfilled;
+
+-- lifecycle statuses which differ only by case or spaces
+update publisher
+set status = lower(trim(status)), status_changed_at = coalesce(status_changed_at, now())
+where status <> lower(trim(status))
+  and lower(trim(status)) in ('onboarding', 'active', 'paused', 'churned');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- legacy statuses aren't restored, transitions keep them in from_status
+delete from publisher_status_transition where source = 'migration';
+-- +goose StatementEnd
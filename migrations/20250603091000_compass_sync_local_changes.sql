@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- columns changed locally and not pushed to compass yet with their values before the change
+alter table publisher_compass_sync add column if not exists local_changed_columns jsonb;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table publisher_compass_sync drop column if exists local_changed_columns;
+-- +goose StatementEnd
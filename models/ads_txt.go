@@ -40,6 +40,7 @@ type AdsTXT struct {
 	ErrorMessage              null.String `boil:"error_message" json:"error_message,omitempty" toml:"error_message" yaml:"error_message,omitempty"`
 	Retries                   null.Int    `boil:"retries" json:"retries,omitempty" toml:"retries" yaml:"retries,omitempty"`
 	ValidURL                  null.String `boil:"valid_url" json:"valid_url,omitempty" toml:"valid_url" yaml:"valid_url,omitempty"`
+	ScanResult                null.String `boil:"scan_result" json:"scan_result,omitempty" toml:"scan_result" yaml:"scan_result,omitempty"`
 
 	R *adsTXTR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L adsTXTL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -62,6 +63,7 @@ var AdsTXTColumns = struct {
 	ErrorMessage              string
 	Retries                   string
 	ValidURL                  string
+	ScanResult                string
 }{
 	ID:                        "id",
 	DemandPartnerConnectionID: "demand_partner_connection_id",
@@ -79,6 +81,7 @@ var AdsTXTColumns = struct {
 	ErrorMessage:              "error_message",
 	Retries:                   "retries",
 	ValidURL:                  "valid_url",
+	ScanResult:                "scan_result",
 }
 
 var AdsTXTTableColumns = struct {
@@ -98,6 +101,7 @@ var AdsTXTTableColumns = struct {
 	ErrorMessage              string
 	Retries                   string
 	ValidURL                  string
+	ScanResult                string
 }{
 	ID:                        "ads_txt.id",
 	DemandPartnerConnectionID: "ads_txt.demand_partner_connection_id",
@@ -115,6 +119,7 @@ var AdsTXTTableColumns = struct {
 	ErrorMessage:              "ads_txt.error_message",
 	Retries:                   "ads_txt.retries",
 	ValidURL:                  "ads_txt.valid_url",
+	ScanResult:                "ads_txt.scan_result",
 }
 
 // Generated where
@@ -319,6 +324,7 @@ var AdsTXTWhere = struct {
 	ErrorMessage              whereHelpernull_String
 	Retries                   whereHelpernull_Int
 	ValidURL                  whereHelpernull_String
+	ScanResult                whereHelpernull_String
 }{
 	ID:                        whereHelperint{field: "\"ads_txt\".\"id\""},
 	DemandPartnerConnectionID: whereHelpernull_Int{field: "\"ads_txt\".\"demand_partner_connection_id\""},
@@ -336,6 +342,7 @@ var AdsTXTWhere = struct {
 	ErrorMessage:              whereHelpernull_String{field: "\"ads_txt\".\"error_message\""},
 	Retries:                   whereHelpernull_Int{field: "\"ads_txt\".\"retries\""},
 	ValidURL:                  whereHelpernull_String{field: "\"ads_txt\".\"valid_url\""},
+	ScanResult:                whereHelpernull_String{field: "\"ads_txt\".\"scan_result\""},
 }
 
 // AdsTXTRels is where relationship names are stored.
@@ -396,9 +403,9 @@ func (r *adsTXTR) GetSeatOwner() *SeatOwner {
 type adsTXTL struct{}
 
 var (
-	adsTXTAllColumns            = []string{"id", "demand_partner_connection_id", "demand_partner_child_id", "seat_owner_id", "publisher_id", "domain", "status", "demand_status", "domain_status", "created_at", "updated_at", "status_changed_at", "last_scanned_at", "error_message", "retries", "valid_url"}
+	adsTXTAllColumns            = []string{"id", "demand_partner_connection_id", "demand_partner_child_id", "seat_owner_id", "publisher_id", "domain", "status", "demand_status", "domain_status", "created_at", "updated_at", "status_changed_at", "last_scanned_at", "error_message", "retries", "valid_url", "scan_result"}
 	adsTXTColumnsWithoutDefault = []string{"publisher_id", "domain", "created_at"}
-	adsTXTColumnsWithDefault    = []string{"id", "demand_partner_connection_id", "demand_partner_child_id", "seat_owner_id", "status", "demand_status", "domain_status", "updated_at", "status_changed_at", "last_scanned_at", "error_message", "retries", "valid_url"}
+	adsTXTColumnsWithDefault    = []string{"id", "demand_partner_connection_id", "demand_partner_child_id", "seat_owner_id", "status", "demand_status", "domain_status", "updated_at", "status_changed_at", "last_scanned_at", "error_message", "retries", "valid_url", "scan_result"}
 	adsTXTPrimaryKeyColumns     = []string{"id"}
 	adsTXTGeneratedColumns      = []string{}
 )
@@ -0,0 +1,1045 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// AdsTXTScan is an object representing the database table.
+type AdsTXTScan struct {
+	ID             int               `boil:"id" json:"id" toml:"id" yaml:"id"`
+	PublisherID    string            `boil:"publisher_id" json:"publisher_id" toml:"publisher_id" yaml:"publisher_id"`
+	Domain         string            `boil:"domain" json:"domain" toml:"domain" yaml:"domain"`
+	FileName       string            `boil:"file_name" json:"file_name" toml:"file_name" yaml:"file_name"`
+	URL            null.String       `boil:"url" json:"url,omitempty" toml:"url" yaml:"url,omitempty"`
+	OwnerDomain    null.String       `boil:"owner_domain" json:"owner_domain,omitempty" toml:"owner_domain" yaml:"owner_domain,omitempty"`
+	ManagerDomains types.StringArray `boil:"manager_domains" json:"manager_domains,omitempty" toml:"manager_domains" yaml:"manager_domains,omitempty"`
+	Records        int               `boil:"records" json:"records" toml:"records" yaml:"records"`
+	Found          int               `boil:"found" json:"found" toml:"found" yaml:"found"`
+	Missing        int               `boil:"missing" json:"missing" toml:"missing" yaml:"missing"`
+	Mismatch       int               `boil:"mismatch" json:"mismatch" toml:"mismatch" yaml:"mismatch"`
+	ErrorMessage   null.String       `boil:"error_message" json:"error_message,omitempty" toml:"error_message" yaml:"error_message,omitempty"`
+	ScannedAt      time.Time         `boil:"scanned_at" json:"scanned_at" toml:"scanned_at" yaml:"scanned_at"`
+	CreatedAt      time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt      null.Time         `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *adsTXTScanR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L adsTXTScanL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AdsTXTScanColumns = struct {
+	ID             string
+	PublisherID    string
+	Domain         string
+	FileName       string
+	URL            string
+	OwnerDomain    string
+	ManagerDomains string
+	Records        string
+	Found          string
+	Missing        string
+	Mismatch       string
+	ErrorMessage   string
+	ScannedAt      string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	ID:             "id",
+	PublisherID:    "publisher_id",
+	Domain:         "domain",
+	FileName:       "file_name",
+	URL:            "url",
+	OwnerDomain:    "owner_domain",
+	ManagerDomains: "manager_domains",
+	Records:        "records",
+	Found:          "found",
+	Missing:        "missing",
+	Mismatch:       "mismatch",
+	ErrorMessage:   "error_message",
+	ScannedAt:      "scanned_at",
+	CreatedAt:      "created_at",
+	UpdatedAt:      "updated_at",
+}
+
+var AdsTXTScanTableColumns = struct {
+	ID             string
+	PublisherID    string
+	Domain         string
+	FileName       string
+	URL            string
+	OwnerDomain    string
+	ManagerDomains string
+	Records        string
+	Found          string
+	Missing        string
+	Mismatch       string
+	ErrorMessage   string
+	ScannedAt      string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	ID:             "ads_txt_scan.id",
+	PublisherID:    "ads_txt_scan.publisher_id",
+	Domain:         "ads_txt_scan.domain",
+	FileName:       "ads_txt_scan.file_name",
+	URL:            "ads_txt_scan.url",
+	OwnerDomain:    "ads_txt_scan.owner_domain",
+	ManagerDomains: "ads_txt_scan.manager_domains",
+	Records:        "ads_txt_scan.records",
+	Found:          "ads_txt_scan.found",
+	Missing:        "ads_txt_scan.missing",
+	Mismatch:       "ads_txt_scan.mismatch",
+	ErrorMessage:   "ads_txt_scan.error_message",
+	ScannedAt:      "ads_txt_scan.scanned_at",
+	CreatedAt:      "ads_txt_scan.created_at",
+	UpdatedAt:      "ads_txt_scan.updated_at",
+}
+
+// Generated where
+
+var AdsTXTScanWhere = struct {
+	ID             whereHelperint
+	PublisherID    whereHelperstring
+	Domain         whereHelperstring
+	FileName       whereHelperstring
+	URL            whereHelpernull_String
+	OwnerDomain    whereHelpernull_String
+	ManagerDomains whereHelpertypes_StringArray
+	Records        whereHelperint
+	Found          whereHelperint
+	Missing        whereHelperint
+	Mismatch       whereHelperint
+	ErrorMessage   whereHelpernull_String
+	ScannedAt      whereHelpertime_Time
+	CreatedAt      whereHelpertime_Time
+	UpdatedAt      whereHelpernull_Time
+}{
+	ID:             whereHelperint{field: "\"ads_txt_scan\".\"id\""},
+	PublisherID:    whereHelperstring{field: "\"ads_txt_scan\".\"publisher_id\""},
+	Domain:         whereHelperstring{field: "\"ads_txt_scan\".\"domain\""},
+	FileName:       whereHelperstring{field: "\"ads_txt_scan\".\"file_name\""},
+	URL:            whereHelpernull_String{field: "\"ads_txt_scan\".\"url\""},
+	OwnerDomain:    whereHelpernull_String{field: "\"ads_txt_scan\".\"owner_domain\""},
+	ManagerDomains: whereHelpertypes_StringArray{field: "\"ads_txt_scan\".\"manager_domains\""},
+	Records:        whereHelperint{field: "\"ads_txt_scan\".\"records\""},
+	Found:          whereHelperint{field: "\"ads_txt_scan\".\"found\""},
+	Missing:        whereHelperint{field: "\"ads_txt_scan\".\"missing\""},
+	Mismatch:       whereHelperint{field: "\"ads_txt_scan\".\"mismatch\""},
+	ErrorMessage:   whereHelpernull_String{field: "\"ads_txt_scan\".\"error_message\""},
+	ScannedAt:      whereHelpertime_Time{field: "\"ads_txt_scan\".\"scanned_at\""},
+	CreatedAt:      whereHelpertime_Time{field: "\"ads_txt_scan\".\"created_at\""},
+	UpdatedAt:      whereHelpernull_Time{field: "\"ads_txt_scan\".\"updated_at\""},
+}
+
+// AdsTXTScanRels is where relationship names are stored.
+var AdsTXTScanRels = struct {
+}{}
+
+// adsTXTScanR is where relationships are stored.
+type adsTXTScanR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*adsTXTScanR) NewStruct() *adsTXTScanR {
+	return &adsTXTScanR{}
+}
+
+// adsTXTScanL is where Load methods for each relationship are stored.
+type adsTXTScanL struct{}
+
+var (
+	adsTXTScanAllColumns            = []string{"id", "publisher_id", "domain", "file_name", "url", "owner_domain", "manager_domains", "records", "found", "missing", "mismatch", "error_message", "scanned_at", "created_at", "updated_at"}
+	adsTXTScanColumnsWithoutDefault = []string{"publisher_id", "domain", "file_name", "scanned_at", "created_at"}
+	adsTXTScanColumnsWithDefault    = []string{"id", "url", "owner_domain", "manager_domains", "records", "found", "missing", "mismatch", "error_message", "updated_at"}
+	adsTXTScanPrimaryKeyColumns     = []string{"id"}
+	adsTXTScanGeneratedColumns      = []string{}
+)
+
+type (
+	// AdsTXTScanSlice is an alias for a slice of pointers to AdsTXTScan.
+	// This should almost always be used instead of []AdsTXTScan.
+	AdsTXTScanSlice []*AdsTXTScan
+	// AdsTXTScanHook is the signature for custom AdsTXTScan hook methods
+	AdsTXTScanHook func(context.Context, boil.ContextExecutor, *AdsTXTScan) error
+
+	adsTXTScanQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	adsTXTScanType                 = reflect.TypeOf(&AdsTXTScan{})
+	adsTXTScanMapping              = queries.MakeStructMapping(adsTXTScanType)
+	adsTXTScanPrimaryKeyMapping, _ = queries.BindMapping(adsTXTScanType, adsTXTScanMapping, adsTXTScanPrimaryKeyColumns)
+	adsTXTScanInsertCacheMut       sync.RWMutex
+	adsTXTScanInsertCache          = make(map[string]insertCache)
+	adsTXTScanUpdateCacheMut       sync.RWMutex
+	adsTXTScanUpdateCache          = make(map[string]updateCache)
+	adsTXTScanUpsertCacheMut       sync.RWMutex
+	adsTXTScanUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var adsTXTScanAfterSelectMu sync.Mutex
+var adsTXTScanAfterSelectHooks []AdsTXTScanHook
+
+var adsTXTScanBeforeInsertMu sync.Mutex
+var adsTXTScanBeforeInsertHooks []AdsTXTScanHook
+var adsTXTScanAfterInsertMu sync.Mutex
+var adsTXTScanAfterInsertHooks []AdsTXTScanHook
+
+var adsTXTScanBeforeUpdateMu sync.Mutex
+var adsTXTScanBeforeUpdateHooks []AdsTXTScanHook
+var adsTXTScanAfterUpdateMu sync.Mutex
+var adsTXTScanAfterUpdateHooks []AdsTXTScanHook
+
+var adsTXTScanBeforeDeleteMu sync.Mutex
+var adsTXTScanBeforeDeleteHooks []AdsTXTScanHook
+var adsTXTScanAfterDeleteMu sync.Mutex
+var adsTXTScanAfterDeleteHooks []AdsTXTScanHook
+
+var adsTXTScanBeforeUpsertMu sync.Mutex
+var adsTXTScanBeforeUpsertHooks []AdsTXTScanHook
+var adsTXTScanAfterUpsertMu sync.Mutex
+var adsTXTScanAfterUpsertHooks []AdsTXTScanHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *AdsTXTScan) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range adsTXTScanAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *AdsTXTScan) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range adsTXTScanBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *AdsTXTScan) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range adsTXTScanAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *AdsTXTScan) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range adsTXTScanBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *AdsTXTScan) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range adsTXTScanAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *AdsTXTScan) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range adsTXTScanBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *AdsTXTScan) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range adsTXTScanAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *AdsTXTScan) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range adsTXTScanBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *AdsTXTScan) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range adsTXTScanAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddAdsTXTScanHook registers your hook function for all future operations.
+func AddAdsTXTScanHook(hookPoint boil.HookPoint, adsTXTScanHook AdsTXTScanHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		adsTXTScanAfterSelectMu.Lock()
+		adsTXTScanAfterSelectHooks = append(adsTXTScanAfterSelectHooks, adsTXTScanHook)
+		adsTXTScanAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		adsTXTScanBeforeInsertMu.Lock()
+		adsTXTScanBeforeInsertHooks = append(adsTXTScanBeforeInsertHooks, adsTXTScanHook)
+		adsTXTScanBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		adsTXTScanAfterInsertMu.Lock()
+		adsTXTScanAfterInsertHooks = append(adsTXTScanAfterInsertHooks, adsTXTScanHook)
+		adsTXTScanAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		adsTXTScanBeforeUpdateMu.Lock()
+		adsTXTScanBeforeUpdateHooks = append(adsTXTScanBeforeUpdateHooks, adsTXTScanHook)
+		adsTXTScanBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		adsTXTScanAfterUpdateMu.Lock()
+		adsTXTScanAfterUpdateHooks = append(adsTXTScanAfterUpdateHooks, adsTXTScanHook)
+		adsTXTScanAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		adsTXTScanBeforeDeleteMu.Lock()
+		adsTXTScanBeforeDeleteHooks = append(adsTXTScanBeforeDeleteHooks, adsTXTScanHook)
+		adsTXTScanBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		adsTXTScanAfterDeleteMu.Lock()
+		adsTXTScanAfterDeleteHooks = append(adsTXTScanAfterDeleteHooks, adsTXTScanHook)
+		adsTXTScanAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		adsTXTScanBeforeUpsertMu.Lock()
+		adsTXTScanBeforeUpsertHooks = append(adsTXTScanBeforeUpsertHooks, adsTXTScanHook)
+		adsTXTScanBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		adsTXTScanAfterUpsertMu.Lock()
+		adsTXTScanAfterUpsertHooks = append(adsTXTScanAfterUpsertHooks, adsTXTScanHook)
+		adsTXTScanAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single adsTXTScan record from the query.
+func (q adsTXTScanQuery) One(ctx context.Context, exec boil.ContextExecutor) (*AdsTXTScan, error) {
+	o := &AdsTXTScan{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for ads_txt_scan")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all AdsTXTScan records from the query.
+func (q adsTXTScanQuery) All(ctx context.Context, exec boil.ContextExecutor) (AdsTXTScanSlice, error) {
+	var o []*AdsTXTScan
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to AdsTXTScan slice")
+	}
+
+	if len(adsTXTScanAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all AdsTXTScan records in the query.
+func (q adsTXTScanQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count ads_txt_scan rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q adsTXTScanQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if ads_txt_scan exists")
+	}
+
+	return count > 0, nil
+}
+
+// AdsTXTScans retrieves all the records using an executor.
+func AdsTXTScans(mods ...qm.QueryMod) adsTXTScanQuery {
+	mods = append(mods, qm.From("\"ads_txt_scan\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"ads_txt_scan\".*"})
+	}
+
+	return adsTXTScanQuery{q}
+}
+
+// FindAdsTXTScan retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAdsTXTScan(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*AdsTXTScan, error) {
+	adsTXTScanObj := &AdsTXTScan{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"ads_txt_scan\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, adsTXTScanObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from ads_txt_scan")
+	}
+
+	if err = adsTXTScanObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return adsTXTScanObj, err
+	}
+
+	return adsTXTScanObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *AdsTXTScan) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no ads_txt_scan provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(adsTXTScanColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	adsTXTScanInsertCacheMut.RLock()
+	cache, cached := adsTXTScanInsertCache[key]
+	adsTXTScanInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			adsTXTScanAllColumns,
+			adsTXTScanColumnsWithDefault,
+			adsTXTScanColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(adsTXTScanType, adsTXTScanMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(adsTXTScanType, adsTXTScanMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"ads_txt_scan\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"ads_txt_scan\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into ads_txt_scan")
+	}
+
+	if !cached {
+		adsTXTScanInsertCacheMut.Lock()
+		adsTXTScanInsertCache[key] = cache
+		adsTXTScanInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the AdsTXTScan.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *AdsTXTScan) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	adsTXTScanUpdateCacheMut.RLock()
+	cache, cached := adsTXTScanUpdateCache[key]
+	adsTXTScanUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			adsTXTScanAllColumns,
+			adsTXTScanPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update ads_txt_scan, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"ads_txt_scan\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, adsTXTScanPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(adsTXTScanType, adsTXTScanMapping, append(wl, adsTXTScanPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update ads_txt_scan row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for ads_txt_scan")
+	}
+
+	if !cached {
+		adsTXTScanUpdateCacheMut.Lock()
+		adsTXTScanUpdateCache[key] = cache
+		adsTXTScanUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q adsTXTScanQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for ads_txt_scan")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for ads_txt_scan")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AdsTXTScanSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), adsTXTScanPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"ads_txt_scan\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, adsTXTScanPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in adsTXTScan slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all adsTXTScan")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *AdsTXTScan) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no ads_txt_scan provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(adsTXTScanColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	adsTXTScanUpsertCacheMut.RLock()
+	cache, cached := adsTXTScanUpsertCache[key]
+	adsTXTScanUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			adsTXTScanAllColumns,
+			adsTXTScanColumnsWithDefault,
+			adsTXTScanColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			adsTXTScanAllColumns,
+			adsTXTScanPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert ads_txt_scan, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(adsTXTScanAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(adsTXTScanPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert ads_txt_scan, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(adsTXTScanPrimaryKeyColumns))
+			copy(conflict, adsTXTScanPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"ads_txt_scan\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(adsTXTScanType, adsTXTScanMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(adsTXTScanType, adsTXTScanMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert ads_txt_scan")
+	}
+
+	if !cached {
+		adsTXTScanUpsertCacheMut.Lock()
+		adsTXTScanUpsertCache[key] = cache
+		adsTXTScanUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single AdsTXTScan record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *AdsTXTScan) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no AdsTXTScan provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), adsTXTScanPrimaryKeyMapping)
+	sql := "DELETE FROM \"ads_txt_scan\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from ads_txt_scan")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for ads_txt_scan")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q adsTXTScanQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no adsTXTScanQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from ads_txt_scan")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for ads_txt_scan")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AdsTXTScanSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(adsTXTScanBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), adsTXTScanPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"ads_txt_scan\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, adsTXTScanPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from adsTXTScan slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for ads_txt_scan")
+	}
+
+	if len(adsTXTScanAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *AdsTXTScan) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindAdsTXTScan(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AdsTXTScanSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AdsTXTScanSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), adsTXTScanPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"ads_txt_scan\".* FROM \"ads_txt_scan\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, adsTXTScanPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in AdsTXTScanSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AdsTXTScanExists checks if the AdsTXTScan row exists.
+func AdsTXTScanExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"ads_txt_scan\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if ads_txt_scan exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the AdsTXTScan row exists.
+func (o *AdsTXTScan) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return AdsTXTScanExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testAdsTXTScans(t *testing.T) {
+	t.Parallel()
+
+	query := AdsTXTScans()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testAdsTXTScansDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AdsTXTScan{}
+	if err = randomize.Struct(seed, o, adsTXTScanDBTypes, true, adsTXTScanColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AdsTXTScan struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AdsTXTScans().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAdsTXTScansQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AdsTXTScan{}
+	if err = randomize.Struct(seed, o, adsTXTScanDBTypes, true, adsTXTScanColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AdsTXTScan struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := AdsTXTScans().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AdsTXTScans().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAdsTXTScansSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AdsTXTScan{}
+	if err = randomize.Struct(seed, o, adsTXTScanDBTypes, true, adsTXTScanColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AdsTXTScan struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := AdsTXTScanSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AdsTXTScans().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAdsTXTScansExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AdsTXTScan{}
+	if err = randomize.Struct(seed, o, adsTXTScanDBTypes, true, adsTXTScanColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AdsTXTScan struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := AdsTXTScanExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if AdsTXTScan exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected AdsTXTScanExists to return true, but got false.")
+	}
+}
+
+func testAdsTXTScansFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AdsTXTScan{}
+	if err = randomize.Struct(seed, o, adsTXTScanDBTypes, true, adsTXTScanColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AdsTXTScan struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	adsTXTScanFound, err := FindAdsTXTScan(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if adsTXTScanFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testAdsTXTScansBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AdsTXTScan{}
+	if err = randomize.Struct(seed, o, adsTXTScanDBTypes, true, adsTXTScanColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AdsTXTScan struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = AdsTXTScans().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAdsTXTScansOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AdsTXTScan{}
+	if err = randomize.Struct(seed, o, adsTXTScanDBTypes, true, adsTXTScanColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AdsTXTScan struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := AdsTXTScans().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testAdsTXTScansAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	adsTXTScanOne := &AdsTXTScan{}
+	adsTXTScanTwo := &AdsTXTScan{}
+	if err = randomize.Struct(seed, adsTXTScanOne, adsTXTScanDBTypes, false, adsTXTScanColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AdsTXTScan struct: %s", err)
+	}
+	if err = randomize.Struct(seed, adsTXTScanTwo, adsTXTScanDBTypes, false, adsTXTScanColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AdsTXTScan struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = adsTXTScanOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = adsTXTScanTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := AdsTXTScans().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testAdsTXTScansCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	adsTXTScanOne := &AdsTXTScan{}
+	adsTXTScanTwo := &AdsTXTScan{}
+	if err = randomize.Struct(seed, adsTXTScanOne, adsTXTScanDBTypes, false, adsTXTScanColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AdsTXTScan struct: %s", err)
+	}
+	if err = randomize.Struct(seed, adsTXTScanTwo, adsTXTScanDBTypes, false, adsTXTScanColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AdsTXTScan struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = adsTXTScanOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = adsTXTScanTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AdsTXTScans().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func adsTXTScanBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *AdsTXTScan) error {
+	*o = AdsTXTScan{}
+	return nil
+}
+
+func adsTXTScanAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *AdsTXTScan) error {
+	*o = AdsTXTScan{}
+	return nil
+}
+
+func adsTXTScanAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *AdsTXTScan) error {
+	*o = AdsTXTScan{}
+	return nil
+}
+
+func adsTXTScanBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *AdsTXTScan) error {
+	*o = AdsTXTScan{}
+	return nil
+}
+
+func adsTXTScanAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *AdsTXTScan) error {
+	*o = AdsTXTScan{}
+	return nil
+}
+
+func adsTXTScanBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *AdsTXTScan) error {
+	*o = AdsTXTScan{}
+	return nil
+}
+
+func adsTXTScanAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *AdsTXTScan) error {
+	*o = AdsTXTScan{}
+	return nil
+}
+
+func adsTXTScanBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *AdsTXTScan) error {
+	*o = AdsTXTScan{}
+	return nil
+}
+
+func adsTXTScanAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *AdsTXTScan) error {
+	*o = AdsTXTScan{}
+	return nil
+}
+
+func testAdsTXTScansHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &AdsTXTScan{}
+	o := &AdsTXTScan{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, adsTXTScanDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize AdsTXTScan object: %s", err)
+	}
+
+	AddAdsTXTScanHook(boil.BeforeInsertHook, adsTXTScanBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	adsTXTScanBeforeInsertHooks = []AdsTXTScanHook{}
+
+	AddAdsTXTScanHook(boil.AfterInsertHook, adsTXTScanAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	adsTXTScanAfterInsertHooks = []AdsTXTScanHook{}
+
+	AddAdsTXTScanHook(boil.AfterSelectHook, adsTXTScanAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	adsTXTScanAfterSelectHooks = []AdsTXTScanHook{}
+
+	AddAdsTXTScanHook(boil.BeforeUpdateHook, adsTXTScanBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	adsTXTScanBeforeUpdateHooks = []AdsTXTScanHook{}
+
+	AddAdsTXTScanHook(boil.AfterUpdateHook, adsTXTScanAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	adsTXTScanAfterUpdateHooks = []AdsTXTScanHook{}
+
+	AddAdsTXTScanHook(boil.BeforeDeleteHook, adsTXTScanBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	adsTXTScanBeforeDeleteHooks = []AdsTXTScanHook{}
+
+	AddAdsTXTScanHook(boil.AfterDeleteHook, adsTXTScanAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	adsTXTScanAfterDeleteHooks = []AdsTXTScanHook{}
+
+	AddAdsTXTScanHook(boil.BeforeUpsertHook, adsTXTScanBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	adsTXTScanBeforeUpsertHooks = []AdsTXTScanHook{}
+
+	AddAdsTXTScanHook(boil.AfterUpsertHook, adsTXTScanAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	adsTXTScanAfterUpsertHooks = []AdsTXTScanHook{}
+}
+
+func testAdsTXTScansInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AdsTXTScan{}
+	if err = randomize.Struct(seed, o, adsTXTScanDBTypes, true, adsTXTScanColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AdsTXTScan struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AdsTXTScans().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testAdsTXTScansInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AdsTXTScan{}
+	if err = randomize.Struct(seed, o, adsTXTScanDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize AdsTXTScan struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(adsTXTScanColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AdsTXTScans().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testAdsTXTScansReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AdsTXTScan{}
+	if err = randomize.Struct(seed, o, adsTXTScanDBTypes, true, adsTXTScanColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AdsTXTScan struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAdsTXTScansReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AdsTXTScan{}
+	if err = randomize.Struct(seed, o, adsTXTScanDBTypes, true, adsTXTScanColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AdsTXTScan struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := AdsTXTScanSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAdsTXTScansSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AdsTXTScan{}
+	if err = randomize.Struct(seed, o, adsTXTScanDBTypes, true, adsTXTScanColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AdsTXTScan struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := AdsTXTScans().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	adsTXTScanDBTypes = map[string]string{`ID`: `integer`, `PublisherID`: `character varying`, `Domain`: `character varying`, `FileName`: `character varying`, `URL`: `character varying`, `OwnerDomain`: `character varying`, `ManagerDomains`: `ARRAYcharacter varying`, `Records`: `integer`, `Found`: `integer`, `Missing`: `integer`, `Mismatch`: `integer`, `ErrorMessage`: `character varying`, `ScannedAt`: `timestamp without time zone`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                 = bytes.MinRead
+)
+
+func testAdsTXTScansUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(adsTXTScanPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(adsTXTScanAllColumns) == len(adsTXTScanPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AdsTXTScan{}
+	if err = randomize.Struct(seed, o, adsTXTScanDBTypes, true, adsTXTScanColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AdsTXTScan struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AdsTXTScans().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, adsTXTScanDBTypes, true, adsTXTScanPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AdsTXTScan struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testAdsTXTScansSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(adsTXTScanAllColumns) == len(adsTXTScanPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AdsTXTScan{}
+	if err = randomize.Struct(seed, o, adsTXTScanDBTypes, true, adsTXTScanColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AdsTXTScan struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AdsTXTScans().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, adsTXTScanDBTypes, true, adsTXTScanPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AdsTXTScan struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(adsTXTScanAllColumns, adsTXTScanPrimaryKeyColumns) {
+		fields = adsTXTScanAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			adsTXTScanAllColumns,
+			adsTXTScanPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := AdsTXTScanSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testAdsTXTScansUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(adsTXTScanAllColumns) == len(adsTXTScanPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := AdsTXTScan{}
+	if err = randomize.Struct(seed, &o, adsTXTScanDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize AdsTXTScan struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert AdsTXTScan: %s", err)
+	}
+
+	count, err := AdsTXTScans().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, adsTXTScanDBTypes, false, adsTXTScanPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AdsTXTScan struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert AdsTXTScan: %s", err)
+	}
+
+	count, err = AdsTXTScans().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -1196,7 +1196,7 @@ func testAdsTXTSSelect(t *testing.T) {
 }
 
 var (
-	adsTXTDBTypes = map[string]string{`ID`: `integer`, `DemandPartnerConnectionID`: `integer`, `DemandPartnerChildID`: `integer`, `SeatOwnerID`: `integer`, `PublisherID`: `character varying`, `Domain`: `character varying`, `Status`: `character varying`, `DemandStatus`: `character varying`, `DomainStatus`: `character varying`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`, `StatusChangedAt`: `timestamp without time zone`, `LastScannedAt`: `timestamp without time zone`, `ErrorMessage`: `character varying`, `Retries`: `integer`, `ValidURL`: `character varying`}
+	adsTXTDBTypes = map[string]string{`ID`: `integer`, `DemandPartnerConnectionID`: `integer`, `DemandPartnerChildID`: `integer`, `SeatOwnerID`: `integer`, `PublisherID`: `character varying`, `Domain`: `character varying`, `Status`: `character varying`, `DemandStatus`: `character varying`, `DomainStatus`: `character varying`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`, `StatusChangedAt`: `timestamp without time zone`, `LastScannedAt`: `timestamp without time zone`, `ErrorMessage`: `character varying`, `Retries`: `integer`, `ValidURL`: `character varying`, `ScanResult`: `character varying`}
 	_             = bytes.MinRead
 )
 
@@ -0,0 +1,1051 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// AlertEvent is an object representing the database table.
+type AlertEvent struct {
+	ID             int          `boil:"id" json:"id" toml:"id" yaml:"id"`
+	RuleID         int          `boil:"rule_id" json:"rule_id" toml:"rule_id" yaml:"rule_id"`
+	Key            string       `boil:"key" json:"key" toml:"key" yaml:"key"`
+	Status         string       `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Severity       string       `boil:"severity" json:"severity" toml:"severity" yaml:"severity"`
+	Value          float64      `boil:"value" json:"value" toml:"value" yaml:"value"`
+	Baseline       null.Float64 `boil:"baseline" json:"baseline,omitempty" toml:"baseline" yaml:"baseline,omitempty"`
+	FirstSeenAt    time.Time    `boil:"first_seen_at" json:"first_seen_at" toml:"first_seen_at" yaml:"first_seen_at"`
+	LastSeenAt     time.Time    `boil:"last_seen_at" json:"last_seen_at" toml:"last_seen_at" yaml:"last_seen_at"`
+	ResolvedAt     null.Time    `boil:"resolved_at" json:"resolved_at,omitempty" toml:"resolved_at" yaml:"resolved_at,omitempty"`
+	NotifiedAt     null.Time    `boil:"notified_at" json:"notified_at,omitempty" toml:"notified_at" yaml:"notified_at,omitempty"`
+	AcknowledgedBy null.Int     `boil:"acknowledged_by" json:"acknowledged_by,omitempty" toml:"acknowledged_by" yaml:"acknowledged_by,omitempty"`
+	AcknowledgedAt null.Time    `boil:"acknowledged_at" json:"acknowledged_at,omitempty" toml:"acknowledged_at" yaml:"acknowledged_at,omitempty"`
+	SnoozedUntil   null.Time    `boil:"snoozed_until" json:"snoozed_until,omitempty" toml:"snoozed_until" yaml:"snoozed_until,omitempty"`
+	CreatedAt      time.Time    `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt      null.Time    `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *alertEventR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L alertEventL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AlertEventColumns = struct {
+	ID             string
+	RuleID         string
+	Key            string
+	Status         string
+	Severity       string
+	Value          string
+	Baseline       string
+	FirstSeenAt    string
+	LastSeenAt     string
+	ResolvedAt     string
+	NotifiedAt     string
+	AcknowledgedBy string
+	AcknowledgedAt string
+	SnoozedUntil   string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	ID:             "id",
+	RuleID:         "rule_id",
+	Key:            "key",
+	Status:         "status",
+	Severity:       "severity",
+	Value:          "value",
+	Baseline:       "baseline",
+	FirstSeenAt:    "first_seen_at",
+	LastSeenAt:     "last_seen_at",
+	ResolvedAt:     "resolved_at",
+	NotifiedAt:     "notified_at",
+	AcknowledgedBy: "acknowledged_by",
+	AcknowledgedAt: "acknowledged_at",
+	SnoozedUntil:   "snoozed_until",
+	CreatedAt:      "created_at",
+	UpdatedAt:      "updated_at",
+}
+
+var AlertEventTableColumns = struct {
+	ID             string
+	RuleID         string
+	Key            string
+	Status         string
+	Severity       string
+	Value          string
+	Baseline       string
+	FirstSeenAt    string
+	LastSeenAt     string
+	ResolvedAt     string
+	NotifiedAt     string
+	AcknowledgedBy string
+	AcknowledgedAt string
+	SnoozedUntil   string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	ID:             "alert_event.id",
+	RuleID:         "alert_event.rule_id",
+	Key:            "alert_event.key",
+	Status:         "alert_event.status",
+	Severity:       "alert_event.severity",
+	Value:          "alert_event.value",
+	Baseline:       "alert_event.baseline",
+	FirstSeenAt:    "alert_event.first_seen_at",
+	LastSeenAt:     "alert_event.last_seen_at",
+	ResolvedAt:     "alert_event.resolved_at",
+	NotifiedAt:     "alert_event.notified_at",
+	AcknowledgedBy: "alert_event.acknowledged_by",
+	AcknowledgedAt: "alert_event.acknowledged_at",
+	SnoozedUntil:   "alert_event.snoozed_until",
+	CreatedAt:      "alert_event.created_at",
+	UpdatedAt:      "alert_event.updated_at",
+}
+
+// Generated where
+
+var AlertEventWhere = struct {
+	ID             whereHelperint
+	RuleID         whereHelperint
+	Key            whereHelperstring
+	Status         whereHelperstring
+	Severity       whereHelperstring
+	Value          whereHelperfloat64
+	Baseline       whereHelpernull_Float64
+	FirstSeenAt    whereHelpertime_Time
+	LastSeenAt     whereHelpertime_Time
+	ResolvedAt     whereHelpernull_Time
+	NotifiedAt     whereHelpernull_Time
+	AcknowledgedBy whereHelpernull_Int
+	AcknowledgedAt whereHelpernull_Time
+	SnoozedUntil   whereHelpernull_Time
+	CreatedAt      whereHelpertime_Time
+	UpdatedAt      whereHelpernull_Time
+}{
+	ID:             whereHelperint{field: "\"alert_event\".\"id\""},
+	RuleID:         whereHelperint{field: "\"alert_event\".\"rule_id\""},
+	Key:            whereHelperstring{field: "\"alert_event\".\"key\""},
+	Status:         whereHelperstring{field: "\"alert_event\".\"status\""},
+	Severity:       whereHelperstring{field: "\"alert_event\".\"severity\""},
+	Value:          whereHelperfloat64{field: "\"alert_event\".\"value\""},
+	Baseline:       whereHelpernull_Float64{field: "\"alert_event\".\"baseline\""},
+	FirstSeenAt:    whereHelpertime_Time{field: "\"alert_event\".\"first_seen_at\""},
+	LastSeenAt:     whereHelpertime_Time{field: "\"alert_event\".\"last_seen_at\""},
+	ResolvedAt:     whereHelpernull_Time{field: "\"alert_event\".\"resolved_at\""},
+	NotifiedAt:     whereHelpernull_Time{field: "\"alert_event\".\"notified_at\""},
+	AcknowledgedBy: whereHelpernull_Int{field: "\"alert_event\".\"acknowledged_by\""},
+	AcknowledgedAt: whereHelpernull_Time{field: "\"alert_event\".\"acknowledged_at\""},
+	SnoozedUntil:   whereHelpernull_Time{field: "\"alert_event\".\"snoozed_until\""},
+	CreatedAt:      whereHelpertime_Time{field: "\"alert_event\".\"created_at\""},
+	UpdatedAt:      whereHelpernull_Time{field: "\"alert_event\".\"updated_at\""},
+}
+
+// AlertEventRels is where relationship names are stored.
+var AlertEventRels = struct {
+}{}
+
+// alertEventR is where relationships are stored.
+type alertEventR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*alertEventR) NewStruct() *alertEventR {
+	return &alertEventR{}
+}
+
+// alertEventL is where Load methods for each relationship are stored.
+type alertEventL struct{}
+
+var (
+	alertEventAllColumns            = []string{"id", "rule_id", "key", "status", "severity", "value", "baseline", "first_seen_at", "last_seen_at", "resolved_at", "notified_at", "acknowledged_by", "acknowledged_at", "snoozed_until", "created_at", "updated_at"}
+	alertEventColumnsWithoutDefault = []string{"rule_id", "key", "status", "severity", "value", "first_seen_at", "last_seen_at", "created_at"}
+	alertEventColumnsWithDefault    = []string{"id", "baseline", "resolved_at", "notified_at", "acknowledged_by", "acknowledged_at", "snoozed_until", "updated_at"}
+	alertEventPrimaryKeyColumns     = []string{"id"}
+	alertEventGeneratedColumns      = []string{}
+)
+
+type (
+	// AlertEventSlice is an alias for a slice of pointers to AlertEvent.
+	// This should almost always be used instead of []AlertEvent.
+	AlertEventSlice []*AlertEvent
+	// AlertEventHook is the signature for custom AlertEvent hook methods
+	AlertEventHook func(context.Context, boil.ContextExecutor, *AlertEvent) error
+
+	alertEventQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	alertEventType                 = reflect.TypeOf(&AlertEvent{})
+	alertEventMapping              = queries.MakeStructMapping(alertEventType)
+	alertEventPrimaryKeyMapping, _ = queries.BindMapping(alertEventType, alertEventMapping, alertEventPrimaryKeyColumns)
+	alertEventInsertCacheMut       sync.RWMutex
+	alertEventInsertCache          = make(map[string]insertCache)
+	alertEventUpdateCacheMut       sync.RWMutex
+	alertEventUpdateCache          = make(map[string]updateCache)
+	alertEventUpsertCacheMut       sync.RWMutex
+	alertEventUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var alertEventAfterSelectMu sync.Mutex
+var alertEventAfterSelectHooks []AlertEventHook
+
+var alertEventBeforeInsertMu sync.Mutex
+var alertEventBeforeInsertHooks []AlertEventHook
+var alertEventAfterInsertMu sync.Mutex
+var alertEventAfterInsertHooks []AlertEventHook
+
+var alertEventBeforeUpdateMu sync.Mutex
+var alertEventBeforeUpdateHooks []AlertEventHook
+var alertEventAfterUpdateMu sync.Mutex
+var alertEventAfterUpdateHooks []AlertEventHook
+
+var alertEventBeforeDeleteMu sync.Mutex
+var alertEventBeforeDeleteHooks []AlertEventHook
+var alertEventAfterDeleteMu sync.Mutex
+var alertEventAfterDeleteHooks []AlertEventHook
+
+var alertEventBeforeUpsertMu sync.Mutex
+var alertEventBeforeUpsertHooks []AlertEventHook
+var alertEventAfterUpsertMu sync.Mutex
+var alertEventAfterUpsertHooks []AlertEventHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *AlertEvent) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range alertEventAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *AlertEvent) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range alertEventBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *AlertEvent) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range alertEventAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *AlertEvent) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range alertEventBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *AlertEvent) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range alertEventAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *AlertEvent) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range alertEventBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *AlertEvent) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range alertEventAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *AlertEvent) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range alertEventBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *AlertEvent) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range alertEventAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddAlertEventHook registers your hook function for all future operations.
+func AddAlertEventHook(hookPoint boil.HookPoint, alertEventHook AlertEventHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		alertEventAfterSelectMu.Lock()
+		alertEventAfterSelectHooks = append(alertEventAfterSelectHooks, alertEventHook)
+		alertEventAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		alertEventBeforeInsertMu.Lock()
+		alertEventBeforeInsertHooks = append(alertEventBeforeInsertHooks, alertEventHook)
+		alertEventBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		alertEventAfterInsertMu.Lock()
+		alertEventAfterInsertHooks = append(alertEventAfterInsertHooks, alertEventHook)
+		alertEventAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		alertEventBeforeUpdateMu.Lock()
+		alertEventBeforeUpdateHooks = append(alertEventBeforeUpdateHooks, alertEventHook)
+		alertEventBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		alertEventAfterUpdateMu.Lock()
+		alertEventAfterUpdateHooks = append(alertEventAfterUpdateHooks, alertEventHook)
+		alertEventAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		alertEventBeforeDeleteMu.Lock()
+		alertEventBeforeDeleteHooks = append(alertEventBeforeDeleteHooks, alertEventHook)
+		alertEventBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		alertEventAfterDeleteMu.Lock()
+		alertEventAfterDeleteHooks = append(alertEventAfterDeleteHooks, alertEventHook)
+		alertEventAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		alertEventBeforeUpsertMu.Lock()
+		alertEventBeforeUpsertHooks = append(alertEventBeforeUpsertHooks, alertEventHook)
+		alertEventBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		alertEventAfterUpsertMu.Lock()
+		alertEventAfterUpsertHooks = append(alertEventAfterUpsertHooks, alertEventHook)
+		alertEventAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single alertEvent record from the query.
+func (q alertEventQuery) One(ctx context.Context, exec boil.ContextExecutor) (*AlertEvent, error) {
+	o := &AlertEvent{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for alert_event")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all AlertEvent records from the query.
+func (q alertEventQuery) All(ctx context.Context, exec boil.ContextExecutor) (AlertEventSlice, error) {
+	var o []*AlertEvent
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to AlertEvent slice")
+	}
+
+	if len(alertEventAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all AlertEvent records in the query.
+func (q alertEventQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count alert_event rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q alertEventQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if alert_event exists")
+	}
+
+	return count > 0, nil
+}
+
+// AlertEvents retrieves all the records using an executor.
+func AlertEvents(mods ...qm.QueryMod) alertEventQuery {
+	mods = append(mods, qm.From("\"alert_event\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"alert_event\".*"})
+	}
+
+	return alertEventQuery{q}
+}
+
+// FindAlertEvent retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAlertEvent(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*AlertEvent, error) {
+	alertEventObj := &AlertEvent{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"alert_event\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, alertEventObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from alert_event")
+	}
+
+	if err = alertEventObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return alertEventObj, err
+	}
+
+	return alertEventObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *AlertEvent) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no alert_event provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(alertEventColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	alertEventInsertCacheMut.RLock()
+	cache, cached := alertEventInsertCache[key]
+	alertEventInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			alertEventAllColumns,
+			alertEventColumnsWithDefault,
+			alertEventColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(alertEventType, alertEventMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(alertEventType, alertEventMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"alert_event\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"alert_event\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into alert_event")
+	}
+
+	if !cached {
+		alertEventInsertCacheMut.Lock()
+		alertEventInsertCache[key] = cache
+		alertEventInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the AlertEvent.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *AlertEvent) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	alertEventUpdateCacheMut.RLock()
+	cache, cached := alertEventUpdateCache[key]
+	alertEventUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			alertEventAllColumns,
+			alertEventPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update alert_event, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"alert_event\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, alertEventPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(alertEventType, alertEventMapping, append(wl, alertEventPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update alert_event row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for alert_event")
+	}
+
+	if !cached {
+		alertEventUpdateCacheMut.Lock()
+		alertEventUpdateCache[key] = cache
+		alertEventUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q alertEventQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for alert_event")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for alert_event")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AlertEventSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), alertEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"alert_event\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, alertEventPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in alertEvent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all alertEvent")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *AlertEvent) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no alert_event provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(alertEventColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	alertEventUpsertCacheMut.RLock()
+	cache, cached := alertEventUpsertCache[key]
+	alertEventUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			alertEventAllColumns,
+			alertEventColumnsWithDefault,
+			alertEventColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			alertEventAllColumns,
+			alertEventPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert alert_event, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(alertEventAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(alertEventPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert alert_event, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(alertEventPrimaryKeyColumns))
+			copy(conflict, alertEventPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"alert_event\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(alertEventType, alertEventMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(alertEventType, alertEventMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert alert_event")
+	}
+
+	if !cached {
+		alertEventUpsertCacheMut.Lock()
+		alertEventUpsertCache[key] = cache
+		alertEventUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single AlertEvent record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *AlertEvent) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no AlertEvent provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), alertEventPrimaryKeyMapping)
+	sql := "DELETE FROM \"alert_event\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from alert_event")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for alert_event")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q alertEventQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no alertEventQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from alert_event")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for alert_event")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AlertEventSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(alertEventBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), alertEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"alert_event\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, alertEventPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from alertEvent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for alert_event")
+	}
+
+	if len(alertEventAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *AlertEvent) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindAlertEvent(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AlertEventSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AlertEventSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), alertEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"alert_event\".* FROM \"alert_event\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, alertEventPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in AlertEventSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AlertEventExists checks if the AlertEvent row exists.
+func AlertEventExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"alert_event\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if alert_event exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the AlertEvent row exists.
+func (o *AlertEvent) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return AlertEventExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testAlertEvents(t *testing.T) {
+	t.Parallel()
+
+	query := AlertEvents()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testAlertEventsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AlertEvent{}
+	if err = randomize.Struct(seed, o, alertEventDBTypes, true, alertEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AlertEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AlertEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAlertEventsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AlertEvent{}
+	if err = randomize.Struct(seed, o, alertEventDBTypes, true, alertEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AlertEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := AlertEvents().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AlertEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAlertEventsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AlertEvent{}
+	if err = randomize.Struct(seed, o, alertEventDBTypes, true, alertEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AlertEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := AlertEventSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AlertEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAlertEventsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AlertEvent{}
+	if err = randomize.Struct(seed, o, alertEventDBTypes, true, alertEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AlertEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := AlertEventExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if AlertEvent exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected AlertEventExists to return true, but got false.")
+	}
+}
+
+func testAlertEventsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AlertEvent{}
+	if err = randomize.Struct(seed, o, alertEventDBTypes, true, alertEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AlertEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	alertEventFound, err := FindAlertEvent(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if alertEventFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testAlertEventsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AlertEvent{}
+	if err = randomize.Struct(seed, o, alertEventDBTypes, true, alertEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AlertEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = AlertEvents().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAlertEventsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AlertEvent{}
+	if err = randomize.Struct(seed, o, alertEventDBTypes, true, alertEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AlertEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := AlertEvents().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testAlertEventsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	alertEventOne := &AlertEvent{}
+	alertEventTwo := &AlertEvent{}
+	if err = randomize.Struct(seed, alertEventOne, alertEventDBTypes, false, alertEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AlertEvent struct: %s", err)
+	}
+	if err = randomize.Struct(seed, alertEventTwo, alertEventDBTypes, false, alertEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AlertEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = alertEventOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = alertEventTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := AlertEvents().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testAlertEventsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	alertEventOne := &AlertEvent{}
+	alertEventTwo := &AlertEvent{}
+	if err = randomize.Struct(seed, alertEventOne, alertEventDBTypes, false, alertEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AlertEvent struct: %s", err)
+	}
+	if err = randomize.Struct(seed, alertEventTwo, alertEventDBTypes, false, alertEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AlertEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = alertEventOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = alertEventTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AlertEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func alertEventBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *AlertEvent) error {
+	*o = AlertEvent{}
+	return nil
+}
+
+func alertEventAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *AlertEvent) error {
+	*o = AlertEvent{}
+	return nil
+}
+
+func alertEventAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *AlertEvent) error {
+	*o = AlertEvent{}
+	return nil
+}
+
+func alertEventBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *AlertEvent) error {
+	*o = AlertEvent{}
+	return nil
+}
+
+func alertEventAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *AlertEvent) error {
+	*o = AlertEvent{}
+	return nil
+}
+
+func alertEventBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *AlertEvent) error {
+	*o = AlertEvent{}
+	return nil
+}
+
+func alertEventAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *AlertEvent) error {
+	*o = AlertEvent{}
+	return nil
+}
+
+func alertEventBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *AlertEvent) error {
+	*o = AlertEvent{}
+	return nil
+}
+
+func alertEventAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *AlertEvent) error {
+	*o = AlertEvent{}
+	return nil
+}
+
+func testAlertEventsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &AlertEvent{}
+	o := &AlertEvent{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, alertEventDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize AlertEvent object: %s", err)
+	}
+
+	AddAlertEventHook(boil.BeforeInsertHook, alertEventBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	alertEventBeforeInsertHooks = []AlertEventHook{}
+
+	AddAlertEventHook(boil.AfterInsertHook, alertEventAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	alertEventAfterInsertHooks = []AlertEventHook{}
+
+	AddAlertEventHook(boil.AfterSelectHook, alertEventAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	alertEventAfterSelectHooks = []AlertEventHook{}
+
+	AddAlertEventHook(boil.BeforeUpdateHook, alertEventBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	alertEventBeforeUpdateHooks = []AlertEventHook{}
+
+	AddAlertEventHook(boil.AfterUpdateHook, alertEventAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	alertEventAfterUpdateHooks = []AlertEventHook{}
+
+	AddAlertEventHook(boil.BeforeDeleteHook, alertEventBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	alertEventBeforeDeleteHooks = []AlertEventHook{}
+
+	AddAlertEventHook(boil.AfterDeleteHook, alertEventAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	alertEventAfterDeleteHooks = []AlertEventHook{}
+
+	AddAlertEventHook(boil.BeforeUpsertHook, alertEventBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	alertEventBeforeUpsertHooks = []AlertEventHook{}
+
+	AddAlertEventHook(boil.AfterUpsertHook, alertEventAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	alertEventAfterUpsertHooks = []AlertEventHook{}
+}
+
+func testAlertEventsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AlertEvent{}
+	if err = randomize.Struct(seed, o, alertEventDBTypes, true, alertEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AlertEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AlertEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testAlertEventsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AlertEvent{}
+	if err = randomize.Struct(seed, o, alertEventDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize AlertEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(alertEventColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AlertEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testAlertEventsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AlertEvent{}
+	if err = randomize.Struct(seed, o, alertEventDBTypes, true, alertEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AlertEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAlertEventsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AlertEvent{}
+	if err = randomize.Struct(seed, o, alertEventDBTypes, true, alertEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AlertEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := AlertEventSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAlertEventsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AlertEvent{}
+	if err = randomize.Struct(seed, o, alertEventDBTypes, true, alertEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AlertEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := AlertEvents().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	alertEventDBTypes = map[string]string{`ID`: `integer`, `RuleID`: `integer`, `Key`: `character varying`, `Status`: `character varying`, `Severity`: `character varying`, `Value`: `double precision`, `Baseline`: `double precision`, `FirstSeenAt`: `timestamp without time zone`, `LastSeenAt`: `timestamp without time zone`, `ResolvedAt`: `timestamp without time zone`, `NotifiedAt`: `timestamp without time zone`, `AcknowledgedBy`: `integer`, `AcknowledgedAt`: `timestamp without time zone`, `SnoozedUntil`: `timestamp without time zone`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                 = bytes.MinRead
+)
+
+func testAlertEventsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(alertEventPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(alertEventAllColumns) == len(alertEventPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AlertEvent{}
+	if err = randomize.Struct(seed, o, alertEventDBTypes, true, alertEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AlertEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AlertEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, alertEventDBTypes, true, alertEventPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AlertEvent struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testAlertEventsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(alertEventAllColumns) == len(alertEventPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AlertEvent{}
+	if err = randomize.Struct(seed, o, alertEventDBTypes, true, alertEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AlertEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AlertEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, alertEventDBTypes, true, alertEventPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AlertEvent struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(alertEventAllColumns, alertEventPrimaryKeyColumns) {
+		fields = alertEventAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			alertEventAllColumns,
+			alertEventPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := AlertEventSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testAlertEventsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(alertEventAllColumns) == len(alertEventPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := AlertEvent{}
+	if err = randomize.Struct(seed, &o, alertEventDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize AlertEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert AlertEvent: %s", err)
+	}
+
+	count, err := AlertEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, alertEventDBTypes, false, alertEventPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AlertEvent struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert AlertEvent: %s", err)
+	}
+
+	count, err = AlertEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1066 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// AlertRule is an object representing the database table.
+type AlertRule struct {
+	ID              int               `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name            string            `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Description     null.String       `boil:"description" json:"description,omitempty" toml:"description" yaml:"description,omitempty"`
+	Source          string            `boil:"source" json:"source" toml:"source" yaml:"source"`
+	Query           string            `boil:"query" json:"query" toml:"query" yaml:"query"`
+	Comparison      string            `boil:"comparison" json:"comparison" toml:"comparison" yaml:"comparison"`
+	Threshold       float64           `boil:"threshold" json:"threshold" toml:"threshold" yaml:"threshold"`
+	WindowMinutes   int               `boil:"window_minutes" json:"window_minutes" toml:"window_minutes" yaml:"window_minutes"`
+	BaselineOffsets types.StringArray `boil:"baseline_offsets" json:"baseline_offsets,omitempty" toml:"baseline_offsets" yaml:"baseline_offsets,omitempty"`
+	Severity        string            `boil:"severity" json:"severity" toml:"severity" yaml:"severity"`
+	Channel         string            `boil:"channel" json:"channel" toml:"channel" yaml:"channel"`
+	Recipients      types.StringArray `boil:"recipients" json:"recipients,omitempty" toml:"recipients" yaml:"recipients,omitempty"`
+	Cron            string            `boil:"cron" json:"cron" toml:"cron" yaml:"cron"`
+	Active          bool              `boil:"active" json:"active" toml:"active" yaml:"active"`
+	SnoozedUntil    null.Time         `boil:"snoozed_until" json:"snoozed_until,omitempty" toml:"snoozed_until" yaml:"snoozed_until,omitempty"`
+	LastEvaluatedAt null.Time         `boil:"last_evaluated_at" json:"last_evaluated_at,omitempty" toml:"last_evaluated_at" yaml:"last_evaluated_at,omitempty"`
+	CreatedAt       time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt       null.Time         `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *alertRuleR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L alertRuleL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AlertRuleColumns = struct {
+	ID              string
+	Name            string
+	Description     string
+	Source          string
+	Query           string
+	Comparison      string
+	Threshold       string
+	WindowMinutes   string
+	BaselineOffsets string
+	Severity        string
+	Channel         string
+	Recipients      string
+	Cron            string
+	Active          string
+	SnoozedUntil    string
+	LastEvaluatedAt string
+	CreatedAt       string
+	UpdatedAt       string
+}{
+	ID:              "id",
+	Name:            "name",
+	Description:     "description",
+	Source:          "source",
+	Query:           "query",
+	Comparison:      "comparison",
+	Threshold:       "threshold",
+	WindowMinutes:   "window_minutes",
+	BaselineOffsets: "baseline_offsets",
+	Severity:        "severity",
+	Channel:         "channel",
+	Recipients:      "recipients",
+	Cron:            "cron",
+	Active:          "active",
+	SnoozedUntil:    "snoozed_until",
+	LastEvaluatedAt: "last_evaluated_at",
+	CreatedAt:       "created_at",
+	UpdatedAt:       "updated_at",
+}
+
+var AlertRuleTableColumns = struct {
+	ID              string
+	Name            string
+	Description     string
+	Source          string
+	Query           string
+	Comparison      string
+	Threshold       string
+	WindowMinutes   string
+	BaselineOffsets string
+	Severity        string
+	Channel         string
+	Recipients      string
+	Cron            string
+	Active          string
+	SnoozedUntil    string
+	LastEvaluatedAt string
+	CreatedAt       string
+	UpdatedAt       string
+}{
+	ID:              "alert_rule.id",
+	Name:            "alert_rule.name",
+	Description:     "alert_rule.description",
+	Source:          "alert_rule.source",
+	Query:           "alert_rule.query",
+	Comparison:      "alert_rule.comparison",
+	Threshold:       "alert_rule.threshold",
+	WindowMinutes:   "alert_rule.window_minutes",
+	BaselineOffsets: "alert_rule.baseline_offsets",
+	Severity:        "alert_rule.severity",
+	Channel:         "alert_rule.channel",
+	Recipients:      "alert_rule.recipients",
+	Cron:            "alert_rule.cron",
+	Active:          "alert_rule.active",
+	SnoozedUntil:    "alert_rule.snoozed_until",
+	LastEvaluatedAt: "alert_rule.last_evaluated_at",
+	CreatedAt:       "alert_rule.created_at",
+	UpdatedAt:       "alert_rule.updated_at",
+}
+
+// Generated where
+
+var AlertRuleWhere = struct {
+	ID              whereHelperint
+	Name            whereHelperstring
+	Description     whereHelpernull_String
+	Source          whereHelperstring
+	Query           whereHelperstring
+	Comparison      whereHelperstring
+	Threshold       whereHelperfloat64
+	WindowMinutes   whereHelperint
+	BaselineOffsets whereHelpertypes_StringArray
+	Severity        whereHelperstring
+	Channel         whereHelperstring
+	Recipients      whereHelpertypes_StringArray
+	Cron            whereHelperstring
+	Active          whereHelperbool
+	SnoozedUntil    whereHelpernull_Time
+	LastEvaluatedAt whereHelpernull_Time
+	CreatedAt       whereHelpertime_Time
+	UpdatedAt       whereHelpernull_Time
+}{
+	ID:              whereHelperint{field: "\"alert_rule\".\"id\""},
+	Name:            whereHelperstring{field: "\"alert_rule\".\"name\""},
+	Description:     whereHelpernull_String{field: "\"alert_rule\".\"description\""},
+	Source:          whereHelperstring{field: "\"alert_rule\".\"source\""},
+	Query:           whereHelperstring{field: "\"alert_rule\".\"query\""},
+	Comparison:      whereHelperstring{field: "\"alert_rule\".\"comparison\""},
+	Threshold:       whereHelperfloat64{field: "\"alert_rule\".\"threshold\""},
+	WindowMinutes:   whereHelperint{field: "\"alert_rule\".\"window_minutes\""},
+	BaselineOffsets: whereHelpertypes_StringArray{field: "\"alert_rule\".\"baseline_offsets\""},
+	Severity:        whereHelperstring{field: "\"alert_rule\".\"severity\""},
+	Channel:         whereHelperstring{field: "\"alert_rule\".\"channel\""},
+	Recipients:      whereHelpertypes_StringArray{field: "\"alert_rule\".\"recipients\""},
+	Cron:            whereHelperstring{field: "\"alert_rule\".\"cron\""},
+	Active:          whereHelperbool{field: "\"alert_rule\".\"active\""},
+	SnoozedUntil:    whereHelpernull_Time{field: "\"alert_rule\".\"snoozed_until\""},
+	LastEvaluatedAt: whereHelpernull_Time{field: "\"alert_rule\".\"last_evaluated_at\""},
+	CreatedAt:       whereHelpertime_Time{field: "\"alert_rule\".\"created_at\""},
+	UpdatedAt:       whereHelpernull_Time{field: "\"alert_rule\".\"updated_at\""},
+}
+
+// AlertRuleRels is where relationship names are stored.
+var AlertRuleRels = struct {
+}{}
+
+// alertRuleR is where relationships are stored.
+type alertRuleR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*alertRuleR) NewStruct() *alertRuleR {
+	return &alertRuleR{}
+}
+
+// alertRuleL is where Load methods for each relationship are stored.
+type alertRuleL struct{}
+
+var (
+	alertRuleAllColumns            = []string{"id", "name", "description", "source", "query", "comparison", "threshold", "window_minutes", "baseline_offsets", "severity", "channel", "recipients", "cron", "active", "snoozed_until", "last_evaluated_at", "created_at", "updated_at"}
+	alertRuleColumnsWithoutDefault = []string{"name", "source", "query", "comparison", "threshold", "window_minutes", "severity", "channel", "cron", "created_at"}
+	alertRuleColumnsWithDefault    = []string{"id", "description", "baseline_offsets", "recipients", "active", "snoozed_until", "last_evaluated_at", "updated_at"}
+	alertRulePrimaryKeyColumns     = []string{"id"}
+	alertRuleGeneratedColumns      = []string{}
+)
+
+type (
+	// AlertRuleSlice is an alias for a slice of pointers to AlertRule.
+	// This should almost always be used instead of []AlertRule.
+	AlertRuleSlice []*AlertRule
+	// AlertRuleHook is the signature for custom AlertRule hook methods
+	AlertRuleHook func(context.Context, boil.ContextExecutor, *AlertRule) error
+
+	alertRuleQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	alertRuleType                 = reflect.TypeOf(&AlertRule{})
+	alertRuleMapping              = queries.MakeStructMapping(alertRuleType)
+	alertRulePrimaryKeyMapping, _ = queries.BindMapping(alertRuleType, alertRuleMapping, alertRulePrimaryKeyColumns)
+	alertRuleInsertCacheMut       sync.RWMutex
+	alertRuleInsertCache          = make(map[string]insertCache)
+	alertRuleUpdateCacheMut       sync.RWMutex
+	alertRuleUpdateCache          = make(map[string]updateCache)
+	alertRuleUpsertCacheMut       sync.RWMutex
+	alertRuleUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var alertRuleAfterSelectMu sync.Mutex
+var alertRuleAfterSelectHooks []AlertRuleHook
+
+var alertRuleBeforeInsertMu sync.Mutex
+var alertRuleBeforeInsertHooks []AlertRuleHook
+var alertRuleAfterInsertMu sync.Mutex
+var alertRuleAfterInsertHooks []AlertRuleHook
+
+var alertRuleBeforeUpdateMu sync.Mutex
+var alertRuleBeforeUpdateHooks []AlertRuleHook
+var alertRuleAfterUpdateMu sync.Mutex
+var alertRuleAfterUpdateHooks []AlertRuleHook
+
+var alertRuleBeforeDeleteMu sync.Mutex
+var alertRuleBeforeDeleteHooks []AlertRuleHook
+var alertRuleAfterDeleteMu sync.Mutex
+var alertRuleAfterDeleteHooks []AlertRuleHook
+
+var alertRuleBeforeUpsertMu sync.Mutex
+var alertRuleBeforeUpsertHooks []AlertRuleHook
+var alertRuleAfterUpsertMu sync.Mutex
+var alertRuleAfterUpsertHooks []AlertRuleHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *AlertRule) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range alertRuleAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *AlertRule) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range alertRuleBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *AlertRule) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range alertRuleAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *AlertRule) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range alertRuleBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *AlertRule) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range alertRuleAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *AlertRule) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range alertRuleBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *AlertRule) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range alertRuleAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *AlertRule) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range alertRuleBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *AlertRule) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range alertRuleAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddAlertRuleHook registers your hook function for all future operations.
+func AddAlertRuleHook(hookPoint boil.HookPoint, alertRuleHook AlertRuleHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		alertRuleAfterSelectMu.Lock()
+		alertRuleAfterSelectHooks = append(alertRuleAfterSelectHooks, alertRuleHook)
+		alertRuleAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		alertRuleBeforeInsertMu.Lock()
+		alertRuleBeforeInsertHooks = append(alertRuleBeforeInsertHooks, alertRuleHook)
+		alertRuleBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		alertRuleAfterInsertMu.Lock()
+		alertRuleAfterInsertHooks = append(alertRuleAfterInsertHooks, alertRuleHook)
+		alertRuleAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		alertRuleBeforeUpdateMu.Lock()
+		alertRuleBeforeUpdateHooks = append(alertRuleBeforeUpdateHooks, alertRuleHook)
+		alertRuleBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		alertRuleAfterUpdateMu.Lock()
+		alertRuleAfterUpdateHooks = append(alertRuleAfterUpdateHooks, alertRuleHook)
+		alertRuleAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		alertRuleBeforeDeleteMu.Lock()
+		alertRuleBeforeDeleteHooks = append(alertRuleBeforeDeleteHooks, alertRuleHook)
+		alertRuleBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		alertRuleAfterDeleteMu.Lock()
+		alertRuleAfterDeleteHooks = append(alertRuleAfterDeleteHooks, alertRuleHook)
+		alertRuleAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		alertRuleBeforeUpsertMu.Lock()
+		alertRuleBeforeUpsertHooks = append(alertRuleBeforeUpsertHooks, alertRuleHook)
+		alertRuleBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		alertRuleAfterUpsertMu.Lock()
+		alertRuleAfterUpsertHooks = append(alertRuleAfterUpsertHooks, alertRuleHook)
+		alertRuleAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single alertRule record from the query.
+func (q alertRuleQuery) One(ctx context.Context, exec boil.ContextExecutor) (*AlertRule, error) {
+	o := &AlertRule{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for alert_rule")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all AlertRule records from the query.
+func (q alertRuleQuery) All(ctx context.Context, exec boil.ContextExecutor) (AlertRuleSlice, error) {
+	var o []*AlertRule
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to AlertRule slice")
+	}
+
+	if len(alertRuleAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all AlertRule records in the query.
+func (q alertRuleQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count alert_rule rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q alertRuleQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if alert_rule exists")
+	}
+
+	return count > 0, nil
+}
+
+// AlertRules retrieves all the records using an executor.
+func AlertRules(mods ...qm.QueryMod) alertRuleQuery {
+	mods = append(mods, qm.From("\"alert_rule\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"alert_rule\".*"})
+	}
+
+	return alertRuleQuery{q}
+}
+
+// FindAlertRule retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAlertRule(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*AlertRule, error) {
+	alertRuleObj := &AlertRule{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"alert_rule\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, alertRuleObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from alert_rule")
+	}
+
+	if err = alertRuleObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return alertRuleObj, err
+	}
+
+	return alertRuleObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *AlertRule) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no alert_rule provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(alertRuleColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	alertRuleInsertCacheMut.RLock()
+	cache, cached := alertRuleInsertCache[key]
+	alertRuleInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			alertRuleAllColumns,
+			alertRuleColumnsWithDefault,
+			alertRuleColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(alertRuleType, alertRuleMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(alertRuleType, alertRuleMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"alert_rule\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"alert_rule\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into alert_rule")
+	}
+
+	if !cached {
+		alertRuleInsertCacheMut.Lock()
+		alertRuleInsertCache[key] = cache
+		alertRuleInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the AlertRule.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *AlertRule) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	alertRuleUpdateCacheMut.RLock()
+	cache, cached := alertRuleUpdateCache[key]
+	alertRuleUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			alertRuleAllColumns,
+			alertRulePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update alert_rule, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"alert_rule\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, alertRulePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(alertRuleType, alertRuleMapping, append(wl, alertRulePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update alert_rule row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for alert_rule")
+	}
+
+	if !cached {
+		alertRuleUpdateCacheMut.Lock()
+		alertRuleUpdateCache[key] = cache
+		alertRuleUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q alertRuleQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for alert_rule")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for alert_rule")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AlertRuleSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), alertRulePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"alert_rule\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, alertRulePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in alertRule slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all alertRule")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *AlertRule) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no alert_rule provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(alertRuleColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	alertRuleUpsertCacheMut.RLock()
+	cache, cached := alertRuleUpsertCache[key]
+	alertRuleUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			alertRuleAllColumns,
+			alertRuleColumnsWithDefault,
+			alertRuleColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			alertRuleAllColumns,
+			alertRulePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert alert_rule, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(alertRuleAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(alertRulePrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert alert_rule, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(alertRulePrimaryKeyColumns))
+			copy(conflict, alertRulePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"alert_rule\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(alertRuleType, alertRuleMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(alertRuleType, alertRuleMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert alert_rule")
+	}
+
+	if !cached {
+		alertRuleUpsertCacheMut.Lock()
+		alertRuleUpsertCache[key] = cache
+		alertRuleUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single AlertRule record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *AlertRule) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no AlertRule provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), alertRulePrimaryKeyMapping)
+	sql := "DELETE FROM \"alert_rule\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from alert_rule")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for alert_rule")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q alertRuleQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no alertRuleQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from alert_rule")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for alert_rule")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AlertRuleSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(alertRuleBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), alertRulePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"alert_rule\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, alertRulePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from alertRule slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for alert_rule")
+	}
+
+	if len(alertRuleAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *AlertRule) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindAlertRule(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AlertRuleSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AlertRuleSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), alertRulePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"alert_rule\".* FROM \"alert_rule\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, alertRulePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in AlertRuleSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AlertRuleExists checks if the AlertRule row exists.
+func AlertRuleExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"alert_rule\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if alert_rule exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the AlertRule row exists.
+func (o *AlertRule) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return AlertRuleExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testAlertRules(t *testing.T) {
+	t.Parallel()
+
+	query := AlertRules()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testAlertRulesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AlertRule{}
+	if err = randomize.Struct(seed, o, alertRuleDBTypes, true, alertRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AlertRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AlertRules().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAlertRulesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AlertRule{}
+	if err = randomize.Struct(seed, o, alertRuleDBTypes, true, alertRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AlertRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := AlertRules().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AlertRules().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAlertRulesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AlertRule{}
+	if err = randomize.Struct(seed, o, alertRuleDBTypes, true, alertRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AlertRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := AlertRuleSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AlertRules().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAlertRulesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AlertRule{}
+	if err = randomize.Struct(seed, o, alertRuleDBTypes, true, alertRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AlertRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := AlertRuleExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if AlertRule exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected AlertRuleExists to return true, but got false.")
+	}
+}
+
+func testAlertRulesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AlertRule{}
+	if err = randomize.Struct(seed, o, alertRuleDBTypes, true, alertRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AlertRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	alertRuleFound, err := FindAlertRule(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if alertRuleFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testAlertRulesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AlertRule{}
+	if err = randomize.Struct(seed, o, alertRuleDBTypes, true, alertRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AlertRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = AlertRules().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAlertRulesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AlertRule{}
+	if err = randomize.Struct(seed, o, alertRuleDBTypes, true, alertRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AlertRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := AlertRules().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testAlertRulesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	alertRuleOne := &AlertRule{}
+	alertRuleTwo := &AlertRule{}
+	if err = randomize.Struct(seed, alertRuleOne, alertRuleDBTypes, false, alertRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AlertRule struct: %s", err)
+	}
+	if err = randomize.Struct(seed, alertRuleTwo, alertRuleDBTypes, false, alertRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AlertRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = alertRuleOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = alertRuleTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := AlertRules().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testAlertRulesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	alertRuleOne := &AlertRule{}
+	alertRuleTwo := &AlertRule{}
+	if err = randomize.Struct(seed, alertRuleOne, alertRuleDBTypes, false, alertRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AlertRule struct: %s", err)
+	}
+	if err = randomize.Struct(seed, alertRuleTwo, alertRuleDBTypes, false, alertRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AlertRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = alertRuleOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = alertRuleTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AlertRules().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func alertRuleBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *AlertRule) error {
+	*o = AlertRule{}
+	return nil
+}
+
+func alertRuleAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *AlertRule) error {
+	*o = AlertRule{}
+	return nil
+}
+
+func alertRuleAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *AlertRule) error {
+	*o = AlertRule{}
+	return nil
+}
+
+func alertRuleBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *AlertRule) error {
+	*o = AlertRule{}
+	return nil
+}
+
+func alertRuleAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *AlertRule) error {
+	*o = AlertRule{}
+	return nil
+}
+
+func alertRuleBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *AlertRule) error {
+	*o = AlertRule{}
+	return nil
+}
+
+func alertRuleAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *AlertRule) error {
+	*o = AlertRule{}
+	return nil
+}
+
+func alertRuleBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *AlertRule) error {
+	*o = AlertRule{}
+	return nil
+}
+
+func alertRuleAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *AlertRule) error {
+	*o = AlertRule{}
+	return nil
+}
+
+func testAlertRulesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &AlertRule{}
+	o := &AlertRule{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, alertRuleDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize AlertRule object: %s", err)
+	}
+
+	AddAlertRuleHook(boil.BeforeInsertHook, alertRuleBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	alertRuleBeforeInsertHooks = []AlertRuleHook{}
+
+	AddAlertRuleHook(boil.AfterInsertHook, alertRuleAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	alertRuleAfterInsertHooks = []AlertRuleHook{}
+
+	AddAlertRuleHook(boil.AfterSelectHook, alertRuleAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	alertRuleAfterSelectHooks = []AlertRuleHook{}
+
+	AddAlertRuleHook(boil.BeforeUpdateHook, alertRuleBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	alertRuleBeforeUpdateHooks = []AlertRuleHook{}
+
+	AddAlertRuleHook(boil.AfterUpdateHook, alertRuleAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	alertRuleAfterUpdateHooks = []AlertRuleHook{}
+
+	AddAlertRuleHook(boil.BeforeDeleteHook, alertRuleBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	alertRuleBeforeDeleteHooks = []AlertRuleHook{}
+
+	AddAlertRuleHook(boil.AfterDeleteHook, alertRuleAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	alertRuleAfterDeleteHooks = []AlertRuleHook{}
+
+	AddAlertRuleHook(boil.BeforeUpsertHook, alertRuleBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	alertRuleBeforeUpsertHooks = []AlertRuleHook{}
+
+	AddAlertRuleHook(boil.AfterUpsertHook, alertRuleAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	alertRuleAfterUpsertHooks = []AlertRuleHook{}
+}
+
+func testAlertRulesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AlertRule{}
+	if err = randomize.Struct(seed, o, alertRuleDBTypes, true, alertRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AlertRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AlertRules().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testAlertRulesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AlertRule{}
+	if err = randomize.Struct(seed, o, alertRuleDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize AlertRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(alertRuleColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AlertRules().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testAlertRulesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AlertRule{}
+	if err = randomize.Struct(seed, o, alertRuleDBTypes, true, alertRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AlertRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAlertRulesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AlertRule{}
+	if err = randomize.Struct(seed, o, alertRuleDBTypes, true, alertRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AlertRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := AlertRuleSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAlertRulesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AlertRule{}
+	if err = randomize.Struct(seed, o, alertRuleDBTypes, true, alertRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AlertRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := AlertRules().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	alertRuleDBTypes = map[string]string{`ID`: `integer`, `Name`: `character varying`, `Description`: `text`, `Source`: `character varying`, `Query`: `text`, `Comparison`: `character varying`, `Threshold`: `double precision`, `WindowMinutes`: `integer`, `BaselineOffsets`: `ARRAYcharacter varying`, `Severity`: `character varying`, `Channel`: `character varying`, `Recipients`: `ARRAYcharacter varying`, `Cron`: `character varying`, `Active`: `boolean`, `SnoozedUntil`: `timestamp without time zone`, `LastEvaluatedAt`: `timestamp without time zone`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                = bytes.MinRead
+)
+
+func testAlertRulesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(alertRulePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(alertRuleAllColumns) == len(alertRulePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AlertRule{}
+	if err = randomize.Struct(seed, o, alertRuleDBTypes, true, alertRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AlertRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AlertRules().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, alertRuleDBTypes, true, alertRulePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AlertRule struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testAlertRulesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(alertRuleAllColumns) == len(alertRulePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AlertRule{}
+	if err = randomize.Struct(seed, o, alertRuleDBTypes, true, alertRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AlertRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AlertRules().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, alertRuleDBTypes, true, alertRulePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AlertRule struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(alertRuleAllColumns, alertRulePrimaryKeyColumns) {
+		fields = alertRuleAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			alertRuleAllColumns,
+			alertRulePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := AlertRuleSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testAlertRulesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(alertRuleAllColumns) == len(alertRulePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := AlertRule{}
+	if err = randomize.Struct(seed, &o, alertRuleDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize AlertRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert AlertRule: %s", err)
+	}
+
+	count, err := AlertRules().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, alertRuleDBTypes, false, alertRulePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AlertRule struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert AlertRule: %s", err)
+	}
+
+	count, err = AlertRules().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
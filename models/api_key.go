@@ -0,0 +1,1038 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// APIKey is an object representing the database table.
+type APIKey struct {
+	ID         int               `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name       string            `boil:"name" json:"name" toml:"name" yaml:"name"`
+	KeyPrefix  string            `boil:"key_prefix" json:"key_prefix" toml:"key_prefix" yaml:"key_prefix"`
+	KeyHash    string            `boil:"key_hash" json:"key_hash" toml:"key_hash" yaml:"key_hash"`
+	OwnerID    int               `boil:"owner_id" json:"owner_id" toml:"owner_id" yaml:"owner_id"`
+	Scopes     types.StringArray `boil:"scopes" json:"scopes,omitempty" toml:"scopes" yaml:"scopes,omitempty"`
+	AllowedIps types.StringArray `boil:"allowed_ips" json:"allowed_ips,omitempty" toml:"allowed_ips" yaml:"allowed_ips,omitempty"`
+	ExpiresAt  null.Time         `boil:"expires_at" json:"expires_at,omitempty" toml:"expires_at" yaml:"expires_at,omitempty"`
+	LastUsedAt null.Time         `boil:"last_used_at" json:"last_used_at,omitempty" toml:"last_used_at" yaml:"last_used_at,omitempty"`
+	LastUsedIP null.String       `boil:"last_used_ip" json:"last_used_ip,omitempty" toml:"last_used_ip" yaml:"last_used_ip,omitempty"`
+	RevokedAt  null.Time         `boil:"revoked_at" json:"revoked_at,omitempty" toml:"revoked_at" yaml:"revoked_at,omitempty"`
+	CreatedBy  int               `boil:"created_by" json:"created_by" toml:"created_by" yaml:"created_by"`
+	CreatedAt  time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt  null.Time         `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *aPIKeyR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L aPIKeyL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var APIKeyColumns = struct {
+	ID         string
+	Name       string
+	KeyPrefix  string
+	KeyHash    string
+	OwnerID    string
+	Scopes     string
+	AllowedIps string
+	ExpiresAt  string
+	LastUsedAt string
+	LastUsedIP string
+	RevokedAt  string
+	CreatedBy  string
+	CreatedAt  string
+	UpdatedAt  string
+}{
+	ID:         "id",
+	Name:       "name",
+	KeyPrefix:  "key_prefix",
+	KeyHash:    "key_hash",
+	OwnerID:    "owner_id",
+	Scopes:     "scopes",
+	AllowedIps: "allowed_ips",
+	ExpiresAt:  "expires_at",
+	LastUsedAt: "last_used_at",
+	LastUsedIP: "last_used_ip",
+	RevokedAt:  "revoked_at",
+	CreatedBy:  "created_by",
+	CreatedAt:  "created_at",
+	UpdatedAt:  "updated_at",
+}
+
+var APIKeyTableColumns = struct {
+	ID         string
+	Name       string
+	KeyPrefix  string
+	KeyHash    string
+	OwnerID    string
+	Scopes     string
+	AllowedIps string
+	ExpiresAt  string
+	LastUsedAt string
+	LastUsedIP string
+	RevokedAt  string
+	CreatedBy  string
+	CreatedAt  string
+	UpdatedAt  string
+}{
+	ID:         "api_key.id",
+	Name:       "api_key.name",
+	KeyPrefix:  "api_key.key_prefix",
+	KeyHash:    "api_key.key_hash",
+	OwnerID:    "api_key.owner_id",
+	Scopes:     "api_key.scopes",
+	AllowedIps: "api_key.allowed_ips",
+	ExpiresAt:  "api_key.expires_at",
+	LastUsedAt: "api_key.last_used_at",
+	LastUsedIP: "api_key.last_used_ip",
+	RevokedAt:  "api_key.revoked_at",
+	CreatedBy:  "api_key.created_by",
+	CreatedAt:  "api_key.created_at",
+	UpdatedAt:  "api_key.updated_at",
+}
+
+// Generated where
+
+var APIKeyWhere = struct {
+	ID         whereHelperint
+	Name       whereHelperstring
+	KeyPrefix  whereHelperstring
+	KeyHash    whereHelperstring
+	OwnerID    whereHelperint
+	Scopes     whereHelpertypes_StringArray
+	AllowedIps whereHelpertypes_StringArray
+	ExpiresAt  whereHelpernull_Time
+	LastUsedAt whereHelpernull_Time
+	LastUsedIP whereHelpernull_String
+	RevokedAt  whereHelpernull_Time
+	CreatedBy  whereHelperint
+	CreatedAt  whereHelpertime_Time
+	UpdatedAt  whereHelpernull_Time
+}{
+	ID:         whereHelperint{field: "\"api_key\".\"id\""},
+	Name:       whereHelperstring{field: "\"api_key\".\"name\""},
+	KeyPrefix:  whereHelperstring{field: "\"api_key\".\"key_prefix\""},
+	KeyHash:    whereHelperstring{field: "\"api_key\".\"key_hash\""},
+	OwnerID:    whereHelperint{field: "\"api_key\".\"owner_id\""},
+	Scopes:     whereHelpertypes_StringArray{field: "\"api_key\".\"scopes\""},
+	AllowedIps: whereHelpertypes_StringArray{field: "\"api_key\".\"allowed_ips\""},
+	ExpiresAt:  whereHelpernull_Time{field: "\"api_key\".\"expires_at\""},
+	LastUsedAt: whereHelpernull_Time{field: "\"api_key\".\"last_used_at\""},
+	LastUsedIP: whereHelpernull_String{field: "\"api_key\".\"last_used_ip\""},
+	RevokedAt:  whereHelpernull_Time{field: "\"api_key\".\"revoked_at\""},
+	CreatedBy:  whereHelperint{field: "\"api_key\".\"created_by\""},
+	CreatedAt:  whereHelpertime_Time{field: "\"api_key\".\"created_at\""},
+	UpdatedAt:  whereHelpernull_Time{field: "\"api_key\".\"updated_at\""},
+}
+
+// APIKeyRels is where relationship names are stored.
+var APIKeyRels = struct {
+}{}
+
+// aPIKeyR is where relationships are stored.
+type aPIKeyR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*aPIKeyR) NewStruct() *aPIKeyR {
+	return &aPIKeyR{}
+}
+
+// aPIKeyL is where Load methods for each relationship are stored.
+type aPIKeyL struct{}
+
+var (
+	aPIKeyAllColumns            = []string{"id", "name", "key_prefix", "key_hash", "owner_id", "scopes", "allowed_ips", "expires_at", "last_used_at", "last_used_ip", "revoked_at", "created_by", "created_at", "updated_at"}
+	aPIKeyColumnsWithoutDefault = []string{"name", "key_prefix", "key_hash", "owner_id", "scopes", "created_by", "created_at"}
+	aPIKeyColumnsWithDefault    = []string{"id", "allowed_ips", "expires_at", "last_used_at", "last_used_ip", "revoked_at", "updated_at"}
+	aPIKeyPrimaryKeyColumns     = []string{"id"}
+	aPIKeyGeneratedColumns      = []string{}
+)
+
+type (
+	// APIKeySlice is an alias for a slice of pointers to APIKey.
+	// This should almost always be used instead of []APIKey.
+	APIKeySlice []*APIKey
+	// APIKeyHook is the signature for custom APIKey hook methods
+	APIKeyHook func(context.Context, boil.ContextExecutor, *APIKey) error
+
+	aPIKeyQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	aPIKeyType                 = reflect.TypeOf(&APIKey{})
+	aPIKeyMapping              = queries.MakeStructMapping(aPIKeyType)
+	aPIKeyPrimaryKeyMapping, _ = queries.BindMapping(aPIKeyType, aPIKeyMapping, aPIKeyPrimaryKeyColumns)
+	aPIKeyInsertCacheMut       sync.RWMutex
+	aPIKeyInsertCache          = make(map[string]insertCache)
+	aPIKeyUpdateCacheMut       sync.RWMutex
+	aPIKeyUpdateCache          = make(map[string]updateCache)
+	aPIKeyUpsertCacheMut       sync.RWMutex
+	aPIKeyUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var aPIKeyAfterSelectMu sync.Mutex
+var aPIKeyAfterSelectHooks []APIKeyHook
+
+var aPIKeyBeforeInsertMu sync.Mutex
+var aPIKeyBeforeInsertHooks []APIKeyHook
+var aPIKeyAfterInsertMu sync.Mutex
+var aPIKeyAfterInsertHooks []APIKeyHook
+
+var aPIKeyBeforeUpdateMu sync.Mutex
+var aPIKeyBeforeUpdateHooks []APIKeyHook
+var aPIKeyAfterUpdateMu sync.Mutex
+var aPIKeyAfterUpdateHooks []APIKeyHook
+
+var aPIKeyBeforeDeleteMu sync.Mutex
+var aPIKeyBeforeDeleteHooks []APIKeyHook
+var aPIKeyAfterDeleteMu sync.Mutex
+var aPIKeyAfterDeleteHooks []APIKeyHook
+
+var aPIKeyBeforeUpsertMu sync.Mutex
+var aPIKeyBeforeUpsertHooks []APIKeyHook
+var aPIKeyAfterUpsertMu sync.Mutex
+var aPIKeyAfterUpsertHooks []APIKeyHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *APIKey) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range aPIKeyAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *APIKey) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range aPIKeyBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *APIKey) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range aPIKeyAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *APIKey) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range aPIKeyBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *APIKey) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range aPIKeyAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *APIKey) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range aPIKeyBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *APIKey) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range aPIKeyAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *APIKey) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range aPIKeyBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *APIKey) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range aPIKeyAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddAPIKeyHook registers your hook function for all future operations.
+func AddAPIKeyHook(hookPoint boil.HookPoint, aPIKeyHook APIKeyHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		aPIKeyAfterSelectMu.Lock()
+		aPIKeyAfterSelectHooks = append(aPIKeyAfterSelectHooks, aPIKeyHook)
+		aPIKeyAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		aPIKeyBeforeInsertMu.Lock()
+		aPIKeyBeforeInsertHooks = append(aPIKeyBeforeInsertHooks, aPIKeyHook)
+		aPIKeyBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		aPIKeyAfterInsertMu.Lock()
+		aPIKeyAfterInsertHooks = append(aPIKeyAfterInsertHooks, aPIKeyHook)
+		aPIKeyAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		aPIKeyBeforeUpdateMu.Lock()
+		aPIKeyBeforeUpdateHooks = append(aPIKeyBeforeUpdateHooks, aPIKeyHook)
+		aPIKeyBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		aPIKeyAfterUpdateMu.Lock()
+		aPIKeyAfterUpdateHooks = append(aPIKeyAfterUpdateHooks, aPIKeyHook)
+		aPIKeyAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		aPIKeyBeforeDeleteMu.Lock()
+		aPIKeyBeforeDeleteHooks = append(aPIKeyBeforeDeleteHooks, aPIKeyHook)
+		aPIKeyBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		aPIKeyAfterDeleteMu.Lock()
+		aPIKeyAfterDeleteHooks = append(aPIKeyAfterDeleteHooks, aPIKeyHook)
+		aPIKeyAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		aPIKeyBeforeUpsertMu.Lock()
+		aPIKeyBeforeUpsertHooks = append(aPIKeyBeforeUpsertHooks, aPIKeyHook)
+		aPIKeyBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		aPIKeyAfterUpsertMu.Lock()
+		aPIKeyAfterUpsertHooks = append(aPIKeyAfterUpsertHooks, aPIKeyHook)
+		aPIKeyAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single aPIKey record from the query.
+func (q aPIKeyQuery) One(ctx context.Context, exec boil.ContextExecutor) (*APIKey, error) {
+	o := &APIKey{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for api_key")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all APIKey records from the query.
+func (q aPIKeyQuery) All(ctx context.Context, exec boil.ContextExecutor) (APIKeySlice, error) {
+	var o []*APIKey
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to APIKey slice")
+	}
+
+	if len(aPIKeyAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all APIKey records in the query.
+func (q aPIKeyQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count api_key rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q aPIKeyQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if api_key exists")
+	}
+
+	return count > 0, nil
+}
+
+// APIKeys retrieves all the records using an executor.
+func APIKeys(mods ...qm.QueryMod) aPIKeyQuery {
+	mods = append(mods, qm.From("\"api_key\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"api_key\".*"})
+	}
+
+	return aPIKeyQuery{q}
+}
+
+// FindAPIKey retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAPIKey(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*APIKey, error) {
+	aPIKeyObj := &APIKey{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"api_key\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, aPIKeyObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from api_key")
+	}
+
+	if err = aPIKeyObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return aPIKeyObj, err
+	}
+
+	return aPIKeyObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *APIKey) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no api_key provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(aPIKeyColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	aPIKeyInsertCacheMut.RLock()
+	cache, cached := aPIKeyInsertCache[key]
+	aPIKeyInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			aPIKeyAllColumns,
+			aPIKeyColumnsWithDefault,
+			aPIKeyColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(aPIKeyType, aPIKeyMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(aPIKeyType, aPIKeyMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"api_key\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"api_key\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into api_key")
+	}
+
+	if !cached {
+		aPIKeyInsertCacheMut.Lock()
+		aPIKeyInsertCache[key] = cache
+		aPIKeyInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the APIKey.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *APIKey) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	aPIKeyUpdateCacheMut.RLock()
+	cache, cached := aPIKeyUpdateCache[key]
+	aPIKeyUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			aPIKeyAllColumns,
+			aPIKeyPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update api_key, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"api_key\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, aPIKeyPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(aPIKeyType, aPIKeyMapping, append(wl, aPIKeyPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update api_key row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for api_key")
+	}
+
+	if !cached {
+		aPIKeyUpdateCacheMut.Lock()
+		aPIKeyUpdateCache[key] = cache
+		aPIKeyUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q aPIKeyQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for api_key")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for api_key")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o APIKeySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), aPIKeyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"api_key\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, aPIKeyPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in aPIKey slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all aPIKey")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *APIKey) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no api_key provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(aPIKeyColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	aPIKeyUpsertCacheMut.RLock()
+	cache, cached := aPIKeyUpsertCache[key]
+	aPIKeyUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			aPIKeyAllColumns,
+			aPIKeyColumnsWithDefault,
+			aPIKeyColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			aPIKeyAllColumns,
+			aPIKeyPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert api_key, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(aPIKeyAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(aPIKeyPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert api_key, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(aPIKeyPrimaryKeyColumns))
+			copy(conflict, aPIKeyPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"api_key\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(aPIKeyType, aPIKeyMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(aPIKeyType, aPIKeyMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert api_key")
+	}
+
+	if !cached {
+		aPIKeyUpsertCacheMut.Lock()
+		aPIKeyUpsertCache[key] = cache
+		aPIKeyUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single APIKey record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *APIKey) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no APIKey provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), aPIKeyPrimaryKeyMapping)
+	sql := "DELETE FROM \"api_key\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from api_key")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for api_key")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q aPIKeyQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no aPIKeyQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from api_key")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for api_key")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o APIKeySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(aPIKeyBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), aPIKeyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"api_key\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, aPIKeyPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from aPIKey slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for api_key")
+	}
+
+	if len(aPIKeyAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *APIKey) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindAPIKey(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *APIKeySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := APIKeySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), aPIKeyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"api_key\".* FROM \"api_key\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, aPIKeyPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in APIKeySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// APIKeyExists checks if the APIKey row exists.
+func APIKeyExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"api_key\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if api_key exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the APIKey row exists.
+func (o *APIKey) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return APIKeyExists(ctx, exec, o.ID)
+}
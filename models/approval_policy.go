@@ -0,0 +1,1017 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// ApprovalPolicy is an object representing the database table.
+type ApprovalPolicy struct {
+	ID                  int               `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name                string            `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Subject             string            `boil:"subject" json:"subject" toml:"subject" yaml:"subject"`
+	MinChangePercentage null.Float64      `boil:"min_change_percentage" json:"min_change_percentage,omitempty" toml:"min_change_percentage" yaml:"min_change_percentage,omitempty"`
+	RequesterRoles      types.StringArray `boil:"requester_roles" json:"requester_roles,omitempty" toml:"requester_roles" yaml:"requester_roles,omitempty"`
+	ApproverRoles       types.StringArray `boil:"approver_roles" json:"approver_roles" toml:"approver_roles" yaml:"approver_roles"`
+	NotifyEmails        types.StringArray `boil:"notify_emails" json:"notify_emails,omitempty" toml:"notify_emails" yaml:"notify_emails,omitempty"`
+	NotifySlack         bool              `boil:"notify_slack" json:"notify_slack" toml:"notify_slack" yaml:"notify_slack"`
+	Active              bool              `boil:"active" json:"active" toml:"active" yaml:"active"`
+	CreatedAt           time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt           null.Time         `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *approvalPolicyR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L approvalPolicyL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ApprovalPolicyColumns = struct {
+	ID                  string
+	Name                string
+	Subject             string
+	MinChangePercentage string
+	RequesterRoles      string
+	ApproverRoles       string
+	NotifyEmails        string
+	NotifySlack         string
+	Active              string
+	CreatedAt           string
+	UpdatedAt           string
+}{
+	ID:                  "id",
+	Name:                "name",
+	Subject:             "subject",
+	MinChangePercentage: "min_change_percentage",
+	RequesterRoles:      "requester_roles",
+	ApproverRoles:       "approver_roles",
+	NotifyEmails:        "notify_emails",
+	NotifySlack:         "notify_slack",
+	Active:              "active",
+	CreatedAt:           "created_at",
+	UpdatedAt:           "updated_at",
+}
+
+var ApprovalPolicyTableColumns = struct {
+	ID                  string
+	Name                string
+	Subject             string
+	MinChangePercentage string
+	RequesterRoles      string
+	ApproverRoles       string
+	NotifyEmails        string
+	NotifySlack         string
+	Active              string
+	CreatedAt           string
+	UpdatedAt           string
+}{
+	ID:                  "approval_policy.id",
+	Name:                "approval_policy.name",
+	Subject:             "approval_policy.subject",
+	MinChangePercentage: "approval_policy.min_change_percentage",
+	RequesterRoles:      "approval_policy.requester_roles",
+	ApproverRoles:       "approval_policy.approver_roles",
+	NotifyEmails:        "approval_policy.notify_emails",
+	NotifySlack:         "approval_policy.notify_slack",
+	Active:              "approval_policy.active",
+	CreatedAt:           "approval_policy.created_at",
+	UpdatedAt:           "approval_policy.updated_at",
+}
+
+// Generated where
+
+var ApprovalPolicyWhere = struct {
+	ID                  whereHelperint
+	Name                whereHelperstring
+	Subject             whereHelperstring
+	MinChangePercentage whereHelpernull_Float64
+	RequesterRoles      whereHelpertypes_StringArray
+	ApproverRoles       whereHelpertypes_StringArray
+	NotifyEmails        whereHelpertypes_StringArray
+	NotifySlack         whereHelperbool
+	Active              whereHelperbool
+	CreatedAt           whereHelpertime_Time
+	UpdatedAt           whereHelpernull_Time
+}{
+	ID:                  whereHelperint{field: "\"approval_policy\".\"id\""},
+	Name:                whereHelperstring{field: "\"approval_policy\".\"name\""},
+	Subject:             whereHelperstring{field: "\"approval_policy\".\"subject\""},
+	MinChangePercentage: whereHelpernull_Float64{field: "\"approval_policy\".\"min_change_percentage\""},
+	RequesterRoles:      whereHelpertypes_StringArray{field: "\"approval_policy\".\"requester_roles\""},
+	ApproverRoles:       whereHelpertypes_StringArray{field: "\"approval_policy\".\"approver_roles\""},
+	NotifyEmails:        whereHelpertypes_StringArray{field: "\"approval_policy\".\"notify_emails\""},
+	NotifySlack:         whereHelperbool{field: "\"approval_policy\".\"notify_slack\""},
+	Active:              whereHelperbool{field: "\"approval_policy\".\"active\""},
+	CreatedAt:           whereHelpertime_Time{field: "\"approval_policy\".\"created_at\""},
+	UpdatedAt:           whereHelpernull_Time{field: "\"approval_policy\".\"updated_at\""},
+}
+
+// ApprovalPolicyRels is where relationship names are stored.
+var ApprovalPolicyRels = struct {
+}{}
+
+// approvalPolicyR is where relationships are stored.
+type approvalPolicyR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*approvalPolicyR) NewStruct() *approvalPolicyR {
+	return &approvalPolicyR{}
+}
+
+// approvalPolicyL is where Load methods for each relationship are stored.
+type approvalPolicyL struct{}
+
+var (
+	approvalPolicyAllColumns            = []string{"id", "name", "subject", "min_change_percentage", "requester_roles", "approver_roles", "notify_emails", "notify_slack", "active", "created_at", "updated_at"}
+	approvalPolicyColumnsWithoutDefault = []string{"name", "subject", "approver_roles", "created_at"}
+	approvalPolicyColumnsWithDefault    = []string{"id", "min_change_percentage", "requester_roles", "notify_emails", "notify_slack", "active", "updated_at"}
+	approvalPolicyPrimaryKeyColumns     = []string{"id"}
+	approvalPolicyGeneratedColumns      = []string{}
+)
+
+type (
+	// ApprovalPolicySlice is an alias for a slice of pointers to ApprovalPolicy.
+	// This should almost always be used instead of []ApprovalPolicy.
+	ApprovalPolicySlice []*ApprovalPolicy
+	// ApprovalPolicyHook is the signature for custom ApprovalPolicy hook methods
+	ApprovalPolicyHook func(context.Context, boil.ContextExecutor, *ApprovalPolicy) error
+
+	approvalPolicyQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	approvalPolicyType                 = reflect.TypeOf(&ApprovalPolicy{})
+	approvalPolicyMapping              = queries.MakeStructMapping(approvalPolicyType)
+	approvalPolicyPrimaryKeyMapping, _ = queries.BindMapping(approvalPolicyType, approvalPolicyMapping, approvalPolicyPrimaryKeyColumns)
+	approvalPolicyInsertCacheMut       sync.RWMutex
+	approvalPolicyInsertCache          = make(map[string]insertCache)
+	approvalPolicyUpdateCacheMut       sync.RWMutex
+	approvalPolicyUpdateCache          = make(map[string]updateCache)
+	approvalPolicyUpsertCacheMut       sync.RWMutex
+	approvalPolicyUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var approvalPolicyAfterSelectMu sync.Mutex
+var approvalPolicyAfterSelectHooks []ApprovalPolicyHook
+
+var approvalPolicyBeforeInsertMu sync.Mutex
+var approvalPolicyBeforeInsertHooks []ApprovalPolicyHook
+var approvalPolicyAfterInsertMu sync.Mutex
+var approvalPolicyAfterInsertHooks []ApprovalPolicyHook
+
+var approvalPolicyBeforeUpdateMu sync.Mutex
+var approvalPolicyBeforeUpdateHooks []ApprovalPolicyHook
+var approvalPolicyAfterUpdateMu sync.Mutex
+var approvalPolicyAfterUpdateHooks []ApprovalPolicyHook
+
+var approvalPolicyBeforeDeleteMu sync.Mutex
+var approvalPolicyBeforeDeleteHooks []ApprovalPolicyHook
+var approvalPolicyAfterDeleteMu sync.Mutex
+var approvalPolicyAfterDeleteHooks []ApprovalPolicyHook
+
+var approvalPolicyBeforeUpsertMu sync.Mutex
+var approvalPolicyBeforeUpsertHooks []ApprovalPolicyHook
+var approvalPolicyAfterUpsertMu sync.Mutex
+var approvalPolicyAfterUpsertHooks []ApprovalPolicyHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ApprovalPolicy) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range approvalPolicyAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ApprovalPolicy) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range approvalPolicyBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ApprovalPolicy) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range approvalPolicyAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ApprovalPolicy) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range approvalPolicyBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ApprovalPolicy) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range approvalPolicyAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ApprovalPolicy) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range approvalPolicyBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ApprovalPolicy) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range approvalPolicyAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ApprovalPolicy) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range approvalPolicyBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ApprovalPolicy) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range approvalPolicyAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddApprovalPolicyHook registers your hook function for all future operations.
+func AddApprovalPolicyHook(hookPoint boil.HookPoint, approvalPolicyHook ApprovalPolicyHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		approvalPolicyAfterSelectMu.Lock()
+		approvalPolicyAfterSelectHooks = append(approvalPolicyAfterSelectHooks, approvalPolicyHook)
+		approvalPolicyAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		approvalPolicyBeforeInsertMu.Lock()
+		approvalPolicyBeforeInsertHooks = append(approvalPolicyBeforeInsertHooks, approvalPolicyHook)
+		approvalPolicyBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		approvalPolicyAfterInsertMu.Lock()
+		approvalPolicyAfterInsertHooks = append(approvalPolicyAfterInsertHooks, approvalPolicyHook)
+		approvalPolicyAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		approvalPolicyBeforeUpdateMu.Lock()
+		approvalPolicyBeforeUpdateHooks = append(approvalPolicyBeforeUpdateHooks, approvalPolicyHook)
+		approvalPolicyBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		approvalPolicyAfterUpdateMu.Lock()
+		approvalPolicyAfterUpdateHooks = append(approvalPolicyAfterUpdateHooks, approvalPolicyHook)
+		approvalPolicyAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		approvalPolicyBeforeDeleteMu.Lock()
+		approvalPolicyBeforeDeleteHooks = append(approvalPolicyBeforeDeleteHooks, approvalPolicyHook)
+		approvalPolicyBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		approvalPolicyAfterDeleteMu.Lock()
+		approvalPolicyAfterDeleteHooks = append(approvalPolicyAfterDeleteHooks, approvalPolicyHook)
+		approvalPolicyAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		approvalPolicyBeforeUpsertMu.Lock()
+		approvalPolicyBeforeUpsertHooks = append(approvalPolicyBeforeUpsertHooks, approvalPolicyHook)
+		approvalPolicyBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		approvalPolicyAfterUpsertMu.Lock()
+		approvalPolicyAfterUpsertHooks = append(approvalPolicyAfterUpsertHooks, approvalPolicyHook)
+		approvalPolicyAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single approvalPolicy record from the query.
+func (q approvalPolicyQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ApprovalPolicy, error) {
+	o := &ApprovalPolicy{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for approval_policy")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ApprovalPolicy records from the query.
+func (q approvalPolicyQuery) All(ctx context.Context, exec boil.ContextExecutor) (ApprovalPolicySlice, error) {
+	var o []*ApprovalPolicy
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ApprovalPolicy slice")
+	}
+
+	if len(approvalPolicyAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ApprovalPolicy records in the query.
+func (q approvalPolicyQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count approval_policy rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q approvalPolicyQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if approval_policy exists")
+	}
+
+	return count > 0, nil
+}
+
+// ApprovalPolicies retrieves all the records using an executor.
+func ApprovalPolicies(mods ...qm.QueryMod) approvalPolicyQuery {
+	mods = append(mods, qm.From("\"approval_policy\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"approval_policy\".*"})
+	}
+
+	return approvalPolicyQuery{q}
+}
+
+// FindApprovalPolicy retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindApprovalPolicy(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*ApprovalPolicy, error) {
+	approvalPolicyObj := &ApprovalPolicy{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"approval_policy\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, approvalPolicyObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from approval_policy")
+	}
+
+	if err = approvalPolicyObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return approvalPolicyObj, err
+	}
+
+	return approvalPolicyObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ApprovalPolicy) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no approval_policy provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(approvalPolicyColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	approvalPolicyInsertCacheMut.RLock()
+	cache, cached := approvalPolicyInsertCache[key]
+	approvalPolicyInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			approvalPolicyAllColumns,
+			approvalPolicyColumnsWithDefault,
+			approvalPolicyColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(approvalPolicyType, approvalPolicyMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(approvalPolicyType, approvalPolicyMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"approval_policy\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"approval_policy\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into approval_policy")
+	}
+
+	if !cached {
+		approvalPolicyInsertCacheMut.Lock()
+		approvalPolicyInsertCache[key] = cache
+		approvalPolicyInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ApprovalPolicy.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ApprovalPolicy) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	approvalPolicyUpdateCacheMut.RLock()
+	cache, cached := approvalPolicyUpdateCache[key]
+	approvalPolicyUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			approvalPolicyAllColumns,
+			approvalPolicyPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update approval_policy, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"approval_policy\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, approvalPolicyPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(approvalPolicyType, approvalPolicyMapping, append(wl, approvalPolicyPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update approval_policy row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for approval_policy")
+	}
+
+	if !cached {
+		approvalPolicyUpdateCacheMut.Lock()
+		approvalPolicyUpdateCache[key] = cache
+		approvalPolicyUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q approvalPolicyQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for approval_policy")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for approval_policy")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ApprovalPolicySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), approvalPolicyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"approval_policy\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, approvalPolicyPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in approvalPolicy slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all approvalPolicy")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ApprovalPolicy) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no approval_policy provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(approvalPolicyColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	approvalPolicyUpsertCacheMut.RLock()
+	cache, cached := approvalPolicyUpsertCache[key]
+	approvalPolicyUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			approvalPolicyAllColumns,
+			approvalPolicyColumnsWithDefault,
+			approvalPolicyColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			approvalPolicyAllColumns,
+			approvalPolicyPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert approval_policy, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(approvalPolicyAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(approvalPolicyPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert approval_policy, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(approvalPolicyPrimaryKeyColumns))
+			copy(conflict, approvalPolicyPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"approval_policy\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(approvalPolicyType, approvalPolicyMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(approvalPolicyType, approvalPolicyMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert approval_policy")
+	}
+
+	if !cached {
+		approvalPolicyUpsertCacheMut.Lock()
+		approvalPolicyUpsertCache[key] = cache
+		approvalPolicyUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ApprovalPolicy record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ApprovalPolicy) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ApprovalPolicy provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), approvalPolicyPrimaryKeyMapping)
+	sql := "DELETE FROM \"approval_policy\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from approval_policy")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for approval_policy")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q approvalPolicyQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no approvalPolicyQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from approval_policy")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for approval_policy")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ApprovalPolicySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(approvalPolicyBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), approvalPolicyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"approval_policy\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, approvalPolicyPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from approvalPolicy slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for approval_policy")
+	}
+
+	if len(approvalPolicyAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ApprovalPolicy) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindApprovalPolicy(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ApprovalPolicySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ApprovalPolicySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), approvalPolicyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"approval_policy\".* FROM \"approval_policy\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, approvalPolicyPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ApprovalPolicySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ApprovalPolicyExists checks if the ApprovalPolicy row exists.
+func ApprovalPolicyExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"approval_policy\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if approval_policy exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ApprovalPolicy row exists.
+func (o *ApprovalPolicy) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ApprovalPolicyExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testApprovalPolicies(t *testing.T) {
+	t.Parallel()
+
+	query := ApprovalPolicies()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testApprovalPoliciesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ApprovalPolicy{}
+	if err = randomize.Struct(seed, o, approvalPolicyDBTypes, true, approvalPolicyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ApprovalPolicy struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ApprovalPolicies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testApprovalPoliciesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ApprovalPolicy{}
+	if err = randomize.Struct(seed, o, approvalPolicyDBTypes, true, approvalPolicyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ApprovalPolicy struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := ApprovalPolicies().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ApprovalPolicies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testApprovalPoliciesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ApprovalPolicy{}
+	if err = randomize.Struct(seed, o, approvalPolicyDBTypes, true, approvalPolicyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ApprovalPolicy struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ApprovalPolicySlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ApprovalPolicies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testApprovalPoliciesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ApprovalPolicy{}
+	if err = randomize.Struct(seed, o, approvalPolicyDBTypes, true, approvalPolicyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ApprovalPolicy struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ApprovalPolicyExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if ApprovalPolicy exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ApprovalPolicyExists to return true, but got false.")
+	}
+}
+
+func testApprovalPoliciesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ApprovalPolicy{}
+	if err = randomize.Struct(seed, o, approvalPolicyDBTypes, true, approvalPolicyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ApprovalPolicy struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	approvalPolicyFound, err := FindApprovalPolicy(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if approvalPolicyFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testApprovalPoliciesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ApprovalPolicy{}
+	if err = randomize.Struct(seed, o, approvalPolicyDBTypes, true, approvalPolicyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ApprovalPolicy struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = ApprovalPolicies().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testApprovalPoliciesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ApprovalPolicy{}
+	if err = randomize.Struct(seed, o, approvalPolicyDBTypes, true, approvalPolicyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ApprovalPolicy struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := ApprovalPolicies().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testApprovalPoliciesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	approvalPolicyOne := &ApprovalPolicy{}
+	approvalPolicyTwo := &ApprovalPolicy{}
+	if err = randomize.Struct(seed, approvalPolicyOne, approvalPolicyDBTypes, false, approvalPolicyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ApprovalPolicy struct: %s", err)
+	}
+	if err = randomize.Struct(seed, approvalPolicyTwo, approvalPolicyDBTypes, false, approvalPolicyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ApprovalPolicy struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = approvalPolicyOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = approvalPolicyTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ApprovalPolicies().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testApprovalPoliciesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	approvalPolicyOne := &ApprovalPolicy{}
+	approvalPolicyTwo := &ApprovalPolicy{}
+	if err = randomize.Struct(seed, approvalPolicyOne, approvalPolicyDBTypes, false, approvalPolicyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ApprovalPolicy struct: %s", err)
+	}
+	if err = randomize.Struct(seed, approvalPolicyTwo, approvalPolicyDBTypes, false, approvalPolicyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ApprovalPolicy struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = approvalPolicyOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = approvalPolicyTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ApprovalPolicies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func approvalPolicyBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *ApprovalPolicy) error {
+	*o = ApprovalPolicy{}
+	return nil
+}
+
+func approvalPolicyAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *ApprovalPolicy) error {
+	*o = ApprovalPolicy{}
+	return nil
+}
+
+func approvalPolicyAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *ApprovalPolicy) error {
+	*o = ApprovalPolicy{}
+	return nil
+}
+
+func approvalPolicyBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ApprovalPolicy) error {
+	*o = ApprovalPolicy{}
+	return nil
+}
+
+func approvalPolicyAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ApprovalPolicy) error {
+	*o = ApprovalPolicy{}
+	return nil
+}
+
+func approvalPolicyBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ApprovalPolicy) error {
+	*o = ApprovalPolicy{}
+	return nil
+}
+
+func approvalPolicyAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ApprovalPolicy) error {
+	*o = ApprovalPolicy{}
+	return nil
+}
+
+func approvalPolicyBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ApprovalPolicy) error {
+	*o = ApprovalPolicy{}
+	return nil
+}
+
+func approvalPolicyAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ApprovalPolicy) error {
+	*o = ApprovalPolicy{}
+	return nil
+}
+
+func testApprovalPoliciesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &ApprovalPolicy{}
+	o := &ApprovalPolicy{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, approvalPolicyDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize ApprovalPolicy object: %s", err)
+	}
+
+	AddApprovalPolicyHook(boil.BeforeInsertHook, approvalPolicyBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	approvalPolicyBeforeInsertHooks = []ApprovalPolicyHook{}
+
+	AddApprovalPolicyHook(boil.AfterInsertHook, approvalPolicyAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	approvalPolicyAfterInsertHooks = []ApprovalPolicyHook{}
+
+	AddApprovalPolicyHook(boil.AfterSelectHook, approvalPolicyAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	approvalPolicyAfterSelectHooks = []ApprovalPolicyHook{}
+
+	AddApprovalPolicyHook(boil.BeforeUpdateHook, approvalPolicyBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	approvalPolicyBeforeUpdateHooks = []ApprovalPolicyHook{}
+
+	AddApprovalPolicyHook(boil.AfterUpdateHook, approvalPolicyAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	approvalPolicyAfterUpdateHooks = []ApprovalPolicyHook{}
+
+	AddApprovalPolicyHook(boil.BeforeDeleteHook, approvalPolicyBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	approvalPolicyBeforeDeleteHooks = []ApprovalPolicyHook{}
+
+	AddApprovalPolicyHook(boil.AfterDeleteHook, approvalPolicyAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	approvalPolicyAfterDeleteHooks = []ApprovalPolicyHook{}
+
+	AddApprovalPolicyHook(boil.BeforeUpsertHook, approvalPolicyBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	approvalPolicyBeforeUpsertHooks = []ApprovalPolicyHook{}
+
+	AddApprovalPolicyHook(boil.AfterUpsertHook, approvalPolicyAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	approvalPolicyAfterUpsertHooks = []ApprovalPolicyHook{}
+}
+
+func testApprovalPoliciesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ApprovalPolicy{}
+	if err = randomize.Struct(seed, o, approvalPolicyDBTypes, true, approvalPolicyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ApprovalPolicy struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ApprovalPolicies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testApprovalPoliciesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ApprovalPolicy{}
+	if err = randomize.Struct(seed, o, approvalPolicyDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ApprovalPolicy struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(approvalPolicyColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ApprovalPolicies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testApprovalPoliciesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ApprovalPolicy{}
+	if err = randomize.Struct(seed, o, approvalPolicyDBTypes, true, approvalPolicyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ApprovalPolicy struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testApprovalPoliciesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ApprovalPolicy{}
+	if err = randomize.Struct(seed, o, approvalPolicyDBTypes, true, approvalPolicyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ApprovalPolicy struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ApprovalPolicySlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testApprovalPoliciesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ApprovalPolicy{}
+	if err = randomize.Struct(seed, o, approvalPolicyDBTypes, true, approvalPolicyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ApprovalPolicy struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ApprovalPolicies().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	approvalPolicyDBTypes = map[string]string{`ID`: `integer`, `Name`: `character varying`, `Subject`: `character varying`, `MinChangePercentage`: `double precision`, `RequesterRoles`: `ARRAYcharacter varying`, `ApproverRoles`: `ARRAYcharacter varying`, `NotifyEmails`: `ARRAYcharacter varying`, `NotifySlack`: `boolean`, `Active`: `boolean`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                     = bytes.MinRead
+)
+
+func testApprovalPoliciesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(approvalPolicyPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(approvalPolicyAllColumns) == len(approvalPolicyPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ApprovalPolicy{}
+	if err = randomize.Struct(seed, o, approvalPolicyDBTypes, true, approvalPolicyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ApprovalPolicy struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ApprovalPolicies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, approvalPolicyDBTypes, true, approvalPolicyPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ApprovalPolicy struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testApprovalPoliciesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(approvalPolicyAllColumns) == len(approvalPolicyPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ApprovalPolicy{}
+	if err = randomize.Struct(seed, o, approvalPolicyDBTypes, true, approvalPolicyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ApprovalPolicy struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ApprovalPolicies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, approvalPolicyDBTypes, true, approvalPolicyPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ApprovalPolicy struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(approvalPolicyAllColumns, approvalPolicyPrimaryKeyColumns) {
+		fields = approvalPolicyAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			approvalPolicyAllColumns,
+			approvalPolicyPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ApprovalPolicySlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testApprovalPoliciesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(approvalPolicyAllColumns) == len(approvalPolicyPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := ApprovalPolicy{}
+	if err = randomize.Struct(seed, &o, approvalPolicyDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ApprovalPolicy struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ApprovalPolicy: %s", err)
+	}
+
+	count, err := ApprovalPolicies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, approvalPolicyDBTypes, false, approvalPolicyPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ApprovalPolicy struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ApprovalPolicy: %s", err)
+	}
+
+	count, err = ApprovalPolicies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
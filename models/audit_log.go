@@ -0,0 +1,1051 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// AuditLog is an object representing the database table.
+type AuditLog struct {
+	ID          int         `boil:"id" json:"id" toml:"id" yaml:"id"`
+	RequestID   null.String `boil:"request_id" json:"request_id,omitempty" toml:"request_id" yaml:"request_id,omitempty"`
+	UserID      null.Int    `boil:"user_id" json:"user_id,omitempty" toml:"user_id" yaml:"user_id,omitempty"`
+	Role        null.String `boil:"role" json:"role,omitempty" toml:"role" yaml:"role,omitempty"`
+	APIKeyID    null.Int    `boil:"api_key_id" json:"api_key_id,omitempty" toml:"api_key_id" yaml:"api_key_id,omitempty"`
+	Method      string      `boil:"method" json:"method" toml:"method" yaml:"method"`
+	Route       string      `boil:"route" json:"route" toml:"route" yaml:"route"`
+	Path        string      `boil:"path" json:"path" toml:"path" yaml:"path"`
+	Status      int         `boil:"status" json:"status" toml:"status" yaml:"status"`
+	LatencyMS   int         `boil:"latency_ms" json:"latency_ms" toml:"latency_ms" yaml:"latency_ms"`
+	IP          null.String `boil:"ip" json:"ip,omitempty" toml:"ip" yaml:"ip,omitempty"`
+	RequestHash string      `boil:"request_hash" json:"request_hash" toml:"request_hash" yaml:"request_hash"`
+	RequestBody null.JSON   `boil:"request_body" json:"request_body,omitempty" toml:"request_body" yaml:"request_body,omitempty"`
+	RequestSize int         `boil:"request_size" json:"request_size" toml:"request_size" yaml:"request_size"`
+	CreatedAt   time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt   null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *auditLogR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L auditLogL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AuditLogColumns = struct {
+	ID          string
+	RequestID   string
+	UserID      string
+	Role        string
+	APIKeyID    string
+	Method      string
+	Route       string
+	Path        string
+	Status      string
+	LatencyMS   string
+	IP          string
+	RequestHash string
+	RequestBody string
+	RequestSize string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "id",
+	RequestID:   "request_id",
+	UserID:      "user_id",
+	Role:        "role",
+	APIKeyID:    "api_key_id",
+	Method:      "method",
+	Route:       "route",
+	Path:        "path",
+	Status:      "status",
+	LatencyMS:   "latency_ms",
+	IP:          "ip",
+	RequestHash: "request_hash",
+	RequestBody: "request_body",
+	RequestSize: "request_size",
+	CreatedAt:   "created_at",
+	UpdatedAt:   "updated_at",
+}
+
+var AuditLogTableColumns = struct {
+	ID          string
+	RequestID   string
+	UserID      string
+	Role        string
+	APIKeyID    string
+	Method      string
+	Route       string
+	Path        string
+	Status      string
+	LatencyMS   string
+	IP          string
+	RequestHash string
+	RequestBody string
+	RequestSize string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "audit_log.id",
+	RequestID:   "audit_log.request_id",
+	UserID:      "audit_log.user_id",
+	Role:        "audit_log.role",
+	APIKeyID:    "audit_log.api_key_id",
+	Method:      "audit_log.method",
+	Route:       "audit_log.route",
+	Path:        "audit_log.path",
+	Status:      "audit_log.status",
+	LatencyMS:   "audit_log.latency_ms",
+	IP:          "audit_log.ip",
+	RequestHash: "audit_log.request_hash",
+	RequestBody: "audit_log.request_body",
+	RequestSize: "audit_log.request_size",
+	CreatedAt:   "audit_log.created_at",
+	UpdatedAt:   "audit_log.updated_at",
+}
+
+// Generated where
+
+var AuditLogWhere = struct {
+	ID          whereHelperint
+	RequestID   whereHelpernull_String
+	UserID      whereHelpernull_Int
+	Role        whereHelpernull_String
+	APIKeyID    whereHelpernull_Int
+	Method      whereHelperstring
+	Route       whereHelperstring
+	Path        whereHelperstring
+	Status      whereHelperint
+	LatencyMS   whereHelperint
+	IP          whereHelpernull_String
+	RequestHash whereHelperstring
+	RequestBody whereHelpernull_JSON
+	RequestSize whereHelperint
+	CreatedAt   whereHelpertime_Time
+	UpdatedAt   whereHelpernull_Time
+}{
+	ID:          whereHelperint{field: "\"audit_log\".\"id\""},
+	RequestID:   whereHelpernull_String{field: "\"audit_log\".\"request_id\""},
+	UserID:      whereHelpernull_Int{field: "\"audit_log\".\"user_id\""},
+	Role:        whereHelpernull_String{field: "\"audit_log\".\"role\""},
+	APIKeyID:    whereHelpernull_Int{field: "\"audit_log\".\"api_key_id\""},
+	Method:      whereHelperstring{field: "\"audit_log\".\"method\""},
+	Route:       whereHelperstring{field: "\"audit_log\".\"route\""},
+	Path:        whereHelperstring{field: "\"audit_log\".\"path\""},
+	Status:      whereHelperint{field: "\"audit_log\".\"status\""},
+	LatencyMS:   whereHelperint{field: "\"audit_log\".\"latency_ms\""},
+	IP:          whereHelpernull_String{field: "\"audit_log\".\"ip\""},
+	RequestHash: whereHelperstring{field: "\"audit_log\".\"request_hash\""},
+	RequestBody: whereHelpernull_JSON{field: "\"audit_log\".\"request_body\""},
+	RequestSize: whereHelperint{field: "\"audit_log\".\"request_size\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"audit_log\".\"created_at\""},
+	UpdatedAt:   whereHelpernull_Time{field: "\"audit_log\".\"updated_at\""},
+}
+
+// AuditLogRels is where relationship names are stored.
+var AuditLogRels = struct {
+}{}
+
+// auditLogR is where relationships are stored.
+type auditLogR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*auditLogR) NewStruct() *auditLogR {
+	return &auditLogR{}
+}
+
+// auditLogL is where Load methods for each relationship are stored.
+type auditLogL struct{}
+
+var (
+	auditLogAllColumns            = []string{"id", "request_id", "user_id", "role", "api_key_id", "method", "route", "path", "status", "latency_ms", "ip", "request_hash", "request_body", "request_size", "created_at", "updated_at"}
+	auditLogColumnsWithoutDefault = []string{"method", "route", "path", "status", "latency_ms", "request_hash", "created_at"}
+	auditLogColumnsWithDefault    = []string{"id", "request_id", "user_id", "role", "api_key_id", "ip", "request_body", "request_size", "updated_at"}
+	auditLogPrimaryKeyColumns     = []string{"id", "created_at"}
+	auditLogGeneratedColumns      = []string{}
+)
+
+type (
+	// AuditLogSlice is an alias for a slice of pointers to AuditLog.
+	// This should almost always be used instead of []AuditLog.
+	AuditLogSlice []*AuditLog
+	// AuditLogHook is the signature for custom AuditLog hook methods
+	AuditLogHook func(context.Context, boil.ContextExecutor, *AuditLog) error
+
+	auditLogQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	auditLogType                 = reflect.TypeOf(&AuditLog{})
+	auditLogMapping              = queries.MakeStructMapping(auditLogType)
+	auditLogPrimaryKeyMapping, _ = queries.BindMapping(auditLogType, auditLogMapping, auditLogPrimaryKeyColumns)
+	auditLogInsertCacheMut       sync.RWMutex
+	auditLogInsertCache          = make(map[string]insertCache)
+	auditLogUpdateCacheMut       sync.RWMutex
+	auditLogUpdateCache          = make(map[string]updateCache)
+	auditLogUpsertCacheMut       sync.RWMutex
+	auditLogUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var auditLogAfterSelectMu sync.Mutex
+var auditLogAfterSelectHooks []AuditLogHook
+
+var auditLogBeforeInsertMu sync.Mutex
+var auditLogBeforeInsertHooks []AuditLogHook
+var auditLogAfterInsertMu sync.Mutex
+var auditLogAfterInsertHooks []AuditLogHook
+
+var auditLogBeforeUpdateMu sync.Mutex
+var auditLogBeforeUpdateHooks []AuditLogHook
+var auditLogAfterUpdateMu sync.Mutex
+var auditLogAfterUpdateHooks []AuditLogHook
+
+var auditLogBeforeDeleteMu sync.Mutex
+var auditLogBeforeDeleteHooks []AuditLogHook
+var auditLogAfterDeleteMu sync.Mutex
+var auditLogAfterDeleteHooks []AuditLogHook
+
+var auditLogBeforeUpsertMu sync.Mutex
+var auditLogBeforeUpsertHooks []AuditLogHook
+var auditLogAfterUpsertMu sync.Mutex
+var auditLogAfterUpsertHooks []AuditLogHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *AuditLog) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range auditLogAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *AuditLog) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range auditLogBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *AuditLog) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range auditLogAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *AuditLog) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range auditLogBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *AuditLog) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range auditLogAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *AuditLog) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range auditLogBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *AuditLog) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range auditLogAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *AuditLog) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range auditLogBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *AuditLog) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range auditLogAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddAuditLogHook registers your hook function for all future operations.
+func AddAuditLogHook(hookPoint boil.HookPoint, auditLogHook AuditLogHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		auditLogAfterSelectMu.Lock()
+		auditLogAfterSelectHooks = append(auditLogAfterSelectHooks, auditLogHook)
+		auditLogAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		auditLogBeforeInsertMu.Lock()
+		auditLogBeforeInsertHooks = append(auditLogBeforeInsertHooks, auditLogHook)
+		auditLogBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		auditLogAfterInsertMu.Lock()
+		auditLogAfterInsertHooks = append(auditLogAfterInsertHooks, auditLogHook)
+		auditLogAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		auditLogBeforeUpdateMu.Lock()
+		auditLogBeforeUpdateHooks = append(auditLogBeforeUpdateHooks, auditLogHook)
+		auditLogBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		auditLogAfterUpdateMu.Lock()
+		auditLogAfterUpdateHooks = append(auditLogAfterUpdateHooks, auditLogHook)
+		auditLogAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		auditLogBeforeDeleteMu.Lock()
+		auditLogBeforeDeleteHooks = append(auditLogBeforeDeleteHooks, auditLogHook)
+		auditLogBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		auditLogAfterDeleteMu.Lock()
+		auditLogAfterDeleteHooks = append(auditLogAfterDeleteHooks, auditLogHook)
+		auditLogAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		auditLogBeforeUpsertMu.Lock()
+		auditLogBeforeUpsertHooks = append(auditLogBeforeUpsertHooks, auditLogHook)
+		auditLogBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		auditLogAfterUpsertMu.Lock()
+		auditLogAfterUpsertHooks = append(auditLogAfterUpsertHooks, auditLogHook)
+		auditLogAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single auditLog record from the query.
+func (q auditLogQuery) One(ctx context.Context, exec boil.ContextExecutor) (*AuditLog, error) {
+	o := &AuditLog{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for audit_log")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all AuditLog records from the query.
+func (q auditLogQuery) All(ctx context.Context, exec boil.ContextExecutor) (AuditLogSlice, error) {
+	var o []*AuditLog
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to AuditLog slice")
+	}
+
+	if len(auditLogAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all AuditLog records in the query.
+func (q auditLogQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count audit_log rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q auditLogQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if audit_log exists")
+	}
+
+	return count > 0, nil
+}
+
+// AuditLogs retrieves all the records using an executor.
+func AuditLogs(mods ...qm.QueryMod) auditLogQuery {
+	mods = append(mods, qm.From("\"audit_log\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"audit_log\".*"})
+	}
+
+	return auditLogQuery{q}
+}
+
+// FindAuditLog retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAuditLog(ctx context.Context, exec boil.ContextExecutor, iD int, createdAt time.Time, selectCols ...string) (*AuditLog, error) {
+	auditLogObj := &AuditLog{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"audit_log\" where \"id\"=$1 AND \"created_at\"=$2", sel,
+	)
+
+	q := queries.Raw(query, iD, createdAt)
+
+	err := q.Bind(ctx, exec, auditLogObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from audit_log")
+	}
+
+	if err = auditLogObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return auditLogObj, err
+	}
+
+	return auditLogObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *AuditLog) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no audit_log provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(auditLogColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	auditLogInsertCacheMut.RLock()
+	cache, cached := auditLogInsertCache[key]
+	auditLogInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			auditLogAllColumns,
+			auditLogColumnsWithDefault,
+			auditLogColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(auditLogType, auditLogMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(auditLogType, auditLogMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"audit_log\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"audit_log\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into audit_log")
+	}
+
+	if !cached {
+		auditLogInsertCacheMut.Lock()
+		auditLogInsertCache[key] = cache
+		auditLogInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the AuditLog.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *AuditLog) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	auditLogUpdateCacheMut.RLock()
+	cache, cached := auditLogUpdateCache[key]
+	auditLogUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			auditLogAllColumns,
+			auditLogPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update audit_log, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"audit_log\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, auditLogPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(auditLogType, auditLogMapping, append(wl, auditLogPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update audit_log row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for audit_log")
+	}
+
+	if !cached {
+		auditLogUpdateCacheMut.Lock()
+		auditLogUpdateCache[key] = cache
+		auditLogUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q auditLogQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for audit_log")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for audit_log")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AuditLogSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), auditLogPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"audit_log\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, auditLogPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in auditLog slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all auditLog")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *AuditLog) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no audit_log provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(auditLogColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	auditLogUpsertCacheMut.RLock()
+	cache, cached := auditLogUpsertCache[key]
+	auditLogUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			auditLogAllColumns,
+			auditLogColumnsWithDefault,
+			auditLogColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			auditLogAllColumns,
+			auditLogPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert audit_log, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(auditLogAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(auditLogPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert audit_log, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(auditLogPrimaryKeyColumns))
+			copy(conflict, auditLogPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"audit_log\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(auditLogType, auditLogMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(auditLogType, auditLogMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert audit_log")
+	}
+
+	if !cached {
+		auditLogUpsertCacheMut.Lock()
+		auditLogUpsertCache[key] = cache
+		auditLogUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single AuditLog record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *AuditLog) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no AuditLog provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), auditLogPrimaryKeyMapping)
+	sql := "DELETE FROM \"audit_log\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from audit_log")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for audit_log")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q auditLogQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no auditLogQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from audit_log")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for audit_log")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AuditLogSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(auditLogBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), auditLogPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"audit_log\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, auditLogPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from auditLog slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for audit_log")
+	}
+
+	if len(auditLogAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *AuditLog) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindAuditLog(ctx, exec, o.ID, o.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AuditLogSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AuditLogSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), auditLogPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"audit_log\".* FROM \"audit_log\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, auditLogPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in AuditLogSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AuditLogExists checks if the AuditLog row exists.
+func AuditLogExists(ctx context.Context, exec boil.ContextExecutor, iD int, createdAt time.Time) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"audit_log\" where \"id\"=$1 AND \"created_at\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD, createdAt)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD, createdAt)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if audit_log exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the AuditLog row exists.
+func (o *AuditLog) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return AuditLogExists(ctx, exec, o.ID, o.CreatedAt)
+}
@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testAuditLogs(t *testing.T) {
+	t.Parallel()
+
+	query := AuditLogs()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testAuditLogsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditLog{}
+	if err = randomize.Struct(seed, o, auditLogDBTypes, true, auditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AuditLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAuditLogsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditLog{}
+	if err = randomize.Struct(seed, o, auditLogDBTypes, true, auditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := AuditLogs().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AuditLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAuditLogsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditLog{}
+	if err = randomize.Struct(seed, o, auditLogDBTypes, true, auditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := AuditLogSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AuditLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAuditLogsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditLog{}
+	if err = randomize.Struct(seed, o, auditLogDBTypes, true, auditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := AuditLogExists(ctx, tx, o.ID, o.CreatedAt)
+	if err != nil {
+		t.Errorf("Unable to check if AuditLog exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected AuditLogExists to return true, but got false.")
+	}
+}
+
+func testAuditLogsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditLog{}
+	if err = randomize.Struct(seed, o, auditLogDBTypes, true, auditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	auditLogFound, err := FindAuditLog(ctx, tx, o.ID, o.CreatedAt)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if auditLogFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testAuditLogsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditLog{}
+	if err = randomize.Struct(seed, o, auditLogDBTypes, true, auditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = AuditLogs().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAuditLogsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditLog{}
+	if err = randomize.Struct(seed, o, auditLogDBTypes, true, auditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := AuditLogs().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testAuditLogsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	auditLogOne := &AuditLog{}
+	auditLogTwo := &AuditLog{}
+	if err = randomize.Struct(seed, auditLogOne, auditLogDBTypes, false, auditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditLog struct: %s", err)
+	}
+	if err = randomize.Struct(seed, auditLogTwo, auditLogDBTypes, false, auditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = auditLogOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = auditLogTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := AuditLogs().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testAuditLogsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	auditLogOne := &AuditLog{}
+	auditLogTwo := &AuditLog{}
+	if err = randomize.Struct(seed, auditLogOne, auditLogDBTypes, false, auditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditLog struct: %s", err)
+	}
+	if err = randomize.Struct(seed, auditLogTwo, auditLogDBTypes, false, auditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = auditLogOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = auditLogTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AuditLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func auditLogBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *AuditLog) error {
+	*o = AuditLog{}
+	return nil
+}
+
+func auditLogAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *AuditLog) error {
+	*o = AuditLog{}
+	return nil
+}
+
+func auditLogAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *AuditLog) error {
+	*o = AuditLog{}
+	return nil
+}
+
+func auditLogBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *AuditLog) error {
+	*o = AuditLog{}
+	return nil
+}
+
+func auditLogAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *AuditLog) error {
+	*o = AuditLog{}
+	return nil
+}
+
+func auditLogBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *AuditLog) error {
+	*o = AuditLog{}
+	return nil
+}
+
+func auditLogAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *AuditLog) error {
+	*o = AuditLog{}
+	return nil
+}
+
+func auditLogBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *AuditLog) error {
+	*o = AuditLog{}
+	return nil
+}
+
+func auditLogAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *AuditLog) error {
+	*o = AuditLog{}
+	return nil
+}
+
+func testAuditLogsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &AuditLog{}
+	o := &AuditLog{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, auditLogDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize AuditLog object: %s", err)
+	}
+
+	AddAuditLogHook(boil.BeforeInsertHook, auditLogBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	auditLogBeforeInsertHooks = []AuditLogHook{}
+
+	AddAuditLogHook(boil.AfterInsertHook, auditLogAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	auditLogAfterInsertHooks = []AuditLogHook{}
+
+	AddAuditLogHook(boil.AfterSelectHook, auditLogAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	auditLogAfterSelectHooks = []AuditLogHook{}
+
+	AddAuditLogHook(boil.BeforeUpdateHook, auditLogBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	auditLogBeforeUpdateHooks = []AuditLogHook{}
+
+	AddAuditLogHook(boil.AfterUpdateHook, auditLogAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	auditLogAfterUpdateHooks = []AuditLogHook{}
+
+	AddAuditLogHook(boil.BeforeDeleteHook, auditLogBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	auditLogBeforeDeleteHooks = []AuditLogHook{}
+
+	AddAuditLogHook(boil.AfterDeleteHook, auditLogAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	auditLogAfterDeleteHooks = []AuditLogHook{}
+
+	AddAuditLogHook(boil.BeforeUpsertHook, auditLogBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	auditLogBeforeUpsertHooks = []AuditLogHook{}
+
+	AddAuditLogHook(boil.AfterUpsertHook, auditLogAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	auditLogAfterUpsertHooks = []AuditLogHook{}
+}
+
+func testAuditLogsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditLog{}
+	if err = randomize.Struct(seed, o, auditLogDBTypes, true, auditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AuditLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testAuditLogsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditLog{}
+	if err = randomize.Struct(seed, o, auditLogDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize AuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(auditLogColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AuditLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testAuditLogsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditLog{}
+	if err = randomize.Struct(seed, o, auditLogDBTypes, true, auditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAuditLogsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditLog{}
+	if err = randomize.Struct(seed, o, auditLogDBTypes, true, auditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := AuditLogSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAuditLogsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditLog{}
+	if err = randomize.Struct(seed, o, auditLogDBTypes, true, auditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := AuditLogs().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	auditLogDBTypes = map[string]string{`ID`: `integer`, `RequestID`: `character varying`, `UserID`: `integer`, `Role`: `character varying`, `APIKeyID`: `integer`, `Method`: `character varying`, `Route`: `character varying`, `Path`: `character varying`, `Status`: `integer`, `LatencyMS`: `integer`, `IP`: `character varying`, `RequestHash`: `character varying`, `RequestBody`: `jsonb`, `RequestSize`: `integer`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_               = bytes.MinRead
+)
+
+func testAuditLogsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(auditLogPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(auditLogAllColumns) == len(auditLogPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditLog{}
+	if err = randomize.Struct(seed, o, auditLogDBTypes, true, auditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AuditLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, auditLogDBTypes, true, auditLogPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AuditLog struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testAuditLogsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(auditLogAllColumns) == len(auditLogPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditLog{}
+	if err = randomize.Struct(seed, o, auditLogDBTypes, true, auditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AuditLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, auditLogDBTypes, true, auditLogPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AuditLog struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(auditLogAllColumns, auditLogPrimaryKeyColumns) {
+		fields = auditLogAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			auditLogAllColumns,
+			auditLogPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := AuditLogSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testAuditLogsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(auditLogAllColumns) == len(auditLogPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := AuditLog{}
+	if err = randomize.Struct(seed, &o, auditLogDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize AuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert AuditLog: %s", err)
+	}
+
+	count, err := AuditLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, auditLogDBTypes, false, auditLogPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AuditLog struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert AuditLog: %s", err)
+	}
+
+	count, err = AuditLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1016 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// AutomationCircuitBreaker is an object representing the database table.
+type AutomationCircuitBreaker struct {
+	ID               int          `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Automation       string       `boil:"automation" json:"automation" toml:"automation" yaml:"automation"`
+	Tripped          bool         `boil:"tripped" json:"tripped" toml:"tripped" yaml:"tripped"`
+	Reason           null.String  `boil:"reason" json:"reason,omitempty" toml:"reason" yaml:"reason,omitempty"`
+	TrippedAt        null.Time    `boil:"tripped_at" json:"tripped_at,omitempty" toml:"tripped_at" yaml:"tripped_at,omitempty"`
+	LastInputRecords null.Int     `boil:"last_input_records" json:"last_input_records,omitempty" toml:"last_input_records" yaml:"last_input_records,omitempty"`
+	LastInputVolume  null.Float64 `boil:"last_input_volume" json:"last_input_volume,omitempty" toml:"last_input_volume" yaml:"last_input_volume,omitempty"`
+	ResetBy          null.Int     `boil:"reset_by" json:"reset_by,omitempty" toml:"reset_by" yaml:"reset_by,omitempty"`
+	ResetAt          null.Time    `boil:"reset_at" json:"reset_at,omitempty" toml:"reset_at" yaml:"reset_at,omitempty"`
+	CreatedAt        time.Time    `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt        null.Time    `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *automationCircuitBreakerR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L automationCircuitBreakerL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AutomationCircuitBreakerColumns = struct {
+	ID               string
+	Automation       string
+	Tripped          string
+	Reason           string
+	TrippedAt        string
+	LastInputRecords string
+	LastInputVolume  string
+	ResetBy          string
+	ResetAt          string
+	CreatedAt        string
+	UpdatedAt        string
+}{
+	ID:               "id",
+	Automation:       "automation",
+	Tripped:          "tripped",
+	Reason:           "reason",
+	TrippedAt:        "tripped_at",
+	LastInputRecords: "last_input_records",
+	LastInputVolume:  "last_input_volume",
+	ResetBy:          "reset_by",
+	ResetAt:          "reset_at",
+	CreatedAt:        "created_at",
+	UpdatedAt:        "updated_at",
+}
+
+var AutomationCircuitBreakerTableColumns = struct {
+	ID               string
+	Automation       string
+	Tripped          string
+	Reason           string
+	TrippedAt        string
+	LastInputRecords string
+	LastInputVolume  string
+	ResetBy          string
+	ResetAt          string
+	CreatedAt        string
+	UpdatedAt        string
+}{
+	ID:               "automation_circuit_breaker.id",
+	Automation:       "automation_circuit_breaker.automation",
+	Tripped:          "automation_circuit_breaker.tripped",
+	Reason:           "automation_circuit_breaker.reason",
+	TrippedAt:        "automation_circuit_breaker.tripped_at",
+	LastInputRecords: "automation_circuit_breaker.last_input_records",
+	LastInputVolume:  "automation_circuit_breaker.last_input_volume",
+	ResetBy:          "automation_circuit_breaker.reset_by",
+	ResetAt:          "automation_circuit_breaker.reset_at",
+	CreatedAt:        "automation_circuit_breaker.created_at",
+	UpdatedAt:        "automation_circuit_breaker.updated_at",
+}
+
+// Generated where
+
+var AutomationCircuitBreakerWhere = struct {
+	ID               whereHelperint
+	Automation       whereHelperstring
+	Tripped          whereHelperbool
+	Reason           whereHelpernull_String
+	TrippedAt        whereHelpernull_Time
+	LastInputRecords whereHelpernull_Int
+	LastInputVolume  whereHelpernull_Float64
+	ResetBy          whereHelpernull_Int
+	ResetAt          whereHelpernull_Time
+	CreatedAt        whereHelpertime_Time
+	UpdatedAt        whereHelpernull_Time
+}{
+	ID:               whereHelperint{field: "\"automation_circuit_breaker\".\"id\""},
+	Automation:       whereHelperstring{field: "\"automation_circuit_breaker\".\"automation\""},
+	Tripped:          whereHelperbool{field: "\"automation_circuit_breaker\".\"tripped\""},
+	Reason:           whereHelpernull_String{field: "\"automation_circuit_breaker\".\"reason\""},
+	TrippedAt:        whereHelpernull_Time{field: "\"automation_circuit_breaker\".\"tripped_at\""},
+	LastInputRecords: whereHelpernull_Int{field: "\"automation_circuit_breaker\".\"last_input_records\""},
+	LastInputVolume:  whereHelpernull_Float64{field: "\"automation_circuit_breaker\".\"last_input_volume\""},
+	ResetBy:          whereHelpernull_Int{field: "\"automation_circuit_breaker\".\"reset_by\""},
+	ResetAt:          whereHelpernull_Time{field: "\"automation_circuit_breaker\".\"reset_at\""},
+	CreatedAt:        whereHelpertime_Time{field: "\"automation_circuit_breaker\".\"created_at\""},
+	UpdatedAt:        whereHelpernull_Time{field: "\"automation_circuit_breaker\".\"updated_at\""},
+}
+
+// AutomationCircuitBreakerRels is where relationship names are stored.
+var AutomationCircuitBreakerRels = struct {
+}{}
+
+// automationCircuitBreakerR is where relationships are stored.
+type automationCircuitBreakerR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*automationCircuitBreakerR) NewStruct() *automationCircuitBreakerR {
+	return &automationCircuitBreakerR{}
+}
+
+// automationCircuitBreakerL is where Load methods for each relationship are stored.
+type automationCircuitBreakerL struct{}
+
+var (
+	automationCircuitBreakerAllColumns            = []string{"id", "automation", "tripped", "reason", "tripped_at", "last_input_records", "last_input_volume", "reset_by", "reset_at", "created_at", "updated_at"}
+	automationCircuitBreakerColumnsWithoutDefault = []string{"automation", "created_at"}
+	automationCircuitBreakerColumnsWithDefault    = []string{"id", "tripped", "reason", "tripped_at", "last_input_records", "last_input_volume", "reset_by", "reset_at", "updated_at"}
+	automationCircuitBreakerPrimaryKeyColumns     = []string{"id"}
+	automationCircuitBreakerGeneratedColumns      = []string{}
+)
+
+type (
+	// AutomationCircuitBreakerSlice is an alias for a slice of pointers to AutomationCircuitBreaker.
+	// This should almost always be used instead of []AutomationCircuitBreaker.
+	AutomationCircuitBreakerSlice []*AutomationCircuitBreaker
+	// AutomationCircuitBreakerHook is the signature for custom AutomationCircuitBreaker hook methods
+	AutomationCircuitBreakerHook func(context.Context, boil.ContextExecutor, *AutomationCircuitBreaker) error
+
+	automationCircuitBreakerQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	automationCircuitBreakerType                 = reflect.TypeOf(&AutomationCircuitBreaker{})
+	automationCircuitBreakerMapping              = queries.MakeStructMapping(automationCircuitBreakerType)
+	automationCircuitBreakerPrimaryKeyMapping, _ = queries.BindMapping(automationCircuitBreakerType, automationCircuitBreakerMapping, automationCircuitBreakerPrimaryKeyColumns)
+	automationCircuitBreakerInsertCacheMut       sync.RWMutex
+	automationCircuitBreakerInsertCache          = make(map[string]insertCache)
+	automationCircuitBreakerUpdateCacheMut       sync.RWMutex
+	automationCircuitBreakerUpdateCache          = make(map[string]updateCache)
+	automationCircuitBreakerUpsertCacheMut       sync.RWMutex
+	automationCircuitBreakerUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var automationCircuitBreakerAfterSelectMu sync.Mutex
+var automationCircuitBreakerAfterSelectHooks []AutomationCircuitBreakerHook
+
+var automationCircuitBreakerBeforeInsertMu sync.Mutex
+var automationCircuitBreakerBeforeInsertHooks []AutomationCircuitBreakerHook
+var automationCircuitBreakerAfterInsertMu sync.Mutex
+var automationCircuitBreakerAfterInsertHooks []AutomationCircuitBreakerHook
+
+var automationCircuitBreakerBeforeUpdateMu sync.Mutex
+var automationCircuitBreakerBeforeUpdateHooks []AutomationCircuitBreakerHook
+var automationCircuitBreakerAfterUpdateMu sync.Mutex
+var automationCircuitBreakerAfterUpdateHooks []AutomationCircuitBreakerHook
+
+var automationCircuitBreakerBeforeDeleteMu sync.Mutex
+var automationCircuitBreakerBeforeDeleteHooks []AutomationCircuitBreakerHook
+var automationCircuitBreakerAfterDeleteMu sync.Mutex
+var automationCircuitBreakerAfterDeleteHooks []AutomationCircuitBreakerHook
+
+var automationCircuitBreakerBeforeUpsertMu sync.Mutex
+var automationCircuitBreakerBeforeUpsertHooks []AutomationCircuitBreakerHook
+var automationCircuitBreakerAfterUpsertMu sync.Mutex
+var automationCircuitBreakerAfterUpsertHooks []AutomationCircuitBreakerHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *AutomationCircuitBreaker) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range automationCircuitBreakerAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *AutomationCircuitBreaker) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range automationCircuitBreakerBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *AutomationCircuitBreaker) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range automationCircuitBreakerAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *AutomationCircuitBreaker) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range automationCircuitBreakerBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *AutomationCircuitBreaker) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range automationCircuitBreakerAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *AutomationCircuitBreaker) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range automationCircuitBreakerBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *AutomationCircuitBreaker) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range automationCircuitBreakerAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *AutomationCircuitBreaker) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range automationCircuitBreakerBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *AutomationCircuitBreaker) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range automationCircuitBreakerAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddAutomationCircuitBreakerHook registers your hook function for all future operations.
+func AddAutomationCircuitBreakerHook(hookPoint boil.HookPoint, automationCircuitBreakerHook AutomationCircuitBreakerHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		automationCircuitBreakerAfterSelectMu.Lock()
+		automationCircuitBreakerAfterSelectHooks = append(automationCircuitBreakerAfterSelectHooks, automationCircuitBreakerHook)
+		automationCircuitBreakerAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		automationCircuitBreakerBeforeInsertMu.Lock()
+		automationCircuitBreakerBeforeInsertHooks = append(automationCircuitBreakerBeforeInsertHooks, automationCircuitBreakerHook)
+		automationCircuitBreakerBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		automationCircuitBreakerAfterInsertMu.Lock()
+		automationCircuitBreakerAfterInsertHooks = append(automationCircuitBreakerAfterInsertHooks, automationCircuitBreakerHook)
+		automationCircuitBreakerAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		automationCircuitBreakerBeforeUpdateMu.Lock()
+		automationCircuitBreakerBeforeUpdateHooks = append(automationCircuitBreakerBeforeUpdateHooks, automationCircuitBreakerHook)
+		automationCircuitBreakerBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		automationCircuitBreakerAfterUpdateMu.Lock()
+		automationCircuitBreakerAfterUpdateHooks = append(automationCircuitBreakerAfterUpdateHooks, automationCircuitBreakerHook)
+		automationCircuitBreakerAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		automationCircuitBreakerBeforeDeleteMu.Lock()
+		automationCircuitBreakerBeforeDeleteHooks = append(automationCircuitBreakerBeforeDeleteHooks, automationCircuitBreakerHook)
+		automationCircuitBreakerBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		automationCircuitBreakerAfterDeleteMu.Lock()
+		automationCircuitBreakerAfterDeleteHooks = append(automationCircuitBreakerAfterDeleteHooks, automationCircuitBreakerHook)
+		automationCircuitBreakerAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		automationCircuitBreakerBeforeUpsertMu.Lock()
+		automationCircuitBreakerBeforeUpsertHooks = append(automationCircuitBreakerBeforeUpsertHooks, automationCircuitBreakerHook)
+		automationCircuitBreakerBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		automationCircuitBreakerAfterUpsertMu.Lock()
+		automationCircuitBreakerAfterUpsertHooks = append(automationCircuitBreakerAfterUpsertHooks, automationCircuitBreakerHook)
+		automationCircuitBreakerAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single automationCircuitBreaker record from the query.
+func (q automationCircuitBreakerQuery) One(ctx context.Context, exec boil.ContextExecutor) (*AutomationCircuitBreaker, error) {
+	o := &AutomationCircuitBreaker{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for automation_circuit_breaker")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all AutomationCircuitBreaker records from the query.
+func (q automationCircuitBreakerQuery) All(ctx context.Context, exec boil.ContextExecutor) (AutomationCircuitBreakerSlice, error) {
+	var o []*AutomationCircuitBreaker
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to AutomationCircuitBreaker slice")
+	}
+
+	if len(automationCircuitBreakerAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all AutomationCircuitBreaker records in the query.
+func (q automationCircuitBreakerQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count automation_circuit_breaker rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q automationCircuitBreakerQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if automation_circuit_breaker exists")
+	}
+
+	return count > 0, nil
+}
+
+// AutomationCircuitBreakers retrieves all the records using an executor.
+func AutomationCircuitBreakers(mods ...qm.QueryMod) automationCircuitBreakerQuery {
+	mods = append(mods, qm.From("\"automation_circuit_breaker\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"automation_circuit_breaker\".*"})
+	}
+
+	return automationCircuitBreakerQuery{q}
+}
+
+// FindAutomationCircuitBreaker retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAutomationCircuitBreaker(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*AutomationCircuitBreaker, error) {
+	automationCircuitBreakerObj := &AutomationCircuitBreaker{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"automation_circuit_breaker\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, automationCircuitBreakerObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from automation_circuit_breaker")
+	}
+
+	if err = automationCircuitBreakerObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return automationCircuitBreakerObj, err
+	}
+
+	return automationCircuitBreakerObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *AutomationCircuitBreaker) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no automation_circuit_breaker provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(automationCircuitBreakerColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	automationCircuitBreakerInsertCacheMut.RLock()
+	cache, cached := automationCircuitBreakerInsertCache[key]
+	automationCircuitBreakerInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			automationCircuitBreakerAllColumns,
+			automationCircuitBreakerColumnsWithDefault,
+			automationCircuitBreakerColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(automationCircuitBreakerType, automationCircuitBreakerMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(automationCircuitBreakerType, automationCircuitBreakerMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"automation_circuit_breaker\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"automation_circuit_breaker\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into automation_circuit_breaker")
+	}
+
+	if !cached {
+		automationCircuitBreakerInsertCacheMut.Lock()
+		automationCircuitBreakerInsertCache[key] = cache
+		automationCircuitBreakerInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the AutomationCircuitBreaker.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *AutomationCircuitBreaker) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	automationCircuitBreakerUpdateCacheMut.RLock()
+	cache, cached := automationCircuitBreakerUpdateCache[key]
+	automationCircuitBreakerUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			automationCircuitBreakerAllColumns,
+			automationCircuitBreakerPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update automation_circuit_breaker, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"automation_circuit_breaker\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, automationCircuitBreakerPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(automationCircuitBreakerType, automationCircuitBreakerMapping, append(wl, automationCircuitBreakerPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update automation_circuit_breaker row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for automation_circuit_breaker")
+	}
+
+	if !cached {
+		automationCircuitBreakerUpdateCacheMut.Lock()
+		automationCircuitBreakerUpdateCache[key] = cache
+		automationCircuitBreakerUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q automationCircuitBreakerQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for automation_circuit_breaker")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for automation_circuit_breaker")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AutomationCircuitBreakerSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), automationCircuitBreakerPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"automation_circuit_breaker\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, automationCircuitBreakerPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in automationCircuitBreaker slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all automationCircuitBreaker")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *AutomationCircuitBreaker) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no automation_circuit_breaker provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(automationCircuitBreakerColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	automationCircuitBreakerUpsertCacheMut.RLock()
+	cache, cached := automationCircuitBreakerUpsertCache[key]
+	automationCircuitBreakerUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			automationCircuitBreakerAllColumns,
+			automationCircuitBreakerColumnsWithDefault,
+			automationCircuitBreakerColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			automationCircuitBreakerAllColumns,
+			automationCircuitBreakerPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert automation_circuit_breaker, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(automationCircuitBreakerAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(automationCircuitBreakerPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert automation_circuit_breaker, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(automationCircuitBreakerPrimaryKeyColumns))
+			copy(conflict, automationCircuitBreakerPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"automation_circuit_breaker\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(automationCircuitBreakerType, automationCircuitBreakerMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(automationCircuitBreakerType, automationCircuitBreakerMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert automation_circuit_breaker")
+	}
+
+	if !cached {
+		automationCircuitBreakerUpsertCacheMut.Lock()
+		automationCircuitBreakerUpsertCache[key] = cache
+		automationCircuitBreakerUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single AutomationCircuitBreaker record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *AutomationCircuitBreaker) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no AutomationCircuitBreaker provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), automationCircuitBreakerPrimaryKeyMapping)
+	sql := "DELETE FROM \"automation_circuit_breaker\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from automation_circuit_breaker")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for automation_circuit_breaker")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q automationCircuitBreakerQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no automationCircuitBreakerQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from automation_circuit_breaker")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for automation_circuit_breaker")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AutomationCircuitBreakerSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(automationCircuitBreakerBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), automationCircuitBreakerPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"automation_circuit_breaker\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, automationCircuitBreakerPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from automationCircuitBreaker slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for automation_circuit_breaker")
+	}
+
+	if len(automationCircuitBreakerAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *AutomationCircuitBreaker) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindAutomationCircuitBreaker(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AutomationCircuitBreakerSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AutomationCircuitBreakerSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), automationCircuitBreakerPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"automation_circuit_breaker\".* FROM \"automation_circuit_breaker\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, automationCircuitBreakerPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in AutomationCircuitBreakerSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AutomationCircuitBreakerExists checks if the AutomationCircuitBreaker row exists.
+func AutomationCircuitBreakerExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"automation_circuit_breaker\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if automation_circuit_breaker exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the AutomationCircuitBreaker row exists.
+func (o *AutomationCircuitBreaker) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return AutomationCircuitBreakerExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testAutomationCircuitBreakers(t *testing.T) {
+	t.Parallel()
+
+	query := AutomationCircuitBreakers()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testAutomationCircuitBreakersDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AutomationCircuitBreaker{}
+	if err = randomize.Struct(seed, o, automationCircuitBreakerDBTypes, true, automationCircuitBreakerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AutomationCircuitBreaker struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AutomationCircuitBreakers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAutomationCircuitBreakersQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AutomationCircuitBreaker{}
+	if err = randomize.Struct(seed, o, automationCircuitBreakerDBTypes, true, automationCircuitBreakerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AutomationCircuitBreaker struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := AutomationCircuitBreakers().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AutomationCircuitBreakers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAutomationCircuitBreakersSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AutomationCircuitBreaker{}
+	if err = randomize.Struct(seed, o, automationCircuitBreakerDBTypes, true, automationCircuitBreakerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AutomationCircuitBreaker struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := AutomationCircuitBreakerSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AutomationCircuitBreakers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAutomationCircuitBreakersExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AutomationCircuitBreaker{}
+	if err = randomize.Struct(seed, o, automationCircuitBreakerDBTypes, true, automationCircuitBreakerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AutomationCircuitBreaker struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := AutomationCircuitBreakerExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if AutomationCircuitBreaker exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected AutomationCircuitBreakerExists to return true, but got false.")
+	}
+}
+
+func testAutomationCircuitBreakersFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AutomationCircuitBreaker{}
+	if err = randomize.Struct(seed, o, automationCircuitBreakerDBTypes, true, automationCircuitBreakerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AutomationCircuitBreaker struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	automationCircuitBreakerFound, err := FindAutomationCircuitBreaker(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if automationCircuitBreakerFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testAutomationCircuitBreakersBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AutomationCircuitBreaker{}
+	if err = randomize.Struct(seed, o, automationCircuitBreakerDBTypes, true, automationCircuitBreakerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AutomationCircuitBreaker struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = AutomationCircuitBreakers().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAutomationCircuitBreakersOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AutomationCircuitBreaker{}
+	if err = randomize.Struct(seed, o, automationCircuitBreakerDBTypes, true, automationCircuitBreakerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AutomationCircuitBreaker struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := AutomationCircuitBreakers().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testAutomationCircuitBreakersAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	automationCircuitBreakerOne := &AutomationCircuitBreaker{}
+	automationCircuitBreakerTwo := &AutomationCircuitBreaker{}
+	if err = randomize.Struct(seed, automationCircuitBreakerOne, automationCircuitBreakerDBTypes, false, automationCircuitBreakerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AutomationCircuitBreaker struct: %s", err)
+	}
+	if err = randomize.Struct(seed, automationCircuitBreakerTwo, automationCircuitBreakerDBTypes, false, automationCircuitBreakerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AutomationCircuitBreaker struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = automationCircuitBreakerOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = automationCircuitBreakerTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := AutomationCircuitBreakers().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testAutomationCircuitBreakersCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	automationCircuitBreakerOne := &AutomationCircuitBreaker{}
+	automationCircuitBreakerTwo := &AutomationCircuitBreaker{}
+	if err = randomize.Struct(seed, automationCircuitBreakerOne, automationCircuitBreakerDBTypes, false, automationCircuitBreakerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AutomationCircuitBreaker struct: %s", err)
+	}
+	if err = randomize.Struct(seed, automationCircuitBreakerTwo, automationCircuitBreakerDBTypes, false, automationCircuitBreakerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AutomationCircuitBreaker struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = automationCircuitBreakerOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = automationCircuitBreakerTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AutomationCircuitBreakers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func automationCircuitBreakerBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *AutomationCircuitBreaker) error {
+	*o = AutomationCircuitBreaker{}
+	return nil
+}
+
+func automationCircuitBreakerAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *AutomationCircuitBreaker) error {
+	*o = AutomationCircuitBreaker{}
+	return nil
+}
+
+func automationCircuitBreakerAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *AutomationCircuitBreaker) error {
+	*o = AutomationCircuitBreaker{}
+	return nil
+}
+
+func automationCircuitBreakerBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *AutomationCircuitBreaker) error {
+	*o = AutomationCircuitBreaker{}
+	return nil
+}
+
+func automationCircuitBreakerAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *AutomationCircuitBreaker) error {
+	*o = AutomationCircuitBreaker{}
+	return nil
+}
+
+func automationCircuitBreakerBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *AutomationCircuitBreaker) error {
+	*o = AutomationCircuitBreaker{}
+	return nil
+}
+
+func automationCircuitBreakerAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *AutomationCircuitBreaker) error {
+	*o = AutomationCircuitBreaker{}
+	return nil
+}
+
+func automationCircuitBreakerBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *AutomationCircuitBreaker) error {
+	*o = AutomationCircuitBreaker{}
+	return nil
+}
+
+func automationCircuitBreakerAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *AutomationCircuitBreaker) error {
+	*o = AutomationCircuitBreaker{}
+	return nil
+}
+
+func testAutomationCircuitBreakersHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &AutomationCircuitBreaker{}
+	o := &AutomationCircuitBreaker{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, automationCircuitBreakerDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize AutomationCircuitBreaker object: %s", err)
+	}
+
+	AddAutomationCircuitBreakerHook(boil.BeforeInsertHook, automationCircuitBreakerBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	automationCircuitBreakerBeforeInsertHooks = []AutomationCircuitBreakerHook{}
+
+	AddAutomationCircuitBreakerHook(boil.AfterInsertHook, automationCircuitBreakerAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	automationCircuitBreakerAfterInsertHooks = []AutomationCircuitBreakerHook{}
+
+	AddAutomationCircuitBreakerHook(boil.AfterSelectHook, automationCircuitBreakerAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	automationCircuitBreakerAfterSelectHooks = []AutomationCircuitBreakerHook{}
+
+	AddAutomationCircuitBreakerHook(boil.BeforeUpdateHook, automationCircuitBreakerBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	automationCircuitBreakerBeforeUpdateHooks = []AutomationCircuitBreakerHook{}
+
+	AddAutomationCircuitBreakerHook(boil.AfterUpdateHook, automationCircuitBreakerAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	automationCircuitBreakerAfterUpdateHooks = []AutomationCircuitBreakerHook{}
+
+	AddAutomationCircuitBreakerHook(boil.BeforeDeleteHook, automationCircuitBreakerBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	automationCircuitBreakerBeforeDeleteHooks = []AutomationCircuitBreakerHook{}
+
+	AddAutomationCircuitBreakerHook(boil.AfterDeleteHook, automationCircuitBreakerAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	automationCircuitBreakerAfterDeleteHooks = []AutomationCircuitBreakerHook{}
+
+	AddAutomationCircuitBreakerHook(boil.BeforeUpsertHook, automationCircuitBreakerBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	automationCircuitBreakerBeforeUpsertHooks = []AutomationCircuitBreakerHook{}
+
+	AddAutomationCircuitBreakerHook(boil.AfterUpsertHook, automationCircuitBreakerAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	automationCircuitBreakerAfterUpsertHooks = []AutomationCircuitBreakerHook{}
+}
+
+func testAutomationCircuitBreakersInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AutomationCircuitBreaker{}
+	if err = randomize.Struct(seed, o, automationCircuitBreakerDBTypes, true, automationCircuitBreakerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AutomationCircuitBreaker struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AutomationCircuitBreakers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testAutomationCircuitBreakersInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AutomationCircuitBreaker{}
+	if err = randomize.Struct(seed, o, automationCircuitBreakerDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize AutomationCircuitBreaker struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(automationCircuitBreakerColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AutomationCircuitBreakers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testAutomationCircuitBreakersReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AutomationCircuitBreaker{}
+	if err = randomize.Struct(seed, o, automationCircuitBreakerDBTypes, true, automationCircuitBreakerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AutomationCircuitBreaker struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAutomationCircuitBreakersReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AutomationCircuitBreaker{}
+	if err = randomize.Struct(seed, o, automationCircuitBreakerDBTypes, true, automationCircuitBreakerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AutomationCircuitBreaker struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := AutomationCircuitBreakerSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAutomationCircuitBreakersSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AutomationCircuitBreaker{}
+	if err = randomize.Struct(seed, o, automationCircuitBreakerDBTypes, true, automationCircuitBreakerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AutomationCircuitBreaker struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := AutomationCircuitBreakers().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	automationCircuitBreakerDBTypes = map[string]string{`ID`: `integer`, `Automation`: `character varying`, `Tripped`: `boolean`, `Reason`: `text`, `TrippedAt`: `timestamp without time zone`, `LastInputRecords`: `integer`, `LastInputVolume`: `double precision`, `ResetBy`: `integer`, `ResetAt`: `timestamp without time zone`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                               = bytes.MinRead
+)
+
+func testAutomationCircuitBreakersUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(automationCircuitBreakerPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(automationCircuitBreakerAllColumns) == len(automationCircuitBreakerPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AutomationCircuitBreaker{}
+	if err = randomize.Struct(seed, o, automationCircuitBreakerDBTypes, true, automationCircuitBreakerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AutomationCircuitBreaker struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AutomationCircuitBreakers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, automationCircuitBreakerDBTypes, true, automationCircuitBreakerPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AutomationCircuitBreaker struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testAutomationCircuitBreakersSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(automationCircuitBreakerAllColumns) == len(automationCircuitBreakerPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AutomationCircuitBreaker{}
+	if err = randomize.Struct(seed, o, automationCircuitBreakerDBTypes, true, automationCircuitBreakerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AutomationCircuitBreaker struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AutomationCircuitBreakers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, automationCircuitBreakerDBTypes, true, automationCircuitBreakerPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AutomationCircuitBreaker struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(automationCircuitBreakerAllColumns, automationCircuitBreakerPrimaryKeyColumns) {
+		fields = automationCircuitBreakerAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			automationCircuitBreakerAllColumns,
+			automationCircuitBreakerPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := AutomationCircuitBreakerSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testAutomationCircuitBreakersUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(automationCircuitBreakerAllColumns) == len(automationCircuitBreakerPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := AutomationCircuitBreaker{}
+	if err = randomize.Struct(seed, &o, automationCircuitBreakerDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize AutomationCircuitBreaker struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert AutomationCircuitBreaker: %s", err)
+	}
+
+	count, err := AutomationCircuitBreakers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, automationCircuitBreakerDBTypes, false, automationCircuitBreakerPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AutomationCircuitBreaker struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert AutomationCircuitBreaker: %s", err)
+	}
+
+	count, err = AutomationCircuitBreakers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
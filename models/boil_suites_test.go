@@ -12,12 +12,26 @@ import "testing"
 // It does NOT run each operation group in parallel.
 // Separating the tests thusly grants avoidance of Postgres deadlocks.
 func TestParent(t *testing.T) {
+	t.Run("APIKeys", testAPIKeys)
 	t.Run("AdsTXTS", testAdsTXTS)
+	t.Run("AdsTXTScans", testAdsTXTScans)
+	t.Run("AlertEvents", testAlertEvents)
+	t.Run("AlertRules", testAlertRules)
+	t.Run("ApprovalPolicies", testApprovalPolicies)
+	t.Run("AuditLogs", testAuditLogs)
+	t.Run("AutomationCircuitBreakers", testAutomationCircuitBreakers)
 	t.Run("BidCachings", testBidCachings)
+	t.Run("Blocks", testBlocks)
+	t.Run("ChangeRequests", testChangeRequests)
+	t.Run("CompassManagers", testCompassManagers)
 	t.Run("CompassPublisherTags", testCompassPublisherTags)
 	t.Run("Competitors", testCompetitors)
 	t.Run("Confiants", testConfiants)
 	t.Run("Configurations", testConfigurations)
+	t.Run("DPApprovalRequests", testDPApprovalRequests)
+	t.Run("DPBidderParams", testDPBidderParams)
+	t.Run("DPBidderSchemas", testDPBidderSchemas)
+	t.Run("DPScores", testDPScores)
 	t.Run("DemandDailies", testDemandDailies)
 	t.Run("DemandHourlies", testDemandHourlies)
 	t.Run("DemandParnterPlacements", testDemandParnterPlacements)
@@ -27,6 +41,7 @@ func TestParent(t *testing.T) {
 	t.Run("DemandPartnerDailies", testDemandPartnerDailies)
 	t.Run("DemandPartnerHourlies", testDemandPartnerHourlies)
 	t.Run("DPAPIReports", testDPAPIReports)
+	t.Run("DomainOnboardings", testDomainOnboardings)
 	t.Run("Dpos", testDpos)
 	t.Run("DpoAutomationLogs", testDpoAutomationLogs)
 	t.Run("DpoRules", testDpoRules)
@@ -49,6 +64,11 @@ func TestParent(t *testing.T) {
 	t.Run("NoDPResponseReports", testNoDPResponseReports)
 	t.Run("Pixalates", testPixalates)
 	t.Run("PriceFactorLogs", testPriceFactorLogs)
+	t.Run("PriceOverrides", testPriceOverrides)
+	t.Run("PublisherCompassSyncs", testPublisherCompassSyncs)
+	t.Run("PublisherNotificationPreferences", testPublisherNotificationPreferences)
+	t.Run("PublisherPausedRules", testPublisherPausedRules)
+	t.Run("PublisherStatusTransitions", testPublisherStatusTransitions)
 	t.Run("Publishers", testPublishers)
 	t.Run("PublisherDailies", testPublisherDailies)
 	t.Run("PublisherDemands", testPublisherDemands)
@@ -60,20 +80,38 @@ func TestParent(t *testing.T) {
 	t.Run("ReportUpdates", testReportUpdates)
 	t.Run("RevenueDailies", testRevenueDailies)
 	t.Run("RevenueHourlies", testRevenueHourlies)
+	t.Run("RolePermissions", testRolePermissions)
+	t.Run("SchainCompliances", testSchainCompliances)
 	t.Run("SchemaMigrations", testSchemaMigrations)
 	t.Run("SeatOwners", testSeatOwners)
 	t.Run("SellersJSONHistories", testSellersJSONHistories)
+	t.Run("SellersJSONVersions", testSellersJSONVersions)
 	t.Run("Targetings", testTargetings)
+	t.Run("UserPublishers", testUserPublishers)
 	t.Run("Users", testUsers)
 }
 
 func TestDelete(t *testing.T) {
+	t.Run("APIKeys", testAPIKeysDelete)
 	t.Run("AdsTXTS", testAdsTXTSDelete)
+	t.Run("AdsTXTScans", testAdsTXTScansDelete)
+	t.Run("AlertEvents", testAlertEventsDelete)
+	t.Run("AlertRules", testAlertRulesDelete)
+	t.Run("ApprovalPolicies", testApprovalPoliciesDelete)
+	t.Run("AuditLogs", testAuditLogsDelete)
+	t.Run("AutomationCircuitBreakers", testAutomationCircuitBreakersDelete)
 	t.Run("BidCachings", testBidCachingsDelete)
+	t.Run("Blocks", testBlocksDelete)
+	t.Run("ChangeRequests", testChangeRequestsDelete)
+	t.Run("CompassManagers", testCompassManagersDelete)
 	t.Run("CompassPublisherTags", testCompassPublisherTagsDelete)
 	t.Run("Competitors", testCompetitorsDelete)
 	t.Run("Confiants", testConfiantsDelete)
 	t.Run("Configurations", testConfigurationsDelete)
+	t.Run("DPApprovalRequests", testDPApprovalRequestsDelete)
+	t.Run("DPBidderParams", testDPBidderParamsDelete)
+	t.Run("DPBidderSchemas", testDPBidderSchemasDelete)
+	t.Run("DPScores", testDPScoresDelete)
 	t.Run("DemandDailies", testDemandDailiesDelete)
 	t.Run("DemandHourlies", testDemandHourliesDelete)
 	t.Run("DemandParnterPlacements", testDemandParnterPlacementsDelete)
@@ -83,6 +121,7 @@ func TestDelete(t *testing.T) {
 	t.Run("DemandPartnerDailies", testDemandPartnerDailiesDelete)
 	t.Run("DemandPartnerHourlies", testDemandPartnerHourliesDelete)
 	t.Run("DPAPIReports", testDPAPIReportsDelete)
+	t.Run("DomainOnboardings", testDomainOnboardingsDelete)
 	t.Run("Dpos", testDposDelete)
 	t.Run("DpoAutomationLogs", testDpoAutomationLogsDelete)
 	t.Run("DpoRules", testDpoRulesDelete)
@@ -105,6 +144,11 @@ func TestDelete(t *testing.T) {
 	t.Run("NoDPResponseReports", testNoDPResponseReportsDelete)
 	t.Run("Pixalates", testPixalatesDelete)
 	t.Run("PriceFactorLogs", testPriceFactorLogsDelete)
+	t.Run("PriceOverrides", testPriceOverridesDelete)
+	t.Run("PublisherCompassSyncs", testPublisherCompassSyncsDelete)
+	t.Run("PublisherNotificationPreferences", testPublisherNotificationPreferencesDelete)
+	t.Run("PublisherPausedRules", testPublisherPausedRulesDelete)
+	t.Run("PublisherStatusTransitions", testPublisherStatusTransitionsDelete)
 	t.Run("Publishers", testPublishersDelete)
 	t.Run("PublisherDailies", testPublisherDailiesDelete)
 	t.Run("PublisherDemands", testPublisherDemandsDelete)
@@ -116,20 +160,38 @@ func TestDelete(t *testing.T) {
 	t.Run("ReportUpdates", testReportUpdatesDelete)
 	t.Run("RevenueDailies", testRevenueDailiesDelete)
 	t.Run("RevenueHourlies", testRevenueHourliesDelete)
+	t.Run("RolePermissions", testRolePermissionsDelete)
+	t.Run("SchainCompliances", testSchainCompliancesDelete)
 	t.Run("SchemaMigrations", testSchemaMigrationsDelete)
 	t.Run("SeatOwners", testSeatOwnersDelete)
 	t.Run("SellersJSONHistories", testSellersJSONHistoriesDelete)
+	t.Run("SellersJSONVersions", testSellersJSONVersionsDelete)
 	t.Run("Targetings", testTargetingsDelete)
+	t.Run("UserPublishers", testUserPublishersDelete)
 	t.Run("Users", testUsersDelete)
 }
 
 func TestQueryDeleteAll(t *testing.T) {
+	t.Run("APIKeys", testAPIKeysQueryDeleteAll)
 	t.Run("AdsTXTS", testAdsTXTSQueryDeleteAll)
+	t.Run("AdsTXTScans", testAdsTXTScansQueryDeleteAll)
+	t.Run("AlertEvents", testAlertEventsQueryDeleteAll)
+	t.Run("AlertRules", testAlertRulesQueryDeleteAll)
+	t.Run("ApprovalPolicies", testApprovalPoliciesQueryDeleteAll)
+	t.Run("AuditLogs", testAuditLogsQueryDeleteAll)
+	t.Run("AutomationCircuitBreakers", testAutomationCircuitBreakersQueryDeleteAll)
 	t.Run("BidCachings", testBidCachingsQueryDeleteAll)
+	t.Run("Blocks", testBlocksQueryDeleteAll)
+	t.Run("ChangeRequests", testChangeRequestsQueryDeleteAll)
+	t.Run("CompassManagers", testCompassManagersQueryDeleteAll)
 	t.Run("CompassPublisherTags", testCompassPublisherTagsQueryDeleteAll)
 	t.Run("Competitors", testCompetitorsQueryDeleteAll)
 	t.Run("Confiants", testConfiantsQueryDeleteAll)
 	t.Run("Configurations", testConfigurationsQueryDeleteAll)
+	t.Run("DPApprovalRequests", testDPApprovalRequestsQueryDeleteAll)
+	t.Run("DPBidderParams", testDPBidderParamsQueryDeleteAll)
+	t.Run("DPBidderSchemas", testDPBidderSchemasQueryDeleteAll)
+	t.Run("DPScores", testDPScoresQueryDeleteAll)
 	t.Run("DemandDailies", testDemandDailiesQueryDeleteAll)
 	t.Run("DemandHourlies", testDemandHourliesQueryDeleteAll)
 	t.Run("DemandParnterPlacements", testDemandParnterPlacementsQueryDeleteAll)
@@ -139,6 +201,7 @@ func TestQueryDeleteAll(t *testing.T) {
 	t.Run("DemandPartnerDailies", testDemandPartnerDailiesQueryDeleteAll)
 	t.Run("DemandPartnerHourlies", testDemandPartnerHourliesQueryDeleteAll)
 	t.Run("DPAPIReports", testDPAPIReportsQueryDeleteAll)
+	t.Run("DomainOnboardings", testDomainOnboardingsQueryDeleteAll)
 	t.Run("Dpos", testDposQueryDeleteAll)
 	t.Run("DpoAutomationLogs", testDpoAutomationLogsQueryDeleteAll)
 	t.Run("DpoRules", testDpoRulesQueryDeleteAll)
@@ -161,6 +224,11 @@ func TestQueryDeleteAll(t *testing.T) {
 	t.Run("NoDPResponseReports", testNoDPResponseReportsQueryDeleteAll)
 	t.Run("Pixalates", testPixalatesQueryDeleteAll)
 	t.Run("PriceFactorLogs", testPriceFactorLogsQueryDeleteAll)
+	t.Run("PriceOverrides", testPriceOverridesQueryDeleteAll)
+	t.Run("PublisherCompassSyncs", testPublisherCompassSyncsQueryDeleteAll)
+	t.Run("PublisherNotificationPreferences", testPublisherNotificationPreferencesQueryDeleteAll)
+	t.Run("PublisherPausedRules", testPublisherPausedRulesQueryDeleteAll)
+	t.Run("PublisherStatusTransitions", testPublisherStatusTransitionsQueryDeleteAll)
 	t.Run("Publishers", testPublishersQueryDeleteAll)
 	t.Run("PublisherDailies", testPublisherDailiesQueryDeleteAll)
 	t.Run("PublisherDemands", testPublisherDemandsQueryDeleteAll)
@@ -172,20 +240,38 @@ func TestQueryDeleteAll(t *testing.T) {
 	t.Run("ReportUpdates", testReportUpdatesQueryDeleteAll)
 	t.Run("RevenueDailies", testRevenueDailiesQueryDeleteAll)
 	t.Run("RevenueHourlies", testRevenueHourliesQueryDeleteAll)
+	t.Run("RolePermissions", testRolePermissionsQueryDeleteAll)
+	t.Run("SchainCompliances", testSchainCompliancesQueryDeleteAll)
 	t.Run("SchemaMigrations", testSchemaMigrationsQueryDeleteAll)
 	t.Run("SeatOwners", testSeatOwnersQueryDeleteAll)
 	t.Run("SellersJSONHistories", testSellersJSONHistoriesQueryDeleteAll)
+	t.Run("SellersJSONVersions", testSellersJSONVersionsQueryDeleteAll)
 	t.Run("Targetings", testTargetingsQueryDeleteAll)
+	t.Run("UserPublishers", testUserPublishersQueryDeleteAll)
 	t.Run("Users", testUsersQueryDeleteAll)
 }
 
 func TestSliceDeleteAll(t *testing.T) {
+	t.Run("APIKeys", testAPIKeysSliceDeleteAll)
 	t.Run("AdsTXTS", testAdsTXTSSliceDeleteAll)
+	t.Run("AdsTXTScans", testAdsTXTScansSliceDeleteAll)
+	t.Run("AlertEvents", testAlertEventsSliceDeleteAll)
+	t.Run("AlertRules", testAlertRulesSliceDeleteAll)
+	t.Run("ApprovalPolicies", testApprovalPoliciesSliceDeleteAll)
+	t.Run("AuditLogs", testAuditLogsSliceDeleteAll)
+	t.Run("AutomationCircuitBreakers", testAutomationCircuitBreakersSliceDeleteAll)
 	t.Run("BidCachings", testBidCachingsSliceDeleteAll)
+	t.Run("Blocks", testBlocksSliceDeleteAll)
+	t.Run("ChangeRequests", testChangeRequestsSliceDeleteAll)
+	t.Run("CompassManagers", testCompassManagersSliceDeleteAll)
 	t.Run("CompassPublisherTags", testCompassPublisherTagsSliceDeleteAll)
 	t.Run("Competitors", testCompetitorsSliceDeleteAll)
 	t.Run("Confiants", testConfiantsSliceDeleteAll)
 	t.Run("Configurations", testConfigurationsSliceDeleteAll)
+	t.Run("DPApprovalRequests", testDPApprovalRequestsSliceDeleteAll)
+	t.Run("DPBidderParams", testDPBidderParamsSliceDeleteAll)
+	t.Run("DPBidderSchemas", testDPBidderSchemasSliceDeleteAll)
+	t.Run("DPScores", testDPScoresSliceDeleteAll)
 	t.Run("DemandDailies", testDemandDailiesSliceDeleteAll)
 	t.Run("DemandHourlies", testDemandHourliesSliceDeleteAll)
 	t.Run("DemandParnterPlacements", testDemandParnterPlacementsSliceDeleteAll)
@@ -195,6 +281,7 @@ func TestSliceDeleteAll(t *testing.T) {
 	t.Run("DemandPartnerDailies", testDemandPartnerDailiesSliceDeleteAll)
 	t.Run("DemandPartnerHourlies", testDemandPartnerHourliesSliceDeleteAll)
 	t.Run("DPAPIReports", testDPAPIReportsSliceDeleteAll)
+	t.Run("DomainOnboardings", testDomainOnboardingsSliceDeleteAll)
 	t.Run("Dpos", testDposSliceDeleteAll)
 	t.Run("DpoAutomationLogs", testDpoAutomationLogsSliceDeleteAll)
 	t.Run("DpoRules", testDpoRulesSliceDeleteAll)
@@ -217,6 +304,11 @@ func TestSliceDeleteAll(t *testing.T) {
 	t.Run("NoDPResponseReports", testNoDPResponseReportsSliceDeleteAll)
 	t.Run("Pixalates", testPixalatesSliceDeleteAll)
 	t.Run("PriceFactorLogs", testPriceFactorLogsSliceDeleteAll)
+	t.Run("PriceOverrides", testPriceOverridesSliceDeleteAll)
+	t.Run("PublisherCompassSyncs", testPublisherCompassSyncsSliceDeleteAll)
+	t.Run("PublisherNotificationPreferences", testPublisherNotificationPreferencesSliceDeleteAll)
+	t.Run("PublisherPausedRules", testPublisherPausedRulesSliceDeleteAll)
+	t.Run("PublisherStatusTransitions", testPublisherStatusTransitionsSliceDeleteAll)
 	t.Run("Publishers", testPublishersSliceDeleteAll)
 	t.Run("PublisherDailies", testPublisherDailiesSliceDeleteAll)
 	t.Run("PublisherDemands", testPublisherDemandsSliceDeleteAll)
@@ -228,20 +320,38 @@ func TestSliceDeleteAll(t *testing.T) {
 	t.Run("ReportUpdates", testReportUpdatesSliceDeleteAll)
 	t.Run("RevenueDailies", testRevenueDailiesSliceDeleteAll)
 	t.Run("RevenueHourlies", testRevenueHourliesSliceDeleteAll)
+	t.Run("RolePermissions", testRolePermissionsSliceDeleteAll)
+	t.Run("SchainCompliances", testSchainCompliancesSliceDeleteAll)
 	t.Run("SchemaMigrations", testSchemaMigrationsSliceDeleteAll)
 	t.Run("SeatOwners", testSeatOwnersSliceDeleteAll)
 	t.Run("SellersJSONHistories", testSellersJSONHistoriesSliceDeleteAll)
+	t.Run("SellersJSONVersions", testSellersJSONVersionsSliceDeleteAll)
 	t.Run("Targetings", testTargetingsSliceDeleteAll)
+	t.Run("UserPublishers", testUserPublishersSliceDeleteAll)
 	t.Run("Users", testUsersSliceDeleteAll)
 }
 
 func TestExists(t *testing.T) {
+	t.Run("APIKeys", testAPIKeysExists)
 	t.Run("AdsTXTS", testAdsTXTSExists)
+	t.Run("AdsTXTScans", testAdsTXTScansExists)
+	t.Run("AlertEvents", testAlertEventsExists)
+	t.Run("AlertRules", testAlertRulesExists)
+	t.Run("ApprovalPolicies", testApprovalPoliciesExists)
+	t.Run("AuditLogs", testAuditLogsExists)
+	t.Run("AutomationCircuitBreakers", testAutomationCircuitBreakersExists)
 	t.Run("BidCachings", testBidCachingsExists)
+	t.Run("Blocks", testBlocksExists)
+	t.Run("ChangeRequests", testChangeRequestsExists)
+	t.Run("CompassManagers", testCompassManagersExists)
 	t.Run("CompassPublisherTags", testCompassPublisherTagsExists)
 	t.Run("Competitors", testCompetitorsExists)
 	t.Run("Confiants", testConfiantsExists)
 	t.Run("Configurations", testConfigurationsExists)
+	t.Run("DPApprovalRequests", testDPApprovalRequestsExists)
+	t.Run("DPBidderParams", testDPBidderParamsExists)
+	t.Run("DPBidderSchemas", testDPBidderSchemasExists)
+	t.Run("DPScores", testDPScoresExists)
 	t.Run("DemandDailies", testDemandDailiesExists)
 	t.Run("DemandHourlies", testDemandHourliesExists)
 	t.Run("DemandParnterPlacements", testDemandParnterPlacementsExists)
@@ -251,6 +361,7 @@ func TestExists(t *testing.T) {
 	t.Run("DemandPartnerDailies", testDemandPartnerDailiesExists)
 	t.Run("DemandPartnerHourlies", testDemandPartnerHourliesExists)
 	t.Run("DPAPIReports", testDPAPIReportsExists)
+	t.Run("DomainOnboardings", testDomainOnboardingsExists)
 	t.Run("Dpos", testDposExists)
 	t.Run("DpoAutomationLogs", testDpoAutomationLogsExists)
 	t.Run("DpoRules", testDpoRulesExists)
@@ -273,6 +384,11 @@ func TestExists(t *testing.T) {
 	t.Run("NoDPResponseReports", testNoDPResponseReportsExists)
 	t.Run("Pixalates", testPixalatesExists)
 	t.Run("PriceFactorLogs", testPriceFactorLogsExists)
+	t.Run("PriceOverrides", testPriceOverridesExists)
+	t.Run("PublisherCompassSyncs", testPublisherCompassSyncsExists)
+	t.Run("PublisherNotificationPreferences", testPublisherNotificationPreferencesExists)
+	t.Run("PublisherPausedRules", testPublisherPausedRulesExists)
+	t.Run("PublisherStatusTransitions", testPublisherStatusTransitionsExists)
 	t.Run("Publishers", testPublishersExists)
 	t.Run("PublisherDailies", testPublisherDailiesExists)
 	t.Run("PublisherDemands", testPublisherDemandsExists)
@@ -284,20 +400,38 @@ func TestExists(t *testing.T) {
 	t.Run("ReportUpdates", testReportUpdatesExists)
 	t.Run("RevenueDailies", testRevenueDailiesExists)
 	t.Run("RevenueHourlies", testRevenueHourliesExists)
+	t.Run("RolePermissions", testRolePermissionsExists)
+	t.Run("SchainCompliances", testSchainCompliancesExists)
 	t.Run("SchemaMigrations", testSchemaMigrationsExists)
 	t.Run("SeatOwners", testSeatOwnersExists)
 	t.Run("SellersJSONHistories", testSellersJSONHistoriesExists)
+	t.Run("SellersJSONVersions", testSellersJSONVersionsExists)
 	t.Run("Targetings", testTargetingsExists)
+	t.Run("UserPublishers", testUserPublishersExists)
 	t.Run("Users", testUsersExists)
 }
 
 func TestFind(t *testing.T) {
+	t.Run("APIKeys", testAPIKeysFind)
 	t.Run("AdsTXTS", testAdsTXTSFind)
+	t.Run("AdsTXTScans", testAdsTXTScansFind)
+	t.Run("AlertEvents", testAlertEventsFind)
+	t.Run("AlertRules", testAlertRulesFind)
+	t.Run("ApprovalPolicies", testApprovalPoliciesFind)
+	t.Run("AuditLogs", testAuditLogsFind)
+	t.Run("AutomationCircuitBreakers", testAutomationCircuitBreakersFind)
 	t.Run("BidCachings", testBidCachingsFind)
+	t.Run("Blocks", testBlocksFind)
+	t.Run("ChangeRequests", testChangeRequestsFind)
+	t.Run("CompassManagers", testCompassManagersFind)
 	t.Run("CompassPublisherTags", testCompassPublisherTagsFind)
 	t.Run("Competitors", testCompetitorsFind)
 	t.Run("Confiants", testConfiantsFind)
 	t.Run("Configurations", testConfigurationsFind)
+	t.Run("DPApprovalRequests", testDPApprovalRequestsFind)
+	t.Run("DPBidderParams", testDPBidderParamsFind)
+	t.Run("DPBidderSchemas", testDPBidderSchemasFind)
+	t.Run("DPScores", testDPScoresFind)
 	t.Run("DemandDailies", testDemandDailiesFind)
 	t.Run("DemandHourlies", testDemandHourliesFind)
 	t.Run("DemandParnterPlacements", testDemandParnterPlacementsFind)
@@ -307,6 +441,7 @@ func TestFind(t *testing.T) {
 	t.Run("DemandPartnerDailies", testDemandPartnerDailiesFind)
 	t.Run("DemandPartnerHourlies", testDemandPartnerHourliesFind)
 	t.Run("DPAPIReports", testDPAPIReportsFind)
+	t.Run("DomainOnboardings", testDomainOnboardingsFind)
 	t.Run("Dpos", testDposFind)
 	t.Run("DpoAutomationLogs", testDpoAutomationLogsFind)
 	t.Run("DpoRules", testDpoRulesFind)
@@ -329,6 +464,11 @@ func TestFind(t *testing.T) {
 	t.Run("NoDPResponseReports", testNoDPResponseReportsFind)
 	t.Run("Pixalates", testPixalatesFind)
 	t.Run("PriceFactorLogs", testPriceFactorLogsFind)
+	t.Run("PriceOverrides", testPriceOverridesFind)
+	t.Run("PublisherCompassSyncs", testPublisherCompassSyncsFind)
+	t.Run("PublisherNotificationPreferences", testPublisherNotificationPreferencesFind)
+	t.Run("PublisherPausedRules", testPublisherPausedRulesFind)
+	t.Run("PublisherStatusTransitions", testPublisherStatusTransitionsFind)
 	t.Run("Publishers", testPublishersFind)
 	t.Run("PublisherDailies", testPublisherDailiesFind)
 	t.Run("PublisherDemands", testPublisherDemandsFind)
@@ -340,20 +480,38 @@ func TestFind(t *testing.T) {
 	t.Run("ReportUpdates", testReportUpdatesFind)
 	t.Run("RevenueDailies", testRevenueDailiesFind)
 	t.Run("RevenueHourlies", testRevenueHourliesFind)
+	t.Run("RolePermissions", testRolePermissionsFind)
+	t.Run("SchainCompliances", testSchainCompliancesFind)
 	t.Run("SchemaMigrations", testSchemaMigrationsFind)
 	t.Run("SeatOwners", testSeatOwnersFind)
 	t.Run("SellersJSONHistories", testSellersJSONHistoriesFind)
+	t.Run("SellersJSONVersions", testSellersJSONVersionsFind)
 	t.Run("Targetings", testTargetingsFind)
+	t.Run("UserPublishers", testUserPublishersFind)
 	t.Run("Users", testUsersFind)
 }
 
 func TestBind(t *testing.T) {
+	t.Run("APIKeys", testAPIKeysBind)
 	t.Run("AdsTXTS", testAdsTXTSBind)
+	t.Run("AdsTXTScans", testAdsTXTScansBind)
+	t.Run("AlertEvents", testAlertEventsBind)
+	t.Run("AlertRules", testAlertRulesBind)
+	t.Run("ApprovalPolicies", testApprovalPoliciesBind)
+	t.Run("AuditLogs", testAuditLogsBind)
+	t.Run("AutomationCircuitBreakers", testAutomationCircuitBreakersBind)
 	t.Run("BidCachings", testBidCachingsBind)
+	t.Run("Blocks", testBlocksBind)
+	t.Run("ChangeRequests", testChangeRequestsBind)
+	t.Run("CompassManagers", testCompassManagersBind)
 	t.Run("CompassPublisherTags", testCompassPublisherTagsBind)
 	t.Run("Competitors", testCompetitorsBind)
 	t.Run("Confiants", testConfiantsBind)
 	t.Run("Configurations", testConfigurationsBind)
+	t.Run("DPApprovalRequests", testDPApprovalRequestsBind)
+	t.Run("DPBidderParams", testDPBidderParamsBind)
+	t.Run("DPBidderSchemas", testDPBidderSchemasBind)
+	t.Run("DPScores", testDPScoresBind)
 	t.Run("DemandDailies", testDemandDailiesBind)
 	t.Run("DemandHourlies", testDemandHourliesBind)
 	t.Run("DemandParnterPlacements", testDemandParnterPlacementsBind)
@@ -363,6 +521,7 @@ func TestBind(t *testing.T) {
 	t.Run("DemandPartnerDailies", testDemandPartnerDailiesBind)
 	t.Run("DemandPartnerHourlies", testDemandPartnerHourliesBind)
 	t.Run("DPAPIReports", testDPAPIReportsBind)
+	t.Run("DomainOnboardings", testDomainOnboardingsBind)
 	t.Run("Dpos", testDposBind)
 	t.Run("DpoAutomationLogs", testDpoAutomationLogsBind)
 	t.Run("DpoRules", testDpoRulesBind)
@@ -385,6 +544,11 @@ func TestBind(t *testing.T) {
 	t.Run("NoDPResponseReports", testNoDPResponseReportsBind)
 	t.Run("Pixalates", testPixalatesBind)
 	t.Run("PriceFactorLogs", testPriceFactorLogsBind)
+	t.Run("PriceOverrides", testPriceOverridesBind)
+	t.Run("PublisherCompassSyncs", testPublisherCompassSyncsBind)
+	t.Run("PublisherNotificationPreferences", testPublisherNotificationPreferencesBind)
+	t.Run("PublisherPausedRules", testPublisherPausedRulesBind)
+	t.Run("PublisherStatusTransitions", testPublisherStatusTransitionsBind)
 	t.Run("Publishers", testPublishersBind)
 	t.Run("PublisherDailies", testPublisherDailiesBind)
 	t.Run("PublisherDemands", testPublisherDemandsBind)
@@ -396,20 +560,38 @@ func TestBind(t *testing.T) {
 	t.Run("ReportUpdates", testReportUpdatesBind)
 	t.Run("RevenueDailies", testRevenueDailiesBind)
 	t.Run("RevenueHourlies", testRevenueHourliesBind)
+	t.Run("RolePermissions", testRolePermissionsBind)
+	t.Run("SchainCompliances", testSchainCompliancesBind)
 	t.Run("SchemaMigrations", testSchemaMigrationsBind)
 	t.Run("SeatOwners", testSeatOwnersBind)
 	t.Run("SellersJSONHistories", testSellersJSONHistoriesBind)
+	t.Run("SellersJSONVersions", testSellersJSONVersionsBind)
 	t.Run("Targetings", testTargetingsBind)
+	t.Run("UserPublishers", testUserPublishersBind)
 	t.Run("Users", testUsersBind)
 }
 
 func TestOne(t *testing.T) {
+	t.Run("APIKeys", testAPIKeysOne)
 	t.Run("AdsTXTS", testAdsTXTSOne)
+	t.Run("AdsTXTScans", testAdsTXTScansOne)
+	t.Run("AlertEvents", testAlertEventsOne)
+	t.Run("AlertRules", testAlertRulesOne)
+	t.Run("ApprovalPolicies", testApprovalPoliciesOne)
+	t.Run("AuditLogs", testAuditLogsOne)
+	t.Run("AutomationCircuitBreakers", testAutomationCircuitBreakersOne)
 	t.Run("BidCachings", testBidCachingsOne)
+	t.Run("Blocks", testBlocksOne)
+	t.Run("ChangeRequests", testChangeRequestsOne)
+	t.Run("CompassManagers", testCompassManagersOne)
 	t.Run("CompassPublisherTags", testCompassPublisherTagsOne)
 	t.Run("Competitors", testCompetitorsOne)
 	t.Run("Confiants", testConfiantsOne)
 	t.Run("Configurations", testConfigurationsOne)
+	t.Run("DPApprovalRequests", testDPApprovalRequestsOne)
+	t.Run("DPBidderParams", testDPBidderParamsOne)
+	t.Run("DPBidderSchemas", testDPBidderSchemasOne)
+	t.Run("DPScores", testDPScoresOne)
 	t.Run("DemandDailies", testDemandDailiesOne)
 	t.Run("DemandHourlies", testDemandHourliesOne)
 	t.Run("DemandParnterPlacements", testDemandParnterPlacementsOne)
@@ -419,6 +601,7 @@ func TestOne(t *testing.T) {
 	t.Run("DemandPartnerDailies", testDemandPartnerDailiesOne)
 	t.Run("DemandPartnerHourlies", testDemandPartnerHourliesOne)
 	t.Run("DPAPIReports", testDPAPIReportsOne)
+	t.Run("DomainOnboardings", testDomainOnboardingsOne)
 	t.Run("Dpos", testDposOne)
 	t.Run("DpoAutomationLogs", testDpoAutomationLogsOne)
 	t.Run("DpoRules", testDpoRulesOne)
@@ -441,6 +624,11 @@ func TestOne(t *testing.T) {
 	t.Run("NoDPResponseReports", testNoDPResponseReportsOne)
 	t.Run("Pixalates", testPixalatesOne)
 	t.Run("PriceFactorLogs", testPriceFactorLogsOne)
+	t.Run("PriceOverrides", testPriceOverridesOne)
+	t.Run("PublisherCompassSyncs", testPublisherCompassSyncsOne)
+	t.Run("PublisherNotificationPreferences", testPublisherNotificationPreferencesOne)
+	t.Run("PublisherPausedRules", testPublisherPausedRulesOne)
+	t.Run("PublisherStatusTransitions", testPublisherStatusTransitionsOne)
 	t.Run("Publishers", testPublishersOne)
 	t.Run("PublisherDailies", testPublisherDailiesOne)
 	t.Run("PublisherDemands", testPublisherDemandsOne)
@@ -452,20 +640,38 @@ func TestOne(t *testing.T) {
 	t.Run("ReportUpdates", testReportUpdatesOne)
 	t.Run("RevenueDailies", testRevenueDailiesOne)
 	t.Run("RevenueHourlies", testRevenueHourliesOne)
+	t.Run("RolePermissions", testRolePermissionsOne)
+	t.Run("SchainCompliances", testSchainCompliancesOne)
 	t.Run("SchemaMigrations", testSchemaMigrationsOne)
 	t.Run("SeatOwners", testSeatOwnersOne)
 	t.Run("SellersJSONHistories", testSellersJSONHistoriesOne)
+	t.Run("SellersJSONVersions", testSellersJSONVersionsOne)
 	t.Run("Targetings", testTargetingsOne)
+	t.Run("UserPublishers", testUserPublishersOne)
 	t.Run("Users", testUsersOne)
 }
 
 func TestAll(t *testing.T) {
+	t.Run("APIKeys", testAPIKeysAll)
 	t.Run("AdsTXTS", testAdsTXTSAll)
+	t.Run("AdsTXTScans", testAdsTXTScansAll)
+	t.Run("AlertEvents", testAlertEventsAll)
+	t.Run("AlertRules", testAlertRulesAll)
+	t.Run("ApprovalPolicies", testApprovalPoliciesAll)
+	t.Run("AuditLogs", testAuditLogsAll)
+	t.Run("AutomationCircuitBreakers", testAutomationCircuitBreakersAll)
 	t.Run("BidCachings", testBidCachingsAll)
+	t.Run("Blocks", testBlocksAll)
+	t.Run("ChangeRequests", testChangeRequestsAll)
+	t.Run("CompassManagers", testCompassManagersAll)
 	t.Run("CompassPublisherTags", testCompassPublisherTagsAll)
 	t.Run("Competitors", testCompetitorsAll)
 	t.Run("Confiants", testConfiantsAll)
 	t.Run("Configurations", testConfigurationsAll)
+	t.Run("DPApprovalRequests", testDPApprovalRequestsAll)
+	t.Run("DPBidderParams", testDPBidderParamsAll)
+	t.Run("DPBidderSchemas", testDPBidderSchemasAll)
+	t.Run("DPScores", testDPScoresAll)
 	t.Run("DemandDailies", testDemandDailiesAll)
 	t.Run("DemandHourlies", testDemandHourliesAll)
 	t.Run("DemandParnterPlacements", testDemandParnterPlacementsAll)
@@ -475,6 +681,7 @@ func TestAll(t *testing.T) {
 	t.Run("DemandPartnerDailies", testDemandPartnerDailiesAll)
 	t.Run("DemandPartnerHourlies", testDemandPartnerHourliesAll)
 	t.Run("DPAPIReports", testDPAPIReportsAll)
+	t.Run("DomainOnboardings", testDomainOnboardingsAll)
 	t.Run("Dpos", testDposAll)
 	t.Run("DpoAutomationLogs", testDpoAutomationLogsAll)
 	t.Run("DpoRules", testDpoRulesAll)
@@ -497,6 +704,11 @@ func TestAll(t *testing.T) {
 	t.Run("NoDPResponseReports", testNoDPResponseReportsAll)
 	t.Run("Pixalates", testPixalatesAll)
 	t.Run("PriceFactorLogs", testPriceFactorLogsAll)
+	t.Run("PriceOverrides", testPriceOverridesAll)
+	t.Run("PublisherCompassSyncs", testPublisherCompassSyncsAll)
+	t.Run("PublisherNotificationPreferences", testPublisherNotificationPreferencesAll)
+	t.Run("PublisherPausedRules", testPublisherPausedRulesAll)
+	t.Run("PublisherStatusTransitions", testPublisherStatusTransitionsAll)
 	t.Run("Publishers", testPublishersAll)
 	t.Run("PublisherDailies", testPublisherDailiesAll)
 	t.Run("PublisherDemands", testPublisherDemandsAll)
@@ -508,20 +720,38 @@ func TestAll(t *testing.T) {
 	t.Run("ReportUpdates", testReportUpdatesAll)
 	t.Run("RevenueDailies", testRevenueDailiesAll)
 	t.Run("RevenueHourlies", testRevenueHourliesAll)
+	t.Run("RolePermissions", testRolePermissionsAll)
+	t.Run("SchainCompliances", testSchainCompliancesAll)
 	t.Run("SchemaMigrations", testSchemaMigrationsAll)
 	t.Run("SeatOwners", testSeatOwnersAll)
 	t.Run("SellersJSONHistories", testSellersJSONHistoriesAll)
+	t.Run("SellersJSONVersions", testSellersJSONVersionsAll)
 	t.Run("Targetings", testTargetingsAll)
+	t.Run("UserPublishers", testUserPublishersAll)
 	t.Run("Users", testUsersAll)
 }
 
 func TestCount(t *testing.T) {
+	t.Run("APIKeys", testAPIKeysCount)
 	t.Run("AdsTXTS", testAdsTXTSCount)
+	t.Run("AdsTXTScans", testAdsTXTScansCount)
+	t.Run("AlertEvents", testAlertEventsCount)
+	t.Run("AlertRules", testAlertRulesCount)
+	t.Run("ApprovalPolicies", testApprovalPoliciesCount)
+	t.Run("AuditLogs", testAuditLogsCount)
+	t.Run("AutomationCircuitBreakers", testAutomationCircuitBreakersCount)
 	t.Run("BidCachings", testBidCachingsCount)
+	t.Run("Blocks", testBlocksCount)
+	t.Run("ChangeRequests", testChangeRequestsCount)
+	t.Run("CompassManagers", testCompassManagersCount)
 	t.Run("CompassPublisherTags", testCompassPublisherTagsCount)
 	t.Run("Competitors", testCompetitorsCount)
 	t.Run("Confiants", testConfiantsCount)
 	t.Run("Configurations", testConfigurationsCount)
+	t.Run("DPApprovalRequests", testDPApprovalRequestsCount)
+	t.Run("DPBidderParams", testDPBidderParamsCount)
+	t.Run("DPBidderSchemas", testDPBidderSchemasCount)
+	t.Run("DPScores", testDPScoresCount)
 	t.Run("DemandDailies", testDemandDailiesCount)
 	t.Run("DemandHourlies", testDemandHourliesCount)
 	t.Run("DemandParnterPlacements", testDemandParnterPlacementsCount)
@@ -531,6 +761,7 @@ func TestCount(t *testing.T) {
 	t.Run("DemandPartnerDailies", testDemandPartnerDailiesCount)
 	t.Run("DemandPartnerHourlies", testDemandPartnerHourliesCount)
 	t.Run("DPAPIReports", testDPAPIReportsCount)
+	t.Run("DomainOnboardings", testDomainOnboardingsCount)
 	t.Run("Dpos", testDposCount)
 	t.Run("DpoAutomationLogs", testDpoAutomationLogsCount)
 	t.Run("DpoRules", testDpoRulesCount)
@@ -553,6 +784,11 @@ func TestCount(t *testing.T) {
 	t.Run("NoDPResponseReports", testNoDPResponseReportsCount)
 	t.Run("Pixalates", testPixalatesCount)
 	t.Run("PriceFactorLogs", testPriceFactorLogsCount)
+	t.Run("PriceOverrides", testPriceOverridesCount)
+	t.Run("PublisherCompassSyncs", testPublisherCompassSyncsCount)
+	t.Run("PublisherNotificationPreferences", testPublisherNotificationPreferencesCount)
+	t.Run("PublisherPausedRules", testPublisherPausedRulesCount)
+	t.Run("PublisherStatusTransitions", testPublisherStatusTransitionsCount)
 	t.Run("Publishers", testPublishersCount)
 	t.Run("PublisherDailies", testPublisherDailiesCount)
 	t.Run("PublisherDemands", testPublisherDemandsCount)
@@ -564,20 +800,38 @@ func TestCount(t *testing.T) {
 	t.Run("ReportUpdates", testReportUpdatesCount)
 	t.Run("RevenueDailies", testRevenueDailiesCount)
 	t.Run("RevenueHourlies", testRevenueHourliesCount)
+	t.Run("RolePermissions", testRolePermissionsCount)
+	t.Run("SchainCompliances", testSchainCompliancesCount)
 	t.Run("SchemaMigrations", testSchemaMigrationsCount)
 	t.Run("SeatOwners", testSeatOwnersCount)
 	t.Run("SellersJSONHistories", testSellersJSONHistoriesCount)
+	t.Run("SellersJSONVersions", testSellersJSONVersionsCount)
 	t.Run("Targetings", testTargetingsCount)
+	t.Run("UserPublishers", testUserPublishersCount)
 	t.Run("Users", testUsersCount)
 }
 
 func TestHooks(t *testing.T) {
+	t.Run("APIKeys", testAPIKeysHooks)
 	t.Run("AdsTXTS", testAdsTXTSHooks)
+	t.Run("AdsTXTScans", testAdsTXTScansHooks)
+	t.Run("AlertEvents", testAlertEventsHooks)
+	t.Run("AlertRules", testAlertRulesHooks)
+	t.Run("ApprovalPolicies", testApprovalPoliciesHooks)
+	t.Run("AuditLogs", testAuditLogsHooks)
+	t.Run("AutomationCircuitBreakers", testAutomationCircuitBreakersHooks)
 	t.Run("BidCachings", testBidCachingsHooks)
+	t.Run("Blocks", testBlocksHooks)
+	t.Run("ChangeRequests", testChangeRequestsHooks)
+	t.Run("CompassManagers", testCompassManagersHooks)
 	t.Run("CompassPublisherTags", testCompassPublisherTagsHooks)
 	t.Run("Competitors", testCompetitorsHooks)
 	t.Run("Confiants", testConfiantsHooks)
 	t.Run("Configurations", testConfigurationsHooks)
+	t.Run("DPApprovalRequests", testDPApprovalRequestsHooks)
+	t.Run("DPBidderParams", testDPBidderParamsHooks)
+	t.Run("DPBidderSchemas", testDPBidderSchemasHooks)
+	t.Run("DPScores", testDPScoresHooks)
 	t.Run("DemandDailies", testDemandDailiesHooks)
 	t.Run("DemandHourlies", testDemandHourliesHooks)
 	t.Run("DemandParnterPlacements", testDemandParnterPlacementsHooks)
@@ -587,6 +841,7 @@ func TestHooks(t *testing.T) {
 	t.Run("DemandPartnerDailies", testDemandPartnerDailiesHooks)
 	t.Run("DemandPartnerHourlies", testDemandPartnerHourliesHooks)
 	t.Run("DPAPIReports", testDPAPIReportsHooks)
+	t.Run("DomainOnboardings", testDomainOnboardingsHooks)
 	t.Run("Dpos", testDposHooks)
 	t.Run("DpoAutomationLogs", testDpoAutomationLogsHooks)
 	t.Run("DpoRules", testDpoRulesHooks)
@@ -609,6 +864,11 @@ func TestHooks(t *testing.T) {
 	t.Run("NoDPResponseReports", testNoDPResponseReportsHooks)
 	t.Run("Pixalates", testPixalatesHooks)
 	t.Run("PriceFactorLogs", testPriceFactorLogsHooks)
+	t.Run("PriceOverrides", testPriceOverridesHooks)
+	t.Run("PublisherCompassSyncs", testPublisherCompassSyncsHooks)
+	t.Run("PublisherNotificationPreferences", testPublisherNotificationPreferencesHooks)
+	t.Run("PublisherPausedRules", testPublisherPausedRulesHooks)
+	t.Run("PublisherStatusTransitions", testPublisherStatusTransitionsHooks)
 	t.Run("Publishers", testPublishersHooks)
 	t.Run("PublisherDailies", testPublisherDailiesHooks)
 	t.Run("PublisherDemands", testPublisherDemandsHooks)
@@ -620,18 +880,42 @@ func TestHooks(t *testing.T) {
 	t.Run("ReportUpdates", testReportUpdatesHooks)
 	t.Run("RevenueDailies", testRevenueDailiesHooks)
 	t.Run("RevenueHourlies", testRevenueHourliesHooks)
+	t.Run("RolePermissions", testRolePermissionsHooks)
+	t.Run("SchainCompliances", testSchainCompliancesHooks)
 	t.Run("SchemaMigrations", testSchemaMigrationsHooks)
 	t.Run("SeatOwners", testSeatOwnersHooks)
 	t.Run("SellersJSONHistories", testSellersJSONHistoriesHooks)
+	t.Run("SellersJSONVersions", testSellersJSONVersionsHooks)
 	t.Run("Targetings", testTargetingsHooks)
+	t.Run("UserPublishers", testUserPublishersHooks)
 	t.Run("Users", testUsersHooks)
 }
 
 func TestInsert(t *testing.T) {
+	t.Run("APIKeys", testAPIKeysInsert)
+	t.Run("APIKeys", testAPIKeysInsertWhitelist)
 	t.Run("AdsTXTS", testAdsTXTSInsert)
 	t.Run("AdsTXTS", testAdsTXTSInsertWhitelist)
+	t.Run("AdsTXTScans", testAdsTXTScansInsert)
+	t.Run("AdsTXTScans", testAdsTXTScansInsertWhitelist)
+	t.Run("AlertEvents", testAlertEventsInsert)
+	t.Run("AlertEvents", testAlertEventsInsertWhitelist)
+	t.Run("AlertRules", testAlertRulesInsert)
+	t.Run("AlertRules", testAlertRulesInsertWhitelist)
+	t.Run("ApprovalPolicies", testApprovalPoliciesInsert)
+	t.Run("ApprovalPolicies", testApprovalPoliciesInsertWhitelist)
+	t.Run("AuditLogs", testAuditLogsInsert)
+	t.Run("AuditLogs", testAuditLogsInsertWhitelist)
+	t.Run("AutomationCircuitBreakers", testAutomationCircuitBreakersInsert)
+	t.Run("AutomationCircuitBreakers", testAutomationCircuitBreakersInsertWhitelist)
 	t.Run("BidCachings", testBidCachingsInsert)
 	t.Run("BidCachings", testBidCachingsInsertWhitelist)
+	t.Run("Blocks", testBlocksInsert)
+	t.Run("Blocks", testBlocksInsertWhitelist)
+	t.Run("ChangeRequests", testChangeRequestsInsert)
+	t.Run("ChangeRequests", testChangeRequestsInsertWhitelist)
+	t.Run("CompassManagers", testCompassManagersInsert)
+	t.Run("CompassManagers", testCompassManagersInsertWhitelist)
 	t.Run("CompassPublisherTags", testCompassPublisherTagsInsert)
 	t.Run("CompassPublisherTags", testCompassPublisherTagsInsertWhitelist)
 	t.Run("Competitors", testCompetitorsInsert)
@@ -640,6 +924,14 @@ func TestInsert(t *testing.T) {
 	t.Run("Confiants", testConfiantsInsertWhitelist)
 	t.Run("Configurations", testConfigurationsInsert)
 	t.Run("Configurations", testConfigurationsInsertWhitelist)
+	t.Run("DPApprovalRequests", testDPApprovalRequestsInsert)
+	t.Run("DPApprovalRequests", testDPApprovalRequestsInsertWhitelist)
+	t.Run("DPBidderParams", testDPBidderParamsInsert)
+	t.Run("DPBidderParams", testDPBidderParamsInsertWhitelist)
+	t.Run("DPBidderSchemas", testDPBidderSchemasInsert)
+	t.Run("DPBidderSchemas", testDPBidderSchemasInsertWhitelist)
+	t.Run("DPScores", testDPScoresInsert)
+	t.Run("DPScores", testDPScoresInsertWhitelist)
 	t.Run("DemandDailies", testDemandDailiesInsert)
 	t.Run("DemandDailies", testDemandDailiesInsertWhitelist)
 	t.Run("DemandHourlies", testDemandHourliesInsert)
@@ -658,6 +950,8 @@ func TestInsert(t *testing.T) {
 	t.Run("DemandPartnerHourlies", testDemandPartnerHourliesInsertWhitelist)
 	t.Run("DPAPIReports", testDPAPIReportsInsert)
 	t.Run("DPAPIReports", testDPAPIReportsInsertWhitelist)
+	t.Run("DomainOnboardings", testDomainOnboardingsInsert)
+	t.Run("DomainOnboardings", testDomainOnboardingsInsertWhitelist)
 	t.Run("Dpos", testDposInsert)
 	t.Run("Dpos", testDposInsertWhitelist)
 	t.Run("DpoAutomationLogs", testDpoAutomationLogsInsert)
@@ -702,6 +996,16 @@ func TestInsert(t *testing.T) {
 	t.Run("Pixalates", testPixalatesInsertWhitelist)
 	t.Run("PriceFactorLogs", testPriceFactorLogsInsert)
 	t.Run("PriceFactorLogs", testPriceFactorLogsInsertWhitelist)
+	t.Run("PriceOverrides", testPriceOverridesInsert)
+	t.Run("PriceOverrides", testPriceOverridesInsertWhitelist)
+	t.Run("PublisherCompassSyncs", testPublisherCompassSyncsInsert)
+	t.Run("PublisherCompassSyncs", testPublisherCompassSyncsInsertWhitelist)
+	t.Run("PublisherNotificationPreferences", testPublisherNotificationPreferencesInsert)
+	t.Run("PublisherNotificationPreferences", testPublisherNotificationPreferencesInsertWhitelist)
+	t.Run("PublisherPausedRules", testPublisherPausedRulesInsert)
+	t.Run("PublisherPausedRules", testPublisherPausedRulesInsertWhitelist)
+	t.Run("PublisherStatusTransitions", testPublisherStatusTransitionsInsert)
+	t.Run("PublisherStatusTransitions", testPublisherStatusTransitionsInsertWhitelist)
 	t.Run("Publishers", testPublishersInsert)
 	t.Run("Publishers", testPublishersInsertWhitelist)
 	t.Run("PublisherDailies", testPublisherDailiesInsert)
@@ -724,25 +1028,47 @@ func TestInsert(t *testing.T) {
 	t.Run("RevenueDailies", testRevenueDailiesInsertWhitelist)
 	t.Run("RevenueHourlies", testRevenueHourliesInsert)
 	t.Run("RevenueHourlies", testRevenueHourliesInsertWhitelist)
+	t.Run("RolePermissions", testRolePermissionsInsert)
+	t.Run("RolePermissions", testRolePermissionsInsertWhitelist)
+	t.Run("SchainCompliances", testSchainCompliancesInsert)
+	t.Run("SchainCompliances", testSchainCompliancesInsertWhitelist)
 	t.Run("SchemaMigrations", testSchemaMigrationsInsert)
 	t.Run("SchemaMigrations", testSchemaMigrationsInsertWhitelist)
 	t.Run("SeatOwners", testSeatOwnersInsert)
 	t.Run("SeatOwners", testSeatOwnersInsertWhitelist)
 	t.Run("SellersJSONHistories", testSellersJSONHistoriesInsert)
 	t.Run("SellersJSONHistories", testSellersJSONHistoriesInsertWhitelist)
+	t.Run("SellersJSONVersions", testSellersJSONVersionsInsert)
+	t.Run("SellersJSONVersions", testSellersJSONVersionsInsertWhitelist)
 	t.Run("Targetings", testTargetingsInsert)
 	t.Run("Targetings", testTargetingsInsertWhitelist)
+	t.Run("UserPublishers", testUserPublishersInsert)
+	t.Run("UserPublishers", testUserPublishersInsertWhitelist)
 	t.Run("Users", testUsersInsert)
 	t.Run("Users", testUsersInsertWhitelist)
 }
 
 func TestReload(t *testing.T) {
+	t.Run("APIKeys", testAPIKeysReload)
 	t.Run("AdsTXTS", testAdsTXTSReload)
+	t.Run("AdsTXTScans", testAdsTXTScansReload)
+	t.Run("AlertEvents", testAlertEventsReload)
+	t.Run("AlertRules", testAlertRulesReload)
+	t.Run("ApprovalPolicies", testApprovalPoliciesReload)
+	t.Run("AuditLogs", testAuditLogsReload)
+	t.Run("AutomationCircuitBreakers", testAutomationCircuitBreakersReload)
 	t.Run("BidCachings", testBidCachingsReload)
+	t.Run("Blocks", testBlocksReload)
+	t.Run("ChangeRequests", testChangeRequestsReload)
+	t.Run("CompassManagers", testCompassManagersReload)
 	t.Run("CompassPublisherTags", testCompassPublisherTagsReload)
 	t.Run("Competitors", testCompetitorsReload)
 	t.Run("Confiants", testConfiantsReload)
 	t.Run("Configurations", testConfigurationsReload)
+	t.Run("DPApprovalRequests", testDPApprovalRequestsReload)
+	t.Run("DPBidderParams", testDPBidderParamsReload)
+	t.Run("DPBidderSchemas", testDPBidderSchemasReload)
+	t.Run("DPScores", testDPScoresReload)
 	t.Run("DemandDailies", testDemandDailiesReload)
 	t.Run("DemandHourlies", testDemandHourliesReload)
 	t.Run("DemandParnterPlacements", testDemandParnterPlacementsReload)
@@ -752,6 +1078,7 @@ func TestReload(t *testing.T) {
 	t.Run("DemandPartnerDailies", testDemandPartnerDailiesReload)
 	t.Run("DemandPartnerHourlies", testDemandPartnerHourliesReload)
 	t.Run("DPAPIReports", testDPAPIReportsReload)
+	t.Run("DomainOnboardings", testDomainOnboardingsReload)
 	t.Run("Dpos", testDposReload)
 	t.Run("DpoAutomationLogs", testDpoAutomationLogsReload)
 	t.Run("DpoRules", testDpoRulesReload)
@@ -774,6 +1101,11 @@ func TestReload(t *testing.T) {
 	t.Run("NoDPResponseReports", testNoDPResponseReportsReload)
 	t.Run("Pixalates", testPixalatesReload)
 	t.Run("PriceFactorLogs", testPriceFactorLogsReload)
+	t.Run("PriceOverrides", testPriceOverridesReload)
+	t.Run("PublisherCompassSyncs", testPublisherCompassSyncsReload)
+	t.Run("PublisherNotificationPreferences", testPublisherNotificationPreferencesReload)
+	t.Run("PublisherPausedRules", testPublisherPausedRulesReload)
+	t.Run("PublisherStatusTransitions", testPublisherStatusTransitionsReload)
 	t.Run("Publishers", testPublishersReload)
 	t.Run("PublisherDailies", testPublisherDailiesReload)
 	t.Run("PublisherDemands", testPublisherDemandsReload)
@@ -785,20 +1117,38 @@ func TestReload(t *testing.T) {
 	t.Run("ReportUpdates", testReportUpdatesReload)
 	t.Run("RevenueDailies", testRevenueDailiesReload)
 	t.Run("RevenueHourlies", testRevenueHourliesReload)
+	t.Run("RolePermissions", testRolePermissionsReload)
+	t.Run("SchainCompliances", testSchainCompliancesReload)
 	t.Run("SchemaMigrations", testSchemaMigrationsReload)
 	t.Run("SeatOwners", testSeatOwnersReload)
 	t.Run("SellersJSONHistories", testSellersJSONHistoriesReload)
+	t.Run("SellersJSONVersions", testSellersJSONVersionsReload)
 	t.Run("Targetings", testTargetingsReload)
+	t.Run("UserPublishers", testUserPublishersReload)
 	t.Run("Users", testUsersReload)
 }
 
 func TestReloadAll(t *testing.T) {
+	t.Run("APIKeys", testAPIKeysReloadAll)
 	t.Run("AdsTXTS", testAdsTXTSReloadAll)
+	t.Run("AdsTXTScans", testAdsTXTScansReloadAll)
+	t.Run("AlertEvents", testAlertEventsReloadAll)
+	t.Run("AlertRules", testAlertRulesReloadAll)
+	t.Run("ApprovalPolicies", testApprovalPoliciesReloadAll)
+	t.Run("AuditLogs", testAuditLogsReloadAll)
+	t.Run("AutomationCircuitBreakers", testAutomationCircuitBreakersReloadAll)
 	t.Run("BidCachings", testBidCachingsReloadAll)
+	t.Run("Blocks", testBlocksReloadAll)
+	t.Run("ChangeRequests", testChangeRequestsReloadAll)
+	t.Run("CompassManagers", testCompassManagersReloadAll)
 	t.Run("CompassPublisherTags", testCompassPublisherTagsReloadAll)
 	t.Run("Competitors", testCompetitorsReloadAll)
 	t.Run("Confiants", testConfiantsReloadAll)
 	t.Run("Configurations", testConfigurationsReloadAll)
+	t.Run("DPApprovalRequests", testDPApprovalRequestsReloadAll)
+	t.Run("DPBidderParams", testDPBidderParamsReloadAll)
+	t.Run("DPBidderSchemas", testDPBidderSchemasReloadAll)
+	t.Run("DPScores", testDPScoresReloadAll)
 	t.Run("DemandDailies", testDemandDailiesReloadAll)
 	t.Run("DemandHourlies", testDemandHourliesReloadAll)
 	t.Run("DemandParnterPlacements", testDemandParnterPlacementsReloadAll)
@@ -808,6 +1158,7 @@ func TestReloadAll(t *testing.T) {
 	t.Run("DemandPartnerDailies", testDemandPartnerDailiesReloadAll)
 	t.Run("DemandPartnerHourlies", testDemandPartnerHourliesReloadAll)
 	t.Run("DPAPIReports", testDPAPIReportsReloadAll)
+	t.Run("DomainOnboardings", testDomainOnboardingsReloadAll)
 	t.Run("Dpos", testDposReloadAll)
 	t.Run("DpoAutomationLogs", testDpoAutomationLogsReloadAll)
 	t.Run("DpoRules", testDpoRulesReloadAll)
@@ -830,6 +1181,11 @@ func TestReloadAll(t *testing.T) {
 	t.Run("NoDPResponseReports", testNoDPResponseReportsReloadAll)
 	t.Run("Pixalates", testPixalatesReloadAll)
 	t.Run("PriceFactorLogs", testPriceFactorLogsReloadAll)
+	t.Run("PriceOverrides", testPriceOverridesReloadAll)
+	t.Run("PublisherCompassSyncs", testPublisherCompassSyncsReloadAll)
+	t.Run("PublisherNotificationPreferences", testPublisherNotificationPreferencesReloadAll)
+	t.Run("PublisherPausedRules", testPublisherPausedRulesReloadAll)
+	t.Run("PublisherStatusTransitions", testPublisherStatusTransitionsReloadAll)
 	t.Run("Publishers", testPublishersReloadAll)
 	t.Run("PublisherDailies", testPublisherDailiesReloadAll)
 	t.Run("PublisherDemands", testPublisherDemandsReloadAll)
@@ -841,20 +1197,38 @@ func TestReloadAll(t *testing.T) {
 	t.Run("ReportUpdates", testReportUpdatesReloadAll)
 	t.Run("RevenueDailies", testRevenueDailiesReloadAll)
 	t.Run("RevenueHourlies", testRevenueHourliesReloadAll)
+	t.Run("RolePermissions", testRolePermissionsReloadAll)
+	t.Run("SchainCompliances", testSchainCompliancesReloadAll)
 	t.Run("SchemaMigrations", testSchemaMigrationsReloadAll)
 	t.Run("SeatOwners", testSeatOwnersReloadAll)
 	t.Run("SellersJSONHistories", testSellersJSONHistoriesReloadAll)
+	t.Run("SellersJSONVersions", testSellersJSONVersionsReloadAll)
 	t.Run("Targetings", testTargetingsReloadAll)
+	t.Run("UserPublishers", testUserPublishersReloadAll)
 	t.Run("Users", testUsersReloadAll)
 }
 
 func TestSelect(t *testing.T) {
+	t.Run("APIKeys", testAPIKeysSelect)
 	t.Run("AdsTXTS", testAdsTXTSSelect)
+	t.Run("AdsTXTScans", testAdsTXTScansSelect)
+	t.Run("AlertEvents", testAlertEventsSelect)
+	t.Run("AlertRules", testAlertRulesSelect)
+	t.Run("ApprovalPolicies", testApprovalPoliciesSelect)
+	t.Run("AuditLogs", testAuditLogsSelect)
+	t.Run("AutomationCircuitBreakers", testAutomationCircuitBreakersSelect)
 	t.Run("BidCachings", testBidCachingsSelect)
+	t.Run("Blocks", testBlocksSelect)
+	t.Run("ChangeRequests", testChangeRequestsSelect)
+	t.Run("CompassManagers", testCompassManagersSelect)
 	t.Run("CompassPublisherTags", testCompassPublisherTagsSelect)
 	t.Run("Competitors", testCompetitorsSelect)
 	t.Run("Confiants", testConfiantsSelect)
 	t.Run("Configurations", testConfigurationsSelect)
+	t.Run("DPApprovalRequests", testDPApprovalRequestsSelect)
+	t.Run("DPBidderParams", testDPBidderParamsSelect)
+	t.Run("DPBidderSchemas", testDPBidderSchemasSelect)
+	t.Run("DPScores", testDPScoresSelect)
 	t.Run("DemandDailies", testDemandDailiesSelect)
 	t.Run("DemandHourlies", testDemandHourliesSelect)
 	t.Run("DemandParnterPlacements", testDemandParnterPlacementsSelect)
@@ -864,6 +1238,7 @@ func TestSelect(t *testing.T) {
 	t.Run("DemandPartnerDailies", testDemandPartnerDailiesSelect)
 	t.Run("DemandPartnerHourlies", testDemandPartnerHourliesSelect)
 	t.Run("DPAPIReports", testDPAPIReportsSelect)
+	t.Run("DomainOnboardings", testDomainOnboardingsSelect)
 	t.Run("Dpos", testDposSelect)
 	t.Run("DpoAutomationLogs", testDpoAutomationLogsSelect)
 	t.Run("DpoRules", testDpoRulesSelect)
@@ -886,6 +1261,11 @@ func TestSelect(t *testing.T) {
 	t.Run("NoDPResponseReports", testNoDPResponseReportsSelect)
 	t.Run("Pixalates", testPixalatesSelect)
 	t.Run("PriceFactorLogs", testPriceFactorLogsSelect)
+	t.Run("PriceOverrides", testPriceOverridesSelect)
+	t.Run("PublisherCompassSyncs", testPublisherCompassSyncsSelect)
+	t.Run("PublisherNotificationPreferences", testPublisherNotificationPreferencesSelect)
+	t.Run("PublisherPausedRules", testPublisherPausedRulesSelect)
+	t.Run("PublisherStatusTransitions", testPublisherStatusTransitionsSelect)
 	t.Run("Publishers", testPublishersSelect)
 	t.Run("PublisherDailies", testPublisherDailiesSelect)
 	t.Run("PublisherDemands", testPublisherDemandsSelect)
@@ -897,20 +1277,38 @@ func TestSelect(t *testing.T) {
 	t.Run("ReportUpdates", testReportUpdatesSelect)
 	t.Run("RevenueDailies", testRevenueDailiesSelect)
 	t.Run("RevenueHourlies", testRevenueHourliesSelect)
+	t.Run("RolePermissions", testRolePermissionsSelect)
+	t.Run("SchainCompliances", testSchainCompliancesSelect)
 	t.Run("SchemaMigrations", testSchemaMigrationsSelect)
 	t.Run("SeatOwners", testSeatOwnersSelect)
 	t.Run("SellersJSONHistories", testSellersJSONHistoriesSelect)
+	t.Run("SellersJSONVersions", testSellersJSONVersionsSelect)
 	t.Run("Targetings", testTargetingsSelect)
+	t.Run("UserPublishers", testUserPublishersSelect)
 	t.Run("Users", testUsersSelect)
 }
 
 func TestUpdate(t *testing.T) {
+	t.Run("APIKeys", testAPIKeysUpdate)
 	t.Run("AdsTXTS", testAdsTXTSUpdate)
+	t.Run("AdsTXTScans", testAdsTXTScansUpdate)
+	t.Run("AlertEvents", testAlertEventsUpdate)
+	t.Run("AlertRules", testAlertRulesUpdate)
+	t.Run("ApprovalPolicies", testApprovalPoliciesUpdate)
+	t.Run("AuditLogs", testAuditLogsUpdate)
+	t.Run("AutomationCircuitBreakers", testAutomationCircuitBreakersUpdate)
 	t.Run("BidCachings", testBidCachingsUpdate)
+	t.Run("Blocks", testBlocksUpdate)
+	t.Run("ChangeRequests", testChangeRequestsUpdate)
+	t.Run("CompassManagers", testCompassManagersUpdate)
 	t.Run("CompassPublisherTags", testCompassPublisherTagsUpdate)
 	t.Run("Competitors", testCompetitorsUpdate)
 	t.Run("Confiants", testConfiantsUpdate)
 	t.Run("Configurations", testConfigurationsUpdate)
+	t.Run("DPApprovalRequests", testDPApprovalRequestsUpdate)
+	t.Run("DPBidderParams", testDPBidderParamsUpdate)
+	t.Run("DPBidderSchemas", testDPBidderSchemasUpdate)
+	t.Run("DPScores", testDPScoresUpdate)
 	t.Run("DemandDailies", testDemandDailiesUpdate)
 	t.Run("DemandHourlies", testDemandHourliesUpdate)
 	t.Run("DemandParnterPlacements", testDemandParnterPlacementsUpdate)
@@ -920,6 +1318,7 @@ func TestUpdate(t *testing.T) {
 	t.Run("DemandPartnerDailies", testDemandPartnerDailiesUpdate)
 	t.Run("DemandPartnerHourlies", testDemandPartnerHourliesUpdate)
 	t.Run("DPAPIReports", testDPAPIReportsUpdate)
+	t.Run("DomainOnboardings", testDomainOnboardingsUpdate)
 	t.Run("Dpos", testDposUpdate)
 	t.Run("DpoAutomationLogs", testDpoAutomationLogsUpdate)
 	t.Run("DpoRules", testDpoRulesUpdate)
@@ -942,6 +1341,11 @@ func TestUpdate(t *testing.T) {
 	t.Run("NoDPResponseReports", testNoDPResponseReportsUpdate)
 	t.Run("Pixalates", testPixalatesUpdate)
 	t.Run("PriceFactorLogs", testPriceFactorLogsUpdate)
+	t.Run("PriceOverrides", testPriceOverridesUpdate)
+	t.Run("PublisherCompassSyncs", testPublisherCompassSyncsUpdate)
+	t.Run("PublisherNotificationPreferences", testPublisherNotificationPreferencesUpdate)
+	t.Run("PublisherPausedRules", testPublisherPausedRulesUpdate)
+	t.Run("PublisherStatusTransitions", testPublisherStatusTransitionsUpdate)
 	t.Run("Publishers", testPublishersUpdate)
 	t.Run("PublisherDailies", testPublisherDailiesUpdate)
 	t.Run("PublisherDemands", testPublisherDemandsUpdate)
@@ -953,20 +1357,38 @@ func TestUpdate(t *testing.T) {
 	t.Run("ReportUpdates", testReportUpdatesUpdate)
 	t.Run("RevenueDailies", testRevenueDailiesUpdate)
 	t.Run("RevenueHourlies", testRevenueHourliesUpdate)
+	t.Run("RolePermissions", testRolePermissionsUpdate)
+	t.Run("SchainCompliances", testSchainCompliancesUpdate)
 	t.Run("SchemaMigrations", testSchemaMigrationsUpdate)
 	t.Run("SeatOwners", testSeatOwnersUpdate)
 	t.Run("SellersJSONHistories", testSellersJSONHistoriesUpdate)
+	t.Run("SellersJSONVersions", testSellersJSONVersionsUpdate)
 	t.Run("Targetings", testTargetingsUpdate)
+	t.Run("UserPublishers", testUserPublishersUpdate)
 	t.Run("Users", testUsersUpdate)
 }
 
 func TestSliceUpdateAll(t *testing.T) {
+	t.Run("APIKeys", testAPIKeysSliceUpdateAll)
 	t.Run("AdsTXTS", testAdsTXTSSliceUpdateAll)
+	t.Run("AdsTXTScans", testAdsTXTScansSliceUpdateAll)
+	t.Run("AlertEvents", testAlertEventsSliceUpdateAll)
+	t.Run("AlertRules", testAlertRulesSliceUpdateAll)
+	t.Run("ApprovalPolicies", testApprovalPoliciesSliceUpdateAll)
+	t.Run("AuditLogs", testAuditLogsSliceUpdateAll)
+	t.Run("AutomationCircuitBreakers", testAutomationCircuitBreakersSliceUpdateAll)
 	t.Run("BidCachings", testBidCachingsSliceUpdateAll)
+	t.Run("Blocks", testBlocksSliceUpdateAll)
+	t.Run("ChangeRequests", testChangeRequestsSliceUpdateAll)
+	t.Run("CompassManagers", testCompassManagersSliceUpdateAll)
 	t.Run("CompassPublisherTags", testCompassPublisherTagsSliceUpdateAll)
 	t.Run("Competitors", testCompetitorsSliceUpdateAll)
 	t.Run("Confiants", testConfiantsSliceUpdateAll)
 	t.Run("Configurations", testConfigurationsSliceUpdateAll)
+	t.Run("DPApprovalRequests", testDPApprovalRequestsSliceUpdateAll)
+	t.Run("DPBidderParams", testDPBidderParamsSliceUpdateAll)
+	t.Run("DPBidderSchemas", testDPBidderSchemasSliceUpdateAll)
+	t.Run("DPScores", testDPScoresSliceUpdateAll)
 	t.Run("DemandDailies", testDemandDailiesSliceUpdateAll)
 	t.Run("DemandHourlies", testDemandHourliesSliceUpdateAll)
 	t.Run("DemandParnterPlacements", testDemandParnterPlacementsSliceUpdateAll)
@@ -976,6 +1398,7 @@ func TestSliceUpdateAll(t *testing.T) {
 	t.Run("DemandPartnerDailies", testDemandPartnerDailiesSliceUpdateAll)
 	t.Run("DemandPartnerHourlies", testDemandPartnerHourliesSliceUpdateAll)
 	t.Run("DPAPIReports", testDPAPIReportsSliceUpdateAll)
+	t.Run("DomainOnboardings", testDomainOnboardingsSliceUpdateAll)
 	t.Run("Dpos", testDposSliceUpdateAll)
 	t.Run("DpoAutomationLogs", testDpoAutomationLogsSliceUpdateAll)
 	t.Run("DpoRules", testDpoRulesSliceUpdateAll)
@@ -998,6 +1421,11 @@ func TestSliceUpdateAll(t *testing.T) {
 	t.Run("NoDPResponseReports", testNoDPResponseReportsSliceUpdateAll)
 	t.Run("Pixalates", testPixalatesSliceUpdateAll)
 	t.Run("PriceFactorLogs", testPriceFactorLogsSliceUpdateAll)
+	t.Run("PriceOverrides", testPriceOverridesSliceUpdateAll)
+	t.Run("PublisherCompassSyncs", testPublisherCompassSyncsSliceUpdateAll)
+	t.Run("PublisherNotificationPreferences", testPublisherNotificationPreferencesSliceUpdateAll)
+	t.Run("PublisherPausedRules", testPublisherPausedRulesSliceUpdateAll)
+	t.Run("PublisherStatusTransitions", testPublisherStatusTransitionsSliceUpdateAll)
 	t.Run("Publishers", testPublishersSliceUpdateAll)
 	t.Run("PublisherDailies", testPublisherDailiesSliceUpdateAll)
 	t.Run("PublisherDemands", testPublisherDemandsSliceUpdateAll)
@@ -1009,9 +1437,13 @@ func TestSliceUpdateAll(t *testing.T) {
 	t.Run("ReportUpdates", testReportUpdatesSliceUpdateAll)
 	t.Run("RevenueDailies", testRevenueDailiesSliceUpdateAll)
 	t.Run("RevenueHourlies", testRevenueHourliesSliceUpdateAll)
+	t.Run("RolePermissions", testRolePermissionsSliceUpdateAll)
+	t.Run("SchainCompliances", testSchainCompliancesSliceUpdateAll)
 	t.Run("SchemaMigrations", testSchemaMigrationsSliceUpdateAll)
 	t.Run("SeatOwners", testSeatOwnersSliceUpdateAll)
 	t.Run("SellersJSONHistories", testSellersJSONHistoriesSliceUpdateAll)
+	t.Run("SellersJSONVersions", testSellersJSONVersionsSliceUpdateAll)
 	t.Run("Targetings", testTargetingsSliceUpdateAll)
+	t.Run("UserPublishers", testUserPublishersSliceUpdateAll)
 	t.Run("Users", testUsersSliceUpdateAll)
 }
@@ -4,111 +4,157 @@
 package models
 
 var TableNames = struct {
-	AdsTXT                  string
-	BidCaching              string
-	CompassPublisherTag     string
-	Competitors             string
-	Confiant                string
-	Configuration           string
-	DemandDaily             string
-	DemandHourly            string
-	DemandParnterPlacement  string
-	DemandPartner           string
-	DemandPartnerChild      string
-	DemandPartnerConnection string
-	DemandPartnerDaily      string
-	DemandPartnerHourly     string
-	DPAPIReport             string
-	Dpo                     string
-	DpoAutomationLog        string
-	DpoRule                 string
-	Factor                  string
-	Floor                   string
-	GlobalFactor            string
-	History                 string
-	ID5Testing              string
-	IiqDaily                string
-	IiqHourly               string
-	IiqTesting              string
-	ImpressionLogDaily      string
-	ImpressionLogHourly     string
-	MetadataInstance        string
-	MetadataQueue           string
-	MetadataQueueTemp       string
-	MissingSellers          string
-	NBDemandHourly          string
-	NBSupplyHourly          string
-	NoDPResponseReport      string
-	Pixalate                string
-	PriceFactorLog          string
-	Publisher               string
-	PublisherDaily          string
-	PublisherDemand         string
-	PublisherDomain         string
-	PublisherHourly         string
-	PublisherSync           string
-	RealTimeReport          string
-	RefreshCache            string
-	ReportUpdate            string
-	RevenueDaily            string
-	RevenueHourly           string
-	SchemaMigrations        string
-	SeatOwner               string
-	SellersJSONHistory      string
-	Targeting               string
-	User                    string
+	APIKey                          string
+	AdsTXT                          string
+	AdsTXTScan                      string
+	AlertEvent                      string
+	AlertRule                       string
+	ApprovalPolicy                  string
+	AuditLog                        string
+	AutomationCircuitBreaker        string
+	BidCaching                      string
+	Blocks                          string
+	ChangeRequest                   string
+	CompassManager                  string
+	CompassPublisherTag             string
+	Competitors                     string
+	Confiant                        string
+	Configuration                   string
+	DPApprovalRequest               string
+	DPBidderParam                   string
+	DPBidderSchema                  string
+	DPScore                         string
+	DemandDaily                     string
+	DemandHourly                    string
+	DemandParnterPlacement          string
+	DemandPartner                   string
+	DemandPartnerChild              string
+	DemandPartnerConnection         string
+	DemandPartnerDaily              string
+	DemandPartnerHourly             string
+	DPAPIReport                     string
+	DomainOnboarding                string
+	Dpo                             string
+	DpoAutomationLog                string
+	DpoRule                         string
+	Factor                          string
+	Floor                           string
+	GlobalFactor                    string
+	History                         string
+	ID5Testing                      string
+	IiqDaily                        string
+	IiqHourly                       string
+	IiqTesting                      string
+	ImpressionLogDaily              string
+	ImpressionLogHourly             string
+	MetadataInstance                string
+	MetadataQueue                   string
+	MetadataQueueTemp               string
+	MissingSellers                  string
+	NBDemandHourly                  string
+	NBSupplyHourly                  string
+	NoDPResponseReport              string
+	Pixalate                        string
+	PriceFactorLog                  string
+	PriceOverride                   string
+	Publisher                       string
+	PublisherCompassSync            string
+	PublisherDaily                  string
+	PublisherDemand                 string
+	PublisherDomain                 string
+	PublisherHourly                 string
+	PublisherNotificationPreference string
+	PublisherPausedRule             string
+	PublisherStatusTransition       string
+	PublisherSync                   string
+	RealTimeReport                  string
+	RefreshCache                    string
+	ReportUpdate                    string
+	RevenueDaily                    string
+	RevenueHourly                   string
+	RolePermission                  string
+	SchainCompliance                string
+	SchemaMigrations                string
+	SeatOwner                       string
+	SellersJSONHistory              string
+	SellersJSONVersion              string
+	Targeting                       string
+	User                            string
 }{
-	AdsTXT:                  "ads_txt",
-	BidCaching:              "bid_caching",
-	CompassPublisherTag:     "compass_publisher_tag",
-	Competitors:             "competitors",
-	Confiant:                "confiant",
-	Configuration:           "configuration",
-	DemandDaily:             "demand_daily",
-	DemandHourly:            "demand_hourly",
-	DemandParnterPlacement:  "demand_parnter_placement",
-	DemandPartner:           "demand_partner",
-	DemandPartnerChild:      "demand_partner_child",
-	DemandPartnerConnection: "demand_partner_connection",
-	DemandPartnerDaily:      "demand_partner_daily",
-	DemandPartnerHourly:     "demand_partner_hourly",
-	DPAPIReport:             "dp_api_report",
-	Dpo:                     "dpo",
-	DpoAutomationLog:        "dpo_automation_log",
-	DpoRule:                 "dpo_rule",
-	Factor:                  "factor",
-	Floor:                   "floor",
-	GlobalFactor:            "global_factor",
-	History:                 "history",
-	ID5Testing:              "id5_testing",
-	IiqDaily:                "iiq_daily",
-	IiqHourly:               "iiq_hourly",
-	IiqTesting:              "iiq_testing",
-	ImpressionLogDaily:      "impression_log_daily",
-	ImpressionLogHourly:     "impression_log_hourly",
-	MetadataInstance:        "metadata_instance",
-	MetadataQueue:           "metadata_queue",
-	MetadataQueueTemp:       "metadata_queue_temp",
-	MissingSellers:          "missing_sellers",
-	NBDemandHourly:          "nb_demand_hourly",
-	NBSupplyHourly:          "nb_supply_hourly",
-	NoDPResponseReport:      "no_dp_response_report",
-	Pixalate:                "pixalate",
-	PriceFactorLog:          "price_factor_log",
-	Publisher:               "publisher",
-	PublisherDaily:          "publisher_daily",
-	PublisherDemand:         "publisher_demand",
-	PublisherDomain:         "publisher_domain",
-	PublisherHourly:         "publisher_hourly",
-	PublisherSync:           "publisher_sync",
-	RealTimeReport:          "real_time_report",
-	RefreshCache:            "refresh_cache",
-	ReportUpdate:            "report_update",
-	RevenueDaily:            "revenue_daily",
-	RevenueHourly:           "revenue_hourly",
-	SchemaMigrations:        "schema_migrations",
-	SeatOwner:               "seat_owner",
-	SellersJSONHistory:      "sellers_json_history",
-	Targeting:               "targeting",
-	User:                    "user",
+	APIKey:                          "api_key",
+	AdsTXT:                          "ads_txt",
+	AdsTXTScan:                      "ads_txt_scan",
+	AlertEvent:                      "alert_event",
+	AlertRule:                       "alert_rule",
+	ApprovalPolicy:                  "approval_policy",
+	AuditLog:                        "audit_log",
+	AutomationCircuitBreaker:        "automation_circuit_breaker",
+	BidCaching:                      "bid_caching",
+	Blocks:                          "blocks",
+	ChangeRequest:                   "change_request",
+	CompassManager:                  "compass_manager",
+	CompassPublisherTag:             "compass_publisher_tag",
+	Competitors:                     "competitors",
+	Confiant:                        "confiant",
+	Configuration:                   "configuration",
+	DPApprovalRequest:               "dp_approval_request",
+	DPBidderParam:                   "dp_bidder_param",
+	DPBidderSchema:                  "dp_bidder_schema",
+	DPScore:                         "dp_score",
+	DemandDaily:                     "demand_daily",
+	DemandHourly:                    "demand_hourly",
+	DemandParnterPlacement:          "demand_parnter_placement",
+	DemandPartner:                   "demand_partner",
+	DemandPartnerChild:              "demand_partner_child",
+	DemandPartnerConnection:         "demand_partner_connection",
+	DemandPartnerDaily:              "demand_partner_daily",
+	DemandPartnerHourly:             "demand_partner_hourly",
+	DPAPIReport:                     "dp_api_report",
+	DomainOnboarding:                "domain_onboarding",
+	Dpo:                             "dpo",
+	DpoAutomationLog:                "dpo_automation_log",
+	DpoRule:                         "dpo_rule",
+	Factor:                          "factor",
+	Floor:                           "floor",
+	GlobalFactor:                    "global_factor",
+	History:                         "history",
+	ID5Testing:                      "id5_testing",
+	IiqDaily:                        "iiq_daily",
+	IiqHourly:                       "iiq_hourly",
+	IiqTesting:                      "iiq_testing",
+	ImpressionLogDaily:              "impression_log_daily",
+	ImpressionLogHourly:             "impression_log_hourly",
+	MetadataInstance:                "metadata_instance",
+	MetadataQueue:                   "metadata_queue",
+	MetadataQueueTemp:               "metadata_queue_temp",
+	MissingSellers:                  "missing_sellers",
+	NBDemandHourly:                  "nb_demand_hourly",
+	NBSupplyHourly:                  "nb_supply_hourly",
+	NoDPResponseReport:              "no_dp_response_report",
+	Pixalate:                        "pixalate",
+	PriceFactorLog:                  "price_factor_log",
+	PriceOverride:                   "price_override",
+	Publisher:                       "publisher",
+	PublisherCompassSync:            "publisher_compass_sync",
+	PublisherDaily:                  "publisher_daily",
+	PublisherDemand:                 "publisher_demand",
+	PublisherDomain:                 "publisher_domain",
+	PublisherHourly:                 "publisher_hourly",
+	PublisherNotificationPreference: "publisher_notification_preference",
+	PublisherPausedRule:             "publisher_paused_rule",
+	PublisherStatusTransition:       "publisher_status_transition",
+	PublisherSync:                   "publisher_sync",
+	RealTimeReport:                  "real_time_report",
+	RefreshCache:                    "refresh_cache",
+	ReportUpdate:                    "report_update",
+	RevenueDaily:                    "revenue_daily",
+	RevenueHourly:                   "revenue_hourly",
+	RolePermission:                  "role_permission",
+	SchainCompliance:                "schain_compliance",
+	SchemaMigrations:                "schema_migrations",
+	SeatOwner:                       "seat_owner",
+	SellersJSONHistory:              "sellers_json_history",
+	SellersJSONVersion:              "sellers_json_version",
+	Targeting:                       "targeting",
+	User:                            "user",
 }
@@ -0,0 +1,1045 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// ChangeRequest is an object representing the database table.
+type ChangeRequest struct {
+	ID               int         `boil:"id" json:"id" toml:"id" yaml:"id"`
+	PolicyID         int         `boil:"policy_id" json:"policy_id" toml:"policy_id" yaml:"policy_id"`
+	Subject          string      `boil:"subject" json:"subject" toml:"subject" yaml:"subject"`
+	Route            string      `boil:"route" json:"route" toml:"route" yaml:"route"`
+	Payload          types.JSON  `boil:"payload" json:"payload" toml:"payload" yaml:"payload"`
+	ChangePercentage float64     `boil:"change_percentage" json:"change_percentage" toml:"change_percentage" yaml:"change_percentage"`
+	Status           string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	RequesterID      int         `boil:"requester_id" json:"requester_id" toml:"requester_id" yaml:"requester_id"`
+	RequesterRole    string      `boil:"requester_role" json:"requester_role" toml:"requester_role" yaml:"requester_role"`
+	ApproverID       null.Int    `boil:"approver_id" json:"approver_id,omitempty" toml:"approver_id" yaml:"approver_id,omitempty"`
+	Comment          null.String `boil:"comment" json:"comment,omitempty" toml:"comment" yaml:"comment,omitempty"`
+	ErrorMessage     null.String `boil:"error_message" json:"error_message,omitempty" toml:"error_message" yaml:"error_message,omitempty"`
+	CreatedAt        time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt        null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+	DecidedAt        null.Time   `boil:"decided_at" json:"decided_at,omitempty" toml:"decided_at" yaml:"decided_at,omitempty"`
+
+	R *changeRequestR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L changeRequestL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ChangeRequestColumns = struct {
+	ID               string
+	PolicyID         string
+	Subject          string
+	Route            string
+	Payload          string
+	ChangePercentage string
+	Status           string
+	RequesterID      string
+	RequesterRole    string
+	ApproverID       string
+	Comment          string
+	ErrorMessage     string
+	CreatedAt        string
+	UpdatedAt        string
+	DecidedAt        string
+}{
+	ID:               "id",
+	PolicyID:         "policy_id",
+	Subject:          "subject",
+	Route:            "route",
+	Payload:          "payload",
+	ChangePercentage: "change_percentage",
+	Status:           "status",
+	RequesterID:      "requester_id",
+	RequesterRole:    "requester_role",
+	ApproverID:       "approver_id",
+	Comment:          "comment",
+	ErrorMessage:     "error_message",
+	CreatedAt:        "created_at",
+	UpdatedAt:        "updated_at",
+	DecidedAt:        "decided_at",
+}
+
+var ChangeRequestTableColumns = struct {
+	ID               string
+	PolicyID         string
+	Subject          string
+	Route            string
+	Payload          string
+	ChangePercentage string
+	Status           string
+	RequesterID      string
+	RequesterRole    string
+	ApproverID       string
+	Comment          string
+	ErrorMessage     string
+	CreatedAt        string
+	UpdatedAt        string
+	DecidedAt        string
+}{
+	ID:               "change_request.id",
+	PolicyID:         "change_request.policy_id",
+	Subject:          "change_request.subject",
+	Route:            "change_request.route",
+	Payload:          "change_request.payload",
+	ChangePercentage: "change_request.change_percentage",
+	Status:           "change_request.status",
+	RequesterID:      "change_request.requester_id",
+	RequesterRole:    "change_request.requester_role",
+	ApproverID:       "change_request.approver_id",
+	Comment:          "change_request.comment",
+	ErrorMessage:     "change_request.error_message",
+	CreatedAt:        "change_request.created_at",
+	UpdatedAt:        "change_request.updated_at",
+	DecidedAt:        "change_request.decided_at",
+}
+
+// Generated where
+
+var ChangeRequestWhere = struct {
+	ID               whereHelperint
+	PolicyID         whereHelperint
+	Subject          whereHelperstring
+	Route            whereHelperstring
+	Payload          whereHelpertypes_JSON
+	ChangePercentage whereHelperfloat64
+	Status           whereHelperstring
+	RequesterID      whereHelperint
+	RequesterRole    whereHelperstring
+	ApproverID       whereHelpernull_Int
+	Comment          whereHelpernull_String
+	ErrorMessage     whereHelpernull_String
+	CreatedAt        whereHelpertime_Time
+	UpdatedAt        whereHelpernull_Time
+	DecidedAt        whereHelpernull_Time
+}{
+	ID:               whereHelperint{field: "\"change_request\".\"id\""},
+	PolicyID:         whereHelperint{field: "\"change_request\".\"policy_id\""},
+	Subject:          whereHelperstring{field: "\"change_request\".\"subject\""},
+	Route:            whereHelperstring{field: "\"change_request\".\"route\""},
+	Payload:          whereHelpertypes_JSON{field: "\"change_request\".\"payload\""},
+	ChangePercentage: whereHelperfloat64{field: "\"change_request\".\"change_percentage\""},
+	Status:           whereHelperstring{field: "\"change_request\".\"status\""},
+	RequesterID:      whereHelperint{field: "\"change_request\".\"requester_id\""},
+	RequesterRole:    whereHelperstring{field: "\"change_request\".\"requester_role\""},
+	ApproverID:       whereHelpernull_Int{field: "\"change_request\".\"approver_id\""},
+	Comment:          whereHelpernull_String{field: "\"change_request\".\"comment\""},
+	ErrorMessage:     whereHelpernull_String{field: "\"change_request\".\"error_message\""},
+	CreatedAt:        whereHelpertime_Time{field: "\"change_request\".\"created_at\""},
+	UpdatedAt:        whereHelpernull_Time{field: "\"change_request\".\"updated_at\""},
+	DecidedAt:        whereHelpernull_Time{field: "\"change_request\".\"decided_at\""},
+}
+
+// ChangeRequestRels is where relationship names are stored.
+var ChangeRequestRels = struct {
+}{}
+
+// changeRequestR is where relationships are stored.
+type changeRequestR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*changeRequestR) NewStruct() *changeRequestR {
+	return &changeRequestR{}
+}
+
+// changeRequestL is where Load methods for each relationship are stored.
+type changeRequestL struct{}
+
+var (
+	changeRequestAllColumns            = []string{"id", "policy_id", "subject", "route", "payload", "change_percentage", "status", "requester_id", "requester_role", "approver_id", "comment", "error_message", "created_at", "updated_at", "decided_at"}
+	changeRequestColumnsWithoutDefault = []string{"policy_id", "subject", "route", "payload", "requester_id", "requester_role", "created_at"}
+	changeRequestColumnsWithDefault    = []string{"id", "change_percentage", "status", "approver_id", "comment", "error_message", "updated_at", "decided_at"}
+	changeRequestPrimaryKeyColumns     = []string{"id"}
+	changeRequestGeneratedColumns      = []string{}
+)
+
+type (
+	// ChangeRequestSlice is an alias for a slice of pointers to ChangeRequest.
+	// This should almost always be used instead of []ChangeRequest.
+	ChangeRequestSlice []*ChangeRequest
+	// ChangeRequestHook is the signature for custom ChangeRequest hook methods
+	ChangeRequestHook func(context.Context, boil.ContextExecutor, *ChangeRequest) error
+
+	changeRequestQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	changeRequestType                 = reflect.TypeOf(&ChangeRequest{})
+	changeRequestMapping              = queries.MakeStructMapping(changeRequestType)
+	changeRequestPrimaryKeyMapping, _ = queries.BindMapping(changeRequestType, changeRequestMapping, changeRequestPrimaryKeyColumns)
+	changeRequestInsertCacheMut       sync.RWMutex
+	changeRequestInsertCache          = make(map[string]insertCache)
+	changeRequestUpdateCacheMut       sync.RWMutex
+	changeRequestUpdateCache          = make(map[string]updateCache)
+	changeRequestUpsertCacheMut       sync.RWMutex
+	changeRequestUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var changeRequestAfterSelectMu sync.Mutex
+var changeRequestAfterSelectHooks []ChangeRequestHook
+
+var changeRequestBeforeInsertMu sync.Mutex
+var changeRequestBeforeInsertHooks []ChangeRequestHook
+var changeRequestAfterInsertMu sync.Mutex
+var changeRequestAfterInsertHooks []ChangeRequestHook
+
+var changeRequestBeforeUpdateMu sync.Mutex
+var changeRequestBeforeUpdateHooks []ChangeRequestHook
+var changeRequestAfterUpdateMu sync.Mutex
+var changeRequestAfterUpdateHooks []ChangeRequestHook
+
+var changeRequestBeforeDeleteMu sync.Mutex
+var changeRequestBeforeDeleteHooks []ChangeRequestHook
+var changeRequestAfterDeleteMu sync.Mutex
+var changeRequestAfterDeleteHooks []ChangeRequestHook
+
+var changeRequestBeforeUpsertMu sync.Mutex
+var changeRequestBeforeUpsertHooks []ChangeRequestHook
+var changeRequestAfterUpsertMu sync.Mutex
+var changeRequestAfterUpsertHooks []ChangeRequestHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ChangeRequest) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range changeRequestAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ChangeRequest) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range changeRequestBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ChangeRequest) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range changeRequestAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ChangeRequest) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range changeRequestBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ChangeRequest) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range changeRequestAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ChangeRequest) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range changeRequestBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ChangeRequest) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range changeRequestAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ChangeRequest) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range changeRequestBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ChangeRequest) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range changeRequestAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddChangeRequestHook registers your hook function for all future operations.
+func AddChangeRequestHook(hookPoint boil.HookPoint, changeRequestHook ChangeRequestHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		changeRequestAfterSelectMu.Lock()
+		changeRequestAfterSelectHooks = append(changeRequestAfterSelectHooks, changeRequestHook)
+		changeRequestAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		changeRequestBeforeInsertMu.Lock()
+		changeRequestBeforeInsertHooks = append(changeRequestBeforeInsertHooks, changeRequestHook)
+		changeRequestBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		changeRequestAfterInsertMu.Lock()
+		changeRequestAfterInsertHooks = append(changeRequestAfterInsertHooks, changeRequestHook)
+		changeRequestAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		changeRequestBeforeUpdateMu.Lock()
+		changeRequestBeforeUpdateHooks = append(changeRequestBeforeUpdateHooks, changeRequestHook)
+		changeRequestBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		changeRequestAfterUpdateMu.Lock()
+		changeRequestAfterUpdateHooks = append(changeRequestAfterUpdateHooks, changeRequestHook)
+		changeRequestAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		changeRequestBeforeDeleteMu.Lock()
+		changeRequestBeforeDeleteHooks = append(changeRequestBeforeDeleteHooks, changeRequestHook)
+		changeRequestBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		changeRequestAfterDeleteMu.Lock()
+		changeRequestAfterDeleteHooks = append(changeRequestAfterDeleteHooks, changeRequestHook)
+		changeRequestAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		changeRequestBeforeUpsertMu.Lock()
+		changeRequestBeforeUpsertHooks = append(changeRequestBeforeUpsertHooks, changeRequestHook)
+		changeRequestBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		changeRequestAfterUpsertMu.Lock()
+		changeRequestAfterUpsertHooks = append(changeRequestAfterUpsertHooks, changeRequestHook)
+		changeRequestAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single changeRequest record from the query.
+func (q changeRequestQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ChangeRequest, error) {
+	o := &ChangeRequest{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for change_request")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ChangeRequest records from the query.
+func (q changeRequestQuery) All(ctx context.Context, exec boil.ContextExecutor) (ChangeRequestSlice, error) {
+	var o []*ChangeRequest
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ChangeRequest slice")
+	}
+
+	if len(changeRequestAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ChangeRequest records in the query.
+func (q changeRequestQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count change_request rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q changeRequestQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if change_request exists")
+	}
+
+	return count > 0, nil
+}
+
+// ChangeRequests retrieves all the records using an executor.
+func ChangeRequests(mods ...qm.QueryMod) changeRequestQuery {
+	mods = append(mods, qm.From("\"change_request\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"change_request\".*"})
+	}
+
+	return changeRequestQuery{q}
+}
+
+// FindChangeRequest retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindChangeRequest(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*ChangeRequest, error) {
+	changeRequestObj := &ChangeRequest{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"change_request\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, changeRequestObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from change_request")
+	}
+
+	if err = changeRequestObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return changeRequestObj, err
+	}
+
+	return changeRequestObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ChangeRequest) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no change_request provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(changeRequestColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	changeRequestInsertCacheMut.RLock()
+	cache, cached := changeRequestInsertCache[key]
+	changeRequestInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			changeRequestAllColumns,
+			changeRequestColumnsWithDefault,
+			changeRequestColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(changeRequestType, changeRequestMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(changeRequestType, changeRequestMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"change_request\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"change_request\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into change_request")
+	}
+
+	if !cached {
+		changeRequestInsertCacheMut.Lock()
+		changeRequestInsertCache[key] = cache
+		changeRequestInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ChangeRequest.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ChangeRequest) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	changeRequestUpdateCacheMut.RLock()
+	cache, cached := changeRequestUpdateCache[key]
+	changeRequestUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			changeRequestAllColumns,
+			changeRequestPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update change_request, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"change_request\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, changeRequestPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(changeRequestType, changeRequestMapping, append(wl, changeRequestPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update change_request row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for change_request")
+	}
+
+	if !cached {
+		changeRequestUpdateCacheMut.Lock()
+		changeRequestUpdateCache[key] = cache
+		changeRequestUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q changeRequestQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for change_request")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for change_request")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ChangeRequestSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), changeRequestPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"change_request\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, changeRequestPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in changeRequest slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all changeRequest")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ChangeRequest) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no change_request provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(changeRequestColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	changeRequestUpsertCacheMut.RLock()
+	cache, cached := changeRequestUpsertCache[key]
+	changeRequestUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			changeRequestAllColumns,
+			changeRequestColumnsWithDefault,
+			changeRequestColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			changeRequestAllColumns,
+			changeRequestPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert change_request, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(changeRequestAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(changeRequestPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert change_request, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(changeRequestPrimaryKeyColumns))
+			copy(conflict, changeRequestPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"change_request\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(changeRequestType, changeRequestMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(changeRequestType, changeRequestMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert change_request")
+	}
+
+	if !cached {
+		changeRequestUpsertCacheMut.Lock()
+		changeRequestUpsertCache[key] = cache
+		changeRequestUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ChangeRequest record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ChangeRequest) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ChangeRequest provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), changeRequestPrimaryKeyMapping)
+	sql := "DELETE FROM \"change_request\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from change_request")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for change_request")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q changeRequestQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no changeRequestQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from change_request")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for change_request")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ChangeRequestSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(changeRequestBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), changeRequestPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"change_request\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, changeRequestPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from changeRequest slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for change_request")
+	}
+
+	if len(changeRequestAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ChangeRequest) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindChangeRequest(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ChangeRequestSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ChangeRequestSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), changeRequestPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"change_request\".* FROM \"change_request\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, changeRequestPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ChangeRequestSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ChangeRequestExists checks if the ChangeRequest row exists.
+func ChangeRequestExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"change_request\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if change_request exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ChangeRequest row exists.
+func (o *ChangeRequest) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ChangeRequestExists(ctx, exec, o.ID)
+}
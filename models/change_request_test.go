@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testChangeRequests(t *testing.T) {
+	t.Parallel()
+
+	query := ChangeRequests()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testChangeRequestsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChangeRequest{}
+	if err = randomize.Struct(seed, o, changeRequestDBTypes, true, changeRequestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChangeRequest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ChangeRequests().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testChangeRequestsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChangeRequest{}
+	if err = randomize.Struct(seed, o, changeRequestDBTypes, true, changeRequestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChangeRequest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := ChangeRequests().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ChangeRequests().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testChangeRequestsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChangeRequest{}
+	if err = randomize.Struct(seed, o, changeRequestDBTypes, true, changeRequestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChangeRequest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ChangeRequestSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ChangeRequests().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testChangeRequestsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChangeRequest{}
+	if err = randomize.Struct(seed, o, changeRequestDBTypes, true, changeRequestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChangeRequest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ChangeRequestExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if ChangeRequest exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ChangeRequestExists to return true, but got false.")
+	}
+}
+
+func testChangeRequestsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChangeRequest{}
+	if err = randomize.Struct(seed, o, changeRequestDBTypes, true, changeRequestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChangeRequest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	changeRequestFound, err := FindChangeRequest(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if changeRequestFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testChangeRequestsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChangeRequest{}
+	if err = randomize.Struct(seed, o, changeRequestDBTypes, true, changeRequestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChangeRequest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = ChangeRequests().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testChangeRequestsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChangeRequest{}
+	if err = randomize.Struct(seed, o, changeRequestDBTypes, true, changeRequestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChangeRequest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := ChangeRequests().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testChangeRequestsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	changeRequestOne := &ChangeRequest{}
+	changeRequestTwo := &ChangeRequest{}
+	if err = randomize.Struct(seed, changeRequestOne, changeRequestDBTypes, false, changeRequestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChangeRequest struct: %s", err)
+	}
+	if err = randomize.Struct(seed, changeRequestTwo, changeRequestDBTypes, false, changeRequestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChangeRequest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = changeRequestOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = changeRequestTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ChangeRequests().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testChangeRequestsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	changeRequestOne := &ChangeRequest{}
+	changeRequestTwo := &ChangeRequest{}
+	if err = randomize.Struct(seed, changeRequestOne, changeRequestDBTypes, false, changeRequestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChangeRequest struct: %s", err)
+	}
+	if err = randomize.Struct(seed, changeRequestTwo, changeRequestDBTypes, false, changeRequestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChangeRequest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = changeRequestOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = changeRequestTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ChangeRequests().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func changeRequestBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *ChangeRequest) error {
+	*o = ChangeRequest{}
+	return nil
+}
+
+func changeRequestAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *ChangeRequest) error {
+	*o = ChangeRequest{}
+	return nil
+}
+
+func changeRequestAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *ChangeRequest) error {
+	*o = ChangeRequest{}
+	return nil
+}
+
+func changeRequestBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ChangeRequest) error {
+	*o = ChangeRequest{}
+	return nil
+}
+
+func changeRequestAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ChangeRequest) error {
+	*o = ChangeRequest{}
+	return nil
+}
+
+func changeRequestBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ChangeRequest) error {
+	*o = ChangeRequest{}
+	return nil
+}
+
+func changeRequestAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ChangeRequest) error {
+	*o = ChangeRequest{}
+	return nil
+}
+
+func changeRequestBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ChangeRequest) error {
+	*o = ChangeRequest{}
+	return nil
+}
+
+func changeRequestAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ChangeRequest) error {
+	*o = ChangeRequest{}
+	return nil
+}
+
+func testChangeRequestsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &ChangeRequest{}
+	o := &ChangeRequest{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, changeRequestDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize ChangeRequest object: %s", err)
+	}
+
+	AddChangeRequestHook(boil.BeforeInsertHook, changeRequestBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	changeRequestBeforeInsertHooks = []ChangeRequestHook{}
+
+	AddChangeRequestHook(boil.AfterInsertHook, changeRequestAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	changeRequestAfterInsertHooks = []ChangeRequestHook{}
+
+	AddChangeRequestHook(boil.AfterSelectHook, changeRequestAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	changeRequestAfterSelectHooks = []ChangeRequestHook{}
+
+	AddChangeRequestHook(boil.BeforeUpdateHook, changeRequestBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	changeRequestBeforeUpdateHooks = []ChangeRequestHook{}
+
+	AddChangeRequestHook(boil.AfterUpdateHook, changeRequestAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	changeRequestAfterUpdateHooks = []ChangeRequestHook{}
+
+	AddChangeRequestHook(boil.BeforeDeleteHook, changeRequestBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	changeRequestBeforeDeleteHooks = []ChangeRequestHook{}
+
+	AddChangeRequestHook(boil.AfterDeleteHook, changeRequestAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	changeRequestAfterDeleteHooks = []ChangeRequestHook{}
+
+	AddChangeRequestHook(boil.BeforeUpsertHook, changeRequestBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	changeRequestBeforeUpsertHooks = []ChangeRequestHook{}
+
+	AddChangeRequestHook(boil.AfterUpsertHook, changeRequestAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	changeRequestAfterUpsertHooks = []ChangeRequestHook{}
+}
+
+func testChangeRequestsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChangeRequest{}
+	if err = randomize.Struct(seed, o, changeRequestDBTypes, true, changeRequestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChangeRequest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ChangeRequests().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testChangeRequestsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChangeRequest{}
+	if err = randomize.Struct(seed, o, changeRequestDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ChangeRequest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(changeRequestColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ChangeRequests().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testChangeRequestsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChangeRequest{}
+	if err = randomize.Struct(seed, o, changeRequestDBTypes, true, changeRequestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChangeRequest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testChangeRequestsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChangeRequest{}
+	if err = randomize.Struct(seed, o, changeRequestDBTypes, true, changeRequestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChangeRequest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ChangeRequestSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testChangeRequestsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChangeRequest{}
+	if err = randomize.Struct(seed, o, changeRequestDBTypes, true, changeRequestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChangeRequest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ChangeRequests().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	changeRequestDBTypes = map[string]string{`ID`: `integer`, `PolicyID`: `integer`, `Subject`: `character varying`, `Route`: `character varying`, `Payload`: `jsonb`, `ChangePercentage`: `double precision`, `Status`: `character varying`, `RequesterID`: `integer`, `RequesterRole`: `character varying`, `ApproverID`: `integer`, `Comment`: `text`, `ErrorMessage`: `text`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`, `DecidedAt`: `timestamp without time zone`}
+	_                    = bytes.MinRead
+)
+
+func testChangeRequestsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(changeRequestPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(changeRequestAllColumns) == len(changeRequestPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChangeRequest{}
+	if err = randomize.Struct(seed, o, changeRequestDBTypes, true, changeRequestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChangeRequest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ChangeRequests().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, changeRequestDBTypes, true, changeRequestPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ChangeRequest struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testChangeRequestsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(changeRequestAllColumns) == len(changeRequestPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ChangeRequest{}
+	if err = randomize.Struct(seed, o, changeRequestDBTypes, true, changeRequestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ChangeRequest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ChangeRequests().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, changeRequestDBTypes, true, changeRequestPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ChangeRequest struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(changeRequestAllColumns, changeRequestPrimaryKeyColumns) {
+		fields = changeRequestAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			changeRequestAllColumns,
+			changeRequestPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ChangeRequestSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testChangeRequestsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(changeRequestAllColumns) == len(changeRequestPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := ChangeRequest{}
+	if err = randomize.Struct(seed, &o, changeRequestDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ChangeRequest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ChangeRequest: %s", err)
+	}
+
+	count, err := ChangeRequests().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, changeRequestDBTypes, false, changeRequestPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ChangeRequest struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ChangeRequest: %s", err)
+	}
+
+	count, err = ChangeRequests().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,974 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// CompassManager is an object representing the database table.
+type CompassManager struct {
+	ID        int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CompassID string    `boil:"compass_id" json:"compass_id" toml:"compass_id" yaml:"compass_id"`
+	UserID    int       `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt null.Time `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *compassManagerR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L compassManagerL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var CompassManagerColumns = struct {
+	ID        string
+	CompassID string
+	UserID    string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "id",
+	CompassID: "compass_id",
+	UserID:    "user_id",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+}
+
+var CompassManagerTableColumns = struct {
+	ID        string
+	CompassID string
+	UserID    string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "compass_manager.id",
+	CompassID: "compass_manager.compass_id",
+	UserID:    "compass_manager.user_id",
+	CreatedAt: "compass_manager.created_at",
+	UpdatedAt: "compass_manager.updated_at",
+}
+
+// Generated where
+
+var CompassManagerWhere = struct {
+	ID        whereHelperint
+	CompassID whereHelperstring
+	UserID    whereHelperint
+	CreatedAt whereHelpertime_Time
+	UpdatedAt whereHelpernull_Time
+}{
+	ID:        whereHelperint{field: "\"compass_manager\".\"id\""},
+	CompassID: whereHelperstring{field: "\"compass_manager\".\"compass_id\""},
+	UserID:    whereHelperint{field: "\"compass_manager\".\"user_id\""},
+	CreatedAt: whereHelpertime_Time{field: "\"compass_manager\".\"created_at\""},
+	UpdatedAt: whereHelpernull_Time{field: "\"compass_manager\".\"updated_at\""},
+}
+
+// CompassManagerRels is where relationship names are stored.
+var CompassManagerRels = struct {
+}{}
+
+// compassManagerR is where relationships are stored.
+type compassManagerR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*compassManagerR) NewStruct() *compassManagerR {
+	return &compassManagerR{}
+}
+
+// compassManagerL is where Load methods for each relationship are stored.
+type compassManagerL struct{}
+
+var (
+	compassManagerAllColumns            = []string{"id", "compass_id", "user_id", "created_at", "updated_at"}
+	compassManagerColumnsWithoutDefault = []string{"compass_id", "user_id", "created_at"}
+	compassManagerColumnsWithDefault    = []string{"id", "updated_at"}
+	compassManagerPrimaryKeyColumns     = []string{"id"}
+	compassManagerGeneratedColumns      = []string{}
+)
+
+type (
+	// CompassManagerSlice is an alias for a slice of pointers to CompassManager.
+	// This should almost always be used instead of []CompassManager.
+	CompassManagerSlice []*CompassManager
+	// CompassManagerHook is the signature for custom CompassManager hook methods
+	CompassManagerHook func(context.Context, boil.ContextExecutor, *CompassManager) error
+
+	compassManagerQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	compassManagerType                 = reflect.TypeOf(&CompassManager{})
+	compassManagerMapping              = queries.MakeStructMapping(compassManagerType)
+	compassManagerPrimaryKeyMapping, _ = queries.BindMapping(compassManagerType, compassManagerMapping, compassManagerPrimaryKeyColumns)
+	compassManagerInsertCacheMut       sync.RWMutex
+	compassManagerInsertCache          = make(map[string]insertCache)
+	compassManagerUpdateCacheMut       sync.RWMutex
+	compassManagerUpdateCache          = make(map[string]updateCache)
+	compassManagerUpsertCacheMut       sync.RWMutex
+	compassManagerUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var compassManagerAfterSelectMu sync.Mutex
+var compassManagerAfterSelectHooks []CompassManagerHook
+
+var compassManagerBeforeInsertMu sync.Mutex
+var compassManagerBeforeInsertHooks []CompassManagerHook
+var compassManagerAfterInsertMu sync.Mutex
+var compassManagerAfterInsertHooks []CompassManagerHook
+
+var compassManagerBeforeUpdateMu sync.Mutex
+var compassManagerBeforeUpdateHooks []CompassManagerHook
+var compassManagerAfterUpdateMu sync.Mutex
+var compassManagerAfterUpdateHooks []CompassManagerHook
+
+var compassManagerBeforeDeleteMu sync.Mutex
+var compassManagerBeforeDeleteHooks []CompassManagerHook
+var compassManagerAfterDeleteMu sync.Mutex
+var compassManagerAfterDeleteHooks []CompassManagerHook
+
+var compassManagerBeforeUpsertMu sync.Mutex
+var compassManagerBeforeUpsertHooks []CompassManagerHook
+var compassManagerAfterUpsertMu sync.Mutex
+var compassManagerAfterUpsertHooks []CompassManagerHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *CompassManager) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range compassManagerAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *CompassManager) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range compassManagerBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *CompassManager) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range compassManagerAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *CompassManager) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range compassManagerBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *CompassManager) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range compassManagerAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *CompassManager) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range compassManagerBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *CompassManager) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range compassManagerAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *CompassManager) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range compassManagerBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *CompassManager) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range compassManagerAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddCompassManagerHook registers your hook function for all future operations.
+func AddCompassManagerHook(hookPoint boil.HookPoint, compassManagerHook CompassManagerHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		compassManagerAfterSelectMu.Lock()
+		compassManagerAfterSelectHooks = append(compassManagerAfterSelectHooks, compassManagerHook)
+		compassManagerAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		compassManagerBeforeInsertMu.Lock()
+		compassManagerBeforeInsertHooks = append(compassManagerBeforeInsertHooks, compassManagerHook)
+		compassManagerBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		compassManagerAfterInsertMu.Lock()
+		compassManagerAfterInsertHooks = append(compassManagerAfterInsertHooks, compassManagerHook)
+		compassManagerAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		compassManagerBeforeUpdateMu.Lock()
+		compassManagerBeforeUpdateHooks = append(compassManagerBeforeUpdateHooks, compassManagerHook)
+		compassManagerBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		compassManagerAfterUpdateMu.Lock()
+		compassManagerAfterUpdateHooks = append(compassManagerAfterUpdateHooks, compassManagerHook)
+		compassManagerAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		compassManagerBeforeDeleteMu.Lock()
+		compassManagerBeforeDeleteHooks = append(compassManagerBeforeDeleteHooks, compassManagerHook)
+		compassManagerBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		compassManagerAfterDeleteMu.Lock()
+		compassManagerAfterDeleteHooks = append(compassManagerAfterDeleteHooks, compassManagerHook)
+		compassManagerAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		compassManagerBeforeUpsertMu.Lock()
+		compassManagerBeforeUpsertHooks = append(compassManagerBeforeUpsertHooks, compassManagerHook)
+		compassManagerBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		compassManagerAfterUpsertMu.Lock()
+		compassManagerAfterUpsertHooks = append(compassManagerAfterUpsertHooks, compassManagerHook)
+		compassManagerAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single compassManager record from the query.
+func (q compassManagerQuery) One(ctx context.Context, exec boil.ContextExecutor) (*CompassManager, error) {
+	o := &CompassManager{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for compass_manager")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all CompassManager records from the query.
+func (q compassManagerQuery) All(ctx context.Context, exec boil.ContextExecutor) (CompassManagerSlice, error) {
+	var o []*CompassManager
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to CompassManager slice")
+	}
+
+	if len(compassManagerAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all CompassManager records in the query.
+func (q compassManagerQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count compass_manager rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q compassManagerQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if compass_manager exists")
+	}
+
+	return count > 0, nil
+}
+
+// CompassManagers retrieves all the records using an executor.
+func CompassManagers(mods ...qm.QueryMod) compassManagerQuery {
+	mods = append(mods, qm.From("\"compass_manager\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"compass_manager\".*"})
+	}
+
+	return compassManagerQuery{q}
+}
+
+// FindCompassManager retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindCompassManager(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*CompassManager, error) {
+	compassManagerObj := &CompassManager{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"compass_manager\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, compassManagerObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from compass_manager")
+	}
+
+	if err = compassManagerObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return compassManagerObj, err
+	}
+
+	return compassManagerObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *CompassManager) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no compass_manager provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(compassManagerColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	compassManagerInsertCacheMut.RLock()
+	cache, cached := compassManagerInsertCache[key]
+	compassManagerInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			compassManagerAllColumns,
+			compassManagerColumnsWithDefault,
+			compassManagerColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(compassManagerType, compassManagerMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(compassManagerType, compassManagerMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"compass_manager\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"compass_manager\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into compass_manager")
+	}
+
+	if !cached {
+		compassManagerInsertCacheMut.Lock()
+		compassManagerInsertCache[key] = cache
+		compassManagerInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the CompassManager.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *CompassManager) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	compassManagerUpdateCacheMut.RLock()
+	cache, cached := compassManagerUpdateCache[key]
+	compassManagerUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			compassManagerAllColumns,
+			compassManagerPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update compass_manager, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"compass_manager\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, compassManagerPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(compassManagerType, compassManagerMapping, append(wl, compassManagerPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update compass_manager row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for compass_manager")
+	}
+
+	if !cached {
+		compassManagerUpdateCacheMut.Lock()
+		compassManagerUpdateCache[key] = cache
+		compassManagerUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q compassManagerQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for compass_manager")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for compass_manager")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o CompassManagerSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), compassManagerPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"compass_manager\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, compassManagerPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in compassManager slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all compassManager")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *CompassManager) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no compass_manager provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(compassManagerColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	compassManagerUpsertCacheMut.RLock()
+	cache, cached := compassManagerUpsertCache[key]
+	compassManagerUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			compassManagerAllColumns,
+			compassManagerColumnsWithDefault,
+			compassManagerColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			compassManagerAllColumns,
+			compassManagerPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert compass_manager, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(compassManagerAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(compassManagerPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert compass_manager, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(compassManagerPrimaryKeyColumns))
+			copy(conflict, compassManagerPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"compass_manager\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(compassManagerType, compassManagerMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(compassManagerType, compassManagerMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert compass_manager")
+	}
+
+	if !cached {
+		compassManagerUpsertCacheMut.Lock()
+		compassManagerUpsertCache[key] = cache
+		compassManagerUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single CompassManager record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *CompassManager) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no CompassManager provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), compassManagerPrimaryKeyMapping)
+	sql := "DELETE FROM \"compass_manager\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from compass_manager")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for compass_manager")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q compassManagerQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no compassManagerQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from compass_manager")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for compass_manager")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o CompassManagerSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(compassManagerBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), compassManagerPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"compass_manager\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, compassManagerPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from compassManager slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for compass_manager")
+	}
+
+	if len(compassManagerAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *CompassManager) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindCompassManager(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *CompassManagerSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := CompassManagerSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), compassManagerPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"compass_manager\".* FROM \"compass_manager\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, compassManagerPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in CompassManagerSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// CompassManagerExists checks if the CompassManager row exists.
+func CompassManagerExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"compass_manager\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if compass_manager exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the CompassManager row exists.
+func (o *CompassManager) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return CompassManagerExists(ctx, exec, o.ID)
+}
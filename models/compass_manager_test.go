@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testCompassManagers(t *testing.T) {
+	t.Parallel()
+
+	query := CompassManagers()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testCompassManagersDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CompassManager{}
+	if err = randomize.Struct(seed, o, compassManagerDBTypes, true, compassManagerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CompassManager struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := CompassManagers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCompassManagersQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CompassManager{}
+	if err = randomize.Struct(seed, o, compassManagerDBTypes, true, compassManagerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CompassManager struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := CompassManagers().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := CompassManagers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCompassManagersSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CompassManager{}
+	if err = randomize.Struct(seed, o, compassManagerDBTypes, true, compassManagerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CompassManager struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := CompassManagerSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := CompassManagers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCompassManagersExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CompassManager{}
+	if err = randomize.Struct(seed, o, compassManagerDBTypes, true, compassManagerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CompassManager struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := CompassManagerExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if CompassManager exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected CompassManagerExists to return true, but got false.")
+	}
+}
+
+func testCompassManagersFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CompassManager{}
+	if err = randomize.Struct(seed, o, compassManagerDBTypes, true, compassManagerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CompassManager struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	compassManagerFound, err := FindCompassManager(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if compassManagerFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testCompassManagersBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CompassManager{}
+	if err = randomize.Struct(seed, o, compassManagerDBTypes, true, compassManagerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CompassManager struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = CompassManagers().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCompassManagersOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CompassManager{}
+	if err = randomize.Struct(seed, o, compassManagerDBTypes, true, compassManagerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CompassManager struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := CompassManagers().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testCompassManagersAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	compassManagerOne := &CompassManager{}
+	compassManagerTwo := &CompassManager{}
+	if err = randomize.Struct(seed, compassManagerOne, compassManagerDBTypes, false, compassManagerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CompassManager struct: %s", err)
+	}
+	if err = randomize.Struct(seed, compassManagerTwo, compassManagerDBTypes, false, compassManagerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CompassManager struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = compassManagerOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = compassManagerTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := CompassManagers().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testCompassManagersCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	compassManagerOne := &CompassManager{}
+	compassManagerTwo := &CompassManager{}
+	if err = randomize.Struct(seed, compassManagerOne, compassManagerDBTypes, false, compassManagerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CompassManager struct: %s", err)
+	}
+	if err = randomize.Struct(seed, compassManagerTwo, compassManagerDBTypes, false, compassManagerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CompassManager struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = compassManagerOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = compassManagerTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CompassManagers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func compassManagerBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *CompassManager) error {
+	*o = CompassManager{}
+	return nil
+}
+
+func compassManagerAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *CompassManager) error {
+	*o = CompassManager{}
+	return nil
+}
+
+func compassManagerAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *CompassManager) error {
+	*o = CompassManager{}
+	return nil
+}
+
+func compassManagerBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *CompassManager) error {
+	*o = CompassManager{}
+	return nil
+}
+
+func compassManagerAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *CompassManager) error {
+	*o = CompassManager{}
+	return nil
+}
+
+func compassManagerBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *CompassManager) error {
+	*o = CompassManager{}
+	return nil
+}
+
+func compassManagerAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *CompassManager) error {
+	*o = CompassManager{}
+	return nil
+}
+
+func compassManagerBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *CompassManager) error {
+	*o = CompassManager{}
+	return nil
+}
+
+func compassManagerAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *CompassManager) error {
+	*o = CompassManager{}
+	return nil
+}
+
+func testCompassManagersHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &CompassManager{}
+	o := &CompassManager{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, compassManagerDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize CompassManager object: %s", err)
+	}
+
+	AddCompassManagerHook(boil.BeforeInsertHook, compassManagerBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	compassManagerBeforeInsertHooks = []CompassManagerHook{}
+
+	AddCompassManagerHook(boil.AfterInsertHook, compassManagerAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	compassManagerAfterInsertHooks = []CompassManagerHook{}
+
+	AddCompassManagerHook(boil.AfterSelectHook, compassManagerAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	compassManagerAfterSelectHooks = []CompassManagerHook{}
+
+	AddCompassManagerHook(boil.BeforeUpdateHook, compassManagerBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	compassManagerBeforeUpdateHooks = []CompassManagerHook{}
+
+	AddCompassManagerHook(boil.AfterUpdateHook, compassManagerAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	compassManagerAfterUpdateHooks = []CompassManagerHook{}
+
+	AddCompassManagerHook(boil.BeforeDeleteHook, compassManagerBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	compassManagerBeforeDeleteHooks = []CompassManagerHook{}
+
+	AddCompassManagerHook(boil.AfterDeleteHook, compassManagerAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	compassManagerAfterDeleteHooks = []CompassManagerHook{}
+
+	AddCompassManagerHook(boil.BeforeUpsertHook, compassManagerBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	compassManagerBeforeUpsertHooks = []CompassManagerHook{}
+
+	AddCompassManagerHook(boil.AfterUpsertHook, compassManagerAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	compassManagerAfterUpsertHooks = []CompassManagerHook{}
+}
+
+func testCompassManagersInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CompassManager{}
+	if err = randomize.Struct(seed, o, compassManagerDBTypes, true, compassManagerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CompassManager struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CompassManagers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testCompassManagersInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CompassManager{}
+	if err = randomize.Struct(seed, o, compassManagerDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize CompassManager struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(compassManagerColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CompassManagers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testCompassManagersReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CompassManager{}
+	if err = randomize.Struct(seed, o, compassManagerDBTypes, true, compassManagerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CompassManager struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCompassManagersReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CompassManager{}
+	if err = randomize.Struct(seed, o, compassManagerDBTypes, true, compassManagerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CompassManager struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := CompassManagerSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCompassManagersSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CompassManager{}
+	if err = randomize.Struct(seed, o, compassManagerDBTypes, true, compassManagerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CompassManager struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := CompassManagers().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	compassManagerDBTypes = map[string]string{`ID`: `integer`, `CompassID`: `character varying`, `UserID`: `integer`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                     = bytes.MinRead
+)
+
+func testCompassManagersUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(compassManagerPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(compassManagerAllColumns) == len(compassManagerPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CompassManager{}
+	if err = randomize.Struct(seed, o, compassManagerDBTypes, true, compassManagerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CompassManager struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CompassManagers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, compassManagerDBTypes, true, compassManagerPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize CompassManager struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testCompassManagersSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(compassManagerAllColumns) == len(compassManagerPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CompassManager{}
+	if err = randomize.Struct(seed, o, compassManagerDBTypes, true, compassManagerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CompassManager struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CompassManagers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, compassManagerDBTypes, true, compassManagerPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize CompassManager struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(compassManagerAllColumns, compassManagerPrimaryKeyColumns) {
+		fields = compassManagerAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			compassManagerAllColumns,
+			compassManagerPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := CompassManagerSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testCompassManagersUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(compassManagerAllColumns) == len(compassManagerPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := CompassManager{}
+	if err = randomize.Struct(seed, &o, compassManagerDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize CompassManager struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert CompassManager: %s", err)
+	}
+
+	count, err := CompassManagers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, compassManagerDBTypes, false, compassManagerPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize CompassManager struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert CompassManager: %s", err)
+	}
+
+	count, err = CompassManagers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
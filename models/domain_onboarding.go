@@ -0,0 +1,1009 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// DomainOnboarding is an object representing the database table.
+type DomainOnboarding struct {
+	ID             int         `boil:"id" json:"id" toml:"id" yaml:"id"`
+	PublisherID    string      `boil:"publisher_id" json:"publisher_id" toml:"publisher_id" yaml:"publisher_id"`
+	Domain         string      `boil:"domain" json:"domain" toml:"domain" yaml:"domain"`
+	Status         string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Checks         null.JSON   `boil:"checks" json:"checks,omitempty" toml:"checks" yaml:"checks,omitempty"`
+	Attempts       int         `boil:"attempts" json:"attempts" toml:"attempts" yaml:"attempts"`
+	NotifiedStatus null.String `boil:"notified_status" json:"notified_status,omitempty" toml:"notified_status" yaml:"notified_status,omitempty"`
+	LastCheckedAt  null.Time   `boil:"last_checked_at" json:"last_checked_at,omitempty" toml:"last_checked_at" yaml:"last_checked_at,omitempty"`
+	CreatedAt      time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt      null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *domainOnboardingR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L domainOnboardingL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DomainOnboardingColumns = struct {
+	ID             string
+	PublisherID    string
+	Domain         string
+	Status         string
+	Checks         string
+	Attempts       string
+	NotifiedStatus string
+	LastCheckedAt  string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	ID:             "id",
+	PublisherID:    "publisher_id",
+	Domain:         "domain",
+	Status:         "status",
+	Checks:         "checks",
+	Attempts:       "attempts",
+	NotifiedStatus: "notified_status",
+	LastCheckedAt:  "last_checked_at",
+	CreatedAt:      "created_at",
+	UpdatedAt:      "updated_at",
+}
+
+var DomainOnboardingTableColumns = struct {
+	ID             string
+	PublisherID    string
+	Domain         string
+	Status         string
+	Checks         string
+	Attempts       string
+	NotifiedStatus string
+	LastCheckedAt  string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	ID:             "domain_onboarding.id",
+	PublisherID:    "domain_onboarding.publisher_id",
+	Domain:         "domain_onboarding.domain",
+	Status:         "domain_onboarding.status",
+	Checks:         "domain_onboarding.checks",
+	Attempts:       "domain_onboarding.attempts",
+	NotifiedStatus: "domain_onboarding.notified_status",
+	LastCheckedAt:  "domain_onboarding.last_checked_at",
+	CreatedAt:      "domain_onboarding.created_at",
+	UpdatedAt:      "domain_onboarding.updated_at",
+}
+
+// Generated where
+
+var DomainOnboardingWhere = struct {
+	ID             whereHelperint
+	PublisherID    whereHelperstring
+	Domain         whereHelperstring
+	Status         whereHelperstring
+	Checks         whereHelpernull_JSON
+	Attempts       whereHelperint
+	NotifiedStatus whereHelpernull_String
+	LastCheckedAt  whereHelpernull_Time
+	CreatedAt      whereHelpertime_Time
+	UpdatedAt      whereHelpernull_Time
+}{
+	ID:             whereHelperint{field: "\"domain_onboarding\".\"id\""},
+	PublisherID:    whereHelperstring{field: "\"domain_onboarding\".\"publisher_id\""},
+	Domain:         whereHelperstring{field: "\"domain_onboarding\".\"domain\""},
+	Status:         whereHelperstring{field: "\"domain_onboarding\".\"status\""},
+	Checks:         whereHelpernull_JSON{field: "\"domain_onboarding\".\"checks\""},
+	Attempts:       whereHelperint{field: "\"domain_onboarding\".\"attempts\""},
+	NotifiedStatus: whereHelpernull_String{field: "\"domain_onboarding\".\"notified_status\""},
+	LastCheckedAt:  whereHelpernull_Time{field: "\"domain_onboarding\".\"last_checked_at\""},
+	CreatedAt:      whereHelpertime_Time{field: "\"domain_onboarding\".\"created_at\""},
+	UpdatedAt:      whereHelpernull_Time{field: "\"domain_onboarding\".\"updated_at\""},
+}
+
+// DomainOnboardingRels is where relationship names are stored.
+var DomainOnboardingRels = struct {
+}{}
+
+// domainOnboardingR is where relationships are stored.
+type domainOnboardingR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*domainOnboardingR) NewStruct() *domainOnboardingR {
+	return &domainOnboardingR{}
+}
+
+// domainOnboardingL is where Load methods for each relationship are stored.
+type domainOnboardingL struct{}
+
+var (
+	domainOnboardingAllColumns            = []string{"id", "publisher_id", "domain", "status", "checks", "attempts", "notified_status", "last_checked_at", "created_at", "updated_at"}
+	domainOnboardingColumnsWithoutDefault = []string{"publisher_id", "domain", "status", "created_at"}
+	domainOnboardingColumnsWithDefault    = []string{"id", "checks", "attempts", "notified_status", "last_checked_at", "updated_at"}
+	domainOnboardingPrimaryKeyColumns     = []string{"id"}
+	domainOnboardingGeneratedColumns      = []string{}
+)
+
+type (
+	// DomainOnboardingSlice is an alias for a slice of pointers to DomainOnboarding.
+	// This should almost always be used instead of []DomainOnboarding.
+	DomainOnboardingSlice []*DomainOnboarding
+	// DomainOnboardingHook is the signature for custom DomainOnboarding hook methods
+	DomainOnboardingHook func(context.Context, boil.ContextExecutor, *DomainOnboarding) error
+
+	domainOnboardingQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	domainOnboardingType                 = reflect.TypeOf(&DomainOnboarding{})
+	domainOnboardingMapping              = queries.MakeStructMapping(domainOnboardingType)
+	domainOnboardingPrimaryKeyMapping, _ = queries.BindMapping(domainOnboardingType, domainOnboardingMapping, domainOnboardingPrimaryKeyColumns)
+	domainOnboardingInsertCacheMut       sync.RWMutex
+	domainOnboardingInsertCache          = make(map[string]insertCache)
+	domainOnboardingUpdateCacheMut       sync.RWMutex
+	domainOnboardingUpdateCache          = make(map[string]updateCache)
+	domainOnboardingUpsertCacheMut       sync.RWMutex
+	domainOnboardingUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var domainOnboardingAfterSelectMu sync.Mutex
+var domainOnboardingAfterSelectHooks []DomainOnboardingHook
+
+var domainOnboardingBeforeInsertMu sync.Mutex
+var domainOnboardingBeforeInsertHooks []DomainOnboardingHook
+var domainOnboardingAfterInsertMu sync.Mutex
+var domainOnboardingAfterInsertHooks []DomainOnboardingHook
+
+var domainOnboardingBeforeUpdateMu sync.Mutex
+var domainOnboardingBeforeUpdateHooks []DomainOnboardingHook
+var domainOnboardingAfterUpdateMu sync.Mutex
+var domainOnboardingAfterUpdateHooks []DomainOnboardingHook
+
+var domainOnboardingBeforeDeleteMu sync.Mutex
+var domainOnboardingBeforeDeleteHooks []DomainOnboardingHook
+var domainOnboardingAfterDeleteMu sync.Mutex
+var domainOnboardingAfterDeleteHooks []DomainOnboardingHook
+
+var domainOnboardingBeforeUpsertMu sync.Mutex
+var domainOnboardingBeforeUpsertHooks []DomainOnboardingHook
+var domainOnboardingAfterUpsertMu sync.Mutex
+var domainOnboardingAfterUpsertHooks []DomainOnboardingHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *DomainOnboarding) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range domainOnboardingAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *DomainOnboarding) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range domainOnboardingBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *DomainOnboarding) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range domainOnboardingAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *DomainOnboarding) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range domainOnboardingBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *DomainOnboarding) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range domainOnboardingAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *DomainOnboarding) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range domainOnboardingBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *DomainOnboarding) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range domainOnboardingAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *DomainOnboarding) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range domainOnboardingBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *DomainOnboarding) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range domainOnboardingAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddDomainOnboardingHook registers your hook function for all future operations.
+func AddDomainOnboardingHook(hookPoint boil.HookPoint, domainOnboardingHook DomainOnboardingHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		domainOnboardingAfterSelectMu.Lock()
+		domainOnboardingAfterSelectHooks = append(domainOnboardingAfterSelectHooks, domainOnboardingHook)
+		domainOnboardingAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		domainOnboardingBeforeInsertMu.Lock()
+		domainOnboardingBeforeInsertHooks = append(domainOnboardingBeforeInsertHooks, domainOnboardingHook)
+		domainOnboardingBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		domainOnboardingAfterInsertMu.Lock()
+		domainOnboardingAfterInsertHooks = append(domainOnboardingAfterInsertHooks, domainOnboardingHook)
+		domainOnboardingAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		domainOnboardingBeforeUpdateMu.Lock()
+		domainOnboardingBeforeUpdateHooks = append(domainOnboardingBeforeUpdateHooks, domainOnboardingHook)
+		domainOnboardingBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		domainOnboardingAfterUpdateMu.Lock()
+		domainOnboardingAfterUpdateHooks = append(domainOnboardingAfterUpdateHooks, domainOnboardingHook)
+		domainOnboardingAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		domainOnboardingBeforeDeleteMu.Lock()
+		domainOnboardingBeforeDeleteHooks = append(domainOnboardingBeforeDeleteHooks, domainOnboardingHook)
+		domainOnboardingBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		domainOnboardingAfterDeleteMu.Lock()
+		domainOnboardingAfterDeleteHooks = append(domainOnboardingAfterDeleteHooks, domainOnboardingHook)
+		domainOnboardingAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		domainOnboardingBeforeUpsertMu.Lock()
+		domainOnboardingBeforeUpsertHooks = append(domainOnboardingBeforeUpsertHooks, domainOnboardingHook)
+		domainOnboardingBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		domainOnboardingAfterUpsertMu.Lock()
+		domainOnboardingAfterUpsertHooks = append(domainOnboardingAfterUpsertHooks, domainOnboardingHook)
+		domainOnboardingAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single domainOnboarding record from the query.
+func (q domainOnboardingQuery) One(ctx context.Context, exec boil.ContextExecutor) (*DomainOnboarding, error) {
+	o := &DomainOnboarding{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for domain_onboarding")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all DomainOnboarding records from the query.
+func (q domainOnboardingQuery) All(ctx context.Context, exec boil.ContextExecutor) (DomainOnboardingSlice, error) {
+	var o []*DomainOnboarding
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to DomainOnboarding slice")
+	}
+
+	if len(domainOnboardingAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all DomainOnboarding records in the query.
+func (q domainOnboardingQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count domain_onboarding rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q domainOnboardingQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if domain_onboarding exists")
+	}
+
+	return count > 0, nil
+}
+
+// DomainOnboardings retrieves all the records using an executor.
+func DomainOnboardings(mods ...qm.QueryMod) domainOnboardingQuery {
+	mods = append(mods, qm.From("\"domain_onboarding\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"domain_onboarding\".*"})
+	}
+
+	return domainOnboardingQuery{q}
+}
+
+// FindDomainOnboarding retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDomainOnboarding(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*DomainOnboarding, error) {
+	domainOnboardingObj := &DomainOnboarding{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"domain_onboarding\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, domainOnboardingObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from domain_onboarding")
+	}
+
+	if err = domainOnboardingObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return domainOnboardingObj, err
+	}
+
+	return domainOnboardingObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *DomainOnboarding) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no domain_onboarding provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(domainOnboardingColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	domainOnboardingInsertCacheMut.RLock()
+	cache, cached := domainOnboardingInsertCache[key]
+	domainOnboardingInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			domainOnboardingAllColumns,
+			domainOnboardingColumnsWithDefault,
+			domainOnboardingColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(domainOnboardingType, domainOnboardingMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(domainOnboardingType, domainOnboardingMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"domain_onboarding\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"domain_onboarding\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into domain_onboarding")
+	}
+
+	if !cached {
+		domainOnboardingInsertCacheMut.Lock()
+		domainOnboardingInsertCache[key] = cache
+		domainOnboardingInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the DomainOnboarding.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *DomainOnboarding) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	domainOnboardingUpdateCacheMut.RLock()
+	cache, cached := domainOnboardingUpdateCache[key]
+	domainOnboardingUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			domainOnboardingAllColumns,
+			domainOnboardingPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update domain_onboarding, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"domain_onboarding\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, domainOnboardingPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(domainOnboardingType, domainOnboardingMapping, append(wl, domainOnboardingPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update domain_onboarding row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for domain_onboarding")
+	}
+
+	if !cached {
+		domainOnboardingUpdateCacheMut.Lock()
+		domainOnboardingUpdateCache[key] = cache
+		domainOnboardingUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q domainOnboardingQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for domain_onboarding")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for domain_onboarding")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DomainOnboardingSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), domainOnboardingPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"domain_onboarding\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, domainOnboardingPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in domainOnboarding slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all domainOnboarding")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *DomainOnboarding) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no domain_onboarding provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(domainOnboardingColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	domainOnboardingUpsertCacheMut.RLock()
+	cache, cached := domainOnboardingUpsertCache[key]
+	domainOnboardingUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			domainOnboardingAllColumns,
+			domainOnboardingColumnsWithDefault,
+			domainOnboardingColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			domainOnboardingAllColumns,
+			domainOnboardingPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert domain_onboarding, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(domainOnboardingAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(domainOnboardingPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert domain_onboarding, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(domainOnboardingPrimaryKeyColumns))
+			copy(conflict, domainOnboardingPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"domain_onboarding\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(domainOnboardingType, domainOnboardingMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(domainOnboardingType, domainOnboardingMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert domain_onboarding")
+	}
+
+	if !cached {
+		domainOnboardingUpsertCacheMut.Lock()
+		domainOnboardingUpsertCache[key] = cache
+		domainOnboardingUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single DomainOnboarding record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *DomainOnboarding) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no DomainOnboarding provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), domainOnboardingPrimaryKeyMapping)
+	sql := "DELETE FROM \"domain_onboarding\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from domain_onboarding")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for domain_onboarding")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q domainOnboardingQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no domainOnboardingQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from domain_onboarding")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for domain_onboarding")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DomainOnboardingSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(domainOnboardingBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), domainOnboardingPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"domain_onboarding\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, domainOnboardingPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from domainOnboarding slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for domain_onboarding")
+	}
+
+	if len(domainOnboardingAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *DomainOnboarding) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDomainOnboarding(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DomainOnboardingSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DomainOnboardingSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), domainOnboardingPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"domain_onboarding\".* FROM \"domain_onboarding\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, domainOnboardingPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in DomainOnboardingSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DomainOnboardingExists checks if the DomainOnboarding row exists.
+func DomainOnboardingExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"domain_onboarding\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if domain_onboarding exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the DomainOnboarding row exists.
+func (o *DomainOnboarding) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return DomainOnboardingExists(ctx, exec, o.ID)
+}
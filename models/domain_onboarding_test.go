@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testDomainOnboardings(t *testing.T) {
+	t.Parallel()
+
+	query := DomainOnboardings()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testDomainOnboardingsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DomainOnboarding{}
+	if err = randomize.Struct(seed, o, domainOnboardingDBTypes, true, domainOnboardingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DomainOnboarding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := DomainOnboardings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testDomainOnboardingsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DomainOnboarding{}
+	if err = randomize.Struct(seed, o, domainOnboardingDBTypes, true, domainOnboardingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DomainOnboarding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := DomainOnboardings().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := DomainOnboardings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testDomainOnboardingsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DomainOnboarding{}
+	if err = randomize.Struct(seed, o, domainOnboardingDBTypes, true, domainOnboardingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DomainOnboarding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := DomainOnboardingSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := DomainOnboardings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testDomainOnboardingsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DomainOnboarding{}
+	if err = randomize.Struct(seed, o, domainOnboardingDBTypes, true, domainOnboardingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DomainOnboarding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := DomainOnboardingExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if DomainOnboarding exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected DomainOnboardingExists to return true, but got false.")
+	}
+}
+
+func testDomainOnboardingsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DomainOnboarding{}
+	if err = randomize.Struct(seed, o, domainOnboardingDBTypes, true, domainOnboardingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DomainOnboarding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	domainOnboardingFound, err := FindDomainOnboarding(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if domainOnboardingFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testDomainOnboardingsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DomainOnboarding{}
+	if err = randomize.Struct(seed, o, domainOnboardingDBTypes, true, domainOnboardingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DomainOnboarding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = DomainOnboardings().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testDomainOnboardingsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DomainOnboarding{}
+	if err = randomize.Struct(seed, o, domainOnboardingDBTypes, true, domainOnboardingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DomainOnboarding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := DomainOnboardings().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testDomainOnboardingsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	domainOnboardingOne := &DomainOnboarding{}
+	domainOnboardingTwo := &DomainOnboarding{}
+	if err = randomize.Struct(seed, domainOnboardingOne, domainOnboardingDBTypes, false, domainOnboardingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DomainOnboarding struct: %s", err)
+	}
+	if err = randomize.Struct(seed, domainOnboardingTwo, domainOnboardingDBTypes, false, domainOnboardingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DomainOnboarding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = domainOnboardingOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = domainOnboardingTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := DomainOnboardings().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testDomainOnboardingsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	domainOnboardingOne := &DomainOnboarding{}
+	domainOnboardingTwo := &DomainOnboarding{}
+	if err = randomize.Struct(seed, domainOnboardingOne, domainOnboardingDBTypes, false, domainOnboardingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DomainOnboarding struct: %s", err)
+	}
+	if err = randomize.Struct(seed, domainOnboardingTwo, domainOnboardingDBTypes, false, domainOnboardingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DomainOnboarding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = domainOnboardingOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = domainOnboardingTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DomainOnboardings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func domainOnboardingBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *DomainOnboarding) error {
+	*o = DomainOnboarding{}
+	return nil
+}
+
+func domainOnboardingAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *DomainOnboarding) error {
+	*o = DomainOnboarding{}
+	return nil
+}
+
+func domainOnboardingAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *DomainOnboarding) error {
+	*o = DomainOnboarding{}
+	return nil
+}
+
+func domainOnboardingBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *DomainOnboarding) error {
+	*o = DomainOnboarding{}
+	return nil
+}
+
+func domainOnboardingAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *DomainOnboarding) error {
+	*o = DomainOnboarding{}
+	return nil
+}
+
+func domainOnboardingBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *DomainOnboarding) error {
+	*o = DomainOnboarding{}
+	return nil
+}
+
+func domainOnboardingAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *DomainOnboarding) error {
+	*o = DomainOnboarding{}
+	return nil
+}
+
+func domainOnboardingBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *DomainOnboarding) error {
+	*o = DomainOnboarding{}
+	return nil
+}
+
+func domainOnboardingAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *DomainOnboarding) error {
+	*o = DomainOnboarding{}
+	return nil
+}
+
+func testDomainOnboardingsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &DomainOnboarding{}
+	o := &DomainOnboarding{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, domainOnboardingDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize DomainOnboarding object: %s", err)
+	}
+
+	AddDomainOnboardingHook(boil.BeforeInsertHook, domainOnboardingBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	domainOnboardingBeforeInsertHooks = []DomainOnboardingHook{}
+
+	AddDomainOnboardingHook(boil.AfterInsertHook, domainOnboardingAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	domainOnboardingAfterInsertHooks = []DomainOnboardingHook{}
+
+	AddDomainOnboardingHook(boil.AfterSelectHook, domainOnboardingAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	domainOnboardingAfterSelectHooks = []DomainOnboardingHook{}
+
+	AddDomainOnboardingHook(boil.BeforeUpdateHook, domainOnboardingBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	domainOnboardingBeforeUpdateHooks = []DomainOnboardingHook{}
+
+	AddDomainOnboardingHook(boil.AfterUpdateHook, domainOnboardingAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	domainOnboardingAfterUpdateHooks = []DomainOnboardingHook{}
+
+	AddDomainOnboardingHook(boil.BeforeDeleteHook, domainOnboardingBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	domainOnboardingBeforeDeleteHooks = []DomainOnboardingHook{}
+
+	AddDomainOnboardingHook(boil.AfterDeleteHook, domainOnboardingAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	domainOnboardingAfterDeleteHooks = []DomainOnboardingHook{}
+
+	AddDomainOnboardingHook(boil.BeforeUpsertHook, domainOnboardingBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	domainOnboardingBeforeUpsertHooks = []DomainOnboardingHook{}
+
+	AddDomainOnboardingHook(boil.AfterUpsertHook, domainOnboardingAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	domainOnboardingAfterUpsertHooks = []DomainOnboardingHook{}
+}
+
+func testDomainOnboardingsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DomainOnboarding{}
+	if err = randomize.Struct(seed, o, domainOnboardingDBTypes, true, domainOnboardingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DomainOnboarding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DomainOnboardings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testDomainOnboardingsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DomainOnboarding{}
+	if err = randomize.Struct(seed, o, domainOnboardingDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize DomainOnboarding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(domainOnboardingColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DomainOnboardings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testDomainOnboardingsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DomainOnboarding{}
+	if err = randomize.Struct(seed, o, domainOnboardingDBTypes, true, domainOnboardingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DomainOnboarding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testDomainOnboardingsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DomainOnboarding{}
+	if err = randomize.Struct(seed, o, domainOnboardingDBTypes, true, domainOnboardingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DomainOnboarding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := DomainOnboardingSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testDomainOnboardingsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DomainOnboarding{}
+	if err = randomize.Struct(seed, o, domainOnboardingDBTypes, true, domainOnboardingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DomainOnboarding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := DomainOnboardings().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	domainOnboardingDBTypes = map[string]string{`ID`: `integer`, `PublisherID`: `character varying`, `Domain`: `character varying`, `Status`: `character varying`, `Checks`: `jsonb`, `Attempts`: `integer`, `NotifiedStatus`: `character varying`, `LastCheckedAt`: `timestamp without time zone`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                       = bytes.MinRead
+)
+
+func testDomainOnboardingsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(domainOnboardingPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(domainOnboardingAllColumns) == len(domainOnboardingPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DomainOnboarding{}
+	if err = randomize.Struct(seed, o, domainOnboardingDBTypes, true, domainOnboardingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DomainOnboarding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DomainOnboardings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, domainOnboardingDBTypes, true, domainOnboardingPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize DomainOnboarding struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testDomainOnboardingsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(domainOnboardingAllColumns) == len(domainOnboardingPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DomainOnboarding{}
+	if err = randomize.Struct(seed, o, domainOnboardingDBTypes, true, domainOnboardingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DomainOnboarding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DomainOnboardings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, domainOnboardingDBTypes, true, domainOnboardingPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize DomainOnboarding struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(domainOnboardingAllColumns, domainOnboardingPrimaryKeyColumns) {
+		fields = domainOnboardingAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			domainOnboardingAllColumns,
+			domainOnboardingPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := DomainOnboardingSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testDomainOnboardingsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(domainOnboardingAllColumns) == len(domainOnboardingPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := DomainOnboarding{}
+	if err = randomize.Struct(seed, &o, domainOnboardingDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize DomainOnboarding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert DomainOnboarding: %s", err)
+	}
+
+	count, err := DomainOnboardings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, domainOnboardingDBTypes, false, domainOnboardingPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize DomainOnboarding struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert DomainOnboarding: %s", err)
+	}
+
+	count, err = DomainOnboardings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
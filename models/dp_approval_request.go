@@ -0,0 +1,1030 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// DPApprovalRequest is an object representing the database table.
+type DPApprovalRequest struct {
+	ID              int         `boil:"id" json:"id" toml:"id" yaml:"id"`
+	DemandPartnerID string      `boil:"demand_partner_id" json:"demand_partner_id" toml:"demand_partner_id" yaml:"demand_partner_id"`
+	PublisherID     string      `boil:"publisher_id" json:"publisher_id" toml:"publisher_id" yaml:"publisher_id"`
+	Domain          string      `boil:"domain" json:"domain" toml:"domain" yaml:"domain"`
+	Status          string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	SentAt          null.Time   `boil:"sent_at" json:"sent_at,omitempty" toml:"sent_at" yaml:"sent_at,omitempty"`
+	Reminders       int         `boil:"reminders" json:"reminders" toml:"reminders" yaml:"reminders"`
+	LastRemindedAt  null.Time   `boil:"last_reminded_at" json:"last_reminded_at,omitempty" toml:"last_reminded_at" yaml:"last_reminded_at,omitempty"`
+	DecidedAt       null.Time   `boil:"decided_at" json:"decided_at,omitempty" toml:"decided_at" yaml:"decided_at,omitempty"`
+	DecidedBy       null.Int    `boil:"decided_by" json:"decided_by,omitempty" toml:"decided_by" yaml:"decided_by,omitempty"`
+	Comment         null.String `boil:"comment" json:"comment,omitempty" toml:"comment" yaml:"comment,omitempty"`
+	CreatedAt       time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt       null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *dPApprovalRequestR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L dPApprovalRequestL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DPApprovalRequestColumns = struct {
+	ID              string
+	DemandPartnerID string
+	PublisherID     string
+	Domain          string
+	Status          string
+	SentAt          string
+	Reminders       string
+	LastRemindedAt  string
+	DecidedAt       string
+	DecidedBy       string
+	Comment         string
+	CreatedAt       string
+	UpdatedAt       string
+}{
+	ID:              "id",
+	DemandPartnerID: "demand_partner_id",
+	PublisherID:     "publisher_id",
+	Domain:          "domain",
+	Status:          "status",
+	SentAt:          "sent_at",
+	Reminders:       "reminders",
+	LastRemindedAt:  "last_reminded_at",
+	DecidedAt:       "decided_at",
+	DecidedBy:       "decided_by",
+	Comment:         "comment",
+	CreatedAt:       "created_at",
+	UpdatedAt:       "updated_at",
+}
+
+var DPApprovalRequestTableColumns = struct {
+	ID              string
+	DemandPartnerID string
+	PublisherID     string
+	Domain          string
+	Status          string
+	SentAt          string
+	Reminders       string
+	LastRemindedAt  string
+	DecidedAt       string
+	DecidedBy       string
+	Comment         string
+	CreatedAt       string
+	UpdatedAt       string
+}{
+	ID:              "dp_approval_request.id",
+	DemandPartnerID: "dp_approval_request.demand_partner_id",
+	PublisherID:     "dp_approval_request.publisher_id",
+	Domain:          "dp_approval_request.domain",
+	Status:          "dp_approval_request.status",
+	SentAt:          "dp_approval_request.sent_at",
+	Reminders:       "dp_approval_request.reminders",
+	LastRemindedAt:  "dp_approval_request.last_reminded_at",
+	DecidedAt:       "dp_approval_request.decided_at",
+	DecidedBy:       "dp_approval_request.decided_by",
+	Comment:         "dp_approval_request.comment",
+	CreatedAt:       "dp_approval_request.created_at",
+	UpdatedAt:       "dp_approval_request.updated_at",
+}
+
+// Generated where
+
+var DPApprovalRequestWhere = struct {
+	ID              whereHelperint
+	DemandPartnerID whereHelperstring
+	PublisherID     whereHelperstring
+	Domain          whereHelperstring
+	Status          whereHelperstring
+	SentAt          whereHelpernull_Time
+	Reminders       whereHelperint
+	LastRemindedAt  whereHelpernull_Time
+	DecidedAt       whereHelpernull_Time
+	DecidedBy       whereHelpernull_Int
+	Comment         whereHelpernull_String
+	CreatedAt       whereHelpertime_Time
+	UpdatedAt       whereHelpernull_Time
+}{
+	ID:              whereHelperint{field: "\"dp_approval_request\".\"id\""},
+	DemandPartnerID: whereHelperstring{field: "\"dp_approval_request\".\"demand_partner_id\""},
+	PublisherID:     whereHelperstring{field: "\"dp_approval_request\".\"publisher_id\""},
+	Domain:          whereHelperstring{field: "\"dp_approval_request\".\"domain\""},
+	Status:          whereHelperstring{field: "\"dp_approval_request\".\"status\""},
+	SentAt:          whereHelpernull_Time{field: "\"dp_approval_request\".\"sent_at\""},
+	Reminders:       whereHelperint{field: "\"dp_approval_request\".\"reminders\""},
+	LastRemindedAt:  whereHelpernull_Time{field: "\"dp_approval_request\".\"last_reminded_at\""},
+	DecidedAt:       whereHelpernull_Time{field: "\"dp_approval_request\".\"decided_at\""},
+	DecidedBy:       whereHelpernull_Int{field: "\"dp_approval_request\".\"decided_by\""},
+	Comment:         whereHelpernull_String{field: "\"dp_approval_request\".\"comment\""},
+	CreatedAt:       whereHelpertime_Time{field: "\"dp_approval_request\".\"created_at\""},
+	UpdatedAt:       whereHelpernull_Time{field: "\"dp_approval_request\".\"updated_at\""},
+}
+
+// DPApprovalRequestRels is where relationship names are stored.
+var DPApprovalRequestRels = struct {
+}{}
+
+// dPApprovalRequestR is where relationships are stored.
+type dPApprovalRequestR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*dPApprovalRequestR) NewStruct() *dPApprovalRequestR {
+	return &dPApprovalRequestR{}
+}
+
+// dPApprovalRequestL is where Load methods for each relationship are stored.
+type dPApprovalRequestL struct{}
+
+var (
+	dPApprovalRequestAllColumns            = []string{"id", "demand_partner_id", "publisher_id", "domain", "status", "sent_at", "reminders", "last_reminded_at", "decided_at", "decided_by", "comment", "created_at", "updated_at"}
+	dPApprovalRequestColumnsWithoutDefault = []string{"demand_partner_id", "publisher_id", "domain", "created_at"}
+	dPApprovalRequestColumnsWithDefault    = []string{"id", "status", "sent_at", "reminders", "last_reminded_at", "decided_at", "decided_by", "comment", "updated_at"}
+	dPApprovalRequestPrimaryKeyColumns     = []string{"id"}
+	dPApprovalRequestGeneratedColumns      = []string{}
+)
+
+type (
+	// DPApprovalRequestSlice is an alias for a slice of pointers to DPApprovalRequest.
+	// This should almost always be used instead of []DPApprovalRequest.
+	DPApprovalRequestSlice []*DPApprovalRequest
+	// DPApprovalRequestHook is the signature for custom DPApprovalRequest hook methods
+	DPApprovalRequestHook func(context.Context, boil.ContextExecutor, *DPApprovalRequest) error
+
+	dPApprovalRequestQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	dPApprovalRequestType                 = reflect.TypeOf(&DPApprovalRequest{})
+	dPApprovalRequestMapping              = queries.MakeStructMapping(dPApprovalRequestType)
+	dPApprovalRequestPrimaryKeyMapping, _ = queries.BindMapping(dPApprovalRequestType, dPApprovalRequestMapping, dPApprovalRequestPrimaryKeyColumns)
+	dPApprovalRequestInsertCacheMut       sync.RWMutex
+	dPApprovalRequestInsertCache          = make(map[string]insertCache)
+	dPApprovalRequestUpdateCacheMut       sync.RWMutex
+	dPApprovalRequestUpdateCache          = make(map[string]updateCache)
+	dPApprovalRequestUpsertCacheMut       sync.RWMutex
+	dPApprovalRequestUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var dPApprovalRequestAfterSelectMu sync.Mutex
+var dPApprovalRequestAfterSelectHooks []DPApprovalRequestHook
+
+var dPApprovalRequestBeforeInsertMu sync.Mutex
+var dPApprovalRequestBeforeInsertHooks []DPApprovalRequestHook
+var dPApprovalRequestAfterInsertMu sync.Mutex
+var dPApprovalRequestAfterInsertHooks []DPApprovalRequestHook
+
+var dPApprovalRequestBeforeUpdateMu sync.Mutex
+var dPApprovalRequestBeforeUpdateHooks []DPApprovalRequestHook
+var dPApprovalRequestAfterUpdateMu sync.Mutex
+var dPApprovalRequestAfterUpdateHooks []DPApprovalRequestHook
+
+var dPApprovalRequestBeforeDeleteMu sync.Mutex
+var dPApprovalRequestBeforeDeleteHooks []DPApprovalRequestHook
+var dPApprovalRequestAfterDeleteMu sync.Mutex
+var dPApprovalRequestAfterDeleteHooks []DPApprovalRequestHook
+
+var dPApprovalRequestBeforeUpsertMu sync.Mutex
+var dPApprovalRequestBeforeUpsertHooks []DPApprovalRequestHook
+var dPApprovalRequestAfterUpsertMu sync.Mutex
+var dPApprovalRequestAfterUpsertHooks []DPApprovalRequestHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *DPApprovalRequest) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dPApprovalRequestAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *DPApprovalRequest) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dPApprovalRequestBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *DPApprovalRequest) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dPApprovalRequestAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *DPApprovalRequest) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dPApprovalRequestBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *DPApprovalRequest) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dPApprovalRequestAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *DPApprovalRequest) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dPApprovalRequestBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *DPApprovalRequest) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dPApprovalRequestAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *DPApprovalRequest) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dPApprovalRequestBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *DPApprovalRequest) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dPApprovalRequestAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddDPApprovalRequestHook registers your hook function for all future operations.
+func AddDPApprovalRequestHook(hookPoint boil.HookPoint, dPApprovalRequestHook DPApprovalRequestHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		dPApprovalRequestAfterSelectMu.Lock()
+		dPApprovalRequestAfterSelectHooks = append(dPApprovalRequestAfterSelectHooks, dPApprovalRequestHook)
+		dPApprovalRequestAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		dPApprovalRequestBeforeInsertMu.Lock()
+		dPApprovalRequestBeforeInsertHooks = append(dPApprovalRequestBeforeInsertHooks, dPApprovalRequestHook)
+		dPApprovalRequestBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		dPApprovalRequestAfterInsertMu.Lock()
+		dPApprovalRequestAfterInsertHooks = append(dPApprovalRequestAfterInsertHooks, dPApprovalRequestHook)
+		dPApprovalRequestAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		dPApprovalRequestBeforeUpdateMu.Lock()
+		dPApprovalRequestBeforeUpdateHooks = append(dPApprovalRequestBeforeUpdateHooks, dPApprovalRequestHook)
+		dPApprovalRequestBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		dPApprovalRequestAfterUpdateMu.Lock()
+		dPApprovalRequestAfterUpdateHooks = append(dPApprovalRequestAfterUpdateHooks, dPApprovalRequestHook)
+		dPApprovalRequestAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		dPApprovalRequestBeforeDeleteMu.Lock()
+		dPApprovalRequestBeforeDeleteHooks = append(dPApprovalRequestBeforeDeleteHooks, dPApprovalRequestHook)
+		dPApprovalRequestBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		dPApprovalRequestAfterDeleteMu.Lock()
+		dPApprovalRequestAfterDeleteHooks = append(dPApprovalRequestAfterDeleteHooks, dPApprovalRequestHook)
+		dPApprovalRequestAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		dPApprovalRequestBeforeUpsertMu.Lock()
+		dPApprovalRequestBeforeUpsertHooks = append(dPApprovalRequestBeforeUpsertHooks, dPApprovalRequestHook)
+		dPApprovalRequestBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		dPApprovalRequestAfterUpsertMu.Lock()
+		dPApprovalRequestAfterUpsertHooks = append(dPApprovalRequestAfterUpsertHooks, dPApprovalRequestHook)
+		dPApprovalRequestAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single dPApprovalRequest record from the query.
+func (q dPApprovalRequestQuery) One(ctx context.Context, exec boil.ContextExecutor) (*DPApprovalRequest, error) {
+	o := &DPApprovalRequest{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for dp_approval_request")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all DPApprovalRequest records from the query.
+func (q dPApprovalRequestQuery) All(ctx context.Context, exec boil.ContextExecutor) (DPApprovalRequestSlice, error) {
+	var o []*DPApprovalRequest
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to DPApprovalRequest slice")
+	}
+
+	if len(dPApprovalRequestAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all DPApprovalRequest records in the query.
+func (q dPApprovalRequestQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count dp_approval_request rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q dPApprovalRequestQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if dp_approval_request exists")
+	}
+
+	return count > 0, nil
+}
+
+// DPApprovalRequests retrieves all the records using an executor.
+func DPApprovalRequests(mods ...qm.QueryMod) dPApprovalRequestQuery {
+	mods = append(mods, qm.From("\"dp_approval_request\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"dp_approval_request\".*"})
+	}
+
+	return dPApprovalRequestQuery{q}
+}
+
+// FindDPApprovalRequest retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDPApprovalRequest(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*DPApprovalRequest, error) {
+	dPApprovalRequestObj := &DPApprovalRequest{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"dp_approval_request\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, dPApprovalRequestObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from dp_approval_request")
+	}
+
+	if err = dPApprovalRequestObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return dPApprovalRequestObj, err
+	}
+
+	return dPApprovalRequestObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *DPApprovalRequest) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no dp_approval_request provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dPApprovalRequestColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	dPApprovalRequestInsertCacheMut.RLock()
+	cache, cached := dPApprovalRequestInsertCache[key]
+	dPApprovalRequestInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			dPApprovalRequestAllColumns,
+			dPApprovalRequestColumnsWithDefault,
+			dPApprovalRequestColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(dPApprovalRequestType, dPApprovalRequestMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(dPApprovalRequestType, dPApprovalRequestMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"dp_approval_request\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"dp_approval_request\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into dp_approval_request")
+	}
+
+	if !cached {
+		dPApprovalRequestInsertCacheMut.Lock()
+		dPApprovalRequestInsertCache[key] = cache
+		dPApprovalRequestInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the DPApprovalRequest.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *DPApprovalRequest) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	dPApprovalRequestUpdateCacheMut.RLock()
+	cache, cached := dPApprovalRequestUpdateCache[key]
+	dPApprovalRequestUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			dPApprovalRequestAllColumns,
+			dPApprovalRequestPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update dp_approval_request, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"dp_approval_request\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, dPApprovalRequestPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(dPApprovalRequestType, dPApprovalRequestMapping, append(wl, dPApprovalRequestPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update dp_approval_request row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for dp_approval_request")
+	}
+
+	if !cached {
+		dPApprovalRequestUpdateCacheMut.Lock()
+		dPApprovalRequestUpdateCache[key] = cache
+		dPApprovalRequestUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q dPApprovalRequestQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for dp_approval_request")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for dp_approval_request")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DPApprovalRequestSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dPApprovalRequestPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"dp_approval_request\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, dPApprovalRequestPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in dPApprovalRequest slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all dPApprovalRequest")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *DPApprovalRequest) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no dp_approval_request provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dPApprovalRequestColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	dPApprovalRequestUpsertCacheMut.RLock()
+	cache, cached := dPApprovalRequestUpsertCache[key]
+	dPApprovalRequestUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			dPApprovalRequestAllColumns,
+			dPApprovalRequestColumnsWithDefault,
+			dPApprovalRequestColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			dPApprovalRequestAllColumns,
+			dPApprovalRequestPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert dp_approval_request, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(dPApprovalRequestAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(dPApprovalRequestPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert dp_approval_request, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(dPApprovalRequestPrimaryKeyColumns))
+			copy(conflict, dPApprovalRequestPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"dp_approval_request\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(dPApprovalRequestType, dPApprovalRequestMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(dPApprovalRequestType, dPApprovalRequestMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert dp_approval_request")
+	}
+
+	if !cached {
+		dPApprovalRequestUpsertCacheMut.Lock()
+		dPApprovalRequestUpsertCache[key] = cache
+		dPApprovalRequestUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single DPApprovalRequest record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *DPApprovalRequest) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no DPApprovalRequest provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), dPApprovalRequestPrimaryKeyMapping)
+	sql := "DELETE FROM \"dp_approval_request\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from dp_approval_request")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for dp_approval_request")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q dPApprovalRequestQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no dPApprovalRequestQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from dp_approval_request")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for dp_approval_request")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DPApprovalRequestSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(dPApprovalRequestBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dPApprovalRequestPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"dp_approval_request\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, dPApprovalRequestPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from dPApprovalRequest slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for dp_approval_request")
+	}
+
+	if len(dPApprovalRequestAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *DPApprovalRequest) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDPApprovalRequest(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DPApprovalRequestSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DPApprovalRequestSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dPApprovalRequestPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"dp_approval_request\".* FROM \"dp_approval_request\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, dPApprovalRequestPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in DPApprovalRequestSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DPApprovalRequestExists checks if the DPApprovalRequest row exists.
+func DPApprovalRequestExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"dp_approval_request\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if dp_approval_request exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the DPApprovalRequest row exists.
+func (o *DPApprovalRequest) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return DPApprovalRequestExists(ctx, exec, o.ID)
+}
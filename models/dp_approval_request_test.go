@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testDPApprovalRequests(t *testing.T) {
+	t.Parallel()
+
+	query := DPApprovalRequests()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testDPApprovalRequestsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPApprovalRequest{}
+	if err = randomize.Struct(seed, o, dPApprovalRequestDBTypes, true, dPApprovalRequestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPApprovalRequest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := DPApprovalRequests().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testDPApprovalRequestsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPApprovalRequest{}
+	if err = randomize.Struct(seed, o, dPApprovalRequestDBTypes, true, dPApprovalRequestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPApprovalRequest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := DPApprovalRequests().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := DPApprovalRequests().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testDPApprovalRequestsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPApprovalRequest{}
+	if err = randomize.Struct(seed, o, dPApprovalRequestDBTypes, true, dPApprovalRequestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPApprovalRequest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := DPApprovalRequestSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := DPApprovalRequests().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testDPApprovalRequestsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPApprovalRequest{}
+	if err = randomize.Struct(seed, o, dPApprovalRequestDBTypes, true, dPApprovalRequestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPApprovalRequest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := DPApprovalRequestExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if DPApprovalRequest exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected DPApprovalRequestExists to return true, but got false.")
+	}
+}
+
+func testDPApprovalRequestsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPApprovalRequest{}
+	if err = randomize.Struct(seed, o, dPApprovalRequestDBTypes, true, dPApprovalRequestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPApprovalRequest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	dPApprovalRequestFound, err := FindDPApprovalRequest(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if dPApprovalRequestFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testDPApprovalRequestsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPApprovalRequest{}
+	if err = randomize.Struct(seed, o, dPApprovalRequestDBTypes, true, dPApprovalRequestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPApprovalRequest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = DPApprovalRequests().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testDPApprovalRequestsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPApprovalRequest{}
+	if err = randomize.Struct(seed, o, dPApprovalRequestDBTypes, true, dPApprovalRequestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPApprovalRequest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := DPApprovalRequests().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testDPApprovalRequestsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	dPApprovalRequestOne := &DPApprovalRequest{}
+	dPApprovalRequestTwo := &DPApprovalRequest{}
+	if err = randomize.Struct(seed, dPApprovalRequestOne, dPApprovalRequestDBTypes, false, dPApprovalRequestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPApprovalRequest struct: %s", err)
+	}
+	if err = randomize.Struct(seed, dPApprovalRequestTwo, dPApprovalRequestDBTypes, false, dPApprovalRequestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPApprovalRequest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = dPApprovalRequestOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = dPApprovalRequestTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := DPApprovalRequests().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testDPApprovalRequestsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	dPApprovalRequestOne := &DPApprovalRequest{}
+	dPApprovalRequestTwo := &DPApprovalRequest{}
+	if err = randomize.Struct(seed, dPApprovalRequestOne, dPApprovalRequestDBTypes, false, dPApprovalRequestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPApprovalRequest struct: %s", err)
+	}
+	if err = randomize.Struct(seed, dPApprovalRequestTwo, dPApprovalRequestDBTypes, false, dPApprovalRequestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPApprovalRequest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = dPApprovalRequestOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = dPApprovalRequestTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DPApprovalRequests().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func dPApprovalRequestBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *DPApprovalRequest) error {
+	*o = DPApprovalRequest{}
+	return nil
+}
+
+func dPApprovalRequestAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *DPApprovalRequest) error {
+	*o = DPApprovalRequest{}
+	return nil
+}
+
+func dPApprovalRequestAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *DPApprovalRequest) error {
+	*o = DPApprovalRequest{}
+	return nil
+}
+
+func dPApprovalRequestBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *DPApprovalRequest) error {
+	*o = DPApprovalRequest{}
+	return nil
+}
+
+func dPApprovalRequestAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *DPApprovalRequest) error {
+	*o = DPApprovalRequest{}
+	return nil
+}
+
+func dPApprovalRequestBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *DPApprovalRequest) error {
+	*o = DPApprovalRequest{}
+	return nil
+}
+
+func dPApprovalRequestAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *DPApprovalRequest) error {
+	*o = DPApprovalRequest{}
+	return nil
+}
+
+func dPApprovalRequestBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *DPApprovalRequest) error {
+	*o = DPApprovalRequest{}
+	return nil
+}
+
+func dPApprovalRequestAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *DPApprovalRequest) error {
+	*o = DPApprovalRequest{}
+	return nil
+}
+
+func testDPApprovalRequestsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &DPApprovalRequest{}
+	o := &DPApprovalRequest{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, dPApprovalRequestDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize DPApprovalRequest object: %s", err)
+	}
+
+	AddDPApprovalRequestHook(boil.BeforeInsertHook, dPApprovalRequestBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	dPApprovalRequestBeforeInsertHooks = []DPApprovalRequestHook{}
+
+	AddDPApprovalRequestHook(boil.AfterInsertHook, dPApprovalRequestAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	dPApprovalRequestAfterInsertHooks = []DPApprovalRequestHook{}
+
+	AddDPApprovalRequestHook(boil.AfterSelectHook, dPApprovalRequestAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	dPApprovalRequestAfterSelectHooks = []DPApprovalRequestHook{}
+
+	AddDPApprovalRequestHook(boil.BeforeUpdateHook, dPApprovalRequestBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	dPApprovalRequestBeforeUpdateHooks = []DPApprovalRequestHook{}
+
+	AddDPApprovalRequestHook(boil.AfterUpdateHook, dPApprovalRequestAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	dPApprovalRequestAfterUpdateHooks = []DPApprovalRequestHook{}
+
+	AddDPApprovalRequestHook(boil.BeforeDeleteHook, dPApprovalRequestBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	dPApprovalRequestBeforeDeleteHooks = []DPApprovalRequestHook{}
+
+	AddDPApprovalRequestHook(boil.AfterDeleteHook, dPApprovalRequestAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	dPApprovalRequestAfterDeleteHooks = []DPApprovalRequestHook{}
+
+	AddDPApprovalRequestHook(boil.BeforeUpsertHook, dPApprovalRequestBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	dPApprovalRequestBeforeUpsertHooks = []DPApprovalRequestHook{}
+
+	AddDPApprovalRequestHook(boil.AfterUpsertHook, dPApprovalRequestAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	dPApprovalRequestAfterUpsertHooks = []DPApprovalRequestHook{}
+}
+
+func testDPApprovalRequestsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPApprovalRequest{}
+	if err = randomize.Struct(seed, o, dPApprovalRequestDBTypes, true, dPApprovalRequestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPApprovalRequest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DPApprovalRequests().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testDPApprovalRequestsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPApprovalRequest{}
+	if err = randomize.Struct(seed, o, dPApprovalRequestDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize DPApprovalRequest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(dPApprovalRequestColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DPApprovalRequests().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testDPApprovalRequestsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPApprovalRequest{}
+	if err = randomize.Struct(seed, o, dPApprovalRequestDBTypes, true, dPApprovalRequestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPApprovalRequest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testDPApprovalRequestsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPApprovalRequest{}
+	if err = randomize.Struct(seed, o, dPApprovalRequestDBTypes, true, dPApprovalRequestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPApprovalRequest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := DPApprovalRequestSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testDPApprovalRequestsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPApprovalRequest{}
+	if err = randomize.Struct(seed, o, dPApprovalRequestDBTypes, true, dPApprovalRequestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPApprovalRequest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := DPApprovalRequests().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	dPApprovalRequestDBTypes = map[string]string{`ID`: `integer`, `DemandPartnerID`: `character varying`, `PublisherID`: `character varying`, `Domain`: `character varying`, `Status`: `character varying`, `SentAt`: `timestamp without time zone`, `Reminders`: `integer`, `LastRemindedAt`: `timestamp without time zone`, `DecidedAt`: `timestamp without time zone`, `DecidedBy`: `integer`, `Comment`: `text`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                        = bytes.MinRead
+)
+
+func testDPApprovalRequestsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(dPApprovalRequestPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(dPApprovalRequestAllColumns) == len(dPApprovalRequestPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPApprovalRequest{}
+	if err = randomize.Struct(seed, o, dPApprovalRequestDBTypes, true, dPApprovalRequestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPApprovalRequest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DPApprovalRequests().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, dPApprovalRequestDBTypes, true, dPApprovalRequestPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize DPApprovalRequest struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testDPApprovalRequestsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(dPApprovalRequestAllColumns) == len(dPApprovalRequestPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPApprovalRequest{}
+	if err = randomize.Struct(seed, o, dPApprovalRequestDBTypes, true, dPApprovalRequestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPApprovalRequest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DPApprovalRequests().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, dPApprovalRequestDBTypes, true, dPApprovalRequestPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize DPApprovalRequest struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(dPApprovalRequestAllColumns, dPApprovalRequestPrimaryKeyColumns) {
+		fields = dPApprovalRequestAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			dPApprovalRequestAllColumns,
+			dPApprovalRequestPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := DPApprovalRequestSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testDPApprovalRequestsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(dPApprovalRequestAllColumns) == len(dPApprovalRequestPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := DPApprovalRequest{}
+	if err = randomize.Struct(seed, &o, dPApprovalRequestDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize DPApprovalRequest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert DPApprovalRequest: %s", err)
+	}
+
+	count, err := DPApprovalRequests().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, dPApprovalRequestDBTypes, false, dPApprovalRequestPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize DPApprovalRequest struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert DPApprovalRequest: %s", err)
+	}
+
+	count, err = DPApprovalRequests().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,989 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// DPBidderParam is an object representing the database table.
+type DPBidderParam struct {
+	ID              int        `boil:"id" json:"id" toml:"id" yaml:"id"`
+	DemandPartnerID string     `boil:"demand_partner_id" json:"demand_partner_id" toml:"demand_partner_id" yaml:"demand_partner_id"`
+	PublisherID     string     `boil:"publisher_id" json:"publisher_id" toml:"publisher_id" yaml:"publisher_id"`
+	Domain          string     `boil:"domain" json:"domain" toml:"domain" yaml:"domain"`
+	Params          types.JSON `boil:"params" json:"params" toml:"params" yaml:"params"`
+	CreatedAt       time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt       null.Time  `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *dPBidderParamR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L dPBidderParamL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DPBidderParamColumns = struct {
+	ID              string
+	DemandPartnerID string
+	PublisherID     string
+	Domain          string
+	Params          string
+	CreatedAt       string
+	UpdatedAt       string
+}{
+	ID:              "id",
+	DemandPartnerID: "demand_partner_id",
+	PublisherID:     "publisher_id",
+	Domain:          "domain",
+	Params:          "params",
+	CreatedAt:       "created_at",
+	UpdatedAt:       "updated_at",
+}
+
+var DPBidderParamTableColumns = struct {
+	ID              string
+	DemandPartnerID string
+	PublisherID     string
+	Domain          string
+	Params          string
+	CreatedAt       string
+	UpdatedAt       string
+}{
+	ID:              "dp_bidder_param.id",
+	DemandPartnerID: "dp_bidder_param.demand_partner_id",
+	PublisherID:     "dp_bidder_param.publisher_id",
+	Domain:          "dp_bidder_param.domain",
+	Params:          "dp_bidder_param.params",
+	CreatedAt:       "dp_bidder_param.created_at",
+	UpdatedAt:       "dp_bidder_param.updated_at",
+}
+
+// Generated where
+
+var DPBidderParamWhere = struct {
+	ID              whereHelperint
+	DemandPartnerID whereHelperstring
+	PublisherID     whereHelperstring
+	Domain          whereHelperstring
+	Params          whereHelpertypes_JSON
+	CreatedAt       whereHelpertime_Time
+	UpdatedAt       whereHelpernull_Time
+}{
+	ID:              whereHelperint{field: "\"dp_bidder_param\".\"id\""},
+	DemandPartnerID: whereHelperstring{field: "\"dp_bidder_param\".\"demand_partner_id\""},
+	PublisherID:     whereHelperstring{field: "\"dp_bidder_param\".\"publisher_id\""},
+	Domain:          whereHelperstring{field: "\"dp_bidder_param\".\"domain\""},
+	Params:          whereHelpertypes_JSON{field: "\"dp_bidder_param\".\"params\""},
+	CreatedAt:       whereHelpertime_Time{field: "\"dp_bidder_param\".\"created_at\""},
+	UpdatedAt:       whereHelpernull_Time{field: "\"dp_bidder_param\".\"updated_at\""},
+}
+
+// DPBidderParamRels is where relationship names are stored.
+var DPBidderParamRels = struct {
+}{}
+
+// dPBidderParamR is where relationships are stored.
+type dPBidderParamR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*dPBidderParamR) NewStruct() *dPBidderParamR {
+	return &dPBidderParamR{}
+}
+
+// dPBidderParamL is where Load methods for each relationship are stored.
+type dPBidderParamL struct{}
+
+var (
+	dPBidderParamAllColumns            = []string{"id", "demand_partner_id", "publisher_id", "domain", "params", "created_at", "updated_at"}
+	dPBidderParamColumnsWithoutDefault = []string{"demand_partner_id", "publisher_id", "params", "created_at"}
+	dPBidderParamColumnsWithDefault    = []string{"id", "domain", "updated_at"}
+	dPBidderParamPrimaryKeyColumns     = []string{"id"}
+	dPBidderParamGeneratedColumns      = []string{}
+)
+
+type (
+	// DPBidderParamSlice is an alias for a slice of pointers to DPBidderParam.
+	// This should almost always be used instead of []DPBidderParam.
+	DPBidderParamSlice []*DPBidderParam
+	// DPBidderParamHook is the signature for custom DPBidderParam hook methods
+	DPBidderParamHook func(context.Context, boil.ContextExecutor, *DPBidderParam) error
+
+	dPBidderParamQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	dPBidderParamType                 = reflect.TypeOf(&DPBidderParam{})
+	dPBidderParamMapping              = queries.MakeStructMapping(dPBidderParamType)
+	dPBidderParamPrimaryKeyMapping, _ = queries.BindMapping(dPBidderParamType, dPBidderParamMapping, dPBidderParamPrimaryKeyColumns)
+	dPBidderParamInsertCacheMut       sync.RWMutex
+	dPBidderParamInsertCache          = make(map[string]insertCache)
+	dPBidderParamUpdateCacheMut       sync.RWMutex
+	dPBidderParamUpdateCache          = make(map[string]updateCache)
+	dPBidderParamUpsertCacheMut       sync.RWMutex
+	dPBidderParamUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var dPBidderParamAfterSelectMu sync.Mutex
+var dPBidderParamAfterSelectHooks []DPBidderParamHook
+
+var dPBidderParamBeforeInsertMu sync.Mutex
+var dPBidderParamBeforeInsertHooks []DPBidderParamHook
+var dPBidderParamAfterInsertMu sync.Mutex
+var dPBidderParamAfterInsertHooks []DPBidderParamHook
+
+var dPBidderParamBeforeUpdateMu sync.Mutex
+var dPBidderParamBeforeUpdateHooks []DPBidderParamHook
+var dPBidderParamAfterUpdateMu sync.Mutex
+var dPBidderParamAfterUpdateHooks []DPBidderParamHook
+
+var dPBidderParamBeforeDeleteMu sync.Mutex
+var dPBidderParamBeforeDeleteHooks []DPBidderParamHook
+var dPBidderParamAfterDeleteMu sync.Mutex
+var dPBidderParamAfterDeleteHooks []DPBidderParamHook
+
+var dPBidderParamBeforeUpsertMu sync.Mutex
+var dPBidderParamBeforeUpsertHooks []DPBidderParamHook
+var dPBidderParamAfterUpsertMu sync.Mutex
+var dPBidderParamAfterUpsertHooks []DPBidderParamHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *DPBidderParam) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dPBidderParamAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *DPBidderParam) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dPBidderParamBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *DPBidderParam) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dPBidderParamAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *DPBidderParam) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dPBidderParamBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *DPBidderParam) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dPBidderParamAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *DPBidderParam) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dPBidderParamBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *DPBidderParam) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dPBidderParamAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *DPBidderParam) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dPBidderParamBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *DPBidderParam) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dPBidderParamAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddDPBidderParamHook registers your hook function for all future operations.
+func AddDPBidderParamHook(hookPoint boil.HookPoint, dPBidderParamHook DPBidderParamHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		dPBidderParamAfterSelectMu.Lock()
+		dPBidderParamAfterSelectHooks = append(dPBidderParamAfterSelectHooks, dPBidderParamHook)
+		dPBidderParamAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		dPBidderParamBeforeInsertMu.Lock()
+		dPBidderParamBeforeInsertHooks = append(dPBidderParamBeforeInsertHooks, dPBidderParamHook)
+		dPBidderParamBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		dPBidderParamAfterInsertMu.Lock()
+		dPBidderParamAfterInsertHooks = append(dPBidderParamAfterInsertHooks, dPBidderParamHook)
+		dPBidderParamAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		dPBidderParamBeforeUpdateMu.Lock()
+		dPBidderParamBeforeUpdateHooks = append(dPBidderParamBeforeUpdateHooks, dPBidderParamHook)
+		dPBidderParamBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		dPBidderParamAfterUpdateMu.Lock()
+		dPBidderParamAfterUpdateHooks = append(dPBidderParamAfterUpdateHooks, dPBidderParamHook)
+		dPBidderParamAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		dPBidderParamBeforeDeleteMu.Lock()
+		dPBidderParamBeforeDeleteHooks = append(dPBidderParamBeforeDeleteHooks, dPBidderParamHook)
+		dPBidderParamBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		dPBidderParamAfterDeleteMu.Lock()
+		dPBidderParamAfterDeleteHooks = append(dPBidderParamAfterDeleteHooks, dPBidderParamHook)
+		dPBidderParamAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		dPBidderParamBeforeUpsertMu.Lock()
+		dPBidderParamBeforeUpsertHooks = append(dPBidderParamBeforeUpsertHooks, dPBidderParamHook)
+		dPBidderParamBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		dPBidderParamAfterUpsertMu.Lock()
+		dPBidderParamAfterUpsertHooks = append(dPBidderParamAfterUpsertHooks, dPBidderParamHook)
+		dPBidderParamAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single dPBidderParam record from the query.
+func (q dPBidderParamQuery) One(ctx context.Context, exec boil.ContextExecutor) (*DPBidderParam, error) {
+	o := &DPBidderParam{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for dp_bidder_param")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all DPBidderParam records from the query.
+func (q dPBidderParamQuery) All(ctx context.Context, exec boil.ContextExecutor) (DPBidderParamSlice, error) {
+	var o []*DPBidderParam
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to DPBidderParam slice")
+	}
+
+	if len(dPBidderParamAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all DPBidderParam records in the query.
+func (q dPBidderParamQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count dp_bidder_param rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q dPBidderParamQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if dp_bidder_param exists")
+	}
+
+	return count > 0, nil
+}
+
+// DPBidderParams retrieves all the records using an executor.
+func DPBidderParams(mods ...qm.QueryMod) dPBidderParamQuery {
+	mods = append(mods, qm.From("\"dp_bidder_param\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"dp_bidder_param\".*"})
+	}
+
+	return dPBidderParamQuery{q}
+}
+
+// FindDPBidderParam retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDPBidderParam(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*DPBidderParam, error) {
+	dPBidderParamObj := &DPBidderParam{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"dp_bidder_param\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, dPBidderParamObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from dp_bidder_param")
+	}
+
+	if err = dPBidderParamObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return dPBidderParamObj, err
+	}
+
+	return dPBidderParamObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *DPBidderParam) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no dp_bidder_param provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dPBidderParamColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	dPBidderParamInsertCacheMut.RLock()
+	cache, cached := dPBidderParamInsertCache[key]
+	dPBidderParamInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			dPBidderParamAllColumns,
+			dPBidderParamColumnsWithDefault,
+			dPBidderParamColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(dPBidderParamType, dPBidderParamMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(dPBidderParamType, dPBidderParamMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"dp_bidder_param\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"dp_bidder_param\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into dp_bidder_param")
+	}
+
+	if !cached {
+		dPBidderParamInsertCacheMut.Lock()
+		dPBidderParamInsertCache[key] = cache
+		dPBidderParamInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the DPBidderParam.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *DPBidderParam) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	dPBidderParamUpdateCacheMut.RLock()
+	cache, cached := dPBidderParamUpdateCache[key]
+	dPBidderParamUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			dPBidderParamAllColumns,
+			dPBidderParamPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update dp_bidder_param, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"dp_bidder_param\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, dPBidderParamPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(dPBidderParamType, dPBidderParamMapping, append(wl, dPBidderParamPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update dp_bidder_param row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for dp_bidder_param")
+	}
+
+	if !cached {
+		dPBidderParamUpdateCacheMut.Lock()
+		dPBidderParamUpdateCache[key] = cache
+		dPBidderParamUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q dPBidderParamQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for dp_bidder_param")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for dp_bidder_param")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DPBidderParamSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dPBidderParamPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"dp_bidder_param\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, dPBidderParamPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in dPBidderParam slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all dPBidderParam")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *DPBidderParam) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no dp_bidder_param provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dPBidderParamColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	dPBidderParamUpsertCacheMut.RLock()
+	cache, cached := dPBidderParamUpsertCache[key]
+	dPBidderParamUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			dPBidderParamAllColumns,
+			dPBidderParamColumnsWithDefault,
+			dPBidderParamColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			dPBidderParamAllColumns,
+			dPBidderParamPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert dp_bidder_param, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(dPBidderParamAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(dPBidderParamPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert dp_bidder_param, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(dPBidderParamPrimaryKeyColumns))
+			copy(conflict, dPBidderParamPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"dp_bidder_param\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(dPBidderParamType, dPBidderParamMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(dPBidderParamType, dPBidderParamMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert dp_bidder_param")
+	}
+
+	if !cached {
+		dPBidderParamUpsertCacheMut.Lock()
+		dPBidderParamUpsertCache[key] = cache
+		dPBidderParamUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single DPBidderParam record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *DPBidderParam) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no DPBidderParam provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), dPBidderParamPrimaryKeyMapping)
+	sql := "DELETE FROM \"dp_bidder_param\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from dp_bidder_param")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for dp_bidder_param")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q dPBidderParamQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no dPBidderParamQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from dp_bidder_param")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for dp_bidder_param")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DPBidderParamSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(dPBidderParamBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dPBidderParamPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"dp_bidder_param\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, dPBidderParamPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from dPBidderParam slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for dp_bidder_param")
+	}
+
+	if len(dPBidderParamAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *DPBidderParam) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDPBidderParam(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DPBidderParamSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DPBidderParamSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dPBidderParamPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"dp_bidder_param\".* FROM \"dp_bidder_param\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, dPBidderParamPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in DPBidderParamSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DPBidderParamExists checks if the DPBidderParam row exists.
+func DPBidderParamExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"dp_bidder_param\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if dp_bidder_param exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the DPBidderParam row exists.
+func (o *DPBidderParam) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return DPBidderParamExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testDPBidderParams(t *testing.T) {
+	t.Parallel()
+
+	query := DPBidderParams()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testDPBidderParamsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPBidderParam{}
+	if err = randomize.Struct(seed, o, dPBidderParamDBTypes, true, dPBidderParamColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPBidderParam struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := DPBidderParams().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testDPBidderParamsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPBidderParam{}
+	if err = randomize.Struct(seed, o, dPBidderParamDBTypes, true, dPBidderParamColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPBidderParam struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := DPBidderParams().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := DPBidderParams().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testDPBidderParamsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPBidderParam{}
+	if err = randomize.Struct(seed, o, dPBidderParamDBTypes, true, dPBidderParamColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPBidderParam struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := DPBidderParamSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := DPBidderParams().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testDPBidderParamsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPBidderParam{}
+	if err = randomize.Struct(seed, o, dPBidderParamDBTypes, true, dPBidderParamColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPBidderParam struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := DPBidderParamExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if DPBidderParam exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected DPBidderParamExists to return true, but got false.")
+	}
+}
+
+func testDPBidderParamsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPBidderParam{}
+	if err = randomize.Struct(seed, o, dPBidderParamDBTypes, true, dPBidderParamColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPBidderParam struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	dPBidderParamFound, err := FindDPBidderParam(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if dPBidderParamFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testDPBidderParamsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPBidderParam{}
+	if err = randomize.Struct(seed, o, dPBidderParamDBTypes, true, dPBidderParamColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPBidderParam struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = DPBidderParams().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testDPBidderParamsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPBidderParam{}
+	if err = randomize.Struct(seed, o, dPBidderParamDBTypes, true, dPBidderParamColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPBidderParam struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := DPBidderParams().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testDPBidderParamsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	dPBidderParamOne := &DPBidderParam{}
+	dPBidderParamTwo := &DPBidderParam{}
+	if err = randomize.Struct(seed, dPBidderParamOne, dPBidderParamDBTypes, false, dPBidderParamColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPBidderParam struct: %s", err)
+	}
+	if err = randomize.Struct(seed, dPBidderParamTwo, dPBidderParamDBTypes, false, dPBidderParamColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPBidderParam struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = dPBidderParamOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = dPBidderParamTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := DPBidderParams().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testDPBidderParamsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	dPBidderParamOne := &DPBidderParam{}
+	dPBidderParamTwo := &DPBidderParam{}
+	if err = randomize.Struct(seed, dPBidderParamOne, dPBidderParamDBTypes, false, dPBidderParamColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPBidderParam struct: %s", err)
+	}
+	if err = randomize.Struct(seed, dPBidderParamTwo, dPBidderParamDBTypes, false, dPBidderParamColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPBidderParam struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = dPBidderParamOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = dPBidderParamTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DPBidderParams().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func dPBidderParamBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *DPBidderParam) error {
+	*o = DPBidderParam{}
+	return nil
+}
+
+func dPBidderParamAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *DPBidderParam) error {
+	*o = DPBidderParam{}
+	return nil
+}
+
+func dPBidderParamAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *DPBidderParam) error {
+	*o = DPBidderParam{}
+	return nil
+}
+
+func dPBidderParamBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *DPBidderParam) error {
+	*o = DPBidderParam{}
+	return nil
+}
+
+func dPBidderParamAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *DPBidderParam) error {
+	*o = DPBidderParam{}
+	return nil
+}
+
+func dPBidderParamBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *DPBidderParam) error {
+	*o = DPBidderParam{}
+	return nil
+}
+
+func dPBidderParamAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *DPBidderParam) error {
+	*o = DPBidderParam{}
+	return nil
+}
+
+func dPBidderParamBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *DPBidderParam) error {
+	*o = DPBidderParam{}
+	return nil
+}
+
+func dPBidderParamAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *DPBidderParam) error {
+	*o = DPBidderParam{}
+	return nil
+}
+
+func testDPBidderParamsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &DPBidderParam{}
+	o := &DPBidderParam{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, dPBidderParamDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize DPBidderParam object: %s", err)
+	}
+
+	AddDPBidderParamHook(boil.BeforeInsertHook, dPBidderParamBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	dPBidderParamBeforeInsertHooks = []DPBidderParamHook{}
+
+	AddDPBidderParamHook(boil.AfterInsertHook, dPBidderParamAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	dPBidderParamAfterInsertHooks = []DPBidderParamHook{}
+
+	AddDPBidderParamHook(boil.AfterSelectHook, dPBidderParamAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	dPBidderParamAfterSelectHooks = []DPBidderParamHook{}
+
+	AddDPBidderParamHook(boil.BeforeUpdateHook, dPBidderParamBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	dPBidderParamBeforeUpdateHooks = []DPBidderParamHook{}
+
+	AddDPBidderParamHook(boil.AfterUpdateHook, dPBidderParamAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	dPBidderParamAfterUpdateHooks = []DPBidderParamHook{}
+
+	AddDPBidderParamHook(boil.BeforeDeleteHook, dPBidderParamBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	dPBidderParamBeforeDeleteHooks = []DPBidderParamHook{}
+
+	AddDPBidderParamHook(boil.AfterDeleteHook, dPBidderParamAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	dPBidderParamAfterDeleteHooks = []DPBidderParamHook{}
+
+	AddDPBidderParamHook(boil.BeforeUpsertHook, dPBidderParamBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	dPBidderParamBeforeUpsertHooks = []DPBidderParamHook{}
+
+	AddDPBidderParamHook(boil.AfterUpsertHook, dPBidderParamAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	dPBidderParamAfterUpsertHooks = []DPBidderParamHook{}
+}
+
+func testDPBidderParamsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPBidderParam{}
+	if err = randomize.Struct(seed, o, dPBidderParamDBTypes, true, dPBidderParamColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPBidderParam struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DPBidderParams().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testDPBidderParamsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPBidderParam{}
+	if err = randomize.Struct(seed, o, dPBidderParamDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize DPBidderParam struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(dPBidderParamColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DPBidderParams().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testDPBidderParamsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPBidderParam{}
+	if err = randomize.Struct(seed, o, dPBidderParamDBTypes, true, dPBidderParamColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPBidderParam struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testDPBidderParamsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPBidderParam{}
+	if err = randomize.Struct(seed, o, dPBidderParamDBTypes, true, dPBidderParamColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPBidderParam struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := DPBidderParamSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testDPBidderParamsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPBidderParam{}
+	if err = randomize.Struct(seed, o, dPBidderParamDBTypes, true, dPBidderParamColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPBidderParam struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := DPBidderParams().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	dPBidderParamDBTypes = map[string]string{`ID`: `integer`, `DemandPartnerID`: `character varying`, `PublisherID`: `character varying`, `Domain`: `character varying`, `Params`: `jsonb`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                    = bytes.MinRead
+)
+
+func testDPBidderParamsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(dPBidderParamPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(dPBidderParamAllColumns) == len(dPBidderParamPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPBidderParam{}
+	if err = randomize.Struct(seed, o, dPBidderParamDBTypes, true, dPBidderParamColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPBidderParam struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DPBidderParams().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, dPBidderParamDBTypes, true, dPBidderParamPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize DPBidderParam struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testDPBidderParamsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(dPBidderParamAllColumns) == len(dPBidderParamPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPBidderParam{}
+	if err = randomize.Struct(seed, o, dPBidderParamDBTypes, true, dPBidderParamColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPBidderParam struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DPBidderParams().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, dPBidderParamDBTypes, true, dPBidderParamPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize DPBidderParam struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(dPBidderParamAllColumns, dPBidderParamPrimaryKeyColumns) {
+		fields = dPBidderParamAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			dPBidderParamAllColumns,
+			dPBidderParamPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := DPBidderParamSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testDPBidderParamsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(dPBidderParamAllColumns) == len(dPBidderParamPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := DPBidderParam{}
+	if err = randomize.Struct(seed, &o, dPBidderParamDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize DPBidderParam struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert DPBidderParam: %s", err)
+	}
+
+	count, err := DPBidderParams().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, dPBidderParamDBTypes, false, dPBidderParamPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize DPBidderParam struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert DPBidderParam: %s", err)
+	}
+
+	count, err = DPBidderParams().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
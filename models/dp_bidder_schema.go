@@ -0,0 +1,975 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// DPBidderSchema is an object representing the database table.
+type DPBidderSchema struct {
+	ID              int        `boil:"id" json:"id" toml:"id" yaml:"id"`
+	DemandPartnerID string     `boil:"demand_partner_id" json:"demand_partner_id" toml:"demand_partner_id" yaml:"demand_partner_id"`
+	Schema          types.JSON `boil:"schema" json:"schema" toml:"schema" yaml:"schema"`
+	CreatedAt       time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt       null.Time  `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *dPBidderSchemaR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L dPBidderSchemaL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DPBidderSchemaColumns = struct {
+	ID              string
+	DemandPartnerID string
+	Schema          string
+	CreatedAt       string
+	UpdatedAt       string
+}{
+	ID:              "id",
+	DemandPartnerID: "demand_partner_id",
+	Schema:          "schema",
+	CreatedAt:       "created_at",
+	UpdatedAt:       "updated_at",
+}
+
+var DPBidderSchemaTableColumns = struct {
+	ID              string
+	DemandPartnerID string
+	Schema          string
+	CreatedAt       string
+	UpdatedAt       string
+}{
+	ID:              "dp_bidder_schema.id",
+	DemandPartnerID: "dp_bidder_schema.demand_partner_id",
+	Schema:          "dp_bidder_schema.schema",
+	CreatedAt:       "dp_bidder_schema.created_at",
+	UpdatedAt:       "dp_bidder_schema.updated_at",
+}
+
+// Generated where
+
+var DPBidderSchemaWhere = struct {
+	ID              whereHelperint
+	DemandPartnerID whereHelperstring
+	Schema          whereHelpertypes_JSON
+	CreatedAt       whereHelpertime_Time
+	UpdatedAt       whereHelpernull_Time
+}{
+	ID:              whereHelperint{field: "\"dp_bidder_schema\".\"id\""},
+	DemandPartnerID: whereHelperstring{field: "\"dp_bidder_schema\".\"demand_partner_id\""},
+	Schema:          whereHelpertypes_JSON{field: "\"dp_bidder_schema\".\"schema\""},
+	CreatedAt:       whereHelpertime_Time{field: "\"dp_bidder_schema\".\"created_at\""},
+	UpdatedAt:       whereHelpernull_Time{field: "\"dp_bidder_schema\".\"updated_at\""},
+}
+
+// DPBidderSchemaRels is where relationship names are stored.
+var DPBidderSchemaRels = struct {
+}{}
+
+// dPBidderSchemaR is where relationships are stored.
+type dPBidderSchemaR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*dPBidderSchemaR) NewStruct() *dPBidderSchemaR {
+	return &dPBidderSchemaR{}
+}
+
+// dPBidderSchemaL is where Load methods for each relationship are stored.
+type dPBidderSchemaL struct{}
+
+var (
+	dPBidderSchemaAllColumns            = []string{"id", "demand_partner_id", "schema", "created_at", "updated_at"}
+	dPBidderSchemaColumnsWithoutDefault = []string{"demand_partner_id", "schema", "created_at"}
+	dPBidderSchemaColumnsWithDefault    = []string{"id", "updated_at"}
+	dPBidderSchemaPrimaryKeyColumns     = []string{"id"}
+	dPBidderSchemaGeneratedColumns      = []string{}
+)
+
+type (
+	// DPBidderSchemaSlice is an alias for a slice of pointers to DPBidderSchema.
+	// This should almost always be used instead of []DPBidderSchema.
+	DPBidderSchemaSlice []*DPBidderSchema
+	// DPBidderSchemaHook is the signature for custom DPBidderSchema hook methods
+	DPBidderSchemaHook func(context.Context, boil.ContextExecutor, *DPBidderSchema) error
+
+	dPBidderSchemaQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	dPBidderSchemaType                 = reflect.TypeOf(&DPBidderSchema{})
+	dPBidderSchemaMapping              = queries.MakeStructMapping(dPBidderSchemaType)
+	dPBidderSchemaPrimaryKeyMapping, _ = queries.BindMapping(dPBidderSchemaType, dPBidderSchemaMapping, dPBidderSchemaPrimaryKeyColumns)
+	dPBidderSchemaInsertCacheMut       sync.RWMutex
+	dPBidderSchemaInsertCache          = make(map[string]insertCache)
+	dPBidderSchemaUpdateCacheMut       sync.RWMutex
+	dPBidderSchemaUpdateCache          = make(map[string]updateCache)
+	dPBidderSchemaUpsertCacheMut       sync.RWMutex
+	dPBidderSchemaUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var dPBidderSchemaAfterSelectMu sync.Mutex
+var dPBidderSchemaAfterSelectHooks []DPBidderSchemaHook
+
+var dPBidderSchemaBeforeInsertMu sync.Mutex
+var dPBidderSchemaBeforeInsertHooks []DPBidderSchemaHook
+var dPBidderSchemaAfterInsertMu sync.Mutex
+var dPBidderSchemaAfterInsertHooks []DPBidderSchemaHook
+
+var dPBidderSchemaBeforeUpdateMu sync.Mutex
+var dPBidderSchemaBeforeUpdateHooks []DPBidderSchemaHook
+var dPBidderSchemaAfterUpdateMu sync.Mutex
+var dPBidderSchemaAfterUpdateHooks []DPBidderSchemaHook
+
+var dPBidderSchemaBeforeDeleteMu sync.Mutex
+var dPBidderSchemaBeforeDeleteHooks []DPBidderSchemaHook
+var dPBidderSchemaAfterDeleteMu sync.Mutex
+var dPBidderSchemaAfterDeleteHooks []DPBidderSchemaHook
+
+var dPBidderSchemaBeforeUpsertMu sync.Mutex
+var dPBidderSchemaBeforeUpsertHooks []DPBidderSchemaHook
+var dPBidderSchemaAfterUpsertMu sync.Mutex
+var dPBidderSchemaAfterUpsertHooks []DPBidderSchemaHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *DPBidderSchema) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dPBidderSchemaAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *DPBidderSchema) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dPBidderSchemaBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *DPBidderSchema) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dPBidderSchemaAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *DPBidderSchema) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dPBidderSchemaBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *DPBidderSchema) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dPBidderSchemaAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *DPBidderSchema) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dPBidderSchemaBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *DPBidderSchema) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dPBidderSchemaAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *DPBidderSchema) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dPBidderSchemaBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *DPBidderSchema) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dPBidderSchemaAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddDPBidderSchemaHook registers your hook function for all future operations.
+func AddDPBidderSchemaHook(hookPoint boil.HookPoint, dPBidderSchemaHook DPBidderSchemaHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		dPBidderSchemaAfterSelectMu.Lock()
+		dPBidderSchemaAfterSelectHooks = append(dPBidderSchemaAfterSelectHooks, dPBidderSchemaHook)
+		dPBidderSchemaAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		dPBidderSchemaBeforeInsertMu.Lock()
+		dPBidderSchemaBeforeInsertHooks = append(dPBidderSchemaBeforeInsertHooks, dPBidderSchemaHook)
+		dPBidderSchemaBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		dPBidderSchemaAfterInsertMu.Lock()
+		dPBidderSchemaAfterInsertHooks = append(dPBidderSchemaAfterInsertHooks, dPBidderSchemaHook)
+		dPBidderSchemaAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		dPBidderSchemaBeforeUpdateMu.Lock()
+		dPBidderSchemaBeforeUpdateHooks = append(dPBidderSchemaBeforeUpdateHooks, dPBidderSchemaHook)
+		dPBidderSchemaBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		dPBidderSchemaAfterUpdateMu.Lock()
+		dPBidderSchemaAfterUpdateHooks = append(dPBidderSchemaAfterUpdateHooks, dPBidderSchemaHook)
+		dPBidderSchemaAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		dPBidderSchemaBeforeDeleteMu.Lock()
+		dPBidderSchemaBeforeDeleteHooks = append(dPBidderSchemaBeforeDeleteHooks, dPBidderSchemaHook)
+		dPBidderSchemaBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		dPBidderSchemaAfterDeleteMu.Lock()
+		dPBidderSchemaAfterDeleteHooks = append(dPBidderSchemaAfterDeleteHooks, dPBidderSchemaHook)
+		dPBidderSchemaAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		dPBidderSchemaBeforeUpsertMu.Lock()
+		dPBidderSchemaBeforeUpsertHooks = append(dPBidderSchemaBeforeUpsertHooks, dPBidderSchemaHook)
+		dPBidderSchemaBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		dPBidderSchemaAfterUpsertMu.Lock()
+		dPBidderSchemaAfterUpsertHooks = append(dPBidderSchemaAfterUpsertHooks, dPBidderSchemaHook)
+		dPBidderSchemaAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single dPBidderSchema record from the query.
+func (q dPBidderSchemaQuery) One(ctx context.Context, exec boil.ContextExecutor) (*DPBidderSchema, error) {
+	o := &DPBidderSchema{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for dp_bidder_schema")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all DPBidderSchema records from the query.
+func (q dPBidderSchemaQuery) All(ctx context.Context, exec boil.ContextExecutor) (DPBidderSchemaSlice, error) {
+	var o []*DPBidderSchema
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to DPBidderSchema slice")
+	}
+
+	if len(dPBidderSchemaAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all DPBidderSchema records in the query.
+func (q dPBidderSchemaQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count dp_bidder_schema rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q dPBidderSchemaQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if dp_bidder_schema exists")
+	}
+
+	return count > 0, nil
+}
+
+// DPBidderSchemas retrieves all the records using an executor.
+func DPBidderSchemas(mods ...qm.QueryMod) dPBidderSchemaQuery {
+	mods = append(mods, qm.From("\"dp_bidder_schema\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"dp_bidder_schema\".*"})
+	}
+
+	return dPBidderSchemaQuery{q}
+}
+
+// FindDPBidderSchema retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDPBidderSchema(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*DPBidderSchema, error) {
+	dPBidderSchemaObj := &DPBidderSchema{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"dp_bidder_schema\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, dPBidderSchemaObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from dp_bidder_schema")
+	}
+
+	if err = dPBidderSchemaObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return dPBidderSchemaObj, err
+	}
+
+	return dPBidderSchemaObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *DPBidderSchema) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no dp_bidder_schema provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dPBidderSchemaColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	dPBidderSchemaInsertCacheMut.RLock()
+	cache, cached := dPBidderSchemaInsertCache[key]
+	dPBidderSchemaInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			dPBidderSchemaAllColumns,
+			dPBidderSchemaColumnsWithDefault,
+			dPBidderSchemaColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(dPBidderSchemaType, dPBidderSchemaMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(dPBidderSchemaType, dPBidderSchemaMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"dp_bidder_schema\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"dp_bidder_schema\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into dp_bidder_schema")
+	}
+
+	if !cached {
+		dPBidderSchemaInsertCacheMut.Lock()
+		dPBidderSchemaInsertCache[key] = cache
+		dPBidderSchemaInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the DPBidderSchema.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *DPBidderSchema) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	dPBidderSchemaUpdateCacheMut.RLock()
+	cache, cached := dPBidderSchemaUpdateCache[key]
+	dPBidderSchemaUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			dPBidderSchemaAllColumns,
+			dPBidderSchemaPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update dp_bidder_schema, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"dp_bidder_schema\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, dPBidderSchemaPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(dPBidderSchemaType, dPBidderSchemaMapping, append(wl, dPBidderSchemaPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update dp_bidder_schema row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for dp_bidder_schema")
+	}
+
+	if !cached {
+		dPBidderSchemaUpdateCacheMut.Lock()
+		dPBidderSchemaUpdateCache[key] = cache
+		dPBidderSchemaUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q dPBidderSchemaQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for dp_bidder_schema")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for dp_bidder_schema")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DPBidderSchemaSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dPBidderSchemaPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"dp_bidder_schema\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, dPBidderSchemaPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in dPBidderSchema slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all dPBidderSchema")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *DPBidderSchema) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no dp_bidder_schema provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dPBidderSchemaColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	dPBidderSchemaUpsertCacheMut.RLock()
+	cache, cached := dPBidderSchemaUpsertCache[key]
+	dPBidderSchemaUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			dPBidderSchemaAllColumns,
+			dPBidderSchemaColumnsWithDefault,
+			dPBidderSchemaColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			dPBidderSchemaAllColumns,
+			dPBidderSchemaPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert dp_bidder_schema, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(dPBidderSchemaAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(dPBidderSchemaPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert dp_bidder_schema, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(dPBidderSchemaPrimaryKeyColumns))
+			copy(conflict, dPBidderSchemaPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"dp_bidder_schema\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(dPBidderSchemaType, dPBidderSchemaMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(dPBidderSchemaType, dPBidderSchemaMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert dp_bidder_schema")
+	}
+
+	if !cached {
+		dPBidderSchemaUpsertCacheMut.Lock()
+		dPBidderSchemaUpsertCache[key] = cache
+		dPBidderSchemaUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single DPBidderSchema record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *DPBidderSchema) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no DPBidderSchema provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), dPBidderSchemaPrimaryKeyMapping)
+	sql := "DELETE FROM \"dp_bidder_schema\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from dp_bidder_schema")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for dp_bidder_schema")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q dPBidderSchemaQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no dPBidderSchemaQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from dp_bidder_schema")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for dp_bidder_schema")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DPBidderSchemaSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(dPBidderSchemaBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dPBidderSchemaPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"dp_bidder_schema\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, dPBidderSchemaPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from dPBidderSchema slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for dp_bidder_schema")
+	}
+
+	if len(dPBidderSchemaAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *DPBidderSchema) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDPBidderSchema(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DPBidderSchemaSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DPBidderSchemaSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dPBidderSchemaPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"dp_bidder_schema\".* FROM \"dp_bidder_schema\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, dPBidderSchemaPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in DPBidderSchemaSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DPBidderSchemaExists checks if the DPBidderSchema row exists.
+func DPBidderSchemaExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"dp_bidder_schema\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if dp_bidder_schema exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the DPBidderSchema row exists.
+func (o *DPBidderSchema) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return DPBidderSchemaExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testDPBidderSchemas(t *testing.T) {
+	t.Parallel()
+
+	query := DPBidderSchemas()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testDPBidderSchemasDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPBidderSchema{}
+	if err = randomize.Struct(seed, o, dPBidderSchemaDBTypes, true, dPBidderSchemaColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPBidderSchema struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := DPBidderSchemas().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testDPBidderSchemasQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPBidderSchema{}
+	if err = randomize.Struct(seed, o, dPBidderSchemaDBTypes, true, dPBidderSchemaColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPBidderSchema struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := DPBidderSchemas().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := DPBidderSchemas().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testDPBidderSchemasSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPBidderSchema{}
+	if err = randomize.Struct(seed, o, dPBidderSchemaDBTypes, true, dPBidderSchemaColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPBidderSchema struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := DPBidderSchemaSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := DPBidderSchemas().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testDPBidderSchemasExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPBidderSchema{}
+	if err = randomize.Struct(seed, o, dPBidderSchemaDBTypes, true, dPBidderSchemaColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPBidderSchema struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := DPBidderSchemaExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if DPBidderSchema exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected DPBidderSchemaExists to return true, but got false.")
+	}
+}
+
+func testDPBidderSchemasFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPBidderSchema{}
+	if err = randomize.Struct(seed, o, dPBidderSchemaDBTypes, true, dPBidderSchemaColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPBidderSchema struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	dPBidderSchemaFound, err := FindDPBidderSchema(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if dPBidderSchemaFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testDPBidderSchemasBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPBidderSchema{}
+	if err = randomize.Struct(seed, o, dPBidderSchemaDBTypes, true, dPBidderSchemaColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPBidderSchema struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = DPBidderSchemas().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testDPBidderSchemasOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPBidderSchema{}
+	if err = randomize.Struct(seed, o, dPBidderSchemaDBTypes, true, dPBidderSchemaColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPBidderSchema struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := DPBidderSchemas().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testDPBidderSchemasAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	dPBidderSchemaOne := &DPBidderSchema{}
+	dPBidderSchemaTwo := &DPBidderSchema{}
+	if err = randomize.Struct(seed, dPBidderSchemaOne, dPBidderSchemaDBTypes, false, dPBidderSchemaColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPBidderSchema struct: %s", err)
+	}
+	if err = randomize.Struct(seed, dPBidderSchemaTwo, dPBidderSchemaDBTypes, false, dPBidderSchemaColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPBidderSchema struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = dPBidderSchemaOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = dPBidderSchemaTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := DPBidderSchemas().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testDPBidderSchemasCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	dPBidderSchemaOne := &DPBidderSchema{}
+	dPBidderSchemaTwo := &DPBidderSchema{}
+	if err = randomize.Struct(seed, dPBidderSchemaOne, dPBidderSchemaDBTypes, false, dPBidderSchemaColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPBidderSchema struct: %s", err)
+	}
+	if err = randomize.Struct(seed, dPBidderSchemaTwo, dPBidderSchemaDBTypes, false, dPBidderSchemaColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPBidderSchema struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = dPBidderSchemaOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = dPBidderSchemaTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DPBidderSchemas().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func dPBidderSchemaBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *DPBidderSchema) error {
+	*o = DPBidderSchema{}
+	return nil
+}
+
+func dPBidderSchemaAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *DPBidderSchema) error {
+	*o = DPBidderSchema{}
+	return nil
+}
+
+func dPBidderSchemaAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *DPBidderSchema) error {
+	*o = DPBidderSchema{}
+	return nil
+}
+
+func dPBidderSchemaBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *DPBidderSchema) error {
+	*o = DPBidderSchema{}
+	return nil
+}
+
+func dPBidderSchemaAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *DPBidderSchema) error {
+	*o = DPBidderSchema{}
+	return nil
+}
+
+func dPBidderSchemaBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *DPBidderSchema) error {
+	*o = DPBidderSchema{}
+	return nil
+}
+
+func dPBidderSchemaAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *DPBidderSchema) error {
+	*o = DPBidderSchema{}
+	return nil
+}
+
+func dPBidderSchemaBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *DPBidderSchema) error {
+	*o = DPBidderSchema{}
+	return nil
+}
+
+func dPBidderSchemaAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *DPBidderSchema) error {
+	*o = DPBidderSchema{}
+	return nil
+}
+
+func testDPBidderSchemasHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &DPBidderSchema{}
+	o := &DPBidderSchema{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, dPBidderSchemaDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize DPBidderSchema object: %s", err)
+	}
+
+	AddDPBidderSchemaHook(boil.BeforeInsertHook, dPBidderSchemaBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	dPBidderSchemaBeforeInsertHooks = []DPBidderSchemaHook{}
+
+	AddDPBidderSchemaHook(boil.AfterInsertHook, dPBidderSchemaAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	dPBidderSchemaAfterInsertHooks = []DPBidderSchemaHook{}
+
+	AddDPBidderSchemaHook(boil.AfterSelectHook, dPBidderSchemaAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	dPBidderSchemaAfterSelectHooks = []DPBidderSchemaHook{}
+
+	AddDPBidderSchemaHook(boil.BeforeUpdateHook, dPBidderSchemaBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	dPBidderSchemaBeforeUpdateHooks = []DPBidderSchemaHook{}
+
+	AddDPBidderSchemaHook(boil.AfterUpdateHook, dPBidderSchemaAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	dPBidderSchemaAfterUpdateHooks = []DPBidderSchemaHook{}
+
+	AddDPBidderSchemaHook(boil.BeforeDeleteHook, dPBidderSchemaBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	dPBidderSchemaBeforeDeleteHooks = []DPBidderSchemaHook{}
+
+	AddDPBidderSchemaHook(boil.AfterDeleteHook, dPBidderSchemaAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	dPBidderSchemaAfterDeleteHooks = []DPBidderSchemaHook{}
+
+	AddDPBidderSchemaHook(boil.BeforeUpsertHook, dPBidderSchemaBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	dPBidderSchemaBeforeUpsertHooks = []DPBidderSchemaHook{}
+
+	AddDPBidderSchemaHook(boil.AfterUpsertHook, dPBidderSchemaAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	dPBidderSchemaAfterUpsertHooks = []DPBidderSchemaHook{}
+}
+
+func testDPBidderSchemasInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPBidderSchema{}
+	if err = randomize.Struct(seed, o, dPBidderSchemaDBTypes, true, dPBidderSchemaColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPBidderSchema struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DPBidderSchemas().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testDPBidderSchemasInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPBidderSchema{}
+	if err = randomize.Struct(seed, o, dPBidderSchemaDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize DPBidderSchema struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(dPBidderSchemaColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DPBidderSchemas().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testDPBidderSchemasReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPBidderSchema{}
+	if err = randomize.Struct(seed, o, dPBidderSchemaDBTypes, true, dPBidderSchemaColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPBidderSchema struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testDPBidderSchemasReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPBidderSchema{}
+	if err = randomize.Struct(seed, o, dPBidderSchemaDBTypes, true, dPBidderSchemaColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPBidderSchema struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := DPBidderSchemaSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testDPBidderSchemasSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPBidderSchema{}
+	if err = randomize.Struct(seed, o, dPBidderSchemaDBTypes, true, dPBidderSchemaColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPBidderSchema struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := DPBidderSchemas().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	dPBidderSchemaDBTypes = map[string]string{`ID`: `integer`, `DemandPartnerID`: `character varying`, `Schema`: `jsonb`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                     = bytes.MinRead
+)
+
+func testDPBidderSchemasUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(dPBidderSchemaPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(dPBidderSchemaAllColumns) == len(dPBidderSchemaPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPBidderSchema{}
+	if err = randomize.Struct(seed, o, dPBidderSchemaDBTypes, true, dPBidderSchemaColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPBidderSchema struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DPBidderSchemas().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, dPBidderSchemaDBTypes, true, dPBidderSchemaPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize DPBidderSchema struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testDPBidderSchemasSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(dPBidderSchemaAllColumns) == len(dPBidderSchemaPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPBidderSchema{}
+	if err = randomize.Struct(seed, o, dPBidderSchemaDBTypes, true, dPBidderSchemaColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPBidderSchema struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DPBidderSchemas().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, dPBidderSchemaDBTypes, true, dPBidderSchemaPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize DPBidderSchema struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(dPBidderSchemaAllColumns, dPBidderSchemaPrimaryKeyColumns) {
+		fields = dPBidderSchemaAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			dPBidderSchemaAllColumns,
+			dPBidderSchemaPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := DPBidderSchemaSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testDPBidderSchemasUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(dPBidderSchemaAllColumns) == len(dPBidderSchemaPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := DPBidderSchema{}
+	if err = randomize.Struct(seed, &o, dPBidderSchemaDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize DPBidderSchema struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert DPBidderSchema: %s", err)
+	}
+
+	count, err := DPBidderSchemas().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, dPBidderSchemaDBTypes, false, dPBidderSchemaPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize DPBidderSchema struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert DPBidderSchema: %s", err)
+	}
+
+	count, err = DPBidderSchemas().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1003 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// DPScore is an object representing the database table.
+type DPScore struct {
+	ID              int        `boil:"id" json:"id" toml:"id" yaml:"id"`
+	DemandPartnerID string     `boil:"demand_partner_id" json:"demand_partner_id" toml:"demand_partner_id" yaml:"demand_partner_id"`
+	Country         string     `boil:"country" json:"country" toml:"country" yaml:"country"`
+	DeviceType      string     `boil:"device_type" json:"device_type" toml:"device_type" yaml:"device_type"`
+	Score           int        `boil:"score" json:"score" toml:"score" yaml:"score"`
+	Components      types.JSON `boil:"components" json:"components" toml:"components" yaml:"components"`
+	CalculatedAt    time.Time  `boil:"calculated_at" json:"calculated_at" toml:"calculated_at" yaml:"calculated_at"`
+	CreatedAt       time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt       null.Time  `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *dPScoreR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L dPScoreL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DPScoreColumns = struct {
+	ID              string
+	DemandPartnerID string
+	Country         string
+	DeviceType      string
+	Score           string
+	Components      string
+	CalculatedAt    string
+	CreatedAt       string
+	UpdatedAt       string
+}{
+	ID:              "id",
+	DemandPartnerID: "demand_partner_id",
+	Country:         "country",
+	DeviceType:      "device_type",
+	Score:           "score",
+	Components:      "components",
+	CalculatedAt:    "calculated_at",
+	CreatedAt:       "created_at",
+	UpdatedAt:       "updated_at",
+}
+
+var DPScoreTableColumns = struct {
+	ID              string
+	DemandPartnerID string
+	Country         string
+	DeviceType      string
+	Score           string
+	Components      string
+	CalculatedAt    string
+	CreatedAt       string
+	UpdatedAt       string
+}{
+	ID:              "dp_score.id",
+	DemandPartnerID: "dp_score.demand_partner_id",
+	Country:         "dp_score.country",
+	DeviceType:      "dp_score.device_type",
+	Score:           "dp_score.score",
+	Components:      "dp_score.components",
+	CalculatedAt:    "dp_score.calculated_at",
+	CreatedAt:       "dp_score.created_at",
+	UpdatedAt:       "dp_score.updated_at",
+}
+
+// Generated where
+
+var DPScoreWhere = struct {
+	ID              whereHelperint
+	DemandPartnerID whereHelperstring
+	Country         whereHelperstring
+	DeviceType      whereHelperstring
+	Score           whereHelperint
+	Components      whereHelpertypes_JSON
+	CalculatedAt    whereHelpertime_Time
+	CreatedAt       whereHelpertime_Time
+	UpdatedAt       whereHelpernull_Time
+}{
+	ID:              whereHelperint{field: "\"dp_score\".\"id\""},
+	DemandPartnerID: whereHelperstring{field: "\"dp_score\".\"demand_partner_id\""},
+	Country:         whereHelperstring{field: "\"dp_score\".\"country\""},
+	DeviceType:      whereHelperstring{field: "\"dp_score\".\"device_type\""},
+	Score:           whereHelperint{field: "\"dp_score\".\"score\""},
+	Components:      whereHelpertypes_JSON{field: "\"dp_score\".\"components\""},
+	CalculatedAt:    whereHelpertime_Time{field: "\"dp_score\".\"calculated_at\""},
+	CreatedAt:       whereHelpertime_Time{field: "\"dp_score\".\"created_at\""},
+	UpdatedAt:       whereHelpernull_Time{field: "\"dp_score\".\"updated_at\""},
+}
+
+// DPScoreRels is where relationship names are stored.
+var DPScoreRels = struct {
+}{}
+
+// dPScoreR is where relationships are stored.
+type dPScoreR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*dPScoreR) NewStruct() *dPScoreR {
+	return &dPScoreR{}
+}
+
+// dPScoreL is where Load methods for each relationship are stored.
+type dPScoreL struct{}
+
+var (
+	dPScoreAllColumns            = []string{"id", "demand_partner_id", "country", "device_type", "score", "components", "calculated_at", "created_at", "updated_at"}
+	dPScoreColumnsWithoutDefault = []string{"demand_partner_id", "score", "components", "calculated_at", "created_at"}
+	dPScoreColumnsWithDefault    = []string{"id", "country", "device_type", "updated_at"}
+	dPScorePrimaryKeyColumns     = []string{"id"}
+	dPScoreGeneratedColumns      = []string{}
+)
+
+type (
+	// DPScoreSlice is an alias for a slice of pointers to DPScore.
+	// This should almost always be used instead of []DPScore.
+	DPScoreSlice []*DPScore
+	// DPScoreHook is the signature for custom DPScore hook methods
+	DPScoreHook func(context.Context, boil.ContextExecutor, *DPScore) error
+
+	dPScoreQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	dPScoreType                 = reflect.TypeOf(&DPScore{})
+	dPScoreMapping              = queries.MakeStructMapping(dPScoreType)
+	dPScorePrimaryKeyMapping, _ = queries.BindMapping(dPScoreType, dPScoreMapping, dPScorePrimaryKeyColumns)
+	dPScoreInsertCacheMut       sync.RWMutex
+	dPScoreInsertCache          = make(map[string]insertCache)
+	dPScoreUpdateCacheMut       sync.RWMutex
+	dPScoreUpdateCache          = make(map[string]updateCache)
+	dPScoreUpsertCacheMut       sync.RWMutex
+	dPScoreUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var dPScoreAfterSelectMu sync.Mutex
+var dPScoreAfterSelectHooks []DPScoreHook
+
+var dPScoreBeforeInsertMu sync.Mutex
+var dPScoreBeforeInsertHooks []DPScoreHook
+var dPScoreAfterInsertMu sync.Mutex
+var dPScoreAfterInsertHooks []DPScoreHook
+
+var dPScoreBeforeUpdateMu sync.Mutex
+var dPScoreBeforeUpdateHooks []DPScoreHook
+var dPScoreAfterUpdateMu sync.Mutex
+var dPScoreAfterUpdateHooks []DPScoreHook
+
+var dPScoreBeforeDeleteMu sync.Mutex
+var dPScoreBeforeDeleteHooks []DPScoreHook
+var dPScoreAfterDeleteMu sync.Mutex
+var dPScoreAfterDeleteHooks []DPScoreHook
+
+var dPScoreBeforeUpsertMu sync.Mutex
+var dPScoreBeforeUpsertHooks []DPScoreHook
+var dPScoreAfterUpsertMu sync.Mutex
+var dPScoreAfterUpsertHooks []DPScoreHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *DPScore) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dPScoreAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *DPScore) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dPScoreBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *DPScore) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dPScoreAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *DPScore) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dPScoreBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *DPScore) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dPScoreAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *DPScore) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dPScoreBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *DPScore) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dPScoreAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *DPScore) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dPScoreBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *DPScore) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dPScoreAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddDPScoreHook registers your hook function for all future operations.
+func AddDPScoreHook(hookPoint boil.HookPoint, dPScoreHook DPScoreHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		dPScoreAfterSelectMu.Lock()
+		dPScoreAfterSelectHooks = append(dPScoreAfterSelectHooks, dPScoreHook)
+		dPScoreAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		dPScoreBeforeInsertMu.Lock()
+		dPScoreBeforeInsertHooks = append(dPScoreBeforeInsertHooks, dPScoreHook)
+		dPScoreBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		dPScoreAfterInsertMu.Lock()
+		dPScoreAfterInsertHooks = append(dPScoreAfterInsertHooks, dPScoreHook)
+		dPScoreAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		dPScoreBeforeUpdateMu.Lock()
+		dPScoreBeforeUpdateHooks = append(dPScoreBeforeUpdateHooks, dPScoreHook)
+		dPScoreBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		dPScoreAfterUpdateMu.Lock()
+		dPScoreAfterUpdateHooks = append(dPScoreAfterUpdateHooks, dPScoreHook)
+		dPScoreAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		dPScoreBeforeDeleteMu.Lock()
+		dPScoreBeforeDeleteHooks = append(dPScoreBeforeDeleteHooks, dPScoreHook)
+		dPScoreBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		dPScoreAfterDeleteMu.Lock()
+		dPScoreAfterDeleteHooks = append(dPScoreAfterDeleteHooks, dPScoreHook)
+		dPScoreAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		dPScoreBeforeUpsertMu.Lock()
+		dPScoreBeforeUpsertHooks = append(dPScoreBeforeUpsertHooks, dPScoreHook)
+		dPScoreBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		dPScoreAfterUpsertMu.Lock()
+		dPScoreAfterUpsertHooks = append(dPScoreAfterUpsertHooks, dPScoreHook)
+		dPScoreAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single dPScore record from the query.
+func (q dPScoreQuery) One(ctx context.Context, exec boil.ContextExecutor) (*DPScore, error) {
+	o := &DPScore{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for dp_score")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all DPScore records from the query.
+func (q dPScoreQuery) All(ctx context.Context, exec boil.ContextExecutor) (DPScoreSlice, error) {
+	var o []*DPScore
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to DPScore slice")
+	}
+
+	if len(dPScoreAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all DPScore records in the query.
+func (q dPScoreQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count dp_score rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q dPScoreQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if dp_score exists")
+	}
+
+	return count > 0, nil
+}
+
+// DPScores retrieves all the records using an executor.
+func DPScores(mods ...qm.QueryMod) dPScoreQuery {
+	mods = append(mods, qm.From("\"dp_score\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"dp_score\".*"})
+	}
+
+	return dPScoreQuery{q}
+}
+
+// FindDPScore retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDPScore(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*DPScore, error) {
+	dPScoreObj := &DPScore{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"dp_score\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, dPScoreObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from dp_score")
+	}
+
+	if err = dPScoreObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return dPScoreObj, err
+	}
+
+	return dPScoreObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *DPScore) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no dp_score provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dPScoreColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	dPScoreInsertCacheMut.RLock()
+	cache, cached := dPScoreInsertCache[key]
+	dPScoreInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			dPScoreAllColumns,
+			dPScoreColumnsWithDefault,
+			dPScoreColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(dPScoreType, dPScoreMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(dPScoreType, dPScoreMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"dp_score\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"dp_score\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into dp_score")
+	}
+
+	if !cached {
+		dPScoreInsertCacheMut.Lock()
+		dPScoreInsertCache[key] = cache
+		dPScoreInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the DPScore.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *DPScore) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	dPScoreUpdateCacheMut.RLock()
+	cache, cached := dPScoreUpdateCache[key]
+	dPScoreUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			dPScoreAllColumns,
+			dPScorePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update dp_score, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"dp_score\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, dPScorePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(dPScoreType, dPScoreMapping, append(wl, dPScorePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update dp_score row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for dp_score")
+	}
+
+	if !cached {
+		dPScoreUpdateCacheMut.Lock()
+		dPScoreUpdateCache[key] = cache
+		dPScoreUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q dPScoreQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for dp_score")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for dp_score")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DPScoreSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dPScorePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"dp_score\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, dPScorePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in dPScore slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all dPScore")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *DPScore) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no dp_score provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dPScoreColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	dPScoreUpsertCacheMut.RLock()
+	cache, cached := dPScoreUpsertCache[key]
+	dPScoreUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			dPScoreAllColumns,
+			dPScoreColumnsWithDefault,
+			dPScoreColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			dPScoreAllColumns,
+			dPScorePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert dp_score, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(dPScoreAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(dPScorePrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert dp_score, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(dPScorePrimaryKeyColumns))
+			copy(conflict, dPScorePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"dp_score\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(dPScoreType, dPScoreMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(dPScoreType, dPScoreMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert dp_score")
+	}
+
+	if !cached {
+		dPScoreUpsertCacheMut.Lock()
+		dPScoreUpsertCache[key] = cache
+		dPScoreUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single DPScore record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *DPScore) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no DPScore provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), dPScorePrimaryKeyMapping)
+	sql := "DELETE FROM \"dp_score\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from dp_score")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for dp_score")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q dPScoreQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no dPScoreQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from dp_score")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for dp_score")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DPScoreSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(dPScoreBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dPScorePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"dp_score\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, dPScorePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from dPScore slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for dp_score")
+	}
+
+	if len(dPScoreAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *DPScore) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDPScore(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DPScoreSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DPScoreSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dPScorePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"dp_score\".* FROM \"dp_score\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, dPScorePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in DPScoreSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DPScoreExists checks if the DPScore row exists.
+func DPScoreExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"dp_score\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if dp_score exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the DPScore row exists.
+func (o *DPScore) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return DPScoreExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testDPScores(t *testing.T) {
+	t.Parallel()
+
+	query := DPScores()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testDPScoresDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPScore{}
+	if err = randomize.Struct(seed, o, dPScoreDBTypes, true, dPScoreColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPScore struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := DPScores().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testDPScoresQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPScore{}
+	if err = randomize.Struct(seed, o, dPScoreDBTypes, true, dPScoreColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPScore struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := DPScores().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := DPScores().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testDPScoresSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPScore{}
+	if err = randomize.Struct(seed, o, dPScoreDBTypes, true, dPScoreColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPScore struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := DPScoreSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := DPScores().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testDPScoresExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPScore{}
+	if err = randomize.Struct(seed, o, dPScoreDBTypes, true, dPScoreColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPScore struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := DPScoreExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if DPScore exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected DPScoreExists to return true, but got false.")
+	}
+}
+
+func testDPScoresFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPScore{}
+	if err = randomize.Struct(seed, o, dPScoreDBTypes, true, dPScoreColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPScore struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	dPScoreFound, err := FindDPScore(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if dPScoreFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testDPScoresBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPScore{}
+	if err = randomize.Struct(seed, o, dPScoreDBTypes, true, dPScoreColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPScore struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = DPScores().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testDPScoresOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPScore{}
+	if err = randomize.Struct(seed, o, dPScoreDBTypes, true, dPScoreColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPScore struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := DPScores().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testDPScoresAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	dPScoreOne := &DPScore{}
+	dPScoreTwo := &DPScore{}
+	if err = randomize.Struct(seed, dPScoreOne, dPScoreDBTypes, false, dPScoreColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPScore struct: %s", err)
+	}
+	if err = randomize.Struct(seed, dPScoreTwo, dPScoreDBTypes, false, dPScoreColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPScore struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = dPScoreOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = dPScoreTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := DPScores().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testDPScoresCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	dPScoreOne := &DPScore{}
+	dPScoreTwo := &DPScore{}
+	if err = randomize.Struct(seed, dPScoreOne, dPScoreDBTypes, false, dPScoreColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPScore struct: %s", err)
+	}
+	if err = randomize.Struct(seed, dPScoreTwo, dPScoreDBTypes, false, dPScoreColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPScore struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = dPScoreOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = dPScoreTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DPScores().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func dPScoreBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *DPScore) error {
+	*o = DPScore{}
+	return nil
+}
+
+func dPScoreAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *DPScore) error {
+	*o = DPScore{}
+	return nil
+}
+
+func dPScoreAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *DPScore) error {
+	*o = DPScore{}
+	return nil
+}
+
+func dPScoreBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *DPScore) error {
+	*o = DPScore{}
+	return nil
+}
+
+func dPScoreAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *DPScore) error {
+	*o = DPScore{}
+	return nil
+}
+
+func dPScoreBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *DPScore) error {
+	*o = DPScore{}
+	return nil
+}
+
+func dPScoreAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *DPScore) error {
+	*o = DPScore{}
+	return nil
+}
+
+func dPScoreBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *DPScore) error {
+	*o = DPScore{}
+	return nil
+}
+
+func dPScoreAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *DPScore) error {
+	*o = DPScore{}
+	return nil
+}
+
+func testDPScoresHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &DPScore{}
+	o := &DPScore{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, dPScoreDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize DPScore object: %s", err)
+	}
+
+	AddDPScoreHook(boil.BeforeInsertHook, dPScoreBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	dPScoreBeforeInsertHooks = []DPScoreHook{}
+
+	AddDPScoreHook(boil.AfterInsertHook, dPScoreAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	dPScoreAfterInsertHooks = []DPScoreHook{}
+
+	AddDPScoreHook(boil.AfterSelectHook, dPScoreAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	dPScoreAfterSelectHooks = []DPScoreHook{}
+
+	AddDPScoreHook(boil.BeforeUpdateHook, dPScoreBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	dPScoreBeforeUpdateHooks = []DPScoreHook{}
+
+	AddDPScoreHook(boil.AfterUpdateHook, dPScoreAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	dPScoreAfterUpdateHooks = []DPScoreHook{}
+
+	AddDPScoreHook(boil.BeforeDeleteHook, dPScoreBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	dPScoreBeforeDeleteHooks = []DPScoreHook{}
+
+	AddDPScoreHook(boil.AfterDeleteHook, dPScoreAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	dPScoreAfterDeleteHooks = []DPScoreHook{}
+
+	AddDPScoreHook(boil.BeforeUpsertHook, dPScoreBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	dPScoreBeforeUpsertHooks = []DPScoreHook{}
+
+	AddDPScoreHook(boil.AfterUpsertHook, dPScoreAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	dPScoreAfterUpsertHooks = []DPScoreHook{}
+}
+
+func testDPScoresInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPScore{}
+	if err = randomize.Struct(seed, o, dPScoreDBTypes, true, dPScoreColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPScore struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DPScores().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testDPScoresInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPScore{}
+	if err = randomize.Struct(seed, o, dPScoreDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize DPScore struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(dPScoreColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DPScores().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testDPScoresReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPScore{}
+	if err = randomize.Struct(seed, o, dPScoreDBTypes, true, dPScoreColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPScore struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testDPScoresReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPScore{}
+	if err = randomize.Struct(seed, o, dPScoreDBTypes, true, dPScoreColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPScore struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := DPScoreSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testDPScoresSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPScore{}
+	if err = randomize.Struct(seed, o, dPScoreDBTypes, true, dPScoreColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPScore struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := DPScores().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	dPScoreDBTypes = map[string]string{`ID`: `integer`, `DemandPartnerID`: `character varying`, `Country`: `character varying`, `DeviceType`: `character varying`, `Score`: `integer`, `Components`: `jsonb`, `CalculatedAt`: `timestamp without time zone`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_              = bytes.MinRead
+)
+
+func testDPScoresUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(dPScorePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(dPScoreAllColumns) == len(dPScorePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPScore{}
+	if err = randomize.Struct(seed, o, dPScoreDBTypes, true, dPScoreColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPScore struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DPScores().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, dPScoreDBTypes, true, dPScorePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize DPScore struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testDPScoresSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(dPScoreAllColumns) == len(dPScorePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DPScore{}
+	if err = randomize.Struct(seed, o, dPScoreDBTypes, true, dPScoreColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DPScore struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DPScores().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, dPScoreDBTypes, true, dPScorePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize DPScore struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(dPScoreAllColumns, dPScorePrimaryKeyColumns) {
+		fields = dPScoreAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			dPScoreAllColumns,
+			dPScorePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := DPScoreSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testDPScoresUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(dPScoreAllColumns) == len(dPScorePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := DPScore{}
+	if err = randomize.Struct(seed, &o, dPScoreDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize DPScore struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert DPScore: %s", err)
+	}
+
+	count, err := DPScores().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, dPScoreDBTypes, false, dPScorePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize DPScore struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert DPScore: %s", err)
+	}
+
+	count, err = DPScores().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -45,6 +45,7 @@ type Dpo struct {
 	Threshold               null.Float64      `boil:"threshold" json:"threshold,omitempty" toml:"threshold" yaml:"threshold,omitempty"`
 	Automation              bool              `boil:"automation" json:"automation" toml:"automation" yaml:"automation"`
 	IntegrationType         types.StringArray `boil:"integration_type" json:"integration_type,omitempty" toml:"integration_type" yaml:"integration_type,omitempty"`
+	AutomationConfig        null.JSON         `boil:"automation_config" json:"automation_config,omitempty" toml:"automation_config" yaml:"automation_config,omitempty"`
 
 	R *dpoR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L dpoL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -71,6 +72,7 @@ var DpoColumns = struct {
 	Threshold               string
 	Automation              string
 	IntegrationType         string
+	AutomationConfig        string
 }{
 	DemandPartnerID:         "demand_partner_id",
 	IsInclude:               "is_include",
@@ -92,6 +94,7 @@ var DpoColumns = struct {
 	Threshold:               "threshold",
 	Automation:              "automation",
 	IntegrationType:         "integration_type",
+	AutomationConfig:        "automation_config",
 }
 
 var DpoTableColumns = struct {
@@ -115,6 +118,7 @@ var DpoTableColumns = struct {
 	Threshold               string
 	Automation              string
 	IntegrationType         string
+	AutomationConfig        string
 }{
 	DemandPartnerID:         "dpo.demand_partner_id",
 	IsInclude:               "dpo.is_include",
@@ -136,6 +140,7 @@ var DpoTableColumns = struct {
 	Threshold:               "dpo.threshold",
 	Automation:              "dpo.automation",
 	IntegrationType:         "dpo.integration_type",
+	AutomationConfig:        "dpo.automation_config",
 }
 
 // Generated where
@@ -161,6 +166,7 @@ var DpoWhere = struct {
 	Threshold               whereHelpernull_Float64
 	Automation              whereHelperbool
 	IntegrationType         whereHelpertypes_StringArray
+	AutomationConfig        whereHelpernull_JSON
 }{
 	DemandPartnerID:         whereHelperstring{field: "\"dpo\".\"demand_partner_id\""},
 	IsInclude:               whereHelperbool{field: "\"dpo\".\"is_include\""},
@@ -182,6 +188,7 @@ var DpoWhere = struct {
 	Threshold:               whereHelpernull_Float64{field: "\"dpo\".\"threshold\""},
 	Automation:              whereHelperbool{field: "\"dpo\".\"automation\""},
 	IntegrationType:         whereHelpertypes_StringArray{field: "\"dpo\".\"integration_type\""},
+	AutomationConfig:        whereHelpernull_JSON{field: "\"dpo\".\"automation_config\""},
 }
 
 // DpoRels is where relationship names are stored.
@@ -252,9 +259,9 @@ func (r *dpoR) GetDemandPartnerPublisherDemands() PublisherDemandSlice {
 type dpoL struct{}
 
 var (
-	dpoAllColumns            = []string{"demand_partner_id", "is_include", "created_at", "updated_at", "demand_partner_name", "active", "seat_owner_id", "manager_id", "is_approval_needed", "score", "approval_process", "comments", "approval_before_going_live", "dp_blocks", "poc_name", "poc_email", "automation_name", "threshold", "automation", "integration_type"}
+	dpoAllColumns            = []string{"demand_partner_id", "is_include", "created_at", "updated_at", "demand_partner_name", "active", "seat_owner_id", "manager_id", "is_approval_needed", "score", "approval_process", "comments", "approval_before_going_live", "dp_blocks", "poc_name", "poc_email", "automation_name", "threshold", "automation", "integration_type", "automation_config"}
 	dpoColumnsWithoutDefault = []string{"demand_partner_id", "created_at", "demand_partner_name"}
-	dpoColumnsWithDefault    = []string{"is_include", "updated_at", "active", "seat_owner_id", "manager_id", "is_approval_needed", "score", "approval_process", "comments", "approval_before_going_live", "dp_blocks", "poc_name", "poc_email", "automation_name", "threshold", "automation", "integration_type"}
+	dpoColumnsWithDefault    = []string{"is_include", "updated_at", "active", "seat_owner_id", "manager_id", "is_approval_needed", "score", "approval_process", "comments", "approval_before_going_live", "dp_blocks", "poc_name", "poc_email", "automation_name", "threshold", "automation", "integration_type", "automation_config"}
 	dpoPrimaryKeyColumns     = []string{"demand_partner_id"}
 	dpoGeneratedColumns      = []string{}
 )
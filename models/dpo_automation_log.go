@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -23,19 +24,20 @@ import (
 
 // DpoAutomationLog is an object representing the database table.
 type DpoAutomationLog struct {
-	Time       time.Time `boil:"time" json:"time" toml:"time" yaml:"time"`
-	EvalTime   time.Time `boil:"eval_time" json:"eval_time" toml:"eval_time" yaml:"eval_time"`
-	Domain     string    `boil:"domain" json:"domain" toml:"domain" yaml:"domain"`
-	Publisher  string    `boil:"publisher" json:"publisher" toml:"publisher" yaml:"publisher"`
-	Os         string    `boil:"os" json:"os" toml:"os" yaml:"os"`
-	Country    string    `boil:"country" json:"country" toml:"country" yaml:"country"`
-	DP         string    `boil:"dp" json:"dp" toml:"dp" yaml:"dp"`
-	BidRequest int       `boil:"bid_request" json:"bid_request" toml:"bid_request" yaml:"bid_request"`
-	Revenue    float64   `boil:"revenue" json:"revenue" toml:"revenue" yaml:"revenue"`
-	Erpm       float64   `boil:"erpm" json:"erpm" toml:"erpm" yaml:"erpm"`
-	OldFactor  float64   `boil:"old_factor" json:"old_factor" toml:"old_factor" yaml:"old_factor"`
-	NewFactor  float64   `boil:"new_factor" json:"new_factor" toml:"new_factor" yaml:"new_factor"`
-	RespStatus int       `boil:"resp_status" json:"resp_status" toml:"resp_status" yaml:"resp_status"`
+	Time       time.Time   `boil:"time" json:"time" toml:"time" yaml:"time"`
+	EvalTime   time.Time   `boil:"eval_time" json:"eval_time" toml:"eval_time" yaml:"eval_time"`
+	Domain     string      `boil:"domain" json:"domain" toml:"domain" yaml:"domain"`
+	Publisher  string      `boil:"publisher" json:"publisher" toml:"publisher" yaml:"publisher"`
+	Os         string      `boil:"os" json:"os" toml:"os" yaml:"os"`
+	Country    string      `boil:"country" json:"country" toml:"country" yaml:"country"`
+	DP         string      `boil:"dp" json:"dp" toml:"dp" yaml:"dp"`
+	BidRequest int         `boil:"bid_request" json:"bid_request" toml:"bid_request" yaml:"bid_request"`
+	Revenue    float64     `boil:"revenue" json:"revenue" toml:"revenue" yaml:"revenue"`
+	Erpm       float64     `boil:"erpm" json:"erpm" toml:"erpm" yaml:"erpm"`
+	OldFactor  float64     `boil:"old_factor" json:"old_factor" toml:"old_factor" yaml:"old_factor"`
+	NewFactor  float64     `boil:"new_factor" json:"new_factor" toml:"new_factor" yaml:"new_factor"`
+	RespStatus int         `boil:"resp_status" json:"resp_status" toml:"resp_status" yaml:"resp_status"`
+	Reason     null.String `boil:"reason" json:"reason,omitempty" toml:"reason" yaml:"reason,omitempty"`
 
 	R *dpoAutomationLogR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L dpoAutomationLogL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -55,6 +57,7 @@ var DpoAutomationLogColumns = struct {
 	OldFactor  string
 	NewFactor  string
 	RespStatus string
+	Reason     string
 }{
 	Time:       "time",
 	EvalTime:   "eval_time",
@@ -69,6 +72,7 @@ var DpoAutomationLogColumns = struct {
 	OldFactor:  "old_factor",
 	NewFactor:  "new_factor",
 	RespStatus: "resp_status",
+	Reason:     "reason",
 }
 
 var DpoAutomationLogTableColumns = struct {
@@ -85,6 +89,7 @@ var DpoAutomationLogTableColumns = struct {
 	OldFactor  string
 	NewFactor  string
 	RespStatus string
+	Reason     string
 }{
 	Time:       "dpo_automation_log.time",
 	EvalTime:   "dpo_automation_log.eval_time",
@@ -99,6 +104,7 @@ var DpoAutomationLogTableColumns = struct {
 	OldFactor:  "dpo_automation_log.old_factor",
 	NewFactor:  "dpo_automation_log.new_factor",
 	RespStatus: "dpo_automation_log.resp_status",
+	Reason:     "dpo_automation_log.reason",
 }
 
 // Generated where
@@ -117,6 +123,7 @@ var DpoAutomationLogWhere = struct {
 	OldFactor  whereHelperfloat64
 	NewFactor  whereHelperfloat64
 	RespStatus whereHelperint
+	Reason     whereHelpernull_String
 }{
 	Time:       whereHelpertime_Time{field: "\"dpo_automation_log\".\"time\""},
 	EvalTime:   whereHelpertime_Time{field: "\"dpo_automation_log\".\"eval_time\""},
@@ -131,6 +138,7 @@ var DpoAutomationLogWhere = struct {
 	OldFactor:  whereHelperfloat64{field: "\"dpo_automation_log\".\"old_factor\""},
 	NewFactor:  whereHelperfloat64{field: "\"dpo_automation_log\".\"new_factor\""},
 	RespStatus: whereHelperint{field: "\"dpo_automation_log\".\"resp_status\""},
+	Reason:     whereHelpernull_String{field: "\"dpo_automation_log\".\"reason\""},
 }
 
 // DpoAutomationLogRels is where relationship names are stored.
@@ -150,9 +158,9 @@ func (*dpoAutomationLogR) NewStruct() *dpoAutomationLogR {
 type dpoAutomationLogL struct{}
 
 var (
-	dpoAutomationLogAllColumns            = []string{"time", "eval_time", "domain", "publisher", "os", "country", "dp", "bid_request", "revenue", "erpm", "old_factor", "new_factor", "resp_status"}
+	dpoAutomationLogAllColumns            = []string{"time", "eval_time", "domain", "publisher", "os", "country", "dp", "bid_request", "revenue", "erpm", "old_factor", "new_factor", "resp_status", "reason"}
 	dpoAutomationLogColumnsWithoutDefault = []string{"time", "eval_time", "domain", "publisher", "os", "country", "dp", "bid_request", "revenue", "erpm", "old_factor", "new_factor", "resp_status"}
-	dpoAutomationLogColumnsWithDefault    = []string{}
+	dpoAutomationLogColumnsWithDefault    = []string{"reason"}
 	dpoAutomationLogPrimaryKeyColumns     = []string{"time", "dp", "country", "publisher", "domain", "os"}
 	dpoAutomationLogGeneratedColumns      = []string{}
 )
@@ -568,7 +568,7 @@ func testDpoAutomationLogsSelect(t *testing.T) {
 }
 
 var (
-	dpoAutomationLogDBTypes = map[string]string{`Time`: `timestamp without time zone`, `EvalTime`: `timestamp without time zone`, `Domain`: `character varying`, `Publisher`: `character varying`, `Os`: `character varying`, `Country`: `character varying`, `DP`: `character varying`, `BidRequest`: `integer`, `Revenue`: `double precision`, `Erpm`: `double precision`, `OldFactor`: `double precision`, `NewFactor`: `double precision`, `RespStatus`: `integer`}
+	dpoAutomationLogDBTypes = map[string]string{`Time`: `timestamp without time zone`, `EvalTime`: `timestamp without time zone`, `Domain`: `character varying`, `Publisher`: `character varying`, `Os`: `character varying`, `Country`: `character varying`, `DP`: `character varying`, `BidRequest`: `integer`, `Revenue`: `double precision`, `Erpm`: `double precision`, `OldFactor`: `double precision`, `NewFactor`: `double precision`, `RespStatus`: `integer`, `Reason`: `character varying`}
 	_                       = bytes.MinRead
 )
 
@@ -37,6 +37,8 @@ type History struct {
 	Domain          null.String `boil:"domain" json:"domain,omitempty" toml:"domain" yaml:"domain,omitempty"`
 	EntityID        null.String `boil:"entity_id" json:"entity_id,omitempty" toml:"entity_id" yaml:"entity_id,omitempty"`
 	DemandPartnerID null.String `boil:"demand_partner_id" json:"demand_partner_id,omitempty" toml:"demand_partner_id" yaml:"demand_partner_id,omitempty"`
+	ApproverID      null.Int    `boil:"approver_id" json:"approver_id,omitempty" toml:"approver_id" yaml:"approver_id,omitempty"`
+	APIKeyID        null.Int    `boil:"api_key_id" json:"api_key_id,omitempty" toml:"api_key_id" yaml:"api_key_id,omitempty"`
 
 	R *historyR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L historyL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -56,6 +58,8 @@ var HistoryColumns = struct {
 	Domain          string
 	EntityID        string
 	DemandPartnerID string
+	ApproverID      string
+	APIKeyID        string
 }{
 	ID:              "id",
 	UserID:          "user_id",
@@ -70,6 +74,8 @@ var HistoryColumns = struct {
 	Domain:          "domain",
 	EntityID:        "entity_id",
 	DemandPartnerID: "demand_partner_id",
+	ApproverID:      "approver_id",
+	APIKeyID:        "api_key_id",
 }
 
 var HistoryTableColumns = struct {
@@ -86,6 +92,8 @@ var HistoryTableColumns = struct {
 	Domain          string
 	EntityID        string
 	DemandPartnerID string
+	ApproverID      string
+	APIKeyID        string
 }{
 	ID:              "history.id",
 	UserID:          "history.user_id",
@@ -100,6 +108,8 @@ var HistoryTableColumns = struct {
 	Domain:          "history.domain",
 	EntityID:        "history.entity_id",
 	DemandPartnerID: "history.demand_partner_id",
+	ApproverID:      "history.approver_id",
+	APIKeyID:        "history.api_key_id",
 }
 
 // Generated where
@@ -142,6 +152,8 @@ var HistoryWhere = struct {
 	Domain          whereHelpernull_String
 	EntityID        whereHelpernull_String
 	DemandPartnerID whereHelpernull_String
+	ApproverID      whereHelpernull_Int
+	APIKeyID        whereHelpernull_Int
 }{
 	ID:              whereHelperint{field: "\"history\".\"id\""},
 	UserID:          whereHelperint{field: "\"history\".\"user_id\""},
@@ -156,6 +168,8 @@ var HistoryWhere = struct {
 	Domain:          whereHelpernull_String{field: "\"history\".\"domain\""},
 	EntityID:        whereHelpernull_String{field: "\"history\".\"entity_id\""},
 	DemandPartnerID: whereHelpernull_String{field: "\"history\".\"demand_partner_id\""},
+	ApproverID:      whereHelpernull_Int{field: "\"history\".\"approver_id\""},
+	APIKeyID:        whereHelpernull_Int{field: "\"history\".\"api_key_id\""},
 }
 
 // HistoryRels is where relationship names are stored.
@@ -175,9 +189,9 @@ func (*historyR) NewStruct() *historyR {
 type historyL struct{}
 
 var (
-	historyAllColumns            = []string{"id", "user_id", "subject", "item", "action", "old_value", "new_value", "changes", "date", "publisher_id", "domain", "entity_id", "demand_partner_id"}
+	historyAllColumns            = []string{"id", "user_id", "subject", "item", "action", "old_value", "new_value", "changes", "date", "publisher_id", "domain", "entity_id", "demand_partner_id", "approver_id", "api_key_id"}
 	historyColumnsWithoutDefault = []string{"user_id", "subject", "item", "action", "date"}
-	historyColumnsWithDefault    = []string{"id", "old_value", "new_value", "changes", "publisher_id", "domain", "entity_id", "demand_partner_id"}
+	historyColumnsWithDefault    = []string{"id", "old_value", "new_value", "changes", "publisher_id", "domain", "entity_id", "demand_partner_id", "approver_id", "api_key_id"}
 	historyPrimaryKeyColumns     = []string{"id"}
 	historyGeneratedColumns      = []string{}
 )
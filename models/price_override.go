@@ -0,0 +1,995 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// PriceOverride is an object representing the database table.
+type PriceOverride struct {
+	ID        int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Domain    string    `boil:"domain" json:"domain" toml:"domain" yaml:"domain"`
+	IP        string    `boil:"ip" json:"ip" toml:"ip" yaml:"ip"`
+	Price     float64   `boil:"price" json:"price" toml:"price" yaml:"price"`
+	ExpiresAt time.Time `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+	CreatedBy null.Int  `boil:"created_by" json:"created_by,omitempty" toml:"created_by" yaml:"created_by,omitempty"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt null.Time `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *priceOverrideR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L priceOverrideL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PriceOverrideColumns = struct {
+	ID        string
+	Domain    string
+	IP        string
+	Price     string
+	ExpiresAt string
+	CreatedBy string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "id",
+	Domain:    "domain",
+	IP:        "ip",
+	Price:     "price",
+	ExpiresAt: "expires_at",
+	CreatedBy: "created_by",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+}
+
+var PriceOverrideTableColumns = struct {
+	ID        string
+	Domain    string
+	IP        string
+	Price     string
+	ExpiresAt string
+	CreatedBy string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "price_override.id",
+	Domain:    "price_override.domain",
+	IP:        "price_override.ip",
+	Price:     "price_override.price",
+	ExpiresAt: "price_override.expires_at",
+	CreatedBy: "price_override.created_by",
+	CreatedAt: "price_override.created_at",
+	UpdatedAt: "price_override.updated_at",
+}
+
+// Generated where
+
+var PriceOverrideWhere = struct {
+	ID        whereHelperint
+	Domain    whereHelperstring
+	IP        whereHelperstring
+	Price     whereHelperfloat64
+	ExpiresAt whereHelpertime_Time
+	CreatedBy whereHelpernull_Int
+	CreatedAt whereHelpertime_Time
+	UpdatedAt whereHelpernull_Time
+}{
+	ID:        whereHelperint{field: "\"price_override\".\"id\""},
+	Domain:    whereHelperstring{field: "\"price_override\".\"domain\""},
+	IP:        whereHelperstring{field: "\"price_override\".\"ip\""},
+	Price:     whereHelperfloat64{field: "\"price_override\".\"price\""},
+	ExpiresAt: whereHelpertime_Time{field: "\"price_override\".\"expires_at\""},
+	CreatedBy: whereHelpernull_Int{field: "\"price_override\".\"created_by\""},
+	CreatedAt: whereHelpertime_Time{field: "\"price_override\".\"created_at\""},
+	UpdatedAt: whereHelpernull_Time{field: "\"price_override\".\"updated_at\""},
+}
+
+// PriceOverrideRels is where relationship names are stored.
+var PriceOverrideRels = struct {
+}{}
+
+// priceOverrideR is where relationships are stored.
+type priceOverrideR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*priceOverrideR) NewStruct() *priceOverrideR {
+	return &priceOverrideR{}
+}
+
+// priceOverrideL is where Load methods for each relationship are stored.
+type priceOverrideL struct{}
+
+var (
+	priceOverrideAllColumns            = []string{"id", "domain", "ip", "price", "expires_at", "created_by", "created_at", "updated_at"}
+	priceOverrideColumnsWithoutDefault = []string{"domain", "ip", "price", "expires_at", "created_at"}
+	priceOverrideColumnsWithDefault    = []string{"id", "created_by", "updated_at"}
+	priceOverridePrimaryKeyColumns     = []string{"id"}
+	priceOverrideGeneratedColumns      = []string{}
+)
+
+type (
+	// PriceOverrideSlice is an alias for a slice of pointers to PriceOverride.
+	// This should almost always be used instead of []PriceOverride.
+	PriceOverrideSlice []*PriceOverride
+	// PriceOverrideHook is the signature for custom PriceOverride hook methods
+	PriceOverrideHook func(context.Context, boil.ContextExecutor, *PriceOverride) error
+
+	priceOverrideQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	priceOverrideType                 = reflect.TypeOf(&PriceOverride{})
+	priceOverrideMapping              = queries.MakeStructMapping(priceOverrideType)
+	priceOverridePrimaryKeyMapping, _ = queries.BindMapping(priceOverrideType, priceOverrideMapping, priceOverridePrimaryKeyColumns)
+	priceOverrideInsertCacheMut       sync.RWMutex
+	priceOverrideInsertCache          = make(map[string]insertCache)
+	priceOverrideUpdateCacheMut       sync.RWMutex
+	priceOverrideUpdateCache          = make(map[string]updateCache)
+	priceOverrideUpsertCacheMut       sync.RWMutex
+	priceOverrideUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var priceOverrideAfterSelectMu sync.Mutex
+var priceOverrideAfterSelectHooks []PriceOverrideHook
+
+var priceOverrideBeforeInsertMu sync.Mutex
+var priceOverrideBeforeInsertHooks []PriceOverrideHook
+var priceOverrideAfterInsertMu sync.Mutex
+var priceOverrideAfterInsertHooks []PriceOverrideHook
+
+var priceOverrideBeforeUpdateMu sync.Mutex
+var priceOverrideBeforeUpdateHooks []PriceOverrideHook
+var priceOverrideAfterUpdateMu sync.Mutex
+var priceOverrideAfterUpdateHooks []PriceOverrideHook
+
+var priceOverrideBeforeDeleteMu sync.Mutex
+var priceOverrideBeforeDeleteHooks []PriceOverrideHook
+var priceOverrideAfterDeleteMu sync.Mutex
+var priceOverrideAfterDeleteHooks []PriceOverrideHook
+
+var priceOverrideBeforeUpsertMu sync.Mutex
+var priceOverrideBeforeUpsertHooks []PriceOverrideHook
+var priceOverrideAfterUpsertMu sync.Mutex
+var priceOverrideAfterUpsertHooks []PriceOverrideHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *PriceOverride) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range priceOverrideAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *PriceOverride) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range priceOverrideBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *PriceOverride) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range priceOverrideAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *PriceOverride) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range priceOverrideBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *PriceOverride) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range priceOverrideAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *PriceOverride) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range priceOverrideBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *PriceOverride) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range priceOverrideAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *PriceOverride) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range priceOverrideBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *PriceOverride) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range priceOverrideAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddPriceOverrideHook registers your hook function for all future operations.
+func AddPriceOverrideHook(hookPoint boil.HookPoint, priceOverrideHook PriceOverrideHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		priceOverrideAfterSelectMu.Lock()
+		priceOverrideAfterSelectHooks = append(priceOverrideAfterSelectHooks, priceOverrideHook)
+		priceOverrideAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		priceOverrideBeforeInsertMu.Lock()
+		priceOverrideBeforeInsertHooks = append(priceOverrideBeforeInsertHooks, priceOverrideHook)
+		priceOverrideBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		priceOverrideAfterInsertMu.Lock()
+		priceOverrideAfterInsertHooks = append(priceOverrideAfterInsertHooks, priceOverrideHook)
+		priceOverrideAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		priceOverrideBeforeUpdateMu.Lock()
+		priceOverrideBeforeUpdateHooks = append(priceOverrideBeforeUpdateHooks, priceOverrideHook)
+		priceOverrideBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		priceOverrideAfterUpdateMu.Lock()
+		priceOverrideAfterUpdateHooks = append(priceOverrideAfterUpdateHooks, priceOverrideHook)
+		priceOverrideAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		priceOverrideBeforeDeleteMu.Lock()
+		priceOverrideBeforeDeleteHooks = append(priceOverrideBeforeDeleteHooks, priceOverrideHook)
+		priceOverrideBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		priceOverrideAfterDeleteMu.Lock()
+		priceOverrideAfterDeleteHooks = append(priceOverrideAfterDeleteHooks, priceOverrideHook)
+		priceOverrideAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		priceOverrideBeforeUpsertMu.Lock()
+		priceOverrideBeforeUpsertHooks = append(priceOverrideBeforeUpsertHooks, priceOverrideHook)
+		priceOverrideBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		priceOverrideAfterUpsertMu.Lock()
+		priceOverrideAfterUpsertHooks = append(priceOverrideAfterUpsertHooks, priceOverrideHook)
+		priceOverrideAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single priceOverride record from the query.
+func (q priceOverrideQuery) One(ctx context.Context, exec boil.ContextExecutor) (*PriceOverride, error) {
+	o := &PriceOverride{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for price_override")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all PriceOverride records from the query.
+func (q priceOverrideQuery) All(ctx context.Context, exec boil.ContextExecutor) (PriceOverrideSlice, error) {
+	var o []*PriceOverride
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to PriceOverride slice")
+	}
+
+	if len(priceOverrideAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all PriceOverride records in the query.
+func (q priceOverrideQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count price_override rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q priceOverrideQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if price_override exists")
+	}
+
+	return count > 0, nil
+}
+
+// PriceOverrides retrieves all the records using an executor.
+func PriceOverrides(mods ...qm.QueryMod) priceOverrideQuery {
+	mods = append(mods, qm.From("\"price_override\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"price_override\".*"})
+	}
+
+	return priceOverrideQuery{q}
+}
+
+// FindPriceOverride retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPriceOverride(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*PriceOverride, error) {
+	priceOverrideObj := &PriceOverride{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"price_override\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, priceOverrideObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from price_override")
+	}
+
+	if err = priceOverrideObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return priceOverrideObj, err
+	}
+
+	return priceOverrideObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *PriceOverride) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no price_override provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(priceOverrideColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	priceOverrideInsertCacheMut.RLock()
+	cache, cached := priceOverrideInsertCache[key]
+	priceOverrideInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			priceOverrideAllColumns,
+			priceOverrideColumnsWithDefault,
+			priceOverrideColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(priceOverrideType, priceOverrideMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(priceOverrideType, priceOverrideMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"price_override\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"price_override\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into price_override")
+	}
+
+	if !cached {
+		priceOverrideInsertCacheMut.Lock()
+		priceOverrideInsertCache[key] = cache
+		priceOverrideInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the PriceOverride.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *PriceOverride) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	priceOverrideUpdateCacheMut.RLock()
+	cache, cached := priceOverrideUpdateCache[key]
+	priceOverrideUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			priceOverrideAllColumns,
+			priceOverridePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update price_override, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"price_override\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, priceOverridePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(priceOverrideType, priceOverrideMapping, append(wl, priceOverridePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update price_override row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for price_override")
+	}
+
+	if !cached {
+		priceOverrideUpdateCacheMut.Lock()
+		priceOverrideUpdateCache[key] = cache
+		priceOverrideUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q priceOverrideQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for price_override")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for price_override")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PriceOverrideSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), priceOverridePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"price_override\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, priceOverridePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in priceOverride slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all priceOverride")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *PriceOverride) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no price_override provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(priceOverrideColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	priceOverrideUpsertCacheMut.RLock()
+	cache, cached := priceOverrideUpsertCache[key]
+	priceOverrideUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			priceOverrideAllColumns,
+			priceOverrideColumnsWithDefault,
+			priceOverrideColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			priceOverrideAllColumns,
+			priceOverridePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert price_override, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(priceOverrideAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(priceOverridePrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert price_override, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(priceOverridePrimaryKeyColumns))
+			copy(conflict, priceOverridePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"price_override\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(priceOverrideType, priceOverrideMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(priceOverrideType, priceOverrideMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert price_override")
+	}
+
+	if !cached {
+		priceOverrideUpsertCacheMut.Lock()
+		priceOverrideUpsertCache[key] = cache
+		priceOverrideUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single PriceOverride record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *PriceOverride) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no PriceOverride provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), priceOverridePrimaryKeyMapping)
+	sql := "DELETE FROM \"price_override\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from price_override")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for price_override")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q priceOverrideQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no priceOverrideQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from price_override")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for price_override")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PriceOverrideSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(priceOverrideBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), priceOverridePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"price_override\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, priceOverridePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from priceOverride slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for price_override")
+	}
+
+	if len(priceOverrideAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *PriceOverride) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindPriceOverride(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PriceOverrideSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PriceOverrideSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), priceOverridePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"price_override\".* FROM \"price_override\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, priceOverridePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in PriceOverrideSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PriceOverrideExists checks if the PriceOverride row exists.
+func PriceOverrideExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"price_override\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if price_override exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the PriceOverride row exists.
+func (o *PriceOverride) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return PriceOverrideExists(ctx, exec, o.ID)
+}
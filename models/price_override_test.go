@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testPriceOverrides(t *testing.T) {
+	t.Parallel()
+
+	query := PriceOverrides()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testPriceOverridesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceOverride{}
+	if err = randomize.Struct(seed, o, priceOverrideDBTypes, true, priceOverrideColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceOverride struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PriceOverrides().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPriceOverridesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceOverride{}
+	if err = randomize.Struct(seed, o, priceOverrideDBTypes, true, priceOverrideColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceOverride struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := PriceOverrides().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PriceOverrides().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPriceOverridesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceOverride{}
+	if err = randomize.Struct(seed, o, priceOverrideDBTypes, true, priceOverrideColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceOverride struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PriceOverrideSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PriceOverrides().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPriceOverridesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceOverride{}
+	if err = randomize.Struct(seed, o, priceOverrideDBTypes, true, priceOverrideColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceOverride struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := PriceOverrideExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if PriceOverride exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected PriceOverrideExists to return true, but got false.")
+	}
+}
+
+func testPriceOverridesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceOverride{}
+	if err = randomize.Struct(seed, o, priceOverrideDBTypes, true, priceOverrideColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceOverride struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	priceOverrideFound, err := FindPriceOverride(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if priceOverrideFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testPriceOverridesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceOverride{}
+	if err = randomize.Struct(seed, o, priceOverrideDBTypes, true, priceOverrideColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceOverride struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = PriceOverrides().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPriceOverridesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceOverride{}
+	if err = randomize.Struct(seed, o, priceOverrideDBTypes, true, priceOverrideColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceOverride struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := PriceOverrides().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testPriceOverridesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	priceOverrideOne := &PriceOverride{}
+	priceOverrideTwo := &PriceOverride{}
+	if err = randomize.Struct(seed, priceOverrideOne, priceOverrideDBTypes, false, priceOverrideColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceOverride struct: %s", err)
+	}
+	if err = randomize.Struct(seed, priceOverrideTwo, priceOverrideDBTypes, false, priceOverrideColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceOverride struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = priceOverrideOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = priceOverrideTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PriceOverrides().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testPriceOverridesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	priceOverrideOne := &PriceOverride{}
+	priceOverrideTwo := &PriceOverride{}
+	if err = randomize.Struct(seed, priceOverrideOne, priceOverrideDBTypes, false, priceOverrideColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceOverride struct: %s", err)
+	}
+	if err = randomize.Struct(seed, priceOverrideTwo, priceOverrideDBTypes, false, priceOverrideColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceOverride struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = priceOverrideOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = priceOverrideTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PriceOverrides().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func priceOverrideBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *PriceOverride) error {
+	*o = PriceOverride{}
+	return nil
+}
+
+func priceOverrideAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *PriceOverride) error {
+	*o = PriceOverride{}
+	return nil
+}
+
+func priceOverrideAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *PriceOverride) error {
+	*o = PriceOverride{}
+	return nil
+}
+
+func priceOverrideBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *PriceOverride) error {
+	*o = PriceOverride{}
+	return nil
+}
+
+func priceOverrideAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *PriceOverride) error {
+	*o = PriceOverride{}
+	return nil
+}
+
+func priceOverrideBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *PriceOverride) error {
+	*o = PriceOverride{}
+	return nil
+}
+
+func priceOverrideAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *PriceOverride) error {
+	*o = PriceOverride{}
+	return nil
+}
+
+func priceOverrideBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *PriceOverride) error {
+	*o = PriceOverride{}
+	return nil
+}
+
+func priceOverrideAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *PriceOverride) error {
+	*o = PriceOverride{}
+	return nil
+}
+
+func testPriceOverridesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &PriceOverride{}
+	o := &PriceOverride{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, priceOverrideDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize PriceOverride object: %s", err)
+	}
+
+	AddPriceOverrideHook(boil.BeforeInsertHook, priceOverrideBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	priceOverrideBeforeInsertHooks = []PriceOverrideHook{}
+
+	AddPriceOverrideHook(boil.AfterInsertHook, priceOverrideAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	priceOverrideAfterInsertHooks = []PriceOverrideHook{}
+
+	AddPriceOverrideHook(boil.AfterSelectHook, priceOverrideAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	priceOverrideAfterSelectHooks = []PriceOverrideHook{}
+
+	AddPriceOverrideHook(boil.BeforeUpdateHook, priceOverrideBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	priceOverrideBeforeUpdateHooks = []PriceOverrideHook{}
+
+	AddPriceOverrideHook(boil.AfterUpdateHook, priceOverrideAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	priceOverrideAfterUpdateHooks = []PriceOverrideHook{}
+
+	AddPriceOverrideHook(boil.BeforeDeleteHook, priceOverrideBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	priceOverrideBeforeDeleteHooks = []PriceOverrideHook{}
+
+	AddPriceOverrideHook(boil.AfterDeleteHook, priceOverrideAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	priceOverrideAfterDeleteHooks = []PriceOverrideHook{}
+
+	AddPriceOverrideHook(boil.BeforeUpsertHook, priceOverrideBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	priceOverrideBeforeUpsertHooks = []PriceOverrideHook{}
+
+	AddPriceOverrideHook(boil.AfterUpsertHook, priceOverrideAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	priceOverrideAfterUpsertHooks = []PriceOverrideHook{}
+}
+
+func testPriceOverridesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceOverride{}
+	if err = randomize.Struct(seed, o, priceOverrideDBTypes, true, priceOverrideColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceOverride struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PriceOverrides().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPriceOverridesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceOverride{}
+	if err = randomize.Struct(seed, o, priceOverrideDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PriceOverride struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(priceOverrideColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PriceOverrides().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPriceOverridesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceOverride{}
+	if err = randomize.Struct(seed, o, priceOverrideDBTypes, true, priceOverrideColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceOverride struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPriceOverridesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceOverride{}
+	if err = randomize.Struct(seed, o, priceOverrideDBTypes, true, priceOverrideColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceOverride struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PriceOverrideSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPriceOverridesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceOverride{}
+	if err = randomize.Struct(seed, o, priceOverrideDBTypes, true, priceOverrideColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceOverride struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PriceOverrides().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	priceOverrideDBTypes = map[string]string{`ID`: `integer`, `Domain`: `character varying`, `IP`: `character varying`, `Price`: `double precision`, `ExpiresAt`: `timestamp without time zone`, `CreatedBy`: `integer`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                    = bytes.MinRead
+)
+
+func testPriceOverridesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(priceOverridePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(priceOverrideAllColumns) == len(priceOverridePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceOverride{}
+	if err = randomize.Struct(seed, o, priceOverrideDBTypes, true, priceOverrideColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceOverride struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PriceOverrides().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, priceOverrideDBTypes, true, priceOverridePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PriceOverride struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testPriceOverridesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(priceOverrideAllColumns) == len(priceOverridePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceOverride{}
+	if err = randomize.Struct(seed, o, priceOverrideDBTypes, true, priceOverrideColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceOverride struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PriceOverrides().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, priceOverrideDBTypes, true, priceOverridePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PriceOverride struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(priceOverrideAllColumns, priceOverridePrimaryKeyColumns) {
+		fields = priceOverrideAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			priceOverrideAllColumns,
+			priceOverridePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := PriceOverrideSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testPriceOverridesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(priceOverrideAllColumns) == len(priceOverridePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := PriceOverride{}
+	if err = randomize.Struct(seed, &o, priceOverrideDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PriceOverride struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PriceOverride: %s", err)
+	}
+
+	count, err := PriceOverrides().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, priceOverrideDBTypes, false, priceOverridePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PriceOverride struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PriceOverride: %s", err)
+	}
+
+	count, err = PriceOverrides().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
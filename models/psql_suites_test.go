@@ -8,8 +8,11 @@ import "testing"
 func TestUpsert(t *testing.T) {
 	t.Run("AdsTXTS", testAdsTXTSUpsert)
 
+	t.Run("ApprovalPolicies", testApprovalPoliciesUpsert)
 	t.Run("BidCachings", testBidCachingsUpsert)
 
+	t.Run("Blocks", testBlocksUpsert)
+	t.Run("ChangeRequests", testChangeRequestsUpsert)
 	t.Run("CompassPublisherTags", testCompassPublisherTagsUpsert)
 
 	t.Run("Competitors", testCompetitorsUpsert)
@@ -80,7 +83,28 @@ func TestUpsert(t *testing.T) {
 
 	t.Run("PriceFactorLogs", testPriceFactorLogsUpsert)
 
+	t.Run("APIKeys", testAPIKeysUpsert)
+	t.Run("AdsTXTScans", testAdsTXTScansUpsert)
+	t.Run("AlertEvents", testAlertEventsUpsert)
+	t.Run("AlertRules", testAlertRulesUpsert)
+	t.Run("AuditLogs", testAuditLogsUpsert)
+	t.Run("AutomationCircuitBreakers", testAutomationCircuitBreakersUpsert)
+	t.Run("CompassManagers", testCompassManagersUpsert)
+	t.Run("DPApprovalRequests", testDPApprovalRequestsUpsert)
+	t.Run("DPBidderParams", testDPBidderParamsUpsert)
+	t.Run("DPBidderSchemas", testDPBidderSchemasUpsert)
+	t.Run("DPScores", testDPScoresUpsert)
+	t.Run("DomainOnboardings", testDomainOnboardingsUpsert)
+	t.Run("PriceOverrides", testPriceOverridesUpsert)
+	t.Run("PublisherCompassSyncs", testPublisherCompassSyncsUpsert)
+	t.Run("PublisherNotificationPreferences", testPublisherNotificationPreferencesUpsert)
+	t.Run("PublisherPausedRules", testPublisherPausedRulesUpsert)
+	t.Run("PublisherStatusTransitions", testPublisherStatusTransitionsUpsert)
 	t.Run("Publishers", testPublishersUpsert)
+	t.Run("RolePermissions", testRolePermissionsUpsert)
+	t.Run("SchainCompliances", testSchainCompliancesUpsert)
+	t.Run("SellersJSONVersions", testSellersJSONVersionsUpsert)
+	t.Run("UserPublishers", testUserPublishersUpsert)
 
 	t.Run("PublisherDailies", testPublisherDailiesUpsert)
 
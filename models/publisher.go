@@ -39,6 +39,10 @@ type Publisher struct {
 	Status              null.String       `boil:"status" json:"status,omitempty" toml:"status" yaml:"status,omitempty"`
 	MediaType           types.StringArray `boil:"media_type" json:"media_type,omitempty" toml:"media_type" yaml:"media_type,omitempty"`
 	IsDirect            bool              `boil:"is_direct" json:"is_direct" toml:"is_direct" yaml:"is_direct"`
+	SellerDomain        null.String       `boil:"seller_domain" json:"seller_domain,omitempty" toml:"seller_domain" yaml:"seller_domain,omitempty"`
+	SellerType          null.String       `boil:"seller_type" json:"seller_type,omitempty" toml:"seller_type" yaml:"seller_type,omitempty"`
+	IsConfidential      bool              `boil:"is_confidential" json:"is_confidential" toml:"is_confidential" yaml:"is_confidential"`
+	StatusChangedAt     null.Time         `boil:"status_changed_at" json:"status_changed_at,omitempty" toml:"status_changed_at" yaml:"status_changed_at,omitempty"`
 
 	R *publisherR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L publisherL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -59,6 +63,10 @@ var PublisherColumns = struct {
 	Status              string
 	MediaType           string
 	IsDirect            string
+	SellerDomain        string
+	SellerType          string
+	IsConfidential      string
+	StatusChangedAt     string
 }{
 	PublisherID:         "publisher_id",
 	CreatedAt:           "created_at",
@@ -74,6 +82,10 @@ var PublisherColumns = struct {
 	Status:              "status",
 	MediaType:           "media_type",
 	IsDirect:            "is_direct",
+	SellerDomain:        "seller_domain",
+	SellerType:          "seller_type",
+	IsConfidential:      "is_confidential",
+	StatusChangedAt:     "status_changed_at",
 }
 
 var PublisherTableColumns = struct {
@@ -91,6 +103,10 @@ var PublisherTableColumns = struct {
 	Status              string
 	MediaType           string
 	IsDirect            string
+	SellerDomain        string
+	SellerType          string
+	IsConfidential      string
+	StatusChangedAt     string
 }{
 	PublisherID:         "publisher.publisher_id",
 	CreatedAt:           "publisher.created_at",
@@ -106,6 +122,10 @@ var PublisherTableColumns = struct {
 	Status:              "publisher.status",
 	MediaType:           "publisher.media_type",
 	IsDirect:            "publisher.is_direct",
+	SellerDomain:        "publisher.seller_domain",
+	SellerType:          "publisher.seller_type",
+	IsConfidential:      "publisher.is_confidential",
+	StatusChangedAt:     "publisher.status_changed_at",
 }
 
 // Generated where
@@ -163,6 +183,10 @@ var PublisherWhere = struct {
 	Status              whereHelpernull_String
 	MediaType           whereHelpertypes_StringArray
 	IsDirect            whereHelperbool
+	SellerDomain        whereHelpernull_String
+	SellerType          whereHelpernull_String
+	IsConfidential      whereHelperbool
+	StatusChangedAt     whereHelpernull_Time
 }{
 	PublisherID:         whereHelperstring{field: "\"publisher\".\"publisher_id\""},
 	CreatedAt:           whereHelpertime_Time{field: "\"publisher\".\"created_at\""},
@@ -178,6 +202,10 @@ var PublisherWhere = struct {
 	Status:              whereHelpernull_String{field: "\"publisher\".\"status\""},
 	MediaType:           whereHelpertypes_StringArray{field: "\"publisher\".\"media_type\""},
 	IsDirect:            whereHelperbool{field: "\"publisher\".\"is_direct\""},
+	SellerDomain:        whereHelpernull_String{field: "\"publisher\".\"seller_domain\""},
+	SellerType:          whereHelpernull_String{field: "\"publisher\".\"seller_type\""},
+	IsConfidential:      whereHelperbool{field: "\"publisher\".\"is_confidential\""},
+	StatusChangedAt:     whereHelpernull_Time{field: "\"publisher\".\"status_changed_at\""},
 }
 
 // PublisherRels is where relationship names are stored.
@@ -318,9 +346,9 @@ func (r *publisherR) GetTargetings() TargetingSlice {
 type publisherL struct{}
 
 var (
-	publisherAllColumns            = []string{"publisher_id", "created_at", "name", "account_manager_id", "media_buyer_id", "campaign_manager_id", "office_location", "pause_timestamp", "start_timestamp", "reactivate_timestamp", "integration_type", "status", "media_type", "is_direct"}
+	publisherAllColumns            = []string{"publisher_id", "created_at", "name", "account_manager_id", "media_buyer_id", "campaign_manager_id", "office_location", "pause_timestamp", "start_timestamp", "reactivate_timestamp", "integration_type", "status", "media_type", "is_direct", "seller_domain", "seller_type", "is_confidential", "status_changed_at"}
 	publisherColumnsWithoutDefault = []string{"publisher_id", "created_at", "name"}
-	publisherColumnsWithDefault    = []string{"account_manager_id", "media_buyer_id", "campaign_manager_id", "office_location", "pause_timestamp", "start_timestamp", "reactivate_timestamp", "integration_type", "status", "media_type", "is_direct"}
+	publisherColumnsWithDefault    = []string{"account_manager_id", "media_buyer_id", "campaign_manager_id", "office_location", "pause_timestamp", "start_timestamp", "reactivate_timestamp", "integration_type", "status", "media_type", "is_direct", "seller_domain", "seller_type", "is_confidential", "status_changed_at"}
 	publisherPrimaryKeyColumns     = []string{"publisher_id"}
 	publisherGeneratedColumns      = []string{}
 )
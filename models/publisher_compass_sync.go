@@ -0,0 +1,1030 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// PublisherCompassSync is an object representing the database table.
+type PublisherCompassSync struct {
+	ID                  int         `boil:"id" json:"id" toml:"id" yaml:"id"`
+	PublisherID         string      `boil:"publisher_id" json:"publisher_id" toml:"publisher_id" yaml:"publisher_id"`
+	Status              string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	LatestTimestamp     null.Int64  `boil:"latest_timestamp" json:"latest_timestamp,omitempty" toml:"latest_timestamp" yaml:"latest_timestamp,omitempty"`
+	LocalChangedAt      null.Time   `boil:"local_changed_at" json:"local_changed_at,omitempty" toml:"local_changed_at" yaml:"local_changed_at,omitempty"`
+	PulledAt            null.Time   `boil:"pulled_at" json:"pulled_at,omitempty" toml:"pulled_at" yaml:"pulled_at,omitempty"`
+	PushedAt            null.Time   `boil:"pushed_at" json:"pushed_at,omitempty" toml:"pushed_at" yaml:"pushed_at,omitempty"`
+	Diffs               null.JSON   `boil:"diffs" json:"diffs,omitempty" toml:"diffs" yaml:"diffs,omitempty"`
+	CompassValues       null.JSON   `boil:"compass_values" json:"compass_values,omitempty" toml:"compass_values" yaml:"compass_values,omitempty"`
+	ErrorMessage        null.String `boil:"error_message" json:"error_message,omitempty" toml:"error_message" yaml:"error_message,omitempty"`
+	CreatedAt           time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt           null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+	LocalChangedColumns null.JSON   `boil:"local_changed_columns" json:"local_changed_columns,omitempty" toml:"local_changed_columns" yaml:"local_changed_columns,omitempty"`
+
+	R *publisherCompassSyncR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L publisherCompassSyncL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PublisherCompassSyncColumns = struct {
+	ID                  string
+	PublisherID         string
+	Status              string
+	LatestTimestamp     string
+	LocalChangedAt      string
+	PulledAt            string
+	PushedAt            string
+	Diffs               string
+	CompassValues       string
+	ErrorMessage        string
+	CreatedAt           string
+	UpdatedAt           string
+	LocalChangedColumns string
+}{
+	ID:                  "id",
+	PublisherID:         "publisher_id",
+	Status:              "status",
+	LatestTimestamp:     "latest_timestamp",
+	LocalChangedAt:      "local_changed_at",
+	PulledAt:            "pulled_at",
+	PushedAt:            "pushed_at",
+	Diffs:               "diffs",
+	CompassValues:       "compass_values",
+	ErrorMessage:        "error_message",
+	CreatedAt:           "created_at",
+	UpdatedAt:           "updated_at",
+	LocalChangedColumns: "local_changed_columns",
+}
+
+var PublisherCompassSyncTableColumns = struct {
+	ID                  string
+	PublisherID         string
+	Status              string
+	LatestTimestamp     string
+	LocalChangedAt      string
+	PulledAt            string
+	PushedAt            string
+	Diffs               string
+	CompassValues       string
+	ErrorMessage        string
+	CreatedAt           string
+	UpdatedAt           string
+	LocalChangedColumns string
+}{
+	ID:                  "publisher_compass_sync.id",
+	PublisherID:         "publisher_compass_sync.publisher_id",
+	Status:              "publisher_compass_sync.status",
+	LatestTimestamp:     "publisher_compass_sync.latest_timestamp",
+	LocalChangedAt:      "publisher_compass_sync.local_changed_at",
+	PulledAt:            "publisher_compass_sync.pulled_at",
+	PushedAt:            "publisher_compass_sync.pushed_at",
+	Diffs:               "publisher_compass_sync.diffs",
+	CompassValues:       "publisher_compass_sync.compass_values",
+	ErrorMessage:        "publisher_compass_sync.error_message",
+	CreatedAt:           "publisher_compass_sync.created_at",
+	UpdatedAt:           "publisher_compass_sync.updated_at",
+	LocalChangedColumns: "publisher_compass_sync.local_changed_columns",
+}
+
+// Generated where
+
+var PublisherCompassSyncWhere = struct {
+	ID                  whereHelperint
+	PublisherID         whereHelperstring
+	Status              whereHelperstring
+	LatestTimestamp     whereHelpernull_Int64
+	LocalChangedAt      whereHelpernull_Time
+	PulledAt            whereHelpernull_Time
+	PushedAt            whereHelpernull_Time
+	Diffs               whereHelpernull_JSON
+	CompassValues       whereHelpernull_JSON
+	ErrorMessage        whereHelpernull_String
+	CreatedAt           whereHelpertime_Time
+	UpdatedAt           whereHelpernull_Time
+	LocalChangedColumns whereHelpernull_JSON
+}{
+	ID:                  whereHelperint{field: "\"publisher_compass_sync\".\"id\""},
+	PublisherID:         whereHelperstring{field: "\"publisher_compass_sync\".\"publisher_id\""},
+	Status:              whereHelperstring{field: "\"publisher_compass_sync\".\"status\""},
+	LatestTimestamp:     whereHelpernull_Int64{field: "\"publisher_compass_sync\".\"latest_timestamp\""},
+	LocalChangedAt:      whereHelpernull_Time{field: "\"publisher_compass_sync\".\"local_changed_at\""},
+	PulledAt:            whereHelpernull_Time{field: "\"publisher_compass_sync\".\"pulled_at\""},
+	PushedAt:            whereHelpernull_Time{field: "\"publisher_compass_sync\".\"pushed_at\""},
+	Diffs:               whereHelpernull_JSON{field: "\"publisher_compass_sync\".\"diffs\""},
+	CompassValues:       whereHelpernull_JSON{field: "\"publisher_compass_sync\".\"compass_values\""},
+	ErrorMessage:        whereHelpernull_String{field: "\"publisher_compass_sync\".\"error_message\""},
+	CreatedAt:           whereHelpertime_Time{field: "\"publisher_compass_sync\".\"created_at\""},
+	UpdatedAt:           whereHelpernull_Time{field: "\"publisher_compass_sync\".\"updated_at\""},
+	LocalChangedColumns: whereHelpernull_JSON{field: "\"publisher_compass_sync\".\"local_changed_columns\""},
+}
+
+// PublisherCompassSyncRels is where relationship names are stored.
+var PublisherCompassSyncRels = struct {
+}{}
+
+// publisherCompassSyncR is where relationships are stored.
+type publisherCompassSyncR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*publisherCompassSyncR) NewStruct() *publisherCompassSyncR {
+	return &publisherCompassSyncR{}
+}
+
+// publisherCompassSyncL is where Load methods for each relationship are stored.
+type publisherCompassSyncL struct{}
+
+var (
+	publisherCompassSyncAllColumns            = []string{"id", "publisher_id", "status", "latest_timestamp", "local_changed_at", "pulled_at", "pushed_at", "diffs", "compass_values", "error_message", "created_at", "updated_at", "local_changed_columns"}
+	publisherCompassSyncColumnsWithoutDefault = []string{"publisher_id", "status", "created_at"}
+	publisherCompassSyncColumnsWithDefault    = []string{"id", "latest_timestamp", "local_changed_at", "pulled_at", "pushed_at", "diffs", "compass_values", "error_message", "updated_at", "local_changed_columns"}
+	publisherCompassSyncPrimaryKeyColumns     = []string{"id"}
+	publisherCompassSyncGeneratedColumns      = []string{}
+)
+
+type (
+	// PublisherCompassSyncSlice is an alias for a slice of pointers to PublisherCompassSync.
+	// This should almost always be used instead of []PublisherCompassSync.
+	PublisherCompassSyncSlice []*PublisherCompassSync
+	// PublisherCompassSyncHook is the signature for custom PublisherCompassSync hook methods
+	PublisherCompassSyncHook func(context.Context, boil.ContextExecutor, *PublisherCompassSync) error
+
+	publisherCompassSyncQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	publisherCompassSyncType                 = reflect.TypeOf(&PublisherCompassSync{})
+	publisherCompassSyncMapping              = queries.MakeStructMapping(publisherCompassSyncType)
+	publisherCompassSyncPrimaryKeyMapping, _ = queries.BindMapping(publisherCompassSyncType, publisherCompassSyncMapping, publisherCompassSyncPrimaryKeyColumns)
+	publisherCompassSyncInsertCacheMut       sync.RWMutex
+	publisherCompassSyncInsertCache          = make(map[string]insertCache)
+	publisherCompassSyncUpdateCacheMut       sync.RWMutex
+	publisherCompassSyncUpdateCache          = make(map[string]updateCache)
+	publisherCompassSyncUpsertCacheMut       sync.RWMutex
+	publisherCompassSyncUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var publisherCompassSyncAfterSelectMu sync.Mutex
+var publisherCompassSyncAfterSelectHooks []PublisherCompassSyncHook
+
+var publisherCompassSyncBeforeInsertMu sync.Mutex
+var publisherCompassSyncBeforeInsertHooks []PublisherCompassSyncHook
+var publisherCompassSyncAfterInsertMu sync.Mutex
+var publisherCompassSyncAfterInsertHooks []PublisherCompassSyncHook
+
+var publisherCompassSyncBeforeUpdateMu sync.Mutex
+var publisherCompassSyncBeforeUpdateHooks []PublisherCompassSyncHook
+var publisherCompassSyncAfterUpdateMu sync.Mutex
+var publisherCompassSyncAfterUpdateHooks []PublisherCompassSyncHook
+
+var publisherCompassSyncBeforeDeleteMu sync.Mutex
+var publisherCompassSyncBeforeDeleteHooks []PublisherCompassSyncHook
+var publisherCompassSyncAfterDeleteMu sync.Mutex
+var publisherCompassSyncAfterDeleteHooks []PublisherCompassSyncHook
+
+var publisherCompassSyncBeforeUpsertMu sync.Mutex
+var publisherCompassSyncBeforeUpsertHooks []PublisherCompassSyncHook
+var publisherCompassSyncAfterUpsertMu sync.Mutex
+var publisherCompassSyncAfterUpsertHooks []PublisherCompassSyncHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *PublisherCompassSync) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range publisherCompassSyncAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *PublisherCompassSync) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range publisherCompassSyncBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *PublisherCompassSync) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range publisherCompassSyncAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *PublisherCompassSync) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range publisherCompassSyncBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *PublisherCompassSync) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range publisherCompassSyncAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *PublisherCompassSync) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range publisherCompassSyncBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *PublisherCompassSync) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range publisherCompassSyncAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *PublisherCompassSync) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range publisherCompassSyncBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *PublisherCompassSync) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range publisherCompassSyncAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddPublisherCompassSyncHook registers your hook function for all future operations.
+func AddPublisherCompassSyncHook(hookPoint boil.HookPoint, publisherCompassSyncHook PublisherCompassSyncHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		publisherCompassSyncAfterSelectMu.Lock()
+		publisherCompassSyncAfterSelectHooks = append(publisherCompassSyncAfterSelectHooks, publisherCompassSyncHook)
+		publisherCompassSyncAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		publisherCompassSyncBeforeInsertMu.Lock()
+		publisherCompassSyncBeforeInsertHooks = append(publisherCompassSyncBeforeInsertHooks, publisherCompassSyncHook)
+		publisherCompassSyncBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		publisherCompassSyncAfterInsertMu.Lock()
+		publisherCompassSyncAfterInsertHooks = append(publisherCompassSyncAfterInsertHooks, publisherCompassSyncHook)
+		publisherCompassSyncAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		publisherCompassSyncBeforeUpdateMu.Lock()
+		publisherCompassSyncBeforeUpdateHooks = append(publisherCompassSyncBeforeUpdateHooks, publisherCompassSyncHook)
+		publisherCompassSyncBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		publisherCompassSyncAfterUpdateMu.Lock()
+		publisherCompassSyncAfterUpdateHooks = append(publisherCompassSyncAfterUpdateHooks, publisherCompassSyncHook)
+		publisherCompassSyncAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		publisherCompassSyncBeforeDeleteMu.Lock()
+		publisherCompassSyncBeforeDeleteHooks = append(publisherCompassSyncBeforeDeleteHooks, publisherCompassSyncHook)
+		publisherCompassSyncBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		publisherCompassSyncAfterDeleteMu.Lock()
+		publisherCompassSyncAfterDeleteHooks = append(publisherCompassSyncAfterDeleteHooks, publisherCompassSyncHook)
+		publisherCompassSyncAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		publisherCompassSyncBeforeUpsertMu.Lock()
+		publisherCompassSyncBeforeUpsertHooks = append(publisherCompassSyncBeforeUpsertHooks, publisherCompassSyncHook)
+		publisherCompassSyncBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		publisherCompassSyncAfterUpsertMu.Lock()
+		publisherCompassSyncAfterUpsertHooks = append(publisherCompassSyncAfterUpsertHooks, publisherCompassSyncHook)
+		publisherCompassSyncAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single publisherCompassSync record from the query.
+func (q publisherCompassSyncQuery) One(ctx context.Context, exec boil.ContextExecutor) (*PublisherCompassSync, error) {
+	o := &PublisherCompassSync{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for publisher_compass_sync")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all PublisherCompassSync records from the query.
+func (q publisherCompassSyncQuery) All(ctx context.Context, exec boil.ContextExecutor) (PublisherCompassSyncSlice, error) {
+	var o []*PublisherCompassSync
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to PublisherCompassSync slice")
+	}
+
+	if len(publisherCompassSyncAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all PublisherCompassSync records in the query.
+func (q publisherCompassSyncQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count publisher_compass_sync rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q publisherCompassSyncQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if publisher_compass_sync exists")
+	}
+
+	return count > 0, nil
+}
+
+// PublisherCompassSyncs retrieves all the records using an executor.
+func PublisherCompassSyncs(mods ...qm.QueryMod) publisherCompassSyncQuery {
+	mods = append(mods, qm.From("\"publisher_compass_sync\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"publisher_compass_sync\".*"})
+	}
+
+	return publisherCompassSyncQuery{q}
+}
+
+// FindPublisherCompassSync retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPublisherCompassSync(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*PublisherCompassSync, error) {
+	publisherCompassSyncObj := &PublisherCompassSync{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"publisher_compass_sync\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, publisherCompassSyncObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from publisher_compass_sync")
+	}
+
+	if err = publisherCompassSyncObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return publisherCompassSyncObj, err
+	}
+
+	return publisherCompassSyncObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *PublisherCompassSync) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no publisher_compass_sync provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(publisherCompassSyncColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	publisherCompassSyncInsertCacheMut.RLock()
+	cache, cached := publisherCompassSyncInsertCache[key]
+	publisherCompassSyncInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			publisherCompassSyncAllColumns,
+			publisherCompassSyncColumnsWithDefault,
+			publisherCompassSyncColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(publisherCompassSyncType, publisherCompassSyncMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(publisherCompassSyncType, publisherCompassSyncMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"publisher_compass_sync\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"publisher_compass_sync\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into publisher_compass_sync")
+	}
+
+	if !cached {
+		publisherCompassSyncInsertCacheMut.Lock()
+		publisherCompassSyncInsertCache[key] = cache
+		publisherCompassSyncInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the PublisherCompassSync.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *PublisherCompassSync) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	publisherCompassSyncUpdateCacheMut.RLock()
+	cache, cached := publisherCompassSyncUpdateCache[key]
+	publisherCompassSyncUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			publisherCompassSyncAllColumns,
+			publisherCompassSyncPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update publisher_compass_sync, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"publisher_compass_sync\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, publisherCompassSyncPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(publisherCompassSyncType, publisherCompassSyncMapping, append(wl, publisherCompassSyncPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update publisher_compass_sync row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for publisher_compass_sync")
+	}
+
+	if !cached {
+		publisherCompassSyncUpdateCacheMut.Lock()
+		publisherCompassSyncUpdateCache[key] = cache
+		publisherCompassSyncUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q publisherCompassSyncQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for publisher_compass_sync")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for publisher_compass_sync")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PublisherCompassSyncSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), publisherCompassSyncPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"publisher_compass_sync\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, publisherCompassSyncPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in publisherCompassSync slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all publisherCompassSync")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *PublisherCompassSync) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no publisher_compass_sync provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(publisherCompassSyncColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	publisherCompassSyncUpsertCacheMut.RLock()
+	cache, cached := publisherCompassSyncUpsertCache[key]
+	publisherCompassSyncUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			publisherCompassSyncAllColumns,
+			publisherCompassSyncColumnsWithDefault,
+			publisherCompassSyncColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			publisherCompassSyncAllColumns,
+			publisherCompassSyncPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert publisher_compass_sync, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(publisherCompassSyncAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(publisherCompassSyncPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert publisher_compass_sync, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(publisherCompassSyncPrimaryKeyColumns))
+			copy(conflict, publisherCompassSyncPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"publisher_compass_sync\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(publisherCompassSyncType, publisherCompassSyncMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(publisherCompassSyncType, publisherCompassSyncMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert publisher_compass_sync")
+	}
+
+	if !cached {
+		publisherCompassSyncUpsertCacheMut.Lock()
+		publisherCompassSyncUpsertCache[key] = cache
+		publisherCompassSyncUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single PublisherCompassSync record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *PublisherCompassSync) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no PublisherCompassSync provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), publisherCompassSyncPrimaryKeyMapping)
+	sql := "DELETE FROM \"publisher_compass_sync\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from publisher_compass_sync")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for publisher_compass_sync")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q publisherCompassSyncQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no publisherCompassSyncQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from publisher_compass_sync")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for publisher_compass_sync")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PublisherCompassSyncSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(publisherCompassSyncBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), publisherCompassSyncPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"publisher_compass_sync\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, publisherCompassSyncPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from publisherCompassSync slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for publisher_compass_sync")
+	}
+
+	if len(publisherCompassSyncAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *PublisherCompassSync) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindPublisherCompassSync(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PublisherCompassSyncSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PublisherCompassSyncSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), publisherCompassSyncPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"publisher_compass_sync\".* FROM \"publisher_compass_sync\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, publisherCompassSyncPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in PublisherCompassSyncSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PublisherCompassSyncExists checks if the PublisherCompassSync row exists.
+func PublisherCompassSyncExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"publisher_compass_sync\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if publisher_compass_sync exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the PublisherCompassSync row exists.
+func (o *PublisherCompassSync) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return PublisherCompassSyncExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testPublisherCompassSyncs(t *testing.T) {
+	t.Parallel()
+
+	query := PublisherCompassSyncs()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testPublisherCompassSyncsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherCompassSync{}
+	if err = randomize.Struct(seed, o, publisherCompassSyncDBTypes, true, publisherCompassSyncColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherCompassSync struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PublisherCompassSyncs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPublisherCompassSyncsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherCompassSync{}
+	if err = randomize.Struct(seed, o, publisherCompassSyncDBTypes, true, publisherCompassSyncColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherCompassSync struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := PublisherCompassSyncs().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PublisherCompassSyncs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPublisherCompassSyncsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherCompassSync{}
+	if err = randomize.Struct(seed, o, publisherCompassSyncDBTypes, true, publisherCompassSyncColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherCompassSync struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PublisherCompassSyncSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PublisherCompassSyncs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPublisherCompassSyncsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherCompassSync{}
+	if err = randomize.Struct(seed, o, publisherCompassSyncDBTypes, true, publisherCompassSyncColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherCompassSync struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := PublisherCompassSyncExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if PublisherCompassSync exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected PublisherCompassSyncExists to return true, but got false.")
+	}
+}
+
+func testPublisherCompassSyncsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherCompassSync{}
+	if err = randomize.Struct(seed, o, publisherCompassSyncDBTypes, true, publisherCompassSyncColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherCompassSync struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	publisherCompassSyncFound, err := FindPublisherCompassSync(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if publisherCompassSyncFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testPublisherCompassSyncsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherCompassSync{}
+	if err = randomize.Struct(seed, o, publisherCompassSyncDBTypes, true, publisherCompassSyncColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherCompassSync struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = PublisherCompassSyncs().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPublisherCompassSyncsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherCompassSync{}
+	if err = randomize.Struct(seed, o, publisherCompassSyncDBTypes, true, publisherCompassSyncColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherCompassSync struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := PublisherCompassSyncs().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testPublisherCompassSyncsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	publisherCompassSyncOne := &PublisherCompassSync{}
+	publisherCompassSyncTwo := &PublisherCompassSync{}
+	if err = randomize.Struct(seed, publisherCompassSyncOne, publisherCompassSyncDBTypes, false, publisherCompassSyncColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherCompassSync struct: %s", err)
+	}
+	if err = randomize.Struct(seed, publisherCompassSyncTwo, publisherCompassSyncDBTypes, false, publisherCompassSyncColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherCompassSync struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = publisherCompassSyncOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = publisherCompassSyncTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PublisherCompassSyncs().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testPublisherCompassSyncsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	publisherCompassSyncOne := &PublisherCompassSync{}
+	publisherCompassSyncTwo := &PublisherCompassSync{}
+	if err = randomize.Struct(seed, publisherCompassSyncOne, publisherCompassSyncDBTypes, false, publisherCompassSyncColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherCompassSync struct: %s", err)
+	}
+	if err = randomize.Struct(seed, publisherCompassSyncTwo, publisherCompassSyncDBTypes, false, publisherCompassSyncColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherCompassSync struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = publisherCompassSyncOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = publisherCompassSyncTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PublisherCompassSyncs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func publisherCompassSyncBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *PublisherCompassSync) error {
+	*o = PublisherCompassSync{}
+	return nil
+}
+
+func publisherCompassSyncAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *PublisherCompassSync) error {
+	*o = PublisherCompassSync{}
+	return nil
+}
+
+func publisherCompassSyncAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *PublisherCompassSync) error {
+	*o = PublisherCompassSync{}
+	return nil
+}
+
+func publisherCompassSyncBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *PublisherCompassSync) error {
+	*o = PublisherCompassSync{}
+	return nil
+}
+
+func publisherCompassSyncAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *PublisherCompassSync) error {
+	*o = PublisherCompassSync{}
+	return nil
+}
+
+func publisherCompassSyncBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *PublisherCompassSync) error {
+	*o = PublisherCompassSync{}
+	return nil
+}
+
+func publisherCompassSyncAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *PublisherCompassSync) error {
+	*o = PublisherCompassSync{}
+	return nil
+}
+
+func publisherCompassSyncBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *PublisherCompassSync) error {
+	*o = PublisherCompassSync{}
+	return nil
+}
+
+func publisherCompassSyncAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *PublisherCompassSync) error {
+	*o = PublisherCompassSync{}
+	return nil
+}
+
+func testPublisherCompassSyncsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &PublisherCompassSync{}
+	o := &PublisherCompassSync{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, publisherCompassSyncDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize PublisherCompassSync object: %s", err)
+	}
+
+	AddPublisherCompassSyncHook(boil.BeforeInsertHook, publisherCompassSyncBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	publisherCompassSyncBeforeInsertHooks = []PublisherCompassSyncHook{}
+
+	AddPublisherCompassSyncHook(boil.AfterInsertHook, publisherCompassSyncAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	publisherCompassSyncAfterInsertHooks = []PublisherCompassSyncHook{}
+
+	AddPublisherCompassSyncHook(boil.AfterSelectHook, publisherCompassSyncAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	publisherCompassSyncAfterSelectHooks = []PublisherCompassSyncHook{}
+
+	AddPublisherCompassSyncHook(boil.BeforeUpdateHook, publisherCompassSyncBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	publisherCompassSyncBeforeUpdateHooks = []PublisherCompassSyncHook{}
+
+	AddPublisherCompassSyncHook(boil.AfterUpdateHook, publisherCompassSyncAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	publisherCompassSyncAfterUpdateHooks = []PublisherCompassSyncHook{}
+
+	AddPublisherCompassSyncHook(boil.BeforeDeleteHook, publisherCompassSyncBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	publisherCompassSyncBeforeDeleteHooks = []PublisherCompassSyncHook{}
+
+	AddPublisherCompassSyncHook(boil.AfterDeleteHook, publisherCompassSyncAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	publisherCompassSyncAfterDeleteHooks = []PublisherCompassSyncHook{}
+
+	AddPublisherCompassSyncHook(boil.BeforeUpsertHook, publisherCompassSyncBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	publisherCompassSyncBeforeUpsertHooks = []PublisherCompassSyncHook{}
+
+	AddPublisherCompassSyncHook(boil.AfterUpsertHook, publisherCompassSyncAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	publisherCompassSyncAfterUpsertHooks = []PublisherCompassSyncHook{}
+}
+
+func testPublisherCompassSyncsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherCompassSync{}
+	if err = randomize.Struct(seed, o, publisherCompassSyncDBTypes, true, publisherCompassSyncColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherCompassSync struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PublisherCompassSyncs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPublisherCompassSyncsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherCompassSync{}
+	if err = randomize.Struct(seed, o, publisherCompassSyncDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PublisherCompassSync struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(publisherCompassSyncColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PublisherCompassSyncs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPublisherCompassSyncsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherCompassSync{}
+	if err = randomize.Struct(seed, o, publisherCompassSyncDBTypes, true, publisherCompassSyncColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherCompassSync struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPublisherCompassSyncsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherCompassSync{}
+	if err = randomize.Struct(seed, o, publisherCompassSyncDBTypes, true, publisherCompassSyncColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherCompassSync struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PublisherCompassSyncSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPublisherCompassSyncsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherCompassSync{}
+	if err = randomize.Struct(seed, o, publisherCompassSyncDBTypes, true, publisherCompassSyncColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherCompassSync struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PublisherCompassSyncs().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	publisherCompassSyncDBTypes = map[string]string{`ID`: `integer`, `PublisherID`: `character varying`, `Status`: `character varying`, `LatestTimestamp`: `bigint`, `LocalChangedAt`: `timestamp without time zone`, `PulledAt`: `timestamp without time zone`, `PushedAt`: `timestamp without time zone`, `Diffs`: `jsonb`, `CompassValues`: `jsonb`, `ErrorMessage`: `character varying`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`, `LocalChangedColumns`: `jsonb`}
+	_                           = bytes.MinRead
+)
+
+func testPublisherCompassSyncsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(publisherCompassSyncPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(publisherCompassSyncAllColumns) == len(publisherCompassSyncPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherCompassSync{}
+	if err = randomize.Struct(seed, o, publisherCompassSyncDBTypes, true, publisherCompassSyncColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherCompassSync struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PublisherCompassSyncs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, publisherCompassSyncDBTypes, true, publisherCompassSyncPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PublisherCompassSync struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testPublisherCompassSyncsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(publisherCompassSyncAllColumns) == len(publisherCompassSyncPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherCompassSync{}
+	if err = randomize.Struct(seed, o, publisherCompassSyncDBTypes, true, publisherCompassSyncColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherCompassSync struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PublisherCompassSyncs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, publisherCompassSyncDBTypes, true, publisherCompassSyncPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PublisherCompassSync struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(publisherCompassSyncAllColumns, publisherCompassSyncPrimaryKeyColumns) {
+		fields = publisherCompassSyncAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			publisherCompassSyncAllColumns,
+			publisherCompassSyncPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := PublisherCompassSyncSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testPublisherCompassSyncsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(publisherCompassSyncAllColumns) == len(publisherCompassSyncPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := PublisherCompassSync{}
+	if err = randomize.Struct(seed, &o, publisherCompassSyncDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PublisherCompassSync struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PublisherCompassSync: %s", err)
+	}
+
+	count, err := PublisherCompassSyncs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, publisherCompassSyncDBTypes, false, publisherCompassSyncPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PublisherCompassSync struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PublisherCompassSync: %s", err)
+	}
+
+	count, err = PublisherCompassSyncs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
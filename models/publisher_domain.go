@@ -25,62 +25,72 @@ import (
 
 // PublisherDomain is an object representing the database table.
 type PublisherDomain struct {
-	Domain            string            `boil:"domain" json:"domain" toml:"domain" yaml:"domain"`
-	PublisherID       string            `boil:"publisher_id" json:"publisher_id" toml:"publisher_id" yaml:"publisher_id"`
-	Automation        bool              `boil:"automation" json:"automation" toml:"automation" yaml:"automation"`
-	GPPTarget         null.Float64      `boil:"gpp_target" json:"gpp_target,omitempty" toml:"gpp_target" yaml:"gpp_target,omitempty"`
-	IntegrationType   types.StringArray `boil:"integration_type" json:"integration_type,omitempty" toml:"integration_type" yaml:"integration_type,omitempty"`
-	CreatedAt         time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt         null.Time         `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
-	MirrorPublisherID null.String       `boil:"mirror_publisher_id" json:"mirror_publisher_id,omitempty" toml:"mirror_publisher_id" yaml:"mirror_publisher_id,omitempty"`
-	IsDirect          null.Bool         `boil:"is_direct" json:"is_direct,omitempty" toml:"is_direct" yaml:"is_direct,omitempty"`
+	Domain             string            `boil:"domain" json:"domain" toml:"domain" yaml:"domain"`
+	PublisherID        string            `boil:"publisher_id" json:"publisher_id" toml:"publisher_id" yaml:"publisher_id"`
+	Automation         bool              `boil:"automation" json:"automation" toml:"automation" yaml:"automation"`
+	GPPTarget          null.Float64      `boil:"gpp_target" json:"gpp_target,omitempty" toml:"gpp_target" yaml:"gpp_target,omitempty"`
+	IntegrationType    types.StringArray `boil:"integration_type" json:"integration_type,omitempty" toml:"integration_type" yaml:"integration_type,omitempty"`
+	CreatedAt          time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt          null.Time         `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+	MirrorPublisherID  null.String       `boil:"mirror_publisher_id" json:"mirror_publisher_id,omitempty" toml:"mirror_publisher_id" yaml:"mirror_publisher_id,omitempty"`
+	IsDirect           null.Bool         `boil:"is_direct" json:"is_direct,omitempty" toml:"is_direct" yaml:"is_direct,omitempty"`
+	AutomationStrategy null.String       `boil:"automation_strategy" json:"automation_strategy,omitempty" toml:"automation_strategy" yaml:"automation_strategy,omitempty"`
+	AutomationParams   null.JSON         `boil:"automation_params" json:"automation_params,omitempty" toml:"automation_params" yaml:"automation_params,omitempty"`
 
 	R *publisherDomainR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L publisherDomainL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var PublisherDomainColumns = struct {
-	Domain            string
-	PublisherID       string
-	Automation        string
-	GPPTarget         string
-	IntegrationType   string
-	CreatedAt         string
-	UpdatedAt         string
-	MirrorPublisherID string
-	IsDirect          string
+	Domain             string
+	PublisherID        string
+	Automation         string
+	GPPTarget          string
+	IntegrationType    string
+	CreatedAt          string
+	UpdatedAt          string
+	MirrorPublisherID  string
+	IsDirect           string
+	AutomationStrategy string
+	AutomationParams   string
 }{
-	Domain:            "domain",
-	PublisherID:       "publisher_id",
-	Automation:        "automation",
-	GPPTarget:         "gpp_target",
-	IntegrationType:   "integration_type",
-	CreatedAt:         "created_at",
-	UpdatedAt:         "updated_at",
-	MirrorPublisherID: "mirror_publisher_id",
-	IsDirect:          "is_direct",
+	Domain:             "domain",
+	PublisherID:        "publisher_id",
+	Automation:         "automation",
+	GPPTarget:          "gpp_target",
+	IntegrationType:    "integration_type",
+	CreatedAt:          "created_at",
+	UpdatedAt:          "updated_at",
+	MirrorPublisherID:  "mirror_publisher_id",
+	IsDirect:           "is_direct",
+	AutomationStrategy: "automation_strategy",
+	AutomationParams:   "automation_params",
 }
 
 var PublisherDomainTableColumns = struct {
-	Domain            string
-	PublisherID       string
-	Automation        string
-	GPPTarget         string
-	IntegrationType   string
-	CreatedAt         string
-	UpdatedAt         string
-	MirrorPublisherID string
-	IsDirect          string
+	Domain             string
+	PublisherID        string
+	Automation         string
+	GPPTarget          string
+	IntegrationType    string
+	CreatedAt          string
+	UpdatedAt          string
+	MirrorPublisherID  string
+	IsDirect           string
+	AutomationStrategy string
+	AutomationParams   string
 }{
-	Domain:            "publisher_domain.domain",
-	PublisherID:       "publisher_domain.publisher_id",
-	Automation:        "publisher_domain.automation",
-	GPPTarget:         "publisher_domain.gpp_target",
-	IntegrationType:   "publisher_domain.integration_type",
-	CreatedAt:         "publisher_domain.created_at",
-	UpdatedAt:         "publisher_domain.updated_at",
-	MirrorPublisherID: "publisher_domain.mirror_publisher_id",
-	IsDirect:          "publisher_domain.is_direct",
+	Domain:             "publisher_domain.domain",
+	PublisherID:        "publisher_domain.publisher_id",
+	Automation:         "publisher_domain.automation",
+	GPPTarget:          "publisher_domain.gpp_target",
+	IntegrationType:    "publisher_domain.integration_type",
+	CreatedAt:          "publisher_domain.created_at",
+	UpdatedAt:          "publisher_domain.updated_at",
+	MirrorPublisherID:  "publisher_domain.mirror_publisher_id",
+	IsDirect:           "publisher_domain.is_direct",
+	AutomationStrategy: "publisher_domain.automation_strategy",
+	AutomationParams:   "publisher_domain.automation_params",
 }
 
 // Generated where
@@ -110,25 +120,29 @@ func (w whereHelpernull_Bool) IsNull() qm.QueryMod    { return qmhelper.WhereIsN
 func (w whereHelpernull_Bool) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
 var PublisherDomainWhere = struct {
-	Domain            whereHelperstring
-	PublisherID       whereHelperstring
-	Automation        whereHelperbool
-	GPPTarget         whereHelpernull_Float64
-	IntegrationType   whereHelpertypes_StringArray
-	CreatedAt         whereHelpertime_Time
-	UpdatedAt         whereHelpernull_Time
-	MirrorPublisherID whereHelpernull_String
-	IsDirect          whereHelpernull_Bool
+	Domain             whereHelperstring
+	PublisherID        whereHelperstring
+	Automation         whereHelperbool
+	GPPTarget          whereHelpernull_Float64
+	IntegrationType    whereHelpertypes_StringArray
+	CreatedAt          whereHelpertime_Time
+	UpdatedAt          whereHelpernull_Time
+	MirrorPublisherID  whereHelpernull_String
+	IsDirect           whereHelpernull_Bool
+	AutomationStrategy whereHelpernull_String
+	AutomationParams   whereHelpernull_JSON
 }{
-	Domain:            whereHelperstring{field: "\"publisher_domain\".\"domain\""},
-	PublisherID:       whereHelperstring{field: "\"publisher_domain\".\"publisher_id\""},
-	Automation:        whereHelperbool{field: "\"publisher_domain\".\"automation\""},
-	GPPTarget:         whereHelpernull_Float64{field: "\"publisher_domain\".\"gpp_target\""},
-	IntegrationType:   whereHelpertypes_StringArray{field: "\"publisher_domain\".\"integration_type\""},
-	CreatedAt:         whereHelpertime_Time{field: "\"publisher_domain\".\"created_at\""},
-	UpdatedAt:         whereHelpernull_Time{field: "\"publisher_domain\".\"updated_at\""},
-	MirrorPublisherID: whereHelpernull_String{field: "\"publisher_domain\".\"mirror_publisher_id\""},
-	IsDirect:          whereHelpernull_Bool{field: "\"publisher_domain\".\"is_direct\""},
+	Domain:             whereHelperstring{field: "\"publisher_domain\".\"domain\""},
+	PublisherID:        whereHelperstring{field: "\"publisher_domain\".\"publisher_id\""},
+	Automation:         whereHelperbool{field: "\"publisher_domain\".\"automation\""},
+	GPPTarget:          whereHelpernull_Float64{field: "\"publisher_domain\".\"gpp_target\""},
+	IntegrationType:    whereHelpertypes_StringArray{field: "\"publisher_domain\".\"integration_type\""},
+	CreatedAt:          whereHelpertime_Time{field: "\"publisher_domain\".\"created_at\""},
+	UpdatedAt:          whereHelpernull_Time{field: "\"publisher_domain\".\"updated_at\""},
+	MirrorPublisherID:  whereHelpernull_String{field: "\"publisher_domain\".\"mirror_publisher_id\""},
+	IsDirect:           whereHelpernull_Bool{field: "\"publisher_domain\".\"is_direct\""},
+	AutomationStrategy: whereHelpernull_String{field: "\"publisher_domain\".\"automation_strategy\""},
+	AutomationParams:   whereHelpernull_JSON{field: "\"publisher_domain\".\"automation_params\""},
 }
 
 // PublisherDomainRels is where relationship names are stored.
@@ -169,9 +183,9 @@ func (r *publisherDomainR) GetPublisher() *Publisher {
 type publisherDomainL struct{}
 
 var (
-	publisherDomainAllColumns            = []string{"domain", "publisher_id", "automation", "gpp_target", "integration_type", "created_at", "updated_at", "mirror_publisher_id", "is_direct"}
+	publisherDomainAllColumns            = []string{"domain", "publisher_id", "automation", "gpp_target", "integration_type", "created_at", "updated_at", "mirror_publisher_id", "is_direct", "automation_strategy", "automation_params"}
 	publisherDomainColumnsWithoutDefault = []string{"domain", "publisher_id", "created_at"}
-	publisherDomainColumnsWithDefault    = []string{"automation", "gpp_target", "integration_type", "updated_at", "mirror_publisher_id", "is_direct"}
+	publisherDomainColumnsWithDefault    = []string{"automation", "gpp_target", "integration_type", "updated_at", "mirror_publisher_id", "is_direct", "automation_strategy", "automation_params"}
 	publisherDomainPrimaryKeyColumns     = []string{"domain", "publisher_id"}
 	publisherDomainGeneratedColumns      = []string{}
 )
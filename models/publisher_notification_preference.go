@@ -0,0 +1,996 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// PublisherNotificationPreference is an object representing the database table.
+type PublisherNotificationPreference struct {
+	ID               int               `boil:"id" json:"id" toml:"id" yaml:"id"`
+	PublisherID      string            `boil:"publisher_id" json:"publisher_id" toml:"publisher_id" yaml:"publisher_id"`
+	Emails           types.StringArray `boil:"emails" json:"emails,omitempty" toml:"emails" yaml:"emails,omitempty"`
+	DailyReport      bool              `boil:"daily_report" json:"daily_report" toml:"daily_report" yaml:"daily_report"`
+	MonthlyStatement bool              `boil:"monthly_statement" json:"monthly_statement" toml:"monthly_statement" yaml:"monthly_statement"`
+	AdsTXTAlerts     bool              `boil:"ads_txt_alerts" json:"ads_txt_alerts" toml:"ads_txt_alerts" yaml:"ads_txt_alerts"`
+	CreatedAt        time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt        null.Time         `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *publisherNotificationPreferenceR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L publisherNotificationPreferenceL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PublisherNotificationPreferenceColumns = struct {
+	ID               string
+	PublisherID      string
+	Emails           string
+	DailyReport      string
+	MonthlyStatement string
+	AdsTXTAlerts     string
+	CreatedAt        string
+	UpdatedAt        string
+}{
+	ID:               "id",
+	PublisherID:      "publisher_id",
+	Emails:           "emails",
+	DailyReport:      "daily_report",
+	MonthlyStatement: "monthly_statement",
+	AdsTXTAlerts:     "ads_txt_alerts",
+	CreatedAt:        "created_at",
+	UpdatedAt:        "updated_at",
+}
+
+var PublisherNotificationPreferenceTableColumns = struct {
+	ID               string
+	PublisherID      string
+	Emails           string
+	DailyReport      string
+	MonthlyStatement string
+	AdsTXTAlerts     string
+	CreatedAt        string
+	UpdatedAt        string
+}{
+	ID:               "publisher_notification_preference.id",
+	PublisherID:      "publisher_notification_preference.publisher_id",
+	Emails:           "publisher_notification_preference.emails",
+	DailyReport:      "publisher_notification_preference.daily_report",
+	MonthlyStatement: "publisher_notification_preference.monthly_statement",
+	AdsTXTAlerts:     "publisher_notification_preference.ads_txt_alerts",
+	CreatedAt:        "publisher_notification_preference.created_at",
+	UpdatedAt:        "publisher_notification_preference.updated_at",
+}
+
+// Generated where
+
+var PublisherNotificationPreferenceWhere = struct {
+	ID               whereHelperint
+	PublisherID      whereHelperstring
+	Emails           whereHelpertypes_StringArray
+	DailyReport      whereHelperbool
+	MonthlyStatement whereHelperbool
+	AdsTXTAlerts     whereHelperbool
+	CreatedAt        whereHelpertime_Time
+	UpdatedAt        whereHelpernull_Time
+}{
+	ID:               whereHelperint{field: "\"publisher_notification_preference\".\"id\""},
+	PublisherID:      whereHelperstring{field: "\"publisher_notification_preference\".\"publisher_id\""},
+	Emails:           whereHelpertypes_StringArray{field: "\"publisher_notification_preference\".\"emails\""},
+	DailyReport:      whereHelperbool{field: "\"publisher_notification_preference\".\"daily_report\""},
+	MonthlyStatement: whereHelperbool{field: "\"publisher_notification_preference\".\"monthly_statement\""},
+	AdsTXTAlerts:     whereHelperbool{field: "\"publisher_notification_preference\".\"ads_txt_alerts\""},
+	CreatedAt:        whereHelpertime_Time{field: "\"publisher_notification_preference\".\"created_at\""},
+	UpdatedAt:        whereHelpernull_Time{field: "\"publisher_notification_preference\".\"updated_at\""},
+}
+
+// PublisherNotificationPreferenceRels is where relationship names are stored.
+var PublisherNotificationPreferenceRels = struct {
+}{}
+
+// publisherNotificationPreferenceR is where relationships are stored.
+type publisherNotificationPreferenceR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*publisherNotificationPreferenceR) NewStruct() *publisherNotificationPreferenceR {
+	return &publisherNotificationPreferenceR{}
+}
+
+// publisherNotificationPreferenceL is where Load methods for each relationship are stored.
+type publisherNotificationPreferenceL struct{}
+
+var (
+	publisherNotificationPreferenceAllColumns            = []string{"id", "publisher_id", "emails", "daily_report", "monthly_statement", "ads_txt_alerts", "created_at", "updated_at"}
+	publisherNotificationPreferenceColumnsWithoutDefault = []string{"publisher_id", "created_at"}
+	publisherNotificationPreferenceColumnsWithDefault    = []string{"id", "emails", "daily_report", "monthly_statement", "ads_txt_alerts", "updated_at"}
+	publisherNotificationPreferencePrimaryKeyColumns     = []string{"id"}
+	publisherNotificationPreferenceGeneratedColumns      = []string{}
+)
+
+type (
+	// PublisherNotificationPreferenceSlice is an alias for a slice of pointers to PublisherNotificationPreference.
+	// This should almost always be used instead of []PublisherNotificationPreference.
+	PublisherNotificationPreferenceSlice []*PublisherNotificationPreference
+	// PublisherNotificationPreferenceHook is the signature for custom PublisherNotificationPreference hook methods
+	PublisherNotificationPreferenceHook func(context.Context, boil.ContextExecutor, *PublisherNotificationPreference) error
+
+	publisherNotificationPreferenceQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	publisherNotificationPreferenceType                 = reflect.TypeOf(&PublisherNotificationPreference{})
+	publisherNotificationPreferenceMapping              = queries.MakeStructMapping(publisherNotificationPreferenceType)
+	publisherNotificationPreferencePrimaryKeyMapping, _ = queries.BindMapping(publisherNotificationPreferenceType, publisherNotificationPreferenceMapping, publisherNotificationPreferencePrimaryKeyColumns)
+	publisherNotificationPreferenceInsertCacheMut       sync.RWMutex
+	publisherNotificationPreferenceInsertCache          = make(map[string]insertCache)
+	publisherNotificationPreferenceUpdateCacheMut       sync.RWMutex
+	publisherNotificationPreferenceUpdateCache          = make(map[string]updateCache)
+	publisherNotificationPreferenceUpsertCacheMut       sync.RWMutex
+	publisherNotificationPreferenceUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var publisherNotificationPreferenceAfterSelectMu sync.Mutex
+var publisherNotificationPreferenceAfterSelectHooks []PublisherNotificationPreferenceHook
+
+var publisherNotificationPreferenceBeforeInsertMu sync.Mutex
+var publisherNotificationPreferenceBeforeInsertHooks []PublisherNotificationPreferenceHook
+var publisherNotificationPreferenceAfterInsertMu sync.Mutex
+var publisherNotificationPreferenceAfterInsertHooks []PublisherNotificationPreferenceHook
+
+var publisherNotificationPreferenceBeforeUpdateMu sync.Mutex
+var publisherNotificationPreferenceBeforeUpdateHooks []PublisherNotificationPreferenceHook
+var publisherNotificationPreferenceAfterUpdateMu sync.Mutex
+var publisherNotificationPreferenceAfterUpdateHooks []PublisherNotificationPreferenceHook
+
+var publisherNotificationPreferenceBeforeDeleteMu sync.Mutex
+var publisherNotificationPreferenceBeforeDeleteHooks []PublisherNotificationPreferenceHook
+var publisherNotificationPreferenceAfterDeleteMu sync.Mutex
+var publisherNotificationPreferenceAfterDeleteHooks []PublisherNotificationPreferenceHook
+
+var publisherNotificationPreferenceBeforeUpsertMu sync.Mutex
+var publisherNotificationPreferenceBeforeUpsertHooks []PublisherNotificationPreferenceHook
+var publisherNotificationPreferenceAfterUpsertMu sync.Mutex
+var publisherNotificationPreferenceAfterUpsertHooks []PublisherNotificationPreferenceHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *PublisherNotificationPreference) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range publisherNotificationPreferenceAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *PublisherNotificationPreference) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range publisherNotificationPreferenceBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *PublisherNotificationPreference) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range publisherNotificationPreferenceAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *PublisherNotificationPreference) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range publisherNotificationPreferenceBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *PublisherNotificationPreference) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range publisherNotificationPreferenceAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *PublisherNotificationPreference) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range publisherNotificationPreferenceBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *PublisherNotificationPreference) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range publisherNotificationPreferenceAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *PublisherNotificationPreference) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range publisherNotificationPreferenceBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *PublisherNotificationPreference) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range publisherNotificationPreferenceAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddPublisherNotificationPreferenceHook registers your hook function for all future operations.
+func AddPublisherNotificationPreferenceHook(hookPoint boil.HookPoint, publisherNotificationPreferenceHook PublisherNotificationPreferenceHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		publisherNotificationPreferenceAfterSelectMu.Lock()
+		publisherNotificationPreferenceAfterSelectHooks = append(publisherNotificationPreferenceAfterSelectHooks, publisherNotificationPreferenceHook)
+		publisherNotificationPreferenceAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		publisherNotificationPreferenceBeforeInsertMu.Lock()
+		publisherNotificationPreferenceBeforeInsertHooks = append(publisherNotificationPreferenceBeforeInsertHooks, publisherNotificationPreferenceHook)
+		publisherNotificationPreferenceBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		publisherNotificationPreferenceAfterInsertMu.Lock()
+		publisherNotificationPreferenceAfterInsertHooks = append(publisherNotificationPreferenceAfterInsertHooks, publisherNotificationPreferenceHook)
+		publisherNotificationPreferenceAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		publisherNotificationPreferenceBeforeUpdateMu.Lock()
+		publisherNotificationPreferenceBeforeUpdateHooks = append(publisherNotificationPreferenceBeforeUpdateHooks, publisherNotificationPreferenceHook)
+		publisherNotificationPreferenceBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		publisherNotificationPreferenceAfterUpdateMu.Lock()
+		publisherNotificationPreferenceAfterUpdateHooks = append(publisherNotificationPreferenceAfterUpdateHooks, publisherNotificationPreferenceHook)
+		publisherNotificationPreferenceAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		publisherNotificationPreferenceBeforeDeleteMu.Lock()
+		publisherNotificationPreferenceBeforeDeleteHooks = append(publisherNotificationPreferenceBeforeDeleteHooks, publisherNotificationPreferenceHook)
+		publisherNotificationPreferenceBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		publisherNotificationPreferenceAfterDeleteMu.Lock()
+		publisherNotificationPreferenceAfterDeleteHooks = append(publisherNotificationPreferenceAfterDeleteHooks, publisherNotificationPreferenceHook)
+		publisherNotificationPreferenceAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		publisherNotificationPreferenceBeforeUpsertMu.Lock()
+		publisherNotificationPreferenceBeforeUpsertHooks = append(publisherNotificationPreferenceBeforeUpsertHooks, publisherNotificationPreferenceHook)
+		publisherNotificationPreferenceBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		publisherNotificationPreferenceAfterUpsertMu.Lock()
+		publisherNotificationPreferenceAfterUpsertHooks = append(publisherNotificationPreferenceAfterUpsertHooks, publisherNotificationPreferenceHook)
+		publisherNotificationPreferenceAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single publisherNotificationPreference record from the query.
+func (q publisherNotificationPreferenceQuery) One(ctx context.Context, exec boil.ContextExecutor) (*PublisherNotificationPreference, error) {
+	o := &PublisherNotificationPreference{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for publisher_notification_preference")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all PublisherNotificationPreference records from the query.
+func (q publisherNotificationPreferenceQuery) All(ctx context.Context, exec boil.ContextExecutor) (PublisherNotificationPreferenceSlice, error) {
+	var o []*PublisherNotificationPreference
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to PublisherNotificationPreference slice")
+	}
+
+	if len(publisherNotificationPreferenceAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all PublisherNotificationPreference records in the query.
+func (q publisherNotificationPreferenceQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count publisher_notification_preference rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q publisherNotificationPreferenceQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if publisher_notification_preference exists")
+	}
+
+	return count > 0, nil
+}
+
+// PublisherNotificationPreferences retrieves all the records using an executor.
+func PublisherNotificationPreferences(mods ...qm.QueryMod) publisherNotificationPreferenceQuery {
+	mods = append(mods, qm.From("\"publisher_notification_preference\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"publisher_notification_preference\".*"})
+	}
+
+	return publisherNotificationPreferenceQuery{q}
+}
+
+// FindPublisherNotificationPreference retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPublisherNotificationPreference(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*PublisherNotificationPreference, error) {
+	publisherNotificationPreferenceObj := &PublisherNotificationPreference{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"publisher_notification_preference\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, publisherNotificationPreferenceObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from publisher_notification_preference")
+	}
+
+	if err = publisherNotificationPreferenceObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return publisherNotificationPreferenceObj, err
+	}
+
+	return publisherNotificationPreferenceObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *PublisherNotificationPreference) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no publisher_notification_preference provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(publisherNotificationPreferenceColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	publisherNotificationPreferenceInsertCacheMut.RLock()
+	cache, cached := publisherNotificationPreferenceInsertCache[key]
+	publisherNotificationPreferenceInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			publisherNotificationPreferenceAllColumns,
+			publisherNotificationPreferenceColumnsWithDefault,
+			publisherNotificationPreferenceColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(publisherNotificationPreferenceType, publisherNotificationPreferenceMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(publisherNotificationPreferenceType, publisherNotificationPreferenceMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"publisher_notification_preference\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"publisher_notification_preference\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into publisher_notification_preference")
+	}
+
+	if !cached {
+		publisherNotificationPreferenceInsertCacheMut.Lock()
+		publisherNotificationPreferenceInsertCache[key] = cache
+		publisherNotificationPreferenceInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the PublisherNotificationPreference.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *PublisherNotificationPreference) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	publisherNotificationPreferenceUpdateCacheMut.RLock()
+	cache, cached := publisherNotificationPreferenceUpdateCache[key]
+	publisherNotificationPreferenceUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			publisherNotificationPreferenceAllColumns,
+			publisherNotificationPreferencePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update publisher_notification_preference, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"publisher_notification_preference\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, publisherNotificationPreferencePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(publisherNotificationPreferenceType, publisherNotificationPreferenceMapping, append(wl, publisherNotificationPreferencePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update publisher_notification_preference row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for publisher_notification_preference")
+	}
+
+	if !cached {
+		publisherNotificationPreferenceUpdateCacheMut.Lock()
+		publisherNotificationPreferenceUpdateCache[key] = cache
+		publisherNotificationPreferenceUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q publisherNotificationPreferenceQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for publisher_notification_preference")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for publisher_notification_preference")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PublisherNotificationPreferenceSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), publisherNotificationPreferencePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"publisher_notification_preference\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, publisherNotificationPreferencePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in publisherNotificationPreference slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all publisherNotificationPreference")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *PublisherNotificationPreference) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no publisher_notification_preference provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(publisherNotificationPreferenceColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	publisherNotificationPreferenceUpsertCacheMut.RLock()
+	cache, cached := publisherNotificationPreferenceUpsertCache[key]
+	publisherNotificationPreferenceUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			publisherNotificationPreferenceAllColumns,
+			publisherNotificationPreferenceColumnsWithDefault,
+			publisherNotificationPreferenceColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			publisherNotificationPreferenceAllColumns,
+			publisherNotificationPreferencePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert publisher_notification_preference, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(publisherNotificationPreferenceAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(publisherNotificationPreferencePrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert publisher_notification_preference, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(publisherNotificationPreferencePrimaryKeyColumns))
+			copy(conflict, publisherNotificationPreferencePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"publisher_notification_preference\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(publisherNotificationPreferenceType, publisherNotificationPreferenceMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(publisherNotificationPreferenceType, publisherNotificationPreferenceMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert publisher_notification_preference")
+	}
+
+	if !cached {
+		publisherNotificationPreferenceUpsertCacheMut.Lock()
+		publisherNotificationPreferenceUpsertCache[key] = cache
+		publisherNotificationPreferenceUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single PublisherNotificationPreference record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *PublisherNotificationPreference) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no PublisherNotificationPreference provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), publisherNotificationPreferencePrimaryKeyMapping)
+	sql := "DELETE FROM \"publisher_notification_preference\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from publisher_notification_preference")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for publisher_notification_preference")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q publisherNotificationPreferenceQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no publisherNotificationPreferenceQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from publisher_notification_preference")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for publisher_notification_preference")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PublisherNotificationPreferenceSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(publisherNotificationPreferenceBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), publisherNotificationPreferencePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"publisher_notification_preference\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, publisherNotificationPreferencePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from publisherNotificationPreference slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for publisher_notification_preference")
+	}
+
+	if len(publisherNotificationPreferenceAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *PublisherNotificationPreference) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindPublisherNotificationPreference(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PublisherNotificationPreferenceSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PublisherNotificationPreferenceSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), publisherNotificationPreferencePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"publisher_notification_preference\".* FROM \"publisher_notification_preference\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, publisherNotificationPreferencePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in PublisherNotificationPreferenceSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PublisherNotificationPreferenceExists checks if the PublisherNotificationPreference row exists.
+func PublisherNotificationPreferenceExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"publisher_notification_preference\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if publisher_notification_preference exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the PublisherNotificationPreference row exists.
+func (o *PublisherNotificationPreference) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return PublisherNotificationPreferenceExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testPublisherNotificationPreferences(t *testing.T) {
+	t.Parallel()
+
+	query := PublisherNotificationPreferences()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testPublisherNotificationPreferencesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherNotificationPreference{}
+	if err = randomize.Struct(seed, o, publisherNotificationPreferenceDBTypes, true, publisherNotificationPreferenceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherNotificationPreference struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PublisherNotificationPreferences().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPublisherNotificationPreferencesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherNotificationPreference{}
+	if err = randomize.Struct(seed, o, publisherNotificationPreferenceDBTypes, true, publisherNotificationPreferenceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherNotificationPreference struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := PublisherNotificationPreferences().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PublisherNotificationPreferences().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPublisherNotificationPreferencesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherNotificationPreference{}
+	if err = randomize.Struct(seed, o, publisherNotificationPreferenceDBTypes, true, publisherNotificationPreferenceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherNotificationPreference struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PublisherNotificationPreferenceSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PublisherNotificationPreferences().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPublisherNotificationPreferencesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherNotificationPreference{}
+	if err = randomize.Struct(seed, o, publisherNotificationPreferenceDBTypes, true, publisherNotificationPreferenceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherNotificationPreference struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := PublisherNotificationPreferenceExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if PublisherNotificationPreference exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected PublisherNotificationPreferenceExists to return true, but got false.")
+	}
+}
+
+func testPublisherNotificationPreferencesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherNotificationPreference{}
+	if err = randomize.Struct(seed, o, publisherNotificationPreferenceDBTypes, true, publisherNotificationPreferenceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherNotificationPreference struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	publisherNotificationPreferenceFound, err := FindPublisherNotificationPreference(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if publisherNotificationPreferenceFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testPublisherNotificationPreferencesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherNotificationPreference{}
+	if err = randomize.Struct(seed, o, publisherNotificationPreferenceDBTypes, true, publisherNotificationPreferenceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherNotificationPreference struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = PublisherNotificationPreferences().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPublisherNotificationPreferencesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherNotificationPreference{}
+	if err = randomize.Struct(seed, o, publisherNotificationPreferenceDBTypes, true, publisherNotificationPreferenceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherNotificationPreference struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := PublisherNotificationPreferences().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testPublisherNotificationPreferencesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	publisherNotificationPreferenceOne := &PublisherNotificationPreference{}
+	publisherNotificationPreferenceTwo := &PublisherNotificationPreference{}
+	if err = randomize.Struct(seed, publisherNotificationPreferenceOne, publisherNotificationPreferenceDBTypes, false, publisherNotificationPreferenceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherNotificationPreference struct: %s", err)
+	}
+	if err = randomize.Struct(seed, publisherNotificationPreferenceTwo, publisherNotificationPreferenceDBTypes, false, publisherNotificationPreferenceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherNotificationPreference struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = publisherNotificationPreferenceOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = publisherNotificationPreferenceTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PublisherNotificationPreferences().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testPublisherNotificationPreferencesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	publisherNotificationPreferenceOne := &PublisherNotificationPreference{}
+	publisherNotificationPreferenceTwo := &PublisherNotificationPreference{}
+	if err = randomize.Struct(seed, publisherNotificationPreferenceOne, publisherNotificationPreferenceDBTypes, false, publisherNotificationPreferenceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherNotificationPreference struct: %s", err)
+	}
+	if err = randomize.Struct(seed, publisherNotificationPreferenceTwo, publisherNotificationPreferenceDBTypes, false, publisherNotificationPreferenceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherNotificationPreference struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = publisherNotificationPreferenceOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = publisherNotificationPreferenceTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PublisherNotificationPreferences().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func publisherNotificationPreferenceBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *PublisherNotificationPreference) error {
+	*o = PublisherNotificationPreference{}
+	return nil
+}
+
+func publisherNotificationPreferenceAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *PublisherNotificationPreference) error {
+	*o = PublisherNotificationPreference{}
+	return nil
+}
+
+func publisherNotificationPreferenceAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *PublisherNotificationPreference) error {
+	*o = PublisherNotificationPreference{}
+	return nil
+}
+
+func publisherNotificationPreferenceBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *PublisherNotificationPreference) error {
+	*o = PublisherNotificationPreference{}
+	return nil
+}
+
+func publisherNotificationPreferenceAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *PublisherNotificationPreference) error {
+	*o = PublisherNotificationPreference{}
+	return nil
+}
+
+func publisherNotificationPreferenceBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *PublisherNotificationPreference) error {
+	*o = PublisherNotificationPreference{}
+	return nil
+}
+
+func publisherNotificationPreferenceAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *PublisherNotificationPreference) error {
+	*o = PublisherNotificationPreference{}
+	return nil
+}
+
+func publisherNotificationPreferenceBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *PublisherNotificationPreference) error {
+	*o = PublisherNotificationPreference{}
+	return nil
+}
+
+func publisherNotificationPreferenceAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *PublisherNotificationPreference) error {
+	*o = PublisherNotificationPreference{}
+	return nil
+}
+
+func testPublisherNotificationPreferencesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &PublisherNotificationPreference{}
+	o := &PublisherNotificationPreference{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, publisherNotificationPreferenceDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize PublisherNotificationPreference object: %s", err)
+	}
+
+	AddPublisherNotificationPreferenceHook(boil.BeforeInsertHook, publisherNotificationPreferenceBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	publisherNotificationPreferenceBeforeInsertHooks = []PublisherNotificationPreferenceHook{}
+
+	AddPublisherNotificationPreferenceHook(boil.AfterInsertHook, publisherNotificationPreferenceAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	publisherNotificationPreferenceAfterInsertHooks = []PublisherNotificationPreferenceHook{}
+
+	AddPublisherNotificationPreferenceHook(boil.AfterSelectHook, publisherNotificationPreferenceAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	publisherNotificationPreferenceAfterSelectHooks = []PublisherNotificationPreferenceHook{}
+
+	AddPublisherNotificationPreferenceHook(boil.BeforeUpdateHook, publisherNotificationPreferenceBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	publisherNotificationPreferenceBeforeUpdateHooks = []PublisherNotificationPreferenceHook{}
+
+	AddPublisherNotificationPreferenceHook(boil.AfterUpdateHook, publisherNotificationPreferenceAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	publisherNotificationPreferenceAfterUpdateHooks = []PublisherNotificationPreferenceHook{}
+
+	AddPublisherNotificationPreferenceHook(boil.BeforeDeleteHook, publisherNotificationPreferenceBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	publisherNotificationPreferenceBeforeDeleteHooks = []PublisherNotificationPreferenceHook{}
+
+	AddPublisherNotificationPreferenceHook(boil.AfterDeleteHook, publisherNotificationPreferenceAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	publisherNotificationPreferenceAfterDeleteHooks = []PublisherNotificationPreferenceHook{}
+
+	AddPublisherNotificationPreferenceHook(boil.BeforeUpsertHook, publisherNotificationPreferenceBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	publisherNotificationPreferenceBeforeUpsertHooks = []PublisherNotificationPreferenceHook{}
+
+	AddPublisherNotificationPreferenceHook(boil.AfterUpsertHook, publisherNotificationPreferenceAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	publisherNotificationPreferenceAfterUpsertHooks = []PublisherNotificationPreferenceHook{}
+}
+
+func testPublisherNotificationPreferencesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherNotificationPreference{}
+	if err = randomize.Struct(seed, o, publisherNotificationPreferenceDBTypes, true, publisherNotificationPreferenceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherNotificationPreference struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PublisherNotificationPreferences().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPublisherNotificationPreferencesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherNotificationPreference{}
+	if err = randomize.Struct(seed, o, publisherNotificationPreferenceDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PublisherNotificationPreference struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(publisherNotificationPreferenceColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PublisherNotificationPreferences().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPublisherNotificationPreferencesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherNotificationPreference{}
+	if err = randomize.Struct(seed, o, publisherNotificationPreferenceDBTypes, true, publisherNotificationPreferenceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherNotificationPreference struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPublisherNotificationPreferencesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherNotificationPreference{}
+	if err = randomize.Struct(seed, o, publisherNotificationPreferenceDBTypes, true, publisherNotificationPreferenceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherNotificationPreference struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PublisherNotificationPreferenceSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPublisherNotificationPreferencesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherNotificationPreference{}
+	if err = randomize.Struct(seed, o, publisherNotificationPreferenceDBTypes, true, publisherNotificationPreferenceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherNotificationPreference struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PublisherNotificationPreferences().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	publisherNotificationPreferenceDBTypes = map[string]string{`ID`: `integer`, `PublisherID`: `character varying`, `Emails`: `ARRAYcharacter varying`, `DailyReport`: `boolean`, `MonthlyStatement`: `boolean`, `AdsTXTAlerts`: `boolean`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                                      = bytes.MinRead
+)
+
+func testPublisherNotificationPreferencesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(publisherNotificationPreferencePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(publisherNotificationPreferenceAllColumns) == len(publisherNotificationPreferencePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherNotificationPreference{}
+	if err = randomize.Struct(seed, o, publisherNotificationPreferenceDBTypes, true, publisherNotificationPreferenceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherNotificationPreference struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PublisherNotificationPreferences().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, publisherNotificationPreferenceDBTypes, true, publisherNotificationPreferencePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PublisherNotificationPreference struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testPublisherNotificationPreferencesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(publisherNotificationPreferenceAllColumns) == len(publisherNotificationPreferencePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherNotificationPreference{}
+	if err = randomize.Struct(seed, o, publisherNotificationPreferenceDBTypes, true, publisherNotificationPreferenceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherNotificationPreference struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PublisherNotificationPreferences().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, publisherNotificationPreferenceDBTypes, true, publisherNotificationPreferencePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PublisherNotificationPreference struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(publisherNotificationPreferenceAllColumns, publisherNotificationPreferencePrimaryKeyColumns) {
+		fields = publisherNotificationPreferenceAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			publisherNotificationPreferenceAllColumns,
+			publisherNotificationPreferencePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := PublisherNotificationPreferenceSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testPublisherNotificationPreferencesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(publisherNotificationPreferenceAllColumns) == len(publisherNotificationPreferencePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := PublisherNotificationPreference{}
+	if err = randomize.Struct(seed, &o, publisherNotificationPreferenceDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PublisherNotificationPreference struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PublisherNotificationPreference: %s", err)
+	}
+
+	count, err := PublisherNotificationPreferences().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, publisherNotificationPreferenceDBTypes, false, publisherNotificationPreferencePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PublisherNotificationPreference struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PublisherNotificationPreference: %s", err)
+	}
+
+	count, err = PublisherNotificationPreferences().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
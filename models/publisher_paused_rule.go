@@ -0,0 +1,981 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// PublisherPausedRule is an object representing the database table.
+type PublisherPausedRule struct {
+	ID          int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	PublisherID string    `boil:"publisher_id" json:"publisher_id" toml:"publisher_id" yaml:"publisher_id"`
+	RuleType    string    `boil:"rule_type" json:"rule_type" toml:"rule_type" yaml:"rule_type"`
+	RuleID      string    `boil:"rule_id" json:"rule_id" toml:"rule_id" yaml:"rule_id"`
+	CreatedAt   time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt   null.Time `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *publisherPausedRuleR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L publisherPausedRuleL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PublisherPausedRuleColumns = struct {
+	ID          string
+	PublisherID string
+	RuleType    string
+	RuleID      string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "id",
+	PublisherID: "publisher_id",
+	RuleType:    "rule_type",
+	RuleID:      "rule_id",
+	CreatedAt:   "created_at",
+	UpdatedAt:   "updated_at",
+}
+
+var PublisherPausedRuleTableColumns = struct {
+	ID          string
+	PublisherID string
+	RuleType    string
+	RuleID      string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "publisher_paused_rule.id",
+	PublisherID: "publisher_paused_rule.publisher_id",
+	RuleType:    "publisher_paused_rule.rule_type",
+	RuleID:      "publisher_paused_rule.rule_id",
+	CreatedAt:   "publisher_paused_rule.created_at",
+	UpdatedAt:   "publisher_paused_rule.updated_at",
+}
+
+// Generated where
+
+var PublisherPausedRuleWhere = struct {
+	ID          whereHelperint
+	PublisherID whereHelperstring
+	RuleType    whereHelperstring
+	RuleID      whereHelperstring
+	CreatedAt   whereHelpertime_Time
+	UpdatedAt   whereHelpernull_Time
+}{
+	ID:          whereHelperint{field: "\"publisher_paused_rule\".\"id\""},
+	PublisherID: whereHelperstring{field: "\"publisher_paused_rule\".\"publisher_id\""},
+	RuleType:    whereHelperstring{field: "\"publisher_paused_rule\".\"rule_type\""},
+	RuleID:      whereHelperstring{field: "\"publisher_paused_rule\".\"rule_id\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"publisher_paused_rule\".\"created_at\""},
+	UpdatedAt:   whereHelpernull_Time{field: "\"publisher_paused_rule\".\"updated_at\""},
+}
+
+// PublisherPausedRuleRels is where relationship names are stored.
+var PublisherPausedRuleRels = struct {
+}{}
+
+// publisherPausedRuleR is where relationships are stored.
+type publisherPausedRuleR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*publisherPausedRuleR) NewStruct() *publisherPausedRuleR {
+	return &publisherPausedRuleR{}
+}
+
+// publisherPausedRuleL is where Load methods for each relationship are stored.
+type publisherPausedRuleL struct{}
+
+var (
+	publisherPausedRuleAllColumns            = []string{"id", "publisher_id", "rule_type", "rule_id", "created_at", "updated_at"}
+	publisherPausedRuleColumnsWithoutDefault = []string{"publisher_id", "rule_type", "rule_id", "created_at"}
+	publisherPausedRuleColumnsWithDefault    = []string{"id", "updated_at"}
+	publisherPausedRulePrimaryKeyColumns     = []string{"id"}
+	publisherPausedRuleGeneratedColumns      = []string{}
+)
+
+type (
+	// PublisherPausedRuleSlice is an alias for a slice of pointers to PublisherPausedRule.
+	// This should almost always be used instead of []PublisherPausedRule.
+	PublisherPausedRuleSlice []*PublisherPausedRule
+	// PublisherPausedRuleHook is the signature for custom PublisherPausedRule hook methods
+	PublisherPausedRuleHook func(context.Context, boil.ContextExecutor, *PublisherPausedRule) error
+
+	publisherPausedRuleQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	publisherPausedRuleType                 = reflect.TypeOf(&PublisherPausedRule{})
+	publisherPausedRuleMapping              = queries.MakeStructMapping(publisherPausedRuleType)
+	publisherPausedRulePrimaryKeyMapping, _ = queries.BindMapping(publisherPausedRuleType, publisherPausedRuleMapping, publisherPausedRulePrimaryKeyColumns)
+	publisherPausedRuleInsertCacheMut       sync.RWMutex
+	publisherPausedRuleInsertCache          = make(map[string]insertCache)
+	publisherPausedRuleUpdateCacheMut       sync.RWMutex
+	publisherPausedRuleUpdateCache          = make(map[string]updateCache)
+	publisherPausedRuleUpsertCacheMut       sync.RWMutex
+	publisherPausedRuleUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var publisherPausedRuleAfterSelectMu sync.Mutex
+var publisherPausedRuleAfterSelectHooks []PublisherPausedRuleHook
+
+var publisherPausedRuleBeforeInsertMu sync.Mutex
+var publisherPausedRuleBeforeInsertHooks []PublisherPausedRuleHook
+var publisherPausedRuleAfterInsertMu sync.Mutex
+var publisherPausedRuleAfterInsertHooks []PublisherPausedRuleHook
+
+var publisherPausedRuleBeforeUpdateMu sync.Mutex
+var publisherPausedRuleBeforeUpdateHooks []PublisherPausedRuleHook
+var publisherPausedRuleAfterUpdateMu sync.Mutex
+var publisherPausedRuleAfterUpdateHooks []PublisherPausedRuleHook
+
+var publisherPausedRuleBeforeDeleteMu sync.Mutex
+var publisherPausedRuleBeforeDeleteHooks []PublisherPausedRuleHook
+var publisherPausedRuleAfterDeleteMu sync.Mutex
+var publisherPausedRuleAfterDeleteHooks []PublisherPausedRuleHook
+
+var publisherPausedRuleBeforeUpsertMu sync.Mutex
+var publisherPausedRuleBeforeUpsertHooks []PublisherPausedRuleHook
+var publisherPausedRuleAfterUpsertMu sync.Mutex
+var publisherPausedRuleAfterUpsertHooks []PublisherPausedRuleHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *PublisherPausedRule) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range publisherPausedRuleAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *PublisherPausedRule) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range publisherPausedRuleBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *PublisherPausedRule) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range publisherPausedRuleAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *PublisherPausedRule) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range publisherPausedRuleBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *PublisherPausedRule) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range publisherPausedRuleAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *PublisherPausedRule) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range publisherPausedRuleBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *PublisherPausedRule) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range publisherPausedRuleAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *PublisherPausedRule) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range publisherPausedRuleBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *PublisherPausedRule) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range publisherPausedRuleAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddPublisherPausedRuleHook registers your hook function for all future operations.
+func AddPublisherPausedRuleHook(hookPoint boil.HookPoint, publisherPausedRuleHook PublisherPausedRuleHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		publisherPausedRuleAfterSelectMu.Lock()
+		publisherPausedRuleAfterSelectHooks = append(publisherPausedRuleAfterSelectHooks, publisherPausedRuleHook)
+		publisherPausedRuleAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		publisherPausedRuleBeforeInsertMu.Lock()
+		publisherPausedRuleBeforeInsertHooks = append(publisherPausedRuleBeforeInsertHooks, publisherPausedRuleHook)
+		publisherPausedRuleBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		publisherPausedRuleAfterInsertMu.Lock()
+		publisherPausedRuleAfterInsertHooks = append(publisherPausedRuleAfterInsertHooks, publisherPausedRuleHook)
+		publisherPausedRuleAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		publisherPausedRuleBeforeUpdateMu.Lock()
+		publisherPausedRuleBeforeUpdateHooks = append(publisherPausedRuleBeforeUpdateHooks, publisherPausedRuleHook)
+		publisherPausedRuleBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		publisherPausedRuleAfterUpdateMu.Lock()
+		publisherPausedRuleAfterUpdateHooks = append(publisherPausedRuleAfterUpdateHooks, publisherPausedRuleHook)
+		publisherPausedRuleAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		publisherPausedRuleBeforeDeleteMu.Lock()
+		publisherPausedRuleBeforeDeleteHooks = append(publisherPausedRuleBeforeDeleteHooks, publisherPausedRuleHook)
+		publisherPausedRuleBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		publisherPausedRuleAfterDeleteMu.Lock()
+		publisherPausedRuleAfterDeleteHooks = append(publisherPausedRuleAfterDeleteHooks, publisherPausedRuleHook)
+		publisherPausedRuleAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		publisherPausedRuleBeforeUpsertMu.Lock()
+		publisherPausedRuleBeforeUpsertHooks = append(publisherPausedRuleBeforeUpsertHooks, publisherPausedRuleHook)
+		publisherPausedRuleBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		publisherPausedRuleAfterUpsertMu.Lock()
+		publisherPausedRuleAfterUpsertHooks = append(publisherPausedRuleAfterUpsertHooks, publisherPausedRuleHook)
+		publisherPausedRuleAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single publisherPausedRule record from the query.
+func (q publisherPausedRuleQuery) One(ctx context.Context, exec boil.ContextExecutor) (*PublisherPausedRule, error) {
+	o := &PublisherPausedRule{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for publisher_paused_rule")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all PublisherPausedRule records from the query.
+func (q publisherPausedRuleQuery) All(ctx context.Context, exec boil.ContextExecutor) (PublisherPausedRuleSlice, error) {
+	var o []*PublisherPausedRule
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to PublisherPausedRule slice")
+	}
+
+	if len(publisherPausedRuleAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all PublisherPausedRule records in the query.
+func (q publisherPausedRuleQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count publisher_paused_rule rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q publisherPausedRuleQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if publisher_paused_rule exists")
+	}
+
+	return count > 0, nil
+}
+
+// PublisherPausedRules retrieves all the records using an executor.
+func PublisherPausedRules(mods ...qm.QueryMod) publisherPausedRuleQuery {
+	mods = append(mods, qm.From("\"publisher_paused_rule\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"publisher_paused_rule\".*"})
+	}
+
+	return publisherPausedRuleQuery{q}
+}
+
+// FindPublisherPausedRule retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPublisherPausedRule(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*PublisherPausedRule, error) {
+	publisherPausedRuleObj := &PublisherPausedRule{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"publisher_paused_rule\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, publisherPausedRuleObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from publisher_paused_rule")
+	}
+
+	if err = publisherPausedRuleObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return publisherPausedRuleObj, err
+	}
+
+	return publisherPausedRuleObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *PublisherPausedRule) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no publisher_paused_rule provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(publisherPausedRuleColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	publisherPausedRuleInsertCacheMut.RLock()
+	cache, cached := publisherPausedRuleInsertCache[key]
+	publisherPausedRuleInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			publisherPausedRuleAllColumns,
+			publisherPausedRuleColumnsWithDefault,
+			publisherPausedRuleColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(publisherPausedRuleType, publisherPausedRuleMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(publisherPausedRuleType, publisherPausedRuleMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"publisher_paused_rule\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"publisher_paused_rule\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into publisher_paused_rule")
+	}
+
+	if !cached {
+		publisherPausedRuleInsertCacheMut.Lock()
+		publisherPausedRuleInsertCache[key] = cache
+		publisherPausedRuleInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the PublisherPausedRule.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *PublisherPausedRule) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	publisherPausedRuleUpdateCacheMut.RLock()
+	cache, cached := publisherPausedRuleUpdateCache[key]
+	publisherPausedRuleUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			publisherPausedRuleAllColumns,
+			publisherPausedRulePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update publisher_paused_rule, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"publisher_paused_rule\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, publisherPausedRulePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(publisherPausedRuleType, publisherPausedRuleMapping, append(wl, publisherPausedRulePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update publisher_paused_rule row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for publisher_paused_rule")
+	}
+
+	if !cached {
+		publisherPausedRuleUpdateCacheMut.Lock()
+		publisherPausedRuleUpdateCache[key] = cache
+		publisherPausedRuleUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q publisherPausedRuleQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for publisher_paused_rule")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for publisher_paused_rule")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PublisherPausedRuleSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), publisherPausedRulePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"publisher_paused_rule\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, publisherPausedRulePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in publisherPausedRule slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all publisherPausedRule")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *PublisherPausedRule) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no publisher_paused_rule provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(publisherPausedRuleColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	publisherPausedRuleUpsertCacheMut.RLock()
+	cache, cached := publisherPausedRuleUpsertCache[key]
+	publisherPausedRuleUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			publisherPausedRuleAllColumns,
+			publisherPausedRuleColumnsWithDefault,
+			publisherPausedRuleColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			publisherPausedRuleAllColumns,
+			publisherPausedRulePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert publisher_paused_rule, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(publisherPausedRuleAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(publisherPausedRulePrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert publisher_paused_rule, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(publisherPausedRulePrimaryKeyColumns))
+			copy(conflict, publisherPausedRulePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"publisher_paused_rule\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(publisherPausedRuleType, publisherPausedRuleMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(publisherPausedRuleType, publisherPausedRuleMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert publisher_paused_rule")
+	}
+
+	if !cached {
+		publisherPausedRuleUpsertCacheMut.Lock()
+		publisherPausedRuleUpsertCache[key] = cache
+		publisherPausedRuleUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single PublisherPausedRule record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *PublisherPausedRule) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no PublisherPausedRule provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), publisherPausedRulePrimaryKeyMapping)
+	sql := "DELETE FROM \"publisher_paused_rule\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from publisher_paused_rule")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for publisher_paused_rule")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q publisherPausedRuleQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no publisherPausedRuleQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from publisher_paused_rule")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for publisher_paused_rule")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PublisherPausedRuleSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(publisherPausedRuleBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), publisherPausedRulePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"publisher_paused_rule\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, publisherPausedRulePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from publisherPausedRule slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for publisher_paused_rule")
+	}
+
+	if len(publisherPausedRuleAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *PublisherPausedRule) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindPublisherPausedRule(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PublisherPausedRuleSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PublisherPausedRuleSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), publisherPausedRulePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"publisher_paused_rule\".* FROM \"publisher_paused_rule\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, publisherPausedRulePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in PublisherPausedRuleSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PublisherPausedRuleExists checks if the PublisherPausedRule row exists.
+func PublisherPausedRuleExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"publisher_paused_rule\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if publisher_paused_rule exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the PublisherPausedRule row exists.
+func (o *PublisherPausedRule) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return PublisherPausedRuleExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testPublisherPausedRules(t *testing.T) {
+	t.Parallel()
+
+	query := PublisherPausedRules()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testPublisherPausedRulesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherPausedRule{}
+	if err = randomize.Struct(seed, o, publisherPausedRuleDBTypes, true, publisherPausedRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherPausedRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PublisherPausedRules().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPublisherPausedRulesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherPausedRule{}
+	if err = randomize.Struct(seed, o, publisherPausedRuleDBTypes, true, publisherPausedRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherPausedRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := PublisherPausedRules().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PublisherPausedRules().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPublisherPausedRulesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherPausedRule{}
+	if err = randomize.Struct(seed, o, publisherPausedRuleDBTypes, true, publisherPausedRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherPausedRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PublisherPausedRuleSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PublisherPausedRules().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPublisherPausedRulesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherPausedRule{}
+	if err = randomize.Struct(seed, o, publisherPausedRuleDBTypes, true, publisherPausedRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherPausedRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := PublisherPausedRuleExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if PublisherPausedRule exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected PublisherPausedRuleExists to return true, but got false.")
+	}
+}
+
+func testPublisherPausedRulesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherPausedRule{}
+	if err = randomize.Struct(seed, o, publisherPausedRuleDBTypes, true, publisherPausedRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherPausedRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	publisherPausedRuleFound, err := FindPublisherPausedRule(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if publisherPausedRuleFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testPublisherPausedRulesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherPausedRule{}
+	if err = randomize.Struct(seed, o, publisherPausedRuleDBTypes, true, publisherPausedRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherPausedRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = PublisherPausedRules().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPublisherPausedRulesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherPausedRule{}
+	if err = randomize.Struct(seed, o, publisherPausedRuleDBTypes, true, publisherPausedRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherPausedRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := PublisherPausedRules().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testPublisherPausedRulesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	publisherPausedRuleOne := &PublisherPausedRule{}
+	publisherPausedRuleTwo := &PublisherPausedRule{}
+	if err = randomize.Struct(seed, publisherPausedRuleOne, publisherPausedRuleDBTypes, false, publisherPausedRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherPausedRule struct: %s", err)
+	}
+	if err = randomize.Struct(seed, publisherPausedRuleTwo, publisherPausedRuleDBTypes, false, publisherPausedRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherPausedRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = publisherPausedRuleOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = publisherPausedRuleTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PublisherPausedRules().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testPublisherPausedRulesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	publisherPausedRuleOne := &PublisherPausedRule{}
+	publisherPausedRuleTwo := &PublisherPausedRule{}
+	if err = randomize.Struct(seed, publisherPausedRuleOne, publisherPausedRuleDBTypes, false, publisherPausedRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherPausedRule struct: %s", err)
+	}
+	if err = randomize.Struct(seed, publisherPausedRuleTwo, publisherPausedRuleDBTypes, false, publisherPausedRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherPausedRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = publisherPausedRuleOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = publisherPausedRuleTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PublisherPausedRules().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func publisherPausedRuleBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *PublisherPausedRule) error {
+	*o = PublisherPausedRule{}
+	return nil
+}
+
+func publisherPausedRuleAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *PublisherPausedRule) error {
+	*o = PublisherPausedRule{}
+	return nil
+}
+
+func publisherPausedRuleAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *PublisherPausedRule) error {
+	*o = PublisherPausedRule{}
+	return nil
+}
+
+func publisherPausedRuleBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *PublisherPausedRule) error {
+	*o = PublisherPausedRule{}
+	return nil
+}
+
+func publisherPausedRuleAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *PublisherPausedRule) error {
+	*o = PublisherPausedRule{}
+	return nil
+}
+
+func publisherPausedRuleBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *PublisherPausedRule) error {
+	*o = PublisherPausedRule{}
+	return nil
+}
+
+func publisherPausedRuleAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *PublisherPausedRule) error {
+	*o = PublisherPausedRule{}
+	return nil
+}
+
+func publisherPausedRuleBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *PublisherPausedRule) error {
+	*o = PublisherPausedRule{}
+	return nil
+}
+
+func publisherPausedRuleAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *PublisherPausedRule) error {
+	*o = PublisherPausedRule{}
+	return nil
+}
+
+func testPublisherPausedRulesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &PublisherPausedRule{}
+	o := &PublisherPausedRule{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, publisherPausedRuleDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize PublisherPausedRule object: %s", err)
+	}
+
+	AddPublisherPausedRuleHook(boil.BeforeInsertHook, publisherPausedRuleBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	publisherPausedRuleBeforeInsertHooks = []PublisherPausedRuleHook{}
+
+	AddPublisherPausedRuleHook(boil.AfterInsertHook, publisherPausedRuleAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	publisherPausedRuleAfterInsertHooks = []PublisherPausedRuleHook{}
+
+	AddPublisherPausedRuleHook(boil.AfterSelectHook, publisherPausedRuleAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	publisherPausedRuleAfterSelectHooks = []PublisherPausedRuleHook{}
+
+	AddPublisherPausedRuleHook(boil.BeforeUpdateHook, publisherPausedRuleBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	publisherPausedRuleBeforeUpdateHooks = []PublisherPausedRuleHook{}
+
+	AddPublisherPausedRuleHook(boil.AfterUpdateHook, publisherPausedRuleAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	publisherPausedRuleAfterUpdateHooks = []PublisherPausedRuleHook{}
+
+	AddPublisherPausedRuleHook(boil.BeforeDeleteHook, publisherPausedRuleBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	publisherPausedRuleBeforeDeleteHooks = []PublisherPausedRuleHook{}
+
+	AddPublisherPausedRuleHook(boil.AfterDeleteHook, publisherPausedRuleAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	publisherPausedRuleAfterDeleteHooks = []PublisherPausedRuleHook{}
+
+	AddPublisherPausedRuleHook(boil.BeforeUpsertHook, publisherPausedRuleBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	publisherPausedRuleBeforeUpsertHooks = []PublisherPausedRuleHook{}
+
+	AddPublisherPausedRuleHook(boil.AfterUpsertHook, publisherPausedRuleAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	publisherPausedRuleAfterUpsertHooks = []PublisherPausedRuleHook{}
+}
+
+func testPublisherPausedRulesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherPausedRule{}
+	if err = randomize.Struct(seed, o, publisherPausedRuleDBTypes, true, publisherPausedRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherPausedRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PublisherPausedRules().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPublisherPausedRulesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherPausedRule{}
+	if err = randomize.Struct(seed, o, publisherPausedRuleDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PublisherPausedRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(publisherPausedRuleColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PublisherPausedRules().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPublisherPausedRulesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherPausedRule{}
+	if err = randomize.Struct(seed, o, publisherPausedRuleDBTypes, true, publisherPausedRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherPausedRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPublisherPausedRulesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherPausedRule{}
+	if err = randomize.Struct(seed, o, publisherPausedRuleDBTypes, true, publisherPausedRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherPausedRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PublisherPausedRuleSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPublisherPausedRulesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherPausedRule{}
+	if err = randomize.Struct(seed, o, publisherPausedRuleDBTypes, true, publisherPausedRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherPausedRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PublisherPausedRules().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	publisherPausedRuleDBTypes = map[string]string{`ID`: `integer`, `PublisherID`: `character varying`, `RuleType`: `character varying`, `RuleID`: `character varying`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                          = bytes.MinRead
+)
+
+func testPublisherPausedRulesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(publisherPausedRulePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(publisherPausedRuleAllColumns) == len(publisherPausedRulePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherPausedRule{}
+	if err = randomize.Struct(seed, o, publisherPausedRuleDBTypes, true, publisherPausedRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherPausedRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PublisherPausedRules().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, publisherPausedRuleDBTypes, true, publisherPausedRulePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PublisherPausedRule struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testPublisherPausedRulesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(publisherPausedRuleAllColumns) == len(publisherPausedRulePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherPausedRule{}
+	if err = randomize.Struct(seed, o, publisherPausedRuleDBTypes, true, publisherPausedRuleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherPausedRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PublisherPausedRules().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, publisherPausedRuleDBTypes, true, publisherPausedRulePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PublisherPausedRule struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(publisherPausedRuleAllColumns, publisherPausedRulePrimaryKeyColumns) {
+		fields = publisherPausedRuleAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			publisherPausedRuleAllColumns,
+			publisherPausedRulePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := PublisherPausedRuleSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testPublisherPausedRulesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(publisherPausedRuleAllColumns) == len(publisherPausedRulePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := PublisherPausedRule{}
+	if err = randomize.Struct(seed, &o, publisherPausedRuleDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PublisherPausedRule struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PublisherPausedRule: %s", err)
+	}
+
+	count, err := PublisherPausedRules().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, publisherPausedRuleDBTypes, false, publisherPausedRulePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PublisherPausedRule struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PublisherPausedRule: %s", err)
+	}
+
+	count, err = PublisherPausedRules().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
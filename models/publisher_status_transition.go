@@ -0,0 +1,995 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// PublisherStatusTransition is an object representing the database table.
+type PublisherStatusTransition struct {
+	ID          int         `boil:"id" json:"id" toml:"id" yaml:"id"`
+	PublisherID string      `boil:"publisher_id" json:"publisher_id" toml:"publisher_id" yaml:"publisher_id"`
+	FromStatus  null.String `boil:"from_status" json:"from_status,omitempty" toml:"from_status" yaml:"from_status,omitempty"`
+	ToStatus    string      `boil:"to_status" json:"to_status" toml:"to_status" yaml:"to_status"`
+	Source      string      `boil:"source" json:"source" toml:"source" yaml:"source"`
+	CreatedBy   null.Int    `boil:"created_by" json:"created_by,omitempty" toml:"created_by" yaml:"created_by,omitempty"`
+	CreatedAt   time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt   null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *publisherStatusTransitionR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L publisherStatusTransitionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PublisherStatusTransitionColumns = struct {
+	ID          string
+	PublisherID string
+	FromStatus  string
+	ToStatus    string
+	Source      string
+	CreatedBy   string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "id",
+	PublisherID: "publisher_id",
+	FromStatus:  "from_status",
+	ToStatus:    "to_status",
+	Source:      "source",
+	CreatedBy:   "created_by",
+	CreatedAt:   "created_at",
+	UpdatedAt:   "updated_at",
+}
+
+var PublisherStatusTransitionTableColumns = struct {
+	ID          string
+	PublisherID string
+	FromStatus  string
+	ToStatus    string
+	Source      string
+	CreatedBy   string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "publisher_status_transition.id",
+	PublisherID: "publisher_status_transition.publisher_id",
+	FromStatus:  "publisher_status_transition.from_status",
+	ToStatus:    "publisher_status_transition.to_status",
+	Source:      "publisher_status_transition.source",
+	CreatedBy:   "publisher_status_transition.created_by",
+	CreatedAt:   "publisher_status_transition.created_at",
+	UpdatedAt:   "publisher_status_transition.updated_at",
+}
+
+// Generated where
+
+var PublisherStatusTransitionWhere = struct {
+	ID          whereHelperint
+	PublisherID whereHelperstring
+	FromStatus  whereHelpernull_String
+	ToStatus    whereHelperstring
+	Source      whereHelperstring
+	CreatedBy   whereHelpernull_Int
+	CreatedAt   whereHelpertime_Time
+	UpdatedAt   whereHelpernull_Time
+}{
+	ID:          whereHelperint{field: "\"publisher_status_transition\".\"id\""},
+	PublisherID: whereHelperstring{field: "\"publisher_status_transition\".\"publisher_id\""},
+	FromStatus:  whereHelpernull_String{field: "\"publisher_status_transition\".\"from_status\""},
+	ToStatus:    whereHelperstring{field: "\"publisher_status_transition\".\"to_status\""},
+	Source:      whereHelperstring{field: "\"publisher_status_transition\".\"source\""},
+	CreatedBy:   whereHelpernull_Int{field: "\"publisher_status_transition\".\"created_by\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"publisher_status_transition\".\"created_at\""},
+	UpdatedAt:   whereHelpernull_Time{field: "\"publisher_status_transition\".\"updated_at\""},
+}
+
+// PublisherStatusTransitionRels is where relationship names are stored.
+var PublisherStatusTransitionRels = struct {
+}{}
+
+// publisherStatusTransitionR is where relationships are stored.
+type publisherStatusTransitionR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*publisherStatusTransitionR) NewStruct() *publisherStatusTransitionR {
+	return &publisherStatusTransitionR{}
+}
+
+// publisherStatusTransitionL is where Load methods for each relationship are stored.
+type publisherStatusTransitionL struct{}
+
+var (
+	publisherStatusTransitionAllColumns            = []string{"id", "publisher_id", "from_status", "to_status", "source", "created_by", "created_at", "updated_at"}
+	publisherStatusTransitionColumnsWithoutDefault = []string{"publisher_id", "to_status", "source", "created_at"}
+	publisherStatusTransitionColumnsWithDefault    = []string{"id", "from_status", "created_by", "updated_at"}
+	publisherStatusTransitionPrimaryKeyColumns     = []string{"id"}
+	publisherStatusTransitionGeneratedColumns      = []string{}
+)
+
+type (
+	// PublisherStatusTransitionSlice is an alias for a slice of pointers to PublisherStatusTransition.
+	// This should almost always be used instead of []PublisherStatusTransition.
+	PublisherStatusTransitionSlice []*PublisherStatusTransition
+	// PublisherStatusTransitionHook is the signature for custom PublisherStatusTransition hook methods
+	PublisherStatusTransitionHook func(context.Context, boil.ContextExecutor, *PublisherStatusTransition) error
+
+	publisherStatusTransitionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	publisherStatusTransitionType                 = reflect.TypeOf(&PublisherStatusTransition{})
+	publisherStatusTransitionMapping              = queries.MakeStructMapping(publisherStatusTransitionType)
+	publisherStatusTransitionPrimaryKeyMapping, _ = queries.BindMapping(publisherStatusTransitionType, publisherStatusTransitionMapping, publisherStatusTransitionPrimaryKeyColumns)
+	publisherStatusTransitionInsertCacheMut       sync.RWMutex
+	publisherStatusTransitionInsertCache          = make(map[string]insertCache)
+	publisherStatusTransitionUpdateCacheMut       sync.RWMutex
+	publisherStatusTransitionUpdateCache          = make(map[string]updateCache)
+	publisherStatusTransitionUpsertCacheMut       sync.RWMutex
+	publisherStatusTransitionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var publisherStatusTransitionAfterSelectMu sync.Mutex
+var publisherStatusTransitionAfterSelectHooks []PublisherStatusTransitionHook
+
+var publisherStatusTransitionBeforeInsertMu sync.Mutex
+var publisherStatusTransitionBeforeInsertHooks []PublisherStatusTransitionHook
+var publisherStatusTransitionAfterInsertMu sync.Mutex
+var publisherStatusTransitionAfterInsertHooks []PublisherStatusTransitionHook
+
+var publisherStatusTransitionBeforeUpdateMu sync.Mutex
+var publisherStatusTransitionBeforeUpdateHooks []PublisherStatusTransitionHook
+var publisherStatusTransitionAfterUpdateMu sync.Mutex
+var publisherStatusTransitionAfterUpdateHooks []PublisherStatusTransitionHook
+
+var publisherStatusTransitionBeforeDeleteMu sync.Mutex
+var publisherStatusTransitionBeforeDeleteHooks []PublisherStatusTransitionHook
+var publisherStatusTransitionAfterDeleteMu sync.Mutex
+var publisherStatusTransitionAfterDeleteHooks []PublisherStatusTransitionHook
+
+var publisherStatusTransitionBeforeUpsertMu sync.Mutex
+var publisherStatusTransitionBeforeUpsertHooks []PublisherStatusTransitionHook
+var publisherStatusTransitionAfterUpsertMu sync.Mutex
+var publisherStatusTransitionAfterUpsertHooks []PublisherStatusTransitionHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *PublisherStatusTransition) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range publisherStatusTransitionAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *PublisherStatusTransition) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range publisherStatusTransitionBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *PublisherStatusTransition) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range publisherStatusTransitionAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *PublisherStatusTransition) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range publisherStatusTransitionBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *PublisherStatusTransition) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range publisherStatusTransitionAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *PublisherStatusTransition) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range publisherStatusTransitionBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *PublisherStatusTransition) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range publisherStatusTransitionAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *PublisherStatusTransition) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range publisherStatusTransitionBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *PublisherStatusTransition) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range publisherStatusTransitionAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddPublisherStatusTransitionHook registers your hook function for all future operations.
+func AddPublisherStatusTransitionHook(hookPoint boil.HookPoint, publisherStatusTransitionHook PublisherStatusTransitionHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		publisherStatusTransitionAfterSelectMu.Lock()
+		publisherStatusTransitionAfterSelectHooks = append(publisherStatusTransitionAfterSelectHooks, publisherStatusTransitionHook)
+		publisherStatusTransitionAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		publisherStatusTransitionBeforeInsertMu.Lock()
+		publisherStatusTransitionBeforeInsertHooks = append(publisherStatusTransitionBeforeInsertHooks, publisherStatusTransitionHook)
+		publisherStatusTransitionBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		publisherStatusTransitionAfterInsertMu.Lock()
+		publisherStatusTransitionAfterInsertHooks = append(publisherStatusTransitionAfterInsertHooks, publisherStatusTransitionHook)
+		publisherStatusTransitionAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		publisherStatusTransitionBeforeUpdateMu.Lock()
+		publisherStatusTransitionBeforeUpdateHooks = append(publisherStatusTransitionBeforeUpdateHooks, publisherStatusTransitionHook)
+		publisherStatusTransitionBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		publisherStatusTransitionAfterUpdateMu.Lock()
+		publisherStatusTransitionAfterUpdateHooks = append(publisherStatusTransitionAfterUpdateHooks, publisherStatusTransitionHook)
+		publisherStatusTransitionAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		publisherStatusTransitionBeforeDeleteMu.Lock()
+		publisherStatusTransitionBeforeDeleteHooks = append(publisherStatusTransitionBeforeDeleteHooks, publisherStatusTransitionHook)
+		publisherStatusTransitionBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		publisherStatusTransitionAfterDeleteMu.Lock()
+		publisherStatusTransitionAfterDeleteHooks = append(publisherStatusTransitionAfterDeleteHooks, publisherStatusTransitionHook)
+		publisherStatusTransitionAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		publisherStatusTransitionBeforeUpsertMu.Lock()
+		publisherStatusTransitionBeforeUpsertHooks = append(publisherStatusTransitionBeforeUpsertHooks, publisherStatusTransitionHook)
+		publisherStatusTransitionBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		publisherStatusTransitionAfterUpsertMu.Lock()
+		publisherStatusTransitionAfterUpsertHooks = append(publisherStatusTransitionAfterUpsertHooks, publisherStatusTransitionHook)
+		publisherStatusTransitionAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single publisherStatusTransition record from the query.
+func (q publisherStatusTransitionQuery) One(ctx context.Context, exec boil.ContextExecutor) (*PublisherStatusTransition, error) {
+	o := &PublisherStatusTransition{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for publisher_status_transition")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all PublisherStatusTransition records from the query.
+func (q publisherStatusTransitionQuery) All(ctx context.Context, exec boil.ContextExecutor) (PublisherStatusTransitionSlice, error) {
+	var o []*PublisherStatusTransition
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to PublisherStatusTransition slice")
+	}
+
+	if len(publisherStatusTransitionAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all PublisherStatusTransition records in the query.
+func (q publisherStatusTransitionQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count publisher_status_transition rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q publisherStatusTransitionQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if publisher_status_transition exists")
+	}
+
+	return count > 0, nil
+}
+
+// PublisherStatusTransitions retrieves all the records using an executor.
+func PublisherStatusTransitions(mods ...qm.QueryMod) publisherStatusTransitionQuery {
+	mods = append(mods, qm.From("\"publisher_status_transition\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"publisher_status_transition\".*"})
+	}
+
+	return publisherStatusTransitionQuery{q}
+}
+
+// FindPublisherStatusTransition retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPublisherStatusTransition(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*PublisherStatusTransition, error) {
+	publisherStatusTransitionObj := &PublisherStatusTransition{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"publisher_status_transition\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, publisherStatusTransitionObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from publisher_status_transition")
+	}
+
+	if err = publisherStatusTransitionObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return publisherStatusTransitionObj, err
+	}
+
+	return publisherStatusTransitionObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *PublisherStatusTransition) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no publisher_status_transition provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(publisherStatusTransitionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	publisherStatusTransitionInsertCacheMut.RLock()
+	cache, cached := publisherStatusTransitionInsertCache[key]
+	publisherStatusTransitionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			publisherStatusTransitionAllColumns,
+			publisherStatusTransitionColumnsWithDefault,
+			publisherStatusTransitionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(publisherStatusTransitionType, publisherStatusTransitionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(publisherStatusTransitionType, publisherStatusTransitionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"publisher_status_transition\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"publisher_status_transition\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into publisher_status_transition")
+	}
+
+	if !cached {
+		publisherStatusTransitionInsertCacheMut.Lock()
+		publisherStatusTransitionInsertCache[key] = cache
+		publisherStatusTransitionInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the PublisherStatusTransition.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *PublisherStatusTransition) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	publisherStatusTransitionUpdateCacheMut.RLock()
+	cache, cached := publisherStatusTransitionUpdateCache[key]
+	publisherStatusTransitionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			publisherStatusTransitionAllColumns,
+			publisherStatusTransitionPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update publisher_status_transition, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"publisher_status_transition\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, publisherStatusTransitionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(publisherStatusTransitionType, publisherStatusTransitionMapping, append(wl, publisherStatusTransitionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update publisher_status_transition row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for publisher_status_transition")
+	}
+
+	if !cached {
+		publisherStatusTransitionUpdateCacheMut.Lock()
+		publisherStatusTransitionUpdateCache[key] = cache
+		publisherStatusTransitionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q publisherStatusTransitionQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for publisher_status_transition")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for publisher_status_transition")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PublisherStatusTransitionSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), publisherStatusTransitionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"publisher_status_transition\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, publisherStatusTransitionPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in publisherStatusTransition slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all publisherStatusTransition")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *PublisherStatusTransition) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no publisher_status_transition provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(publisherStatusTransitionColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	publisherStatusTransitionUpsertCacheMut.RLock()
+	cache, cached := publisherStatusTransitionUpsertCache[key]
+	publisherStatusTransitionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			publisherStatusTransitionAllColumns,
+			publisherStatusTransitionColumnsWithDefault,
+			publisherStatusTransitionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			publisherStatusTransitionAllColumns,
+			publisherStatusTransitionPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert publisher_status_transition, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(publisherStatusTransitionAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(publisherStatusTransitionPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert publisher_status_transition, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(publisherStatusTransitionPrimaryKeyColumns))
+			copy(conflict, publisherStatusTransitionPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"publisher_status_transition\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(publisherStatusTransitionType, publisherStatusTransitionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(publisherStatusTransitionType, publisherStatusTransitionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert publisher_status_transition")
+	}
+
+	if !cached {
+		publisherStatusTransitionUpsertCacheMut.Lock()
+		publisherStatusTransitionUpsertCache[key] = cache
+		publisherStatusTransitionUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single PublisherStatusTransition record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *PublisherStatusTransition) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no PublisherStatusTransition provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), publisherStatusTransitionPrimaryKeyMapping)
+	sql := "DELETE FROM \"publisher_status_transition\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from publisher_status_transition")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for publisher_status_transition")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q publisherStatusTransitionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no publisherStatusTransitionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from publisher_status_transition")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for publisher_status_transition")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PublisherStatusTransitionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(publisherStatusTransitionBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), publisherStatusTransitionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"publisher_status_transition\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, publisherStatusTransitionPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from publisherStatusTransition slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for publisher_status_transition")
+	}
+
+	if len(publisherStatusTransitionAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *PublisherStatusTransition) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindPublisherStatusTransition(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PublisherStatusTransitionSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PublisherStatusTransitionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), publisherStatusTransitionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"publisher_status_transition\".* FROM \"publisher_status_transition\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, publisherStatusTransitionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in PublisherStatusTransitionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PublisherStatusTransitionExists checks if the PublisherStatusTransition row exists.
+func PublisherStatusTransitionExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"publisher_status_transition\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if publisher_status_transition exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the PublisherStatusTransition row exists.
+func (o *PublisherStatusTransition) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return PublisherStatusTransitionExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testPublisherStatusTransitions(t *testing.T) {
+	t.Parallel()
+
+	query := PublisherStatusTransitions()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testPublisherStatusTransitionsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherStatusTransition{}
+	if err = randomize.Struct(seed, o, publisherStatusTransitionDBTypes, true, publisherStatusTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherStatusTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PublisherStatusTransitions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPublisherStatusTransitionsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherStatusTransition{}
+	if err = randomize.Struct(seed, o, publisherStatusTransitionDBTypes, true, publisherStatusTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherStatusTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := PublisherStatusTransitions().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PublisherStatusTransitions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPublisherStatusTransitionsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherStatusTransition{}
+	if err = randomize.Struct(seed, o, publisherStatusTransitionDBTypes, true, publisherStatusTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherStatusTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PublisherStatusTransitionSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PublisherStatusTransitions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPublisherStatusTransitionsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherStatusTransition{}
+	if err = randomize.Struct(seed, o, publisherStatusTransitionDBTypes, true, publisherStatusTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherStatusTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := PublisherStatusTransitionExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if PublisherStatusTransition exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected PublisherStatusTransitionExists to return true, but got false.")
+	}
+}
+
+func testPublisherStatusTransitionsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherStatusTransition{}
+	if err = randomize.Struct(seed, o, publisherStatusTransitionDBTypes, true, publisherStatusTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherStatusTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	publisherStatusTransitionFound, err := FindPublisherStatusTransition(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if publisherStatusTransitionFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testPublisherStatusTransitionsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherStatusTransition{}
+	if err = randomize.Struct(seed, o, publisherStatusTransitionDBTypes, true, publisherStatusTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherStatusTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = PublisherStatusTransitions().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPublisherStatusTransitionsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherStatusTransition{}
+	if err = randomize.Struct(seed, o, publisherStatusTransitionDBTypes, true, publisherStatusTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherStatusTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := PublisherStatusTransitions().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testPublisherStatusTransitionsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	publisherStatusTransitionOne := &PublisherStatusTransition{}
+	publisherStatusTransitionTwo := &PublisherStatusTransition{}
+	if err = randomize.Struct(seed, publisherStatusTransitionOne, publisherStatusTransitionDBTypes, false, publisherStatusTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherStatusTransition struct: %s", err)
+	}
+	if err = randomize.Struct(seed, publisherStatusTransitionTwo, publisherStatusTransitionDBTypes, false, publisherStatusTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherStatusTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = publisherStatusTransitionOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = publisherStatusTransitionTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PublisherStatusTransitions().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testPublisherStatusTransitionsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	publisherStatusTransitionOne := &PublisherStatusTransition{}
+	publisherStatusTransitionTwo := &PublisherStatusTransition{}
+	if err = randomize.Struct(seed, publisherStatusTransitionOne, publisherStatusTransitionDBTypes, false, publisherStatusTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherStatusTransition struct: %s", err)
+	}
+	if err = randomize.Struct(seed, publisherStatusTransitionTwo, publisherStatusTransitionDBTypes, false, publisherStatusTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherStatusTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = publisherStatusTransitionOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = publisherStatusTransitionTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PublisherStatusTransitions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func publisherStatusTransitionBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *PublisherStatusTransition) error {
+	*o = PublisherStatusTransition{}
+	return nil
+}
+
+func publisherStatusTransitionAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *PublisherStatusTransition) error {
+	*o = PublisherStatusTransition{}
+	return nil
+}
+
+func publisherStatusTransitionAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *PublisherStatusTransition) error {
+	*o = PublisherStatusTransition{}
+	return nil
+}
+
+func publisherStatusTransitionBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *PublisherStatusTransition) error {
+	*o = PublisherStatusTransition{}
+	return nil
+}
+
+func publisherStatusTransitionAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *PublisherStatusTransition) error {
+	*o = PublisherStatusTransition{}
+	return nil
+}
+
+func publisherStatusTransitionBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *PublisherStatusTransition) error {
+	*o = PublisherStatusTransition{}
+	return nil
+}
+
+func publisherStatusTransitionAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *PublisherStatusTransition) error {
+	*o = PublisherStatusTransition{}
+	return nil
+}
+
+func publisherStatusTransitionBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *PublisherStatusTransition) error {
+	*o = PublisherStatusTransition{}
+	return nil
+}
+
+func publisherStatusTransitionAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *PublisherStatusTransition) error {
+	*o = PublisherStatusTransition{}
+	return nil
+}
+
+func testPublisherStatusTransitionsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &PublisherStatusTransition{}
+	o := &PublisherStatusTransition{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, publisherStatusTransitionDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize PublisherStatusTransition object: %s", err)
+	}
+
+	AddPublisherStatusTransitionHook(boil.BeforeInsertHook, publisherStatusTransitionBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	publisherStatusTransitionBeforeInsertHooks = []PublisherStatusTransitionHook{}
+
+	AddPublisherStatusTransitionHook(boil.AfterInsertHook, publisherStatusTransitionAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	publisherStatusTransitionAfterInsertHooks = []PublisherStatusTransitionHook{}
+
+	AddPublisherStatusTransitionHook(boil.AfterSelectHook, publisherStatusTransitionAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	publisherStatusTransitionAfterSelectHooks = []PublisherStatusTransitionHook{}
+
+	AddPublisherStatusTransitionHook(boil.BeforeUpdateHook, publisherStatusTransitionBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	publisherStatusTransitionBeforeUpdateHooks = []PublisherStatusTransitionHook{}
+
+	AddPublisherStatusTransitionHook(boil.AfterUpdateHook, publisherStatusTransitionAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	publisherStatusTransitionAfterUpdateHooks = []PublisherStatusTransitionHook{}
+
+	AddPublisherStatusTransitionHook(boil.BeforeDeleteHook, publisherStatusTransitionBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	publisherStatusTransitionBeforeDeleteHooks = []PublisherStatusTransitionHook{}
+
+	AddPublisherStatusTransitionHook(boil.AfterDeleteHook, publisherStatusTransitionAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	publisherStatusTransitionAfterDeleteHooks = []PublisherStatusTransitionHook{}
+
+	AddPublisherStatusTransitionHook(boil.BeforeUpsertHook, publisherStatusTransitionBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	publisherStatusTransitionBeforeUpsertHooks = []PublisherStatusTransitionHook{}
+
+	AddPublisherStatusTransitionHook(boil.AfterUpsertHook, publisherStatusTransitionAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	publisherStatusTransitionAfterUpsertHooks = []PublisherStatusTransitionHook{}
+}
+
+func testPublisherStatusTransitionsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherStatusTransition{}
+	if err = randomize.Struct(seed, o, publisherStatusTransitionDBTypes, true, publisherStatusTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherStatusTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PublisherStatusTransitions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPublisherStatusTransitionsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherStatusTransition{}
+	if err = randomize.Struct(seed, o, publisherStatusTransitionDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PublisherStatusTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(publisherStatusTransitionColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PublisherStatusTransitions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPublisherStatusTransitionsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherStatusTransition{}
+	if err = randomize.Struct(seed, o, publisherStatusTransitionDBTypes, true, publisherStatusTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherStatusTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPublisherStatusTransitionsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherStatusTransition{}
+	if err = randomize.Struct(seed, o, publisherStatusTransitionDBTypes, true, publisherStatusTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherStatusTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PublisherStatusTransitionSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPublisherStatusTransitionsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherStatusTransition{}
+	if err = randomize.Struct(seed, o, publisherStatusTransitionDBTypes, true, publisherStatusTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherStatusTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PublisherStatusTransitions().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	publisherStatusTransitionDBTypes = map[string]string{`ID`: `integer`, `PublisherID`: `character varying`, `FromStatus`: `character varying`, `ToStatus`: `character varying`, `Source`: `character varying`, `CreatedBy`: `integer`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                                = bytes.MinRead
+)
+
+func testPublisherStatusTransitionsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(publisherStatusTransitionPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(publisherStatusTransitionAllColumns) == len(publisherStatusTransitionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherStatusTransition{}
+	if err = randomize.Struct(seed, o, publisherStatusTransitionDBTypes, true, publisherStatusTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherStatusTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PublisherStatusTransitions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, publisherStatusTransitionDBTypes, true, publisherStatusTransitionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PublisherStatusTransition struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testPublisherStatusTransitionsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(publisherStatusTransitionAllColumns) == len(publisherStatusTransitionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PublisherStatusTransition{}
+	if err = randomize.Struct(seed, o, publisherStatusTransitionDBTypes, true, publisherStatusTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PublisherStatusTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PublisherStatusTransitions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, publisherStatusTransitionDBTypes, true, publisherStatusTransitionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PublisherStatusTransition struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(publisherStatusTransitionAllColumns, publisherStatusTransitionPrimaryKeyColumns) {
+		fields = publisherStatusTransitionAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			publisherStatusTransitionAllColumns,
+			publisherStatusTransitionPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := PublisherStatusTransitionSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testPublisherStatusTransitionsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(publisherStatusTransitionAllColumns) == len(publisherStatusTransitionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := PublisherStatusTransition{}
+	if err = randomize.Struct(seed, &o, publisherStatusTransitionDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PublisherStatusTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PublisherStatusTransition: %s", err)
+	}
+
+	count, err := PublisherStatusTransitions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, publisherStatusTransitionDBTypes, false, publisherStatusTransitionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PublisherStatusTransition struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PublisherStatusTransition: %s", err)
+	}
+
+	count, err = PublisherStatusTransitions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -2755,7 +2755,7 @@ func testPublishersSelect(t *testing.T) {
 }
 
 var (
-	publisherDBTypes = map[string]string{`PublisherID`: `character varying`, `CreatedAt`: `timestamp without time zone`, `Name`: `character varying`, `AccountManagerID`: `character varying`, `MediaBuyerID`: `character varying`, `CampaignManagerID`: `character varying`, `OfficeLocation`: `character varying`, `PauseTimestamp`: `bigint`, `StartTimestamp`: `bigint`, `ReactivateTimestamp`: `bigint`, `IntegrationType`: `ARRAYcharacter varying`, `Status`: `character varying`, `MediaType`: `ARRAYcharacter varying`, `IsDirect`: `boolean`}
+	publisherDBTypes = map[string]string{`PublisherID`: `character varying`, `CreatedAt`: `timestamp without time zone`, `Name`: `character varying`, `AccountManagerID`: `character varying`, `MediaBuyerID`: `character varying`, `CampaignManagerID`: `character varying`, `OfficeLocation`: `character varying`, `PauseTimestamp`: `bigint`, `StartTimestamp`: `bigint`, `ReactivateTimestamp`: `bigint`, `IntegrationType`: `ARRAYcharacter varying`, `Status`: `character varying`, `MediaType`: `ARRAYcharacter varying`, `IsDirect`: `boolean`, `SellerDomain`: `character varying`, `SellerType`: `character varying`, `IsConfidential`: `boolean`, `StatusChangedAt`: `timestamp without time zone`}
 	_                = bytes.MinRead
 )
 
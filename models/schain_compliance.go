@@ -0,0 +1,1058 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// SchainCompliance is an object representing the database table.
+type SchainCompliance struct {
+	ID              int         `boil:"id" json:"id" toml:"id" yaml:"id"`
+	PublisherID     string      `boil:"publisher_id" json:"publisher_id" toml:"publisher_id" yaml:"publisher_id"`
+	DemandPartnerID null.String `boil:"demand_partner_id" json:"demand_partner_id,omitempty" toml:"demand_partner_id" yaml:"demand_partner_id,omitempty"`
+	SeatOwnerID     null.Int    `boil:"seat_owner_id" json:"seat_owner_id,omitempty" toml:"seat_owner_id" yaml:"seat_owner_id,omitempty"`
+	PartnerName     string      `boil:"partner_name" json:"partner_name" toml:"partner_name" yaml:"partner_name"`
+	AdsTXTDomain    string      `boil:"ads_txt_domain" json:"ads_txt_domain" toml:"ads_txt_domain" yaml:"ads_txt_domain"`
+	SellerID        string      `boil:"seller_id" json:"seller_id" toml:"seller_id" yaml:"seller_id"`
+	Relationship    string      `boil:"relationship" json:"relationship" toml:"relationship" yaml:"relationship"`
+	SellersJSONURL  null.String `boil:"sellers_json_url" json:"sellers_json_url,omitempty" toml:"sellers_json_url" yaml:"sellers_json_url,omitempty"`
+	DPStatus        string      `boil:"dp_status" json:"dp_status" toml:"dp_status" yaml:"dp_status"`
+	DPSellerType    null.String `boil:"dp_seller_type" json:"dp_seller_type,omitempty" toml:"dp_seller_type" yaml:"dp_seller_type,omitempty"`
+	OurStatus       string      `boil:"our_status" json:"our_status" toml:"our_status" yaml:"our_status"`
+	IsCompliant     bool        `boil:"is_compliant" json:"is_compliant" toml:"is_compliant" yaml:"is_compliant"`
+	ErrorMessage    null.String `boil:"error_message" json:"error_message,omitempty" toml:"error_message" yaml:"error_message,omitempty"`
+	CheckedAt       time.Time   `boil:"checked_at" json:"checked_at" toml:"checked_at" yaml:"checked_at"`
+	CreatedAt       time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt       null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *schainComplianceR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L schainComplianceL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var SchainComplianceColumns = struct {
+	ID              string
+	PublisherID     string
+	DemandPartnerID string
+	SeatOwnerID     string
+	PartnerName     string
+	AdsTXTDomain    string
+	SellerID        string
+	Relationship    string
+	SellersJSONURL  string
+	DPStatus        string
+	DPSellerType    string
+	OurStatus       string
+	IsCompliant     string
+	ErrorMessage    string
+	CheckedAt       string
+	CreatedAt       string
+	UpdatedAt       string
+}{
+	ID:              "id",
+	PublisherID:     "publisher_id",
+	DemandPartnerID: "demand_partner_id",
+	SeatOwnerID:     "seat_owner_id",
+	PartnerName:     "partner_name",
+	AdsTXTDomain:    "ads_txt_domain",
+	SellerID:        "seller_id",
+	Relationship:    "relationship",
+	SellersJSONURL:  "sellers_json_url",
+	DPStatus:        "dp_status",
+	DPSellerType:    "dp_seller_type",
+	OurStatus:       "our_status",
+	IsCompliant:     "is_compliant",
+	ErrorMessage:    "error_message",
+	CheckedAt:       "checked_at",
+	CreatedAt:       "created_at",
+	UpdatedAt:       "updated_at",
+}
+
+var SchainComplianceTableColumns = struct {
+	ID              string
+	PublisherID     string
+	DemandPartnerID string
+	SeatOwnerID     string
+	PartnerName     string
+	AdsTXTDomain    string
+	SellerID        string
+	Relationship    string
+	SellersJSONURL  string
+	DPStatus        string
+	DPSellerType    string
+	OurStatus       string
+	IsCompliant     string
+	ErrorMessage    string
+	CheckedAt       string
+	CreatedAt       string
+	UpdatedAt       string
+}{
+	ID:              "schain_compliance.id",
+	PublisherID:     "schain_compliance.publisher_id",
+	DemandPartnerID: "schain_compliance.demand_partner_id",
+	SeatOwnerID:     "schain_compliance.seat_owner_id",
+	PartnerName:     "schain_compliance.partner_name",
+	AdsTXTDomain:    "schain_compliance.ads_txt_domain",
+	SellerID:        "schain_compliance.seller_id",
+	Relationship:    "schain_compliance.relationship",
+	SellersJSONURL:  "schain_compliance.sellers_json_url",
+	DPStatus:        "schain_compliance.dp_status",
+	DPSellerType:    "schain_compliance.dp_seller_type",
+	OurStatus:       "schain_compliance.our_status",
+	IsCompliant:     "schain_compliance.is_compliant",
+	ErrorMessage:    "schain_compliance.error_message",
+	CheckedAt:       "schain_compliance.checked_at",
+	CreatedAt:       "schain_compliance.created_at",
+	UpdatedAt:       "schain_compliance.updated_at",
+}
+
+// Generated where
+
+var SchainComplianceWhere = struct {
+	ID              whereHelperint
+	PublisherID     whereHelperstring
+	DemandPartnerID whereHelpernull_String
+	SeatOwnerID     whereHelpernull_Int
+	PartnerName     whereHelperstring
+	AdsTXTDomain    whereHelperstring
+	SellerID        whereHelperstring
+	Relationship    whereHelperstring
+	SellersJSONURL  whereHelpernull_String
+	DPStatus        whereHelperstring
+	DPSellerType    whereHelpernull_String
+	OurStatus       whereHelperstring
+	IsCompliant     whereHelperbool
+	ErrorMessage    whereHelpernull_String
+	CheckedAt       whereHelpertime_Time
+	CreatedAt       whereHelpertime_Time
+	UpdatedAt       whereHelpernull_Time
+}{
+	ID:              whereHelperint{field: "\"schain_compliance\".\"id\""},
+	PublisherID:     whereHelperstring{field: "\"schain_compliance\".\"publisher_id\""},
+	DemandPartnerID: whereHelpernull_String{field: "\"schain_compliance\".\"demand_partner_id\""},
+	SeatOwnerID:     whereHelpernull_Int{field: "\"schain_compliance\".\"seat_owner_id\""},
+	PartnerName:     whereHelperstring{field: "\"schain_compliance\".\"partner_name\""},
+	AdsTXTDomain:    whereHelperstring{field: "\"schain_compliance\".\"ads_txt_domain\""},
+	SellerID:        whereHelperstring{field: "\"schain_compliance\".\"seller_id\""},
+	Relationship:    whereHelperstring{field: "\"schain_compliance\".\"relationship\""},
+	SellersJSONURL:  whereHelpernull_String{field: "\"schain_compliance\".\"sellers_json_url\""},
+	DPStatus:        whereHelperstring{field: "\"schain_compliance\".\"dp_status\""},
+	DPSellerType:    whereHelpernull_String{field: "\"schain_compliance\".\"dp_seller_type\""},
+	OurStatus:       whereHelperstring{field: "\"schain_compliance\".\"our_status\""},
+	IsCompliant:     whereHelperbool{field: "\"schain_compliance\".\"is_compliant\""},
+	ErrorMessage:    whereHelpernull_String{field: "\"schain_compliance\".\"error_message\""},
+	CheckedAt:       whereHelpertime_Time{field: "\"schain_compliance\".\"checked_at\""},
+	CreatedAt:       whereHelpertime_Time{field: "\"schain_compliance\".\"created_at\""},
+	UpdatedAt:       whereHelpernull_Time{field: "\"schain_compliance\".\"updated_at\""},
+}
+
+// SchainComplianceRels is where relationship names are stored.
+var SchainComplianceRels = struct {
+}{}
+
+// schainComplianceR is where relationships are stored.
+type schainComplianceR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*schainComplianceR) NewStruct() *schainComplianceR {
+	return &schainComplianceR{}
+}
+
+// schainComplianceL is where Load methods for each relationship are stored.
+type schainComplianceL struct{}
+
+var (
+	schainComplianceAllColumns            = []string{"id", "publisher_id", "demand_partner_id", "seat_owner_id", "partner_name", "ads_txt_domain", "seller_id", "relationship", "sellers_json_url", "dp_status", "dp_seller_type", "our_status", "is_compliant", "error_message", "checked_at", "created_at", "updated_at"}
+	schainComplianceColumnsWithoutDefault = []string{"publisher_id", "partner_name", "ads_txt_domain", "seller_id", "relationship", "dp_status", "our_status", "checked_at", "created_at"}
+	schainComplianceColumnsWithDefault    = []string{"id", "demand_partner_id", "seat_owner_id", "sellers_json_url", "dp_seller_type", "is_compliant", "error_message", "updated_at"}
+	schainCompliancePrimaryKeyColumns     = []string{"id"}
+	schainComplianceGeneratedColumns      = []string{}
+)
+
+type (
+	// SchainComplianceSlice is an alias for a slice of pointers to SchainCompliance.
+	// This should almost always be used instead of []SchainCompliance.
+	SchainComplianceSlice []*SchainCompliance
+	// SchainComplianceHook is the signature for custom SchainCompliance hook methods
+	SchainComplianceHook func(context.Context, boil.ContextExecutor, *SchainCompliance) error
+
+	schainComplianceQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	schainComplianceType                 = reflect.TypeOf(&SchainCompliance{})
+	schainComplianceMapping              = queries.MakeStructMapping(schainComplianceType)
+	schainCompliancePrimaryKeyMapping, _ = queries.BindMapping(schainComplianceType, schainComplianceMapping, schainCompliancePrimaryKeyColumns)
+	schainComplianceInsertCacheMut       sync.RWMutex
+	schainComplianceInsertCache          = make(map[string]insertCache)
+	schainComplianceUpdateCacheMut       sync.RWMutex
+	schainComplianceUpdateCache          = make(map[string]updateCache)
+	schainComplianceUpsertCacheMut       sync.RWMutex
+	schainComplianceUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var schainComplianceAfterSelectMu sync.Mutex
+var schainComplianceAfterSelectHooks []SchainComplianceHook
+
+var schainComplianceBeforeInsertMu sync.Mutex
+var schainComplianceBeforeInsertHooks []SchainComplianceHook
+var schainComplianceAfterInsertMu sync.Mutex
+var schainComplianceAfterInsertHooks []SchainComplianceHook
+
+var schainComplianceBeforeUpdateMu sync.Mutex
+var schainComplianceBeforeUpdateHooks []SchainComplianceHook
+var schainComplianceAfterUpdateMu sync.Mutex
+var schainComplianceAfterUpdateHooks []SchainComplianceHook
+
+var schainComplianceBeforeDeleteMu sync.Mutex
+var schainComplianceBeforeDeleteHooks []SchainComplianceHook
+var schainComplianceAfterDeleteMu sync.Mutex
+var schainComplianceAfterDeleteHooks []SchainComplianceHook
+
+var schainComplianceBeforeUpsertMu sync.Mutex
+var schainComplianceBeforeUpsertHooks []SchainComplianceHook
+var schainComplianceAfterUpsertMu sync.Mutex
+var schainComplianceAfterUpsertHooks []SchainComplianceHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *SchainCompliance) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range schainComplianceAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *SchainCompliance) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range schainComplianceBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *SchainCompliance) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range schainComplianceAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *SchainCompliance) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range schainComplianceBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *SchainCompliance) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range schainComplianceAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *SchainCompliance) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range schainComplianceBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *SchainCompliance) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range schainComplianceAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *SchainCompliance) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range schainComplianceBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *SchainCompliance) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range schainComplianceAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddSchainComplianceHook registers your hook function for all future operations.
+func AddSchainComplianceHook(hookPoint boil.HookPoint, schainComplianceHook SchainComplianceHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		schainComplianceAfterSelectMu.Lock()
+		schainComplianceAfterSelectHooks = append(schainComplianceAfterSelectHooks, schainComplianceHook)
+		schainComplianceAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		schainComplianceBeforeInsertMu.Lock()
+		schainComplianceBeforeInsertHooks = append(schainComplianceBeforeInsertHooks, schainComplianceHook)
+		schainComplianceBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		schainComplianceAfterInsertMu.Lock()
+		schainComplianceAfterInsertHooks = append(schainComplianceAfterInsertHooks, schainComplianceHook)
+		schainComplianceAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		schainComplianceBeforeUpdateMu.Lock()
+		schainComplianceBeforeUpdateHooks = append(schainComplianceBeforeUpdateHooks, schainComplianceHook)
+		schainComplianceBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		schainComplianceAfterUpdateMu.Lock()
+		schainComplianceAfterUpdateHooks = append(schainComplianceAfterUpdateHooks, schainComplianceHook)
+		schainComplianceAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		schainComplianceBeforeDeleteMu.Lock()
+		schainComplianceBeforeDeleteHooks = append(schainComplianceBeforeDeleteHooks, schainComplianceHook)
+		schainComplianceBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		schainComplianceAfterDeleteMu.Lock()
+		schainComplianceAfterDeleteHooks = append(schainComplianceAfterDeleteHooks, schainComplianceHook)
+		schainComplianceAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		schainComplianceBeforeUpsertMu.Lock()
+		schainComplianceBeforeUpsertHooks = append(schainComplianceBeforeUpsertHooks, schainComplianceHook)
+		schainComplianceBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		schainComplianceAfterUpsertMu.Lock()
+		schainComplianceAfterUpsertHooks = append(schainComplianceAfterUpsertHooks, schainComplianceHook)
+		schainComplianceAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single schainCompliance record from the query.
+func (q schainComplianceQuery) One(ctx context.Context, exec boil.ContextExecutor) (*SchainCompliance, error) {
+	o := &SchainCompliance{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for schain_compliance")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all SchainCompliance records from the query.
+func (q schainComplianceQuery) All(ctx context.Context, exec boil.ContextExecutor) (SchainComplianceSlice, error) {
+	var o []*SchainCompliance
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to SchainCompliance slice")
+	}
+
+	if len(schainComplianceAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all SchainCompliance records in the query.
+func (q schainComplianceQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count schain_compliance rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q schainComplianceQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if schain_compliance exists")
+	}
+
+	return count > 0, nil
+}
+
+// SchainCompliances retrieves all the records using an executor.
+func SchainCompliances(mods ...qm.QueryMod) schainComplianceQuery {
+	mods = append(mods, qm.From("\"schain_compliance\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"schain_compliance\".*"})
+	}
+
+	return schainComplianceQuery{q}
+}
+
+// FindSchainCompliance retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindSchainCompliance(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*SchainCompliance, error) {
+	schainComplianceObj := &SchainCompliance{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"schain_compliance\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, schainComplianceObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from schain_compliance")
+	}
+
+	if err = schainComplianceObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return schainComplianceObj, err
+	}
+
+	return schainComplianceObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *SchainCompliance) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no schain_compliance provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(schainComplianceColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	schainComplianceInsertCacheMut.RLock()
+	cache, cached := schainComplianceInsertCache[key]
+	schainComplianceInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			schainComplianceAllColumns,
+			schainComplianceColumnsWithDefault,
+			schainComplianceColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(schainComplianceType, schainComplianceMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(schainComplianceType, schainComplianceMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"schain_compliance\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"schain_compliance\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into schain_compliance")
+	}
+
+	if !cached {
+		schainComplianceInsertCacheMut.Lock()
+		schainComplianceInsertCache[key] = cache
+		schainComplianceInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the SchainCompliance.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *SchainCompliance) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	schainComplianceUpdateCacheMut.RLock()
+	cache, cached := schainComplianceUpdateCache[key]
+	schainComplianceUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			schainComplianceAllColumns,
+			schainCompliancePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update schain_compliance, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"schain_compliance\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, schainCompliancePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(schainComplianceType, schainComplianceMapping, append(wl, schainCompliancePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update schain_compliance row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for schain_compliance")
+	}
+
+	if !cached {
+		schainComplianceUpdateCacheMut.Lock()
+		schainComplianceUpdateCache[key] = cache
+		schainComplianceUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q schainComplianceQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for schain_compliance")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for schain_compliance")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o SchainComplianceSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), schainCompliancePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"schain_compliance\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, schainCompliancePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in schainCompliance slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all schainCompliance")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *SchainCompliance) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no schain_compliance provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(schainComplianceColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	schainComplianceUpsertCacheMut.RLock()
+	cache, cached := schainComplianceUpsertCache[key]
+	schainComplianceUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			schainComplianceAllColumns,
+			schainComplianceColumnsWithDefault,
+			schainComplianceColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			schainComplianceAllColumns,
+			schainCompliancePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert schain_compliance, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(schainComplianceAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(schainCompliancePrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert schain_compliance, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(schainCompliancePrimaryKeyColumns))
+			copy(conflict, schainCompliancePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"schain_compliance\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(schainComplianceType, schainComplianceMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(schainComplianceType, schainComplianceMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert schain_compliance")
+	}
+
+	if !cached {
+		schainComplianceUpsertCacheMut.Lock()
+		schainComplianceUpsertCache[key] = cache
+		schainComplianceUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single SchainCompliance record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *SchainCompliance) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no SchainCompliance provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), schainCompliancePrimaryKeyMapping)
+	sql := "DELETE FROM \"schain_compliance\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from schain_compliance")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for schain_compliance")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q schainComplianceQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no schainComplianceQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from schain_compliance")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for schain_compliance")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o SchainComplianceSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(schainComplianceBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), schainCompliancePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"schain_compliance\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, schainCompliancePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from schainCompliance slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for schain_compliance")
+	}
+
+	if len(schainComplianceAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *SchainCompliance) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindSchainCompliance(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SchainComplianceSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := SchainComplianceSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), schainCompliancePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"schain_compliance\".* FROM \"schain_compliance\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, schainCompliancePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in SchainComplianceSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// SchainComplianceExists checks if the SchainCompliance row exists.
+func SchainComplianceExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"schain_compliance\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if schain_compliance exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the SchainCompliance row exists.
+func (o *SchainCompliance) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return SchainComplianceExists(ctx, exec, o.ID)
+}
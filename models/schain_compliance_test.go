@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testSchainCompliances(t *testing.T) {
+	t.Parallel()
+
+	query := SchainCompliances()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testSchainCompliancesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SchainCompliance{}
+	if err = randomize.Struct(seed, o, schainComplianceDBTypes, true, schainComplianceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SchainCompliance struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := SchainCompliances().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSchainCompliancesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SchainCompliance{}
+	if err = randomize.Struct(seed, o, schainComplianceDBTypes, true, schainComplianceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SchainCompliance struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := SchainCompliances().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := SchainCompliances().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSchainCompliancesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SchainCompliance{}
+	if err = randomize.Struct(seed, o, schainComplianceDBTypes, true, schainComplianceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SchainCompliance struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := SchainComplianceSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := SchainCompliances().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSchainCompliancesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SchainCompliance{}
+	if err = randomize.Struct(seed, o, schainComplianceDBTypes, true, schainComplianceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SchainCompliance struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := SchainComplianceExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if SchainCompliance exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected SchainComplianceExists to return true, but got false.")
+	}
+}
+
+func testSchainCompliancesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SchainCompliance{}
+	if err = randomize.Struct(seed, o, schainComplianceDBTypes, true, schainComplianceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SchainCompliance struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	schainComplianceFound, err := FindSchainCompliance(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if schainComplianceFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testSchainCompliancesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SchainCompliance{}
+	if err = randomize.Struct(seed, o, schainComplianceDBTypes, true, schainComplianceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SchainCompliance struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = SchainCompliances().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testSchainCompliancesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SchainCompliance{}
+	if err = randomize.Struct(seed, o, schainComplianceDBTypes, true, schainComplianceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SchainCompliance struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := SchainCompliances().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testSchainCompliancesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	schainComplianceOne := &SchainCompliance{}
+	schainComplianceTwo := &SchainCompliance{}
+	if err = randomize.Struct(seed, schainComplianceOne, schainComplianceDBTypes, false, schainComplianceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SchainCompliance struct: %s", err)
+	}
+	if err = randomize.Struct(seed, schainComplianceTwo, schainComplianceDBTypes, false, schainComplianceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SchainCompliance struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = schainComplianceOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = schainComplianceTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := SchainCompliances().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testSchainCompliancesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	schainComplianceOne := &SchainCompliance{}
+	schainComplianceTwo := &SchainCompliance{}
+	if err = randomize.Struct(seed, schainComplianceOne, schainComplianceDBTypes, false, schainComplianceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SchainCompliance struct: %s", err)
+	}
+	if err = randomize.Struct(seed, schainComplianceTwo, schainComplianceDBTypes, false, schainComplianceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SchainCompliance struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = schainComplianceOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = schainComplianceTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SchainCompliances().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func schainComplianceBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *SchainCompliance) error {
+	*o = SchainCompliance{}
+	return nil
+}
+
+func schainComplianceAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *SchainCompliance) error {
+	*o = SchainCompliance{}
+	return nil
+}
+
+func schainComplianceAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *SchainCompliance) error {
+	*o = SchainCompliance{}
+	return nil
+}
+
+func schainComplianceBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *SchainCompliance) error {
+	*o = SchainCompliance{}
+	return nil
+}
+
+func schainComplianceAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *SchainCompliance) error {
+	*o = SchainCompliance{}
+	return nil
+}
+
+func schainComplianceBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *SchainCompliance) error {
+	*o = SchainCompliance{}
+	return nil
+}
+
+func schainComplianceAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *SchainCompliance) error {
+	*o = SchainCompliance{}
+	return nil
+}
+
+func schainComplianceBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *SchainCompliance) error {
+	*o = SchainCompliance{}
+	return nil
+}
+
+func schainComplianceAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *SchainCompliance) error {
+	*o = SchainCompliance{}
+	return nil
+}
+
+func testSchainCompliancesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &SchainCompliance{}
+	o := &SchainCompliance{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, schainComplianceDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize SchainCompliance object: %s", err)
+	}
+
+	AddSchainComplianceHook(boil.BeforeInsertHook, schainComplianceBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	schainComplianceBeforeInsertHooks = []SchainComplianceHook{}
+
+	AddSchainComplianceHook(boil.AfterInsertHook, schainComplianceAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	schainComplianceAfterInsertHooks = []SchainComplianceHook{}
+
+	AddSchainComplianceHook(boil.AfterSelectHook, schainComplianceAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	schainComplianceAfterSelectHooks = []SchainComplianceHook{}
+
+	AddSchainComplianceHook(boil.BeforeUpdateHook, schainComplianceBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	schainComplianceBeforeUpdateHooks = []SchainComplianceHook{}
+
+	AddSchainComplianceHook(boil.AfterUpdateHook, schainComplianceAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	schainComplianceAfterUpdateHooks = []SchainComplianceHook{}
+
+	AddSchainComplianceHook(boil.BeforeDeleteHook, schainComplianceBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	schainComplianceBeforeDeleteHooks = []SchainComplianceHook{}
+
+	AddSchainComplianceHook(boil.AfterDeleteHook, schainComplianceAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	schainComplianceAfterDeleteHooks = []SchainComplianceHook{}
+
+	AddSchainComplianceHook(boil.BeforeUpsertHook, schainComplianceBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	schainComplianceBeforeUpsertHooks = []SchainComplianceHook{}
+
+	AddSchainComplianceHook(boil.AfterUpsertHook, schainComplianceAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	schainComplianceAfterUpsertHooks = []SchainComplianceHook{}
+}
+
+func testSchainCompliancesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SchainCompliance{}
+	if err = randomize.Struct(seed, o, schainComplianceDBTypes, true, schainComplianceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SchainCompliance struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SchainCompliances().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testSchainCompliancesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SchainCompliance{}
+	if err = randomize.Struct(seed, o, schainComplianceDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize SchainCompliance struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(schainComplianceColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SchainCompliances().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testSchainCompliancesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SchainCompliance{}
+	if err = randomize.Struct(seed, o, schainComplianceDBTypes, true, schainComplianceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SchainCompliance struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testSchainCompliancesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SchainCompliance{}
+	if err = randomize.Struct(seed, o, schainComplianceDBTypes, true, schainComplianceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SchainCompliance struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := SchainComplianceSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testSchainCompliancesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SchainCompliance{}
+	if err = randomize.Struct(seed, o, schainComplianceDBTypes, true, schainComplianceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SchainCompliance struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := SchainCompliances().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	schainComplianceDBTypes = map[string]string{`ID`: `integer`, `PublisherID`: `character varying`, `DemandPartnerID`: `character varying`, `SeatOwnerID`: `integer`, `PartnerName`: `character varying`, `AdsTXTDomain`: `character varying`, `SellerID`: `character varying`, `Relationship`: `character varying`, `SellersJSONURL`: `character varying`, `DPStatus`: `character varying`, `DPSellerType`: `character varying`, `OurStatus`: `character varying`, `IsCompliant`: `boolean`, `ErrorMessage`: `character varying`, `CheckedAt`: `timestamp without time zone`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                       = bytes.MinRead
+)
+
+func testSchainCompliancesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(schainCompliancePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(schainComplianceAllColumns) == len(schainCompliancePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SchainCompliance{}
+	if err = randomize.Struct(seed, o, schainComplianceDBTypes, true, schainComplianceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SchainCompliance struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SchainCompliances().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, schainComplianceDBTypes, true, schainCompliancePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize SchainCompliance struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testSchainCompliancesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(schainComplianceAllColumns) == len(schainCompliancePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SchainCompliance{}
+	if err = randomize.Struct(seed, o, schainComplianceDBTypes, true, schainComplianceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SchainCompliance struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SchainCompliances().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, schainComplianceDBTypes, true, schainCompliancePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize SchainCompliance struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(schainComplianceAllColumns, schainCompliancePrimaryKeyColumns) {
+		fields = schainComplianceAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			schainComplianceAllColumns,
+			schainCompliancePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := SchainComplianceSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testSchainCompliancesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(schainComplianceAllColumns) == len(schainCompliancePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := SchainCompliance{}
+	if err = randomize.Struct(seed, &o, schainComplianceDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize SchainCompliance struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert SchainCompliance: %s", err)
+	}
+
+	count, err := SchainCompliances().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, schainComplianceDBTypes, false, schainCompliancePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize SchainCompliance struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert SchainCompliance: %s", err)
+	}
+
+	count, err = SchainCompliances().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
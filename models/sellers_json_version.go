@@ -0,0 +1,1016 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// SellersJSONVersion is an object representing the database table.
+type SellersJSONVersion struct {
+	ID          int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Content     string    `boil:"content" json:"content" toml:"content" yaml:"content"`
+	Etag        string    `boil:"etag" json:"etag" toml:"etag" yaml:"etag"`
+	Sellers     int       `boil:"sellers" json:"sellers" toml:"sellers" yaml:"sellers"`
+	Added       int       `boil:"added" json:"added" toml:"added" yaml:"added"`
+	Removed     int       `boil:"removed" json:"removed" toml:"removed" yaml:"removed"`
+	Changed     int       `boil:"changed" json:"changed" toml:"changed" yaml:"changed"`
+	PublishedBy null.Int  `boil:"published_by" json:"published_by,omitempty" toml:"published_by" yaml:"published_by,omitempty"`
+	PublishedAt time.Time `boil:"published_at" json:"published_at" toml:"published_at" yaml:"published_at"`
+	CreatedAt   time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt   null.Time `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *sellersJSONVersionR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L sellersJSONVersionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var SellersJSONVersionColumns = struct {
+	ID          string
+	Content     string
+	Etag        string
+	Sellers     string
+	Added       string
+	Removed     string
+	Changed     string
+	PublishedBy string
+	PublishedAt string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "id",
+	Content:     "content",
+	Etag:        "etag",
+	Sellers:     "sellers",
+	Added:       "added",
+	Removed:     "removed",
+	Changed:     "changed",
+	PublishedBy: "published_by",
+	PublishedAt: "published_at",
+	CreatedAt:   "created_at",
+	UpdatedAt:   "updated_at",
+}
+
+var SellersJSONVersionTableColumns = struct {
+	ID          string
+	Content     string
+	Etag        string
+	Sellers     string
+	Added       string
+	Removed     string
+	Changed     string
+	PublishedBy string
+	PublishedAt string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "sellers_json_version.id",
+	Content:     "sellers_json_version.content",
+	Etag:        "sellers_json_version.etag",
+	Sellers:     "sellers_json_version.sellers",
+	Added:       "sellers_json_version.added",
+	Removed:     "sellers_json_version.removed",
+	Changed:     "sellers_json_version.changed",
+	PublishedBy: "sellers_json_version.published_by",
+	PublishedAt: "sellers_json_version.published_at",
+	CreatedAt:   "sellers_json_version.created_at",
+	UpdatedAt:   "sellers_json_version.updated_at",
+}
+
+// Generated where
+
+var SellersJSONVersionWhere = struct {
+	ID          whereHelperint
+	Content     whereHelperstring
+	Etag        whereHelperstring
+	Sellers     whereHelperint
+	Added       whereHelperint
+	Removed     whereHelperint
+	Changed     whereHelperint
+	PublishedBy whereHelpernull_Int
+	PublishedAt whereHelpertime_Time
+	CreatedAt   whereHelpertime_Time
+	UpdatedAt   whereHelpernull_Time
+}{
+	ID:          whereHelperint{field: "\"sellers_json_version\".\"id\""},
+	Content:     whereHelperstring{field: "\"sellers_json_version\".\"content\""},
+	Etag:        whereHelperstring{field: "\"sellers_json_version\".\"etag\""},
+	Sellers:     whereHelperint{field: "\"sellers_json_version\".\"sellers\""},
+	Added:       whereHelperint{field: "\"sellers_json_version\".\"added\""},
+	Removed:     whereHelperint{field: "\"sellers_json_version\".\"removed\""},
+	Changed:     whereHelperint{field: "\"sellers_json_version\".\"changed\""},
+	PublishedBy: whereHelpernull_Int{field: "\"sellers_json_version\".\"published_by\""},
+	PublishedAt: whereHelpertime_Time{field: "\"sellers_json_version\".\"published_at\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"sellers_json_version\".\"created_at\""},
+	UpdatedAt:   whereHelpernull_Time{field: "\"sellers_json_version\".\"updated_at\""},
+}
+
+// SellersJSONVersionRels is where relationship names are stored.
+var SellersJSONVersionRels = struct {
+}{}
+
+// sellersJSONVersionR is where relationships are stored.
+type sellersJSONVersionR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*sellersJSONVersionR) NewStruct() *sellersJSONVersionR {
+	return &sellersJSONVersionR{}
+}
+
+// sellersJSONVersionL is where Load methods for each relationship are stored.
+type sellersJSONVersionL struct{}
+
+var (
+	sellersJSONVersionAllColumns            = []string{"id", "content", "etag", "sellers", "added", "removed", "changed", "published_by", "published_at", "created_at", "updated_at"}
+	sellersJSONVersionColumnsWithoutDefault = []string{"content", "etag", "published_at", "created_at"}
+	sellersJSONVersionColumnsWithDefault    = []string{"id", "sellers", "added", "removed", "changed", "published_by", "updated_at"}
+	sellersJSONVersionPrimaryKeyColumns     = []string{"id"}
+	sellersJSONVersionGeneratedColumns      = []string{}
+)
+
+type (
+	// SellersJSONVersionSlice is an alias for a slice of pointers to SellersJSONVersion.
+	// This should almost always be used instead of []SellersJSONVersion.
+	SellersJSONVersionSlice []*SellersJSONVersion
+	// SellersJSONVersionHook is the signature for custom SellersJSONVersion hook methods
+	SellersJSONVersionHook func(context.Context, boil.ContextExecutor, *SellersJSONVersion) error
+
+	sellersJSONVersionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	sellersJSONVersionType                 = reflect.TypeOf(&SellersJSONVersion{})
+	sellersJSONVersionMapping              = queries.MakeStructMapping(sellersJSONVersionType)
+	sellersJSONVersionPrimaryKeyMapping, _ = queries.BindMapping(sellersJSONVersionType, sellersJSONVersionMapping, sellersJSONVersionPrimaryKeyColumns)
+	sellersJSONVersionInsertCacheMut       sync.RWMutex
+	sellersJSONVersionInsertCache          = make(map[string]insertCache)
+	sellersJSONVersionUpdateCacheMut       sync.RWMutex
+	sellersJSONVersionUpdateCache          = make(map[string]updateCache)
+	sellersJSONVersionUpsertCacheMut       sync.RWMutex
+	sellersJSONVersionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var sellersJSONVersionAfterSelectMu sync.Mutex
+var sellersJSONVersionAfterSelectHooks []SellersJSONVersionHook
+
+var sellersJSONVersionBeforeInsertMu sync.Mutex
+var sellersJSONVersionBeforeInsertHooks []SellersJSONVersionHook
+var sellersJSONVersionAfterInsertMu sync.Mutex
+var sellersJSONVersionAfterInsertHooks []SellersJSONVersionHook
+
+var sellersJSONVersionBeforeUpdateMu sync.Mutex
+var sellersJSONVersionBeforeUpdateHooks []SellersJSONVersionHook
+var sellersJSONVersionAfterUpdateMu sync.Mutex
+var sellersJSONVersionAfterUpdateHooks []SellersJSONVersionHook
+
+var sellersJSONVersionBeforeDeleteMu sync.Mutex
+var sellersJSONVersionBeforeDeleteHooks []SellersJSONVersionHook
+var sellersJSONVersionAfterDeleteMu sync.Mutex
+var sellersJSONVersionAfterDeleteHooks []SellersJSONVersionHook
+
+var sellersJSONVersionBeforeUpsertMu sync.Mutex
+var sellersJSONVersionBeforeUpsertHooks []SellersJSONVersionHook
+var sellersJSONVersionAfterUpsertMu sync.Mutex
+var sellersJSONVersionAfterUpsertHooks []SellersJSONVersionHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *SellersJSONVersion) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range sellersJSONVersionAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *SellersJSONVersion) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range sellersJSONVersionBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *SellersJSONVersion) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range sellersJSONVersionAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *SellersJSONVersion) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range sellersJSONVersionBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *SellersJSONVersion) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range sellersJSONVersionAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *SellersJSONVersion) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range sellersJSONVersionBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *SellersJSONVersion) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range sellersJSONVersionAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *SellersJSONVersion) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range sellersJSONVersionBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *SellersJSONVersion) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range sellersJSONVersionAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddSellersJSONVersionHook registers your hook function for all future operations.
+func AddSellersJSONVersionHook(hookPoint boil.HookPoint, sellersJSONVersionHook SellersJSONVersionHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		sellersJSONVersionAfterSelectMu.Lock()
+		sellersJSONVersionAfterSelectHooks = append(sellersJSONVersionAfterSelectHooks, sellersJSONVersionHook)
+		sellersJSONVersionAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		sellersJSONVersionBeforeInsertMu.Lock()
+		sellersJSONVersionBeforeInsertHooks = append(sellersJSONVersionBeforeInsertHooks, sellersJSONVersionHook)
+		sellersJSONVersionBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		sellersJSONVersionAfterInsertMu.Lock()
+		sellersJSONVersionAfterInsertHooks = append(sellersJSONVersionAfterInsertHooks, sellersJSONVersionHook)
+		sellersJSONVersionAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		sellersJSONVersionBeforeUpdateMu.Lock()
+		sellersJSONVersionBeforeUpdateHooks = append(sellersJSONVersionBeforeUpdateHooks, sellersJSONVersionHook)
+		sellersJSONVersionBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		sellersJSONVersionAfterUpdateMu.Lock()
+		sellersJSONVersionAfterUpdateHooks = append(sellersJSONVersionAfterUpdateHooks, sellersJSONVersionHook)
+		sellersJSONVersionAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		sellersJSONVersionBeforeDeleteMu.Lock()
+		sellersJSONVersionBeforeDeleteHooks = append(sellersJSONVersionBeforeDeleteHooks, sellersJSONVersionHook)
+		sellersJSONVersionBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		sellersJSONVersionAfterDeleteMu.Lock()
+		sellersJSONVersionAfterDeleteHooks = append(sellersJSONVersionAfterDeleteHooks, sellersJSONVersionHook)
+		sellersJSONVersionAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		sellersJSONVersionBeforeUpsertMu.Lock()
+		sellersJSONVersionBeforeUpsertHooks = append(sellersJSONVersionBeforeUpsertHooks, sellersJSONVersionHook)
+		sellersJSONVersionBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		sellersJSONVersionAfterUpsertMu.Lock()
+		sellersJSONVersionAfterUpsertHooks = append(sellersJSONVersionAfterUpsertHooks, sellersJSONVersionHook)
+		sellersJSONVersionAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single sellersJSONVersion record from the query.
+func (q sellersJSONVersionQuery) One(ctx context.Context, exec boil.ContextExecutor) (*SellersJSONVersion, error) {
+	o := &SellersJSONVersion{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for sellers_json_version")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all SellersJSONVersion records from the query.
+func (q sellersJSONVersionQuery) All(ctx context.Context, exec boil.ContextExecutor) (SellersJSONVersionSlice, error) {
+	var o []*SellersJSONVersion
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to SellersJSONVersion slice")
+	}
+
+	if len(sellersJSONVersionAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all SellersJSONVersion records in the query.
+func (q sellersJSONVersionQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count sellers_json_version rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q sellersJSONVersionQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if sellers_json_version exists")
+	}
+
+	return count > 0, nil
+}
+
+// SellersJSONVersions retrieves all the records using an executor.
+func SellersJSONVersions(mods ...qm.QueryMod) sellersJSONVersionQuery {
+	mods = append(mods, qm.From("\"sellers_json_version\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"sellers_json_version\".*"})
+	}
+
+	return sellersJSONVersionQuery{q}
+}
+
+// FindSellersJSONVersion retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindSellersJSONVersion(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*SellersJSONVersion, error) {
+	sellersJSONVersionObj := &SellersJSONVersion{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"sellers_json_version\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, sellersJSONVersionObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from sellers_json_version")
+	}
+
+	if err = sellersJSONVersionObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return sellersJSONVersionObj, err
+	}
+
+	return sellersJSONVersionObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *SellersJSONVersion) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no sellers_json_version provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(sellersJSONVersionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	sellersJSONVersionInsertCacheMut.RLock()
+	cache, cached := sellersJSONVersionInsertCache[key]
+	sellersJSONVersionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			sellersJSONVersionAllColumns,
+			sellersJSONVersionColumnsWithDefault,
+			sellersJSONVersionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(sellersJSONVersionType, sellersJSONVersionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(sellersJSONVersionType, sellersJSONVersionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"sellers_json_version\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"sellers_json_version\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into sellers_json_version")
+	}
+
+	if !cached {
+		sellersJSONVersionInsertCacheMut.Lock()
+		sellersJSONVersionInsertCache[key] = cache
+		sellersJSONVersionInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the SellersJSONVersion.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *SellersJSONVersion) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	sellersJSONVersionUpdateCacheMut.RLock()
+	cache, cached := sellersJSONVersionUpdateCache[key]
+	sellersJSONVersionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			sellersJSONVersionAllColumns,
+			sellersJSONVersionPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update sellers_json_version, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"sellers_json_version\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, sellersJSONVersionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(sellersJSONVersionType, sellersJSONVersionMapping, append(wl, sellersJSONVersionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update sellers_json_version row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for sellers_json_version")
+	}
+
+	if !cached {
+		sellersJSONVersionUpdateCacheMut.Lock()
+		sellersJSONVersionUpdateCache[key] = cache
+		sellersJSONVersionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q sellersJSONVersionQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for sellers_json_version")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for sellers_json_version")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o SellersJSONVersionSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), sellersJSONVersionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"sellers_json_version\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, sellersJSONVersionPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in sellersJSONVersion slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all sellersJSONVersion")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *SellersJSONVersion) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no sellers_json_version provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(sellersJSONVersionColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	sellersJSONVersionUpsertCacheMut.RLock()
+	cache, cached := sellersJSONVersionUpsertCache[key]
+	sellersJSONVersionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			sellersJSONVersionAllColumns,
+			sellersJSONVersionColumnsWithDefault,
+			sellersJSONVersionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			sellersJSONVersionAllColumns,
+			sellersJSONVersionPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert sellers_json_version, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(sellersJSONVersionAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(sellersJSONVersionPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert sellers_json_version, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(sellersJSONVersionPrimaryKeyColumns))
+			copy(conflict, sellersJSONVersionPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"sellers_json_version\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(sellersJSONVersionType, sellersJSONVersionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(sellersJSONVersionType, sellersJSONVersionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert sellers_json_version")
+	}
+
+	if !cached {
+		sellersJSONVersionUpsertCacheMut.Lock()
+		sellersJSONVersionUpsertCache[key] = cache
+		sellersJSONVersionUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single SellersJSONVersion record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *SellersJSONVersion) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no SellersJSONVersion provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), sellersJSONVersionPrimaryKeyMapping)
+	sql := "DELETE FROM \"sellers_json_version\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from sellers_json_version")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for sellers_json_version")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q sellersJSONVersionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no sellersJSONVersionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from sellers_json_version")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for sellers_json_version")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o SellersJSONVersionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(sellersJSONVersionBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), sellersJSONVersionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"sellers_json_version\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, sellersJSONVersionPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from sellersJSONVersion slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for sellers_json_version")
+	}
+
+	if len(sellersJSONVersionAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *SellersJSONVersion) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindSellersJSONVersion(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SellersJSONVersionSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := SellersJSONVersionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), sellersJSONVersionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"sellers_json_version\".* FROM \"sellers_json_version\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, sellersJSONVersionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in SellersJSONVersionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// SellersJSONVersionExists checks if the SellersJSONVersion row exists.
+func SellersJSONVersionExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"sellers_json_version\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if sellers_json_version exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the SellersJSONVersion row exists.
+func (o *SellersJSONVersion) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return SellersJSONVersionExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testSellersJSONVersions(t *testing.T) {
+	t.Parallel()
+
+	query := SellersJSONVersions()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testSellersJSONVersionsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SellersJSONVersion{}
+	if err = randomize.Struct(seed, o, sellersJSONVersionDBTypes, true, sellersJSONVersionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SellersJSONVersion struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := SellersJSONVersions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSellersJSONVersionsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SellersJSONVersion{}
+	if err = randomize.Struct(seed, o, sellersJSONVersionDBTypes, true, sellersJSONVersionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SellersJSONVersion struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := SellersJSONVersions().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := SellersJSONVersions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSellersJSONVersionsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SellersJSONVersion{}
+	if err = randomize.Struct(seed, o, sellersJSONVersionDBTypes, true, sellersJSONVersionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SellersJSONVersion struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := SellersJSONVersionSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := SellersJSONVersions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSellersJSONVersionsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SellersJSONVersion{}
+	if err = randomize.Struct(seed, o, sellersJSONVersionDBTypes, true, sellersJSONVersionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SellersJSONVersion struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := SellersJSONVersionExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if SellersJSONVersion exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected SellersJSONVersionExists to return true, but got false.")
+	}
+}
+
+func testSellersJSONVersionsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SellersJSONVersion{}
+	if err = randomize.Struct(seed, o, sellersJSONVersionDBTypes, true, sellersJSONVersionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SellersJSONVersion struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	sellersJSONVersionFound, err := FindSellersJSONVersion(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if sellersJSONVersionFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testSellersJSONVersionsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SellersJSONVersion{}
+	if err = randomize.Struct(seed, o, sellersJSONVersionDBTypes, true, sellersJSONVersionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SellersJSONVersion struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = SellersJSONVersions().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testSellersJSONVersionsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SellersJSONVersion{}
+	if err = randomize.Struct(seed, o, sellersJSONVersionDBTypes, true, sellersJSONVersionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SellersJSONVersion struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := SellersJSONVersions().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testSellersJSONVersionsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	sellersJSONVersionOne := &SellersJSONVersion{}
+	sellersJSONVersionTwo := &SellersJSONVersion{}
+	if err = randomize.Struct(seed, sellersJSONVersionOne, sellersJSONVersionDBTypes, false, sellersJSONVersionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SellersJSONVersion struct: %s", err)
+	}
+	if err = randomize.Struct(seed, sellersJSONVersionTwo, sellersJSONVersionDBTypes, false, sellersJSONVersionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SellersJSONVersion struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = sellersJSONVersionOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = sellersJSONVersionTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := SellersJSONVersions().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testSellersJSONVersionsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	sellersJSONVersionOne := &SellersJSONVersion{}
+	sellersJSONVersionTwo := &SellersJSONVersion{}
+	if err = randomize.Struct(seed, sellersJSONVersionOne, sellersJSONVersionDBTypes, false, sellersJSONVersionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SellersJSONVersion struct: %s", err)
+	}
+	if err = randomize.Struct(seed, sellersJSONVersionTwo, sellersJSONVersionDBTypes, false, sellersJSONVersionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SellersJSONVersion struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = sellersJSONVersionOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = sellersJSONVersionTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SellersJSONVersions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func sellersJSONVersionBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *SellersJSONVersion) error {
+	*o = SellersJSONVersion{}
+	return nil
+}
+
+func sellersJSONVersionAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *SellersJSONVersion) error {
+	*o = SellersJSONVersion{}
+	return nil
+}
+
+func sellersJSONVersionAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *SellersJSONVersion) error {
+	*o = SellersJSONVersion{}
+	return nil
+}
+
+func sellersJSONVersionBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *SellersJSONVersion) error {
+	*o = SellersJSONVersion{}
+	return nil
+}
+
+func sellersJSONVersionAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *SellersJSONVersion) error {
+	*o = SellersJSONVersion{}
+	return nil
+}
+
+func sellersJSONVersionBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *SellersJSONVersion) error {
+	*o = SellersJSONVersion{}
+	return nil
+}
+
+func sellersJSONVersionAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *SellersJSONVersion) error {
+	*o = SellersJSONVersion{}
+	return nil
+}
+
+func sellersJSONVersionBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *SellersJSONVersion) error {
+	*o = SellersJSONVersion{}
+	return nil
+}
+
+func sellersJSONVersionAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *SellersJSONVersion) error {
+	*o = SellersJSONVersion{}
+	return nil
+}
+
+func testSellersJSONVersionsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &SellersJSONVersion{}
+	o := &SellersJSONVersion{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, sellersJSONVersionDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize SellersJSONVersion object: %s", err)
+	}
+
+	AddSellersJSONVersionHook(boil.BeforeInsertHook, sellersJSONVersionBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	sellersJSONVersionBeforeInsertHooks = []SellersJSONVersionHook{}
+
+	AddSellersJSONVersionHook(boil.AfterInsertHook, sellersJSONVersionAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	sellersJSONVersionAfterInsertHooks = []SellersJSONVersionHook{}
+
+	AddSellersJSONVersionHook(boil.AfterSelectHook, sellersJSONVersionAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	sellersJSONVersionAfterSelectHooks = []SellersJSONVersionHook{}
+
+	AddSellersJSONVersionHook(boil.BeforeUpdateHook, sellersJSONVersionBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	sellersJSONVersionBeforeUpdateHooks = []SellersJSONVersionHook{}
+
+	AddSellersJSONVersionHook(boil.AfterUpdateHook, sellersJSONVersionAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	sellersJSONVersionAfterUpdateHooks = []SellersJSONVersionHook{}
+
+	AddSellersJSONVersionHook(boil.BeforeDeleteHook, sellersJSONVersionBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	sellersJSONVersionBeforeDeleteHooks = []SellersJSONVersionHook{}
+
+	AddSellersJSONVersionHook(boil.AfterDeleteHook, sellersJSONVersionAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	sellersJSONVersionAfterDeleteHooks = []SellersJSONVersionHook{}
+
+	AddSellersJSONVersionHook(boil.BeforeUpsertHook, sellersJSONVersionBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	sellersJSONVersionBeforeUpsertHooks = []SellersJSONVersionHook{}
+
+	AddSellersJSONVersionHook(boil.AfterUpsertHook, sellersJSONVersionAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	sellersJSONVersionAfterUpsertHooks = []SellersJSONVersionHook{}
+}
+
+func testSellersJSONVersionsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SellersJSONVersion{}
+	if err = randomize.Struct(seed, o, sellersJSONVersionDBTypes, true, sellersJSONVersionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SellersJSONVersion struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SellersJSONVersions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testSellersJSONVersionsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SellersJSONVersion{}
+	if err = randomize.Struct(seed, o, sellersJSONVersionDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize SellersJSONVersion struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(sellersJSONVersionColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SellersJSONVersions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testSellersJSONVersionsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SellersJSONVersion{}
+	if err = randomize.Struct(seed, o, sellersJSONVersionDBTypes, true, sellersJSONVersionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SellersJSONVersion struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testSellersJSONVersionsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SellersJSONVersion{}
+	if err = randomize.Struct(seed, o, sellersJSONVersionDBTypes, true, sellersJSONVersionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SellersJSONVersion struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := SellersJSONVersionSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testSellersJSONVersionsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SellersJSONVersion{}
+	if err = randomize.Struct(seed, o, sellersJSONVersionDBTypes, true, sellersJSONVersionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SellersJSONVersion struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := SellersJSONVersions().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	sellersJSONVersionDBTypes = map[string]string{`ID`: `integer`, `Content`: `text`, `Etag`: `character varying`, `Sellers`: `integer`, `Added`: `integer`, `Removed`: `integer`, `Changed`: `integer`, `PublishedBy`: `integer`, `PublishedAt`: `timestamp without time zone`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                         = bytes.MinRead
+)
+
+func testSellersJSONVersionsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(sellersJSONVersionPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(sellersJSONVersionAllColumns) == len(sellersJSONVersionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SellersJSONVersion{}
+	if err = randomize.Struct(seed, o, sellersJSONVersionDBTypes, true, sellersJSONVersionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SellersJSONVersion struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SellersJSONVersions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, sellersJSONVersionDBTypes, true, sellersJSONVersionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize SellersJSONVersion struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testSellersJSONVersionsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(sellersJSONVersionAllColumns) == len(sellersJSONVersionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SellersJSONVersion{}
+	if err = randomize.Struct(seed, o, sellersJSONVersionDBTypes, true, sellersJSONVersionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SellersJSONVersion struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SellersJSONVersions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, sellersJSONVersionDBTypes, true, sellersJSONVersionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize SellersJSONVersion struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(sellersJSONVersionAllColumns, sellersJSONVersionPrimaryKeyColumns) {
+		fields = sellersJSONVersionAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			sellersJSONVersionAllColumns,
+			sellersJSONVersionPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := SellersJSONVersionSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testSellersJSONVersionsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(sellersJSONVersionAllColumns) == len(sellersJSONVersionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := SellersJSONVersion{}
+	if err = randomize.Struct(seed, &o, sellersJSONVersionDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize SellersJSONVersion struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert SellersJSONVersion: %s", err)
+	}
+
+	count, err := SellersJSONVersions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, sellersJSONVersionDBTypes, false, sellersJSONVersionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize SellersJSONVersion struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert SellersJSONVersion: %s", err)
+	}
+
+	count, err = SellersJSONVersions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,974 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// UserPublisher is an object representing the database table.
+type UserPublisher struct {
+	ID          int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID      int       `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	PublisherID string    `boil:"publisher_id" json:"publisher_id" toml:"publisher_id" yaml:"publisher_id"`
+	CreatedAt   time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt   null.Time `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *userPublisherR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L userPublisherL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var UserPublisherColumns = struct {
+	ID          string
+	UserID      string
+	PublisherID string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "id",
+	UserID:      "user_id",
+	PublisherID: "publisher_id",
+	CreatedAt:   "created_at",
+	UpdatedAt:   "updated_at",
+}
+
+var UserPublisherTableColumns = struct {
+	ID          string
+	UserID      string
+	PublisherID string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "user_publisher.id",
+	UserID:      "user_publisher.user_id",
+	PublisherID: "user_publisher.publisher_id",
+	CreatedAt:   "user_publisher.created_at",
+	UpdatedAt:   "user_publisher.updated_at",
+}
+
+// Generated where
+
+var UserPublisherWhere = struct {
+	ID          whereHelperint
+	UserID      whereHelperint
+	PublisherID whereHelperstring
+	CreatedAt   whereHelpertime_Time
+	UpdatedAt   whereHelpernull_Time
+}{
+	ID:          whereHelperint{field: "\"user_publisher\".\"id\""},
+	UserID:      whereHelperint{field: "\"user_publisher\".\"user_id\""},
+	PublisherID: whereHelperstring{field: "\"user_publisher\".\"publisher_id\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"user_publisher\".\"created_at\""},
+	UpdatedAt:   whereHelpernull_Time{field: "\"user_publisher\".\"updated_at\""},
+}
+
+// UserPublisherRels is where relationship names are stored.
+var UserPublisherRels = struct {
+}{}
+
+// userPublisherR is where relationships are stored.
+type userPublisherR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*userPublisherR) NewStruct() *userPublisherR {
+	return &userPublisherR{}
+}
+
+// userPublisherL is where Load methods for each relationship are stored.
+type userPublisherL struct{}
+
+var (
+	userPublisherAllColumns            = []string{"id", "user_id", "publisher_id", "created_at", "updated_at"}
+	userPublisherColumnsWithoutDefault = []string{"user_id", "publisher_id", "created_at"}
+	userPublisherColumnsWithDefault    = []string{"id", "updated_at"}
+	userPublisherPrimaryKeyColumns     = []string{"id"}
+	userPublisherGeneratedColumns      = []string{}
+)
+
+type (
+	// UserPublisherSlice is an alias for a slice of pointers to UserPublisher.
+	// This should almost always be used instead of []UserPublisher.
+	UserPublisherSlice []*UserPublisher
+	// UserPublisherHook is the signature for custom UserPublisher hook methods
+	UserPublisherHook func(context.Context, boil.ContextExecutor, *UserPublisher) error
+
+	userPublisherQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	userPublisherType                 = reflect.TypeOf(&UserPublisher{})
+	userPublisherMapping              = queries.MakeStructMapping(userPublisherType)
+	userPublisherPrimaryKeyMapping, _ = queries.BindMapping(userPublisherType, userPublisherMapping, userPublisherPrimaryKeyColumns)
+	userPublisherInsertCacheMut       sync.RWMutex
+	userPublisherInsertCache          = make(map[string]insertCache)
+	userPublisherUpdateCacheMut       sync.RWMutex
+	userPublisherUpdateCache          = make(map[string]updateCache)
+	userPublisherUpsertCacheMut       sync.RWMutex
+	userPublisherUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var userPublisherAfterSelectMu sync.Mutex
+var userPublisherAfterSelectHooks []UserPublisherHook
+
+var userPublisherBeforeInsertMu sync.Mutex
+var userPublisherBeforeInsertHooks []UserPublisherHook
+var userPublisherAfterInsertMu sync.Mutex
+var userPublisherAfterInsertHooks []UserPublisherHook
+
+var userPublisherBeforeUpdateMu sync.Mutex
+var userPublisherBeforeUpdateHooks []UserPublisherHook
+var userPublisherAfterUpdateMu sync.Mutex
+var userPublisherAfterUpdateHooks []UserPublisherHook
+
+var userPublisherBeforeDeleteMu sync.Mutex
+var userPublisherBeforeDeleteHooks []UserPublisherHook
+var userPublisherAfterDeleteMu sync.Mutex
+var userPublisherAfterDeleteHooks []UserPublisherHook
+
+var userPublisherBeforeUpsertMu sync.Mutex
+var userPublisherBeforeUpsertHooks []UserPublisherHook
+var userPublisherAfterUpsertMu sync.Mutex
+var userPublisherAfterUpsertHooks []UserPublisherHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *UserPublisher) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userPublisherAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *UserPublisher) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userPublisherBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *UserPublisher) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userPublisherAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *UserPublisher) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userPublisherBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *UserPublisher) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userPublisherAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *UserPublisher) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userPublisherBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *UserPublisher) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userPublisherAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *UserPublisher) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userPublisherBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *UserPublisher) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userPublisherAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddUserPublisherHook registers your hook function for all future operations.
+func AddUserPublisherHook(hookPoint boil.HookPoint, userPublisherHook UserPublisherHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		userPublisherAfterSelectMu.Lock()
+		userPublisherAfterSelectHooks = append(userPublisherAfterSelectHooks, userPublisherHook)
+		userPublisherAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		userPublisherBeforeInsertMu.Lock()
+		userPublisherBeforeInsertHooks = append(userPublisherBeforeInsertHooks, userPublisherHook)
+		userPublisherBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		userPublisherAfterInsertMu.Lock()
+		userPublisherAfterInsertHooks = append(userPublisherAfterInsertHooks, userPublisherHook)
+		userPublisherAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		userPublisherBeforeUpdateMu.Lock()
+		userPublisherBeforeUpdateHooks = append(userPublisherBeforeUpdateHooks, userPublisherHook)
+		userPublisherBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		userPublisherAfterUpdateMu.Lock()
+		userPublisherAfterUpdateHooks = append(userPublisherAfterUpdateHooks, userPublisherHook)
+		userPublisherAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		userPublisherBeforeDeleteMu.Lock()
+		userPublisherBeforeDeleteHooks = append(userPublisherBeforeDeleteHooks, userPublisherHook)
+		userPublisherBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		userPublisherAfterDeleteMu.Lock()
+		userPublisherAfterDeleteHooks = append(userPublisherAfterDeleteHooks, userPublisherHook)
+		userPublisherAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		userPublisherBeforeUpsertMu.Lock()
+		userPublisherBeforeUpsertHooks = append(userPublisherBeforeUpsertHooks, userPublisherHook)
+		userPublisherBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		userPublisherAfterUpsertMu.Lock()
+		userPublisherAfterUpsertHooks = append(userPublisherAfterUpsertHooks, userPublisherHook)
+		userPublisherAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single userPublisher record from the query.
+func (q userPublisherQuery) One(ctx context.Context, exec boil.ContextExecutor) (*UserPublisher, error) {
+	o := &UserPublisher{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for user_publisher")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all UserPublisher records from the query.
+func (q userPublisherQuery) All(ctx context.Context, exec boil.ContextExecutor) (UserPublisherSlice, error) {
+	var o []*UserPublisher
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to UserPublisher slice")
+	}
+
+	if len(userPublisherAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all UserPublisher records in the query.
+func (q userPublisherQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count user_publisher rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q userPublisherQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if user_publisher exists")
+	}
+
+	return count > 0, nil
+}
+
+// UserPublishers retrieves all the records using an executor.
+func UserPublishers(mods ...qm.QueryMod) userPublisherQuery {
+	mods = append(mods, qm.From("\"user_publisher\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"user_publisher\".*"})
+	}
+
+	return userPublisherQuery{q}
+}
+
+// FindUserPublisher retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindUserPublisher(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*UserPublisher, error) {
+	userPublisherObj := &UserPublisher{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"user_publisher\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, userPublisherObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from user_publisher")
+	}
+
+	if err = userPublisherObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return userPublisherObj, err
+	}
+
+	return userPublisherObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *UserPublisher) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no user_publisher provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(userPublisherColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	userPublisherInsertCacheMut.RLock()
+	cache, cached := userPublisherInsertCache[key]
+	userPublisherInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			userPublisherAllColumns,
+			userPublisherColumnsWithDefault,
+			userPublisherColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(userPublisherType, userPublisherMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(userPublisherType, userPublisherMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"user_publisher\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"user_publisher\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into user_publisher")
+	}
+
+	if !cached {
+		userPublisherInsertCacheMut.Lock()
+		userPublisherInsertCache[key] = cache
+		userPublisherInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the UserPublisher.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *UserPublisher) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	userPublisherUpdateCacheMut.RLock()
+	cache, cached := userPublisherUpdateCache[key]
+	userPublisherUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			userPublisherAllColumns,
+			userPublisherPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update user_publisher, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"user_publisher\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, userPublisherPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(userPublisherType, userPublisherMapping, append(wl, userPublisherPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update user_publisher row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for user_publisher")
+	}
+
+	if !cached {
+		userPublisherUpdateCacheMut.Lock()
+		userPublisherUpdateCache[key] = cache
+		userPublisherUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q userPublisherQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for user_publisher")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for user_publisher")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o UserPublisherSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userPublisherPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"user_publisher\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, userPublisherPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in userPublisher slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all userPublisher")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *UserPublisher) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no user_publisher provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(userPublisherColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	userPublisherUpsertCacheMut.RLock()
+	cache, cached := userPublisherUpsertCache[key]
+	userPublisherUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			userPublisherAllColumns,
+			userPublisherColumnsWithDefault,
+			userPublisherColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			userPublisherAllColumns,
+			userPublisherPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert user_publisher, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(userPublisherAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(userPublisherPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert user_publisher, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(userPublisherPrimaryKeyColumns))
+			copy(conflict, userPublisherPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"user_publisher\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(userPublisherType, userPublisherMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(userPublisherType, userPublisherMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert user_publisher")
+	}
+
+	if !cached {
+		userPublisherUpsertCacheMut.Lock()
+		userPublisherUpsertCache[key] = cache
+		userPublisherUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single UserPublisher record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *UserPublisher) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no UserPublisher provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), userPublisherPrimaryKeyMapping)
+	sql := "DELETE FROM \"user_publisher\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from user_publisher")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for user_publisher")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q userPublisherQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no userPublisherQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from user_publisher")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for user_publisher")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o UserPublisherSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(userPublisherBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userPublisherPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"user_publisher\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, userPublisherPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from userPublisher slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for user_publisher")
+	}
+
+	if len(userPublisherAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *UserPublisher) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindUserPublisher(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *UserPublisherSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := UserPublisherSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userPublisherPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"user_publisher\".* FROM \"user_publisher\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, userPublisherPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in UserPublisherSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// UserPublisherExists checks if the UserPublisher row exists.
+func UserPublisherExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"user_publisher\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if user_publisher exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the UserPublisher row exists.
+func (o *UserPublisher) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return UserPublisherExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testUserPublishers(t *testing.T) {
+	t.Parallel()
+
+	query := UserPublishers()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testUserPublishersDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserPublisher{}
+	if err = randomize.Struct(seed, o, userPublisherDBTypes, true, userPublisherColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserPublisher struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := UserPublishers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testUserPublishersQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserPublisher{}
+	if err = randomize.Struct(seed, o, userPublisherDBTypes, true, userPublisherColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserPublisher struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := UserPublishers().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := UserPublishers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testUserPublishersSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserPublisher{}
+	if err = randomize.Struct(seed, o, userPublisherDBTypes, true, userPublisherColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserPublisher struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := UserPublisherSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := UserPublishers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testUserPublishersExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserPublisher{}
+	if err = randomize.Struct(seed, o, userPublisherDBTypes, true, userPublisherColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserPublisher struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := UserPublisherExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if UserPublisher exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected UserPublisherExists to return true, but got false.")
+	}
+}
+
+func testUserPublishersFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserPublisher{}
+	if err = randomize.Struct(seed, o, userPublisherDBTypes, true, userPublisherColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserPublisher struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	userPublisherFound, err := FindUserPublisher(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if userPublisherFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testUserPublishersBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserPublisher{}
+	if err = randomize.Struct(seed, o, userPublisherDBTypes, true, userPublisherColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserPublisher struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = UserPublishers().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testUserPublishersOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserPublisher{}
+	if err = randomize.Struct(seed, o, userPublisherDBTypes, true, userPublisherColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserPublisher struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := UserPublishers().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testUserPublishersAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	userPublisherOne := &UserPublisher{}
+	userPublisherTwo := &UserPublisher{}
+	if err = randomize.Struct(seed, userPublisherOne, userPublisherDBTypes, false, userPublisherColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserPublisher struct: %s", err)
+	}
+	if err = randomize.Struct(seed, userPublisherTwo, userPublisherDBTypes, false, userPublisherColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserPublisher struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = userPublisherOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = userPublisherTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := UserPublishers().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testUserPublishersCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	userPublisherOne := &UserPublisher{}
+	userPublisherTwo := &UserPublisher{}
+	if err = randomize.Struct(seed, userPublisherOne, userPublisherDBTypes, false, userPublisherColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserPublisher struct: %s", err)
+	}
+	if err = randomize.Struct(seed, userPublisherTwo, userPublisherDBTypes, false, userPublisherColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserPublisher struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = userPublisherOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = userPublisherTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := UserPublishers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func userPublisherBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *UserPublisher) error {
+	*o = UserPublisher{}
+	return nil
+}
+
+func userPublisherAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *UserPublisher) error {
+	*o = UserPublisher{}
+	return nil
+}
+
+func userPublisherAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *UserPublisher) error {
+	*o = UserPublisher{}
+	return nil
+}
+
+func userPublisherBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *UserPublisher) error {
+	*o = UserPublisher{}
+	return nil
+}
+
+func userPublisherAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *UserPublisher) error {
+	*o = UserPublisher{}
+	return nil
+}
+
+func userPublisherBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *UserPublisher) error {
+	*o = UserPublisher{}
+	return nil
+}
+
+func userPublisherAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *UserPublisher) error {
+	*o = UserPublisher{}
+	return nil
+}
+
+func userPublisherBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *UserPublisher) error {
+	*o = UserPublisher{}
+	return nil
+}
+
+func userPublisherAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *UserPublisher) error {
+	*o = UserPublisher{}
+	return nil
+}
+
+func testUserPublishersHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &UserPublisher{}
+	o := &UserPublisher{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, userPublisherDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize UserPublisher object: %s", err)
+	}
+
+	AddUserPublisherHook(boil.BeforeInsertHook, userPublisherBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	userPublisherBeforeInsertHooks = []UserPublisherHook{}
+
+	AddUserPublisherHook(boil.AfterInsertHook, userPublisherAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	userPublisherAfterInsertHooks = []UserPublisherHook{}
+
+	AddUserPublisherHook(boil.AfterSelectHook, userPublisherAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	userPublisherAfterSelectHooks = []UserPublisherHook{}
+
+	AddUserPublisherHook(boil.BeforeUpdateHook, userPublisherBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	userPublisherBeforeUpdateHooks = []UserPublisherHook{}
+
+	AddUserPublisherHook(boil.AfterUpdateHook, userPublisherAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	userPublisherAfterUpdateHooks = []UserPublisherHook{}
+
+	AddUserPublisherHook(boil.BeforeDeleteHook, userPublisherBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	userPublisherBeforeDeleteHooks = []UserPublisherHook{}
+
+	AddUserPublisherHook(boil.AfterDeleteHook, userPublisherAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	userPublisherAfterDeleteHooks = []UserPublisherHook{}
+
+	AddUserPublisherHook(boil.BeforeUpsertHook, userPublisherBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	userPublisherBeforeUpsertHooks = []UserPublisherHook{}
+
+	AddUserPublisherHook(boil.AfterUpsertHook, userPublisherAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	userPublisherAfterUpsertHooks = []UserPublisherHook{}
+}
+
+func testUserPublishersInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserPublisher{}
+	if err = randomize.Struct(seed, o, userPublisherDBTypes, true, userPublisherColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserPublisher struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := UserPublishers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testUserPublishersInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserPublisher{}
+	if err = randomize.Struct(seed, o, userPublisherDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize UserPublisher struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(userPublisherColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := UserPublishers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testUserPublishersReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserPublisher{}
+	if err = randomize.Struct(seed, o, userPublisherDBTypes, true, userPublisherColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserPublisher struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testUserPublishersReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserPublisher{}
+	if err = randomize.Struct(seed, o, userPublisherDBTypes, true, userPublisherColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserPublisher struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := UserPublisherSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testUserPublishersSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserPublisher{}
+	if err = randomize.Struct(seed, o, userPublisherDBTypes, true, userPublisherColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserPublisher struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := UserPublishers().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	userPublisherDBTypes = map[string]string{`ID`: `integer`, `UserID`: `integer`, `PublisherID`: `character varying`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                    = bytes.MinRead
+)
+
+func testUserPublishersUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(userPublisherPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(userPublisherAllColumns) == len(userPublisherPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserPublisher{}
+	if err = randomize.Struct(seed, o, userPublisherDBTypes, true, userPublisherColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserPublisher struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := UserPublishers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, userPublisherDBTypes, true, userPublisherPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize UserPublisher struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testUserPublishersSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(userPublisherAllColumns) == len(userPublisherPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &UserPublisher{}
+	if err = randomize.Struct(seed, o, userPublisherDBTypes, true, userPublisherColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize UserPublisher struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := UserPublishers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, userPublisherDBTypes, true, userPublisherPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize UserPublisher struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(userPublisherAllColumns, userPublisherPrimaryKeyColumns) {
+		fields = userPublisherAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			userPublisherAllColumns,
+			userPublisherPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := UserPublisherSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testUserPublishersUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(userPublisherAllColumns) == len(userPublisherPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := UserPublisher{}
+	if err = randomize.Struct(seed, &o, userPublisherDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize UserPublisher struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert UserPublisher: %s", err)
+	}
+
+	count, err := UserPublishers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, userPublisherDBTypes, false, userPublisherPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize UserPublisher struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert UserPublisher: %s", err)
+	}
+
+	count, err = UserPublishers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
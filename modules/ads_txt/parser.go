@@ -0,0 +1,156 @@
+package adstxt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/m6yf/bcwork/dto"
+)
+
+// ads.txt variables
+const (
+	VariableContact                = "CONTACT"
+	VariableSubdomain              = "SUBDOMAIN"
+	VariableInventoryPartnerDomain = "INVENTORYPARTNERDOMAIN"
+	VariableOwnerDomain            = "OWNERDOMAIN"
+	VariableManagerDomain          = "MANAGERDOMAIN"
+)
+
+// Record is a data record of ads.txt: <advertising system domain>, <account id>, <relationship>[, <certification authority id>]
+type Record struct {
+	Domain                   string
+	AccountID                string
+	Relationship             string
+	CertificationAuthorityID string
+}
+
+func (r *Record) String() string {
+	line := fmt.Sprintf("%v, %v, %v", r.Domain, r.AccountID, r.Relationship)
+	if r.CertificationAuthorityID != "" {
+		line += ", " + r.CertificationAuthorityID
+	}
+
+	return line
+}
+
+// File is a parsed ads.txt or app-ads.txt file
+type File struct {
+	Records   []*Record
+	Variables map[string][]string // by upper cased variable name
+}
+
+// Parse parses ads.txt content per IAB specification: comments start with '#', extension fields
+// start with ';', variables are "<name>=<value>" lines, invalid records are skipped
+func Parse(content string) *File {
+	file := &File{
+		Records:   make([]*Record, 0),
+		Variables: make(map[string][]string),
+	}
+
+	content = strings.TrimPrefix(content, "\ufeff")
+	for _, line := range strings.Split(content, "\n") {
+		line, _, _ = strings.Cut(line, "#")
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		name, value, isVariable := strings.Cut(line, "=")
+		if isVariable && !strings.Contains(name, ",") {
+			name = strings.ToUpper(strings.TrimSpace(name))
+			file.Variables[name] = append(file.Variables[name], strings.TrimSpace(value))
+			continue
+		}
+
+		record, ok := ParseRecord(line)
+		if ok {
+			file.Records = append(file.Records, record)
+		}
+	}
+
+	return file
+}
+
+// ParseRecord parses a single data record, domain is lower cased and relationship is upper cased
+func ParseRecord(line string) (*Record, bool) {
+	line, _, _ = strings.Cut(line, ";")
+	fields := strings.Split(line, ",")
+	if len(fields) < 3 {
+		return nil, false
+	}
+
+	record := &Record{
+		Domain:       strings.ToLower(strings.TrimSpace(fields[0])),
+		AccountID:    strings.TrimSpace(fields[1]),
+		Relationship: strings.ToUpper(strings.TrimSpace(fields[2])),
+	}
+	if len(fields) > 3 {
+		record.CertificationAuthorityID = strings.TrimSpace(fields[3])
+	}
+
+	if record.Domain == "" || record.AccountID == "" ||
+		(record.Relationship != dto.AdsTxtTypeDirect && record.Relationship != dto.AdsTxtTypeReseller) {
+		return nil, false
+	}
+
+	return record, true
+}
+
+// Subdomains returns subdomains which ads.txt files are declared by SUBDOMAIN variable
+func (f *File) Subdomains() []string {
+	subdomains := make([]string, 0, len(f.Variables[VariableSubdomain]))
+	for _, value := range f.Variables[VariableSubdomain] {
+		subdomains = append(subdomains, strings.ToLower(value))
+	}
+
+	return subdomains
+}
+
+// OwnerDomain returns business domain of the inventory owner declared by OWNERDOMAIN variable
+func (f *File) OwnerDomain() string {
+	values := f.Variables[VariableOwnerDomain]
+	if len(values) == 0 {
+		return ""
+	}
+
+	return strings.ToLower(values[0])
+}
+
+// ManagerDomains returns domains declared by MANAGERDOMAIN variables without optional country code
+func (f *File) ManagerDomains() []string {
+	domains := make([]string, 0, len(f.Variables[VariableManagerDomain]))
+	for _, value := range f.Variables[VariableManagerDomain] {
+		domain, _, _ := strings.Cut(value, ",")
+		domains = append(domains, strings.ToLower(strings.TrimSpace(domain)))
+	}
+
+	return domains
+}
+
+// Verify looks for expected record in the file. Record with the same advertising system and account
+// is found when its relationship and certification authority match, otherwise it is a mismatch.
+// Certification authority is optional, so its absence in the file is not a mismatch.
+func (f *File) Verify(expected *Record) (string, string) {
+	var reason string
+	for _, record := range f.Records {
+		if record.Domain != expected.Domain || !strings.EqualFold(record.AccountID, expected.AccountID) {
+			continue
+		}
+
+		switch {
+		case record.Relationship != expected.Relationship:
+			reason = fmt.Sprintf("relationship is %v instead of %v", record.Relationship, expected.Relationship)
+		case record.CertificationAuthorityID != "" && expected.CertificationAuthorityID != "" &&
+			!strings.EqualFold(record.CertificationAuthorityID, expected.CertificationAuthorityID):
+			reason = fmt.Sprintf("certification authority id is %v instead of %v", record.CertificationAuthorityID, expected.CertificationAuthorityID)
+		default:
+			return dto.AdsTxtScanResultFound, ""
+		}
+	}
+
+	if reason != "" {
+		return dto.AdsTxtScanResultMismatch, reason
+	}
+
+	return dto.AdsTxtScanResultMissing, ""
+}
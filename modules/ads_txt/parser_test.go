@@ -0,0 +1,96 @@
+package adstxt
+
+import (
+	"testing"
+
+	"github.com/m6yf/bcwork/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	content := "\ufeff# ads.txt of example.com\n" +
+		"CONTACT=adops@example.com\n" +
+		"subdomain=News.Example.com\n" +
+		"OWNERDOMAIN=Example.com\n" +
+		"MANAGERDOMAIN=manager.com, US\n" +
+		"managerdomain=other-manager.com\n" +
+		"Google.com, pub-123, direct, f08c47fec0942fa0 # comment\n" +
+		"appnexus.com, 456, RESELLER; extension=value\n" +
+		"invalid.com, 789, PARTNER\n" +
+		"not a record\n" +
+		"\r\n" +
+		"openx.com,  999 ,DIRECT\r\n"
+
+	file := Parse(content)
+
+	assert.Equal(t, []*Record{
+		{Domain: "google.com", AccountID: "pub-123", Relationship: dto.AdsTxtTypeDirect, CertificationAuthorityID: "f08c47fec0942fa0"},
+		{Domain: "appnexus.com", AccountID: "456", Relationship: dto.AdsTxtTypeReseller},
+		{Domain: "openx.com", AccountID: "999", Relationship: dto.AdsTxtTypeDirect},
+	}, file.Records)
+	assert.Equal(t, []string{"adops@example.com"}, file.Variables[VariableContact])
+	assert.Equal(t, []string{"news.example.com"}, file.Subdomains())
+	assert.Equal(t, "example.com", file.OwnerDomain())
+	assert.Equal(t, []string{"manager.com", "other-manager.com"}, file.ManagerDomains())
+}
+
+func TestFile_Verify(t *testing.T) {
+	t.Parallel()
+
+	file := Parse(
+		"google.com, pub-123, DIRECT, f08c47fec0942fa0\n" +
+			"appnexus.com, 456, RESELLER\n" +
+			"openx.com, 999, DIRECT, aaa\n",
+	)
+
+	tests := []struct {
+		name       string
+		expected   string
+		wantResult string
+		wantReason string
+	}{
+		{
+			name:       "found",
+			expected:   "Google.com, PUB-123, DIRECT, f08c47fec0942fa0",
+			wantResult: dto.AdsTxtScanResultFound,
+		},
+		{
+			name:       "foundWithoutCertificationAuthorityInFile",
+			expected:   "appnexus.com, 456, RESELLER, abc",
+			wantResult: dto.AdsTxtScanResultFound,
+		},
+		{
+			name:       "relationshipMismatch",
+			expected:   "appnexus.com, 456, DIRECT",
+			wantResult: dto.AdsTxtScanResultMismatch,
+			wantReason: "relationship is RESELLER instead of DIRECT",
+		},
+		{
+			name:       "certificationAuthorityMismatch",
+			expected:   "openx.com, 999, DIRECT, bbb",
+			wantResult: dto.AdsTxtScanResultMismatch,
+			wantReason: "certification authority id is aaa instead of bbb",
+		},
+		{
+			name:       "missing",
+			expected:   "google.com, pub-999, DIRECT",
+			wantResult: dto.AdsTxtScanResultMissing,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			expected, ok := ParseRecord(tt.expected)
+			assert.True(t, ok)
+
+			result, reason := file.Verify(expected)
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantReason, reason)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package adstxt
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FileLine is a line of generated ads.txt with the group it is listed under
+type FileLine struct {
+	Group string
+	Line  string
+}
+
+// FileOptions describes generated ads.txt or app-ads.txt of the domain
+type FileOptions struct {
+	Domain        string
+	FileName      string
+	OwnerDomain   string
+	ManagerDomain string
+	Lines         []*FileLine // already ordered
+}
+
+// Render returns content of the file: header, OWNERDOMAIN and MANAGERDOMAIN variables
+// and the lines grouped under comments. Duplicated lines are listed only once.
+// Content doesn't depend on generation time so it can be cached.
+func Render(ops FileOptions) string {
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "# %v for %v\n", ops.FileName, ops.Domain)
+	if ops.OwnerDomain != "" {
+		fmt.Fprintf(&builder, "%v=%v\n", VariableOwnerDomain, ops.OwnerDomain)
+	}
+	if ops.ManagerDomain != "" {
+		fmt.Fprintf(&builder, "%v=%v\n", VariableManagerDomain, ops.ManagerDomain)
+	}
+
+	seen := make(map[string]struct{}, len(ops.Lines))
+	var group *string
+	for _, line := range ops.Lines {
+		value := strings.TrimSpace(line.Line)
+		key := strings.ToLower(value)
+		if _, ok := seen[key]; ok || value == "" {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		if group == nil || line.Group != *group {
+			group = &line.Group
+			builder.WriteString("\n")
+			if line.Group != "" {
+				fmt.Fprintf(&builder, "# %v\n", line.Group)
+			}
+		}
+		builder.WriteString(value + "\n")
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,68 @@
+package adstxt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		ops  FileOptions
+		want string
+	}{
+		{
+			name: "groupedLines",
+			ops: FileOptions{
+				Domain:        "example.com",
+				FileName:      "ads.txt",
+				OwnerDomain:   "example.com",
+				ManagerDomain: "manager.com",
+				Lines: []*FileLine{
+					{Group: "Seat Owner - Direct", Line: "seatowner.com, 1, DIRECT"},
+					{Group: "Appnexus", Line: "appnexus.com, 2, RESELLER"},
+					{Group: "Appnexus", Line: "AppNexus.com, 2, RESELLER"},
+					{Group: "Appnexus", Line: "appnexus.com, 3, DIRECT, f5ab79cb980f11d1"},
+					{Group: "OpenX", Line: "seatowner.com, 1, DIRECT"},
+					{Group: "OpenX", Line: "openx.com, 4, RESELLER"},
+				},
+			},
+			want: "# ads.txt for example.com\n" +
+				"OWNERDOMAIN=example.com\n" +
+				"MANAGERDOMAIN=manager.com\n" +
+				"\n# Seat Owner - Direct\n" +
+				"seatowner.com, 1, DIRECT\n" +
+				"\n# Appnexus\n" +
+				"appnexus.com, 2, RESELLER\n" +
+				"appnexus.com, 3, DIRECT, f5ab79cb980f11d1\n" +
+				"\n# OpenX\n" +
+				"openx.com, 4, RESELLER\n",
+		},
+		{
+			name: "withoutVariables",
+			ops: FileOptions{
+				Domain:   "example.com",
+				FileName: "app-ads.txt",
+				Lines: []*FileLine{
+					{Group: "", Line: " "},
+					{Group: "", Line: "openx.com, 4, RESELLER"},
+				},
+			},
+			want: "# app-ads.txt for example.com\n" +
+				"\n" +
+				"openx.com, 4, RESELLER\n",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, Render(tt.ops))
+		})
+	}
+}
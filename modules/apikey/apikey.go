@@ -0,0 +1,102 @@
+package apikey
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+)
+
+const (
+	// Prefix marks keys issued by OMS, it helps secret scanners to find leaked keys
+	Prefix = "oms_"
+
+	secretLength  = 32
+	displayLength = len(Prefix) + 8
+)
+
+// Generate returns new random key, its prefix for display and hash to store
+func Generate() (key, keyPrefix, keyHash string, err error) {
+	secret := make([]byte, secretLength)
+	_, err = rand.Read(secret)
+	if err != nil {
+		return "", "", "", fmt.Errorf("failed to generate api key: %w", err)
+	}
+
+	key = Prefix + hex.EncodeToString(secret)
+
+	return key, key[:displayLength], Hash(key), nil
+}
+
+// Hash returns sha256 hex of the key. Keys are random and long enough so salting is not needed.
+func Hash(key string) string {
+	sum := sha256.Sum256([]byte(key))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// IsIssued reports whether the value looks like a key issued by OMS
+func IsIssued(value string) bool {
+	return strings.HasPrefix(value, Prefix) && len(value) == len(Prefix)+2*secretLength
+}
+
+// IsAllowedIP reports whether the ip matches one of allowed ips or networks in CIDR notation.
+// Empty allow-list allows any ip.
+func IsAllowedIP(allowed []string, ip string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+
+	for _, value := range allowed {
+		if strings.Contains(value, "/") {
+			_, network, err := net.ParseCIDR(value)
+			if err == nil && network.Contains(parsed) {
+				return true
+			}
+			continue
+		}
+
+		if allowedIP := net.ParseIP(value); allowedIP != nil && allowedIP.Equal(parsed) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ClientIP returns ip of the client. X-Forwarded-For is used only when the connection comes from one of trusted
+// proxies (ips or networks in CIDR notation): its entries are walked from the last one, which is appended by the proxy,
+// and the first entry which is not a trusted proxy is the client. Otherwise remote address of the connection is used,
+// as X-Forwarded-For can be sent by the client itself
+func ClientIP(forwardedFor, remoteAddr string, trustedProxies []string) string {
+	ip := remoteAddr
+	if host, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		ip = host
+	}
+
+	if len(trustedProxies) == 0 || !IsAllowedIP(trustedProxies, ip) {
+		return ip
+	}
+
+	entries := strings.Split(forwardedFor, ",")
+	for i := len(entries) - 1; i >= 0; i-- {
+		entry := strings.TrimSpace(entries[i])
+		if entry == "" {
+			continue
+		}
+
+		ip = entry
+		if !IsAllowedIP(trustedProxies, entry) {
+			break
+		}
+	}
+
+	return ip
+}
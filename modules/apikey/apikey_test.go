@@ -0,0 +1,78 @@
+package apikey
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	key, keyPrefix, keyHash, err := Generate()
+	require.NoError(t, err)
+
+	assert.True(t, IsIssued(key))
+	assert.True(t, len(keyPrefix) < len(key))
+	assert.Equal(t, key[:len(keyPrefix)], keyPrefix)
+	assert.Equal(t, Hash(key), keyHash)
+	assert.Len(t, keyHash, 64)
+
+	other, _, otherHash, err := Generate()
+	require.NoError(t, err)
+	assert.NotEqual(t, key, other)
+	assert.NotEqual(t, keyHash, otherHash)
+}
+
+func TestIsIssued(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, IsIssued(""))
+	assert.False(t, IsIssued("cron_worker_api_key"))
+	assert.False(t, IsIssued(Prefix+"short"))
+}
+
+func TestIsAllowedIP(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		allowed []string
+		ip      string
+		want    bool
+	}{
+		{name: "emptyAllowList", allowed: nil, ip: "10.0.0.1", want: true},
+		{name: "exactIP", allowed: []string{"10.0.0.1"}, ip: "10.0.0.1", want: true},
+		{name: "otherIP", allowed: []string{"10.0.0.1"}, ip: "10.0.0.2", want: false},
+		{name: "network", allowed: []string{"192.168.0.1", "10.0.0.0/24"}, ip: "10.0.0.200", want: true},
+		{name: "outsideNetwork", allowed: []string{"10.0.0.0/24"}, ip: "10.0.1.1", want: false},
+		{name: "ipv6", allowed: []string{"2001:db8::/32"}, ip: "2001:db8::1", want: true},
+		{name: "invalidIP", allowed: []string{"10.0.0.0/24"}, ip: "unknown", want: false},
+		{name: "invalidAllowed", allowed: []string{"10.0.0.0/99"}, ip: "10.0.0.1", want: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, IsAllowedIP(tt.allowed, tt.ip))
+		})
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	t.Parallel()
+
+	trustedProxies := []string{"10.0.0.0/24"}
+
+	assert.Equal(t, "1.1.1.1", ClientIP("1.1.1.1", "10.0.0.2:443", trustedProxies))
+	assert.Equal(t, "1.1.1.1", ClientIP("6.6.6.6, 1.1.1.1", "10.0.0.2:443", trustedProxies))
+	assert.Equal(t, "1.1.1.1", ClientIP("6.6.6.6, 1.1.1.1, 10.0.0.3", "10.0.0.2:443", trustedProxies))
+	assert.Equal(t, "10.0.0.2", ClientIP("", "10.0.0.2:443", trustedProxies))
+	assert.Equal(t, "10.0.0.2", ClientIP(" ", "10.0.0.2", trustedProxies))
+	// forwarded for is sent by the client itself when the connection doesn't come from trusted proxy
+	assert.Equal(t, "2.2.2.2", ClientIP("1.1.1.1", "2.2.2.2:443", trustedProxies))
+	assert.Equal(t, "10.0.0.2", ClientIP("1.1.1.1", "10.0.0.2:443", nil))
+}
@@ -0,0 +1,141 @@
+package audit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/models"
+	"github.com/m6yf/bcwork/modules/apikey"
+	"github.com/m6yf/bcwork/modules/rbac"
+	"github.com/m6yf/bcwork/utils/constant"
+	"github.com/rs/zerolog/log"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+const (
+	defaultBufferSize    = 1000
+	defaultBodySizeLimit = 64 * 1024
+)
+
+// Recorder records mutating requests into audit log. Entries are saved in background
+// so the request latency doesn't depend on the DB, entries are dropped when the buffer is full.
+type Recorder struct {
+	bodySizeLimit  int
+	trustedProxies []string
+	entries        chan *models.AuditLog
+	save           func(ctx context.Context, entry *models.AuditLog) error
+}
+
+// NewRecorder returns recorder, client ip is taken from X-Forwarded-For only behind trusted proxies
+func NewRecorder(bufferSize, bodySizeLimit int, trustedProxies []string) *Recorder {
+	if bufferSize <= 0 {
+		bufferSize = defaultBufferSize
+	}
+
+	if bodySizeLimit <= 0 {
+		bodySizeLimit = defaultBodySizeLimit
+	}
+
+	return &Recorder{
+		bodySizeLimit:  bodySizeLimit,
+		trustedProxies: trustedProxies,
+		entries:        make(chan *models.AuditLog, bufferSize),
+		save:           saveEntry,
+	}
+}
+
+// Run saves recorded entries until the context is done
+func (r *Recorder) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case entry := <-r.entries:
+			err := r.save(ctx, entry)
+			if err != nil {
+				log.Error().Err(err).Str("route", entry.Route).Msg("failed to save audit log entry")
+			}
+		}
+	}
+}
+
+// Record is a middleware recording requests which require write or delete permission.
+// It must be registered after session verification to attribute requests to users and api keys.
+func (r *Recorder) Record(c *fiber.Ctx) error {
+	if !IsMutating(c.Method(), c.Path()) {
+		return c.Next()
+	}
+
+	start := time.Now()
+	body := c.Body()
+	hash := sha256.Sum256(body)
+
+	// values of fiber context are reused after the request, the entry is saved later so they are copied
+	entry := &models.AuditLog{
+		Method:      strings.Clone(c.Method()),
+		Path:        strings.Clone(c.Path()),
+		IP:          null.StringFrom(strings.Clone(apikey.ClientIP(c.Get(fiber.HeaderXForwardedFor), c.Context().RemoteAddr().String(), r.trustedProxies))),
+		RequestHash: hex.EncodeToString(hash[:]),
+		RequestSize: len(body),
+		CreatedAt:   start.UTC(),
+	}
+
+	if len(body) <= r.bodySizeLimit {
+		if redacted, ok := Redact(body); ok {
+			entry.RequestBody = null.JSONFrom(redacted)
+		}
+	}
+
+	if requestID, ok := c.Locals(constant.RequestIDContextKey).(string); ok {
+		entry.RequestID = null.StringFrom(requestID)
+	}
+
+	if userID, ok := c.Context().Value(constant.UserIDContextKey).(int); ok {
+		entry.UserID = null.IntFrom(userID)
+	}
+
+	if role, ok := c.Context().Value(constant.RoleContextKey).(string); ok && role != "" {
+		entry.Role = null.StringFrom(strings.Clone(role))
+	}
+
+	if apiKeyID, ok := c.Context().Value(constant.APIKeyIDContextKey).(int); ok {
+		entry.APIKeyID = null.IntFrom(apiKeyID)
+	}
+
+	err := c.Next()
+
+	// route is known only after matching the handler
+	entry.Route = c.Route().Path
+	entry.Status = c.Response().StatusCode()
+	if err != nil {
+		entry.Status = fiber.StatusInternalServerError
+		if fiberErr, ok := err.(*fiber.Error); ok {
+			entry.Status = fiberErr.Code
+		}
+	}
+	entry.LatencyMS = int(time.Since(start).Milliseconds())
+
+	select {
+	case r.entries <- entry:
+	default:
+		log.Warn().Str("route", entry.Route).Msg("audit log buffer is full, entry is dropped")
+	}
+
+	return err
+}
+
+// IsMutating reports whether the route requires write or delete permission,
+// many read routes use POST method so the method alone is not enough
+func IsMutating(method, path string) bool {
+	return rbac.RoutePermission(method, path).Action != rbac.ActionRead
+}
+
+func saveEntry(ctx context.Context, entry *models.AuditLog) error {
+	return entry.Insert(ctx, bcdb.DB(), boil.Infer())
+}
@@ -0,0 +1,125 @@
+package audit
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/m6yf/bcwork/utils/constant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedact(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		body   string
+		want   string
+		wantOk bool
+	}{
+		{
+			name:   "nested",
+			body:   `{"email":"a@b.com","password":"123","data":[{"api_key":"oms_1","client_secret":"s"}],"amount":1.10}`,
+			want:   `{"amount":1.10,"data":[{"api_key":"[REDACTED]","client_secret":"[REDACTED]"}],"email":"a@b.com","password":"[REDACTED]"}`,
+			wantOk: true,
+		},
+		{
+			name:   "array",
+			body:   `[{"token":"t"},{"name":"n"}]`,
+			want:   `[{"token":"[REDACTED]"},{"name":"n"}]`,
+			wantOk: true,
+		},
+		{
+			name:   "notJSON",
+			body:   "domain,publisher\nexample.com,1",
+			wantOk: false,
+		},
+		{
+			name:   "empty",
+			body:   "",
+			wantOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := Redact([]byte(tt.body))
+			assert.Equal(t, tt.wantOk, ok)
+			if tt.wantOk {
+				assert.JSONEq(t, tt.want, string(got))
+			}
+		})
+	}
+}
+
+func TestIsMutating(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, IsMutating(fiber.MethodPost, "/floor/get"))
+	assert.True(t, IsMutating(fiber.MethodPost, "/floor"))
+	assert.True(t, IsMutating(fiber.MethodDelete, "/dpo/delete"))
+	assert.True(t, IsMutating(fiber.MethodPost, "/unknown"))
+}
+
+func TestRecorderRecord(t *testing.T) {
+	t.Parallel()
+
+	recorder := NewRecorder(10, 0, []string{"0.0.0.0"})
+	app := fiber.New()
+	app.Use(func(c *fiber.Ctx) error {
+		c.Context().SetUserValue(constant.UserIDContextKey, 7)
+		c.Context().SetUserValue(constant.RoleContextKey, "Admin")
+		c.Context().SetUserValue(constant.APIKeyIDContextKey, 3)
+		return c.Next()
+	})
+	app.Use(recorder.Record)
+	app.Post("/floor", func(c *fiber.Ctx) error { return c.SendStatus(fiber.StatusCreated) })
+	app.Post("/floor/get", func(c *fiber.Ctx) error { return c.SendStatus(fiber.StatusOK) })
+
+	req := httptest.NewRequest(fiber.MethodPost, "/floor/get", strings.NewReader(`{}`))
+	_, err := app.Test(req, -1)
+	require.NoError(t, err)
+	assert.Len(t, recorder.entries, 0)
+
+	req = httptest.NewRequest(fiber.MethodPost, "/floor", strings.NewReader(`{"floor":0.5,"token":"t"}`))
+	req.Header.Set(fiber.HeaderXForwardedFor, "10.0.0.1, 10.0.0.2")
+	_, err = app.Test(req, -1)
+	require.NoError(t, err)
+	require.Len(t, recorder.entries, 1)
+
+	entry := <-recorder.entries
+	assert.Equal(t, fiber.MethodPost, entry.Method)
+	assert.Equal(t, "/floor", entry.Route)
+	assert.Equal(t, fiber.StatusCreated, entry.Status)
+	assert.Equal(t, 7, entry.UserID.Int)
+	assert.Equal(t, "Admin", entry.Role.String)
+	assert.Equal(t, 3, entry.APIKeyID.Int)
+	assert.Equal(t, "10.0.0.2", entry.IP.String)
+	assert.Len(t, entry.RequestHash, 64)
+	assert.JSONEq(t, `{"floor":0.5,"token":"[REDACTED]"}`, string(entry.RequestBody.JSON))
+}
+
+func TestRecorderRecordBodySizeLimit(t *testing.T) {
+	t.Parallel()
+
+	recorder := NewRecorder(10, 8, nil)
+	app := fiber.New()
+	app.Use(recorder.Record)
+	app.Post("/floor", func(c *fiber.Ctx) error { return c.SendStatus(fiber.StatusOK) })
+
+	req := httptest.NewRequest(fiber.MethodPost, "/floor", strings.NewReader(`{"floor":0.5}`))
+	_, err := app.Test(req, -1)
+	require.NoError(t, err)
+	require.Len(t, recorder.entries, 1)
+
+	entry := <-recorder.entries
+	assert.False(t, entry.RequestBody.Valid)
+	assert.Equal(t, 13, entry.RequestSize)
+	assert.False(t, entry.UserID.Valid)
+}
@@ -0,0 +1,65 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+const redactedValue = "[REDACTED]"
+
+// sensitiveKeys are parts of JSON keys whose values are never stored in audit log
+var sensitiveKeys = []string{"password", "secret", "token", "authorization", "credential"}
+
+// Redact returns JSON body with values of sensitive keys replaced at any depth.
+// The second value is false when the body is not valid JSON.
+func Redact(body []byte) ([]byte, bool) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, false
+	}
+
+	redacted, err := json.Marshal(redactValue(value))
+	if err != nil {
+		return nil, false
+	}
+
+	return redacted, true
+}
+
+func redactValue(value any) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		for key, nested := range typed {
+			if isSensitiveKey(key) {
+				typed[key] = redactedValue
+				continue
+			}
+			typed[key] = redactValue(nested)
+		}
+	case []any:
+		for i, nested := range typed {
+			typed[i] = redactValue(nested)
+		}
+	}
+
+	return value
+}
+
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	if key == "key" || key == "api_key" {
+		return true
+	}
+
+	for _, sensitive := range sensitiveKeys {
+		if strings.Contains(key, sensitive) {
+			return true
+		}
+	}
+
+	return false
+}
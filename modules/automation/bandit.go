@@ -0,0 +1,144 @@
+package automation
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"sort"
+)
+
+const BanditStrategyName = "bandit"
+
+type BanditParams struct {
+	// Arms are the factors the strategy chooses from
+	Arms []float64 `json:"arms"`
+	// Exploration is the weight of the confidence bound, higher values try less played arms more often
+	Exploration float64 `json:"exploration"`
+	// MinGpp excludes arms whose average GPP is below it once they were played
+	MinGpp float64 `json:"min_gpp"`
+}
+
+// bandit chooses factor maximizing revenue per 1000 publisher impressions using UCB1 over the history of the key
+type bandit struct {
+	params BanditParams
+}
+
+type armStats struct {
+	factor  float64
+	plays   int
+	reward  float64
+	revenue float64
+	gp      float64
+}
+
+func newBandit(params json.RawMessage) (FactorStrategy, error) {
+	p := BanditParams{Arms: []float64{0.5, 0.6, 0.7, 0.8, 0.9, 1}, Exploration: 1}
+	if err := decodeParams(params, &p); err != nil {
+		return nil, err
+	}
+
+	if len(p.Arms) == 0 {
+		return nil, errors.New("arms must not be empty")
+	}
+	for _, arm := range p.Arms {
+		if arm <= 0 {
+			return nil, errors.New("arms must be positive")
+		}
+	}
+	if p.Exploration < 0 {
+		return nil, errors.New("exploration must not be negative")
+	}
+
+	arms := append([]float64(nil), p.Arms...)
+	sort.Float64s(arms)
+	p.Arms = arms
+
+	return &bandit{params: p}, nil
+}
+
+func (b *bandit) Next(input *Input) (float64, error) {
+	stats := b.stats(input)
+
+	// arms never played are tried first, starting from the closest to the current factor
+	var unplayed []*armStats
+	for _, arm := range stats {
+		if arm.plays == 0 {
+			unplayed = append(unplayed, arm)
+		}
+	}
+	if len(unplayed) > 0 {
+		sort.SliceStable(unplayed, func(i, j int) bool {
+			return math.Abs(unplayed[i].factor-input.Factor) < math.Abs(unplayed[j].factor-input.Factor)
+		})
+
+		return unplayed[0].factor, nil
+	}
+
+	maxReward, totalPlays := 0.0, 0
+	for _, arm := range stats {
+		maxReward = math.Max(maxReward, arm.reward/float64(arm.plays))
+		totalPlays += arm.plays
+	}
+
+	best, bestScore := input.Factor, math.Inf(-1)
+	for _, arm := range stats {
+		if arm.revenue > 0 && arm.gp/arm.revenue < b.params.MinGpp {
+			continue
+		}
+
+		mean := arm.reward / float64(arm.plays)
+		if maxReward > 0 {
+			mean /= maxReward
+		}
+		score := mean + b.params.Exploration*math.Sqrt(2*math.Log(float64(totalPlays))/float64(arm.plays))
+		if score > bestScore {
+			best, bestScore = arm.factor, score
+		}
+	}
+
+	return best, nil
+}
+
+// stats assigns the current window and the history of the key to the closest arms
+func (b *bandit) stats(input *Input) []*armStats {
+	stats := make([]*armStats, 0, len(b.params.Arms))
+	for _, arm := range b.params.Arms {
+		stats = append(stats, &armStats{factor: arm})
+	}
+
+	observations := append(append([]Observation(nil), input.History...), Observation{
+		Factor:               input.Factor,
+		Revenue:              input.Revenue,
+		Gp:                   input.Gp,
+		PublisherImpressions: input.PublisherImpressions,
+	})
+
+	for _, observation := range observations {
+		if observation.PublisherImpressions <= 0 {
+			continue
+		}
+
+		arm := b.closestArm(stats, observation.Factor)
+		arm.plays++
+		arm.reward += observation.Revenue / float64(observation.PublisherImpressions) * 1000
+		arm.revenue += observation.Revenue
+		arm.gp += observation.Gp
+	}
+
+	return stats
+}
+
+func (b *bandit) closestArm(stats []*armStats, factor float64) *armStats {
+	closest := stats[0]
+	for _, arm := range stats[1:] {
+		if math.Abs(arm.factor-factor) < math.Abs(closest.factor-factor) {
+			closest = arm
+		}
+	}
+
+	return closest
+}
+
+func init() {
+	Register(BanditStrategyName, newBandit)
+}
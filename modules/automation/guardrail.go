@@ -0,0 +1,114 @@
+package automation
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/m6yf/bcwork/config"
+)
+
+// ErrGuardrail is wrapped by every guardrail violation, violations trip the circuit breaker of the automation
+var ErrGuardrail = errors.New("automation guardrail violated")
+
+// Guardrails bound a single automation run, zero value of a limit disables it
+type Guardrails struct {
+	// MaxChanges is the maximal number of rules changed in a run
+	MaxChanges int
+	// MaxChangesShare is the maximal share of existing rules changed in a run
+	MaxChangesShare float64
+	// MaxDelta is the maximal absolute change of a rule value in a run
+	MaxDelta float64
+	// MinVolumeRatio and MaxVolumeRatio bound input volume of a run relative to the previous successful run
+	MinVolumeRatio float64
+	MaxVolumeRatio float64
+}
+
+// LoadGuardrails reads guardrails from worker configuration keys prefixed by "guardrail_", missing keys keep the defaults
+func LoadGuardrails(conf config.StringMap, defaults Guardrails) (Guardrails, error) {
+	errs := make([]string, 0)
+	guardrails := defaults
+
+	var err error
+	guardrails.MaxChanges, err = conf.GetIntValueWithDefault("guardrail_max_changes", defaults.MaxChanges)
+	if err != nil {
+		errs = append(errs, fmt.Sprintf("failed to get guardrail_max_changes. err: %s", err))
+	}
+
+	guardrails.MaxChangesShare, err = conf.GetFloat64ValueWithDefault("guardrail_max_changes_share", defaults.MaxChangesShare)
+	if err != nil {
+		errs = append(errs, fmt.Sprintf("failed to get guardrail_max_changes_share. err: %s", err))
+	}
+
+	guardrails.MaxDelta, err = conf.GetFloat64ValueWithDefault("guardrail_max_delta", defaults.MaxDelta)
+	if err != nil {
+		errs = append(errs, fmt.Sprintf("failed to get guardrail_max_delta. err: %s", err))
+	}
+
+	guardrails.MinVolumeRatio, err = conf.GetFloat64ValueWithDefault("guardrail_min_volume_ratio", defaults.MinVolumeRatio)
+	if err != nil {
+		errs = append(errs, fmt.Sprintf("failed to get guardrail_min_volume_ratio. err: %s", err))
+	}
+
+	guardrails.MaxVolumeRatio, err = conf.GetFloat64ValueWithDefault("guardrail_max_volume_ratio", defaults.MaxVolumeRatio)
+	if err != nil {
+		errs = append(errs, fmt.Sprintf("failed to get guardrail_max_volume_ratio. err: %s", err))
+	}
+
+	if len(errs) != 0 {
+		return guardrails, errors.New(strings.Join(errs, "\n"))
+	}
+
+	return guardrails, nil
+}
+
+// ClampDelta limits the change from old to new value by MaxDelta
+func (g Guardrails) ClampDelta(oldValue, newValue float64) float64 {
+	if g.MaxDelta <= 0 || math.Abs(newValue-oldValue) <= g.MaxDelta {
+		return newValue
+	}
+
+	if newValue > oldValue {
+		return oldValue + g.MaxDelta
+	}
+
+	return oldValue - g.MaxDelta
+}
+
+// CheckChanges checks the number of changed rules against the change budget of a run
+func (g Guardrails) CheckChanges(changed, total int) error {
+	if g.MaxChanges > 0 && changed > g.MaxChanges {
+		return fmt.Errorf("%w: %d rules changed, max is %d", ErrGuardrail, changed, g.MaxChanges)
+	}
+
+	if g.MaxChangesShare > 0 && total > 0 && float64(changed)/float64(total) > g.MaxChangesShare {
+		return fmt.Errorf("%w: %d of %d rules changed, max share is %.2f", ErrGuardrail, changed, total, g.MaxChangesShare)
+	}
+
+	return nil
+}
+
+// CheckVolume checks input of a run against the previous successful run, runs without previous volume are not checked
+func (g Guardrails) CheckVolume(records int, volume float64, previousVolume *float64) error {
+	if records == 0 {
+		return fmt.Errorf("%w: input has no records", ErrGuardrail)
+	}
+
+	if previousVolume == nil || *previousVolume <= 0 {
+		return nil
+	}
+
+	ratio := volume / *previousVolume
+	if g.MinVolumeRatio > 0 && ratio < g.MinVolumeRatio {
+		return fmt.Errorf("%w: input volume %.2f is %.2f of previous run volume %.2f, min is %.2f",
+			ErrGuardrail, volume, ratio, *previousVolume, g.MinVolumeRatio)
+	}
+
+	if g.MaxVolumeRatio > 0 && ratio > g.MaxVolumeRatio {
+		return fmt.Errorf("%w: input volume %.2f is %.2f of previous run volume %.2f, max is %.2f",
+			ErrGuardrail, volume, ratio, *previousVolume, g.MaxVolumeRatio)
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package automation
+
+import (
+	"testing"
+
+	"github.com/m6yf/bcwork/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadGuardrails(t *testing.T) {
+	t.Parallel()
+
+	defaults := Guardrails{MaxChanges: 100, MaxDelta: 1}
+
+	guardrails, err := LoadGuardrails(config.StringMap{"guardrail_max_changes": "10", "guardrail_min_volume_ratio": "0.3"}, defaults)
+	require.NoError(t, err)
+	assert.Equal(t, Guardrails{MaxChanges: 10, MaxDelta: 1, MinVolumeRatio: 0.3}, guardrails)
+
+	_, err = LoadGuardrails(config.StringMap{"guardrail_max_delta": "wrong"}, defaults)
+	assert.Error(t, err)
+}
+
+func TestGuardrails_ClampDelta(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		guardrails Guardrails
+		oldValue   float64
+		newValue   float64
+		want       float64
+	}{
+		{name: "disabled", oldValue: 1, newValue: 5, want: 5},
+		{name: "withinDelta", guardrails: Guardrails{MaxDelta: 0.5}, oldValue: 1, newValue: 1.3, want: 1.3},
+		{name: "clampIncrease", guardrails: Guardrails{MaxDelta: 0.5}, oldValue: 1, newValue: 3, want: 1.5},
+		{name: "clampDecrease", guardrails: Guardrails{MaxDelta: 25}, oldValue: 90, newValue: 0, want: 65},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.guardrails.ClampDelta(tt.oldValue, tt.newValue))
+		})
+	}
+}
+
+func TestGuardrails_CheckChanges(t *testing.T) {
+	t.Parallel()
+
+	guardrails := Guardrails{MaxChanges: 100, MaxChangesShare: 0.5}
+
+	assert.NoError(t, guardrails.CheckChanges(50, 100))
+	assert.ErrorIs(t, guardrails.CheckChanges(101, 1000), ErrGuardrail)
+	assert.ErrorIs(t, guardrails.CheckChanges(60, 100), ErrGuardrail)
+	assert.NoError(t, Guardrails{}.CheckChanges(10000, 10000))
+}
+
+func TestGuardrails_CheckVolume(t *testing.T) {
+	t.Parallel()
+
+	guardrails := Guardrails{MinVolumeRatio: 0.3, MaxVolumeRatio: 5}
+	previous := 1000.0
+
+	tests := []struct {
+		name     string
+		records  int
+		volume   float64
+		previous *float64
+		wantErr  bool
+	}{
+		{name: "noRecords", records: 0, volume: 0, previous: &previous, wantErr: true},
+		{name: "noPreviousRun", records: 10, volume: 1, previous: nil},
+		{name: "similarVolume", records: 10, volume: 800, previous: &previous},
+		{name: "volumeDropped", records: 10, volume: 100, previous: &previous, wantErr: true},
+		{name: "volumeSpiked", records: 10, volume: 6000, previous: &previous, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := guardrails.CheckVolume(tt.records, tt.volume, tt.previous)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrGuardrail)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
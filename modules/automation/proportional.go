@@ -0,0 +1,57 @@
+package automation
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+)
+
+const ProportionalStrategyName = "proportional"
+
+type ProportionalParams struct {
+	// Gain is the relative factor change per GPP point away from the target
+	Gain float64 `json:"gain"`
+	// MaxStep limits relative factor change of a single run
+	MaxStep float64 `json:"max_step"`
+	// Deadband is the distance from the target in which factor is kept
+	Deadband float64 `json:"deadband"`
+}
+
+// proportional moves factor toward GPP target proportionally to the distance from it
+type proportional struct {
+	params ProportionalParams
+}
+
+func newProportional(params json.RawMessage) (FactorStrategy, error) {
+	p := ProportionalParams{Gain: 1, MaxStep: 0.2, Deadband: 0.02}
+	if err := decodeParams(params, &p); err != nil {
+		return nil, err
+	}
+
+	if p.Gain <= 0 {
+		return nil, errors.New("gain must be positive")
+	}
+	if p.MaxStep <= 0 || p.MaxStep >= 1 {
+		return nil, errors.New("max_step must be between 0 and 1")
+	}
+	if p.Deadband < 0 {
+		return nil, errors.New("deadband must not be negative")
+	}
+
+	return &proportional{params: p}, nil
+}
+
+func (p *proportional) Next(input *Input) (float64, error) {
+	delta := input.Gpp - input.GppTarget
+	if math.Abs(delta) <= p.params.Deadband {
+		return input.Factor, nil
+	}
+
+	step := math.Max(-p.params.MaxStep, math.Min(p.params.MaxStep, p.params.Gain*delta))
+
+	return input.Factor * (1 + step), nil
+}
+
+func init() {
+	Register(ProportionalStrategyName, newProportional)
+}
@@ -0,0 +1,86 @@
+package automation
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+)
+
+const StepLadderStrategyName = "step_ladder"
+
+// Step multiplies the factor when GPP is at least MinGppDelta above the target (negative delta is below the target)
+type Step struct {
+	MinGppDelta float64 `json:"min_gpp_delta"`
+	Multiplier  float64 `json:"multiplier"`
+}
+
+type StepLadderParams struct {
+	Steps []Step `json:"steps"`
+	// FallbackMultiplier is used when GPP is below all steps
+	FallbackMultiplier float64 `json:"fallback_multiplier"`
+}
+
+// stepLadder moves factor by fixed multipliers depending on how far GPP is from the target
+type stepLadder struct {
+	params StepLadderParams
+}
+
+func defaultStepLadderParams() StepLadderParams {
+	return StepLadderParams{
+		Steps: []Step{
+			{MinGppDelta: 0.23, Multiplier: 1.3},
+			{MinGppDelta: 0.18, Multiplier: 1.25},
+			{MinGppDelta: 0.13, Multiplier: 1.2},
+			{MinGppDelta: 0.06, Multiplier: 1.1},
+			{MinGppDelta: -0.06, Multiplier: 1},
+			{MinGppDelta: -0.17, Multiplier: 0.875},
+			{MinGppDelta: -0.27, Multiplier: 0.8},
+			{MinGppDelta: -0.37, Multiplier: 0.7},
+		},
+		FallbackMultiplier: 0.5,
+	}
+}
+
+func newStepLadder(params json.RawMessage) (FactorStrategy, error) {
+	p := defaultStepLadderParams()
+	custom := StepLadderParams{}
+	if err := decodeParams(params, &custom); err != nil {
+		return nil, err
+	}
+
+	if len(custom.Steps) > 0 {
+		p.Steps = custom.Steps
+	}
+	if custom.FallbackMultiplier != 0 {
+		p.FallbackMultiplier = custom.FallbackMultiplier
+	}
+
+	for _, step := range p.Steps {
+		if step.Multiplier <= 0 {
+			return nil, errors.New("step multiplier must be positive")
+		}
+	}
+	if p.FallbackMultiplier <= 0 {
+		return nil, errors.New("fallback_multiplier must be positive")
+	}
+
+	sort.SliceStable(p.Steps, func(i, j int) bool {
+		return p.Steps[i].MinGppDelta > p.Steps[j].MinGppDelta
+	})
+
+	return &stepLadder{params: p}, nil
+}
+
+func (s *stepLadder) Next(input *Input) (float64, error) {
+	for _, step := range s.params.Steps {
+		if input.Gpp >= input.GppTarget+step.MinGppDelta {
+			return input.Factor * step.Multiplier, nil
+		}
+	}
+
+	return input.Factor * s.params.FallbackMultiplier, nil
+}
+
+func init() {
+	Register(StepLadderStrategyName, newStepLadder)
+}
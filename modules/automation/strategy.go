@@ -0,0 +1,161 @@
+package automation
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+var ErrUnknownStrategy = errors.New("unknown factor automation strategy")
+
+// DefaultStrategy is used for domains without configured strategy
+const DefaultStrategy = StepLadderStrategyName
+
+// Input is the performance of publisher, domain, country and device key in the evaluation window
+type Input struct {
+	Key                  string
+	Domain               string
+	Factor               float64
+	GppTarget            float64
+	Revenue              float64
+	Gp                   float64
+	Gpp                  float64
+	SoldImpressions      int
+	PublisherImpressions int
+	// History holds previous evaluation windows of the key, oldest first
+	History []Observation
+}
+
+// Observation is the performance of the key in a past evaluation window with the factor it was evaluated with
+type Observation struct {
+	Time                 time.Time
+	Factor               float64
+	Revenue              float64
+	Gp                   float64
+	PublisherImpressions int
+}
+
+// FactorStrategy calculates factor of the key for the next window.
+// Stop loss, inactive keys and min/max bounds are applied by the caller for all strategies.
+type FactorStrategy interface {
+	Next(input *Input) (float64, error)
+}
+
+// Factory creates strategy from its json parameters, unknown parameters are ignored
+type Factory func(params json.RawMessage) (FactorStrategy, error)
+
+var (
+	registryMu sync.RWMutex
+	registry   = make(map[string]Factory)
+)
+
+// Register makes strategy available by name, registering the same name twice panics
+func Register(name string, factory Factory) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if _, ok := registry[name]; ok {
+		panic(fmt.Sprintf("factor automation strategy [%v] is already registered", name))
+	}
+	registry[name] = factory
+}
+
+// New returns registered strategy with parameters, empty name is the default strategy
+func New(name string, params json.RawMessage) (FactorStrategy, error) {
+	if name == "" {
+		name = DefaultStrategy
+	}
+
+	registryMu.RLock()
+	factory, ok := registry[name]
+	registryMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w [%v]", ErrUnknownStrategy, name)
+	}
+
+	strategy, err := factory(params)
+	if err != nil {
+		return nil, fmt.Errorf("invalid parameters of strategy [%v]: %w", name, err)
+	}
+
+	return strategy, nil
+}
+
+// Names returns names of registered strategies
+func Names() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Validate checks that strategy exists and its parameters and bounds are valid
+func Validate(name string, params json.RawMessage) error {
+	if _, err := New(name, params); err != nil {
+		return err
+	}
+
+	_, err := ParseBounds(params)
+
+	return err
+}
+
+// Bounds are per domain limits of the factor which override the worker defaults
+type Bounds struct {
+	MinFactor *float64 `json:"min_factor"`
+	MaxFactor *float64 `json:"max_factor"`
+}
+
+// ParseBounds reads min_factor and max_factor from strategy parameters
+func ParseBounds(params json.RawMessage) (Bounds, error) {
+	var bounds Bounds
+	if err := decodeParams(params, &bounds); err != nil {
+		return Bounds{}, err
+	}
+
+	if bounds.MinFactor != nil && *bounds.MinFactor <= 0 {
+		return Bounds{}, errors.New("min_factor must be positive")
+	}
+
+	if bounds.MinFactor != nil && bounds.MaxFactor != nil && *bounds.MinFactor > *bounds.MaxFactor {
+		return Bounds{}, errors.New("min_factor must not be greater than max_factor")
+	}
+
+	return bounds, nil
+}
+
+// Apply limits factor by domain bounds or by the defaults if domain has none
+func (b Bounds) Apply(factor, defaultMin, defaultMax float64) float64 {
+	minFactor, maxFactor := defaultMin, defaultMax
+	if b.MinFactor != nil {
+		minFactor = *b.MinFactor
+	}
+	if b.MaxFactor != nil {
+		maxFactor = *b.MaxFactor
+	}
+
+	return math.Max(minFactor, math.Min(maxFactor, factor))
+}
+
+func decodeParams(params json.RawMessage, target any) error {
+	if len(bytes.TrimSpace(params)) == 0 || string(bytes.TrimSpace(params)) == "null" {
+		return nil
+	}
+
+	if err := json.Unmarshal(params, target); err != nil {
+		return fmt.Errorf("failed to parse parameters: %w", err)
+	}
+
+	return nil
+}
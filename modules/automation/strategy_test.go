@@ -0,0 +1,177 @@
+package automation
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		params  string
+		wantErr bool
+	}{
+		{name: "", params: ""},
+		{name: StepLadderStrategyName, params: `{"fallback_multiplier": 0.6}`},
+		{name: ProportionalStrategyName, params: `{"gain": 2}`},
+		{name: BanditStrategyName, params: `{"arms": [0.6, 0.8]}`},
+		{name: "unknown", wantErr: true},
+		{name: ProportionalStrategyName, params: `{"max_step": 1.5}`, wantErr: true},
+		{name: BanditStrategyName, params: `{"arms": [0, 1]}`, wantErr: true},
+		{name: StepLadderStrategyName, params: `{"steps": "wrong"}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name+tt.params, func(t *testing.T) {
+			t.Parallel()
+
+			strategy, err := New(tt.name, json.RawMessage(tt.params))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.NotNil(t, strategy)
+		})
+	}
+}
+
+func TestBounds(t *testing.T) {
+	t.Parallel()
+
+	bounds, err := ParseBounds(json.RawMessage(`{"min_factor": 0.5}`))
+	require.NoError(t, err)
+	assert.Equal(t, 0.5, bounds.Apply(0.3, 0.1, 10))
+	assert.Equal(t, 10.0, bounds.Apply(12, 0.1, 10))
+
+	bounds, err = ParseBounds(nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0.3, bounds.Apply(0.3, 0.1, 10))
+
+	_, err = ParseBounds(json.RawMessage(`{"min_factor": 0}`))
+	assert.Error(t, err)
+}
+
+func TestStepLadder(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		params string
+		gpp    float64
+		want   float64
+	}{
+		{name: "farAboveTarget", gpp: 0.6, want: 1.3},
+		{name: "slightlyAboveTarget", gpp: 0.37, want: 1.1},
+		{name: "onTarget", gpp: 0.3, want: 1},
+		{name: "belowTarget", gpp: 0.1, want: 0.8},
+		{name: "farBelowTarget", gpp: -0.2, want: 0.5},
+		{name: "customSteps", params: `{"steps": [{"min_gpp_delta": 0, "multiplier": 1.05}], "fallback_multiplier": 0.9}`, gpp: 0.2, want: 0.9},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			strategy, err := New(StepLadderStrategyName, json.RawMessage(tt.params))
+			require.NoError(t, err)
+
+			got, err := strategy.Next(&Input{Factor: 1, GppTarget: 0.3, Gpp: tt.gpp})
+			require.NoError(t, err)
+			assert.InDelta(t, tt.want, got, 1e-9)
+		})
+	}
+}
+
+func TestProportional(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		gpp  float64
+		want float64
+	}{
+		{name: "insideDeadband", gpp: 0.31, want: 1},
+		{name: "aboveTarget", gpp: 0.4, want: 1.1},
+		{name: "belowTarget", gpp: 0.25, want: 0.95},
+		{name: "stepIsLimited", gpp: -0.5, want: 0.8},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			strategy, err := New(ProportionalStrategyName, nil)
+			require.NoError(t, err)
+
+			got, err := strategy.Next(&Input{Factor: 1, GppTarget: 0.3, Gpp: tt.gpp})
+			require.NoError(t, err)
+			assert.InDelta(t, tt.want, got, 1e-9)
+		})
+	}
+}
+
+func TestBandit(t *testing.T) {
+	t.Parallel()
+
+	played := func(factor, revenue, gp float64) Observation {
+		return Observation{Factor: factor, Revenue: revenue, Gp: gp, PublisherImpressions: 1000}
+	}
+
+	tests := []struct {
+		name    string
+		params  string
+		history []Observation
+		current Observation
+		want    float64
+	}{
+		{
+			name:    "unplayedArmClosestToCurrentFactor",
+			params:  `{"arms": [0.6, 0.7, 0.8]}`,
+			current: played(0.7, 1, 0.3),
+			want:    0.6,
+		},
+		{
+			name:    "bestRevenueArm",
+			params:  `{"arms": [0.6, 0.8], "exploration": 0.1}`,
+			history: []Observation{played(0.6, 1, 0.4), played(0.8, 2, 0.4), played(0.6, 1, 0.4)},
+			current: played(0.8, 2.2, 0.5),
+			want:    0.8,
+		},
+		{
+			name:    "armBelowMinGppIsExcluded",
+			params:  `{"arms": [0.6, 0.8], "exploration": 0.1, "min_gpp": 0.3}`,
+			history: []Observation{played(0.6, 1, 0.4), played(0.8, 2, 0.2), played(0.6, 1, 0.4)},
+			current: played(0.8, 2, 0.2),
+			want:    0.6,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			strategy, err := New(BanditStrategyName, json.RawMessage(tt.params))
+			require.NoError(t, err)
+
+			got, err := strategy.Next(&Input{
+				Factor:               tt.current.Factor,
+				Revenue:              tt.current.Revenue,
+				Gp:                   tt.current.Gp,
+				PublisherImpressions: tt.current.PublisherImpressions,
+				History:              tt.history,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
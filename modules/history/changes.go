@@ -192,6 +192,12 @@ func getInternalFieldsBasedOnSubject(subject string) []string {
 		activeFieldJsonName          = "active"
 		userIDFieldJsonName          = "user_id"
 		passwordChangedFieldJsonName = "password_changed"
+		ipFieldJsonName              = "ip"
+		createdByFieldJsonName       = "created_by"
+		keyHashFieldJsonName         = "key_hash"
+		lastUsedAtFieldJsonName      = "last_used_at"
+		lastUsedIPFieldJsonName      = "last_used_ip"
+		statusChangedAtFieldJsonName = "status_changed_at"
 
 		browserFieldJsonName         = "browser"
 		countryFieldJsonName         = "country"
@@ -220,6 +226,12 @@ func getInternalFieldsBasedOnSubject(subject string) []string {
 		PixalatePublisherSubject, PixalateDomainSubject,
 		ConfiantPublisherSubject, ConfiantDomainSubject:
 		return []string{publisherIDFieldJsonName, publisherFieldJsonName, domainFieldJsonName}
+	case PriceOverrideSubject:
+		return []string{domainFieldJsonName, ipFieldJsonName, createdByFieldJsonName}
+	case APIKeySubject:
+		return []string{keyHashFieldJsonName, lastUsedAtFieldJsonName, lastUsedIPFieldJsonName, createdByFieldJsonName}
+	case PublisherSubject:
+		return []string{statusChangedAtFieldJsonName}
 	}
 
 	return []string{}
@@ -37,6 +37,15 @@ const (
 	BidCachingDomainSubject   = "Max Creatives in Cache - Domain"
 	RefreshCacheSubject       = "Max Client Refresh - Publisher"
 	RefreshCacheDomainSubject = "Max Client Refresh - Domain"
+	ApprovalPolicySubject     = "Approval Policy"
+	PriceOverrideSubject      = "Price Override"
+	AlertRuleSubject          = "Alert Rule"
+	APIKeySubject             = "API Key"
+	NotificationSubject       = "Notification Preference"
+	CompassManagerSubject     = "Compass Manager"
+	DPApprovalSubject         = "Demand Partner Approval"
+	BidderSchemaSubject       = "Bidder Schema"
+	BidderParamsSubject       = "Bidder Params"
 
 	// actions
 	createdAction = "Created"
@@ -93,14 +102,21 @@ func (h *HistoryClient) SaveAction(ctx context.Context, oldValue, newValue any,
 		userID = supertokens_module.AutomationUserID
 	}
 
+	// approver is present only when change was applied after approval
+	approverID, _ := ctx.Value(constant.ApproverIDContextKey).(int)
+	// api key is present when change was made by integration or worker authenticated with issued key
+	apiKeyID, _ := ctx.Value(constant.APIKeyIDContextKey).(int)
+
 	innerCtx := context.WithValue(context.Background(), constant.LoggerContextKey, logger.Logger(ctx))
 
-	go h.saveAction(innerCtx, userID, subject, isMultipleValues, oldValue, newValue)
+	go h.saveAction(innerCtx, userID, approverID, apiKeyID, subject, isMultipleValues, oldValue, newValue)
 }
 
 func (h *HistoryClient) saveAction(
 	ctx context.Context,
 	userID int,
+	approverID int,
+	apiKeyID int,
 	subject string,
 	isMultipleValuesExpected bool,
 	oldValue any,
@@ -194,6 +210,14 @@ func (h *HistoryClient) saveAction(
 			Date:            time.Now().UTC(),
 		}
 
+		if approverID != 0 {
+			mod.ApproverID = null.IntFrom(approverID)
+		}
+
+		if apiKeyID != 0 {
+			mod.APIKeyID = null.IntFrom(apiKeyID)
+		}
+
 		err = mod.Insert(context.Background(), bcdb.DB(), boil.Infer())
 		if err != nil {
 			logger.Logger(ctx).Error().Msgf("cannot insert history data: %v", err.Error())
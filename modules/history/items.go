@@ -65,6 +65,24 @@ func getItem(subject string, value any) (item, error) {
 		return getRefreshCacheItem(value)
 	case RefreshCacheDomainSubject:
 		return getRefreshCacheDomainItem(value)
+	case ApprovalPolicySubject:
+		return getApprovalPolicyItem(value)
+	case PriceOverrideSubject:
+		return getPriceOverrideItem(value)
+	case AlertRuleSubject:
+		return getAlertRuleItem(value)
+	case APIKeySubject:
+		return getAPIKeyItem(value)
+	case NotificationSubject:
+		return getNotificationItem(value)
+	case CompassManagerSubject:
+		return getCompassManagerItem(value)
+	case DPApprovalSubject:
+		return getDPApprovalItem(value)
+	case BidderSchemaSubject:
+		return getBidderSchemaItem(value)
+	case BidderParamsSubject:
+		return getBidderParamsItem(value)
 	default:
 		return item{}, errors.New("unknown item")
 	}
@@ -428,3 +446,126 @@ func getDimensionValue(value string) string {
 
 	return value
 }
+
+func getApprovalPolicyItem(value any) (item, error) {
+	policy, ok := value.(*models.ApprovalPolicy)
+	if !ok {
+		return item{}, errors.New("cannot cast value to approval policy")
+	}
+
+	return item{
+		key:      policy.Name + " (" + policy.Subject + ")",
+		entityID: helpers.GetPointerToString(strconv.Itoa(policy.ID)),
+	}, nil
+}
+
+func getPriceOverrideItem(value any) (item, error) {
+	override, ok := value.(*models.PriceOverride)
+	if !ok {
+		return item{}, errors.New("cannot cast value to price override")
+	}
+
+	return item{
+		key:      override.IP + " (" + override.Domain + ")",
+		domain:   helpers.GetPointerToString(override.Domain),
+		entityID: helpers.GetPointerToString(strconv.Itoa(override.ID)),
+	}, nil
+}
+
+func getAlertRuleItem(value any) (item, error) {
+	rule, ok := value.(*models.AlertRule)
+	if !ok {
+		return item{}, errors.New("cannot cast value to alert rule")
+	}
+
+	return item{
+		key:      rule.Name,
+		entityID: helpers.GetPointerToString(strconv.Itoa(rule.ID)),
+	}, nil
+}
+
+func getAPIKeyItem(value any) (item, error) {
+	key, ok := value.(*models.APIKey)
+	if !ok {
+		return item{}, errors.New("cannot cast value to api key")
+	}
+
+	return item{
+		key:      key.Name + " (" + key.KeyPrefix + ")",
+		entityID: helpers.GetPointerToString(strconv.Itoa(key.ID)),
+	}, nil
+}
+
+func getCompassManagerItem(value any) (item, error) {
+	manager, ok := value.(*models.CompassManager)
+	if !ok {
+		return item{}, errors.New("cannot cast value to compass manager")
+	}
+
+	return item{
+		key:      manager.CompassID,
+		entityID: helpers.GetPointerToString(strconv.Itoa(manager.ID)),
+	}, nil
+}
+
+func getDPApprovalItem(value any) (item, error) {
+	request, ok := value.(*models.DPApprovalRequest)
+	if !ok {
+		return item{}, errors.New("cannot cast value to demand partner approval request")
+	}
+
+	return item{
+		key:             request.DemandPartnerID + " - " + request.Domain + " (" + request.PublisherID + ")",
+		publisherID:     helpers.GetPointerToString(request.PublisherID),
+		domain:          helpers.GetPointerToString(request.Domain),
+		demandPartnerID: helpers.GetPointerToString(request.DemandPartnerID),
+		entityID:        helpers.GetPointerToString(strconv.Itoa(request.ID)),
+	}, nil
+}
+
+func getBidderSchemaItem(value any) (item, error) {
+	schema, ok := value.(*models.DPBidderSchema)
+	if !ok {
+		return item{}, errors.New("cannot cast value to bidder schema")
+	}
+
+	return item{
+		key:             schema.DemandPartnerID,
+		demandPartnerID: helpers.GetPointerToString(schema.DemandPartnerID),
+		entityID:        helpers.GetPointerToString(strconv.Itoa(schema.ID)),
+	}, nil
+}
+
+func getBidderParamsItem(value any) (item, error) {
+	params, ok := value.(*models.DPBidderParam)
+	if !ok {
+		return item{}, errors.New("cannot cast value to bidder params")
+	}
+
+	key := params.DemandPartnerID + " - " + params.PublisherID
+	var domain *string
+	if params.Domain != "" {
+		key = params.DemandPartnerID + " - " + params.Domain + " (" + params.PublisherID + ")"
+		domain = helpers.GetPointerToString(params.Domain)
+	}
+
+	return item{
+		key:             key,
+		publisherID:     helpers.GetPointerToString(params.PublisherID),
+		domain:          domain,
+		demandPartnerID: helpers.GetPointerToString(params.DemandPartnerID),
+		entityID:        helpers.GetPointerToString(strconv.Itoa(params.ID)),
+	}, nil
+}
+
+func getNotificationItem(value any) (item, error) {
+	preference, ok := value.(*models.PublisherNotificationPreference)
+	if !ok {
+		return item{}, errors.New("cannot cast value to notification preference")
+	}
+
+	return item{
+		key:         preference.PublisherID,
+		publisherID: helpers.GetPointerToString(preference.PublisherID),
+	}, nil
+}
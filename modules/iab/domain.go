@@ -0,0 +1,58 @@
+package iab
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/idna"
+	"golang.org/x/net/publicsuffix"
+)
+
+var ErrInvalidAdvertiserDomain = errors.New("invalid advertiser domain")
+
+// NormalizeAdvertiserDomain brings advertiser domain (badv) to the form used in bid responses adomain:
+// lowercase host without scheme, path, port and "www." prefix, internationalized names in punycode.
+// Domain has to be registrable, public suffixes (e.g. "co.uk") and unknown top level domains are rejected.
+func NormalizeAdvertiserDomain(value string) (string, error) {
+	host := strings.ToLower(strings.TrimSpace(value))
+	if strings.Contains(host, "://") {
+		u, err := url.Parse(host)
+		if err != nil {
+			return "", fmt.Errorf("%w [%v]: %v", ErrInvalidAdvertiserDomain, value, err)
+		}
+		host = u.Hostname()
+	} else {
+		host, _, _ = strings.Cut(host, "/")
+		if h, _, err := net.SplitHostPort(host); err == nil {
+			host = h
+		}
+	}
+	host = strings.TrimPrefix(strings.TrimSuffix(host, "."), "www.")
+
+	if host == "" {
+		return "", fmt.Errorf("%w [%v]: empty domain", ErrInvalidAdvertiserDomain, value)
+	}
+
+	if net.ParseIP(host) != nil {
+		return "", fmt.Errorf("%w [%v]: ip address is not a domain", ErrInvalidAdvertiserDomain, value)
+	}
+
+	domain, err := idna.Lookup.ToASCII(host)
+	if err != nil {
+		return "", fmt.Errorf("%w [%v]: %v", ErrInvalidAdvertiserDomain, value, err)
+	}
+
+	suffix, icann := publicsuffix.PublicSuffix(domain)
+	if !icann && !strings.Contains(suffix, ".") {
+		return "", fmt.Errorf("%w [%v]: unknown top level domain [%v]", ErrInvalidAdvertiserDomain, value, suffix)
+	}
+
+	if _, err := publicsuffix.EffectiveTLDPlusOne(domain); err != nil {
+		return "", fmt.Errorf("%w [%v]: %v", ErrInvalidAdvertiserDomain, value, err)
+	}
+
+	return domain, nil
+}
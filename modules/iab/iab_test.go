@@ -0,0 +1,204 @@
+package iab
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsKnownCategory(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		id   string
+		want bool
+	}{
+		{name: "v1Subcategory", id: "IAB7-39", want: true},
+		{name: "v1Tier1", id: "IAB26", want: true},
+		{name: "v1OutOfRange", id: "IAB26-5", want: false},
+		{name: "v2AndV3", id: "483", want: true},
+		{name: "unknown", id: "gambling", want: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, IsKnownCategory(tt.id))
+		})
+	}
+}
+
+func TestIsValidCategory(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		id   string
+		want bool
+	}{
+		{name: "v1Known", id: "IAB7-39", want: true},
+		{name: "v1OutOfRange", id: "IAB26-5", want: false},
+		{name: "v1LooksLikeExtension", id: "IAB123", want: false},
+		{name: "v2Tier1", id: "483", want: true},
+		{name: "v2LowerTierNotBundled", id: "43", want: true},
+		{name: "v3Extension", id: "8VZQHL", want: true},
+		{name: "tooLongNumber", id: "12345", want: false},
+		{name: "word", id: "gambling", want: false},
+		{name: "empty", id: "", want: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, IsValidCategory(tt.id))
+		})
+	}
+}
+
+func TestMapCategory(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		id   string
+		from TaxonomyVersion
+		to   TaxonomyVersion
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "v1ToV3ThroughV2",
+			args: args{id: "IAB17", from: ContentTaxonomyV1, to: ContentTaxonomyV3},
+			want: []string{"483"},
+		},
+		{
+			name: "v3ToV1",
+			args: args{id: "210", from: ContentTaxonomyV3, to: ContentTaxonomyV1},
+			want: []string{"IAB8"},
+		},
+		{
+			name: "sameVersion",
+			args: args{id: "1", from: ContentTaxonomyV2, to: ContentTaxonomyV2},
+			want: []string{"1"},
+		},
+		{
+			name: "noEquivalent",
+			args: args{id: "IAB26-2", from: ContentTaxonomyV1, to: ContentTaxonomyV2},
+			want: []string{},
+		},
+		{
+			name:    "categoryNotInVersion",
+			args:    args{id: "IAB1", from: ContentTaxonomyV2, to: ContentTaxonomyV3},
+			wantErr: true,
+		},
+		{
+			name:    "unknownVersion",
+			args:    args{id: "1", from: "4.0", to: ContentTaxonomyV3},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := MapCategory(tt.args.id, tt.args.from, tt.args.to)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNormalizeAdvertiserDomain(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{name: "alreadyNormalized", value: "ford.com", want: "ford.com"},
+		{name: "uppercaseAndSpaces", value: "  Ford.COM ", want: "ford.com"},
+		{name: "urlWithPath", value: "https://www.ford.com/cars?id=1", want: "ford.com"},
+		{name: "portAndTrailingDot", value: "shop.example.co.uk.:8080", want: "shop.example.co.uk"},
+		{name: "punycode", value: "bücher.de", want: "xn--bcher-kva.de"},
+		{name: "publicSuffix", value: "co.uk", wantErr: true},
+		{name: "unknownTLD", value: "example.notatld", wantErr: true},
+		{name: "ipAddress", value: "10.0.0.1", wantErr: true},
+		{name: "empty", value: " ", wantErr: true},
+		{name: "invalidCharacters", value: "exa mple.com", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NormalizeAdvertiserDomain(tt.value)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidAdvertiserDomain)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseTSV(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		data    string
+		want    [][]string
+		wantErr bool
+	}{
+		{
+			name: "headerOnFirstLine",
+			data: "Unique ID\tParent\tName\n1\t\tAutomotive\n2\t1\tAuto Body Styles\n",
+			want: [][]string{{"1", "", "Automotive"}, {"2", "1", "Auto Body Styles"}},
+		},
+		{
+			name: "titleLinesAboveHeader",
+			data: "\t\tRelational ID System\n\n Unique ID \tParent\tName\tTier 1\n1\t\tAutomotive\tAutomotive\n\t\t\t\n",
+			want: [][]string{{"1", "", "Automotive"}},
+		},
+		{
+			name:    "columnsNotFound",
+			data:    "ID\tName\n1\tAutomotive\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseTSV(strings.NewReader(tt.data), "test.tsv", "Unique ID", "Parent", "Name")
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,287 @@
+package iab
+
+import (
+	"embed"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+type TaxonomyVersion string
+
+const (
+	// ContentTaxonomyV1 is the legacy list from OpenRTB 2.5 (IAB1-1 style ids), kept for lists created before v2
+	ContentTaxonomyV1 TaxonomyVersion = "1.0"
+	ContentTaxonomyV2 TaxonomyVersion = "2.2"
+	ContentTaxonomyV3 TaxonomyVersion = "3.0"
+)
+
+// versions ordered from oldest to newest, mapping files exist between neighbours
+var versions = []TaxonomyVersion{ContentTaxonomyV1, ContentTaxonomyV2, ContentTaxonomyV3}
+
+// Bundled files use layout of IAB Tech Lab tsv exports (Unique ID, Parent, Name, extra columns and lines
+// above the header are ignored), so they can be refreshed by replacing files in data directory.
+// Files of versions 2.2 and 3.0 hold tier 1 categories only and mappings contain tier 1 equivalents,
+// so ids of lower tiers are accepted by IsValidCategory by their format until the official exports are placed there.
+//
+//go:embed data/*.tsv
+var dataFS embed.FS
+
+type Category struct {
+	ID       string          `json:"id"`
+	ParentID string          `json:"parent_id"`
+	Name     string          `json:"name"`
+	Version  TaxonomyVersion `json:"version"`
+}
+
+type taxonomy struct {
+	categories []*Category
+	byID       map[string]*Category
+}
+
+type mappingKey struct {
+	from TaxonomyVersion
+	to   TaxonomyVersion
+}
+
+var (
+	taxonomies = make(map[TaxonomyVersion]*taxonomy)
+	mappings   = make(map[mappingKey]map[string][]string)
+)
+
+func init() {
+	for i, version := range versions {
+		t, err := loadTaxonomy(version)
+		if err != nil {
+			panic(err)
+		}
+		taxonomies[version] = t
+
+		if i == 0 {
+			continue
+		}
+
+		err = loadMapping(versions[i-1], version)
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
+func Versions() []TaxonomyVersion {
+	return slices.Clone(versions)
+}
+
+// GetCategories returns all categories of provided taxonomy version
+func GetCategories(version TaxonomyVersion) ([]Category, error) {
+	t, ok := taxonomies[version]
+	if !ok {
+		return nil, fmt.Errorf("unknown taxonomy version [%v]", version)
+	}
+
+	categories := make([]Category, 0, len(t.categories))
+	for _, category := range t.categories {
+		categories = append(categories, *category)
+	}
+
+	return categories, nil
+}
+
+// GetCategoryVersions returns taxonomy versions which contain category id
+func GetCategoryVersions(id string) []TaxonomyVersion {
+	found := make([]TaxonomyVersion, 0, len(versions))
+	for _, version := range versions {
+		if _, ok := taxonomies[version].byID[id]; ok {
+			found = append(found, version)
+		}
+	}
+
+	return found
+}
+
+// IsKnownCategory checks that category id exists in any of bundled taxonomy versions
+func IsKnownCategory(id string) bool {
+	return len(GetCategoryVersions(id)) > 0
+}
+
+// categoryIDPattern matches ids of versions 2.2 and 3.0: numeric ids of tiers and 6 characters ids of extensions
+var categoryIDPattern = regexp.MustCompile(`^([0-9]{1,4}|[0-9A-Za-z]{6})$`)
+
+// IsValidCategory checks that category id could be used as bcat. Legacy ids are checked against the full 1.0 list,
+// ids of versions 2.2 and 3.0 missing from bundled files are accepted if they have the format of these versions.
+func IsValidCategory(id string) bool {
+	if IsKnownCategory(id) {
+		return true
+	}
+
+	if strings.HasPrefix(strings.ToUpper(id), "IAB") {
+		return false
+	}
+
+	return categoryIDPattern.MatchString(id)
+}
+
+// MapCategory translates category id between taxonomy versions going through intermediate versions.
+// Returns empty slice if there is no equivalent category.
+func MapCategory(id string, from, to TaxonomyVersion) ([]string, error) {
+	fromIdx := slices.Index(versions, from)
+	toIdx := slices.Index(versions, to)
+	if fromIdx == -1 || toIdx == -1 {
+		return nil, fmt.Errorf("unknown taxonomy versions [%v] -> [%v]", from, to)
+	}
+
+	if _, ok := taxonomies[from].byID[id]; !ok {
+		return nil, fmt.Errorf("category [%v] not found in taxonomy version [%v]", id, from)
+	}
+
+	step := 1
+	if toIdx < fromIdx {
+		step = -1
+	}
+
+	ids := []string{id}
+	for i := fromIdx; i != toIdx; i += step {
+		mapping := mappings[mappingKey{from: versions[i], to: versions[i+step]}]
+		next := make([]string, 0, len(ids))
+		for _, id := range ids {
+			for _, mapped := range mapping[id] {
+				if !slices.Contains(next, mapped) {
+					next = append(next, mapped)
+				}
+			}
+		}
+		ids = next
+	}
+
+	return ids, nil
+}
+
+func loadTaxonomy(version TaxonomyVersion) (*taxonomy, error) {
+	rows, err := readTSV(fmt.Sprintf("data/content_taxonomy_%v.tsv", version), "Unique ID", "Parent", "Name")
+	if err != nil {
+		return nil, err
+	}
+
+	t := &taxonomy{
+		categories: make([]*Category, 0, len(rows)),
+		byID:       make(map[string]*Category, len(rows)),
+	}
+	for _, row := range rows {
+		category := &Category{ID: row[0], ParentID: row[1], Name: row[2], Version: version}
+		if _, ok := t.byID[category.ID]; ok {
+			return nil, fmt.Errorf("duplicate category [%v] in taxonomy version [%v]", category.ID, version)
+		}
+		t.categories = append(t.categories, category)
+		t.byID[category.ID] = category
+	}
+
+	for _, category := range t.categories {
+		if _, ok := t.byID[category.ParentID]; category.ParentID != "" && !ok {
+			return nil, fmt.Errorf("unknown parent [%v] of category [%v] in taxonomy version [%v]", category.ParentID, category.ID, version)
+		}
+	}
+
+	return t, nil
+}
+
+func loadMapping(from, to TaxonomyVersion) error {
+	rows, err := readTSV(fmt.Sprintf("data/mapping_%v_%v.tsv", from, to), "From", "To")
+	if err != nil {
+		return err
+	}
+
+	forward := make(map[string][]string)
+	backward := make(map[string][]string)
+	for _, row := range rows {
+		if _, ok := taxonomies[from].byID[row[0]]; !ok {
+			return fmt.Errorf("mapping [%v] -> [%v]: category [%v] not found in [%v]", from, to, row[0], from)
+		}
+		if _, ok := taxonomies[to].byID[row[1]]; !ok {
+			return fmt.Errorf("mapping [%v] -> [%v]: category [%v] not found in [%v]", from, to, row[1], to)
+		}
+		forward[row[0]] = append(forward[row[0]], row[1])
+		backward[row[1]] = append(backward[row[1]], row[0])
+	}
+
+	mappings[mappingKey{from: from, to: to}] = forward
+	mappings[mappingKey{from: to, to: from}] = backward
+
+	return nil
+}
+
+// readTSV returns values of requested columns for every row
+func readTSV(name string, columns ...string) ([][]string, error) {
+	file, err := dataFS.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %v: %w", name, err)
+	}
+	defer file.Close()
+
+	return parseTSV(file, name, columns...)
+}
+
+// parseTSV looks for the first line which contains all requested columns and reads rows below it,
+// as IAB Tech Lab exports may start with title lines
+func parseTSV(r io.Reader, name string, columns ...string) ([][]string, error) {
+	reader := csv.NewReader(r)
+	reader.Comma = '\t'
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	var indexes []int
+	for indexes == nil {
+		header, err := reader.Read()
+		if err == io.EOF {
+			return nil, fmt.Errorf("columns %v not found in %v", columns, name)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read header of %v: %w", name, err)
+		}
+
+		indexes = getColumnIndexes(header, columns)
+	}
+
+	rows := make([][]string, 0)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %v: %w", name, err)
+		}
+
+		row := make([]string, 0, len(indexes))
+		for _, idx := range indexes {
+			var value string
+			if idx < len(record) {
+				value = strings.TrimSpace(record[idx])
+			}
+			row = append(row, value)
+		}
+
+		if row[0] == "" {
+			continue
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// getColumnIndexes returns indexes of columns in header or nil if any of them is missing
+func getColumnIndexes(header []string, columns []string) []int {
+	indexes := make([]int, 0, len(columns))
+	for _, column := range columns {
+		idx := slices.IndexFunc(header, func(h string) bool { return strings.EqualFold(strings.TrimSpace(h), column) })
+		if idx == -1 {
+			return nil
+		}
+		indexes = append(indexes, idx)
+	}
+
+	return indexes
+}
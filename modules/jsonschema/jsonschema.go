@@ -0,0 +1,256 @@
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/rotisserie/eris"
+)
+
+const (
+	TypeObject  = "object"
+	TypeArray   = "array"
+	TypeString  = "string"
+	TypeInteger = "integer"
+	TypeNumber  = "number"
+	TypeBoolean = "boolean"
+)
+
+var types = []string{TypeObject, TypeArray, TypeString, TypeInteger, TypeNumber, TypeBoolean}
+
+// Schema is a subset of JSON Schema used to describe bidder parameters:
+// type, properties, required, additionalProperties, enum, items and basic numeric, string and array bounds.
+// $schema, title and description are kept as annotations, any other keyword is rejected by Parse.
+type Schema struct {
+	SchemaURI            string             `json:"$schema,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Type                 string             `json:"type"`
+	Description          string             `json:"description,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *bool              `json:"additionalProperties,omitempty"`
+	Enum                 []any              `json:"enum,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+
+	pattern *regexp.Regexp
+}
+
+// unknownFieldErrorPrefix starts error of encoding/json about field missing in Schema
+const unknownFieldErrorPrefix = "json: unknown field "
+
+// Parse parses and compiles schema, unsupported keywords, types and invalid patterns are rejected
+// instead of being ignored, as ignored keyword would silently accept values it's meant to reject
+func Parse(data []byte) (*Schema, error) {
+	schema := &Schema{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	err := decoder.Decode(schema)
+	if err != nil {
+		if keyword, ok := strings.CutPrefix(err.Error(), unknownFieldErrorPrefix); ok {
+			return nil, fmt.Errorf("unsupported keyword [%v]", strings.Trim(keyword, `"`))
+		}
+
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			return nil, fmt.Errorf("unsupported %v value of keyword [%v]", typeErr.Value, typeErr.Field)
+		}
+
+		return nil, eris.Wrap(err, "failed to parse schema")
+	}
+
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, errors.New("failed to parse schema: unexpected data after schema")
+	}
+
+	err = schema.compile("")
+	if err != nil {
+		return nil, err
+	}
+
+	return schema, nil
+}
+
+func (s *Schema) compile(path string) error {
+	if !slices.Contains(types, s.Type) {
+		return fmt.Errorf("%v: unsupported type [%v]", pathName(path), s.Type)
+	}
+
+	if s.Pattern != "" {
+		pattern, err := regexp.Compile(s.Pattern)
+		if err != nil {
+			return fmt.Errorf("%v: invalid pattern: %w", pathName(path), err)
+		}
+		s.pattern = pattern
+	}
+
+	for _, name := range s.Required {
+		if _, ok := s.Properties[name]; !ok {
+			return fmt.Errorf("%v: required property [%v] is not defined", pathName(path), name)
+		}
+	}
+
+	for name, property := range s.Properties {
+		if property == nil {
+			return fmt.Errorf("%v: property is empty", pathName(joinPath(path, name)))
+		}
+
+		err := property.compile(joinPath(path, name))
+		if err != nil {
+			return err
+		}
+	}
+
+	if s.Items != nil {
+		err := s.Items.compile(path + "[]")
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Validate returns errors of value against schema, value is expected as decoded by encoding/json
+func (s *Schema) Validate(value any) []string {
+	return s.validate("", value, true)
+}
+
+// ValidatePartial validates value like Validate but doesn't require top level required properties,
+// it's used for values which are completed by other values later
+func (s *Schema) ValidatePartial(value any) []string {
+	return s.validate("", value, false)
+}
+
+func (s *Schema) validate(path string, value any, isRequiredChecked bool) []string {
+	errs := make([]string, 0)
+	if !isType(value, s.Type) {
+		return append(errs, fmt.Sprintf("%v: must be %v", pathName(path), s.Type))
+	}
+
+	if len(s.Enum) > 0 && !slices.ContainsFunc(s.Enum, func(option any) bool { return isEqual(option, value) }) {
+		errs = append(errs, fmt.Sprintf("%v: must be one of %v", pathName(path), s.Enum))
+	}
+
+	switch typed := value.(type) {
+	case map[string]any:
+		if isRequiredChecked {
+			for _, name := range s.Required {
+				if _, ok := typed[name]; !ok {
+					errs = append(errs, fmt.Sprintf("%v: is required", pathName(joinPath(path, name))))
+				}
+			}
+		}
+
+		names := make([]string, 0, len(typed))
+		for name := range typed {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			property, ok := s.Properties[name]
+			if !ok {
+				if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+					errs = append(errs, fmt.Sprintf("%v: is not allowed", pathName(joinPath(path, name))))
+				}
+				continue
+			}
+			errs = append(errs, property.validate(joinPath(path, name), typed[name], true)...)
+		}
+	case []any:
+		if s.MinItems != nil && len(typed) < *s.MinItems {
+			errs = append(errs, fmt.Sprintf("%v: must have at least %v items", pathName(path), *s.MinItems))
+		}
+		if s.MaxItems != nil && len(typed) > *s.MaxItems {
+			errs = append(errs, fmt.Sprintf("%v: must have at most %v items", pathName(path), *s.MaxItems))
+		}
+		if s.Items != nil {
+			for i, item := range typed {
+				errs = append(errs, s.Items.validate(fmt.Sprintf("%v[%v]", path, i), item, true)...)
+			}
+		}
+	case string:
+		length := len([]rune(typed))
+		if s.MinLength != nil && length < *s.MinLength {
+			errs = append(errs, fmt.Sprintf("%v: must be at least %v characters", pathName(path), *s.MinLength))
+		}
+		if s.MaxLength != nil && length > *s.MaxLength {
+			errs = append(errs, fmt.Sprintf("%v: must be at most %v characters", pathName(path), *s.MaxLength))
+		}
+		if s.pattern != nil && !s.pattern.MatchString(typed) {
+			errs = append(errs, fmt.Sprintf("%v: must match pattern %v", pathName(path), s.Pattern))
+		}
+	case float64:
+		if s.Minimum != nil && typed < *s.Minimum {
+			errs = append(errs, fmt.Sprintf("%v: must be greater than or equal to %v", pathName(path), *s.Minimum))
+		}
+		if s.Maximum != nil && typed > *s.Maximum {
+			errs = append(errs, fmt.Sprintf("%v: must be less than or equal to %v", pathName(path), *s.Maximum))
+		}
+	}
+
+	return errs
+}
+
+func isType(value any, schemaType string) bool {
+	switch schemaType {
+	case TypeObject:
+		_, ok := value.(map[string]any)
+		return ok
+	case TypeArray:
+		_, ok := value.([]any)
+		return ok
+	case TypeString:
+		_, ok := value.(string)
+		return ok
+	case TypeInteger:
+		number, ok := value.(float64)
+		return ok && number == math.Trunc(number)
+	case TypeNumber:
+		_, ok := value.(float64)
+		return ok
+	case TypeBoolean:
+		_, ok := value.(bool)
+		return ok
+	}
+
+	return false
+}
+
+func isEqual(a, b any) bool {
+	aData, errA := json.Marshal(a)
+	bData, errB := json.Marshal(b)
+
+	return errA == nil && errB == nil && string(aData) == string(bData)
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
+
+func pathName(path string) string {
+	if path == "" {
+		return "value"
+	}
+
+	return path
+}
@@ -0,0 +1,171 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testSchema = `{
+	"type": "object",
+	"properties": {
+		"placementId": {"type": "integer", "minimum": 1},
+		"siteId": {"type": "string", "pattern": "^[0-9a-f]+$", "maxLength": 8},
+		"sizes": {"type": "array", "minItems": 1, "items": {"type": "string", "enum": ["300x250", "728x90"]}},
+		"test": {"type": "boolean"}
+	},
+	"required": ["placementId", "siteId"],
+	"additionalProperties": false
+}`
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		schema  string
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			schema: testSchema,
+		},
+		{
+			name:    "invalidJSON",
+			schema:  `{"type":`,
+			wantErr: "failed to parse schema",
+		},
+		{
+			name:    "unsupportedType",
+			schema:  `{"type": "object", "properties": {"id": {"type": "uuid"}}}`,
+			wantErr: "id: unsupported type [uuid]",
+		},
+		{
+			name:    "invalidPattern",
+			schema:  `{"type": "string", "pattern": "("}`,
+			wantErr: "value: invalid pattern",
+		},
+		{
+			name:   "annotations",
+			schema: `{"$schema": "http://json-schema.org/draft-04/schema#", "title": "bidder", "description": "params", "type": "object"}`,
+		},
+		{
+			name:    "unsupportedKeyword",
+			schema:  `{"type": "object", "properties": {"id": {"type": "string", "format": "uuid"}}}`,
+			wantErr: "unsupported keyword [format]",
+		},
+		{
+			name:    "unsupportedCombinator",
+			schema:  `{"type": "object", "oneOf": [{"required": ["id"]}]}`,
+			wantErr: "unsupported keyword [oneOf]",
+		},
+		{
+			name:    "unsupportedRef",
+			schema:  `{"type": "object", "properties": {"id": {"$ref": "#/definitions/id"}}}`,
+			wantErr: "unsupported keyword [$ref]",
+		},
+		{
+			name:    "arrayOfTypes",
+			schema:  `{"type": "object", "properties": {"id": {"type": ["string", "null"]}}}`,
+			wantErr: "unsupported array value of keyword [properties.id.type]",
+		},
+		{
+			name:    "trailingData",
+			schema:  `{"type": "object"} {}`,
+			wantErr: "unexpected data after schema",
+		},
+		{
+			name:    "undefinedRequired",
+			schema:  `{"type": "object", "required": ["id"]}`,
+			wantErr: "value: required property [id] is not defined",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Parse([]byte(tt.schema))
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestSchema_Validate(t *testing.T) {
+	t.Parallel()
+
+	schema, err := Parse([]byte(testSchema))
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		value     string
+		isPartial bool
+		want      []string
+	}{
+		{
+			name:  "valid",
+			value: `{"placementId": 10, "siteId": "ab12", "sizes": ["300x250"], "test": true}`,
+			want:  []string{},
+		},
+		{
+			name:  "missingRequired",
+			value: `{"placementId": 10}`,
+			want:  []string{"siteId: is required"},
+		},
+		{
+			name:      "partialWithoutRequired",
+			value:     `{"placementId": 10}`,
+			isPartial: true,
+			want:      []string{},
+		},
+		{
+			name:  "notObject",
+			value: `[]`,
+			want:  []string{"value: must be object"},
+		},
+		{
+			name:  "invalidValues",
+			value: `{"placementId": 0.5, "siteId": "XYZ123456", "sizes": ["1x1"], "test": "yes", "other": 1}`,
+			want: []string{
+				"other: is not allowed",
+				"placementId: must be integer",
+				"siteId: must be at most 8 characters",
+				"siteId: must match pattern ^[0-9a-f]+$",
+				"sizes[0]: must be one of [300x250 728x90]",
+				"test: must be boolean",
+			},
+		},
+		{
+			name:  "bounds",
+			value: `{"placementId": 0, "siteId": "ab", "sizes": []}`,
+			want: []string{
+				"placementId: must be greater than or equal to 1",
+				"sizes: must have at least 1 items",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var value any
+			err := json.Unmarshal([]byte(tt.value), &value)
+			assert.NoError(t, err)
+
+			got := schema.Validate(value)
+			if tt.isPartial {
+				got = schema.ValidatePartial(value)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
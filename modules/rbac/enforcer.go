@@ -0,0 +1,143 @@
+package rbac
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/models"
+	supertokens_module "github.com/m6yf/bcwork/modules/supertokens"
+	"github.com/m6yf/bcwork/utils"
+	"github.com/m6yf/bcwork/utils/constant"
+	"github.com/rotisserie/eris"
+)
+
+const defaultPolicyTTL = time.Minute
+
+// ScopedRoles are roles which see and edit only publishers assigned to the user
+var ScopedRoles = []string{
+	supertokens_module.PublisherRoleName,
+	supertokens_module.ConsultantRoleName,
+}
+
+type (
+	policyLoader    func(ctx context.Context) (Policy, error)
+	publisherLoader func(ctx context.Context, userID int) ([]string, error)
+)
+
+// Enforcer authorizes requests by permissions of user role and scopes publishers of scoped roles
+type Enforcer struct {
+	ttl            time.Duration
+	loadPolicy     policyLoader
+	loadPublishers publisherLoader
+
+	mu       sync.Mutex
+	policy   Policy
+	loadedAt time.Time
+}
+
+// NewEnforcer returns enforcer reading role permissions and user publishers from the DB.
+// Role permissions are cached for ttl.
+func NewEnforcer(ttl time.Duration) *Enforcer {
+	if ttl <= 0 {
+		ttl = defaultPolicyTTL
+	}
+
+	return &Enforcer{
+		ttl:            ttl,
+		loadPolicy:     loadPolicy,
+		loadPublishers: loadUserPublishers,
+	}
+}
+
+// Authorize is a middleware checking that user role is granted the permission required by the route.
+// For scoped roles it also puts ids of user publishers into request context.
+func (e *Enforcer) Authorize(c *fiber.Ctx) error {
+	role, _ := c.Context().Value(constant.RoleContextKey).(string)
+	required := RoutePermission(c.Method(), c.Path())
+
+	policy, err := e.getPolicy(c.Context())
+	if err != nil {
+		return utils.ErrorResponse(c, fiber.StatusInternalServerError, "failed to get role permissions", err)
+	}
+
+	if !policy.Allows(role, required) {
+		return utils.ErrorResponse(
+			c,
+			fiber.StatusForbidden,
+			"permission required",
+			fmt.Errorf("role [%v] doesn't have [%v] permission", role, required),
+		)
+	}
+
+	// api key can't exceed permissions of its owner role and is limited by its scopes
+	if scopes, ok := c.Context().Value(constant.APIKeyScopesContextKey).([]string); ok && !ScopesAllow(scopes, required) {
+		return utils.ErrorResponse(
+			c,
+			fiber.StatusForbidden,
+			"permission required",
+			fmt.Errorf("api key scopes don't include [%v] permission", required),
+		)
+	}
+
+	if slices.Contains(ScopedRoles, role) {
+		userID, _ := c.Context().Value(constant.UserIDContextKey).(int)
+		publisherIDs, err := e.loadPublishers(c.Context(), userID)
+		if err != nil {
+			return utils.ErrorResponse(c, fiber.StatusInternalServerError, "failed to get user publishers", err)
+		}
+		c.Context().SetUserValue(constant.PublisherScopeContextKey, publisherIDs)
+	}
+
+	return c.Next()
+}
+
+func (e *Enforcer) getPolicy(ctx context.Context) (Policy, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.policy != nil && time.Since(e.loadedAt) < e.ttl {
+		return e.policy, nil
+	}
+
+	policy, err := e.loadPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	e.policy = policy
+	e.loadedAt = time.Now()
+
+	return policy, nil
+}
+
+func loadPolicy(ctx context.Context) (Policy, error) {
+	mods, err := models.RolePermissions().All(ctx, bcdb.DB())
+	if err != nil {
+		return nil, eris.Wrap(err, "failed to retrieve role permissions")
+	}
+
+	policy := make(Policy)
+	for _, mod := range mods {
+		policy[mod.Role] = append(policy[mod.Role], Permission{Resource: mod.Resource, Action: mod.Action})
+	}
+
+	return policy, nil
+}
+
+func loadUserPublishers(ctx context.Context, userID int) ([]string, error) {
+	mods, err := models.UserPublishers(models.UserPublisherWhere.UserID.EQ(userID)).All(ctx, bcdb.DB())
+	if err != nil {
+		return nil, eris.Wrapf(err, "failed to retrieve publishers of user [%v]", userID)
+	}
+
+	publisherIDs := make([]string, 0, len(mods))
+	for _, mod := range mods {
+		publisherIDs = append(publisherIDs, mod.PublisherID)
+	}
+
+	return publisherIDs, nil
+}
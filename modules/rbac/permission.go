@@ -0,0 +1,67 @@
+package rbac
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	ActionRead   = "read"
+	ActionWrite  = "write"
+	ActionDelete = "delete"
+
+	// Wildcard matches any resource or any action
+	Wildcard = "*"
+)
+
+// Permission is an action allowed on a resource, e.g. floor:write
+type Permission struct {
+	Resource string
+	Action   string
+}
+
+func (p Permission) String() string {
+	return p.Resource + ":" + p.Action
+}
+
+// ParsePermission parses permission in resource:action format
+func ParsePermission(value string) (Permission, error) {
+	resource, action, ok := strings.Cut(strings.TrimSpace(value), ":")
+	if !ok || resource == "" || action == "" {
+		return Permission{}, fmt.Errorf("invalid permission [%v], expected resource:action", value)
+	}
+
+	return Permission{Resource: resource, Action: action}, nil
+}
+
+// matches reports whether the granted permission covers the required one
+func (p Permission) matches(required Permission) bool {
+	return (p.Resource == Wildcard || p.Resource == required.Resource) &&
+		(p.Action == Wildcard || p.Action == required.Action)
+}
+
+// ScopesAllow reports whether one of scopes in resource:action format covers the permission
+func ScopesAllow(scopes []string, required Permission) bool {
+	for _, scope := range scopes {
+		granted, err := ParsePermission(scope)
+		if err == nil && granted.matches(required) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Policy is a set of permissions granted to each role
+type Policy map[string][]Permission
+
+// Allows reports whether the role is granted the permission
+func (p Policy) Allows(role string, required Permission) bool {
+	for _, granted := range p[role] {
+		if granted.matches(required) {
+			return true
+		}
+	}
+
+	return false
+}
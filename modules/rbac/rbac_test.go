@@ -0,0 +1,392 @@
+package rbac
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	supertokens_module "github.com/m6yf/bcwork/modules/supertokens"
+	"github.com/m6yf/bcwork/utils/constant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testPolicy mirrors role permissions seeded by the migration
+var testPolicy = Policy{
+	supertokens_module.DeveloperRoleName:   {{Wildcard, Wildcard}},
+	supertokens_module.AdminRoleName:       {{Wildcard, Wildcard}},
+	supertokens_module.SupermemberRoleName: {{Wildcard, ActionRead}, {Wildcard, ActionWrite}, {Wildcard, ActionDelete}},
+	supertokens_module.MemberRoleName:      {{Wildcard, ActionRead}, {Wildcard, ActionWrite}},
+	supertokens_module.ConsultantRoleName: {
+		{PublisherResource, ActionRead},
+		{PublisherResource, ActionWrite},
+		{DomainResource, ActionRead},
+		{DomainResource, ActionWrite},
+	},
+	supertokens_module.PublisherRoleName: {
+		{PublisherResource, ActionRead},
+		{DomainResource, ActionRead},
+		{PortalResource, ActionRead},
+		{PortalResource, ActionWrite},
+	},
+}
+
+var testRoles = []string{
+	supertokens_module.DeveloperRoleName,
+	supertokens_module.AdminRoleName,
+	supertokens_module.SupermemberRoleName,
+	supertokens_module.MemberRoleName,
+	supertokens_module.ConsultantRoleName,
+	supertokens_module.PublisherRoleName,
+}
+
+func newTestEnforcer(policy Policy, publisherIDs []string) *Enforcer {
+	return &Enforcer{
+		ttl:        time.Minute,
+		loadPolicy: func(ctx context.Context) (Policy, error) { return policy, nil },
+		loadPublishers: func(ctx context.Context, userID int) ([]string, error) {
+			return publisherIDs, nil
+		},
+	}
+}
+
+// newTestApp registers the route behind the enforcer, user role and api key scopes are taken from request headers
+func newTestApp(enforcer *Enforcer, method, path string) *fiber.App {
+	app := fiber.New()
+	app.Use(func(c *fiber.Ctx) error {
+		c.Context().SetUserValue(constant.UserIDContextKey, 1)
+		c.Context().SetUserValue(constant.RoleContextKey, c.Get("role"))
+		if scopes := c.Get("scopes"); scopes != "" {
+			c.Context().SetUserValue(constant.APIKeyScopesContextKey, strings.Split(scopes, ","))
+		}
+		return c.Next()
+	})
+	app.Use(enforcer.Authorize)
+	app.Add(method, path, func(c *fiber.Ctx) error {
+		publisherIDs, ok := PublisherScope(c.Context())
+		if !ok {
+			return c.SendStatus(fiber.StatusOK)
+		}
+		return c.JSON(publisherIDs)
+	})
+
+	return app
+}
+
+func splitRoute(route string) (string, string) {
+	method, path, _ := strings.Cut(route, " ")
+	return method, path
+}
+
+func TestParsePermission(t *testing.T) {
+	t.Parallel()
+
+	permission, err := ParsePermission("floor:write")
+	require.NoError(t, err)
+	assert.Equal(t, Permission{Resource: FloorResource, Action: ActionWrite}, permission)
+	assert.Equal(t, "floor:write", permission.String())
+
+	for _, value := range []string{"", "floor", ":write", "floor:"} {
+		_, err := ParsePermission(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestPolicyAllows(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		role     string
+		required Permission
+		want     bool
+	}{
+		{name: "adminAnything", role: supertokens_module.AdminRoleName, required: Permission{DPOResource, ActionDelete}, want: true},
+		{name: "adminFullAccess", role: supertokens_module.AdminRoleName, required: fullAccess, want: true},
+		{name: "supermemberDelete", role: supertokens_module.SupermemberRoleName, required: Permission{DPOResource, ActionDelete}, want: true},
+		{name: "supermemberFullAccess", role: supertokens_module.SupermemberRoleName, required: fullAccess, want: false},
+		{name: "memberWrite", role: supertokens_module.MemberRoleName, required: Permission{FloorResource, ActionWrite}, want: true},
+		{name: "memberDelete", role: supertokens_module.MemberRoleName, required: Permission{FloorResource, ActionDelete}, want: false},
+		{name: "publisherRead", role: supertokens_module.PublisherRoleName, required: Permission{PublisherResource, ActionRead}, want: true},
+		{name: "publisherWrite", role: supertokens_module.PublisherRoleName, required: Permission{PublisherResource, ActionWrite}, want: false},
+		{name: "publisherReport", role: supertokens_module.PublisherRoleName, required: Permission{ReportResource, ActionRead}, want: false},
+		{name: "consultantWrite", role: supertokens_module.ConsultantRoleName, required: Permission{DomainResource, ActionWrite}, want: true},
+		{name: "unknownRole", role: "Guest", required: Permission{PublisherResource, ActionRead}, want: false},
+		{name: "emptyRole", role: "", required: Permission{PublisherResource, ActionRead}, want: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, testPolicy.Allows(tt.role, tt.required))
+		})
+	}
+}
+
+func TestRoutePermission(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		want   Permission
+	}{
+		{name: "exact", method: fiber.MethodPost, path: "/floor/get", want: Permission{FloorResource, ActionRead}},
+		{name: "trailingSlash", method: fiber.MethodPost, path: "/floor/get/", want: Permission{FloorResource, ActionRead}},
+		{name: "caseInsensitive", method: fiber.MethodPost, path: "/dp-api-report/demandPartners", want: Permission{DPAPIReportResource, ActionRead}},
+		{name: "head", method: fiber.MethodHead, path: "/report/demand", want: Permission{ReportResource, ActionRead}},
+		{name: "wrongMethod", method: fiber.MethodGet, path: "/floor/get", want: fullAccess},
+		{name: "unknown", method: fiber.MethodPost, path: "/unknown", want: fullAccess},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, RoutePermission(tt.method, tt.path))
+		})
+	}
+}
+
+func TestRoutes(t *testing.T) {
+	t.Parallel()
+
+	actions := []string{ActionRead, ActionWrite, ActionDelete}
+	for route, permission := range Routes {
+		method, path := splitRoute(route)
+		assert.Contains(t, []string{fiber.MethodGet, fiber.MethodPost, fiber.MethodDelete}, method, route)
+		assert.Equal(t, strings.ToLower(path), path, route)
+		assert.True(t, path == "/" || !strings.HasSuffix(path, "/"), route)
+		assert.NotEmpty(t, permission.Resource, route)
+		assert.Contains(t, actions, permission.Action, route)
+	}
+}
+
+func TestEnforcerAuthorizeRoutes(t *testing.T) {
+	t.Parallel()
+
+	enforcer := newTestEnforcer(testPolicy, []string{"1"})
+	for route, permission := range Routes {
+		method, path := splitRoute(route)
+		app := newTestApp(enforcer, method, path)
+		for _, role := range testRoles {
+			req := httptest.NewRequest(method, path, nil)
+			req.Header.Set("role", role)
+			resp, err := app.Test(req, -1)
+			require.NoError(t, err)
+
+			want := fiber.StatusForbidden
+			if testPolicy.Allows(role, permission) {
+				want = fiber.StatusOK
+			}
+			assert.Equal(t, want, resp.StatusCode, "%v as %v", route, role)
+		}
+	}
+}
+
+func TestEnforcerAuthorize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		role   string
+		method string
+		path   string
+		want   int
+	}{
+		{name: "publisherReadsPublishers", role: supertokens_module.PublisherRoleName, method: fiber.MethodPost, path: "/publisher/get", want: fiber.StatusOK},
+		{name: "publisherUpdatesPublisher", role: supertokens_module.PublisherRoleName, method: fiber.MethodPost, path: "/publisher/update", want: fiber.StatusForbidden},
+		{name: "publisherWritesFloor", role: supertokens_module.PublisherRoleName, method: fiber.MethodPost, path: "/floor", want: fiber.StatusForbidden},
+		{name: "publisherReadsPortal", role: supertokens_module.PublisherRoleName, method: fiber.MethodPost, path: "/portal/report", want: fiber.StatusOK},
+		{name: "publisherSetsNotifications", role: supertokens_module.PublisherRoleName, method: fiber.MethodPost, path: "/portal/notification/set", want: fiber.StatusOK},
+		{name: "consultantReadsPortal", role: supertokens_module.ConsultantRoleName, method: fiber.MethodPost, path: "/portal/report", want: fiber.StatusForbidden},
+		{name: "consultantUpdatesDomain", role: supertokens_module.ConsultantRoleName, method: fiber.MethodPost, path: "/publisher/domain", want: fiber.StatusOK},
+		{name: "consultantReadsDPO", role: supertokens_module.ConsultantRoleName, method: fiber.MethodPost, path: "/dpo/get", want: fiber.StatusForbidden},
+		{name: "memberDeletesDPO", role: supertokens_module.MemberRoleName, method: fiber.MethodDelete, path: "/dpo/delete", want: fiber.StatusForbidden},
+		{name: "supermemberDeletesDPO", role: supertokens_module.SupermemberRoleName, method: fiber.MethodDelete, path: "/dpo/delete", want: fiber.StatusOK},
+		{name: "memberUnknownRoute", role: supertokens_module.MemberRoleName, method: fiber.MethodPost, path: "/unknown", want: fiber.StatusForbidden},
+		{name: "adminUnknownRoute", role: supertokens_module.AdminRoleName, method: fiber.MethodPost, path: "/unknown", want: fiber.StatusOK},
+		{name: "noRole", role: "", method: fiber.MethodPost, path: "/floor/get", want: fiber.StatusForbidden},
+	}
+
+	enforcer := newTestEnforcer(testPolicy, []string{"1"})
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			app := newTestApp(enforcer, tt.method, tt.path)
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req.Header.Set("role", tt.role)
+			resp, err := app.Test(req, -1)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, resp.StatusCode)
+		})
+	}
+}
+
+func TestScopesAllow(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		scopes   []string
+		required Permission
+		want     bool
+	}{
+		{name: "exact", scopes: []string{"floor:write"}, required: Permission{FloorResource, ActionWrite}, want: true},
+		{name: "wildcardAction", scopes: []string{"report:read", "floor:*"}, required: Permission{FloorResource, ActionDelete}, want: true},
+		{name: "wildcardResource", scopes: []string{"*:read"}, required: Permission{FloorResource, ActionRead}, want: true},
+		{name: "otherAction", scopes: []string{"floor:read"}, required: Permission{FloorResource, ActionWrite}, want: false},
+		{name: "invalidScope", scopes: []string{"floor"}, required: Permission{FloorResource, ActionRead}, want: false},
+		{name: "noScopes", scopes: nil, required: Permission{FloorResource, ActionRead}, want: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, ScopesAllow(tt.scopes, tt.required))
+		})
+	}
+}
+
+func TestEnforcerAuthorizeAPIKeyScopes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		role   string
+		scopes string
+		path   string
+		want   int
+	}{
+		{name: "inScope", role: supertokens_module.AdminRoleName, scopes: "floor:read", path: "/floor/get", want: fiber.StatusOK},
+		{name: "outOfScope", role: supertokens_module.AdminRoleName, scopes: "floor:read", path: "/floor", want: fiber.StatusForbidden},
+		{name: "scopeExceedsRole", role: supertokens_module.PublisherRoleName, scopes: "*:*", path: "/floor", want: fiber.StatusForbidden},
+	}
+
+	enforcer := newTestEnforcer(testPolicy, []string{"1"})
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			app := newTestApp(enforcer, fiber.MethodPost, tt.path)
+			req := httptest.NewRequest(fiber.MethodPost, tt.path, nil)
+			req.Header.Set("role", tt.role)
+			req.Header.Set("scopes", tt.scopes)
+			resp, err := app.Test(req, -1)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, resp.StatusCode)
+		})
+	}
+}
+
+func TestEnforcerAuthorizePublisherScope(t *testing.T) {
+	t.Parallel()
+
+	enforcer := newTestEnforcer(testPolicy, []string{"100", "200"})
+	app := newTestApp(enforcer, fiber.MethodPost, "/publisher/get")
+
+	tests := []struct {
+		name string
+		role string
+		want string
+	}{
+		{name: "publisher", role: supertokens_module.PublisherRoleName, want: `["100","200"]`},
+		{name: "consultant", role: supertokens_module.ConsultantRoleName, want: `["100","200"]`},
+		{name: "member", role: supertokens_module.MemberRoleName, want: "OK"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(fiber.MethodPost, "/publisher/get", nil)
+			req.Header.Set("role", tt.role)
+			resp, err := app.Test(req, -1)
+			require.NoError(t, err)
+			require.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(body))
+		})
+	}
+}
+
+func TestEnforcerAuthorizePolicyError(t *testing.T) {
+	t.Parallel()
+
+	enforcer := &Enforcer{
+		ttl:        time.Minute,
+		loadPolicy: func(ctx context.Context) (Policy, error) { return nil, errors.New("db is down") },
+	}
+	app := newTestApp(enforcer, fiber.MethodPost, "/floor/get")
+
+	req := httptest.NewRequest(fiber.MethodPost, "/floor/get", nil)
+	req.Header.Set("role", supertokens_module.AdminRoleName)
+	resp, err := app.Test(req, -1)
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusInternalServerError, resp.StatusCode)
+
+	var body map[string]string
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, "failed to get role permissions", body["message"])
+}
+
+func TestEnforcerPolicyCache(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	enforcer := &Enforcer{
+		ttl: time.Hour,
+		loadPolicy: func(ctx context.Context) (Policy, error) {
+			calls++
+			return testPolicy, nil
+		},
+	}
+
+	for i := 0; i < 3; i++ {
+		_, err := enforcer.getPolicy(context.Background())
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, calls)
+
+	enforcer.loadedAt = time.Now().Add(-2 * time.Hour)
+	_, err := enforcer.getPolicy(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+}
+
+func TestPublisherScope(t *testing.T) {
+	t.Parallel()
+
+	unscoped := context.Background()
+	assert.True(t, CanAccessPublisher(unscoped, "1"))
+	assert.NoError(t, CheckPublisher(unscoped, "1"))
+	assert.Empty(t, PublisherScopeMods(unscoped, "publisher_id"))
+
+	scoped := context.WithValue(unscoped, constant.PublisherScopeContextKey, []string{"1", "2"})
+	assert.True(t, CanAccessPublisher(scoped, "2"))
+	assert.False(t, CanAccessPublisher(scoped, "3"))
+	assert.ErrorIs(t, CheckPublisher(scoped, "3"), ErrForbidden)
+	assert.Len(t, PublisherScopeMods(scoped, "publisher_id"), 1)
+
+	empty := context.WithValue(unscoped, constant.PublisherScopeContextKey, []string{})
+	assert.False(t, CanAccessPublisher(empty, "1"))
+	assert.Len(t, PublisherScopeMods(empty, "publisher_id"), 1)
+}
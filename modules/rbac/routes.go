@@ -0,0 +1,246 @@
+package rbac
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// resources
+const (
+	ConfigResource          = "config"
+	ReportResource          = "report"
+	MetadataResource        = "metadata"
+	PriceFloorResource      = "price_floor"
+	FixedPriceResource      = "fixed_price"
+	HouseAdPriceResource    = "house_ad_price"
+	DemandFactorResource    = "demand_factor"
+	PublisherDemandResource = "publisher_demand"
+	PriceOverrideResource   = "price_override"
+	GlobalFactorResource    = "global_factor"
+	BlockResource           = "block"
+	ConfiantResource        = "confiant"
+	PixalateResource        = "pixalate"
+	DemandPartnerResource   = "dp"
+	DPOResource             = "dpo"
+	AdsTxtResource          = "ads_txt"
+	PublisherResource       = "publisher"
+	DomainResource          = "domain"
+	BidCachingResource      = "bid_caching"
+	RefreshCacheResource    = "refresh_cache"
+	FactorResource          = "factor"
+	FloorResource           = "floor"
+	RecommendationResource  = "recommendation"
+	AutomationResource      = "automation"
+	CompetitorResource      = "competitor"
+	TargetingResource       = "targeting"
+	SearchResource          = "search"
+	DPAPIReportResource     = "dp_api_report"
+	UserResource            = "user"
+	ApprovalResource        = "approval"
+	AlertResource           = "alert"
+	SellersJSONResource     = "sellers_json"
+	SchainResource          = "schain"
+	HistoryResource         = "history"
+	EmailResource           = "email"
+	DebugResource           = "debug"
+	APIKeyResource          = "api_key"
+	PortalResource          = "portal"
+	AuditResource           = "audit"
+	CompassResource         = "compass"
+)
+
+// Routes maps every route behind session verification to the permission it requires.
+// Routes which are not listed require full access.
+var Routes = map[string]Permission{
+	"GET /debug/pprof":         {DebugResource, ActionRead},
+	"GET /debug/pprof/profile": {DebugResource, ActionRead},
+
+	"POST /config/get": {ConfigResource, ActionRead},
+	"POST /config":     {ConfigResource, ActionWrite},
+
+	"GET /report/daily/revenue":    {ReportResource, ActionRead},
+	"GET /report/hourly/revenue":   {ReportResource, ActionRead},
+	"GET /report/demand":           {ReportResource, ActionRead},
+	"GET /report/demand/hourly":    {ReportResource, ActionRead},
+	"GET /report/publisher":        {ReportResource, ActionRead},
+	"GET /report/publisher/hourly": {ReportResource, ActionRead},
+	"GET /report/iiq/hourly":       {ReportResource, ActionRead},
+
+	"POST /metadata/update":                  {MetadataResource, ActionWrite},
+	"GET /price/floor/set":                   {PriceFloorResource, ActionWrite},
+	"GET /price/floor/get":                   {PriceFloorResource, ActionRead},
+	"GET /price/floor/get/all":               {PriceFloorResource, ActionRead},
+	"POST /price/fixed":                      {FixedPriceResource, ActionWrite},
+	"GET /price/fixed":                       {FixedPriceResource, ActionRead},
+	"GET /had/price/set":                     {HouseAdPriceResource, ActionWrite},
+	"GET /had/price/get":                     {HouseAdPriceResource, ActionRead},
+	"GET /had/price/get/all":                 {HouseAdPriceResource, ActionRead},
+	"POST /demand/factor":                    {DemandFactorResource, ActionWrite},
+	"GET /demand/factor/set":                 {DemandFactorResource, ActionWrite},
+	"GET /demand/factor/get":                 {DemandFactorResource, ActionRead},
+	"GET /demand/factor/get/all":             {DemandFactorResource, ActionRead},
+	"POST /publisher/demand/get":             {PublisherDemandResource, ActionRead},
+	"POST /publisher/demand/udpate":          {PublisherDemandResource, ActionWrite},
+	"POST /price/override":                   {PriceOverrideResource, ActionWrite},
+	"POST /price/override/get":               {PriceOverrideResource, ActionRead},
+	"DELETE /price/override/delete":          {PriceOverrideResource, ActionDelete},
+	"POST /global/factor":                    {GlobalFactorResource, ActionWrite},
+	"POST /global/factor/get":                {GlobalFactorResource, ActionRead},
+	"POST /block":                            {BlockResource, ActionWrite},
+	"POST /block/get":                        {BlockResource, ActionRead},
+	"POST /block/items/get":                  {BlockResource, ActionRead},
+	"POST /block/items/add":                  {BlockResource, ActionWrite},
+	"DELETE /block/items/remove":             {BlockResource, ActionDelete},
+	"POST /block/upload":                     {BlockResource, ActionWrite},
+	"POST /block/taxonomy/get":               {BlockResource, ActionRead},
+	"POST /confiant":                         {ConfiantResource, ActionWrite},
+	"POST /confiant/get":                     {ConfiantResource, ActionRead},
+	"POST /pixalate":                         {PixalateResource, ActionWrite},
+	"POST /pixalate/get":                     {PixalateResource, ActionRead},
+	"DELETE /pixalate/delete":                {PixalateResource, ActionDelete},
+	"POST /dp/get":                           {DemandPartnerResource, ActionRead},
+	"POST /dp/set":                           {DemandPartnerResource, ActionWrite},
+	"POST /dp/update":                        {DemandPartnerResource, ActionWrite},
+	"POST /dp/seat_owner/get":                {DemandPartnerResource, ActionRead},
+	"POST /dp/approval/get":                  {DemandPartnerResource, ActionRead},
+	"POST /dp/approval/send":                 {DemandPartnerResource, ActionWrite},
+	"POST /dp/approval/decide":               {DemandPartnerResource, ActionWrite},
+	"POST /dp/bidder/schema/get":             {DemandPartnerResource, ActionRead},
+	"POST /dp/bidder/schema/set":             {DemandPartnerResource, ActionWrite},
+	"POST /dp/bidder/params/get":             {DemandPartnerResource, ActionRead},
+	"POST /dp/bidder/params/set":             {DemandPartnerResource, ActionWrite},
+	"POST /dp/bidder/params/delete":          {DemandPartnerResource, ActionDelete},
+	"POST /dp/score/get":                     {DemandPartnerResource, ActionRead},
+	"POST /dpo/set":                          {DPOResource, ActionWrite},
+	"POST /dpo/get":                          {DPOResource, ActionRead},
+	"DELETE /dpo/delete":                     {DPOResource, ActionDelete},
+	"GET /dpo/update":                        {DPOResource, ActionWrite},
+	"GET /ads_txt/filter":                    {AdsTxtResource, ActionRead},
+	"POST /ads_txt/main":                     {AdsTxtResource, ActionRead},
+	"POST /ads_txt/group_by_dp":              {AdsTxtResource, ActionRead},
+	"POST /ads_txt/am":                       {AdsTxtResource, ActionRead},
+	"POST /ads_txt/cm":                       {AdsTxtResource, ActionRead},
+	"POST /ads_txt/mb":                       {AdsTxtResource, ActionRead},
+	"POST /ads_txt/update":                   {AdsTxtResource, ActionWrite},
+	"POST /ads_txt/file":                     {AdsTxtResource, ActionRead},
+	"POST /publisher/new":                    {PublisherResource, ActionWrite},
+	"POST /publisher/update":                 {PublisherResource, ActionWrite},
+	"POST /publisher/get":                    {PublisherResource, ActionRead},
+	"POST /publisher/count":                  {PublisherResource, ActionRead},
+	"POST /publisher/details/get":            {PublisherResource, ActionRead},
+	"POST /publisher/lifecycle/report":       {PublisherResource, ActionRead},
+	"POST /publisher/sync/get":               {PublisherResource, ActionRead},
+	"POST /publisher/sync/resolve":           {PublisherResource, ActionWrite},
+	"POST /publisher/domain/get":             {DomainResource, ActionRead},
+	"POST /publisher/domain":                 {DomainResource, ActionWrite},
+	"POST /bid_caching/get":                  {BidCachingResource, ActionRead},
+	"POST /bid_caching/set":                  {BidCachingResource, ActionWrite},
+	"POST /bid_caching/update":               {BidCachingResource, ActionWrite},
+	"DELETE /bid_caching/delete":             {BidCachingResource, ActionDelete},
+	"POST /refresh_cache/get":                {RefreshCacheResource, ActionRead},
+	"POST /refresh_cache/set":                {RefreshCacheResource, ActionWrite},
+	"POST /refresh_cache/update":             {RefreshCacheResource, ActionWrite},
+	"DELETE /refresh_cache/delete":           {RefreshCacheResource, ActionDelete},
+	"POST /factor/get":                       {FactorResource, ActionRead},
+	"POST /factor":                           {FactorResource, ActionWrite},
+	"DELETE /factor/delete":                  {FactorResource, ActionDelete},
+	"POST /floor/get":                        {FloorResource, ActionRead},
+	"POST /floor":                            {FloorResource, ActionWrite},
+	"DELETE /floor/delete":                   {FloorResource, ActionDelete},
+	"POST /bulk/factor":                      {FactorResource, ActionWrite},
+	"POST /bulk/floor":                       {FloorResource, ActionWrite},
+	"POST /bulk/dpo":                         {DPOResource, ActionWrite},
+	"POST /bulk/global/factor":               {GlobalFactorResource, ActionWrite},
+	"POST /adjust/floor":                     {FloorResource, ActionWrite},
+	"POST /adjust/factor":                    {FactorResource, ActionWrite},
+	"POST /recommendation/get":               {RecommendationResource, ActionRead},
+	"POST /recommendation/apply":             {RecommendationResource, ActionWrite},
+	"POST /automation/circuit_breaker/get":   {AutomationResource, ActionRead},
+	"POST /automation/circuit_breaker/reset": {AutomationResource, ActionWrite},
+	"POST /automation/factor/log":            {AutomationResource, ActionRead},
+	"POST /automation/factor/log/aggregate":  {AutomationResource, ActionRead},
+	"POST /automation/factor/log/timeline":   {AutomationResource, ActionRead},
+	"POST /automation/dpo/log":               {AutomationResource, ActionRead},
+	"POST /automation/dpo/log/aggregate":     {AutomationResource, ActionRead},
+	"POST /automation/dpo/log/timeline":      {AutomationResource, ActionRead},
+	"POST /automation/download":              {AutomationResource, ActionRead},
+	"POST /competitor/get":                   {CompetitorResource, ActionRead},
+	"POST /competitor":                       {CompetitorResource, ActionWrite},
+	"POST /targeting/get":                    {TargetingResource, ActionRead},
+	"POST /targeting/set":                    {TargetingResource, ActionWrite},
+	"POST /targeting/update":                 {TargetingResource, ActionWrite},
+	"POST /targeting/tags":                   {TargetingResource, ActionRead},
+	"POST /search":                           {SearchResource, ActionRead},
+	"POST /dp-api-report":                    {DPAPIReportResource, ActionRead},
+	"POST /dp-api-report/demandpartners":     {DPAPIReportResource, ActionRead},
+
+	"POST /user/get":           {UserResource, ActionRead},
+	"POST /user/set":           {UserResource, ActionWrite},
+	"POST /user/update":        {UserResource, ActionWrite},
+	"POST /user/publisher/get": {UserResource, ActionRead},
+	"POST /user/publisher/set": {UserResource, ActionWrite},
+
+	"POST /api_key/get":    {APIKeyResource, ActionRead},
+	"POST /api_key/set":    {APIKeyResource, ActionWrite},
+	"POST /api_key/update": {APIKeyResource, ActionWrite},
+	"POST /api_key/revoke": {APIKeyResource, ActionDelete},
+
+	"POST /compass/manager/get":    {CompassResource, ActionRead},
+	"POST /compass/manager/set":    {CompassResource, ActionWrite},
+	"POST /compass/manager/delete": {CompassResource, ActionDelete},
+
+	"POST /portal/report":           {PortalResource, ActionRead},
+	"POST /portal/domains":          {PortalResource, ActionRead},
+	"POST /portal/tags":             {PortalResource, ActionRead},
+	"POST /portal/statement":        {PortalResource, ActionRead},
+	"POST /portal/notification/get": {PortalResource, ActionRead},
+	"POST /portal/notification/set": {PortalResource, ActionWrite},
+
+	"POST /audit/get": {AuditResource, ActionRead},
+
+	"POST /approval/request/get":     {ApprovalResource, ActionRead},
+	"POST /approval/request/approve": {ApprovalResource, ActionWrite},
+	"POST /approval/request/reject":  {ApprovalResource, ActionWrite},
+	"POST /approval/policy/get":      {ApprovalResource, ActionRead},
+	"POST /approval/policy/set":      {ApprovalResource, ActionWrite},
+	"POST /approval/policy/update":   {ApprovalResource, ActionWrite},
+	"DELETE /approval/policy/delete": {ApprovalResource, ActionDelete},
+	"POST /alert/event/get":          {AlertResource, ActionRead},
+	"POST /alert/event/acknowledge":  {AlertResource, ActionWrite},
+	"POST /alert/event/snooze":       {AlertResource, ActionWrite},
+	"POST /alert/rule/get":           {AlertResource, ActionRead},
+	"POST /alert/rule/set":           {AlertResource, ActionWrite},
+	"POST /alert/rule/update":        {AlertResource, ActionWrite},
+	"DELETE /alert/rule/delete":      {AlertResource, ActionDelete},
+	"POST /sellers_json/version/get": {SellersJSONResource, ActionRead},
+	"GET /sellers_json/diff":         {SellersJSONResource, ActionRead},
+	"POST /sellers_json/publish":     {SellersJSONResource, ActionWrite},
+	"POST /schain/get":               {SchainResource, ActionRead},
+	"POST /schain/summary":           {SchainResource, ActionRead},
+	"POST /history/get":              {HistoryResource, ActionRead},
+	"POST /email":                    {EmailResource, ActionWrite},
+}
+
+// fullAccess is required for routes missing in the routes table
+var fullAccess = Permission{Resource: Wildcard, Action: Wildcard}
+
+// RoutePermission returns the permission required by the route.
+// Paths are matched case-insensitively and regardless of trailing slash as fiber router does.
+func RoutePermission(method, path string) Permission {
+	if method == fiber.MethodHead {
+		method = fiber.MethodGet
+	}
+
+	path = strings.ToLower(path)
+	if len(path) > 1 {
+		path = strings.TrimSuffix(path, "/")
+	}
+
+	permission, ok := Routes[method+" "+path]
+	if !ok {
+		return fullAccess
+	}
+
+	return permission
+}
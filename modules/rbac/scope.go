@@ -0,0 +1,56 @@
+package rbac
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/m6yf/bcwork/utils/constant"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+var ErrForbidden = errors.New("access to publisher is forbidden")
+
+// PublisherScope returns ids of publishers the user is limited to.
+// The second value is false when the user is not limited.
+func PublisherScope(ctx context.Context) ([]string, bool) {
+	publisherIDs, ok := ctx.Value(constant.PublisherScopeContextKey).([]string)
+
+	return publisherIDs, ok
+}
+
+// CanAccessPublisher reports whether the user can see and edit the publisher
+func CanAccessPublisher(ctx context.Context, publisherID string) bool {
+	publisherIDs, ok := PublisherScope(ctx)
+
+	return !ok || slices.Contains(publisherIDs, publisherID)
+}
+
+// CheckPublisher returns ErrForbidden when the user can't access the publisher
+func CheckPublisher(ctx context.Context, publisherID string) error {
+	if !CanAccessPublisher(ctx, publisherID) {
+		return fmt.Errorf("%w [%v]", ErrForbidden, publisherID)
+	}
+
+	return nil
+}
+
+// PublisherScopeMods returns query mods limiting rows by the column to publishers of the user
+func PublisherScopeMods(ctx context.Context, column string) []qm.QueryMod {
+	publisherIDs, ok := PublisherScope(ctx)
+	if !ok {
+		return nil
+	}
+
+	if len(publisherIDs) == 0 {
+		return []qm.QueryMod{qm.Where("false")}
+	}
+
+	args := make([]interface{}, 0, len(publisherIDs))
+	for _, publisherID := range publisherIDs {
+		args = append(args, publisherID)
+	}
+
+	return []qm.QueryMod{qm.WhereIn(column+" in ?", args...)}
+}
@@ -0,0 +1,87 @@
+package sellersjson
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/m6yf/bcwork/dto"
+)
+
+// Diff returns sellers added, removed and changed in the current file comparing to the previous one.
+// Previous file is nil for the first version.
+func Diff(previous, current *File) *dto.SellersJSONDiff {
+	diff := &dto.SellersJSONDiff{
+		Added:   make([]string, 0),
+		Removed: make([]string, 0),
+		Changed: make([]*dto.SellersJSONSellerChange, 0),
+	}
+
+	previousSellers := make(map[string]*Seller)
+	if previous != nil {
+		for _, seller := range previous.Sellers {
+			previousSellers[seller.SellerID] = seller
+		}
+	}
+
+	currentSellers := make(map[string]*Seller, len(current.Sellers))
+	for _, seller := range current.Sellers {
+		currentSellers[seller.SellerID] = seller
+
+		old, ok := previousSellers[seller.SellerID]
+		if !ok {
+			diff.Added = append(diff.Added, seller.SellerID)
+			continue
+		}
+		diff.Changed = append(diff.Changed, sellerChanges(old, seller)...)
+	}
+
+	for id := range previousSellers {
+		if _, ok := currentSellers[id]; !ok {
+			diff.Removed = append(diff.Removed, id)
+		}
+	}
+
+	sort.Strings(diff.Added)
+	sort.Strings(diff.Removed)
+	sort.SliceStable(diff.Changed, func(i, j int) bool {
+		return diff.Changed[i].SellerID < diff.Changed[j].SellerID
+	})
+
+	return diff
+}
+
+// ChangedSellers returns number of sellers with at least one changed field
+func ChangedSellers(diff *dto.SellersJSONDiff) int {
+	ids := make(map[string]struct{}, len(diff.Changed))
+	for _, change := range diff.Changed {
+		ids[change.SellerID] = struct{}{}
+	}
+
+	return len(ids)
+}
+
+func sellerChanges(old, current *Seller) []*dto.SellersJSONSellerChange {
+	fields := []struct {
+		name     string
+		old, new string
+	}{
+		{name: "seller_type", old: old.SellerType, new: current.SellerType},
+		{name: "is_confidential", old: fmt.Sprint(old.IsConfidential), new: fmt.Sprint(current.IsConfidential)},
+		{name: "name", old: old.Name, new: current.Name},
+		{name: "domain", old: old.Domain, new: current.Domain},
+	}
+
+	changes := make([]*dto.SellersJSONSellerChange, 0)
+	for _, field := range fields {
+		if field.old != field.new {
+			changes = append(changes, &dto.SellersJSONSellerChange{
+				SellerID: current.SellerID,
+				Field:    field.name,
+				OldValue: field.old,
+				NewValue: field.new,
+			})
+		}
+	}
+
+	return changes
+}
@@ -0,0 +1,233 @@
+package sellersjson
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// SpecVersion is a version of IAB sellers.json specification the file follows
+const SpecVersion = "1.0"
+
+// seller types per IAB sellers.json specification
+const (
+	SellerTypePublisher    = "PUBLISHER"
+	SellerTypeIntermediary = "INTERMEDIARY"
+	SellerTypeBoth         = "BOTH"
+)
+
+// ErrInvalid is returned when generated file doesn't comply with the specification
+var ErrInvalid = errors.New("invalid sellers.json")
+
+// SellerTypes are allowed seller types
+var SellerTypes = []string{SellerTypePublisher, SellerTypeIntermediary, SellerTypeBoth}
+
+// Identifier is a business identifier of the file owner, e.g. TAG-ID
+type Identifier struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Seller is a seller object of sellers.json
+type Seller struct {
+	SellerID       string `json:"seller_id"`
+	IsConfidential int    `json:"is_confidential,omitempty"`
+	SellerType     string `json:"seller_type"`
+	Name           string `json:"name,omitempty"`
+	Domain         string `json:"domain,omitempty"`
+}
+
+// UnmarshalJSON accepts numeric seller ids and boolean is_confidential
+// which are common in sellers.json files of other companies
+func (s *Seller) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		SellerID       any    `json:"seller_id"`
+		IsConfidential any    `json:"is_confidential"`
+		SellerType     string `json:"seller_type"`
+		Name           string `json:"name"`
+		Domain         string `json:"domain"`
+	}
+
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+
+	switch id := raw.SellerID.(type) {
+	case string:
+		s.SellerID = strings.TrimSpace(id)
+	case float64:
+		s.SellerID = strconv.FormatFloat(id, 'f', -1, 64)
+	}
+
+	switch confidential := raw.IsConfidential.(type) {
+	case bool:
+		if confidential {
+			s.IsConfidential = 1
+		}
+	case float64:
+		s.IsConfidential = int(confidential)
+	}
+
+	s.SellerType = strings.ToUpper(strings.TrimSpace(raw.SellerType))
+	s.Name = raw.Name
+	s.Domain = raw.Domain
+
+	return nil
+}
+
+// File is a sellers.json file
+type File struct {
+	ContactEmail   string        `json:"contact_email,omitempty"`
+	ContactAddress string        `json:"contact_address,omitempty"`
+	Version        string        `json:"version"`
+	Identifiers    []*Identifier `json:"identifiers,omitempty"`
+	Sellers        []*Seller     `json:"sellers"`
+}
+
+// SellerOptions are publisher data the seller is built from
+type SellerOptions struct {
+	PublisherID    string
+	Name           string
+	Domain         string
+	SellerType     string
+	IsDirect       bool
+	IsConfidential bool
+}
+
+// NewSeller builds seller of the publisher. When seller type is not set explicitly it's
+// PUBLISHER for direct publishers and INTERMEDIARY otherwise. Name and domain of
+// confidential sellers are not disclosed.
+func NewSeller(ops SellerOptions) *Seller {
+	sellerType := strings.ToUpper(strings.TrimSpace(ops.SellerType))
+	if sellerType == "" {
+		sellerType = SellerTypeIntermediary
+		if ops.IsDirect {
+			sellerType = SellerTypePublisher
+		}
+	}
+
+	seller := &Seller{
+		SellerID:   ops.PublisherID,
+		SellerType: sellerType,
+	}
+	if ops.IsConfidential {
+		seller.IsConfidential = 1
+		return seller
+	}
+
+	seller.Name = strings.TrimSpace(ops.Name)
+	seller.Domain = normalizeDomain(ops.Domain)
+
+	return seller
+}
+
+// NewFile returns the file with sellers ordered by seller id
+func NewFile(contactEmail, contactAddress string, identifiers []*Identifier, sellers []*Seller) *File {
+	sort.SliceStable(sellers, func(i, j int) bool {
+		return sellers[i].SellerID < sellers[j].SellerID
+	})
+
+	return &File{
+		ContactEmail:   contactEmail,
+		ContactAddress: contactAddress,
+		Version:        SpecVersion,
+		Identifiers:    identifiers,
+		Sellers:        sellers,
+	}
+}
+
+// Parse parses content of sellers.json
+func Parse(content []byte) (*File, error) {
+	file := &File{}
+	err := json.Unmarshal(content, file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse sellers.json: %w", err)
+	}
+
+	return file, nil
+}
+
+// Validate checks the file complies with the specification: seller ids are unique,
+// seller types are known, sellers which are not confidential have name and domain
+func (f *File) Validate() error {
+	var errs []error
+	if f.Version == "" {
+		errs = append(errs, errors.New("version is missing"))
+	}
+
+	ids := make(map[string]struct{}, len(f.Sellers))
+	for _, seller := range f.Sellers {
+		if seller.SellerID == "" {
+			errs = append(errs, errors.New("seller id is missing"))
+			continue
+		}
+		if _, ok := ids[seller.SellerID]; ok {
+			errs = append(errs, fmt.Errorf("seller [%v]: seller id is duplicated", seller.SellerID))
+		}
+		ids[seller.SellerID] = struct{}{}
+
+		if !slices.Contains(SellerTypes, seller.SellerType) {
+			errs = append(errs, fmt.Errorf("seller [%v]: unknown seller type [%v]", seller.SellerID, seller.SellerType))
+		}
+		if seller.IsConfidential != 0 && seller.IsConfidential != 1 {
+			errs = append(errs, fmt.Errorf("seller [%v]: is_confidential must be 0 or 1", seller.SellerID))
+		}
+		if seller.IsConfidential == 1 {
+			continue
+		}
+		if seller.Name == "" {
+			errs = append(errs, fmt.Errorf("seller [%v]: name is missing", seller.SellerID))
+		}
+		if !isDomain(seller.Domain) {
+			errs = append(errs, fmt.Errorf("seller [%v]: invalid domain [%v]", seller.SellerID, seller.Domain))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %w", ErrInvalid, errors.Join(errs...))
+	}
+
+	return nil
+}
+
+// Marshal returns content of the file and its ETag
+func (f *File) Marshal() ([]byte, string, error) {
+	content, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to marshal sellers.json: %w", err)
+	}
+
+	return content, ETag(content), nil
+}
+
+// ETag returns strong entity tag of the content
+func ETag(content []byte) string {
+	hash := sha256.Sum256(content)
+	return `"` + hex.EncodeToString(hash[:16]) + `"`
+}
+
+// isDomain reports whether value is a bare domain without scheme, path or port
+func isDomain(value string) bool {
+	return value != "" &&
+		strings.Contains(value, ".") &&
+		!strings.ContainsAny(value, "/: ") &&
+		!strings.HasPrefix(value, ".") &&
+		!strings.HasSuffix(value, ".")
+}
+
+func normalizeDomain(domain string) string {
+	domain = strings.ToLower(strings.TrimSpace(domain))
+	domain = strings.TrimPrefix(domain, "https://")
+	domain = strings.TrimPrefix(domain, "http://")
+	domain = strings.TrimPrefix(domain, "www.")
+	domain, _, _ = strings.Cut(domain, "/")
+
+	return domain
+}
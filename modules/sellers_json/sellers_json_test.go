@@ -0,0 +1,167 @@
+package sellersjson
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/m6yf/bcwork/dto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSeller(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		ops  SellerOptions
+		want *Seller
+	}{
+		{
+			name: "directPublisher",
+			ops:  SellerOptions{PublisherID: "1", Name: " Publisher ", Domain: "https://www.Publisher.com/", IsDirect: true},
+			want: &Seller{SellerID: "1", SellerType: SellerTypePublisher, Name: "Publisher", Domain: "publisher.com"},
+		},
+		{
+			name: "indirectPublisher",
+			ops:  SellerOptions{PublisherID: "2", Name: "Network", Domain: "network.com"},
+			want: &Seller{SellerID: "2", SellerType: SellerTypeIntermediary, Name: "Network", Domain: "network.com"},
+		},
+		{
+			name: "explicitSellerType",
+			ops:  SellerOptions{PublisherID: "3", Name: "Both", Domain: "both.com", SellerType: "both", IsDirect: true},
+			want: &Seller{SellerID: "3", SellerType: SellerTypeBoth, Name: "Both", Domain: "both.com"},
+		},
+		{
+			name: "confidential",
+			ops:  SellerOptions{PublisherID: "4", Name: "Secret", Domain: "secret.com", IsDirect: true, IsConfidential: true},
+			want: &Seller{SellerID: "4", SellerType: SellerTypePublisher, IsConfidential: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, NewSeller(tt.ops))
+		})
+	}
+}
+
+func TestFile_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		sellers []*Seller
+		wantErr []string
+	}{
+		{
+			name: "valid",
+			sellers: []*Seller{
+				{SellerID: "1", SellerType: SellerTypePublisher, Name: "Publisher", Domain: "publisher.com"},
+				{SellerID: "2", SellerType: SellerTypeIntermediary, IsConfidential: 1},
+			},
+		},
+		{
+			name: "invalid",
+			sellers: []*Seller{
+				{SellerID: "1", SellerType: SellerTypePublisher, Name: "Publisher", Domain: "publisher.com"},
+				{SellerID: "1", SellerType: "RESELLER", Domain: "publisher.com/ads"},
+				{SellerType: SellerTypePublisher},
+			},
+			wantErr: []string{
+				"seller [1]: seller id is duplicated",
+				"seller [1]: unknown seller type [RESELLER]",
+				"seller [1]: name is missing",
+				"seller [1]: invalid domain [publisher.com/ads]",
+				"seller id is missing",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := NewFile("", "", nil, tt.sellers).Validate()
+			if len(tt.wantErr) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ErrInvalid))
+			for _, msg := range tt.wantErr {
+				assert.Contains(t, err.Error(), msg)
+			}
+		})
+	}
+}
+
+func TestFile_Marshal(t *testing.T) {
+	t.Parallel()
+
+	file := NewFile("adops@example.com", "", []*Identifier{{Name: "TAG-ID", Value: "abc"}}, []*Seller{
+		{SellerID: "2", SellerType: SellerTypeIntermediary, IsConfidential: 1},
+		{SellerID: "1", SellerType: SellerTypePublisher, Name: "Publisher", Domain: "publisher.com"},
+	})
+
+	content, etag, err := file.Marshal()
+	require.NoError(t, err)
+	assert.Equal(t, ETag(content), etag)
+
+	parsed, err := Parse(content)
+	require.NoError(t, err)
+	assert.Equal(t, file, parsed)
+	assert.Equal(t, "1", parsed.Sellers[0].SellerID)
+	assert.NotContains(t, string(content), "contact_address")
+}
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	previous := NewFile("", "", nil, []*Seller{
+		{SellerID: "1", SellerType: SellerTypePublisher, Name: "Publisher", Domain: "publisher.com"},
+		{SellerID: "2", SellerType: SellerTypeIntermediary, Name: "Network", Domain: "network.com"},
+		{SellerID: "3", SellerType: SellerTypePublisher, Name: "Removed", Domain: "removed.com"},
+	})
+	current := NewFile("", "", nil, []*Seller{
+		{SellerID: "1", SellerType: SellerTypePublisher, Name: "Publisher", Domain: "publisher.com"},
+		{SellerID: "2", SellerType: SellerTypeBoth, Name: "Network Inc", Domain: "network.com"},
+		{SellerID: "4", SellerType: SellerTypePublisher, IsConfidential: 1},
+	})
+
+	diff := Diff(previous, current)
+
+	assert.Equal(t, []string{"4"}, diff.Added)
+	assert.Equal(t, []string{"3"}, diff.Removed)
+	assert.Equal(t, []*dto.SellersJSONSellerChange{
+		{SellerID: "2", Field: "seller_type", OldValue: SellerTypeIntermediary, NewValue: SellerTypeBoth},
+		{SellerID: "2", Field: "name", OldValue: "Network", NewValue: "Network Inc"},
+	}, diff.Changed)
+	assert.Equal(t, 1, ChangedSellers(diff))
+
+	first := Diff(nil, current)
+	assert.Equal(t, []string{"1", "2", "4"}, first.Added)
+	assert.Empty(t, first.Removed)
+}
+
+func TestParse_lenientSellers(t *testing.T) {
+	t.Parallel()
+
+	content := []byte(`{"version":"1.0","sellers":[` +
+		`{"seller_id":12345,"seller_type":"publisher","name":"Numeric","domain":"numeric.com"},` +
+		`{"seller_id":" abc ","seller_type":"INTERMEDIARY","is_confidential":true},` +
+		`{"seller_id":"def","seller_type":"BOTH","is_confidential":0,"name":"Both","domain":"both.com"}]}`)
+
+	file, err := Parse(content)
+	require.NoError(t, err)
+	assert.Equal(t, []*Seller{
+		{SellerID: "12345", SellerType: SellerTypePublisher, Name: "Numeric", Domain: "numeric.com"},
+		{SellerID: "abc", SellerType: SellerTypeIntermediary, IsConfidential: 1},
+		{SellerID: "def", SellerType: SellerTypeBoth, Name: "Both", Domain: "both.com"},
+	}, file.Sellers)
+}
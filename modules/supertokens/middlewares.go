@@ -10,6 +10,7 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/m6yf/bcwork/bcdb"
 	"github.com/m6yf/bcwork/models"
+	"github.com/m6yf/bcwork/modules/apikey"
 	"github.com/m6yf/bcwork/utils"
 	"github.com/m6yf/bcwork/utils/constant"
 	"github.com/rs/zerolog/log"
@@ -29,6 +30,29 @@ func (c *SuperTokensClient) VerifySession(next http.Handler) http.Handler {
 			return
 		}
 
+		if key := r.Header.Get(constant.HeaderOMSWorkerAPIKey); key != "" && c.apiKeyVerifier != nil {
+			ip := apikey.ClientIP(r.Header.Get(fiber.HeaderXForwardedFor), r.RemoteAddr, c.trustedProxies)
+			identity, err := c.apiKeyVerifier.VerifyAPIKey(r.Context(), key, ip)
+			if err != nil {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, err = w.Write([]byte(fmt.Sprintf(`{"error": "%v"}`, err.Error())))
+				if err != nil {
+					log.Error().Err(err).Msg("failed writing response body while verifying api key")
+				}
+
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), constant.UserIDContextKey, identity.OwnerID)
+			ctx = context.WithValue(ctx, constant.UserEmailContextKey, identity.Email)
+			ctx = context.WithValue(ctx, constant.RoleContextKey, identity.Role)
+			ctx = context.WithValue(ctx, constant.APIKeyIDContextKey, identity.ID)
+			ctx = context.WithValue(ctx, constant.APIKeyScopesContextKey, identity.Scopes)
+			next.ServeHTTP(w, r.WithContext(ctx))
+
+			return
+		}
+
 		sessionContainer, err := session.GetSession(r, w, nil)
 		if err != nil {
 			var (
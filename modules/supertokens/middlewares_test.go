@@ -0,0 +1,48 @@
+package supertokens
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/m6yf/bcwork/utils/constant"
+	"github.com/stretchr/testify/assert"
+)
+
+type apiKeyVerifierStub struct {
+	ip string
+}
+
+func (v *apiKeyVerifierStub) VerifyAPIKey(ctx context.Context, key, ip string) (*APIKeyIdentity, error) {
+	v.ip = ip
+
+	return nil, errors.New("api key is not allowed from this ip")
+}
+
+func TestVerifySession_invalidAPIKey(t *testing.T) {
+	t.Parallel()
+
+	verifier := &apiKeyVerifierStub{}
+	client := &SuperTokensClient{trustedProxies: []string{"192.0.2.0/24"}}
+	client.SetAPIKeyVerifier(verifier)
+
+	isNextCalled := false
+	handler := client.VerifySession(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		isNextCalled = true
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/publisher/get", nil)
+	req.Header.Set(constant.HeaderOMSWorkerAPIKey, "key")
+	req.Header.Set(fiber.HeaderXForwardedFor, "6.6.6.6, 1.1.1.1")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.False(t, isNextCalled)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Equal(t, `{"error": "api key is not allowed from this ip"}`, rec.Body.String())
+	assert.Equal(t, "1.1.1.1", verifier.ip)
+}
@@ -44,11 +44,27 @@ type TokenManagementSystem interface {
 	GetEmailByUserID(userID string) (string, error)
 }
 
+// APIKeyIdentity is an api key which request was authenticated with
+type APIKeyIdentity struct {
+	ID      int
+	OwnerID int
+	Email   string
+	Role    string
+	Scopes  []string
+}
+
+// APIKeyVerifier authenticates requests by api keys issued to integrations and workers
+type APIKeyVerifier interface {
+	VerifyAPIKey(ctx context.Context, key, ip string) (*APIKeyIdentity, error)
+}
+
 type SuperTokensClient struct {
-	apiURL     string
-	webURL     string
-	apiKeys    []string
-	httpClient httpclient.Doer
+	apiURL         string
+	webURL         string
+	apiKeys        []string
+	apiKeyVerifier APIKeyVerifier
+	trustedProxies []string
+	httpClient     httpclient.Doer
 }
 
 var _ TokenManagementSystem = (*SuperTokensClient)(nil)
@@ -67,13 +83,19 @@ func NewSuperTokensClient(
 	cronKey := viper.GetString(config.CronWorkerAPIKeyKey)
 
 	return &SuperTokensClient{
-		apiURL:     apiURL,
-		webURL:     webURL,
-		apiKeys:    []string{awsKey, cronKey},
-		httpClient: httpclient.New(true),
+		apiURL:         apiURL,
+		webURL:         webURL,
+		apiKeys:        []string{awsKey, cronKey},
+		trustedProxies: viper.GetStringSlice(config.TrustedProxiesKey),
+		httpClient:     httpclient.New(true),
 	}, nil
 }
 
+// SetAPIKeyVerifier enables authentication by issued api keys in addition to shared worker keys
+func (c *SuperTokensClient) SetAPIKeyVerifier(verifier APIKeyVerifier) {
+	c.apiKeyVerifier = verifier
+}
+
 func (c *SuperTokensClient) GetWebURL() string {
 	return c.webURL
 }
@@ -2,10 +2,13 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"time"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/m6yf/bcwork/core"
+	"github.com/m6yf/bcwork/dto"
 	"github.com/m6yf/bcwork/models"
 	adstxt "github.com/m6yf/bcwork/modules/ads_txt"
 	"github.com/rotisserie/eris"
@@ -56,6 +59,11 @@ func (d *DB) UpsertPublisherAndDomains(
 		return fmt.Errorf("failed to upsert row [%v] in publisher table: %w", publisher.PublisherID, err)
 	}
 
+	err = initPublisherStatus(ctx, tx, publisher.PublisherID)
+	if err != nil {
+		return err
+	}
+
 	var isNewAdsTxtLinesWereCreated bool
 	for _, domain := range domains {
 		isExisted, err := models.PublisherDomains(
@@ -79,6 +87,11 @@ func (d *DB) UpsertPublisherAndDomains(
 				return fmt.Errorf("failed to insert domain [%v] to publisher domain table for publisherId [%v]: %w", domain.Domain, domain.PublisherID, err)
 			}
 
+			err = core.StartDomainOnboarding(ctx, tx, domain.PublisherID, domain.Domain)
+			if err != nil {
+				return err
+			}
+
 			// if it was new domain, then creating ads txt lines for it
 			if d.isNeededToCreateAdsTxtLines {
 				isNewAdsTxtLinesWereCreated = true
@@ -102,6 +115,48 @@ func (d *DB) UpsertPublisherAndDomains(
 	return nil
 }
 
+// initPublisherStatus gives publisher without status of the lifecycle (new one or with legacy status) the initial status,
+// status itself isn't synced from compass, so otherwise scheduled statuses wouldn't be applied to the publisher
+func initPublisherStatus(ctx context.Context, tx *sql.Tx, publisherID string) error {
+	mod, err := models.FindPublisher(ctx, tx, publisherID,
+		models.PublisherColumns.PublisherID,
+		models.PublisherColumns.Status,
+		models.PublisherColumns.StartTimestamp,
+		models.PublisherColumns.PauseTimestamp,
+		models.PublisherColumns.ReactivateTimestamp,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to get status of publisher [%v]: %w", publisherID, err)
+	}
+
+	if dto.IsPublisherStatus(mod.Status.String) {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	transition := &models.PublisherStatusTransition{
+		PublisherID: publisherID,
+		FromStatus:  null.NewString(mod.Status.String, mod.Status.String != ""),
+		ToStatus:    dto.InitialPublisherStatus(mod, now),
+		Source:      dto.PublisherStatusSourceSync,
+		CreatedAt:   now,
+	}
+
+	mod.Status = null.StringFrom(transition.ToStatus)
+	mod.StatusChangedAt = null.TimeFrom(now)
+	_, err = mod.Update(ctx, tx, boil.Whitelist(models.PublisherColumns.Status, models.PublisherColumns.StatusChangedAt))
+	if err != nil {
+		return fmt.Errorf("failed to set initial status of publisher [%v]: %w", publisherID, err)
+	}
+
+	err = transition.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		return fmt.Errorf("failed to save initial status transition of publisher [%v]: %w", publisherID, err)
+	}
+
+	return nil
+}
+
 func (d *DB) HadLoadingErrorLastTime(ctx context.Context, key string) bool {
 	lastResult, err := models.PublisherSyncs(qm.Where(models.PublisherSyncColumns.Key+" = ?", key)).One(ctx, d.dbClient)
 	if err != nil {
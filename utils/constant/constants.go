@@ -19,12 +19,16 @@ const (
 	PostgresCurrentTime     = "NOW()"
 
 	// Context
-	UserIDContextKey      ContextKey = "user_id"
-	UserEmailContextKey   ContextKey = "email"
-	RoleContextKey        ContextKey = "role"
-	RequestIDContextKey   ContextKey = "request_id"
-	LoggerContextKey      ContextKey = "logger"
-	RequestPathContextKey ContextKey = "request_path"
+	UserIDContextKey         ContextKey = "user_id"
+	UserEmailContextKey      ContextKey = "email"
+	RoleContextKey           ContextKey = "role"
+	RequestIDContextKey      ContextKey = "request_id"
+	LoggerContextKey         ContextKey = "logger"
+	RequestPathContextKey    ContextKey = "request_path"
+	ApproverIDContextKey     ContextKey = "approver_id"
+	PublisherScopeContextKey ContextKey = "publisher_scope"
+	APIKeyIDContextKey       ContextKey = "api_key_id"
+	APIKeyScopesContextKey   ContextKey = "api_key_scopes"
 
 	// Global Factor Fee Type
 	GlobalFactorConsultantFeeType = "consultant_fee"
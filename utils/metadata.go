@@ -16,6 +16,8 @@ const (
 	BidCachingMetaDataKeyPrefix   = "bid:cache"
 	RefreshCacheMetaDataKeyPrefix = "refresh:cache"
 	ConfiantMetaDataKeyPrefix     = "confiant:v2"
+	BidderParamsMetaDataKeyPrefix = "bidder:params"
+	DPRankingMetaDataKey          = "dp:ranking"
 	AdsTxtMetaDataKeyTemplate     = "demand:%s:adtxtv2"
 )
 
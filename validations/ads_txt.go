@@ -57,3 +57,48 @@ func validateAdsTxtUpdate(request *dto.AdsTxtUpdateRequest) []string {
 
 	return validationErrors
 }
+
+func AdsTxtFileValidation(c *fiber.Ctx) error {
+	var request *dto.AdsTxtFileRequest
+	err := c.BodyParser(&request)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid request body for ads txt file. Please ensure it's a valid JSON.",
+		})
+	}
+
+	validationErrors := validateAdsTxtFile(request)
+
+	if len(validationErrors) > 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(errorResponse{
+			Status:  errorStatus,
+			Message: "could not validate ads.txt file request",
+			Errors:  validationErrors,
+		})
+	}
+
+	return c.Next()
+}
+
+func validateAdsTxtFile(request *dto.AdsTxtFileRequest) []string {
+	var errorMessages = map[string]string{
+		adsTxtFileNameValidationKey: adsTxtFileNameErrorMessage,
+	}
+
+	validationErrors := make([]string, 0)
+
+	err := Validator.Struct(request)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			if msg, ok := errorMessages[err.Tag()]; ok {
+				validationErrors = append(validationErrors, msg)
+			} else {
+				validationErrors = append(validationErrors,
+					fmt.Sprintf("%s is mandatory, validation failed", err.Field()))
+			}
+		}
+	}
+
+	return validationErrors
+}
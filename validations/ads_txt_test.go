@@ -78,3 +78,44 @@ func Test_validateAdsTxtUpdate(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateAdsTxtFile(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		request *dto.AdsTxtFileRequest
+		want    []string
+	}{
+		{
+			name:    "valid_adsTxt",
+			request: &dto.AdsTxtFileRequest{Domain: "test.com", FileName: dto.AdsTxtFileName},
+			want:    []string{},
+		},
+		{
+			name:    "valid_appAdsTxtOfPublisher",
+			request: &dto.AdsTxtFileRequest{PublisherID: "999", Domain: "test.com", FileName: dto.AppAdsTxtFileName},
+			want:    []string{},
+		},
+		{
+			name:    "invalid_fileName",
+			request: &dto.AdsTxtFileRequest{Domain: "test.com", FileName: "sellers.json"},
+			want:    []string{adsTxtFileNameErrorMessage},
+		},
+		{
+			name:    "invalid_noDomain",
+			request: &dto.AdsTxtFileRequest{FileName: dto.AdsTxtFileName},
+			want:    []string{"Domain is mandatory, validation failed"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validateAdsTxtFile(tt.request)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
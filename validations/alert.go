@@ -0,0 +1,178 @@
+package validations
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/utils/bccron"
+)
+
+var (
+	// statements and functions which change data, schema or server state, rule queries only read metrics
+	alertQueryForbiddenKeywords = regexp.MustCompile(`(?i)\b(insert|update|delete|merge|upsert|drop|alter|create|truncate|grant|revoke|copy|call|do|execute|vacuum|analyze|lock|set|reset|into|dblink\w*|pg_\w+|lo_\w+)\b`)
+
+	alertSources     = []string{dto.AlertSourcePostgres, dto.AlertSourceQuest}
+	alertComparisons = []string{dto.AlertComparisonAbove, dto.AlertComparisonBelow, dto.AlertComparisonAboveRatio, dto.AlertComparisonBelowRatio}
+	alertSeverities  = []string{dto.AlertSeverityInfo, dto.AlertSeverityWarning, dto.AlertSeverityCritical}
+	alertChannels    = []string{dto.AlertChannelSlack, dto.AlertChannelEmail}
+)
+
+func ValidateAlertRule(c *fiber.Ctx) error {
+	var request *dto.AlertRule
+	err := c.BodyParser(&request)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid request body for Alert Rule. Please ensure it's a valid JSON.",
+		})
+	}
+
+	validationErrors := validateAlertRule(request)
+
+	if len(validationErrors) > 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(errorResponse{
+			Status:  errorStatus,
+			Message: "could not validate Alert Rule request",
+			Errors:  validationErrors,
+		})
+	}
+
+	return c.Next()
+}
+
+func ValidateAlertEventAcknowledge(c *fiber.Ctx) error {
+	var request *dto.AlertEventAcknowledgeRequest
+	err := c.BodyParser(&request)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid request body for Alert Event acknowledge. Please ensure it's a valid JSON.",
+		})
+	}
+
+	validationErrors := validateAlertRequest(request)
+
+	if len(validationErrors) > 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(errorResponse{
+			Status:  errorStatus,
+			Message: "could not validate Alert Event acknowledge request",
+			Errors:  validationErrors,
+		})
+	}
+
+	return c.Next()
+}
+
+func ValidateAlertEventSnooze(c *fiber.Ctx) error {
+	var request *dto.AlertEventSnoozeRequest
+	err := c.BodyParser(&request)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid request body for Alert Event snooze. Please ensure it's a valid JSON.",
+		})
+	}
+
+	validationErrors := validateAlertRequest(request)
+	if request != nil && !request.Until.After(time.Now()) {
+		validationErrors = append(validationErrors, alertSnoozeErrorMessage)
+	}
+
+	if len(validationErrors) > 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(errorResponse{
+			Status:  errorStatus,
+			Message: "could not validate Alert Event snooze request",
+			Errors:  validationErrors,
+		})
+	}
+
+	return c.Next()
+}
+
+func validateAlertRule(request *dto.AlertRule) []string {
+	validationErrors := validateAlertRequest(request)
+	if request != nil && request.IsRatio() && len(request.BaselineOffsets) == 0 {
+		validationErrors = append(validationErrors, alertBaselineErrorMessage)
+	}
+
+	return validationErrors
+}
+
+func validateAlertRequest(request any) []string {
+	var errorMessages = map[string]string{
+		alertSourceValidationKey:     fmt.Sprintf("%s: %v", alertSourceErrorMessage, alertSources),
+		alertComparisonValidationKey: fmt.Sprintf("%s: %v", alertComparisonErrorMessage, alertComparisons),
+		alertSeverityValidationKey:   fmt.Sprintf("%s: %v", alertSeverityErrorMessage, alertSeverities),
+		alertChannelValidationKey:    fmt.Sprintf("%s: %v", alertChannelErrorMessage, alertChannels),
+		alertOffsetValidationKey:     alertOffsetErrorMessage,
+		alertCronValidationKey:       alertCronErrorMessage,
+		alertQueryValidationKey:      alertQueryErrorMessage,
+		emailValidationKey:           emailValidationErrorMessage,
+	}
+
+	validationErrors := make([]string, 0)
+
+	err := Validator.Struct(request)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			if msg, ok := errorMessages[err.Tag()]; ok {
+				validationErrors = append(validationErrors, msg)
+			} else {
+				validationErrors = append(validationErrors,
+					fmt.Sprintf("%s is mandatory, validation failed", err.Field()))
+			}
+		}
+	}
+
+	return validationErrors
+}
+
+func alertSourceValidation(fl validator.FieldLevel) bool {
+	return slices.Contains(alertSources, fl.Field().String())
+}
+
+func alertComparisonValidation(fl validator.FieldLevel) bool {
+	return slices.Contains(alertComparisons, fl.Field().String())
+}
+
+func alertSeverityValidation(fl validator.FieldLevel) bool {
+	return slices.Contains(alertSeverities, fl.Field().String())
+}
+
+func alertChannelValidation(fl validator.FieldLevel) bool {
+	return slices.Contains(alertChannels, fl.Field().String())
+}
+
+func alertOffsetValidation(fl validator.FieldLevel) bool {
+	offset, err := time.ParseDuration(fl.Field().String())
+
+	return err == nil && offset > 0
+}
+
+func alertCronValidation(fl validator.FieldLevel) bool {
+	_, err := bccron.Parse(fl.Field().String())
+
+	return err == nil
+}
+
+// alertQueryValidation accepts a single SELECT (optionally with CTEs) statement
+func alertQueryValidation(fl validator.FieldLevel) bool {
+	query := strings.TrimSpace(fl.Field().String())
+	query = strings.TrimSpace(strings.TrimSuffix(query, ";"))
+	if query == "" || strings.ContainsAny(query, ";") || strings.Contains(query, "--") || strings.Contains(query, "/*") {
+		return false
+	}
+
+	fields := strings.Fields(strings.ToLower(query))
+	if fields[0] != "select" && fields[0] != "with" {
+		return false
+	}
+
+	return !alertQueryForbiddenKeywords.MatchString(query)
+}
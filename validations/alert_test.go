@@ -0,0 +1,142 @@
+package validations
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/m6yf/bcwork/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_validateAlertRule(t *testing.T) {
+	t.Parallel()
+
+	validRule := func() *dto.AlertRule {
+		return &dto.AlertRule{
+			Name:            "rpm_decrease",
+			Source:          dto.AlertSourcePostgres,
+			Query:           "select domain as key, 1 as value from publisher_daily where time >= $1 and time < $2",
+			Comparison:      dto.AlertComparisonBelowRatio,
+			Threshold:       0.4,
+			WindowMinutes:   1440,
+			BaselineOffsets: []string{"24h", "168h"},
+			Severity:        dto.AlertSeverityWarning,
+			Channel:         dto.AlertChannelEmail,
+			Recipients:      []string{"am@example.com"},
+			Cron:            "0 12 * * *",
+		}
+	}
+
+	tests := []struct {
+		name    string
+		request func() *dto.AlertRule
+		want    []string
+	}{
+		{
+			name:    "valid",
+			request: validRule,
+			want:    []string{},
+		},
+		{
+			name: "unknownComparison",
+			request: func() *dto.AlertRule {
+				rule := validRule()
+				rule.Comparison = "equal"
+				return rule
+			},
+			want: []string{fmt.Sprintf("%s: %v", alertComparisonErrorMessage, alertComparisons)},
+		},
+		{
+			name: "wrongOffsetAndCron",
+			request: func() *dto.AlertRule {
+				rule := validRule()
+				rule.BaselineOffsets = []string{"1 day"}
+				rule.Cron = "every day"
+				return rule
+			},
+			want: []string{alertOffsetErrorMessage, alertCronErrorMessage},
+		},
+		{
+			name: "ratioWithoutBaseline",
+			request: func() *dto.AlertRule {
+				rule := validRule()
+				rule.BaselineOffsets = nil
+				return rule
+			},
+			want: []string{alertBaselineErrorMessage},
+		},
+		{
+			name: "withQuery",
+			request: func() *dto.AlertRule {
+				rule := validRule()
+				rule.Query = "WITH d AS (SELECT domain, revenue FROM publisher_daily) SELECT domain AS key, sum(revenue) AS value FROM d GROUP BY 1;"
+				return rule
+			},
+			want: []string{},
+		},
+		{
+			name: "multipleStatements",
+			request: func() *dto.AlertRule {
+				rule := validRule()
+				rule.Query = "select 1 as key, 1 as value; drop table publisher"
+				return rule
+			},
+			want: []string{alertQueryErrorMessage},
+		},
+		{
+			name: "dataModifyingCTE",
+			request: func() *dto.AlertRule {
+				rule := validRule()
+				rule.Query = "with d as (delete from publisher returning publisher_id) select publisher_id as key, 1 as value from d"
+				return rule
+			},
+			want: []string{alertQueryErrorMessage},
+		},
+		{
+			name: "notSelect",
+			request: func() *dto.AlertRule {
+				rule := validRule()
+				rule.Query = "update publisher set status = 'paused'"
+				return rule
+			},
+			want: []string{alertQueryErrorMessage},
+		},
+		{
+			name: "selectInto",
+			request: func() *dto.AlertRule {
+				rule := validRule()
+				rule.Query = "select domain as key, 1 as value into copy_table from publisher_daily"
+				return rule
+			},
+			want: []string{alertQueryErrorMessage},
+		},
+		{
+			name: "adminFunction",
+			request: func() *dto.AlertRule {
+				rule := validRule()
+				rule.Query = "select pg_terminate_backend(pid) as value, 'x' as key from pg_stat_activity"
+				return rule
+			},
+			want: []string{alertQueryErrorMessage},
+		},
+		{
+			name: "missingWindow",
+			request: func() *dto.AlertRule {
+				rule := validRule()
+				rule.WindowMinutes = 0
+				return rule
+			},
+			want: []string{"WindowMinutes is mandatory, validation failed"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validateAlertRule(tt.request())
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package validations
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/modules/rbac"
+)
+
+var permissionActions = []string{rbac.ActionRead, rbac.ActionWrite, rbac.ActionDelete, rbac.Wildcard}
+
+func ValidateAPIKey(c *fiber.Ctx) error {
+	var request *dto.APIKey
+	err := c.BodyParser(&request)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid request body for API Key. Please ensure it's a valid JSON.",
+		})
+	}
+
+	validationErrors := validateAPIKey(request)
+
+	if len(validationErrors) > 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(errorResponse{
+			Status:  errorStatus,
+			Message: "could not validate API Key request",
+			Errors:  validationErrors,
+		})
+	}
+
+	return c.Next()
+}
+
+func validateAPIKey(request *dto.APIKey) []string {
+	var errorMessages = map[string]string{
+		permissionValidationKey: permissionErrorMessage,
+		ipOrCidrValidationKey:   ipOrCidrErrorMessage,
+	}
+
+	validationErrors := make([]string, 0)
+
+	err := Validator.Struct(request)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			if msg, ok := errorMessages[err.Tag()]; ok {
+				validationErrors = append(validationErrors, msg)
+			} else {
+				validationErrors = append(validationErrors,
+					fmt.Sprintf("%s is mandatory, validation failed", err.Field()))
+			}
+		}
+	}
+
+	if request != nil && request.ExpiresAt != nil && !request.ExpiresAt.After(time.Now()) {
+		validationErrors = append(validationErrors, apiKeyExpiresAtErrorMessage)
+	}
+
+	return validationErrors
+}
+
+func permissionValidation(fl validator.FieldLevel) bool {
+	permission, err := rbac.ParsePermission(fl.Field().String())
+	return err == nil && slices.Contains(permissionActions, permission.Action)
+}
+
+func ValidateAPIKeyRevoke(c *fiber.Ctx) error {
+	var request *dto.APIKeyRevokeRequest
+	err := c.BodyParser(&request)
+	if err != nil || request == nil || request.ID == 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid request body for API Key revoke. Please ensure it's a valid JSON with id.",
+		})
+	}
+
+	return c.Next()
+}
@@ -0,0 +1,90 @@
+package validations
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m6yf/bcwork/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_validateAPIKey(t *testing.T) {
+	t.Parallel()
+
+	validKey := func() *dto.APIKey {
+		return &dto.APIKey{
+			Name:       "reporting integration",
+			OwnerID:    1,
+			Scopes:     []string{"report:read", "floor:*"},
+			AllowedIPs: []string{"10.0.0.1", "192.168.0.0/16"},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		request func() *dto.APIKey
+		want    []string
+	}{
+		{
+			name:    "valid",
+			request: validKey,
+			want:    []string{},
+		},
+		{
+			name: "invalidScope",
+			request: func() *dto.APIKey {
+				key := validKey()
+				key.Scopes = []string{"report", "floor:update"}
+				return key
+			},
+			want: []string{permissionErrorMessage, permissionErrorMessage},
+		},
+		{
+			name: "noScopes",
+			request: func() *dto.APIKey {
+				key := validKey()
+				key.Scopes = nil
+				return key
+			},
+			want: []string{"Scopes is mandatory, validation failed"},
+		},
+		{
+			name: "invalidIP",
+			request: func() *dto.APIKey {
+				key := validKey()
+				key.AllowedIPs = []string{"localhost"}
+				return key
+			},
+			want: []string{ipOrCidrErrorMessage},
+		},
+		{
+			name: "expired",
+			request: func() *dto.APIKey {
+				key := validKey()
+				expiresAt := time.Now().Add(-time.Hour)
+				key.ExpiresAt = &expiresAt
+				return key
+			},
+			want: []string{apiKeyExpiresAtErrorMessage},
+		},
+		{
+			name: "missingOwner",
+			request: func() *dto.APIKey {
+				key := validKey()
+				key.OwnerID = 0
+				return key
+			},
+			want: []string{"OwnerID is mandatory, validation failed"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validateAPIKey(tt.request())
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
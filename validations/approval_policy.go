@@ -0,0 +1,62 @@
+package validations
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/m6yf/bcwork/core/approval"
+	"github.com/m6yf/bcwork/dto"
+)
+
+func ValidateApprovalPolicy(c *fiber.Ctx) error {
+	var request *dto.ApprovalPolicy
+	err := c.BodyParser(&request)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid request body for Approval Policy. Please ensure it's a valid JSON.",
+		})
+	}
+
+	validationErrors := validateApprovalPolicy(request)
+
+	if len(validationErrors) > 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(errorResponse{
+			Status:  errorStatus,
+			Message: "could not validate Approval Policy request",
+			Errors:  validationErrors,
+		})
+	}
+
+	return c.Next()
+}
+
+func validateApprovalPolicy(request *dto.ApprovalPolicy) []string {
+	var errorMessages = map[string]string{
+		approvalSubjectValidationKey: approvalSubjectErrorMessage,
+		userRoleValidationKey:        userRoleValidationErrorMessage,
+		emailValidationKey:           emailValidationErrorMessage,
+	}
+
+	validationErrors := make([]string, 0)
+
+	err := Validator.Struct(request)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			if msg, ok := errorMessages[err.Tag()]; ok {
+				validationErrors = append(validationErrors, msg)
+			} else {
+				validationErrors = append(validationErrors,
+					fmt.Sprintf("%s is mandatory, validation failed", err.Field()))
+			}
+		}
+	}
+
+	return validationErrors
+}
+
+func approvalSubjectValidation(fl validator.FieldLevel) bool {
+	return slices.Contains(approval.Subjects(), fl.Field().String())
+}
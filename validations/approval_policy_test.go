@@ -0,0 +1,91 @@
+package validations
+
+import (
+	"testing"
+
+	"github.com/m6yf/bcwork/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_validateApprovalPolicy(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		request *dto.ApprovalPolicy
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "valid",
+			args: args{
+				request: &dto.ApprovalPolicy{
+					Name:           "big factor changes",
+					Subject:        "Bidder Targeting",
+					RequesterRoles: []string{"Member"},
+					ApproverRoles:  []string{"Admin", "Supermember"},
+					NotifyEmails:   []string{"approvers@email.com"},
+				},
+			},
+			want: []string{},
+		},
+		{
+			name: "unknownSubject",
+			args: args{
+				request: &dto.ApprovalPolicy{
+					Name:          "publishers",
+					Subject:       "Publisher",
+					ApproverRoles: []string{"Admin"},
+				},
+			},
+			want: []string{approvalSubjectErrorMessage},
+		},
+		{
+			name: "badRoles",
+			args: args{
+				request: &dto.ApprovalPolicy{
+					Name:           "floors",
+					Subject:        "Floor",
+					RequesterRoles: []string{"unknown_role"},
+					ApproverRoles:  []string{"Admin"},
+				},
+			},
+			want: []string{userRoleValidationErrorMessage},
+		},
+		{
+			name: "noApprovers",
+			args: args{
+				request: &dto.ApprovalPolicy{
+					Name:    "dpo",
+					Subject: "DPO",
+				},
+			},
+			want: []string{"ApproverRoles is mandatory, validation failed"},
+		},
+		{
+			name: "badEmail",
+			args: args{
+				request: &dto.ApprovalPolicy{
+					Name:          "serving fees",
+					Subject:       "Serving Fees",
+					ApproverRoles: []string{"Admin"},
+					NotifyEmails:  []string{"emailemail.com"},
+				},
+			},
+			want: []string{emailValidationErrorMessage},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validateApprovalPolicy(tt.args.request)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
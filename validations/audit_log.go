@@ -0,0 +1,55 @@
+package validations
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/m6yf/bcwork/core"
+)
+
+// auditLogMaxRange limits search to a few monthly partitions of audit log
+const auditLogMaxRange = 93 * 24 * time.Hour
+
+func ValidateAuditLog(c *fiber.Ctx) error {
+	request := new(core.GetAuditLogOptions)
+	err := c.BodyParser(&request)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid request body for audit log. Please ensure it's a valid JSON.",
+		})
+	}
+
+	validationErrors := validateAuditLog(request)
+
+	if len(validationErrors) > 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(errorResponse{
+			Status:  errorStatus,
+			Message: "could not validate audit log request",
+			Errors:  validationErrors,
+		})
+	}
+
+	return c.Next()
+}
+
+func validateAuditLog(request *core.GetAuditLogOptions) []string {
+	validationErrors := make([]string, 0)
+
+	createdAt := request.Filter.CreatedAt
+	if createdAt == nil {
+		return append(validationErrors, auditLogCreatedAtErrorMessage)
+	}
+
+	err := createdAt.Validate()
+	if err != nil {
+		return append(validationErrors, fmt.Sprintf("created_at: %s", err.Error()))
+	}
+
+	if createdAt.To.Sub(createdAt.From) > auditLogMaxRange {
+		validationErrors = append(validationErrors, auditLogRangeErrorMessage)
+	}
+
+	return validationErrors
+}
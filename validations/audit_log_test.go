@@ -0,0 +1,53 @@
+package validations
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m6yf/bcwork/bcdb/filter"
+	"github.com/m6yf/bcwork/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_validateAuditLog(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		createdAt *filter.DatesFilter
+		want      []string
+	}{
+		{
+			name:      "valid",
+			createdAt: &filter.DatesFilter{From: from, To: from.AddDate(0, 1, 0)},
+			want:      []string{},
+		},
+		{
+			name:      "missingCreatedAt",
+			createdAt: nil,
+			want:      []string{auditLogCreatedAtErrorMessage},
+		},
+		{
+			name:      "toBeforeFrom",
+			createdAt: &filter.DatesFilter{From: from, To: from.AddDate(0, 0, -1)},
+			want:      []string{"created_at: 'to' should be after 'from'"},
+		},
+		{
+			name:      "rangeTooLong",
+			createdAt: &filter.DatesFilter{From: from, To: from.AddDate(0, 6, 0)},
+			want:      []string{auditLogRangeErrorMessage},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validateAuditLog(&core.GetAuditLogOptions{Filter: core.AuditLogFilter{CreatedAt: tt.createdAt}})
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
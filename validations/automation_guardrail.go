@@ -0,0 +1,61 @@
+package validations
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/m6yf/bcwork/dto"
+)
+
+var automations = []string{dto.FactorAutomation, dto.DPOAutomation}
+
+func ValidateCircuitBreakerReset(c *fiber.Ctx) error {
+	var request *dto.CircuitBreakerResetRequest
+	err := c.BodyParser(&request)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid request body for Circuit Breaker reset. Please ensure it's a valid JSON.",
+		})
+	}
+
+	validationErrors := validateCircuitBreakerReset(request)
+
+	if len(validationErrors) > 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(errorResponse{
+			Status:  errorStatus,
+			Message: "could not validate Circuit Breaker reset request",
+			Errors:  validationErrors,
+		})
+	}
+
+	return c.Next()
+}
+
+func validateCircuitBreakerReset(request *dto.CircuitBreakerResetRequest) []string {
+	var errorMessages = map[string]string{
+		automationValidationKey: fmt.Sprintf("%s: %v", automationErrorMessage, automations),
+	}
+
+	validationErrors := make([]string, 0)
+
+	err := Validator.Struct(request)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			if msg, ok := errorMessages[err.Tag()]; ok {
+				validationErrors = append(validationErrors, msg)
+			} else {
+				validationErrors = append(validationErrors,
+					fmt.Sprintf("%s is mandatory, validation failed", err.Field()))
+			}
+		}
+	}
+
+	return validationErrors
+}
+
+func automationValidation(fl validator.FieldLevel) bool {
+	return slices.Contains(automations, fl.Field().String())
+}
@@ -0,0 +1,39 @@
+package validations
+
+import (
+	"testing"
+
+	"github.com/m6yf/bcwork/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_validateCircuitBreakerReset(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		request *dto.CircuitBreakerResetRequest
+		want    []string
+	}{
+		{
+			name:    "valid",
+			request: &dto.CircuitBreakerResetRequest{Automation: dto.DPOAutomation},
+			want:    []string{},
+		},
+		{
+			name:    "unknownAutomation",
+			request: &dto.CircuitBreakerResetRequest{Automation: "floor"},
+			want:    []string{automationErrorMessage + ": [factor dpo]"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validateCircuitBreakerReset(tt.request)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
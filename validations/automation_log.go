@@ -0,0 +1,77 @@
+package validations
+
+import (
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/m6yf/bcwork/bcdb/filter"
+	"github.com/m6yf/bcwork/core"
+)
+
+func ValidateFactorLogTimeline(c *fiber.Ctx) error {
+	request := new(core.FactorLogTimelineOptions)
+	err := c.BodyParser(&request)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid request body for factor rule timeline. Please ensure it's a valid JSON.",
+		})
+	}
+
+	validationErrors := validateAutomationLogTimeline(request, request.Time)
+
+	if len(validationErrors) > 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(errorResponse{
+			Status:  errorStatus,
+			Message: "could not validate factor rule timeline request",
+			Errors:  validationErrors,
+		})
+	}
+
+	return c.Next()
+}
+
+func ValidateDpoLogTimeline(c *fiber.Ctx) error {
+	request := new(core.DpoLogTimelineOptions)
+	err := c.BodyParser(&request)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid request body for dpo rule timeline. Please ensure it's a valid JSON.",
+		})
+	}
+
+	validationErrors := validateAutomationLogTimeline(request, request.Time)
+
+	if len(validationErrors) > 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(errorResponse{
+			Status:  errorStatus,
+			Message: "could not validate dpo rule timeline request",
+			Errors:  validationErrors,
+		})
+	}
+
+	return c.Next()
+}
+
+func validateAutomationLogTimeline(request any, time *filter.DatesFilter) []string {
+	validationErrors := make([]string, 0)
+
+	err := Validator.Struct(request)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors,
+				fmt.Sprintf("%s is mandatory, validation failed", err.Field()))
+		}
+	}
+
+	if time != nil {
+		err := time.Validate()
+		if err != nil {
+			validationErrors = append(validationErrors, fmt.Sprintf("time: %s", err.Error()))
+		}
+	}
+
+	return validationErrors
+}
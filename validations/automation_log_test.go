@@ -0,0 +1,53 @@
+package validations
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m6yf/bcwork/bcdb/filter"
+	"github.com/m6yf/bcwork/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_validateAutomationLogTimeline(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		request *core.DpoLogTimelineOptions
+		want    []string
+	}{
+		{
+			name:    "valid",
+			request: &core.DpoLogTimelineOptions{DP: "dp", Publisher: "1", Domain: "example.com"},
+			want:    []string{},
+		},
+		{
+			name:    "missingRuleKey",
+			request: &core.DpoLogTimelineOptions{Publisher: "1"},
+			want:    []string{"DP is mandatory, validation failed", "Domain is mandatory, validation failed"},
+		},
+		{
+			name: "wrongTimeWindow",
+			request: &core.DpoLogTimelineOptions{
+				DP:        "dp",
+				Publisher: "1",
+				Domain:    "example.com",
+				Time:      &filter.DatesFilter{From: from, To: from.Add(-time.Hour)},
+			},
+			want: []string{"time: 'to' should be after 'from'"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validateAutomationLogTimeline(tt.request, tt.request.Time)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package validations
+
+import (
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/m6yf/bcwork/dto"
+)
+
+func ValidateDPBidderSchema(c *fiber.Ctx) error {
+	var request *dto.DPBidderSchema
+	err := c.BodyParser(&request)
+	if err != nil || request == nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid request body for Bidder Schema. Please ensure it's a valid JSON.",
+		})
+	}
+
+	validationErrors := validateBidderParamsRequest(request)
+
+	if len(validationErrors) > 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(errorResponse{
+			Status:  errorStatus,
+			Message: "could not validate Bidder Schema request",
+			Errors:  validationErrors,
+		})
+	}
+
+	return c.Next()
+}
+
+func ValidateDPBidderParams(c *fiber.Ctx) error {
+	var request *dto.DPBidderParams
+	err := c.BodyParser(&request)
+	if err != nil || request == nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid request body for Bidder Params. Please ensure it's a valid JSON.",
+		})
+	}
+
+	validationErrors := validateBidderParamsRequest(request)
+
+	if len(validationErrors) > 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(errorResponse{
+			Status:  errorStatus,
+			Message: "could not validate Bidder Params request",
+			Errors:  validationErrors,
+		})
+	}
+
+	return c.Next()
+}
+
+func ValidateDPBidderParamsDelete(c *fiber.Ctx) error {
+	var request *dto.DPBidderParamsDeleteRequest
+	err := c.BodyParser(&request)
+	if err != nil || request == nil || len(request.IDs) == 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid request body for Bidder Params delete. Please ensure it's a valid JSON with ids.",
+		})
+	}
+
+	return c.Next()
+}
+
+func validateBidderParamsRequest(request any) []string {
+	validationErrors := make([]string, 0)
+
+	err := Validator.Struct(request)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, fmt.Sprintf("%s is mandatory, validation failed", err.Field()))
+		}
+	}
+
+	return validationErrors
+}
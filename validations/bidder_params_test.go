@@ -0,0 +1,54 @@
+package validations
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/m6yf/bcwork/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_validateBidderParamsRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		request any
+		want    []string
+	}{
+		{
+			name:    "validSchema",
+			request: &dto.DPBidderSchema{DemandPartnerID: "dp", Schema: json.RawMessage(`{"type": "object"}`)},
+			want:    []string{},
+		},
+		{
+			name:    "missingSchema",
+			request: &dto.DPBidderSchema{DemandPartnerID: "dp"},
+			want:    []string{"Schema is mandatory, validation failed"},
+		},
+		{
+			name:    "validPublisherParams",
+			request: &dto.DPBidderParams{DemandPartnerID: "dp", PublisherID: "999", Params: json.RawMessage(`{"siteId": "1"}`)},
+			want:    []string{},
+		},
+		{
+			name:    "missingParamsFields",
+			request: &dto.DPBidderParams{Domain: "example.com"},
+			want: []string{
+				"DemandPartnerID is mandatory, validation failed",
+				"PublisherID is mandatory, validation failed",
+				"Params is mandatory, validation failed",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validateBidderParamsRequest(tt.request)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
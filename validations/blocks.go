@@ -2,9 +2,11 @@ package validations
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
+	"github.com/m6yf/bcwork/core"
 	"github.com/m6yf/bcwork/dto"
 )
 
@@ -32,7 +34,64 @@ func ValidateBlocks(c *fiber.Ctx) error {
 }
 
 func validateBlocks(request *dto.BlockUpdateRequest) []string {
-	var errorMessages = map[string]string{}
+	var errorMessages = map[string]string{
+		bcatValidationKey: bcatErrorMessage,
+		badvValidationKey: badvErrorMessage,
+	}
+
+	validationErrors := make([]string, 0)
+
+	err := Validator.Struct(request)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			if msg, ok := errorMessages[err.Tag()]; ok {
+				validationErrors = append(validationErrors, fmt.Sprintf("%v, got [%v]", msg, err.Value()))
+			} else {
+				validationErrors = append(validationErrors,
+					fmt.Sprintf("%s is mandatory, validation failed", err.Field()))
+			}
+		}
+	}
+
+	return validationErrors
+}
+
+func ValidateBlockItems(c *fiber.Ctx) error {
+	return validateBlockItemsRequest(c, true)
+}
+
+// ValidateBlockItemsRemoval doesn't check format of values, so entries stored before validation was introduced can be removed
+func ValidateBlockItemsRemoval(c *fiber.Ctx) error {
+	return validateBlockItemsRequest(c, false)
+}
+
+func validateBlockItemsRequest(c *fiber.Ctx, validateValues bool) error {
+	var request *dto.BlockItemsRequest
+	err := c.BodyParser(&request)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid request body for Block items. Please ensure it's a valid JSON.",
+		})
+	}
+
+	validationErrors := validateBlockItems(request, validateValues)
+
+	if len(validationErrors) > 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(errorResponse{
+			Status:  errorStatus,
+			Message: "could not validate block items request",
+			Errors:  validationErrors,
+		})
+	}
+
+	return c.Next()
+}
+
+func validateBlockItems(request *dto.BlockItemsRequest, validateValues bool) []string {
+	var errorMessages = map[string]string{
+		blockTypeValidationKey: blockTypeErrorMessage,
+	}
 
 	validationErrors := make([]string, 0)
 
@@ -46,7 +105,34 @@ func validateBlocks(request *dto.BlockUpdateRequest) []string {
 					fmt.Sprintf("%s is mandatory, validation failed", err.Field()))
 			}
 		}
+
+		return validationErrors
+	}
+
+	if !validateValues {
+		return validationErrors
+	}
+
+	// value rules depend on the type of item
+	for _, item := range request.Items {
+		if _, err := core.NormalizeBlockValue(item.Type, item.Value); err != nil {
+			validationErrors = append(validationErrors, err.Error())
+		}
 	}
 
 	return validationErrors
 }
+
+func blockTypeValidation(fl validator.FieldLevel) bool {
+	return slices.Contains([]string{dto.BlockTypeBADV, dto.BlockTypeBCAT}, fl.Field().String())
+}
+
+func bcatValidation(fl validator.FieldLevel) bool {
+	_, err := core.NormalizeBlockValue(dto.BlockTypeBCAT, fl.Field().String())
+	return err == nil
+}
+
+func badvValidation(fl validator.FieldLevel) bool {
+	_, err := core.NormalizeBlockValue(dto.BlockTypeBADV, fl.Field().String())
+	return err == nil
+}
@@ -0,0 +1,151 @@
+package validations
+
+import (
+	"testing"
+
+	"github.com/m6yf/bcwork/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_validateBlockItems(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		request        *dto.BlockItemsRequest
+		validateValues bool
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "valid",
+			args: args{
+				request: &dto.BlockItemsRequest{
+					Publisher: "1111111",
+					Domain:    "oms.com",
+					Items: []dto.BlockItem{
+						{Type: "badv", Value: "advertiser.com", Reason: "complaint"},
+						{Type: "bcat", Value: "IAB7-39"},
+					},
+				},
+				validateValues: true,
+			},
+			want: []string{},
+		},
+		{
+			name: "invalidValues",
+			args: args{
+				request: &dto.BlockItemsRequest{
+					Publisher: "1111111",
+					Items: []dto.BlockItem{
+						{Type: "badv", Value: "co.uk"},
+						{Type: "bcat", Value: "gambling"},
+					},
+				},
+				validateValues: true,
+			},
+			want: []string{
+				"invalid advertiser domain [co.uk]: publicsuffix: cannot derive eTLD+1 for domain \"co.uk\"",
+				"bcat [gambling] is not a category of IAB content taxonomy [1.0 2.2 3.0]",
+			},
+		},
+		{
+			name: "invalidValuesOnRemoval",
+			args: args{
+				request: &dto.BlockItemsRequest{
+					Publisher: "1111111",
+					Items:     []dto.BlockItem{{Type: "badv", Value: "legacy value"}},
+				},
+				validateValues: false,
+			},
+			want: []string{},
+		},
+		{
+			name: "unknownType",
+			args: args{
+				request: &dto.BlockItemsRequest{
+					Publisher: "1111111",
+					Items:     []dto.BlockItem{{Type: "bapp", Value: "com.app"}},
+				},
+				validateValues: true,
+			},
+			want: []string{blockTypeErrorMessage},
+		},
+		{
+			name: "noItems",
+			args: args{
+				request: &dto.BlockItemsRequest{
+					Publisher: "1111111",
+				},
+				validateValues: true,
+			},
+			want: []string{"Items is mandatory, validation failed"},
+		},
+		{
+			name: "emptyValue",
+			args: args{
+				request: &dto.BlockItemsRequest{
+					Publisher: "1111111",
+					Items:     []dto.BlockItem{{Type: "badv"}},
+				},
+				validateValues: true,
+			},
+			want: []string{"Value is mandatory, validation failed"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validateBlockItems(tt.args.request, tt.args.validateValues)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_validateBlocks(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		request *dto.BlockUpdateRequest
+		want    []string
+	}{
+		{
+			name: "valid",
+			request: &dto.BlockUpdateRequest{
+				Publisher: "1111111",
+				BCAT:      []string{"IAB1-1", "483"},
+				BADV:      []string{"https://www.Advertiser.com/"},
+			},
+			want: []string{},
+		},
+		{
+			name: "invalidEntries",
+			request: &dto.BlockUpdateRequest{
+				Publisher: "1111111",
+				BCAT:      []string{"IAB27"},
+				BADV:      []string{"advertiser"},
+			},
+			want: []string{
+				bcatErrorMessage + ", got [IAB27]",
+				badvErrorMessage + ", got [advertiser]",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validateBlocks(tt.request)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package validations
+
+import (
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/m6yf/bcwork/dto"
+)
+
+func ValidateCompassManagers(c *fiber.Ctx) error {
+	var request []*dto.CompassManager
+	err := c.BodyParser(&request)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid request body for Compass Managers. Please ensure it's a valid JSON.",
+		})
+	}
+
+	validationErrors := validateCompassManagers(request)
+
+	if len(validationErrors) > 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(errorResponse{
+			Status:  errorStatus,
+			Message: "could not validate Compass Managers request",
+			Errors:  validationErrors,
+		})
+	}
+
+	return c.Next()
+}
+
+func validateCompassManagers(request []*dto.CompassManager) []string {
+	validationErrors := make([]string, 0)
+	if len(request) == 0 {
+		return append(validationErrors, "managers are mandatory, validation failed")
+	}
+
+	compassIDs := make(map[string]struct{}, len(request))
+	for _, manager := range request {
+		if manager == nil {
+			validationErrors = append(validationErrors, "manager is mandatory, validation failed")
+			continue
+		}
+
+		err := Validator.Struct(manager)
+		if err != nil {
+			for _, err := range err.(validator.ValidationErrors) {
+				validationErrors = append(validationErrors, fmt.Sprintf("%s is mandatory, validation failed", err.Field()))
+			}
+			continue
+		}
+
+		if _, ok := compassIDs[manager.CompassID]; ok {
+			validationErrors = append(validationErrors, compassManagerDuplicateErrorMessage)
+		}
+		compassIDs[manager.CompassID] = struct{}{}
+	}
+
+	return validationErrors
+}
+
+func ValidateCompassManagersDelete(c *fiber.Ctx) error {
+	var request *dto.CompassManagerDeleteRequest
+	err := c.BodyParser(&request)
+	if err != nil || request == nil || len(request.IDs) == 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid request body for Compass Managers delete. Please ensure it's a valid JSON with ids.",
+		})
+	}
+
+	return c.Next()
+}
+
+func ValidatePublisherSyncResolve(c *fiber.Ctx) error {
+	var request *dto.PublisherSyncResolveRequest
+	err := c.BodyParser(&request)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid request body for Publisher Sync Resolve. Please ensure it's a valid JSON.",
+		})
+	}
+
+	validationErrors := validatePublisherSyncResolve(request)
+
+	if len(validationErrors) > 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(errorResponse{
+			Status:  errorStatus,
+			Message: "could not validate Publisher Sync Resolve request",
+			Errors:  validationErrors,
+		})
+	}
+
+	return c.Next()
+}
+
+func validatePublisherSyncResolve(request *dto.PublisherSyncResolveRequest) []string {
+	var errorMessages = map[string]string{
+		"oneof": compassSyncKeepErrorMessage,
+	}
+
+	validationErrors := make([]string, 0)
+
+	err := Validator.Struct(request)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			if msg, ok := errorMessages[err.Tag()]; ok {
+				validationErrors = append(validationErrors, msg)
+			} else {
+				validationErrors = append(validationErrors,
+					fmt.Sprintf("%s is mandatory, validation failed", err.Field()))
+			}
+		}
+	}
+
+	return validationErrors
+}
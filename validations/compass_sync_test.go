@@ -0,0 +1,102 @@
+package validations
+
+import (
+	"testing"
+
+	"github.com/m6yf/bcwork/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_validateCompassManagers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		request []*dto.CompassManager
+		want    []string
+	}{
+		{
+			name: "valid",
+			request: []*dto.CompassManager{
+				{CompassID: "5f1b", UserID: 1},
+				{CompassID: "5f1c", UserID: 1},
+			},
+			want: []string{},
+		},
+		{
+			name:    "empty",
+			request: []*dto.CompassManager{},
+			want:    []string{"managers are mandatory, validation failed"},
+		},
+		{
+			name: "missingFields",
+			request: []*dto.CompassManager{
+				{CompassID: "5f1b"},
+				{UserID: 1},
+			},
+			want: []string{
+				"UserID is mandatory, validation failed",
+				"CompassID is mandatory, validation failed",
+			},
+		},
+		{
+			name: "duplicateCompassID",
+			request: []*dto.CompassManager{
+				{CompassID: "5f1b", UserID: 1},
+				{CompassID: "5f1b", UserID: 2},
+			},
+			want: []string{compassManagerDuplicateErrorMessage},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validateCompassManagers(tt.request)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_validatePublisherSyncResolve(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		request *dto.PublisherSyncResolveRequest
+		want    []string
+	}{
+		{
+			name:    "keepLocal",
+			request: &dto.PublisherSyncResolveRequest{PublisherID: "1", Keep: dto.CompassSyncKeepLocal},
+			want:    []string{},
+		},
+		{
+			name:    "keepCompass",
+			request: &dto.PublisherSyncResolveRequest{PublisherID: "1", Keep: dto.CompassSyncKeepCompass},
+			want:    []string{},
+		},
+		{
+			name:    "invalidKeep",
+			request: &dto.PublisherSyncResolveRequest{PublisherID: "1", Keep: "both"},
+			want:    []string{compassSyncKeepErrorMessage},
+		},
+		{
+			name:    "missingPublisher",
+			request: &dto.PublisherSyncResolveRequest{Keep: dto.CompassSyncKeepLocal},
+			want:    []string{"PublisherID is mandatory, validation failed"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validatePublisherSyncResolve(tt.request)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
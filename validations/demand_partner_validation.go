@@ -38,6 +38,7 @@ func validateDemandPartner(request *dto.DemandPartner) []string {
 		approvalProcessKey:            approvalProcessErrorMessage,
 		dpBlocksKey:                   dpBlocksErrorMessage,
 		dpThresholdKey:                fmt.Sprintf("dp threshold must be >= %s and <= %s", fmt.Sprintf("%.2f", constant.MinThreshold), fmt.Sprintf("%.2f", constant.MaxThreshold)),
+		dpoAutomationConfigKey:        dpoAutomationConfigErrorMessage,
 		intergrationTypeValidationKey: intergrationTypeErrorMessage + ": " + strings.Join(integrationTypes, ","),
 		mediaTypeValidationKey:        mediaTypeErrorMessage + ": " + strings.Join(mediaTypes, ","),
 	}
@@ -381,6 +381,88 @@ func Test_validateDemandPartner(t *testing.T) {
 			},
 			want: []string{},
 		},
+		{
+			name: "test valid dpo automation config",
+			args: args{
+				request: &dto.DemandPartner{
+					DemandPartnerID:   "id",
+					DemandPartnerName: "name",
+					Threshold:         0.010,
+					AutomationConfig:  &dto.DPOAutomationConfig{Levels: []dto.DPOThrottleLevel{{MaxErpmRatio: 0.5, Factor: 90}}, MinHoldHours: helpers.GetPointerToInt(0)},
+					Automation:        true,
+					IntegrationType:   []string{dto.PrebidServerIntergrationType},
+					Connections: []*dto.DemandPartnerConnection{
+						{
+							DPDomain:                 "domain.com",
+							PublisherAccount:         "abcde",
+							CertificationAuthorityID: helpers.GetPointerToString(certID),
+							Children: []*dto.DemandPartnerChild{
+								{
+									DPChildName:      "child_name",
+									DPDomain:         "childdomain.com",
+									PublisherAccount: "12345",
+								},
+							},
+							MediaType: []string{dto.WebBannersMediaType},
+						},
+					},
+					ApprovalProcess:         dto.GDocApprovalProcess,
+					DPBlocks:                dto.EmailApprovalProcess,
+					POCName:                 "poc_name",
+					POCEmail:                "poc_email",
+					SeatOwnerID:             helpers.GetPointerToInt(1),
+					ManagerID:               helpers.GetPointerToInt(1),
+					IsInclude:               false,
+					Active:                  true,
+					IsApprovalNeeded:        true,
+					ApprovalBeforeGoingLive: true,
+					Score:                   5,
+					Comments:                helpers.GetPointerToString(comments),
+				},
+			},
+			want: []string{},
+		},
+		{
+			name: "test invalid dpo automation config level",
+			args: args{
+				request: &dto.DemandPartner{
+					DemandPartnerID:   "id",
+					DemandPartnerName: "name",
+					Threshold:         0.010,
+					AutomationConfig:  &dto.DPOAutomationConfig{Levels: []dto.DPOThrottleLevel{{MaxErpmRatio: 1.5, Factor: 90}}},
+					Automation:        true,
+					IntegrationType:   []string{dto.PrebidServerIntergrationType},
+					Connections: []*dto.DemandPartnerConnection{
+						{
+							DPDomain:                 "domain.com",
+							PublisherAccount:         "abcde",
+							CertificationAuthorityID: helpers.GetPointerToString(certID),
+							Children: []*dto.DemandPartnerChild{
+								{
+									DPChildName:      "child_name",
+									DPDomain:         "childdomain.com",
+									PublisherAccount: "12345",
+								},
+							},
+							MediaType: []string{dto.WebBannersMediaType},
+						},
+					},
+					ApprovalProcess:         dto.GDocApprovalProcess,
+					DPBlocks:                dto.EmailApprovalProcess,
+					POCName:                 "poc_name",
+					POCEmail:                "poc_email",
+					SeatOwnerID:             helpers.GetPointerToInt(1),
+					ManagerID:               helpers.GetPointerToInt(1),
+					IsInclude:               false,
+					Active:                  true,
+					IsApprovalNeeded:        true,
+					ApprovalBeforeGoingLive: true,
+					Score:                   5,
+					Comments:                helpers.GetPointerToString(comments),
+				},
+			},
+			want: []string{dpoAutomationConfigErrorMessage},
+		},
 	}
 
 	for _, tt := range tests {
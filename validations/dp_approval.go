@@ -0,0 +1,87 @@
+package validations
+
+import (
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/m6yf/bcwork/dto"
+)
+
+func ValidateDPApprovalSend(c *fiber.Ctx) error {
+	var request *dto.DPApprovalSendRequest
+	err := c.BodyParser(&request)
+	if err != nil || request == nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid request body for Demand Partner Approval Send. Please ensure it's a valid JSON.",
+		})
+	}
+
+	validationErrors := validateDPApproval(request, request.Domains)
+
+	if len(validationErrors) > 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(errorResponse{
+			Status:  errorStatus,
+			Message: "could not validate Demand Partner Approval Send request",
+			Errors:  validationErrors,
+		})
+	}
+
+	return c.Next()
+}
+
+func ValidateDPApprovalDecision(c *fiber.Ctx) error {
+	var request *dto.DPApprovalDecisionRequest
+	err := c.BodyParser(&request)
+	if err != nil || request == nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid request body for Demand Partner Approval Decision. Please ensure it's a valid JSON.",
+		})
+	}
+
+	validationErrors := validateDPApproval(request, request.Domains)
+
+	if len(validationErrors) > 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(errorResponse{
+			Status:  errorStatus,
+			Message: "could not validate Demand Partner Approval Decision request",
+			Errors:  validationErrors,
+		})
+	}
+
+	return c.Next()
+}
+
+func validateDPApproval(request any, domains []dto.DPApprovalDomain) []string {
+	var errorMessages = map[string]string{
+		"min":   dpApprovalDomainsErrorMessage,
+		"oneof": dpApprovalStatusErrorMessage,
+	}
+
+	validationErrors := make([]string, 0)
+
+	err := Validator.Struct(request)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			if msg, ok := errorMessages[err.Tag()]; ok {
+				validationErrors = append(validationErrors, msg)
+			} else {
+				validationErrors = append(validationErrors,
+					fmt.Sprintf("%s is mandatory, validation failed", err.Field()))
+			}
+		}
+	}
+
+	seen := make(map[dto.DPApprovalDomain]struct{}, len(domains))
+	for _, domain := range domains {
+		if _, ok := seen[domain]; ok {
+			validationErrors = append(validationErrors, dpApprovalDomainDuplicateErrorMessage)
+			break
+		}
+		seen[domain] = struct{}{}
+	}
+
+	return validationErrors
+}
@@ -0,0 +1,75 @@
+package validations
+
+import (
+	"testing"
+
+	"github.com/m6yf/bcwork/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_validateDPApproval(t *testing.T) {
+	t.Parallel()
+
+	domains := []dto.DPApprovalDomain{
+		{PublisherID: "999", Domain: "example.com"},
+		{PublisherID: "999", Domain: "example.org"},
+	}
+
+	tests := []struct {
+		name    string
+		request any
+		domains []dto.DPApprovalDomain
+		want    []string
+	}{
+		{
+			name:    "validSend",
+			request: &dto.DPApprovalSendRequest{DemandPartnerID: "dp", Domains: domains},
+			domains: domains,
+			want:    []string{},
+		},
+		{
+			name:    "validDecision",
+			request: &dto.DPApprovalDecisionRequest{DemandPartnerID: "dp", Domains: domains, Status: dto.DPApprovalStatusRejected},
+			domains: domains,
+			want:    []string{},
+		},
+		{
+			name:    "noDomains",
+			request: &dto.DPApprovalSendRequest{DemandPartnerID: "dp"},
+			want:    []string{dpApprovalDomainsErrorMessage},
+		},
+		{
+			name: "missingFields",
+			request: &dto.DPApprovalSendRequest{
+				Domains: []dto.DPApprovalDomain{{PublisherID: "999"}},
+			},
+			domains: []dto.DPApprovalDomain{{PublisherID: "999"}},
+			want: []string{
+				"DemandPartnerID is mandatory, validation failed",
+				"Domain is mandatory, validation failed",
+			},
+		},
+		{
+			name:    "wrongStatus",
+			request: &dto.DPApprovalDecisionRequest{DemandPartnerID: "dp", Domains: domains, Status: dto.DPApprovalStatusSent},
+			domains: domains,
+			want:    []string{dpApprovalStatusErrorMessage},
+		},
+		{
+			name:    "duplicateDomain",
+			request: &dto.DPApprovalSendRequest{DemandPartnerID: "dp", Domains: append(domains, domains[0])},
+			domains: append(domains, domains[0]),
+			want:    []string{dpApprovalDomainDuplicateErrorMessage},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validateDPApproval(tt.request, tt.domains)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
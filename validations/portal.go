@@ -0,0 +1,141 @@
+package validations
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/m6yf/bcwork/dto"
+)
+
+// portalHourlyRange limits hourly reports of the portal to keep queries of publisher_hourly cheap
+const portalHourlyRange = 7 * 24 * time.Hour
+
+func ValidatePortal(c *fiber.Ctx) error {
+	var request *dto.PortalRequest
+	err := c.BodyParser(&request)
+	if err != nil || request == nil || request.PublisherID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid request body for Portal. Please ensure it's a valid JSON with publisher_id.",
+		})
+	}
+
+	return c.Next()
+}
+
+func ValidatePortalReport(c *fiber.Ctx) error {
+	var request *dto.PortalReportRequest
+	err := c.BodyParser(&request)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid request body for Portal Report. Please ensure it's a valid JSON.",
+		})
+	}
+
+	return portalValidationResponse(c, "Portal Report", validatePortalReport(request))
+}
+
+func ValidatePortalTags(c *fiber.Ctx) error {
+	var request *dto.PortalTagsRequest
+	err := c.BodyParser(&request)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid request body for Portal Tags. Please ensure it's a valid JSON.",
+		})
+	}
+
+	return portalValidationResponse(c, "Portal Tags", validatePortalRequest(request))
+}
+
+func ValidatePortalStatement(c *fiber.Ctx) error {
+	var request *dto.PortalStatementRequest
+	err := c.BodyParser(&request)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid request body for Portal Statement. Please ensure it's a valid JSON.",
+		})
+	}
+
+	return portalValidationResponse(c, "Portal Statement", validatePortalStatement(request))
+}
+
+func ValidateNotificationPreference(c *fiber.Ctx) error {
+	var request *dto.PublisherNotificationPreference
+	err := c.BodyParser(&request)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid request body for Notification Preference. Please ensure it's a valid JSON.",
+		})
+	}
+
+	return portalValidationResponse(c, "Notification Preference", validatePortalRequest(request))
+}
+
+func portalValidationResponse(c *fiber.Ctx, name string, validationErrors []string) error {
+	if len(validationErrors) > 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(errorResponse{
+			Status:  errorStatus,
+			Message: fmt.Sprintf("could not validate %v request", name),
+			Errors:  validationErrors,
+		})
+	}
+
+	return c.Next()
+}
+
+func validatePortalReport(request *dto.PortalReportRequest) []string {
+	validationErrors := validatePortalRequest(request)
+
+	if request != nil && request.Granularity == dto.PortalGranularityHourly && request.To.Sub(request.From) > portalHourlyRange {
+		validationErrors = append(validationErrors, portalHourlyRangeErrorMessage)
+	}
+
+	return validationErrors
+}
+
+func validatePortalStatement(request *dto.PortalStatementRequest) []string {
+	validationErrors := validatePortalRequest(request)
+
+	// months in YYYYMM format are compared as strings
+	if len(validationErrors) == 0 && request.From > request.To {
+		validationErrors = append(validationErrors, portalStatementRangeErrorMessage)
+	}
+
+	return validationErrors
+}
+
+func validatePortalRequest(request any) []string {
+	var errorMessages = map[string]string{
+		portalGranularityValidationKey: portalGranularityErrorMessage,
+		"email":                        emailErrorMessage,
+		"datetime":                     portalMonthErrorMessage,
+		"gtfield":                      portalReportRangeErrorMessage,
+	}
+
+	validationErrors := make([]string, 0)
+
+	err := Validator.Struct(request)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			if msg, ok := errorMessages[err.Tag()]; ok {
+				validationErrors = append(validationErrors, msg)
+			} else {
+				validationErrors = append(validationErrors,
+					fmt.Sprintf("%s is mandatory, validation failed", err.Field()))
+			}
+		}
+	}
+
+	return validationErrors
+}
+
+func portalGranularityValidation(fl validator.FieldLevel) bool {
+	granularity := fl.Field().String()
+	return granularity == "" || granularity == dto.PortalGranularityDaily || granularity == dto.PortalGranularityHourly
+}
@@ -0,0 +1,105 @@
+package validations
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m6yf/bcwork/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_validatePortalReport(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		request *dto.PortalReportRequest
+		want    []string
+	}{
+		{
+			name:    "valid",
+			request: &dto.PortalReportRequest{PublisherID: "1", From: from, To: from.AddDate(0, 1, 0)},
+			want:    []string{},
+		},
+		{
+			name:    "validHourly",
+			request: &dto.PortalReportRequest{PublisherID: "1", Granularity: dto.PortalGranularityHourly, From: from, To: from.AddDate(0, 0, 7)},
+			want:    []string{},
+		},
+		{
+			name:    "hourlyRangeTooLong",
+			request: &dto.PortalReportRequest{PublisherID: "1", Granularity: dto.PortalGranularityHourly, From: from, To: from.AddDate(0, 0, 8)},
+			want:    []string{portalHourlyRangeErrorMessage},
+		},
+		{
+			name:    "invalidGranularity",
+			request: &dto.PortalReportRequest{PublisherID: "1", Granularity: "weekly", From: from, To: from.AddDate(0, 0, 1)},
+			want:    []string{portalGranularityErrorMessage},
+		},
+		{
+			name:    "toBeforeFrom",
+			request: &dto.PortalReportRequest{PublisherID: "1", From: from, To: from.AddDate(0, 0, -1)},
+			want:    []string{portalReportRangeErrorMessage},
+		},
+		{
+			name:    "missingPublisher",
+			request: &dto.PortalReportRequest{From: from, To: from.AddDate(0, 0, 1)},
+			want:    []string{"PublisherID is mandatory, validation failed"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validatePortalReport(tt.request)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_validatePortalStatement(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		request *dto.PortalStatementRequest
+		want    []string
+	}{
+		{
+			name:    "valid",
+			request: &dto.PortalStatementRequest{PublisherID: "1", From: "202501", To: "202505"},
+			want:    []string{},
+		},
+		{
+			name:    "invalidMonth",
+			request: &dto.PortalStatementRequest{PublisherID: "1", From: "2025-01", To: "202505"},
+			want:    []string{portalMonthErrorMessage},
+		},
+		{
+			name:    "fromAfterTo",
+			request: &dto.PortalStatementRequest{PublisherID: "1", From: "202506", To: "202505"},
+			want:    []string{portalStatementRangeErrorMessage},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validatePortalStatement(tt.request)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_validateNotificationPreference(t *testing.T) {
+	t.Parallel()
+
+	got := validatePortalRequest(&dto.PublisherNotificationPreference{PublisherID: "1", Emails: []string{"billing@example.com", "billing"}})
+	assert.Equal(t, []string{emailErrorMessage}, got)
+}
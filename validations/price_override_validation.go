@@ -2,8 +2,10 @@ package validations
 
 import (
 	"fmt"
+
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
+	"github.com/m6yf/bcwork/core"
 	"github.com/m6yf/bcwork/dto"
 )
 
@@ -32,8 +34,9 @@ func ValidatePriceOverride(c *fiber.Ctx) error {
 
 func validatePriceOverride(request *dto.PriceOverrideRequest) []string {
 	var errorMessages = map[string]string{
-		ipsKey:           duplicateIpsErrorMessage,
-		overridePriceKey: overridePriceErrorMessage,
+		ipsKey:                duplicateIpsErrorMessage,
+		overridePriceKey:      overridePriceErrorMessage,
+		ipOrCidrValidationKey: ipOrCidrErrorMessage,
 	}
 
 	validationErrors := make([]string, 0)
@@ -52,3 +55,8 @@ func validatePriceOverride(request *dto.PriceOverrideRequest) []string {
 
 	return validationErrors
 }
+
+func ipOrCidrValidation(fl validator.FieldLevel) bool {
+	_, err := core.NormalizeOverrideIP(fl.Field().String())
+	return err == nil
+}
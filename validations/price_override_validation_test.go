@@ -0,0 +1,71 @@
+package validations
+
+import (
+	"testing"
+
+	"github.com/m6yf/bcwork/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_validatePriceOverride(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		request *dto.PriceOverrideRequest
+		want    []string
+	}{
+		{
+			name: "valid",
+			request: &dto.PriceOverrideRequest{
+				Domain:   "oms.com",
+				TTLHours: 24,
+				Ips:      []dto.Ips{{IP: "192.168.1.1", Price: 2}, {IP: "10.0.0.0/24", Price: 1}},
+			},
+			want: []string{},
+		},
+		{
+			name: "invalidIP",
+			request: &dto.PriceOverrideRequest{
+				Domain: "oms.com",
+				Ips:    []dto.Ips{{IP: "localhost", Price: 2}},
+			},
+			want: []string{ipOrCidrErrorMessage},
+		},
+		{
+			name: "duplicateIPs",
+			request: &dto.PriceOverrideRequest{
+				Domain: "oms.com",
+				Ips:    []dto.Ips{{IP: "192.168.1.1", Price: 2}, {IP: "192.168.1.1", Price: 3}},
+			},
+			want: []string{duplicateIpsErrorMessage},
+		},
+		{
+			name: "priceOutOfRange",
+			request: &dto.PriceOverrideRequest{
+				Domain: "oms.com",
+				Ips:    []dto.Ips{{IP: "192.168.1.1", Price: 11}},
+			},
+			want: []string{overridePriceErrorMessage},
+		},
+		{
+			name: "ttlTooLong",
+			request: &dto.PriceOverrideRequest{
+				Domain:   "oms.com",
+				TTLHours: 1000,
+				Ips:      []dto.Ips{{IP: "192.168.1.1", Price: 2}},
+			},
+			want: []string{"TTLHours is mandatory, validation failed"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validatePriceOverride(tt.request)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
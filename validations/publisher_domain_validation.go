@@ -7,6 +7,7 @@ import (
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
 	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/modules/automation"
 )
 
 func PublisherDomainValidation(c *fiber.Ctx) error {
@@ -52,5 +53,18 @@ func validatePublisherDomain(request *dto.PublisherDomainUpdateRequest) []string
 		}
 	}
 
+	if request.AutomationStrategy != nil || len(request.AutomationParams) > 0 {
+		var strategy string
+		if request.AutomationStrategy != nil {
+			strategy = *request.AutomationStrategy
+		}
+
+		err := automation.Validate(strategy, request.AutomationParams)
+		if err != nil {
+			validationErrors = append(validationErrors,
+				fmt.Sprintf("%s, allowed strategies: %s", err.Error(), strings.Join(automation.Names(), ",")))
+		}
+	}
+
 	return validationErrors
 }
@@ -1,9 +1,11 @@
 package validations
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/utils/helpers"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -41,6 +43,44 @@ func Test_validatePublisherDomain(t *testing.T) {
 				// "integration type must be in allowed list: oRTB,Prebid Server,Amazon APS",
 			},
 		},
+		{
+			name: "validAutomationStrategy",
+			args: args{
+				request: &dto.PublisherDomainUpdateRequest{
+					PublisherID:        "publisher",
+					Domain:             "domain.com",
+					AutomationStrategy: helpers.GetPointerToString("proportional"),
+					AutomationParams:   json.RawMessage(`{"gain": 0.5, "min_factor": 0.6}`),
+				},
+			},
+			want: []string{},
+		},
+		{
+			name: "unknownAutomationStrategy",
+			args: args{
+				request: &dto.PublisherDomainUpdateRequest{
+					PublisherID:        "publisher",
+					Domain:             "domain.com",
+					AutomationStrategy: helpers.GetPointerToString("unknown"),
+				},
+			},
+			want: []string{
+				"unknown factor automation strategy [unknown], allowed strategies: bandit,proportional,step_ladder",
+			},
+		},
+		{
+			name: "invalidAutomationBounds",
+			args: args{
+				request: &dto.PublisherDomainUpdateRequest{
+					PublisherID:      "publisher",
+					Domain:           "domain.com",
+					AutomationParams: json.RawMessage(`{"min_factor": 2, "max_factor": 1}`),
+				},
+			},
+			want: []string{
+				"min_factor must not be greater than max_factor, allowed strategies: bandit,proportional,step_ladder",
+			},
+		},
 	}
 
 	for _, tt := range tests {
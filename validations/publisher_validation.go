@@ -7,6 +7,7 @@ import (
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
 	"github.com/m6yf/bcwork/dto"
+	sellersjson "github.com/m6yf/bcwork/modules/sellers_json"
 )
 
 func CreatePublisherValidation(c *fiber.Ctx) error {
@@ -59,6 +60,57 @@ func validatePublisher(request interface{}) []string {
 	var errorMessages = map[string]string{
 		intergrationTypeValidationKey: intergrationTypeErrorMessage + ": " + strings.Join(integrationTypes, ","),
 		mediaTypeValidationKey:        mediaTypeErrorMessage + ": " + strings.Join(mediaTypes, ","),
+		sellerTypeValidationKey:       sellerTypeErrorMessage + ": " + strings.Join(sellersjson.SellerTypes, ","),
+		publisherStatusValidationKey:  publisherStatusErrorMessage + ": " + strings.Join(dto.PublisherStatuses, ","),
+	}
+
+	validationErrors := make([]string, 0)
+
+	err := Validator.Struct(request)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			if msg, ok := errorMessages[err.Tag()]; ok {
+				validationErrors = append(validationErrors, msg)
+			} else {
+				validationErrors = append(validationErrors,
+					fmt.Sprintf("%s is mandatory, validation failed", err.Field()))
+			}
+		}
+	}
+
+	return validationErrors
+}
+
+func publisherStatusValidation(fl validator.FieldLevel) bool {
+	return dto.IsPublisherStatus(fl.Field().String())
+}
+
+func ValidatePublisherLifecycleReport(c *fiber.Ctx) error {
+	request := new(dto.PublisherLifecycleReportRequest)
+	err := c.BodyParser(&request)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid request body for publisher lifecycle report. Please ensure it's a valid JSON.",
+		})
+	}
+
+	validationErrors := validatePublisherLifecycleReport(request)
+
+	if len(validationErrors) > 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(errorResponse{
+			Status:  errorStatus,
+			Message: "could not validate publisher lifecycle report request",
+			Errors:  validationErrors,
+		})
+	}
+
+	return c.Next()
+}
+
+func validatePublisherLifecycleReport(request *dto.PublisherLifecycleReportRequest) []string {
+	var errorMessages = map[string]string{
+		"gtfield": publisherLifecycleRangeErrorMessage,
 	}
 
 	validationErrors := make([]string, 0)
@@ -2,8 +2,10 @@ package validations
 
 import (
 	"testing"
+	"time"
 
 	"github.com/m6yf/bcwork/dto"
+	sellersjson "github.com/m6yf/bcwork/modules/sellers_json"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -63,6 +65,52 @@ func Test_validatePublisher(t *testing.T) {
 			},
 			want: []string{},
 		},
+		{
+			name: "valid_sellerTypeUpdateRequest",
+			args: args{
+				request: &dto.UpdatePublisherValues{
+					SellerType: func() *string {
+						s := sellersjson.SellerTypeBoth
+
+						return &s
+					}(),
+				},
+			},
+			want: []string{},
+		},
+		{
+			name: "invalid_sellerTypeCreateRequest",
+			args: args{
+				request: &dto.PublisherCreateValues{
+					Name:       "publisher",
+					SellerType: "RESELLER",
+				},
+			},
+			want: []string{"seller type must be in allowed list: PUBLISHER,INTERMEDIARY,BOTH"},
+		},
+		{
+			name: "valid_statusUpdateRequest",
+			args: args{
+				request: &dto.UpdatePublisherValues{
+					Status: func() *string {
+						s := "Paused"
+
+						return &s
+					}(),
+				},
+			},
+			want: []string{},
+		},
+		{
+			name: "invalid_statusCreateRequest",
+			args: args{
+				request: &dto.PublisherCreateValues{
+					Name:   "publisher",
+					Status: "Live",
+				},
+			},
+			want: []string{"publisher status must be in allowed list: onboarding,active,paused,churned"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -75,3 +123,41 @@ func Test_validatePublisher(t *testing.T) {
 		})
 	}
 }
+
+func Test_validatePublisherLifecycleReport(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		request *dto.PublisherLifecycleReportRequest
+		want    []string
+	}{
+		{
+			name:    "valid",
+			request: &dto.PublisherLifecycleReportRequest{PublisherIDs: []string{"1"}, From: from, To: from.AddDate(0, 1, 0)},
+			want:    []string{},
+		},
+		{
+			name:    "toBeforeFrom",
+			request: &dto.PublisherLifecycleReportRequest{From: from, To: from.AddDate(0, -1, 0)},
+			want:    []string{publisherLifecycleRangeErrorMessage},
+		},
+		{
+			name:    "missingFrom",
+			request: &dto.PublisherLifecycleReportRequest{To: from},
+			want:    []string{"From is mandatory, validation failed"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validatePublisherLifecycleReport(tt.request)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
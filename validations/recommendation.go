@@ -0,0 +1,82 @@
+package validations
+
+import (
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/m6yf/bcwork/core/recommendation"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/utils/constant"
+)
+
+func ValidateRecommendationOptions(c *fiber.Ctx) error {
+	body := new(recommendation.GetRecommendationOptions)
+	err := c.BodyParser(&body)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid request body for recommendations. Please ensure it's a valid JSON.",
+		})
+	}
+
+	validationErrors := validateRecommendation(body)
+
+	if len(validationErrors) > 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(errorResponse{
+			Status:  errorStatus,
+			Message: "could not validate recommendation options",
+			Errors:  validationErrors,
+		})
+	}
+
+	return c.Next()
+}
+
+func ValidateRecommendationApply(c *fiber.Ctx) error {
+	body := new(dto.RecommendationApplyRequest)
+	err := c.BodyParser(&body)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid request body for recommendations apply. Please ensure it's a valid JSON.",
+		})
+	}
+
+	validationErrors := validateRecommendation(body)
+
+	if len(validationErrors) > 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(errorResponse{
+			Status:  errorStatus,
+			Message: "could not validate recommendations to apply",
+			Errors:  validationErrors,
+		})
+	}
+
+	return c.Next()
+}
+
+func validateRecommendation(request any) []string {
+	var errorMessages = map[string]string{
+		"country": countryValidationErrorMessage,
+		"device":  deviceValidationErrorMessage,
+		"floor":   "floor must be greater or equal to 0",
+		"factor":  fmt.Sprintf("factor must be >= %.2f and <= %.2f", constant.MinFactorValue, constant.MaxFactorValue),
+	}
+
+	validationErrors := make([]string, 0)
+
+	err := Validator.Struct(request)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			if msg, ok := errorMessages[err.Tag()]; ok {
+				validationErrors = append(validationErrors, msg)
+			} else {
+				validationErrors = append(validationErrors,
+					fmt.Sprintf("%s is mandatory, validation failed", err.Field()))
+			}
+		}
+	}
+
+	return validationErrors
+}
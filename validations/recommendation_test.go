@@ -0,0 +1,77 @@
+package validations
+
+import (
+	"testing"
+
+	"github.com/m6yf/bcwork/core/recommendation"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_validateRecommendation(t *testing.T) {
+	t.Parallel()
+
+	floor := 0.5
+	negativeFloor := -1.0
+	factor := 0.8
+	tooHighFactor := 11.0
+	maxFillRateDrop := 1.5
+
+	tests := []struct {
+		name    string
+		request any
+		want    []string
+	}{
+		{
+			name:    "validOptions",
+			request: &recommendation.GetRecommendationOptions{LookbackDays: 14, MinFillRate: 0.05},
+			want:    []string{},
+		},
+		{
+			name:    "invalidOptions",
+			request: &recommendation.GetRecommendationOptions{LookbackDays: 365, MaxFillRateDrop: &maxFillRateDrop},
+			want: []string{
+				"LookbackDays is mandatory, validation failed",
+				"MaxFillRateDrop is mandatory, validation failed",
+			},
+		},
+		{
+			name: "validApply",
+			request: &dto.RecommendationApplyRequest{
+				Items: []dto.RecommendationApplyItem{
+					{Publisher: "1", Domain: "oms.com", Country: "us", Device: "mobile", Floor: &floor, Factor: &factor},
+					{Publisher: "1", Domain: "oms.com", Country: "il", Device: "desktop", Floor: &floor},
+				},
+			},
+			want: []string{},
+		},
+		{
+			name: "invalidApplyItem",
+			request: &dto.RecommendationApplyRequest{
+				Items: []dto.RecommendationApplyItem{
+					{Publisher: "1", Domain: "oms.com", Country: "usa", Device: "mobile", Floor: &negativeFloor, Factor: &tooHighFactor},
+				},
+			},
+			want: []string{
+				countryValidationErrorMessage,
+				"floor must be greater or equal to 0",
+				"factor must be >= 0.01 and <= 10.00",
+			},
+		},
+		{
+			name:    "emptyApply",
+			request: &dto.RecommendationApplyRequest{},
+			want:    []string{"Items is mandatory, validation failed"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validateRecommendation(tt.request)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -55,3 +55,32 @@ func validateUser(request *dto.User) []string {
 
 	return validationErrors
 }
+
+func ValidateUserPublishers(c *fiber.Ctx) error {
+	var request *dto.UserPublishers
+	err := c.BodyParser(&request)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Invalid request body for User Publishers. Please ensure it's a valid JSON.",
+		})
+	}
+
+	validationErrors := make([]string, 0)
+	err = Validator.Struct(request)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			validationErrors = append(validationErrors, fmt.Sprintf("%s is mandatory, validation failed", err.Field()))
+		}
+	}
+
+	if len(validationErrors) > 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(errorResponse{
+			Status:  errorStatus,
+			Message: "could not validate User Publishers request",
+			Errors:  validationErrors,
+		})
+	}
+
+	return c.Next()
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/go-playground/validator/v10"
 	"github.com/m6yf/bcwork/dto"
+	sellersjson "github.com/m6yf/bcwork/modules/sellers_json"
 	supertokens_module "github.com/m6yf/bcwork/modules/supertokens"
 	"github.com/m6yf/bcwork/utils/constant"
 )
@@ -26,13 +27,32 @@ const (
 	approvalProcessKey               = "approvalProcess"
 	dpBlocksKey                      = "dpBlocks"
 	dpThresholdKey                   = "dpThreshold"
+	dpoAutomationConfigKey           = "dpoAutomationConfig"
 	bidCachingControlPercentageKey   = "bccp"
 	mediaTypeValidationKey           = "mediaType"
 	intergrationTypeValidationKey    = "integrationType"
 	adsTxtDomainStatusValidationKey  = "adsTxtDomainStatus"
 	adsTxtDemandStatusValidationKey  = "adsTxtDemandStatus"
+	adsTxtFileNameValidationKey      = "adsTxtFileName"
+	sellerTypeValidationKey          = "sellerType"
 	ipsKey                           = "duplicateIps"
 	overridePriceKey                 = "overridePriceKey"
+	approvalSubjectValidationKey     = "approvalSubject"
+	blockTypeValidationKey           = "blockType"
+	bcatValidationKey                = "bcat"
+	badvValidationKey                = "badv"
+	ipOrCidrValidationKey            = "ipOrCidr"
+	automationValidationKey          = "automation"
+	alertSourceValidationKey         = "alertSource"
+	alertComparisonValidationKey     = "alertComparison"
+	alertSeverityValidationKey       = "alertSeverity"
+	alertChannelValidationKey        = "alertChannel"
+	alertOffsetValidationKey         = "alertOffset"
+	alertCronValidationKey           = "alertCron"
+	alertQueryValidationKey          = "alertQuery"
+	permissionValidationKey          = "permission"
+	portalGranularityValidationKey   = "portalGranularity"
+	publisherStatusValidationKey     = "publisherStatus"
 
 	// Error messages
 	countryValidationErrorMessage            = "country code must be 2 characters long and should be in the allowed list"
@@ -45,13 +65,48 @@ const (
 	userTypesValidationErrorMessage          = "user types must be in allowed list"
 	approvalProcessErrorMessage              = "approval process must be in allowed list"
 	dpBlocksErrorMessage                     = "dp blocks must be in allowed list"
+	dpoAutomationConfigErrorMessage          = "dpo automation config levels must have max_erpm_ratio in (0,1] and factor in (0,100], hysteresis must be in [0,1], hours must be >= 0 and exploration factor in [0,100)"
 	bidCachingControlPercentageErrorMessage  = "bid caching control percentage must be from 0 to 1"
 	mediaTypeErrorMessage                    = "media type must be in allowed list"
 	intergrationTypeErrorMessage             = "integration type must be in allowed list"
 	adsTxtDomainStatusErrorMessage           = "ads.txt domain status must be in allowed list"
 	adsTxtDemandStatusErrorMessage           = "ads.txt demand status must be in allowed list"
+	adsTxtFileNameErrorMessage               = "ads.txt file name must be 'ads.txt' or 'app-ads.txt'"
+	sellerTypeErrorMessage                   = "seller type must be in allowed list"
 	duplicateIpsErrorMessage                 = "can't have duplicate Ips in request"
 	overridePriceErrorMessage                = "price must be between 1 and 10"
+	approvalSubjectErrorMessage              = "approval policy subject must be in allowed list"
+	automationErrorMessage                   = "automation must be in allowed list"
+	blockTypeErrorMessage                    = "block type must be 'badv' or 'bcat'"
+	bcatErrorMessage                         = "bcat must be a category id from IAB content taxonomy"
+	badvErrorMessage                         = "badv must be a valid advertiser domain"
+	ipOrCidrErrorMessage                     = "ip must be a valid IP address or CIDR range"
+	alertSourceErrorMessage                  = "alert source must be in allowed list"
+	alertComparisonErrorMessage              = "alert comparison must be in allowed list"
+	alertSeverityErrorMessage                = "alert severity must be in allowed list"
+	alertChannelErrorMessage                 = "alert channel must be in allowed list"
+	alertOffsetErrorMessage                  = "alert baseline offset must be a positive duration, e.g. 24h"
+	alertCronErrorMessage                    = "alert cron must be a valid cron expression"
+	alertQueryErrorMessage                   = "alert query must be a single SELECT statement without comments, data or schema changes"
+	alertBaselineErrorMessage                = "ratio comparison requires at least one baseline offset"
+	alertSnoozeErrorMessage                  = "snooze time must be in the future"
+	permissionErrorMessage                   = "scope must be a permission in resource:action format with action 'read', 'write', 'delete' or '*'"
+	apiKeyExpiresAtErrorMessage              = "api key expiration time must be in the future"
+	portalGranularityErrorMessage            = "granularity must be 'daily' or 'hourly'"
+	portalHourlyRangeErrorMessage            = "hourly report range must not exceed 7 days"
+	portalStatementRangeErrorMessage         = "statement 'from' month must not be after 'to' month"
+	portalMonthErrorMessage                  = "statement months must be in YYYYMM format"
+	portalReportRangeErrorMessage            = "report 'to' time must be after 'from' time"
+	emailErrorMessage                        = "emails must be valid email addresses"
+	auditLogCreatedAtErrorMessage            = "created_at filter is required"
+	auditLogRangeErrorMessage                = "created_at range must not exceed 93 days"
+	publisherStatusErrorMessage              = "publisher status must be in allowed list"
+	publisherLifecycleRangeErrorMessage      = "report 'to' time must be after 'from' time"
+	compassSyncKeepErrorMessage              = "keep must be 'local' or 'compass'"
+	compassManagerDuplicateErrorMessage      = "compass id must be unique in request"
+	dpApprovalDomainsErrorMessage            = "at least one domain is mandatory"
+	dpApprovalDomainDuplicateErrorMessage    = "domains must be unique in request"
+	dpApprovalStatusErrorMessage             = "status must be 'approved' or 'rejected'"
 )
 
 var (
@@ -90,6 +145,9 @@ var (
 	adsTxtDomainStatuses = []string{
 		dto.DomainStatusActive, dto.DomainStatusNew, dto.DomainStatusPaused,
 	}
+	adsTxtFileNames = []string{
+		dto.AdsTxtFileName, dto.AppAdsTxtFileName,
+	}
 	adsTxtDemandStatuses = []string{
 		dto.DPStatusPending, dto.DPStatusApproved, dto.DPStatusApprovedPaused,
 		dto.DPStatusRejected, dto.DPStatusRejectedTQ, dto.DPStatusDisabledSPO,
@@ -199,6 +257,10 @@ func init() {
 	if err != nil {
 		return
 	}
+	err = Validator.RegisterValidation(dpoAutomationConfigKey, dpoAutomationConfigValidation, true)
+	if err != nil {
+		return
+	}
 	err = Validator.RegisterValidation(bidCachingControlPercentageKey, bidCachingControlPercentageValidation, true)
 	if err != nil {
 		return
@@ -219,6 +281,14 @@ func init() {
 	if err != nil {
 		return
 	}
+	err = Validator.RegisterValidation(adsTxtFileNameValidationKey, adsTxtFileNameValidation)
+	if err != nil {
+		return
+	}
+	err = Validator.RegisterValidation(sellerTypeValidationKey, sellerTypeValidation)
+	if err != nil {
+		return
+	}
 	err = Validator.RegisterValidation(ipsKey, duplicateIpsValidation)
 	if err != nil {
 		return
@@ -227,6 +297,70 @@ func init() {
 	if err != nil {
 		return
 	}
+	err = Validator.RegisterValidation(approvalSubjectValidationKey, approvalSubjectValidation)
+	if err != nil {
+		return
+	}
+	err = Validator.RegisterValidation(automationValidationKey, automationValidation)
+	if err != nil {
+		return
+	}
+	err = Validator.RegisterValidation(blockTypeValidationKey, blockTypeValidation)
+	if err != nil {
+		return
+	}
+	err = Validator.RegisterValidation(bcatValidationKey, bcatValidation)
+	if err != nil {
+		return
+	}
+	err = Validator.RegisterValidation(badvValidationKey, badvValidation)
+	if err != nil {
+		return
+	}
+	err = Validator.RegisterValidation(ipOrCidrValidationKey, ipOrCidrValidation)
+	if err != nil {
+		return
+	}
+	err = Validator.RegisterValidation(alertSourceValidationKey, alertSourceValidation)
+	if err != nil {
+		return
+	}
+	err = Validator.RegisterValidation(alertComparisonValidationKey, alertComparisonValidation)
+	if err != nil {
+		return
+	}
+	err = Validator.RegisterValidation(alertSeverityValidationKey, alertSeverityValidation)
+	if err != nil {
+		return
+	}
+	err = Validator.RegisterValidation(alertChannelValidationKey, alertChannelValidation)
+	if err != nil {
+		return
+	}
+	err = Validator.RegisterValidation(alertOffsetValidationKey, alertOffsetValidation)
+	if err != nil {
+		return
+	}
+	err = Validator.RegisterValidation(alertCronValidationKey, alertCronValidation)
+	if err != nil {
+		return
+	}
+	err = Validator.RegisterValidation(alertQueryValidationKey, alertQueryValidation)
+	if err != nil {
+		return
+	}
+	err = Validator.RegisterValidation(permissionValidationKey, permissionValidation)
+	if err != nil {
+		return
+	}
+	err = Validator.RegisterValidation(portalGranularityValidationKey, portalGranularityValidation)
+	if err != nil {
+		return
+	}
+	err = Validator.RegisterValidation(publisherStatusValidationKey, publisherStatusValidation)
+	if err != nil {
+		return
+	}
 }
 
 func floorValidation(fl validator.FieldLevel) bool {
@@ -433,6 +567,17 @@ func dpThresholdValidation(fl validator.FieldLevel) bool {
 	return val >= constant.MinThreshold && val <= constant.MaxThreshold
 }
 
+func dpoAutomationConfigValidation(fl validator.FieldLevel) bool {
+	config, ok := fl.Field().Interface().(*dto.DPOAutomationConfig)
+	if !ok {
+		if value, isValue := fl.Field().Interface().(dto.DPOAutomationConfig); isValue {
+			config = &value
+		}
+	}
+
+	return config == nil || config.IsValid()
+}
+
 func bidCachingControlPercentageValidation(fl validator.FieldLevel) bool {
 	var val float64
 	if fl.Field().Kind() == reflect.Ptr {
@@ -496,6 +641,16 @@ func mediaTypeValidation(fl validator.FieldLevel) bool {
 	return true
 }
 
+// sellerTypeValidation allows empty seller type, then it's derived from is_direct
+func sellerTypeValidation(fl validator.FieldLevel) bool {
+	sellerType := fl.Field().String()
+	return sellerType == "" || slices.Contains(sellersjson.SellerTypes, sellerType)
+}
+
+func adsTxtFileNameValidation(fl validator.FieldLevel) bool {
+	return slices.Contains(adsTxtFileNames, fl.Field().String())
+}
+
 func adsTxtDemandStatusValidation(fl validator.FieldLevel) bool {
 	var val string
 	if fl.Field().Kind() == reflect.Ptr {
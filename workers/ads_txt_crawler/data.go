@@ -0,0 +1,139 @@
+package ads_txt_crawler
+
+import (
+	"context"
+	"time"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/models"
+	"github.com/rotisserie/eris"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// adsTxtLinesQuery returns expected lines of domains which are not paused
+const adsTxtLinesQuery = `
+	select
+		at2.id,
+		at2.publisher_id,
+		at2."domain",
+		at2.status,
+		at2.demand_status,
+		at2.domain_status,
+		at2.scan_result,
+		at2.retries,
+		at2.status_changed_at,
+		at2.seat_owner_id is not null as is_seat_owner,
+		coalesce(dpc.media_type, dpc3.media_type) as media_type,
+		p.media_type as publisher_media_type,
+		coalesce(dpc.dp_domain, dpc2.dp_domain, so.seat_owner_domain) as ads_txt_domain,
+		coalesce(dpc.publisher_account, dpc2.publisher_account, replace(so.publisher_account, '%s', at2.publisher_id)) as publisher_account,
+		coalesce(dpc.is_direct, dpc2.is_direct, true) as is_direct,
+		coalesce(dpc.certification_authority_id, dpc2.certification_authority_id, so.certification_authority_id) as certification_authority_id
+	from ads_txt at2
+		join publisher p on p.publisher_id = at2.publisher_id
+		left join demand_partner_connection dpc on dpc.id = at2.demand_partner_connection_id
+		left join demand_partner_child dpc2 on dpc2.id = at2.demand_partner_child_id
+		left join demand_partner_connection dpc3 on dpc3.id = dpc2.dp_connection_id
+		left join seat_owner so on so.id = at2.seat_owner_id
+	where at2.domain_status <> $1
+	order by at2.publisher_id, at2."domain", at2.id
+`
+
+// getDomainLines returns expected lines grouped by publisher domain
+func getDomainLines(ctx context.Context) ([][]*adsTxtLine, error) {
+	var lines []*adsTxtLine
+	err := queries.Raw(adsTxtLinesQuery, dto.DomainStatusPaused).Bind(ctx, bcdb.DB(), &lines)
+	if err != nil {
+		return nil, eris.Wrap(err, "failed to retrieve ads.txt lines")
+	}
+
+	domains := make([][]*adsTxtLine, 0)
+	for i, line := range lines {
+		if i == 0 || line.PublisherID != lines[i-1].PublisherID || line.Domain != lines[i-1].Domain {
+			domains = append(domains, make([]*adsTxtLine, 0))
+		}
+		domains[len(domains)-1] = append(domains[len(domains)-1], line)
+	}
+
+	return domains, nil
+}
+
+// saveDomainScan saves fetch result of domain file with its OWNERDOMAIN and MANAGERDOMAIN declarations
+func saveDomainScan(
+	ctx context.Context,
+	publisherID, domain, fileName string,
+	document *Document,
+	lines []*adsTxtLine,
+	fetchErr error,
+	now time.Time,
+) error {
+	mod := &models.AdsTXTScan{
+		PublisherID: publisherID,
+		Domain:      domain,
+		FileName:    fileName,
+		ScannedAt:   now,
+		CreatedAt:   now,
+		UpdatedAt:   null.TimeFrom(now),
+	}
+
+	if fetchErr != nil {
+		mod.ErrorMessage = null.StringFrom(truncate(fetchErr.Error(), scanErrorMaxLength))
+	}
+
+	if document != nil {
+		mod.URL = null.StringFrom(document.URL)
+		mod.OwnerDomain = null.NewString(document.File.OwnerDomain(), document.File.OwnerDomain() != "")
+		mod.ManagerDomains = document.File.ManagerDomains()
+		mod.Records = len(document.File.Records)
+		for _, line := range lines {
+			scan := verifyLine(line, []*Document{document})
+			switch scan.Result {
+			case dto.AdsTxtScanResultFound:
+				mod.Found++
+			case dto.AdsTxtScanResultMismatch:
+				mod.Mismatch++
+			default:
+				mod.Missing++
+			}
+		}
+	}
+
+	err := mod.Upsert(
+		ctx,
+		bcdb.DB(),
+		true,
+		[]string{models.AdsTXTScanColumns.PublisherID, models.AdsTXTScanColumns.Domain, models.AdsTXTScanColumns.FileName},
+		boil.Blacklist(models.AdsTXTScanColumns.CreatedAt),
+		boil.Infer(),
+	)
+	if err != nil {
+		return eris.Wrapf(err, "failed to save %v scan of domain [%v]", fileName, domain)
+	}
+
+	return nil
+}
+
+func saveLines(ctx context.Context, lines []*adsTxtLine, scans map[int]*lineScan, columns map[int][]string, now time.Time) error {
+	tx, err := bcdb.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return eris.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	for _, line := range lines {
+		_, err := line.toModel(scans[line.ID], now).Update(ctx, tx, boil.Whitelist(columns[line.ID]...))
+		if err != nil {
+			return eris.Wrapf(err, "failed to update ads.txt line [%v]", line.ID)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return eris.Wrap(err, "failed to commit ads.txt lines update")
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package ads_txt_crawler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	adstxt "github.com/m6yf/bcwork/modules/ads_txt"
+	"golang.org/x/net/publicsuffix"
+)
+
+const (
+	maxFileSize = 10 << 20
+	userAgent   = "bcwork-ads-txt-crawler/1.0"
+)
+
+// Document is a fetched and parsed ads.txt file
+type Document struct {
+	URL  string
+	File *adstxt.File
+}
+
+// Fetcher fetches ads.txt files of domains
+type Fetcher struct {
+	Client *http.Client
+}
+
+// Fetch returns the file which is authoritative for the domain. For a subdomain the root domain file
+// is used unless it declares the subdomain with SUBDOMAIN variable, then the subdomain own file is used.
+func (f *Fetcher) Fetch(ctx context.Context, domain, fileName string) (*Document, error) {
+	domain = strings.ToLower(strings.TrimSpace(domain))
+
+	root, err := publicsuffix.EffectiveTLDPlusOne(domain)
+	if err != nil || root == domain {
+		return f.get(ctx, domain, fileName)
+	}
+
+	rootDocument, err := f.get(ctx, root, fileName)
+	if err != nil {
+		return f.get(ctx, domain, fileName)
+	}
+
+	if slices.Contains(rootDocument.File.Subdomains(), domain) {
+		document, err := f.get(ctx, domain, fileName)
+		if err == nil {
+			return document, nil
+		}
+	}
+
+	return rootDocument, nil
+}
+
+// get fetches the file over https falling back to http
+func (f *Fetcher) get(ctx context.Context, domain, fileName string) (*Document, error) {
+	var errs []error
+	for _, scheme := range []string{"https", "http"} {
+		url := fmt.Sprintf("%v://%v/%v", scheme, domain, fileName)
+		document, err := f.getURL(ctx, url)
+		if err == nil {
+			return document, nil
+		}
+		errs = append(errs, err)
+	}
+
+	return nil, errors.Join(errs...)
+}
+
+func (f *Fetcher) getURL(ctx context.Context, url string) (*Document, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request for %v: %w", url, err)
+	}
+	req.Header.Set("User-Agent", userAgent)
+
+	resp, err := f.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %v: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch %v: status code %v", url, resp.StatusCode)
+	}
+
+	// sites without ads.txt often respond with html page instead of 404
+	if strings.Contains(strings.ToLower(resp.Header.Get("Content-Type")), "text/html") {
+		return nil, fmt.Errorf("failed to fetch %v: html served instead of text file", url)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxFileSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %v: %w", url, err)
+	}
+
+	return &Document{
+		URL:  resp.Request.URL.String(),
+		File: adstxt.Parse(string(body)),
+	}, nil
+}
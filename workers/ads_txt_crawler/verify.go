@@ -0,0 +1,226 @@
+package ads_txt_crawler
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/models"
+	adstxt "github.com/m6yf/bcwork/modules/ads_txt"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+const (
+	errorMessageMaxLength = 256
+	validURLMaxLength     = 128
+	scanErrorMaxLength    = 512
+)
+
+// adsTxtLine is an expected ads.txt line of publisher domain with its current scan state
+type adsTxtLine struct {
+	ID                       int               `boil:"id"`
+	PublisherID              string            `boil:"publisher_id"`
+	Domain                   string            `boil:"domain"`
+	Status                   string            `boil:"status"`
+	DemandStatus             string            `boil:"demand_status"`
+	DomainStatus             string            `boil:"domain_status"`
+	ScanResult               null.String       `boil:"scan_result"`
+	Retries                  null.Int          `boil:"retries"`
+	StatusChangedAt          null.Time         `boil:"status_changed_at"`
+	IsSeatOwner              bool              `boil:"is_seat_owner"`
+	MediaType                types.StringArray `boil:"media_type"`
+	PublisherMediaType       types.StringArray `boil:"publisher_media_type"`
+	AdsTxtDomain             string            `boil:"ads_txt_domain"`
+	PublisherAccount         string            `boil:"publisher_account"`
+	IsDirect                 bool              `boil:"is_direct"`
+	CertificationAuthorityID null.String       `boil:"certification_authority_id"`
+}
+
+// lineScan is a result of checking expected line against domain files
+type lineScan struct {
+	Result string
+	Reason string
+	URL    string
+}
+
+func (l *adsTxtLine) record() *adstxt.Record {
+	relationship := dto.AdsTxtTypeReseller
+	if l.IsDirect {
+		relationship = dto.AdsTxtTypeDirect
+	}
+
+	record, ok := adstxt.ParseRecord(fmt.Sprintf("%v, %v, %v", l.AdsTxtDomain, l.PublisherAccount, relationship))
+	if !ok {
+		return nil
+	}
+	record.CertificationAuthorityID = l.CertificationAuthorityID.String
+
+	return record
+}
+
+// isInApp reports whether the line is served only to in-app inventory
+func (l *adsTxtLine) isInApp() bool {
+	return len(l.MediaType) > 0 && !slices.ContainsFunc(l.MediaType, func(mediaType string) bool {
+		return mediaType != dto.InAppMediaType
+	})
+}
+
+// fileNames returns files to verify lines of the domain against: ads.txt unless publisher is in-app only
+// and app-ads.txt when publisher or some of the lines are in-app
+func fileNames(lines []*adsTxtLine) []string {
+	if len(lines) == 0 {
+		return nil
+	}
+
+	publisherMediaType := lines[0].PublisherMediaType
+	isWeb := len(publisherMediaType) == 0 || slices.ContainsFunc(publisherMediaType, func(mediaType string) bool {
+		return mediaType != dto.InAppMediaType
+	})
+	isInApp := slices.Contains(publisherMediaType, dto.InAppMediaType) || slices.ContainsFunc(lines, (*adsTxtLine).isInApp)
+
+	names := make([]string, 0, 2)
+	if isWeb {
+		names = append(names, dto.AdsTxtFileName)
+	}
+	if isInApp {
+		names = append(names, dto.AppAdsTxtFileName)
+	}
+
+	return names
+}
+
+// verifyLine checks the line against every fetched file and returns the best result
+func verifyLine(line *adsTxtLine, documents []*Document) *lineScan {
+	record := line.record()
+	if record == nil {
+		return &lineScan{Result: dto.AdsTxtScanResultMissing, Reason: "expected line is invalid"}
+	}
+
+	scan := &lineScan{Result: dto.AdsTxtScanResultMissing}
+	for _, document := range documents {
+		result, reason := document.File.Verify(record)
+		switch {
+		case result == dto.AdsTxtScanResultFound:
+			return &lineScan{Result: result, URL: document.URL}
+		case result == dto.AdsTxtScanResultMismatch && scan.Result == dto.AdsTxtScanResultMissing:
+			scan = &lineScan{Result: result, Reason: reason, URL: document.URL}
+		}
+	}
+
+	return scan
+}
+
+// apply updates scan state of the line and returns columns to update.
+// Lines of approved demand are paused when they disappear from active domain
+// and approved again when they come back.
+func (l *adsTxtLine) apply(scan *lineScan, now time.Time) []string {
+	status := l.Status
+	switch scan.Result {
+	case dto.AdsTxtScanResultFound, dto.AdsTxtScanResultMismatch:
+		status = dto.AdsTxtStatusAdded
+	case dto.AdsTxtScanResultMissing:
+		status = dto.AdsTxtStatusNo
+		if l.Status == dto.AdsTxtStatusAdded || l.Status == dto.AdsTxtStatusDeleted {
+			status = dto.AdsTxtStatusDeleted
+		}
+	}
+
+	if status != l.Status {
+		l.Status = status
+		l.StatusChangedAt = null.TimeFrom(now)
+	}
+
+	switch {
+	case scan.Result == dto.AdsTxtScanResultMissing &&
+		l.DemandStatus == dto.DPStatusApproved &&
+		l.DomainStatus == dto.DomainStatusActive:
+		l.DemandStatus = dto.DPStatusApprovedPaused
+	case scan.Result == dto.AdsTxtScanResultFound &&
+		l.DemandStatus == dto.DPStatusApprovedPaused &&
+		l.ScanResult.String == dto.AdsTxtScanResultMissing:
+		l.DemandStatus = dto.DPStatusApproved
+	}
+
+	l.ScanResult = null.StringFrom(scan.Result)
+	l.Retries = null.IntFrom(0)
+
+	return []string{
+		models.AdsTXTColumns.Status,
+		models.AdsTXTColumns.StatusChangedAt,
+		models.AdsTXTColumns.DemandStatus,
+		models.AdsTXTColumns.DomainStatus,
+		models.AdsTXTColumns.ScanResult,
+		models.AdsTXTColumns.ErrorMessage,
+		models.AdsTXTColumns.Retries,
+		models.AdsTXTColumns.ValidURL,
+		models.AdsTXTColumns.LastScannedAt,
+		models.AdsTXTColumns.UpdatedAt,
+	}
+}
+
+// applyUnavailable counts failed fetch of domain files, after max retries lines are considered missing
+func (l *adsTxtLine) applyUnavailable(err error, maxRetries int, now time.Time) ([]string, *lineScan) {
+	retries := l.Retries.Int + 1
+	if retries < maxRetries {
+		l.Retries = null.IntFrom(retries)
+
+		return []string{models.AdsTXTColumns.Retries, models.AdsTXTColumns.UpdatedAt}, nil
+	}
+
+	scan := &lineScan{
+		Result: dto.AdsTxtScanResultMissing,
+		Reason: fmt.Sprintf("ads.txt is not available: %v", err),
+	}
+	columns := l.apply(scan, now)
+	l.Retries = null.IntFrom(retries)
+
+	return columns, scan
+}
+
+// activateDomain turns new domain into active when our seat owner line is found in its files
+func activateDomain(lines []*adsTxtLine, scans map[int]*lineScan) {
+	isFound := slices.ContainsFunc(lines, func(line *adsTxtLine) bool {
+		scan, ok := scans[line.ID]
+		return line.IsSeatOwner && ok && scan.Result == dto.AdsTxtScanResultFound
+	})
+	if !isFound {
+		return
+	}
+
+	for _, line := range lines {
+		if line.DomainStatus == dto.DomainStatusNew {
+			line.DomainStatus = dto.DomainStatusActive
+		}
+	}
+}
+
+func (l *adsTxtLine) toModel(scan *lineScan, now time.Time) *models.AdsTXT {
+	mod := &models.AdsTXT{
+		ID:              l.ID,
+		Status:          l.Status,
+		DemandStatus:    l.DemandStatus,
+		DomainStatus:    l.DomainStatus,
+		ScanResult:      l.ScanResult,
+		Retries:         l.Retries,
+		StatusChangedAt: l.StatusChangedAt,
+		UpdatedAt:       null.TimeFrom(now),
+	}
+
+	if scan != nil {
+		mod.LastScannedAt = null.TimeFrom(now)
+		mod.ValidURL = null.NewString(scan.URL, scan.URL != "" && len(scan.URL) <= validURLMaxLength)
+		mod.ErrorMessage = null.NewString(truncate(scan.Reason, errorMessageMaxLength), scan.Reason != "")
+	}
+
+	return mod
+}
+
+func truncate(value string, length int) string {
+	if len(value) <= length {
+		return value
+	}
+
+	return value[:length]
+}
@@ -0,0 +1,154 @@
+package ads_txt_crawler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/config"
+	adstxt "github.com/m6yf/bcwork/modules/ads_txt"
+	"github.com/m6yf/bcwork/utils/bccron"
+	"github.com/m6yf/bcwork/utils/constant"
+	"github.com/rotisserie/eris"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/sync/errgroup"
+)
+
+// Worker fetches ads.txt and app-ads.txt of publisher domains, verifies expected lines
+// and updates their status, demand status and domain status
+type Worker struct {
+	DatabaseEnv  string `json:"dbenv"`
+	Cron         string `json:"cron"`
+	Concurrency  int    `json:"concurrency"`
+	MaxRetries   int    `json:"max_retries"`
+	skipInitRun  bool
+	fetcher      *Fetcher
+	adsTxtModule adstxt.AdsTxtManager
+}
+
+func (w *Worker) Init(ctx context.Context, conf config.StringMap) error {
+	var err error
+	w.DatabaseEnv = conf.GetStringValueWithDefault(config.DBEnvKey, "local_prod")
+	w.skipInitRun, _ = conf.GetBoolValue("skip_init_run")
+	w.Cron, _ = conf.GetStringValue("cron")
+
+	w.Concurrency, err = conf.GetIntValueWithDefault("concurrency", constant.SellersJsonWorkerCount)
+	if err != nil {
+		return eris.Wrap(err, "failed to get concurrency")
+	}
+
+	w.MaxRetries, err = conf.GetIntValueWithDefault("max_retries", 3)
+	if err != nil {
+		return eris.Wrap(err, "failed to get max retries")
+	}
+
+	timeout, err := conf.GetDurationValueWithDefault("timeout", constant.AdsTxtRequestTimeout*time.Second)
+	if err != nil {
+		return eris.Wrap(err, "failed to get timeout")
+	}
+
+	err = bcdb.InitDB(w.DatabaseEnv)
+	if err != nil {
+		return eris.Wrapf(err, "failed to initalize DB")
+	}
+
+	w.fetcher = &Fetcher{Client: &http.Client{Timeout: timeout}}
+	w.adsTxtModule = adstxt.NewAdsTxtModule()
+
+	return nil
+}
+
+func (w *Worker) Do(ctx context.Context) error {
+	if w.skipInitRun {
+		fmt.Println("Skipping work as per the skip_init_run flag.")
+		w.skipInitRun = false
+
+		return nil
+	}
+
+	log.Info().Msg("Start ads.txt crawling")
+
+	domains, err := getDomainLines(ctx)
+	if err != nil {
+		return err
+	}
+
+	var (
+		mu   sync.Mutex
+		errs []error
+	)
+	group := errgroup.Group{}
+	group.SetLimit(w.Concurrency)
+	for _, lines := range domains {
+		lines := lines
+		group.Go(func() error {
+			err := w.scanDomain(ctx, lines, time.Now().UTC())
+			if err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+
+			return nil
+		})
+	}
+	_ = group.Wait()
+
+	err = w.adsTxtModule.UpdateAdsTxtMaterializedViews(ctx)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	log.Info().Int("domains", len(domains)).Int("errors", len(errs)).Msg("Finished ads.txt crawling")
+
+	return errors.Join(errs...)
+}
+
+func (w *Worker) GetSleep() int {
+	if w.Cron != "" {
+		return bccron.Next(w.Cron)
+	}
+
+	return 0
+}
+
+// scanDomain fetches files of the domain, verifies its lines and saves the results
+func (w *Worker) scanDomain(ctx context.Context, lines []*adsTxtLine, now time.Time) error {
+	publisherID, domain := lines[0].PublisherID, lines[0].Domain
+
+	documents := make([]*Document, 0, 2)
+	var fetchErrs []error
+	for _, fileName := range fileNames(lines) {
+		document, err := w.fetcher.Fetch(ctx, domain, fileName)
+		if err != nil {
+			log.Debug().Err(err).Str("domain", domain).Str("file", fileName).Msg("failed to fetch ads.txt")
+			fetchErrs = append(fetchErrs, err)
+		} else {
+			documents = append(documents, document)
+		}
+
+		err = saveDomainScan(ctx, publisherID, domain, fileName, document, lines, err, now)
+		if err != nil {
+			return err
+		}
+	}
+
+	scans := make(map[int]*lineScan, len(lines))
+	columns := make(map[int][]string, len(lines))
+	for _, line := range lines {
+		if len(documents) == 0 {
+			columns[line.ID], scans[line.ID] = line.applyUnavailable(errors.Join(fetchErrs...), w.MaxRetries, now)
+			continue
+		}
+
+		scans[line.ID] = verifyLine(line, documents)
+		columns[line.ID] = line.apply(scans[line.ID], now)
+	}
+	activateDomain(lines, scans)
+
+	return saveLines(ctx, lines, scans, columns, now)
+}
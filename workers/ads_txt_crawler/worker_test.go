@@ -0,0 +1,388 @@
+package ads_txt_crawler
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+// newTestServer serves files by host and path, every domain is resolved to the server
+func newTestServer(t *testing.T, files map[string]string) (*httptest.Server, *Fetcher) {
+	t.Helper()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host, _, err := net.SplitHostPort(r.Host)
+		if err != nil {
+			host = r.Host
+		}
+
+		switch host + r.URL.Path {
+		case "html.com/ads.txt":
+			w.Header().Set("Content-Type", "text/html")
+			_, _ = w.Write([]byte("<html><body>not found</body></html>"))
+		case "redirect.com/ads.txt":
+			http.Redirect(w, r, "https://www.redirect.com/ads.txt", http.StatusMovedPermanently)
+		default:
+			content, ok := files[host+r.URL.Path]
+			if !ok {
+				http.NotFound(w, r)
+				return
+			}
+			w.Header().Set("Content-Type", "text/plain")
+			_, _ = w.Write([]byte(content))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	address := server.Listener.Addr().String()
+	transport := server.Client().Transport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	transport.DialContext = func(ctx context.Context, network, _ string) (net.Conn, error) {
+		return (&net.Dialer{}).DialContext(ctx, network, address)
+	}
+
+	return server, &Fetcher{Client: &http.Client{Transport: transport, Timeout: 5 * time.Second}}
+}
+
+func TestFetcher_Fetch(t *testing.T) {
+	t.Parallel()
+
+	_, fetcher := newTestServer(t, map[string]string{
+		"example.com/ads.txt":           "OWNERDOMAIN=example.com\nsubdomain=news.example.com\ngoogle.com, pub-1, DIRECT\n",
+		"news.example.com/ads.txt":      "google.com, pub-news, DIRECT\n",
+		"blog.example.com/ads.txt":      "google.com, pub-blog, DIRECT\n",
+		"app.com/app-ads.txt":           "google.com, pub-app, DIRECT\n",
+		"www.redirect.com/ads.txt":      "google.com, pub-redirect, DIRECT\n",
+		"standalone.co.uk/ads.txt":      "google.com, pub-uk, DIRECT\n",
+		"shop.standalone.co.uk/ads.txt": "google.com, pub-shop, DIRECT\n",
+	})
+
+	tests := []struct {
+		name          string
+		domain        string
+		fileName      string
+		wantURL       string
+		wantAccountID string
+		wantErr       bool
+	}{
+		{
+			name:          "rootDomain",
+			domain:        "Example.com",
+			fileName:      dto.AdsTxtFileName,
+			wantURL:       "https://example.com/ads.txt",
+			wantAccountID: "pub-1",
+		},
+		{
+			name:          "subdomainDeclaredByRoot",
+			domain:        "news.example.com",
+			fileName:      dto.AdsTxtFileName,
+			wantURL:       "https://news.example.com/ads.txt",
+			wantAccountID: "pub-news",
+		},
+		{
+			name:          "subdomainNotDeclaredUsesRoot",
+			domain:        "blog.example.com",
+			fileName:      dto.AdsTxtFileName,
+			wantURL:       "https://example.com/ads.txt",
+			wantAccountID: "pub-1",
+		},
+		{
+			name:          "subdomainUnderPublicSuffix",
+			domain:        "shop.standalone.co.uk",
+			fileName:      dto.AdsTxtFileName,
+			wantURL:       "https://standalone.co.uk/ads.txt",
+			wantAccountID: "pub-uk",
+		},
+		{
+			name:          "appAdsTxt",
+			domain:        "app.com",
+			fileName:      dto.AppAdsTxtFileName,
+			wantURL:       "https://app.com/app-ads.txt",
+			wantAccountID: "pub-app",
+		},
+		{
+			name:          "redirect",
+			domain:        "redirect.com",
+			fileName:      dto.AdsTxtFileName,
+			wantURL:       "https://www.redirect.com/ads.txt",
+			wantAccountID: "pub-redirect",
+		},
+		{
+			name:     "notFound",
+			domain:   "missing.com",
+			fileName: dto.AdsTxtFileName,
+			wantErr:  true,
+		},
+		{
+			name:     "htmlInsteadOfText",
+			domain:   "html.com",
+			fileName: dto.AdsTxtFileName,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			document, err := fetcher.Fetch(context.Background(), tt.domain, tt.fileName)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantURL, document.URL)
+			require.Len(t, document.File.Records, 1)
+			assert.Equal(t, tt.wantAccountID, document.File.Records[0].AccountID)
+		})
+	}
+}
+
+func Test_verifyLine(t *testing.T) {
+	t.Parallel()
+
+	_, fetcher := newTestServer(t, map[string]string{
+		"example.com/ads.txt": "google.com, pub-1, DIRECT, f08c47fec0942fa0\n" +
+			"appnexus.com, 2, DIRECT\n",
+		"example.com/app-ads.txt": "appnexus.com, 2, RESELLER\n" +
+			"openx.com, 3, RESELLER\n",
+	})
+
+	webDocument, err := fetcher.Fetch(context.Background(), "example.com", dto.AdsTxtFileName)
+	require.NoError(t, err)
+	appDocument, err := fetcher.Fetch(context.Background(), "example.com", dto.AppAdsTxtFileName)
+	require.NoError(t, err)
+	documents := []*Document{webDocument, appDocument}
+
+	tests := []struct {
+		name string
+		line *adsTxtLine
+		want *lineScan
+	}{
+		{
+			name: "found",
+			line: &adsTxtLine{AdsTxtDomain: "google.com", PublisherAccount: "pub-1", IsDirect: true, CertificationAuthorityID: null.StringFrom("f08c47fec0942fa0")},
+			want: &lineScan{Result: dto.AdsTxtScanResultFound, URL: "https://example.com/ads.txt"},
+		},
+		{
+			name: "foundInAppAdsTxt",
+			line: &adsTxtLine{AdsTxtDomain: "openx.com", PublisherAccount: "3"},
+			want: &lineScan{Result: dto.AdsTxtScanResultFound, URL: "https://example.com/app-ads.txt"},
+		},
+		{
+			name: "foundInOneFileMismatchInOther",
+			line: &adsTxtLine{AdsTxtDomain: "appnexus.com", PublisherAccount: "2"},
+			want: &lineScan{Result: dto.AdsTxtScanResultFound, URL: "https://example.com/app-ads.txt"},
+		},
+		{
+			name: "mismatch",
+			line: &adsTxtLine{AdsTxtDomain: "google.com", PublisherAccount: "pub-1"},
+			want: &lineScan{Result: dto.AdsTxtScanResultMismatch, Reason: "relationship is DIRECT instead of RESELLER", URL: "https://example.com/ads.txt"},
+		},
+		{
+			name: "missing",
+			line: &adsTxtLine{AdsTxtDomain: "pubmatic.com", PublisherAccount: "4", IsDirect: true},
+			want: &lineScan{Result: dto.AdsTxtScanResultMissing},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, verifyLine(tt.line, documents))
+		})
+	}
+}
+
+func Test_fileNames(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		lines []*adsTxtLine
+		want  []string
+	}{
+		{
+			name:  "web",
+			lines: []*adsTxtLine{{PublisherMediaType: types.StringArray{dto.WebBannersMediaType}}},
+			want:  []string{dto.AdsTxtFileName},
+		},
+		{
+			name:  "noMediaType",
+			lines: []*adsTxtLine{{}},
+			want:  []string{dto.AdsTxtFileName},
+		},
+		{
+			name:  "inAppOnly",
+			lines: []*adsTxtLine{{PublisherMediaType: types.StringArray{dto.InAppMediaType}}},
+			want:  []string{dto.AppAdsTxtFileName},
+		},
+		{
+			name:  "webAndInApp",
+			lines: []*adsTxtLine{{PublisherMediaType: types.StringArray{dto.VideoMediaType, dto.InAppMediaType}}},
+			want:  []string{dto.AdsTxtFileName, dto.AppAdsTxtFileName},
+		},
+		{
+			name: "inAppLine",
+			lines: []*adsTxtLine{
+				{PublisherMediaType: types.StringArray{dto.WebBannersMediaType}},
+				{PublisherMediaType: types.StringArray{dto.WebBannersMediaType}, MediaType: types.StringArray{dto.InAppMediaType}},
+			},
+			want: []string{dto.AdsTxtFileName, dto.AppAdsTxtFileName},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, fileNames(tt.lines))
+		})
+	}
+}
+
+func Test_adsTxtLine_apply(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 4, 30, 10, 0, 0, 0, time.UTC)
+	before := null.TimeFrom(now.Add(-24 * time.Hour))
+
+	tests := []struct {
+		name             string
+		line             *adsTxtLine
+		scan             *lineScan
+		wantStatus       string
+		wantDemandStatus string
+		wantChangedAt    null.Time
+	}{
+		{
+			name:             "foundNewLine",
+			line:             &adsTxtLine{Status: dto.AdsTxtStatusNotScanned, DemandStatus: dto.DPStatusNotSent, DomainStatus: dto.DomainStatusNew},
+			scan:             &lineScan{Result: dto.AdsTxtScanResultFound},
+			wantStatus:       dto.AdsTxtStatusAdded,
+			wantDemandStatus: dto.DPStatusNotSent,
+			wantChangedAt:    null.TimeFrom(now),
+		},
+		{
+			name:             "mismatchIsAdded",
+			line:             &adsTxtLine{Status: dto.AdsTxtStatusAdded, StatusChangedAt: before, DemandStatus: dto.DPStatusApproved, DomainStatus: dto.DomainStatusActive},
+			scan:             &lineScan{Result: dto.AdsTxtScanResultMismatch, Reason: "relationship"},
+			wantStatus:       dto.AdsTxtStatusAdded,
+			wantDemandStatus: dto.DPStatusApproved,
+			wantChangedAt:    before,
+		},
+		{
+			name:             "missingAddedLinePausesApprovedDemand",
+			line:             &adsTxtLine{Status: dto.AdsTxtStatusAdded, StatusChangedAt: before, DemandStatus: dto.DPStatusApproved, DomainStatus: dto.DomainStatusActive},
+			scan:             &lineScan{Result: dto.AdsTxtScanResultMissing},
+			wantStatus:       dto.AdsTxtStatusDeleted,
+			wantDemandStatus: dto.DPStatusApprovedPaused,
+			wantChangedAt:    null.TimeFrom(now),
+		},
+		{
+			name:             "missingOnNewDomainKeepsDemand",
+			line:             &adsTxtLine{Status: dto.AdsTxtStatusNotScanned, DemandStatus: dto.DPStatusApproved, DomainStatus: dto.DomainStatusNew},
+			scan:             &lineScan{Result: dto.AdsTxtScanResultMissing},
+			wantStatus:       dto.AdsTxtStatusNo,
+			wantDemandStatus: dto.DPStatusApproved,
+			wantChangedAt:    null.TimeFrom(now),
+		},
+		{
+			name: "foundAgainApprovesPausedDemand",
+			line: &adsTxtLine{
+				Status: dto.AdsTxtStatusDeleted, StatusChangedAt: before, DemandStatus: dto.DPStatusApprovedPaused,
+				DomainStatus: dto.DomainStatusActive, ScanResult: null.StringFrom(dto.AdsTxtScanResultMissing),
+			},
+			scan:             &lineScan{Result: dto.AdsTxtScanResultFound},
+			wantStatus:       dto.AdsTxtStatusAdded,
+			wantDemandStatus: dto.DPStatusApproved,
+			wantChangedAt:    null.TimeFrom(now),
+		},
+		{
+			name: "foundKeepsManuallyPausedDemand",
+			line: &adsTxtLine{
+				Status: dto.AdsTxtStatusAdded, StatusChangedAt: before, DemandStatus: dto.DPStatusApprovedPaused,
+				DomainStatus: dto.DomainStatusActive, ScanResult: null.StringFrom(dto.AdsTxtScanResultFound),
+			},
+			scan:             &lineScan{Result: dto.AdsTxtScanResultFound},
+			wantStatus:       dto.AdsTxtStatusAdded,
+			wantDemandStatus: dto.DPStatusApprovedPaused,
+			wantChangedAt:    before,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			columns := tt.line.apply(tt.scan, now)
+			assert.Contains(t, columns, models.AdsTXTColumns.ScanResult)
+			assert.Equal(t, tt.wantStatus, tt.line.Status)
+			assert.Equal(t, tt.wantDemandStatus, tt.line.DemandStatus)
+			assert.Equal(t, tt.wantChangedAt, tt.line.StatusChangedAt)
+			assert.Equal(t, null.StringFrom(tt.scan.Result), tt.line.ScanResult)
+		})
+	}
+}
+
+func Test_adsTxtLine_applyUnavailable(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 4, 30, 10, 0, 0, 0, time.UTC)
+	line := &adsTxtLine{Status: dto.AdsTxtStatusAdded, DemandStatus: dto.DPStatusApproved, DomainStatus: dto.DomainStatusActive}
+
+	columns, scan := line.applyUnavailable(assert.AnError, 2, now)
+	assert.Nil(t, scan)
+	assert.Equal(t, []string{models.AdsTXTColumns.Retries, models.AdsTXTColumns.UpdatedAt}, columns)
+	assert.Equal(t, null.IntFrom(1), line.Retries)
+	assert.Equal(t, dto.AdsTxtStatusAdded, line.Status)
+
+	columns, scan = line.applyUnavailable(assert.AnError, 2, now)
+	require.NotNil(t, scan)
+	assert.Contains(t, columns, models.AdsTXTColumns.Status)
+	assert.Equal(t, dto.AdsTxtScanResultMissing, scan.Result)
+	assert.Contains(t, scan.Reason, "ads.txt is not available")
+	assert.Equal(t, null.IntFrom(2), line.Retries)
+	assert.Equal(t, dto.AdsTxtStatusDeleted, line.Status)
+	assert.Equal(t, dto.DPStatusApprovedPaused, line.DemandStatus)
+}
+
+func Test_activateDomain(t *testing.T) {
+	t.Parallel()
+
+	lines := []*adsTxtLine{
+		{ID: 1, IsSeatOwner: true, DomainStatus: dto.DomainStatusNew},
+		{ID: 2, DomainStatus: dto.DomainStatusNew},
+	}
+
+	activateDomain(lines, map[int]*lineScan{
+		1: {Result: dto.AdsTxtScanResultMissing},
+		2: {Result: dto.AdsTxtScanResultFound},
+	})
+	assert.Equal(t, dto.DomainStatusNew, lines[0].DomainStatus)
+	assert.Equal(t, dto.DomainStatusNew, lines[1].DomainStatus)
+
+	activateDomain(lines, map[int]*lineScan{
+		1: {Result: dto.AdsTxtScanResultFound},
+		2: {Result: dto.AdsTxtScanResultMissing},
+	})
+	assert.Equal(t, dto.DomainStatusActive, lines[0].DomainStatus)
+	assert.Equal(t, dto.DomainStatusActive, lines[1].DomainStatus)
+}
@@ -0,0 +1,116 @@
+package rules
+
+import (
+	"sort"
+	"time"
+
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/models"
+	"github.com/m6yf/bcwork/utils/bccron"
+	"github.com/volatiletech/null/v8"
+)
+
+// Measurement is a row returned by metric query of alert rule
+type Measurement struct {
+	Key   string       `boil:"key"`
+	Value null.Float64 `boil:"value"`
+}
+
+// Breach is a key which value violates the alert rule
+type Breach struct {
+	Key      string
+	Value    float64
+	Baseline *float64
+}
+
+// Breaches compares current values with threshold of the rule. Ratio rules compare them with every baseline,
+// keys missing in one of baselines are skipped.
+func Breaches(rule *models.AlertRule, current map[string]float64, baselines []map[string]float64) []*Breach {
+	breaches := make([]*Breach, 0)
+	for key, value := range current {
+		values := make([]float64, 0, len(baselines))
+		for _, baseline := range baselines {
+			baselineValue, ok := baseline[key]
+			if !ok {
+				break
+			}
+			values = append(values, baselineValue)
+		}
+
+		if len(values) != len(baselines) || !isBreach(rule.Comparison, rule.Threshold, value, values) {
+			continue
+		}
+
+		breach := &Breach{Key: key, Value: value}
+		if len(values) > 0 {
+			breach.Baseline = &values[0]
+		}
+		breaches = append(breaches, breach)
+	}
+
+	sort.Slice(breaches, func(i, j int) bool {
+		return breaches[i].Key < breaches[j].Key
+	})
+
+	return breaches
+}
+
+func isBreach(comparison string, threshold, value float64, baselines []float64) bool {
+	switch comparison {
+	case dto.AlertComparisonAbove:
+		return value > threshold
+	case dto.AlertComparisonBelow:
+		return value < threshold
+	case dto.AlertComparisonAboveRatio, dto.AlertComparisonBelowRatio:
+		if len(baselines) == 0 {
+			return false
+		}
+
+		for _, baseline := range baselines {
+			limit := threshold * baseline
+			if comparison == dto.AlertComparisonAboveRatio && value <= limit ||
+				comparison == dto.AlertComparisonBelowRatio && value >= limit {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// isDue reports whether the next run of rule schedule after its last evaluation has come
+func isDue(cron string, lastEvaluatedAt null.Time, now time.Time) bool {
+	if !lastEvaluatedAt.Valid {
+		return true
+	}
+
+	expr, err := bccron.Parse(cron)
+	if err != nil {
+		return false
+	}
+
+	return !expr.Next(lastEvaluatedAt.Time).After(now)
+}
+
+func isSnoozed(snoozedUntil null.Time, now time.Time) bool {
+	return snoozedUntil.Valid && snoozedUntil.Time.After(now)
+}
+
+// shouldNotify reports whether firing event should be notified. Every event is notified once,
+// snoozed events are notified after the snooze ends unless they were acknowledged or resolved meanwhile.
+func shouldNotify(rule *models.AlertRule, event *models.AlertEvent, now time.Time) bool {
+	return event.Status == dto.AlertEventStatusFiring &&
+		!event.NotifiedAt.Valid &&
+		!event.AcknowledgedAt.Valid &&
+		!isSnoozed(rule.SnoozedUntil, now) &&
+		!isSnoozed(event.SnoozedUntil, now)
+}
+
+// shouldNotifyResolution reports whether resolution of event should be notified, only notified events are
+func shouldNotifyResolution(rule *models.AlertRule, event *models.AlertEvent, now time.Time) bool {
+	return event.Status == dto.AlertEventStatusResolved &&
+		event.NotifiedAt.Valid &&
+		!isSnoozed(rule.SnoozedUntil, now)
+}
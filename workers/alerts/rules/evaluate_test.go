@@ -0,0 +1,199 @@
+package rules
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestBreaches(t *testing.T) {
+	t.Parallel()
+
+	baseline := func(v float64) *float64 { return &v }
+
+	tests := []struct {
+		name      string
+		rule      *models.AlertRule
+		current   map[string]float64
+		baselines []map[string]float64
+		want      []*Breach
+	}{
+		{
+			name:    "above",
+			rule:    &models.AlertRule{Comparison: dto.AlertComparisonAbove, Threshold: 10},
+			current: map[string]float64{"b": 11, "a": 12, "c": 10},
+			want: []*Breach{
+				{Key: "a", Value: 12},
+				{Key: "b", Value: 11},
+			},
+		},
+		{
+			name:    "below",
+			rule:    &models.AlertRule{Comparison: dto.AlertComparisonBelow, Threshold: 10},
+			current: map[string]float64{"a": 9, "b": 10},
+			want:    []*Breach{{Key: "a", Value: 9}},
+		},
+		{
+			name:    "belowRatioAllBaselines",
+			rule:    &models.AlertRule{Comparison: dto.AlertComparisonBelowRatio, Threshold: 0.4},
+			current: map[string]float64{"a": 3, "b": 3, "c": 5},
+			baselines: []map[string]float64{
+				{"a": 10, "b": 10, "c": 10},
+				{"a": 20, "b": 5, "c": 20},
+			},
+			want: []*Breach{{Key: "a", Value: 3, Baseline: baseline(10)}},
+		},
+		{
+			name:    "aboveRatio",
+			rule:    &models.AlertRule{Comparison: dto.AlertComparisonAboveRatio, Threshold: 2},
+			current: map[string]float64{"a": 21, "b": 20},
+			baselines: []map[string]float64{
+				{"a": 10, "b": 10},
+			},
+			want: []*Breach{{Key: "a", Value: 21, Baseline: baseline(10)}},
+		},
+		{
+			name:    "ratioMissingBaselineKey",
+			rule:    &models.AlertRule{Comparison: dto.AlertComparisonBelowRatio, Threshold: 0.4},
+			current: map[string]float64{"a": 1},
+			baselines: []map[string]float64{
+				{"a": 10},
+				{"b": 10},
+			},
+			want: []*Breach{},
+		},
+		{
+			name:    "ratioWithoutBaselines",
+			rule:    &models.AlertRule{Comparison: dto.AlertComparisonBelowRatio, Threshold: 0.4},
+			current: map[string]float64{"a": 1},
+			want:    []*Breach{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := Breaches(tt.rule, tt.current, tt.baselines)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_isDue(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 4, 28, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		cron            string
+		lastEvaluatedAt null.Time
+		want            bool
+	}{
+		{
+			name: "neverEvaluated",
+			cron: "0 12 * * *",
+			want: true,
+		},
+		{
+			name:            "nextRunPassed",
+			cron:            "0 12 * * *",
+			lastEvaluatedAt: null.TimeFrom(now.Add(-24 * time.Hour)),
+			want:            true,
+		},
+		{
+			name:            "nextRunNotYet",
+			cron:            "0 12 * * *",
+			lastEvaluatedAt: null.TimeFrom(now),
+			want:            false,
+		},
+		{
+			name:            "invalidCron",
+			cron:            "invalid",
+			lastEvaluatedAt: null.TimeFrom(now.Add(-24 * time.Hour)),
+			want:            false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, isDue(tt.cron, tt.lastEvaluatedAt, now))
+		})
+	}
+}
+
+func Test_shouldNotify(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 4, 28, 12, 0, 0, 0, time.UTC)
+	later := null.TimeFrom(now.Add(time.Hour))
+	earlier := null.TimeFrom(now.Add(-time.Hour))
+
+	tests := []struct {
+		name  string
+		rule  *models.AlertRule
+		event *models.AlertEvent
+		want  bool
+	}{
+		{
+			name:  "newFiringEvent",
+			rule:  &models.AlertRule{},
+			event: &models.AlertEvent{Status: dto.AlertEventStatusFiring},
+			want:  true,
+		},
+		{
+			name:  "alreadyNotified",
+			rule:  &models.AlertRule{},
+			event: &models.AlertEvent{Status: dto.AlertEventStatusFiring, NotifiedAt: earlier},
+			want:  false,
+		},
+		{
+			name:  "acknowledged",
+			rule:  &models.AlertRule{},
+			event: &models.AlertEvent{Status: dto.AlertEventStatusFiring, AcknowledgedAt: earlier},
+			want:  false,
+		},
+		{
+			name:  "ruleSnoozed",
+			rule:  &models.AlertRule{SnoozedUntil: later},
+			event: &models.AlertEvent{Status: dto.AlertEventStatusFiring},
+			want:  false,
+		},
+		{
+			name:  "eventSnoozed",
+			rule:  &models.AlertRule{},
+			event: &models.AlertEvent{Status: dto.AlertEventStatusFiring, SnoozedUntil: later},
+			want:  false,
+		},
+		{
+			name:  "snoozeEnded",
+			rule:  &models.AlertRule{SnoozedUntil: earlier},
+			event: &models.AlertEvent{Status: dto.AlertEventStatusFiring, SnoozedUntil: earlier},
+			want:  true,
+		},
+		{
+			name:  "resolved",
+			rule:  &models.AlertRule{},
+			event: &models.AlertEvent{Status: dto.AlertEventStatusResolved},
+			want:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, shouldNotify(tt.rule, tt.event, now))
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/models"
+	"github.com/m6yf/bcwork/modules"
+	"github.com/rotisserie/eris"
+	"github.com/rs/zerolog/log"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// notify sends new firing events and resolutions to rule channel in one message,
+// firing events are marked as notified only after successful send to retry on next evaluation
+func (r *Rules) notify(ctx context.Context, rule *models.AlertRule, events models.AlertEventSlice, now time.Time) {
+	lines := make([]string, 0)
+	notified := make(models.AlertEventSlice, 0)
+	for _, event := range events {
+		switch {
+		case shouldNotify(rule, event, now):
+			lines = append(lines, firingLine(rule, event))
+			notified = append(notified, event)
+		case shouldNotifyResolution(rule, event, now):
+			lines = append(lines, resolvedLine(rule, event))
+		}
+	}
+
+	if len(lines) == 0 {
+		return
+	}
+
+	err := r.send(rule, strings.Join(lines, "\n"))
+	if err != nil {
+		log.Error().Err(err).Str("rule", rule.Name).Msg("failed to send alert notification")
+		return
+	}
+
+	for _, event := range notified {
+		event.NotifiedAt = null.TimeFrom(now)
+		_, err = event.Update(ctx, bcdb.DB(), boil.Whitelist(models.AlertEventColumns.NotifiedAt))
+		if err != nil {
+			log.Error().Err(err).Int("event", event.ID).Msg("failed to mark alert event as notified")
+		}
+	}
+}
+
+func (r *Rules) send(rule *models.AlertRule, message string) error {
+	switch rule.Channel {
+	case dto.AlertChannelSlack:
+		if r.Slack == nil {
+			return eris.New("slack module is not initialized")
+		}
+
+		return r.Slack.SendMessage(message)
+	case dto.AlertChannelEmail:
+		if len(rule.Recipients) == 0 {
+			return eris.New("no recipients for email alert")
+		}
+
+		return modules.SendEmail(modules.EmailRequest{
+			To:      rule.Recipients,
+			Subject: fmt.Sprintf("Alert: %v", rule.Name),
+			Body:    message,
+		})
+	}
+
+	return fmt.Errorf("unknown alert channel [%v]", rule.Channel)
+}
+
+func firingLine(rule *models.AlertRule, event *models.AlertEvent) string {
+	line := fmt.Sprintf("[%v] %v: %v value %.4f", strings.ToUpper(event.Severity), rule.Name, event.Key, event.Value)
+	if event.Baseline.Valid {
+		line += fmt.Sprintf(" (baseline %.4f)", event.Baseline.Float64)
+	}
+
+	return line
+}
+
+func resolvedLine(rule *models.AlertRule, event *models.AlertEvent) string {
+	return fmt.Sprintf("[RESOLVED] %v: %v value %.4f", rule.Name, event.Key, event.Value)
+}
@@ -0,0 +1,278 @@
+package rules
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/config"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/models"
+	"github.com/m6yf/bcwork/modules/messager"
+	"github.com/m6yf/bcwork/quest"
+	"github.com/m6yf/bcwork/utils/bccron"
+	"github.com/rotisserie/eris"
+	"github.com/rs/zerolog/log"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// Rules evaluates active alert rules which schedule is due, keeps one firing event per rule and key
+// and notifies rule channel about new and resolved events
+type Rules struct {
+	DatabaseEnv  string                `json:"dbenv"`
+	Cron         string                `json:"cron"`
+	Quest        []string              `json:"quest_instances"`
+	QueryTimeout time.Duration         `json:"query_timeout"`
+	Slack        *messager.SlackModule `json:"slack_instances"`
+}
+
+func (r *Rules) Init(conf config.StringMap) error {
+	r.DatabaseEnv = conf.GetStringValueWithDefault("dbenv", "local")
+	err := bcdb.InitDB(r.DatabaseEnv)
+	if err != nil {
+		return eris.Wrapf(err, "Failed to initialize DB")
+	}
+
+	if r.Cron == "" {
+		r.Cron, _ = conf.GetStringValue("cron")
+	}
+
+	r.QueryTimeout, err = conf.GetDurationValueWithDefault("query_timeout", time.Minute)
+	if err != nil {
+		return eris.Wrap(err, "failed to get query timeout")
+	}
+
+	var questExist bool
+	r.Quest, questExist = conf.GetStringSlice("quest", ",")
+	if !questExist {
+		r.Quest = []string{"amsquest2", "nycquest2"}
+	}
+
+	r.Slack, err = messager.NewSlackModule()
+	if err != nil {
+		return eris.Wrapf(err, "failed to initialize slack module")
+	}
+
+	return nil
+}
+
+func (r *Rules) Do(ctx context.Context) error {
+	now := time.Now().UTC().Truncate(time.Minute)
+
+	rules, err := models.AlertRules(models.AlertRuleWhere.Active.EQ(true)).All(ctx, bcdb.DB())
+	if err != nil {
+		return eris.Wrap(err, "failed to retrieve active alert rules")
+	}
+
+	var errs []error
+	for _, rule := range rules {
+		if !isDue(rule.Cron, rule.LastEvaluatedAt, now) {
+			continue
+		}
+
+		err := r.evaluateRule(ctx, rule, now)
+		if err != nil {
+			log.Error().Err(err).Str("rule", rule.Name).Msg("failed to evaluate alert rule")
+			errs = append(errs, fmt.Errorf("rule [%v]: %w", rule.Name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (r *Rules) GetSleep() int {
+	if r.Cron != "" {
+		return bccron.Next(r.Cron)
+	}
+
+	return 0
+}
+
+func (r *Rules) evaluateRule(ctx context.Context, rule *models.AlertRule, now time.Time) error {
+	window := time.Duration(rule.WindowMinutes) * time.Minute
+
+	current, err := r.fetch(ctx, rule, now.Add(-window), now)
+	if err != nil {
+		return err
+	}
+
+	baselines := make([]map[string]float64, 0, len(rule.BaselineOffsets))
+	for _, offset := range rule.BaselineOffsets {
+		duration, err := time.ParseDuration(offset)
+		if err != nil {
+			return eris.Wrapf(err, "failed to parse baseline offset [%v]", offset)
+		}
+
+		end := now.Add(-duration)
+		baseline, err := r.fetch(ctx, rule, end.Add(-window), end)
+		if err != nil {
+			return err
+		}
+		baselines = append(baselines, baseline)
+	}
+
+	events, err := r.saveEvents(ctx, rule, current, Breaches(rule, current, baselines), now)
+	if err != nil {
+		return err
+	}
+
+	r.notify(ctx, rule, events, now)
+
+	rule.LastEvaluatedAt = null.TimeFrom(now)
+	_, err = rule.Update(ctx, bcdb.DB(), boil.Whitelist(models.AlertRuleColumns.LastEvaluatedAt))
+	if err != nil {
+		return eris.Wrap(err, "failed to update alert rule evaluation time")
+	}
+
+	return nil
+}
+
+// fetch runs metric query of the rule for the window, values of quest instances are summed by key.
+// Queries are limited by timeout, postgres queries run in read only transaction so rule can't change data
+func (r *Rules) fetch(ctx context.Context, rule *models.AlertRule, start, end time.Time) (map[string]float64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.QueryTimeout)
+	defer cancel()
+
+	values := make(map[string]float64)
+	add := func(measurements []*Measurement) {
+		for _, measurement := range measurements {
+			if measurement.Value.Valid {
+				values[measurement.Key] += measurement.Value.Float64
+			}
+		}
+	}
+
+	if rule.Source != dto.AlertSourceQuest {
+		measurements, err := r.fetchReadOnly(ctx, rule.Query, start, end)
+		if err != nil {
+			return nil, err
+		}
+		add(measurements)
+
+		return values, nil
+	}
+
+	for _, instance := range r.Quest {
+		measurements, err := fetchQuest(ctx, instance, rule.Query, start, end)
+		if err != nil {
+			return nil, err
+		}
+		add(measurements)
+	}
+
+	return values, nil
+}
+
+// fetchQuest runs query on Quest instance, connection to the instance is closed on return
+func fetchQuest(ctx context.Context, instance, query string, start, end time.Time) (measurements []*Measurement, err error) {
+	err = quest.InitDB(instance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize Quest instance [%s]: %w", instance, err)
+	}
+	defer func() {
+		closeErr := quest.CloseDB()
+		if closeErr != nil && err == nil {
+			err = fmt.Errorf("failed to close Quest instance [%s]: %w", instance, closeErr)
+		}
+	}()
+
+	err = queries.Raw(query, start, end).Bind(ctx, quest.DB(), &measurements)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query alert rule metric from Quest instance [%s]: %w", instance, err)
+	}
+
+	return measurements, nil
+}
+
+func (r *Rules) fetchReadOnly(ctx context.Context, query string, start, end time.Time) ([]*Measurement, error) {
+	tx, err := bcdb.DB().BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, eris.Wrap(err, "failed to begin read only transaction")
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, fmt.Sprintf("SET LOCAL statement_timeout = %d", r.QueryTimeout.Milliseconds()))
+	if err != nil {
+		return nil, eris.Wrap(err, "failed to set statement timeout")
+	}
+
+	var measurements []*Measurement
+	err = queries.Raw(query, start, end).Bind(ctx, tx, &measurements)
+	if err != nil {
+		return nil, eris.Wrap(err, "failed to query alert rule metric")
+	}
+
+	return measurements, nil
+}
+
+// saveEvents updates firing events of breached keys, creates events for new keys and resolves the rest
+// with their current value. It returns all touched events.
+func (r *Rules) saveEvents(ctx context.Context, rule *models.AlertRule, current map[string]float64, breaches []*Breach, now time.Time) (models.AlertEventSlice, error) {
+	firing, err := models.AlertEvents(
+		models.AlertEventWhere.RuleID.EQ(rule.ID),
+		models.AlertEventWhere.Status.EQ(dto.AlertEventStatusFiring),
+	).All(ctx, bcdb.DB())
+	if err != nil {
+		return nil, eris.Wrap(err, "failed to retrieve firing alert events")
+	}
+
+	firingByKey := make(map[string]*models.AlertEvent, len(firing))
+	for _, event := range firing {
+		firingByKey[event.Key] = event
+	}
+
+	events := make(models.AlertEventSlice, 0, len(breaches)+len(firing))
+	for _, breach := range breaches {
+		event, ok := firingByKey[breach.Key]
+		if ok {
+			delete(firingByKey, breach.Key)
+			event.Value = breach.Value
+			event.Baseline = null.Float64FromPtr(breach.Baseline)
+			event.Severity = rule.Severity
+			event.LastSeenAt = now
+			event.UpdatedAt = null.TimeFrom(now)
+			_, err = event.Update(ctx, bcdb.DB(), boil.Infer())
+			if err != nil {
+				return nil, eris.Wrapf(err, "failed to update alert event [%v]", event.ID)
+			}
+		} else {
+			event = &models.AlertEvent{
+				RuleID:      rule.ID,
+				Key:         breach.Key,
+				Status:      dto.AlertEventStatusFiring,
+				Severity:    rule.Severity,
+				Value:       breach.Value,
+				Baseline:    null.Float64FromPtr(breach.Baseline),
+				FirstSeenAt: now,
+				LastSeenAt:  now,
+				CreatedAt:   now,
+			}
+			err = event.Insert(ctx, bcdb.DB(), boil.Infer())
+			if err != nil {
+				return nil, eris.Wrapf(err, "failed to create alert event for key [%v]", breach.Key)
+			}
+		}
+		events = append(events, event)
+	}
+
+	for _, event := range firingByKey {
+		if value, ok := current[event.Key]; ok {
+			event.Value = value
+		}
+		event.Status = dto.AlertEventStatusResolved
+		event.ResolvedAt = null.TimeFrom(now)
+		event.UpdatedAt = null.TimeFrom(now)
+		_, err = event.Update(ctx, bcdb.DB(), boil.Infer())
+		if err != nil {
+			return nil, eris.Wrapf(err, "failed to resolve alert event [%v]", event.ID)
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
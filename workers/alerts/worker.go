@@ -4,19 +4,23 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/m6yf/bcwork/utils/bccron"
 
 	"github.com/m6yf/bcwork/bcdb"
 	"github.com/m6yf/bcwork/config"
 	"github.com/m6yf/bcwork/workers/alerts/factor"
+	"github.com/m6yf/bcwork/workers/alerts/rules"
 	"github.com/rotisserie/eris"
 )
 
 type Worker struct {
 	DatabaseEnv string            `json:"dbenv"`
 	TaskCrons   map[string]string `json:"task_crons"`
+	NextRuns    map[string]time.Time
 	Factor      *factor.Factor
+	Rules       *rules.Rules
 }
 
 func (worker *Worker) Init(ctx context.Context, conf config.StringMap) error {
@@ -53,6 +57,17 @@ func (worker *Worker) runAlerts(pairs []string, conf config.StringMap) (error, b
 					return eris.Wrapf(err, "Failed to initialize Factor task"), true
 				}
 			}
+
+			if task == "rules" {
+				worker.Rules = &rules.Rules{
+					DatabaseEnv: worker.DatabaseEnv,
+					Cron:        cron,
+				}
+				err := worker.Rules.Init(conf)
+				if err != nil {
+					return eris.Wrapf(err, "Failed to initialize Rules task"), true
+				}
+			}
 		}
 	}
 
@@ -61,6 +76,7 @@ func (worker *Worker) runAlerts(pairs []string, conf config.StringMap) (error, b
 
 func (worker *Worker) getParams(conf config.StringMap) []string {
 	worker.TaskCrons = make(map[string]string)
+	worker.NextRuns = make(map[string]time.Time)
 	taskCronPairs, _ := conf.GetStringValue("tasks")
 	taskCronPairs = strings.Trim(taskCronPairs, "{}")
 	pairs := strings.Split(taskCronPairs, ",")
@@ -69,7 +85,12 @@ func (worker *Worker) getParams(conf config.StringMap) []string {
 }
 
 func (worker *Worker) Do(ctx context.Context) error {
+	now := time.Now()
 	for task, cron := range worker.TaskCrons {
+		if !worker.isTaskDue(task, cron, now) {
+			continue
+		}
+
 		fmt.Printf("Executing task: %s with cron: %s\n", task, cron)
 
 		switch task {
@@ -80,6 +101,12 @@ func (worker *Worker) Do(ctx context.Context) error {
 			}
 			worker.Factor.Cron = cron
 
+		case "rules":
+			err := worker.Rules.Do(ctx)
+			if err != nil {
+				fmt.Printf("Failed to execute Rules task: %v\n", err)
+			}
+
 		default:
 			fmt.Printf("No handler found for task: %s\n", task)
 		}
@@ -90,10 +117,39 @@ func (worker *Worker) Do(ctx context.Context) error {
 	return nil
 }
 
+// taskRunTolerance allows a task to run when the worker wakes up slightly before its scheduled time
+const taskRunTolerance = time.Minute
+
+// isTaskDue reports whether the task should run now and schedules its next run,
+// so tasks with different crons sharing the worker run only on their own schedule
+func (worker *Worker) isTaskDue(task, cron string, now time.Time) bool {
+	expr, err := bccron.Parse(cron)
+	if err != nil {
+		return true
+	}
+
+	next, ok := worker.NextRuns[task]
+	if ok && now.Add(taskRunTolerance).Before(next) {
+		return false
+	}
+	worker.NextRuns[task] = expr.Next(now.Add(taskRunTolerance))
+
+	return true
+}
+
+// GetSleep returns seconds until the nearest run of the tasks
 func (worker *Worker) GetSleep() int {
-	if worker.Factor != nil && worker.Factor.Cron != "" {
-		return bccron.Next(worker.Factor.Cron)
+	sleep := 0
+	for _, cron := range worker.TaskCrons {
+		if cron == "" {
+			continue
+		}
+
+		next := bccron.Next(cron)
+		if sleep == 0 || next < sleep {
+			sleep = next
+		}
 	}
 
-	return 0
+	return sleep
 }
@@ -0,0 +1,204 @@
+package audit_log
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/config"
+	"github.com/m6yf/bcwork/utils/bccron"
+	"github.com/rotisserie/eris"
+	"github.com/rs/zerolog/log"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+const (
+	defaultRetentionDays = 180
+	defaultMonthsAhead   = 2
+
+	partitionPrefix = "audit_log_y"
+	partitionLayout = "2006m01"
+)
+
+const partitionsQuery = `
+	select c.relname as name
+	from pg_inherits i
+		join pg_class c on c.oid = i.inhrelid
+		join pg_class p on p.oid = i.inhparent
+	where p.relname = 'audit_log'
+`
+
+// Worker maintains monthly partitions of audit log: creates partitions of upcoming months
+// and drops partitions which are entirely older than retention
+type Worker struct {
+	DatabaseEnv   string `json:"dbenv"`
+	Cron          string `json:"cron"`
+	RetentionDays int    `json:"retention_days"`
+	MonthsAhead   int    `json:"months_ahead"`
+	skipInitRun   bool
+}
+
+type partition struct {
+	Name string `boil:"name"`
+}
+
+func (w *Worker) Init(ctx context.Context, conf config.StringMap) error {
+	var err error
+	w.DatabaseEnv = conf.GetStringValueWithDefault(config.DBEnvKey, "local_prod")
+	w.skipInitRun, _ = conf.GetBoolValue("skip_init_run")
+	w.Cron, _ = conf.GetStringValue("cron")
+
+	w.RetentionDays, err = conf.GetIntValueWithDefault("retention_days", defaultRetentionDays)
+	if err != nil {
+		return eris.Wrapf(err, "failed to get retention days")
+	}
+
+	w.MonthsAhead, err = conf.GetIntValueWithDefault("months_ahead", defaultMonthsAhead)
+	if err != nil {
+		return eris.Wrapf(err, "failed to get months ahead")
+	}
+
+	err = bcdb.InitDB(w.DatabaseEnv)
+	if err != nil {
+		return eris.Wrapf(err, "failed to initalize DB")
+	}
+
+	return nil
+}
+
+func (w *Worker) Do(ctx context.Context) error {
+	if w.skipInitRun {
+		fmt.Println("Skipping work as per the skip_init_run flag.")
+		w.skipInitRun = false
+
+		return nil
+	}
+
+	log.Info().Msg("Start audit log partitions maintenance")
+
+	var partitions []*partition
+	err := queries.Raw(partitionsQuery).Bind(ctx, bcdb.DB(), &partitions)
+	if err != nil {
+		return eris.Wrap(err, "failed to retrieve audit log partitions")
+	}
+
+	names := make([]string, 0, len(partitions))
+	for _, p := range partitions {
+		names = append(names, p.Name)
+	}
+
+	now := time.Now().UTC()
+	for _, month := range upcomingMonths(now, w.MonthsAhead) {
+		if slices.Contains(names, partitionName(month)) {
+			continue
+		}
+
+		// failure to create partition doesn't stop retention, rows of the month keep going to default partition
+		err := createPartition(ctx, month)
+		if err != nil {
+			log.Error().Err(err).Str("partition", partitionName(month)).Msg("failed to create audit log partition")
+			continue
+		}
+		log.Info().Str("partition", partitionName(month)).Msg("audit log partition created")
+	}
+
+	cutoff := now.AddDate(0, 0, -w.RetentionDays)
+	for _, name := range expiredPartitions(names, cutoff) {
+		_, err := queries.Raw(fmt.Sprintf("drop table if exists %v", name)).ExecContext(ctx, bcdb.DB())
+		if err != nil {
+			return eris.Wrapf(err, "failed to drop audit log partition [%v]", name)
+		}
+		log.Info().Str("partition", name).Msg("audit log partition dropped")
+	}
+
+	// rows of default partition are written only when monthly partition was missing
+	_, err = queries.Raw("delete from audit_log_default where created_at < $1", cutoff).ExecContext(ctx, bcdb.DB())
+	if err != nil {
+		return eris.Wrap(err, "failed to delete expired rows of default audit log partition")
+	}
+
+	log.Info().Msg("Finished audit log partitions maintenance")
+
+	return nil
+}
+
+func (w *Worker) GetSleep() int {
+	if w.Cron != "" {
+		return bccron.Next(w.Cron)
+	}
+
+	return 0
+}
+
+// createPartition creates partition of month, rows of the month which were written to default partition
+// are moved to it first as partition can't be attached while default partition has rows of its range
+func createPartition(ctx context.Context, month time.Time) error {
+	name := partitionName(month)
+	from, to := month.Format(time.DateOnly), month.AddDate(0, 1, 0).Format(time.DateOnly)
+
+	tx, err := bcdb.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return eris.Wrapf(err, "failed to begin transaction for audit log partition [%v]", name)
+	}
+	defer tx.Rollback()
+
+	statements := []string{
+		fmt.Sprintf("create table %v (like audit_log including defaults including constraints)", name),
+		fmt.Sprintf("insert into %v select * from audit_log_default where created_at >= '%v' and created_at < '%v'", name, from, to),
+		fmt.Sprintf("delete from audit_log_default where created_at >= '%v' and created_at < '%v'", from, to),
+		fmt.Sprintf("alter table audit_log attach partition %v for values from ('%v') to ('%v')", name, from, to),
+	}
+	for _, statement := range statements {
+		_, err := queries.Raw(statement).ExecContext(ctx, tx)
+		if err != nil {
+			return eris.Wrapf(err, "failed to create audit log partition [%v]", name)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return eris.Wrapf(err, "failed to commit audit log partition [%v]", name)
+	}
+
+	return nil
+}
+
+// upcomingMonths returns first days of current month and months ahead
+func upcomingMonths(now time.Time, monthsAhead int) []time.Time {
+	current := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	months := make([]time.Time, 0, monthsAhead+1)
+	for i := 0; i <= monthsAhead; i++ {
+		months = append(months, current.AddDate(0, i, 0))
+	}
+
+	return months
+}
+
+func partitionName(month time.Time) string {
+	return partitionPrefix + month.Format(partitionLayout)
+}
+
+// expiredPartitions returns monthly partitions which contain only rows older than cutoff,
+// default partition and tables with unexpected names are skipped
+func expiredPartitions(names []string, cutoff time.Time) []string {
+	expired := make([]string, 0)
+	for _, name := range names {
+		if len(name) <= len(partitionPrefix) || name[:len(partitionPrefix)] != partitionPrefix {
+			continue
+		}
+
+		month, err := time.Parse(partitionLayout, name[len(partitionPrefix):])
+		if err != nil {
+			continue
+		}
+
+		if !month.AddDate(0, 1, 0).After(cutoff) {
+			expired = append(expired, name)
+		}
+	}
+
+	return expired
+}
@@ -0,0 +1,38 @@
+package audit_log
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_upcomingMonths(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 11, 20, 10, 0, 0, 0, time.UTC)
+	got := upcomingMonths(now, 2)
+
+	names := make([]string, 0, len(got))
+	for _, month := range got {
+		names = append(names, partitionName(month))
+	}
+
+	assert.Equal(t, []string{"audit_log_y2025m11", "audit_log_y2025m12", "audit_log_y2026m01"}, names)
+}
+
+func Test_expiredPartitions(t *testing.T) {
+	t.Parallel()
+
+	names := []string{
+		"audit_log_default",
+		"audit_log_y2025m01",
+		"audit_log_y2025m02",
+		"audit_log_y2025m03",
+		"audit_log_yinvalid",
+	}
+	cutoff := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	got := expiredPartitions(names, cutoff)
+	assert.Equal(t, []string{"audit_log_y2025m01", "audit_log_y2025m02"}, got)
+}
@@ -0,0 +1,148 @@
+package backtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/modules/export"
+)
+
+const (
+	FactorType = "factor"
+	DpoType    = "dpo"
+)
+
+var ErrNoRows = errors.New("no rows to export")
+
+// Report is replay result of automation type exported to file
+type Report struct {
+	Type string
+	Data []byte
+}
+
+type Options struct {
+	Types   []string
+	From    time.Time
+	To      time.Time
+	Domains []string
+	Format  dto.DownloadFormat
+	Factor  FactorOptions
+	Dpo     DpoOptions
+}
+
+// Run replays automations of the requested types over the range and exports the trajectories
+func Run(ctx context.Context, opts Options) ([]*Report, error) {
+	if !opts.From.Before(opts.To) {
+		return nil, fmt.Errorf("from [%v] must be before to [%v]", opts.From, opts.To)
+	}
+
+	exporter := export.NewExportModule()
+	reports := make([]*Report, 0, len(opts.Types))
+	for _, reportType := range opts.Types {
+		var (
+			rows    []json.RawMessage
+			columns []*dto.Column
+			err     error
+		)
+
+		switch reportType {
+		case FactorType:
+			var factorRows []*FactorRow
+			factorRows, err = ReplayFactors(ctx, opts.From, opts.To, opts.Domains, opts.Factor)
+			if err == nil {
+				rows, err = toRawMessages(factorRows)
+			}
+			columns = factorColumns
+		case DpoType:
+			var dpoRows []*DpoRow
+			dpoRows, err = ReplayDpo(ctx, opts.From, opts.To, opts.Domains, opts.Dpo)
+			if err == nil {
+				rows, err = toRawMessages(dpoRows)
+			}
+			columns = dpoColumns
+		default:
+			return nil, fmt.Errorf("unknown backtest type [%v]", reportType)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to replay %v automation: %w", reportType, err)
+		}
+
+		data, err := exportRows(ctx, exporter, opts.Format, columns, rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to export %v backtest: %w", reportType, err)
+		}
+
+		reports = append(reports, &Report{Type: reportType, Data: data})
+	}
+
+	return reports, nil
+}
+
+func exportRows(ctx context.Context, exporter export.Exporter, format dto.DownloadFormat, columns []*dto.Column, rows []json.RawMessage) ([]byte, error) {
+	switch format {
+	case dto.CSV:
+		if len(rows) == 0 {
+			return nil, ErrNoRows
+		}
+
+		return exporter.ExportCSV(ctx, rows)
+	case dto.XLSX:
+		return exporter.ExportXLSX(ctx, &dto.DownloadRequest{Columns: columns, FileFormat: format, Data: rows})
+	}
+
+	return nil, fmt.Errorf("unknown file format [%v]", format)
+}
+
+func toRawMessages[T any](rows []T) ([]json.RawMessage, error) {
+	messages := make([]json.RawMessage, 0, len(rows))
+	for _, row := range rows {
+		data, err := json.Marshal(row)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, data)
+	}
+
+	return messages, nil
+}
+
+var factorColumns = []*dto.Column{
+	{Name: "time", DisplayName: "Time", Style: export.HourColumnStyle},
+	{Name: "publisher", DisplayName: "Publisher"},
+	{Name: "domain", DisplayName: "Domain"},
+	{Name: "country", DisplayName: "Country"},
+	{Name: "device", DisplayName: "Device"},
+	{Name: "strategy", DisplayName: "Strategy"},
+	{Name: "actual_factor", DisplayName: "Actual Factor", Style: export.FloatColumnStyle},
+	{Name: "simulated_factor", DisplayName: "Simulated Factor", Style: export.FloatColumnStyle},
+	{Name: "actual_revenue", DisplayName: "Actual Revenue", Style: export.CurrencyColumnStyle},
+	{Name: "estimated_revenue", DisplayName: "Estimated Revenue", Style: export.CurrencyColumnStyle},
+	{Name: "actual_gp", DisplayName: "Actual GP", Style: export.CurrencyColumnStyle},
+	{Name: "estimated_gp", DisplayName: "Estimated GP", Style: export.CurrencyColumnStyle},
+	{Name: "actual_gpp", DisplayName: "Actual GPP", Style: export.PercentageFloatColumnStyle},
+	{Name: "estimated_gpp", DisplayName: "Estimated GPP", Style: export.PercentageFloatColumnStyle},
+}
+
+var dpoColumns = []*dto.Column{
+	{Name: "time", DisplayName: "Time", Style: export.HourColumnStyle},
+	{Name: "publisher", DisplayName: "Publisher"},
+	{Name: "domain", DisplayName: "Domain"},
+	{Name: "country", DisplayName: "Country"},
+	{Name: "os", DisplayName: "OS"},
+	{Name: "demand_partner", DisplayName: "Demand Partner"},
+	{Name: "actual_factor", DisplayName: "Actual Factor", Style: export.IntColumnStyle},
+	{Name: "simulated_factor", DisplayName: "Simulated Factor", Style: export.IntColumnStyle},
+	{Name: "actual_revenue", DisplayName: "Actual Revenue", Style: export.CurrencyColumnStyle},
+	{Name: "estimated_revenue", DisplayName: "Estimated Revenue", Style: export.CurrencyColumnStyle},
+	{Name: "actual_gp", DisplayName: "Actual GP", Style: export.CurrencyColumnStyle},
+	{Name: "estimated_gp", DisplayName: "Estimated GP", Style: export.CurrencyColumnStyle},
+}
+
+func roundFloat(value float64) float64 {
+	return math.Round(value*100) / 100
+}
@@ -0,0 +1,173 @@
+package backtest
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/models"
+	"github.com/m6yf/bcwork/modules/automation"
+	"github.com/m6yf/bcwork/modules/export"
+	"github.com/m6yf/bcwork/workers/dpo"
+	factors_automation "github.com/m6yf/bcwork/workers/factors/automation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_estimateFactorWindow(t *testing.T) {
+	t.Parallel()
+
+	window := &models.PriceFactorLog{Revenue: 100, Cost: 60, GP: 30, Soldimps: 1000, Pubimps: 2000, OldFactor: 0.6}
+
+	t.Run("sameFactorKeepsActualPerformance", func(t *testing.T) {
+		t.Parallel()
+
+		got := estimateFactorWindow(window, 0.6, 0.5)
+		assert.InDelta(t, 100, got.Revenue, 1e-9)
+		assert.InDelta(t, 30, got.GP, 1e-9)
+		assert.InDelta(t, 0.3, got.GPP, 1e-9)
+		assert.Equal(t, 2000, got.PublisherImpressions)
+	})
+
+	t.Run("lowerFactorReducesCostFasterThanVolume", func(t *testing.T) {
+		t.Parallel()
+
+		got := estimateFactorWindow(window, 0.15, 0.5)
+		assert.InDelta(t, 50, got.Revenue, 1e-9)
+		assert.InDelta(t, 7.5, got.Cost, 1e-9)
+		assert.InDelta(t, 37.5, got.GP, 1e-9)
+		assert.Equal(t, 500, got.SoldImpressions)
+	})
+}
+
+func Test_replayFactorKey(t *testing.T) {
+	t.Parallel()
+
+	strategy, err := automation.New(automation.StepLadderStrategyName, nil)
+	require.NoError(t, err)
+
+	worker := &factors_automation.Worker{
+		Domains: map[string]*factors_automation.DomainSetup{
+			"pub1example.com": {Domain: "example.com", StrategyName: automation.StepLadderStrategyName, Strategy: strategy},
+		},
+		GppTarget:     0.3,
+		MinFactor:     0.5,
+		MaxFactor:     10,
+		StopLoss:      -10,
+		DefaultFactor: 0.75,
+		History:       make(map[string][]automation.Observation),
+	}
+
+	start := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	window := func(offset int, oldFactor float64) *models.PriceFactorLog {
+		return &models.PriceFactorLog{
+			EvalTime:  start.Add(time.Duration(offset) * 30 * time.Minute),
+			Publisher: "pub1",
+			Domain:    "example.com",
+			Country:   "us",
+			Device:    "mobile",
+			Revenue:   100,
+			Cost:      50,
+			GP:        40,
+			GPP:       0.4,
+			Pubimps:   1000,
+			OldFactor: oldFactor,
+		}
+	}
+
+	rows := replayFactorKey(worker, []*models.PriceFactorLog{window(0, 1), window(1, 1), window(2, 1)}, 0)
+	require.Len(t, rows, 3)
+
+	// GPP 0.4 is above the target by 0.1 which raises factor by 10% on first window,
+	// next windows are estimated with the simulated factor so GPP goes down
+	assert.Equal(t, 1.0, rows[0].SimulatedFactor)
+	assert.Equal(t, 1.1, rows[1].SimulatedFactor)
+	assert.InDelta(t, 35, rows[1].EstimatedGP, 1e-9)
+	assert.Equal(t, 1.0, rows[2].ActualFactor)
+	assert.Equal(t, automation.StepLadderStrategyName, rows[2].Strategy)
+	assert.Len(t, worker.History["pub1example.comusmobile"], 3)
+}
+
+func Test_scaleDpoReport(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		actualFactor    float64
+		simulatedFactor float64
+		wantRevenue     float64
+		wantBidRequests int
+	}{
+		{name: "sameFactor", actualFactor: 90, simulatedFactor: 90, wantRevenue: 10, wantBidRequests: 1000},
+		{name: "notThrottledInReplay", actualFactor: 90, simulatedFactor: 0, wantRevenue: 100, wantBidRequests: 10000},
+		{name: "throttledOnlyInReplay", actualFactor: 0, simulatedFactor: 90, wantRevenue: 1, wantBidRequests: 100},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			report := &dpo.DpoReport{Revenue: 10, BidRequest: 1000}
+			scaleDpoReport(report, tt.actualFactor, tt.simulatedFactor)
+			assert.InDelta(t, tt.wantRevenue, report.Revenue, 1e-9)
+			assert.Equal(t, tt.wantBidRequests, report.BidRequest)
+		})
+	}
+}
+
+func Test_dpoTimeline_rulesAt(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	change := func(hour int, dp string, factor float64) *dpo.DpoChanges {
+		return &dpo.DpoChanges{
+			Time:      start.Add(time.Duration(hour) * time.Hour),
+			DP:        dp,
+			Domain:    "example.com",
+			Publisher: "pub1",
+			Os:        "ios",
+			Country:   "us",
+			NewFactor: factor,
+		}
+	}
+
+	timeline := &dpoTimeline{
+		changes: []*dpo.DpoChanges{change(0, "dp1", 90), change(1, "dp2", 90), change(2, "dp1", 0)},
+		rules:   make(map[string]*dpo.DpoApi),
+		state:   make(map[string]*dpo.RuleState),
+	}
+
+	key := func(dp string) string {
+		return (&dpo.DpoApi{DP: dp, Domain: "example.com", Publisher: "pub1", Os: "ios", Country: "us"}).Key()
+	}
+
+	rules := timeline.rulesAt(start)
+	assert.Equal(t, 90.0, ruleFactor(rules, key("dp1")))
+	assert.Equal(t, 0.0, ruleFactor(rules, key("dp2")))
+
+	rules = timeline.rulesAt(start.Add(2 * time.Hour))
+	assert.Equal(t, 0.0, ruleFactor(rules, key("dp1")))
+	assert.Equal(t, 90.0, ruleFactor(rules, key("dp2")))
+	assert.Equal(t, start.Add(2*time.Hour), timeline.state[key("dp1")].Time)
+}
+
+func Test_exportRows(t *testing.T) {
+	t.Parallel()
+
+	rows, err := toRawMessages([]*DpoRow{{Domain: "example.com", ActualFactor: 90}})
+	require.NoError(t, err)
+
+	data, err := exportRows(context.Background(), export.NewExportModule(), dto.CSV, dpoColumns, rows)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "example.com")
+
+	_, err = exportRows(context.Background(), export.NewExportModule(), dto.CSV, dpoColumns, []json.RawMessage{})
+	assert.ErrorIs(t, err, ErrNoRows)
+
+	data, err = exportRows(context.Background(), export.NewExportModule(), dto.XLSX, dpoColumns, rows)
+	require.NoError(t, err)
+	assert.NotEmpty(t, data)
+}
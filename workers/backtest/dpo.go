@@ -0,0 +1,326 @@
+package backtest
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/bcdb/filter"
+	"github.com/m6yf/bcwork/core"
+	"github.com/m6yf/bcwork/models"
+	adstxt "github.com/m6yf/bcwork/modules/ads_txt"
+	"github.com/m6yf/bcwork/modules/history"
+	"github.com/m6yf/bcwork/workers/dpo"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type DpoOptions struct {
+	RevenueThreshold          float64
+	DpRevenueThreshold        float64
+	PlacementRevenueThreshold float64
+	// Margin is the share of demand partner revenue which is gross profit before tech fee
+	Margin float64
+}
+
+// DpoRow is an hour of demand partner on publisher, domain, os and country which was throttled in reality or in the replay
+type DpoRow struct {
+	Time             time.Time `json:"time"`
+	Publisher        string    `json:"publisher"`
+	Domain           string    `json:"domain"`
+	Country          string    `json:"country"`
+	Os               string    `json:"os"`
+	DemandPartner    string    `json:"demand_partner"`
+	ActualFactor     float64   `json:"actual_factor"`
+	SimulatedFactor  float64   `json:"simulated_factor"`
+	ActualRevenue    float64   `json:"actual_revenue"`
+	EstimatedRevenue float64   `json:"estimated_revenue"`
+	ActualGP         float64   `json:"actual_gp"`
+	EstimatedGP      float64   `json:"estimated_gp"`
+}
+
+// ReplayDpo runs hourly demand reports of the range through DPO rules calculation, starting from the rules
+// the automation had set at the beginning of the range, and returns throttled demand partners ordered by domain, key and time
+func ReplayDpo(ctx context.Context, from, to time.Time, domains []string, opts DpoOptions) ([]*DpoRow, error) {
+	demands, err := fetchDemands(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	techFee, err := fetchTechFee(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	actual, err := newDpoTimeline(ctx, from, to, domains)
+	if err != nil {
+		return nil, err
+	}
+
+	worker := &dpo.Worker{
+		Demands:                   demands,
+		RevenueThreshold:          opts.RevenueThreshold,
+		DpRevenueThreshold:        opts.DpRevenueThreshold,
+		PlacementRevenueThreshold: opts.PlacementRevenueThreshold,
+	}
+
+	actualRules := actual.rulesAt(from)
+	simulatedRules := make(map[string]*dpo.DpoApi, len(actualRules))
+	for key, rule := range actualRules {
+		simulatedRules[key] = rule
+	}
+	simulatedState := make(map[string]*dpo.RuleState, len(actual.state))
+	for key, state := range actual.state {
+		simulatedState[key] = state
+	}
+
+	var rows []*DpoRow
+	for hour := from.Truncate(time.Hour); hour.Before(to); hour = hour.Add(time.Hour) {
+		worker.Start = hour
+		worker.End = hour.Add(time.Hour)
+		actualRules = actual.rulesAt(hour)
+
+		reports, err := worker.FetchFromPostgres(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch demand report of %v: %w", hour, err)
+		}
+
+		for key, report := range reports {
+			if len(domains) > 0 && !slices.Contains(domains, report.Domain) {
+				delete(reports, key)
+				continue
+			}
+
+			actualFactor := ruleFactor(actualRules, report.ApiKey())
+			simulatedFactor := ruleFactor(simulatedRules, report.ApiKey())
+			observed := *report
+			scaleDpoReport(report, actualFactor, simulatedFactor)
+
+			if actualFactor == 0 && simulatedFactor == 0 {
+				continue
+			}
+
+			rows = append(rows, &DpoRow{
+				Time:             hour,
+				Publisher:        report.Publisher,
+				Domain:           report.Domain,
+				Country:          report.Country,
+				Os:               report.Os,
+				DemandPartner:    report.DpApiName,
+				ActualFactor:     actualFactor,
+				SimulatedFactor:  simulatedFactor,
+				ActualRevenue:    roundFloat(observed.Revenue),
+				EstimatedRevenue: roundFloat(report.Revenue),
+				ActualGP:         roundFloat(dpoGP(observed.Revenue, observed.BidRequest, opts.Margin, techFee)),
+				EstimatedGP:      roundFloat(dpoGP(report.Revenue, report.BidRequest, opts.Margin, techFee)),
+			})
+		}
+
+		updates, deletes, err := worker.CalculateRules(dpo.DpoData{
+			DpoReport:       reports,
+			PlacementReport: dpo.GroupByPlacement(reports),
+			DpReport:        dpo.GroupByDP(reports),
+			DpoApi:          simulatedRules,
+			State:           simulatedState,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate rules of %v: %w", hour, err)
+		}
+
+		applyDpoChanges(simulatedRules, simulatedState, updates, deletes)
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		if rows[i].Domain != rows[j].Domain {
+			return rows[i].Domain < rows[j].Domain
+		}
+		keyI := fmt.Sprint(rows[i].Publisher, rows[i].Country, rows[i].Os, rows[i].DemandPartner)
+		keyJ := fmt.Sprint(rows[j].Publisher, rows[j].Country, rows[j].Os, rows[j].DemandPartner)
+		if keyI != keyJ {
+			return keyI < keyJ
+		}
+
+		return rows[i].Time.Before(rows[j].Time)
+	})
+
+	return rows, nil
+}
+
+// scaleDpoReport converts report observed under actual throttling to the simulated throttling,
+// eRPM is kept as throttling removes requests regardless of their value
+func scaleDpoReport(report *dpo.DpoReport, actualFactor, simulatedFactor float64) {
+	if actualFactor == simulatedFactor || actualFactor >= 100 {
+		return
+	}
+
+	ratio := (100 - simulatedFactor) / (100 - actualFactor)
+	report.Revenue *= ratio
+	report.BidRequest = int(float64(report.BidRequest) * ratio)
+}
+
+func dpoGP(revenue float64, bidRequests int, margin, techFee float64) float64 {
+	return revenue*margin - techFee*float64(bidRequests)/1000000
+}
+
+func applyDpoChanges(rules map[string]*dpo.DpoApi, state map[string]*dpo.RuleState, updates, deletes map[string]*dpo.DpoChanges) {
+	for _, change := range updates {
+		rule := dpoRule(change)
+		rules[rule.Key()] = rule
+		state[rule.Key()] = dpoRuleState(change)
+	}
+	for _, change := range deletes {
+		delete(rules, dpoRule(change).Key())
+		state[change.Key()] = dpoRuleState(change)
+	}
+}
+
+func dpoRuleState(change *dpo.DpoChanges) *dpo.RuleState {
+	return &dpo.RuleState{
+		Time:      change.Time,
+		DP:        change.DP,
+		Domain:    change.Domain,
+		Publisher: change.Publisher,
+		Os:        change.Os,
+		Country:   change.Country,
+		Factor:    change.NewFactor,
+		Reason:    change.Reason,
+	}
+}
+
+func dpoRule(change *dpo.DpoChanges) *dpo.DpoApi {
+	return &dpo.DpoApi{
+		DP:        change.DP,
+		Domain:    change.Domain,
+		Publisher: change.Publisher,
+		Os:        change.Os,
+		Country:   change.Country,
+		Factor:    change.NewFactor,
+		RuleId:    change.RuleId,
+	}
+}
+
+func ruleFactor(rules map[string]*dpo.DpoApi, key string) float64 {
+	if rule, ok := rules[key]; ok {
+		return rule.Factor
+	}
+
+	return 0
+}
+
+// dpoTimeline holds successful changes of DPO automation ordered by time
+type dpoTimeline struct {
+	changes []*dpo.DpoChanges
+	next    int
+	rules   map[string]*dpo.DpoApi
+	state   map[string]*dpo.RuleState
+}
+
+// newDpoTimeline loads the last change of every rule until from, which gives rules at the beginning of the range,
+// and all changes of the range
+func newDpoTimeline(ctx context.Context, from, to time.Time, domains []string) (*dpoTimeline, error) {
+	ruleColumns := strings.Join([]string{
+		models.DpoAutomationLogColumns.DP,
+		models.DpoAutomationLogColumns.Domain,
+		models.DpoAutomationLogColumns.Publisher,
+		models.DpoAutomationLogColumns.Os,
+		models.DpoAutomationLogColumns.Country,
+	}, ", ")
+
+	lastLogs, err := models.DpoAutomationLogs(dpoLogsQueryMods(domains,
+		qm.Select("distinct on ("+ruleColumns+") *"),
+		models.DpoAutomationLogWhere.Time.LTE(from),
+		qm.OrderBy(ruleColumns+", "+models.DpoAutomationLogColumns.Time+" desc"),
+	)...).All(ctx, bcdb.DB())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch last dpo automation logs before the range: %w", err)
+	}
+	sort.SliceStable(lastLogs, func(i, j int) bool { return lastLogs[i].Time.Before(lastLogs[j].Time) })
+
+	rangeLogs, err := models.DpoAutomationLogs(dpoLogsQueryMods(domains,
+		models.DpoAutomationLogWhere.Time.GT(from),
+		models.DpoAutomationLogWhere.Time.LTE(to),
+		qm.OrderBy(models.DpoAutomationLogColumns.Time),
+	)...).All(ctx, bcdb.DB())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch dpo automation logs: %w", err)
+	}
+	logs := append(lastLogs, rangeLogs...)
+
+	timeline := &dpoTimeline{rules: make(map[string]*dpo.DpoApi), state: make(map[string]*dpo.RuleState)}
+	for _, mod := range logs {
+		timeline.changes = append(timeline.changes, &dpo.DpoChanges{
+			Time:      mod.Time,
+			Domain:    mod.Domain,
+			Publisher: mod.Publisher,
+			Os:        mod.Os,
+			Country:   mod.Country,
+			DP:        mod.DP,
+			NewFactor: mod.NewFactor,
+			Reason:    mod.Reason.String,
+		})
+	}
+
+	return timeline, nil
+}
+
+// dpoLogsQueryMods returns query mods of successful changes of the domains
+func dpoLogsQueryMods(domains []string, mods ...qm.QueryMod) []qm.QueryMod {
+	queryMods := []qm.QueryMod{models.DpoAutomationLogWhere.RespStatus.EQ(200)}
+	if len(domains) > 0 {
+		queryMods = append(queryMods, models.DpoAutomationLogWhere.Domain.IN(domains))
+	}
+
+	return append(queryMods, mods...)
+}
+
+// rulesAt returns automation rules active at the time and keeps the last change of every rule,
+// time must not decrease between calls
+func (t *dpoTimeline) rulesAt(at time.Time) map[string]*dpo.DpoApi {
+	for ; t.next < len(t.changes) && !t.changes[t.next].Time.After(at); t.next++ {
+		change := t.changes[t.next]
+		t.state[change.Key()] = dpoRuleState(change)
+		if change.NewFactor == 0 {
+			delete(t.rules, dpoRule(change).Key())
+		} else {
+			rule := dpoRule(change)
+			t.rules[rule.Key()] = rule
+		}
+	}
+
+	return t.rules
+}
+
+func fetchDemands(ctx context.Context) (map[string]*dpo.DemandSetup, error) {
+	service := core.NewDemandPartnerService(history.NewHistoryClient(), adstxt.NewAdsTxtModule())
+	partners, err := service.GetDemandPartners(ctx, &core.DemandPartnerGetOptions{
+		Filter: core.DemandPartnerGetFilter{Automation: filter.NewBoolFilter(true)},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch automation demand partners: %w", err)
+	}
+
+	demands := make(map[string]*dpo.DemandSetup)
+	for _, partner := range partners {
+		demands[partner.AutomationName] = dpo.NewDemandSetup(partner)
+	}
+
+	return demands, nil
+}
+
+func fetchTechFee(ctx context.Context) (float64, error) {
+	mods, err := models.GlobalFactors(models.GlobalFactorWhere.Key.EQ("tech_fee")).All(ctx, bcdb.DB())
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch tech fee: %w", err)
+	}
+
+	for _, mod := range mods {
+		if mod.PublisherID == "" {
+			return mod.Value.Float64, nil
+		}
+	}
+
+	return 0, nil
+}
@@ -0,0 +1,288 @@
+package backtest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/models"
+	"github.com/m6yf/bcwork/modules/automation"
+	factors_automation "github.com/m6yf/bcwork/workers/factors/automation"
+	"github.com/rs/zerolog/log"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const gppTargetConfigKey = "factor-automation:gpp-target"
+
+type FactorOptions struct {
+	// Strategy and Params replace the configured strategy of all domains when Strategy is set
+	Strategy      string
+	Params        json.RawMessage
+	GppTarget     *float64 // in percents like the system configuration
+	MinFactor     float64
+	MaxFactor     float64
+	StopLoss      float64
+	DefaultFactor float64
+	Elasticity    float64
+}
+
+// FactorRow is a single evaluation window of publisher, domain, country and device key
+type FactorRow struct {
+	Time             time.Time `json:"time"`
+	Publisher        string    `json:"publisher"`
+	Domain           string    `json:"domain"`
+	Country          string    `json:"country"`
+	Device           string    `json:"device"`
+	Strategy         string    `json:"strategy"`
+	ActualFactor     float64   `json:"actual_factor"`
+	SimulatedFactor  float64   `json:"simulated_factor"`
+	ActualRevenue    float64   `json:"actual_revenue"`
+	EstimatedRevenue float64   `json:"estimated_revenue"`
+	ActualGP         float64   `json:"actual_gp"`
+	EstimatedGP      float64   `json:"estimated_gp"`
+	ActualGPP        float64   `json:"actual_gpp"`
+	EstimatedGPP     float64   `json:"estimated_gpp"`
+}
+
+type windowPerformance struct {
+	Revenue              float64
+	Cost                 float64
+	GP                   float64
+	GPP                  float64
+	SoldImpressions      int
+	PublisherImpressions int
+}
+
+// ReplayFactors runs price_factor_log windows of the range through the factor strategies, starting every key
+// from its actual factor, and returns trajectories ordered by domain, key and time
+func ReplayFactors(ctx context.Context, from, to time.Time, domains []string, opts FactorOptions) ([]*FactorRow, error) {
+	queryMods := []qm.QueryMod{
+		models.PriceFactorLogWhere.EvalTime.GTE(from),
+		models.PriceFactorLogWhere.EvalTime.LT(to),
+		qm.OrderBy(models.PriceFactorLogColumns.EvalTime),
+	}
+	if len(domains) > 0 {
+		queryMods = append(queryMods, models.PriceFactorLogWhere.Domain.IN(domains))
+	}
+
+	logs, err := models.PriceFactorLogs(queryMods...).All(ctx, bcdb.DB())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch price factor logs: %w", err)
+	}
+
+	worker, err := newFactorWorker(ctx, logs, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	windows := make(map[string][]*models.PriceFactorLog)
+	for _, window := range logs {
+		key := fmt.Sprint(window.Publisher, window.Domain, window.Country, window.Device)
+		windows[key] = append(windows[key], window)
+	}
+
+	rows := make([]*FactorRow, 0, len(logs))
+	for _, keyWindows := range windows {
+		rows = append(rows, replayFactorKey(worker, keyWindows, opts.Elasticity)...)
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		if rows[i].Domain != rows[j].Domain {
+			return rows[i].Domain < rows[j].Domain
+		}
+		if rows[i].Publisher != rows[j].Publisher {
+			return rows[i].Publisher < rows[j].Publisher
+		}
+		if rows[i].Country != rows[j].Country {
+			return rows[i].Country < rows[j].Country
+		}
+		if rows[i].Device != rows[j].Device {
+			return rows[i].Device < rows[j].Device
+		}
+
+		return rows[i].Time.Before(rows[j].Time)
+	})
+
+	return rows, nil
+}
+
+func replayFactorKey(worker *factors_automation.Worker, windows []*models.PriceFactorLog, elasticity float64) []*FactorRow {
+	rows := make([]*FactorRow, 0, len(windows))
+	factor := windows[0].OldFactor
+
+	for _, window := range windows {
+		estimated := estimateFactorWindow(window, factor, elasticity)
+		record := &factors_automation.FactorReport{
+			Time:                 window.Time,
+			PublisherID:          window.Publisher,
+			Domain:               window.Domain,
+			Country:              window.Country,
+			DeviceType:           window.Device,
+			Revenue:              estimated.Revenue,
+			Cost:                 estimated.Cost,
+			SoldImpressions:      estimated.SoldImpressions,
+			PublisherImpressions: estimated.PublisherImpressions,
+			Gp:                   estimated.GP,
+			Gpp:                  estimated.GPP,
+		}
+
+		var strategy string
+		if setup, ok := worker.Domains[record.SetupKey()]; ok {
+			strategy = setup.StrategyName
+		}
+
+		rows = append(rows, &FactorRow{
+			Time:             window.EvalTime,
+			Publisher:        window.Publisher,
+			Domain:           window.Domain,
+			Country:          window.Country,
+			Device:           window.Device,
+			Strategy:         strategy,
+			ActualFactor:     window.OldFactor,
+			SimulatedFactor:  factor,
+			ActualRevenue:    window.Revenue,
+			EstimatedRevenue: roundFloat(estimated.Revenue),
+			ActualGP:         window.GP,
+			EstimatedGP:      roundFloat(estimated.GP),
+			ActualGPP:        window.GPP,
+			EstimatedGPP:     roundFloat(estimated.GPP),
+		})
+
+		next, err := worker.FactorStrategy(record, factor)
+		if err != nil {
+			log.Warn().Err(err).Str("key", record.Key()).Msg("failed to replay factor strategy")
+		}
+
+		worker.History[record.Key()] = append(worker.History[record.Key()], automation.Observation{
+			Time:                 window.EvalTime,
+			Factor:               factor,
+			Revenue:              estimated.Revenue,
+			Gp:                   estimated.GP,
+			PublisherImpressions: estimated.PublisherImpressions,
+		})
+		factor = next
+	}
+
+	return rows
+}
+
+// estimateFactorWindow scales the observed window to the simulated factor. Publisher cost follows the factor,
+// volume follows factor^elasticity and the remaining costs (fees) follow the volume.
+// Cost of price_factor_log includes demand partner and data fees, they are scaled as publisher cost.
+func estimateFactorWindow(window *models.PriceFactorLog, factor, elasticity float64) windowPerformance {
+	ratio := 1.0
+	if window.OldFactor > 0 {
+		ratio = factor / window.OldFactor
+	}
+	volume := math.Pow(ratio, elasticity)
+	otherCosts := window.Revenue - window.Cost - window.GP
+
+	performance := windowPerformance{
+		Revenue:              window.Revenue * volume,
+		Cost:                 window.Cost * ratio * volume,
+		SoldImpressions:      int(math.Round(float64(window.Soldimps) * volume)),
+		PublisherImpressions: int(math.Round(float64(window.Pubimps) * volume)),
+	}
+	performance.GP = performance.Revenue - performance.Cost - otherCosts*volume
+	if performance.Revenue != 0 {
+		performance.GPP = performance.GP / performance.Revenue
+	}
+
+	return performance
+}
+
+// newFactorWorker prepares factors automation worker with domain strategies of the replayed domains
+func newFactorWorker(ctx context.Context, logs models.PriceFactorLogSlice, opts FactorOptions) (*factors_automation.Worker, error) {
+	gppTarget, err := fetchGppTarget(ctx, opts.GppTarget)
+	if err != nil {
+		return nil, err
+	}
+
+	worker := &factors_automation.Worker{
+		Domains:       make(map[string]*factors_automation.DomainSetup),
+		GppTarget:     gppTarget,
+		MinFactor:     opts.MinFactor,
+		MaxFactor:     opts.MaxFactor,
+		StopLoss:      opts.StopLoss,
+		DefaultFactor: opts.DefaultFactor,
+		History:       make(map[string][]automation.Observation),
+	}
+
+	domains := make(map[string]struct{})
+	for _, window := range logs {
+		domains[window.Domain] = struct{}{}
+	}
+	if len(domains) == 0 {
+		return worker, nil
+	}
+
+	domainNames := make([]string, 0, len(domains))
+	for domain := range domains {
+		domainNames = append(domainNames, domain)
+	}
+
+	mods, err := models.PublisherDomains(models.PublisherDomainWhere.Domain.IN(domainNames)).All(ctx, bcdb.DB())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch publisher domains: %w", err)
+	}
+
+	setups := make(map[string]*factors_automation.AutomationApi)
+	for _, mod := range mods {
+		item := &factors_automation.AutomationApi{
+			PublisherId:        mod.PublisherID,
+			Domain:             mod.Domain,
+			Automation:         mod.Automation,
+			GppTarget:          mod.GPPTarget.Float64,
+			AutomationStrategy: mod.AutomationStrategy.String,
+			AutomationParams:   json.RawMessage(mod.AutomationParams.JSON),
+		}
+		setups[item.Key()] = item
+	}
+
+	// domains without publisher domain row are replayed with the default strategy
+	for _, window := range logs {
+		item, ok := setups[fmt.Sprint(window.Publisher, window.Domain)]
+		if !ok {
+			item = &factors_automation.AutomationApi{PublisherId: window.Publisher, Domain: window.Domain}
+			setups[item.Key()] = item
+		}
+	}
+
+	for key, item := range setups {
+		if opts.Strategy != "" {
+			item.AutomationStrategy = opts.Strategy
+			item.AutomationParams = opts.Params
+		}
+
+		setup, err := factors_automation.NewDomainSetup(item)
+		if err != nil {
+			return nil, fmt.Errorf("invalid automation setup of %v: %w", key, err)
+		}
+		worker.Domains[key] = setup
+	}
+
+	return worker, nil
+}
+
+func fetchGppTarget(ctx context.Context, gppTarget *float64) (float64, error) {
+	if gppTarget != nil {
+		return *gppTarget / 100, nil
+	}
+
+	mod, err := models.Configurations(models.ConfigurationWhere.Key.EQ(gppTargetConfigKey)).One(ctx, bcdb.DB())
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch default gpp target: %w", err)
+	}
+
+	value, err := strconv.ParseFloat(mod.Value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse default gpp target [%v]: %w", mod.Value, err)
+	}
+
+	return value / 100, nil
+}
@@ -0,0 +1,65 @@
+package blocks_expiry
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/config"
+	"github.com/m6yf/bcwork/core"
+	"github.com/m6yf/bcwork/modules/history"
+	"github.com/m6yf/bcwork/utils/bccron"
+	"github.com/rotisserie/eris"
+	"github.com/rs/zerolog/log"
+)
+
+// Worker removes expired badv/bcat entries and republishes affected lists to metadata
+type Worker struct {
+	DatabaseEnv   string `json:"dbenv"`
+	Cron          string `json:"cron"`
+	skipInitRun   bool
+	blocksService *core.BlocksService
+}
+
+func (w *Worker) Init(ctx context.Context, conf config.StringMap) error {
+	w.DatabaseEnv = conf.GetStringValueWithDefault(config.DBEnvKey, "local_prod")
+	w.skipInitRun, _ = conf.GetBoolValue("skip_init_run")
+	w.Cron, _ = conf.GetStringValue("cron")
+
+	err := bcdb.InitDB(w.DatabaseEnv)
+	if err != nil {
+		return eris.Wrapf(err, "failed to initalize DB")
+	}
+
+	w.blocksService = core.NewBlocksService(history.NewHistoryClient())
+
+	return nil
+}
+
+func (w *Worker) Do(ctx context.Context) error {
+	if w.skipInitRun {
+		fmt.Println("Skipping work as per the skip_init_run flag.")
+		w.skipInitRun = false
+
+		return nil
+	}
+
+	log.Info().Msg("Start to remove expired blocks")
+
+	err := w.blocksService.RemoveExpiredBlocks(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to remove expired blocks: %w", err)
+	}
+
+	log.Info().Msg("Finished expired blocks removal")
+
+	return nil
+}
+
+func (w *Worker) GetSleep() int {
+	if w.Cron != "" {
+		return bccron.Next(w.Cron)
+	}
+
+	return 0
+}
@@ -0,0 +1,300 @@
+package domain_onboarding
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/core"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/models"
+	adstxt "github.com/m6yf/bcwork/modules/ads_txt"
+	sellersjson "github.com/m6yf/bcwork/modules/sellers_json"
+	"github.com/m6yf/bcwork/workers/ads_txt_crawler"
+	"github.com/rotisserie/eris"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+const checkMessageMaxLength = 256
+
+// seatOwnerLinesQuery returns our seat owner lines expected in ads.txt of publisher domain
+const seatOwnerLinesQuery = `
+	select
+		so.seat_owner_domain as ads_txt_domain,
+		replace(so.publisher_account, '%s', at2.publisher_id) as publisher_account,
+		so.certification_authority_id
+	from ads_txt at2
+		join seat_owner so on so.id = at2.seat_owner_id
+	where at2.publisher_id = $1 and at2."domain" = $2
+`
+
+type seatOwnerLine struct {
+	AdsTxtDomain             string      `boil:"ads_txt_domain"`
+	PublisherAccount         string      `boil:"publisher_account"`
+	CertificationAuthorityID null.String `boil:"certification_authority_id"`
+}
+
+// Resolver resolves domain names, it's implemented by net.Resolver
+type Resolver interface {
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
+
+// Defaults are pixalate and confiant configuration applied to new domains
+type Defaults struct {
+	PixalateRate float64
+	ConfiantKey  string
+	ConfiantRate float64
+}
+
+// Checker runs onboarding checks of publisher domain
+type Checker struct {
+	Resolver           Resolver
+	Client             *http.Client
+	Fetcher            *ads_txt_crawler.Fetcher
+	Defaults           Defaults
+	pixalateService    *core.PixalateService
+	confiantService    *core.ConfiantService
+	sellersJSONService *core.SellersJSONService
+}
+
+type checkFunc func(ctx context.Context, publisher *models.Publisher, domain string) (string, error)
+
+// Run runs all checks of the domain, failed check doesn't stop the others
+func (c *Checker) Run(ctx context.Context, publisher *models.Publisher, domain string, now time.Time) []dto.DomainOnboardingCheck {
+	checkFuncs := map[string]checkFunc{
+		dto.DomainOnboardingCheckDNS:         c.checkDNS,
+		dto.DomainOnboardingCheckHTTPS:       c.checkHTTPS,
+		dto.DomainOnboardingCheckAdsTxt:      c.checkAdsTxt,
+		dto.DomainOnboardingCheckSellersJSON: c.checkSellersJSON,
+		dto.DomainOnboardingCheckPixalate:    c.applyPixalate,
+		dto.DomainOnboardingCheckConfiant:    c.applyConfiant,
+	}
+
+	return c.runChecks(ctx, publisher, domain, checkFuncs, now)
+}
+
+func (c *Checker) runChecks(
+	ctx context.Context,
+	publisher *models.Publisher,
+	domain string,
+	checkFuncs map[string]checkFunc,
+	now time.Time,
+) []dto.DomainOnboardingCheck {
+	checks := make([]dto.DomainOnboardingCheck, 0, len(dto.DomainOnboardingChecks))
+	for _, name := range dto.DomainOnboardingChecks {
+		fn, ok := checkFuncs[name]
+		if !ok {
+			checks = append(checks, dto.DomainOnboardingCheck{Name: name, Status: dto.DomainOnboardingStatusPending})
+			continue
+		}
+
+		checkedAt := now
+		check := dto.DomainOnboardingCheck{
+			Name:      name,
+			Status:    dto.DomainOnboardingStatusPassed,
+			CheckedAt: &checkedAt,
+		}
+
+		message, err := fn(ctx, publisher, domain)
+		if err != nil {
+			check.Status = dto.DomainOnboardingStatusFailed
+			message = err.Error()
+		}
+		check.Message = truncate(message, checkMessageMaxLength)
+
+		checks = append(checks, check)
+	}
+
+	return checks
+}
+
+func (c *Checker) checkDNS(ctx context.Context, publisher *models.Publisher, domain string) (string, error) {
+	addresses, err := c.Resolver.LookupHost(ctx, domain)
+	if err != nil {
+		return "", fmt.Errorf("domain is not resolved: %w", err)
+	}
+
+	if len(addresses) == 0 {
+		return "", fmt.Errorf("domain is resolved to no addresses")
+	}
+
+	return fmt.Sprintf("resolved to %v", strings.Join(addresses, ", ")), nil
+}
+
+func (c *Checker) checkHTTPS(ctx context.Context, publisher *models.Publisher, domain string) (string, error) {
+	url := fmt.Sprintf("https://%v/", domain)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request for %v: %w", url, err)
+	}
+
+	resp, err := c.Client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("site is not reachable over https: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return "", fmt.Errorf("site responded with status code %v", resp.StatusCode)
+	}
+
+	return fmt.Sprintf("reachable at %v", resp.Request.URL.String()), nil
+}
+
+func (c *Checker) checkAdsTxt(ctx context.Context, publisher *models.Publisher, domain string) (string, error) {
+	fileName := adsTxtFileName(publisher)
+	document, err := c.Fetcher.Fetch(ctx, domain, fileName)
+	if err != nil {
+		return "", fmt.Errorf("%v is not available: %w", fileName, err)
+	}
+
+	var lines []*seatOwnerLine
+	err = queries.Raw(seatOwnerLinesQuery, publisher.PublisherID, domain).Bind(ctx, bcdb.DB(), &lines)
+	if err != nil {
+		return "", eris.Wrap(err, "failed to retrieve expected ads.txt lines")
+	}
+
+	missing := missingLines(document.File, lines)
+	if len(missing) > 0 {
+		return "", fmt.Errorf("%v at %v misses lines: %v", fileName, document.URL, strings.Join(missing, "; "))
+	}
+
+	return fmt.Sprintf("%v found at %v with %v expected lines", fileName, document.URL, len(lines)), nil
+}
+
+func (c *Checker) checkSellersJSON(ctx context.Context, publisher *models.Publisher, domain string) (string, error) {
+	latest, err := c.sellersJSONService.GetLatestSellersJSON(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if latest == nil {
+		return "", fmt.Errorf("sellers.json is not published yet")
+	}
+
+	file, err := sellersjson.Parse([]byte(latest.Content))
+	if err != nil {
+		return "", eris.Wrap(err, "failed to parse sellers.json")
+	}
+
+	idx := slices.IndexFunc(file.Sellers, func(seller *sellersjson.Seller) bool {
+		return seller.SellerID == publisher.PublisherID
+	})
+	if idx == -1 {
+		return "", fmt.Errorf("publisher is not listed in sellers.json version [%v]", latest.ID)
+	}
+
+	return fmt.Sprintf("listed as %v in sellers.json version [%v]", file.Sellers[idx].SellerType, latest.ID), nil
+}
+
+// applyPixalate configures default pixalate rate unless publisher or domain already has one
+func (c *Checker) applyPixalate(ctx context.Context, publisher *models.Publisher, domain string) (string, error) {
+	isConfigured, err := models.Pixalates(
+		models.PixalateWhere.PublisherID.EQ(publisher.PublisherID),
+		models.PixalateWhere.Domain.IN([]string{"", domain}),
+		models.PixalateWhere.Active.EQ(true),
+	).Exists(ctx, bcdb.DB())
+	if err != nil {
+		return "", eris.Wrap(err, "failed to check pixalate configuration")
+	}
+
+	if isConfigured {
+		return "already configured", nil
+	}
+
+	data := &dto.PixalateUpdateRequest{
+		Publisher: publisher.PublisherID,
+		Domain:    domain,
+		Rate:      c.Defaults.PixalateRate,
+		Active:    true,
+	}
+
+	err = c.pixalateService.UpdateMetaDataQueueWithPixalate(ctx, data)
+	if err != nil {
+		return "", err
+	}
+
+	err = c.pixalateService.UpdatePixalateTable(ctx, data)
+	if err != nil {
+		return "", eris.Wrap(err, "failed to configure default pixalate")
+	}
+
+	return fmt.Sprintf("default rate %v configured", c.Defaults.PixalateRate), nil
+}
+
+// applyConfiant configures default confiant key and rate unless publisher or domain already has one
+func (c *Checker) applyConfiant(ctx context.Context, publisher *models.Publisher, domain string) (string, error) {
+	isConfigured, err := models.Confiants(
+		models.ConfiantWhere.PublisherID.EQ(publisher.PublisherID),
+		models.ConfiantWhere.Domain.IN([]string{"", domain}),
+	).Exists(ctx, bcdb.DB())
+	if err != nil {
+		return "", eris.Wrap(err, "failed to check confiant configuration")
+	}
+
+	if isConfigured {
+		return "already configured", nil
+	}
+
+	data := &dto.ConfiantUpdateRequest{
+		Publisher: publisher.PublisherID,
+		Domain:    domain,
+		Hash:      c.Defaults.ConfiantKey,
+		Rate:      c.Defaults.ConfiantRate,
+	}
+
+	err = c.confiantService.UpdateMetaDataQueue(ctx, data)
+	if err != nil {
+		return "", err
+	}
+
+	err = c.confiantService.UpdateConfiant(ctx, data)
+	if err != nil {
+		return "", eris.Wrap(err, "failed to configure default confiant")
+	}
+
+	return fmt.Sprintf("default rate %v configured", c.Defaults.ConfiantRate), nil
+}
+
+// adsTxtFileName returns app-ads.txt for publishers serving only in-app inventory
+func adsTxtFileName(publisher *models.Publisher) string {
+	if len(publisher.MediaType) > 0 && !slices.ContainsFunc(publisher.MediaType, func(mediaType string) bool {
+		return mediaType != dto.InAppMediaType
+	}) {
+		return dto.AppAdsTxtFileName
+	}
+
+	return dto.AdsTxtFileName
+}
+
+// missingLines returns expected lines which are not found in the file
+func missingLines(file *adstxt.File, lines []*seatOwnerLine) []string {
+	missing := make([]string, 0)
+	for _, line := range lines {
+		record, ok := adstxt.ParseRecord(fmt.Sprintf("%v, %v, %v", line.AdsTxtDomain, line.PublisherAccount, dto.AdsTxtTypeDirect))
+		if !ok {
+			continue
+		}
+		record.CertificationAuthorityID = line.CertificationAuthorityID.String
+
+		result, _ := file.Verify(record)
+		if result != dto.AdsTxtScanResultFound {
+			missing = append(missing, record.String())
+		}
+	}
+
+	return missing
+}
+
+func truncate(value string, length int) string {
+	if len(value) > length {
+		return value[:length]
+	}
+
+	return value
+}
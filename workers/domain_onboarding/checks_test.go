@@ -0,0 +1,146 @@
+package domain_onboarding
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/models"
+	adstxt "github.com/m6yf/bcwork/modules/ads_txt"
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+type resolverStub struct {
+	addresses []string
+	err       error
+}
+
+func (r *resolverStub) LookupHost(ctx context.Context, host string) ([]string, error) {
+	return r.addresses, r.err
+}
+
+func TestChecker_checkDNS(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		resolver *resolverStub
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:     "resolved",
+			resolver: &resolverStub{addresses: []string{"1.1.1.1", "1.0.0.1"}},
+			want:     "resolved to 1.1.1.1, 1.0.0.1",
+		},
+		{
+			name:     "notResolved",
+			resolver: &resolverStub{err: errors.New("no such host")},
+			wantErr:  true,
+		},
+		{
+			name:     "noAddresses",
+			resolver: &resolverStub{},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			checker := &Checker{Resolver: tt.resolver}
+			got, err := checker.checkDNS(context.Background(), &models.Publisher{}, "domain.com")
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestChecker_checkHTTPS(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		statusCode int
+		trusted    bool
+		wantErr    bool
+	}{
+		{name: "reachable", statusCode: http.StatusOK, trusted: true},
+		{name: "errorStatus", statusCode: http.StatusServiceUnavailable, trusted: true, wantErr: true},
+		{name: "untrustedCertificate", statusCode: http.StatusOK, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer server.Close()
+
+			checker := &Checker{Client: &http.Client{}}
+			if tt.trusted {
+				checker.Client = server.Client()
+			}
+
+			_, err := checker.checkHTTPS(context.Background(), &models.Publisher{}, strings.TrimPrefix(server.URL, "https://"))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestChecker_runChecks(t *testing.T) {
+	t.Parallel()
+
+	checker := &Checker{Resolver: &resolverStub{err: errors.New("no such host")}}
+	checks := checker.runChecks(context.Background(), &models.Publisher{}, "domain.com", map[string]checkFunc{
+		dto.DomainOnboardingCheckDNS: checker.checkDNS,
+	}, time.Now())
+
+	assert.Len(t, checks, len(dto.DomainOnboardingChecks))
+	assert.Equal(t, dto.DomainOnboardingStatusFailed, checks[0].Status)
+	assert.Equal(t, "domain is not resolved: no such host", checks[0].Message)
+	for _, check := range checks[1:] {
+		assert.Equal(t, dto.DomainOnboardingStatusPending, check.Status)
+	}
+	assert.Equal(t, dto.DomainOnboardingStatusFailed, dto.DomainOnboardingStatus(checks))
+}
+
+func Test_missingLines(t *testing.T) {
+	t.Parallel()
+
+	file := adstxt.Parse("onlinemediasolutions.com, 100, DIRECT, 7e93a05d\nother.com, 1, RESELLER")
+	lines := []*seatOwnerLine{
+		{AdsTxtDomain: "onlinemediasolutions.com", PublisherAccount: "100", CertificationAuthorityID: null.StringFrom("7e93a05d")},
+		{AdsTxtDomain: "onlinemediasolutions.com", PublisherAccount: "200"},
+	}
+
+	assert.Equal(t, []string{"onlinemediasolutions.com, 200, DIRECT"}, missingLines(file, lines))
+}
+
+func Test_adsTxtFileName(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, dto.AdsTxtFileName, adsTxtFileName(&models.Publisher{}))
+	assert.Equal(t, dto.AdsTxtFileName, adsTxtFileName(&models.Publisher{MediaType: types.StringArray{dto.InAppMediaType, "Web Banners"}}))
+	assert.Equal(t, dto.AppAdsTxtFileName, adsTxtFileName(&models.Publisher{MediaType: types.StringArray{dto.InAppMediaType}}))
+}
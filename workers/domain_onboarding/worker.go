@@ -0,0 +1,212 @@
+package domain_onboarding
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/config"
+	"github.com/m6yf/bcwork/core"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/models"
+	"github.com/m6yf/bcwork/modules"
+	"github.com/m6yf/bcwork/modules/history"
+	"github.com/m6yf/bcwork/utils/bccron"
+	"github.com/m6yf/bcwork/utils/constant"
+	"github.com/m6yf/bcwork/workers/ads_txt_crawler"
+	"github.com/rotisserie/eris"
+	"github.com/rs/zerolog/log"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Worker runs onboarding checks of new publisher domains: dns, https, ads.txt, sellers.json
+// and default pixalate and confiant configuration. Failed domains are checked again on next runs
+// until max attempts, account manager is notified when domain passes or fails onboarding.
+type Worker struct {
+	DatabaseEnv string `json:"dbenv"`
+	Cron        string `json:"cron"`
+	MaxAttempts int    `json:"max_attempts"`
+	skipInitRun bool
+	checker     *Checker
+}
+
+func (w *Worker) Init(ctx context.Context, conf config.StringMap) error {
+	var err error
+	w.DatabaseEnv = conf.GetStringValueWithDefault(config.DBEnvKey, "local_prod")
+	w.skipInitRun, _ = conf.GetBoolValue("skip_init_run")
+	w.Cron, _ = conf.GetStringValue("cron")
+
+	w.MaxAttempts, err = conf.GetIntValueWithDefault("max_attempts", 5)
+	if err != nil {
+		return eris.Wrap(err, "failed to get max attempts")
+	}
+
+	timeout, err := conf.GetDurationValueWithDefault("timeout", constant.AdsTxtRequestTimeout*time.Second)
+	if err != nil {
+		return eris.Wrap(err, "failed to get timeout")
+	}
+
+	defaults, err := getDefaults(conf)
+	if err != nil {
+		return err
+	}
+
+	err = bcdb.InitDB(w.DatabaseEnv)
+	if err != nil {
+		return eris.Wrapf(err, "failed to initalize DB")
+	}
+
+	client := &http.Client{Timeout: timeout}
+	historyModule := history.NewHistoryClient()
+	w.checker = &Checker{
+		Resolver:           net.DefaultResolver,
+		Client:             client,
+		Fetcher:            &ads_txt_crawler.Fetcher{Client: client},
+		Defaults:           defaults,
+		pixalateService:    core.NewPixalateService(historyModule),
+		confiantService:    core.NewConfiantService(historyModule),
+		sellersJSONService: core.NewSellersJSONService(),
+	}
+
+	return nil
+}
+
+func (w *Worker) Do(ctx context.Context) error {
+	if w.skipInitRun {
+		fmt.Println("Skipping work as per the skip_init_run flag.")
+		w.skipInitRun = false
+
+		return nil
+	}
+
+	log.Info().Msg("Start to run domains onboarding")
+
+	mods, err := models.DomainOnboardings(
+		models.DomainOnboardingWhere.Status.NEQ(dto.DomainOnboardingStatusPassed),
+		models.DomainOnboardingWhere.Attempts.LT(w.MaxAttempts),
+		qm.OrderBy(models.DomainOnboardingColumns.ID),
+	).All(ctx, bcdb.DB())
+	if err != nil {
+		return eris.Wrap(err, "failed to retrieve domains onboarding")
+	}
+
+	for _, mod := range mods {
+		err := w.onboard(ctx, mod)
+		if err != nil {
+			log.Error().Err(err).Msgf("failed to onboard domain [%v:%v]", mod.PublisherID, mod.Domain)
+		}
+	}
+
+	log.Info().Msgf("Finished domains onboarding, checked [%v] domains", len(mods))
+
+	return nil
+}
+
+func (w *Worker) GetSleep() int {
+	if w.Cron != "" {
+		return bccron.Next(w.Cron)
+	}
+
+	return 0
+}
+
+func (w *Worker) onboard(ctx context.Context, mod *models.DomainOnboarding) error {
+	publisher, err := models.FindPublisher(ctx, bcdb.DB(), mod.PublisherID)
+	if err != nil {
+		return eris.Wrapf(err, "failed to retrieve publisher [%v]", mod.PublisherID)
+	}
+
+	now := time.Now().UTC()
+	checks := w.checker.Run(ctx, publisher, mod.Domain, now)
+
+	data, err := json.Marshal(checks)
+	if err != nil {
+		return eris.Wrap(err, "failed to marshal onboarding checks")
+	}
+
+	mod.Checks = null.JSONFrom(data)
+	mod.Status = dto.DomainOnboardingStatus(checks)
+	mod.Attempts++
+	mod.LastCheckedAt = null.TimeFrom(now)
+	mod.UpdatedAt = null.TimeFrom(now)
+
+	if mod.Status != mod.NotifiedStatus.String {
+		err = notifyAccountManager(ctx, publisher, mod, checks)
+		if err != nil {
+			log.Error().Err(err).Msgf("failed to notify account manager about onboarding of domain [%v:%v]", mod.PublisherID, mod.Domain)
+		} else {
+			mod.NotifiedStatus = null.StringFrom(mod.Status)
+		}
+	}
+
+	_, err = mod.Update(ctx, bcdb.DB(), boil.Infer())
+	if err != nil {
+		return eris.Wrap(err, "failed to save onboarding checks")
+	}
+
+	return nil
+}
+
+// notifyAccountManager sends onboarding result to account manager of publisher,
+// publishers without account manager are logged only
+func notifyAccountManager(ctx context.Context, publisher *models.Publisher, mod *models.DomainOnboarding, checks []dto.DomainOnboardingCheck) error {
+	subject := fmt.Sprintf("Domain %v of publisher %v (%v) %v onboarding", mod.Domain, publisher.Name, publisher.PublisherID, mod.Status)
+
+	lines := []string{subject + ":"}
+	for _, check := range checks {
+		line := fmt.Sprintf("%v: %v", check.Name, check.Status)
+		if check.Message != "" {
+			line += " - " + check.Message
+		}
+		lines = append(lines, line)
+	}
+
+	userID, err := strconv.Atoi(publisher.AccountManagerID.String)
+	if err != nil {
+		log.Warn().Msgf("%v, publisher has no account manager to notify", subject)
+		return nil
+	}
+
+	user, err := models.FindUser(ctx, bcdb.DB(), userID)
+	if err != nil {
+		return eris.Wrapf(err, "failed to retrieve account manager [%v]", userID)
+	}
+
+	return modules.SendEmail(modules.EmailRequest{
+		To:      []string{user.Email},
+		Subject: subject,
+		Body:    strings.Join(lines, "\n"),
+	})
+}
+
+func getDefaults(conf config.StringMap) (Defaults, error) {
+	var (
+		defaults Defaults
+		err      error
+	)
+
+	defaults.PixalateRate, err = conf.GetFloat64ValueWithDefault("pixalate_rate", 100)
+	if err != nil {
+		return defaults, eris.Wrap(err, "failed to get default pixalate rate")
+	}
+
+	defaults.ConfiantRate, err = conf.GetFloat64ValueWithDefault("confiant_rate", 100)
+	if err != nil {
+		return defaults, eris.Wrap(err, "failed to get default confiant rate")
+	}
+
+	defaults.ConfiantKey, _ = conf.GetStringValue("confiant_key")
+	if defaults.ConfiantKey == "" {
+		return defaults, eris.New("default confiant key is mandatory")
+	}
+
+	return defaults, nil
+}
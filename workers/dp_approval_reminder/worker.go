@@ -0,0 +1,80 @@
+package dp_approval_reminder
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/config"
+	"github.com/m6yf/bcwork/core"
+	"github.com/m6yf/bcwork/modules/history"
+	"github.com/m6yf/bcwork/utils/bccron"
+	"github.com/rotisserie/eris"
+	"github.com/rs/zerolog/log"
+)
+
+// Worker reminds points of contact of demand partners about approval requests
+// which were sent but not decided for a while, up to max reminders per request
+type Worker struct {
+	DatabaseEnv       string        `json:"dbenv"`
+	Cron              string        `json:"cron"`
+	StaleAfter        time.Duration `json:"stale_after"`
+	MaxReminders      int           `json:"max_reminders"`
+	skipInitRun       bool
+	dpApprovalService *core.DPApprovalService
+}
+
+func (w *Worker) Init(ctx context.Context, conf config.StringMap) error {
+	var err error
+	w.DatabaseEnv = conf.GetStringValueWithDefault(config.DBEnvKey, "local_prod")
+	w.skipInitRun, _ = conf.GetBoolValue("skip_init_run")
+	w.Cron, _ = conf.GetStringValue("cron")
+
+	w.StaleAfter, err = conf.GetDurationValueWithDefault("stale_after", 7*24*time.Hour)
+	if err != nil {
+		return eris.Wrap(err, "failed to get stale after duration")
+	}
+
+	w.MaxReminders, err = conf.GetIntValueWithDefault("max_reminders", 3)
+	if err != nil {
+		return eris.Wrap(err, "failed to get max reminders")
+	}
+
+	err = bcdb.InitDB(w.DatabaseEnv)
+	if err != nil {
+		return eris.Wrapf(err, "failed to initalize DB")
+	}
+
+	w.dpApprovalService = core.NewDPApprovalService(history.NewHistoryClient(), nil)
+
+	return nil
+}
+
+func (w *Worker) Do(ctx context.Context) error {
+	if w.skipInitRun {
+		fmt.Println("Skipping work as per the skip_init_run flag.")
+		w.skipInitRun = false
+
+		return nil
+	}
+
+	log.Info().Msg("Start to remind about stale demand partner approval requests")
+
+	reminded, err := w.dpApprovalService.RemindStaleRequests(ctx, w.StaleAfter, w.MaxReminders)
+	if err != nil {
+		return fmt.Errorf("failed to remind about stale approval requests: %w", err)
+	}
+
+	log.Info().Msgf("Finished reminders about demand partner approval requests, reminded [%v] requests", reminded)
+
+	return nil
+}
+
+func (w *Worker) GetSleep() int {
+	if w.Cron != "" {
+		return bccron.Next(w.Cron)
+	}
+
+	return 0
+}
@@ -0,0 +1,108 @@
+package dp_scoring
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/config"
+	"github.com/m6yf/bcwork/core"
+	"github.com/m6yf/bcwork/utils/bccron"
+	"github.com/rotisserie/eris"
+	"github.com/rs/zerolog/log"
+)
+
+// Worker calculates scores of demand partners by their performance for the last days,
+// stores them to history and publishes ranking of demand partners to metadata.
+// Weights of score components can be overridden by "weight_<component>" keys
+type Worker struct {
+	DatabaseEnv    string              `json:"dbenv"`
+	Cron           string              `json:"cron"`
+	Days           int                 `json:"days"`
+	MinBidRequests int64               `json:"min_bid_requests"`
+	Weights        core.DPScoreWeights `json:"weights"`
+	skipInitRun    bool
+	dpScoreService *core.DPScoreService
+}
+
+func (w *Worker) Init(ctx context.Context, conf config.StringMap) error {
+	var err error
+	w.DatabaseEnv = conf.GetStringValueWithDefault(config.DBEnvKey, "local_prod")
+	w.skipInitRun, _ = conf.GetBoolValue("skip_init_run")
+	w.Cron, _ = conf.GetStringValue("cron")
+
+	w.Days, err = conf.GetIntValueWithDefault("days", 7)
+	if err != nil {
+		return eris.Wrap(err, "failed to get days")
+	}
+
+	w.MinBidRequests, err = conf.GetInt64ValueWithDefault("min_bid_requests", 10000)
+	if err != nil {
+		return eris.Wrap(err, "failed to get min bid requests")
+	}
+
+	w.Weights = make(core.DPScoreWeights)
+	for name, weight := range core.DefaultDPScoreWeights {
+		w.Weights[name], err = conf.GetFloat64ValueWithDefault("weight_"+name, weight)
+		if err != nil {
+			return eris.Wrapf(err, "failed to get weight of [%v]", name)
+		}
+	}
+
+	err = w.Weights.Validate()
+	if err != nil {
+		return err
+	}
+
+	err = bcdb.InitDB(w.DatabaseEnv)
+	if err != nil {
+		return eris.Wrapf(err, "failed to initalize DB")
+	}
+
+	w.dpScoreService = core.NewDPScoreService()
+
+	return nil
+}
+
+func (w *Worker) Do(ctx context.Context) error {
+	if w.skipInitRun {
+		fmt.Println("Skipping work as per the skip_init_run flag.")
+		w.skipInitRun = false
+
+		return nil
+	}
+
+	now := time.Now().UTC()
+	to := now.Truncate(24 * time.Hour)
+	from := to.AddDate(0, 0, -w.Days)
+
+	log.Info().Msgf("Start to calculate demand partner scores from [%v] to [%v]", from, to)
+
+	scores, err := w.dpScoreService.CalculateScores(ctx, from, to, w.Weights, w.MinBidRequests)
+	if err != nil {
+		return fmt.Errorf("failed to calculate demand partner scores: %w", err)
+	}
+
+	if len(scores) == 0 {
+		log.Warn().Msg("No demand partners with enough bid requests to score")
+		return nil
+	}
+
+	scores, err = w.dpScoreService.SaveScores(ctx, scores, now)
+	if err != nil {
+		return fmt.Errorf("failed to save demand partner scores: %w", err)
+	}
+
+	log.Info().Msgf("Finished demand partner scores calculation, saved [%v] scores", len(scores))
+
+	return nil
+}
+
+func (w *Worker) GetSleep() int {
+	if w.Cron != "" {
+		return bccron.Next(w.Cron)
+	}
+
+	return 0
+}
@@ -8,6 +8,7 @@ import (
 	"math"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/volatiletech/sqlboiler/v4/boil"
 
@@ -41,6 +42,18 @@ var SelectQuery = `
 		1,2,3,4,5,6;
         `
 
+var stateQuery = `
+	SELECT DISTINCT ON (dp, domain, publisher, os, country)
+		time, dp, domain, publisher, os, country, new_factor, COALESCE(reason, '') AS reason
+	FROM
+		dpo_automation_log
+	WHERE
+		resp_status = 200
+		AND time >= $1
+	ORDER BY
+		dp, domain, publisher, os, country, time DESC;
+	`
+
 func (worker *Worker) FetchData(ctx context.Context) DpoData {
 	var data DpoData
 	var err error
@@ -59,6 +72,11 @@ func (worker *Worker) FetchData(ctx context.Context) DpoData {
 		return DpoData{Error: err}
 	}
 
+	data.State, err = worker.FetchState(ctx)
+	if err != nil {
+		return DpoData{Error: err}
+	}
+
 	return data
 }
 
@@ -110,6 +128,33 @@ func (worker *Worker) FetchFromPostgres(ctx context.Context) (map[string]*DpoRep
 	return reportMap, nil
 }
 
+// FetchState fetches the last successful automation change of every rule within the state window
+func (worker *Worker) FetchState(ctx context.Context) (map[string]*RuleState, error) {
+	var records []*RuleState
+
+	err := queries.Raw(stateQuery, worker.End.Add(-time.Duration(worker.StateDays)*24*time.Hour)).Bind(ctx, bcdb.DB(), &records)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error fetching dpo automation state from postgres")
+	}
+
+	state := make(map[string]*RuleState, len(records))
+	for _, record := range records {
+		state[record.Key()] = record
+	}
+
+	return state, nil
+}
+
+// InputVolume returns number of records and their revenue
+func InputVolume(reports map[string]*DpoReport) (int, float64) {
+	var revenue float64
+	for _, report := range reports {
+		revenue += report.Revenue
+	}
+
+	return len(reports), revenue
+}
+
 func GroupByPlacement(reports map[string]*DpoReport) map[string]*PlacementReport {
 	placementMap := make(map[string]*PlacementReport)
 
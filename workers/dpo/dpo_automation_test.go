@@ -3,8 +3,10 @@ package dpo
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/modules/automation"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -46,34 +48,107 @@ func TestGetDemandPartners_DesiredOutput(t *testing.T) {
 	}
 
 	demands, err := worker.getDemandPartners(demandSlice)
+	defaultConfig := (*dto.DPOAutomationConfig)(nil).WithDefaults()
 	expected := map[string]*DemandSetup{
 		"index-pbs": {
 			Name:      "index-pbs",
 			ApiName:   "indexs2s",
 			Threshold: 0.001,
+			Config:    defaultConfig,
 		},
 		"onetag-bcm": {
 			Name:      "onetag-bcm",
 			ApiName:   "onetagbcm",
 			Threshold: 0.001,
+			Config:    defaultConfig,
 		},
 		"pubmatic-pbs": {
 			Name:      "pubmatic-pbs",
 			ApiName:   "pubmaticbcm",
 			Threshold: 0.001,
+			Config:    defaultConfig,
 		},
 		"sovrn": {
 			Name:      "sovrn",
 			ApiName:   "sovrnbcm",
 			Threshold: 0.001,
+			Config:    defaultConfig,
 		},
 		"yieldmo-audienciad": {
 			Name:      "yieldmo-audienciad",
 			ApiName:   "yieldmo",
 			Threshold: 0.001,
+			Config:    defaultConfig,
 		},
 	}
 
 	assert.NoError(t, err)
 	assert.Equal(t, expected, demands)
 }
+
+func Test_nextFactor(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 4, 22, 12, 0, 0, 0, time.UTC)
+	config := (&dto.DPOAutomationConfig{}).WithDefaults()
+	stateAt := func(hoursAgo int, factor float64, reason string) *RuleState {
+		return &RuleState{Time: now.Add(-time.Duration(hoursAgo) * time.Hour), Factor: factor, Reason: reason}
+	}
+
+	tests := []struct {
+		name       string
+		state      *RuleState
+		ratio      float64
+		oldFactor  float64
+		lowRevenue bool
+		wantFactor float64
+		wantReason string
+	}{
+		{name: "throttleByErpmLevel", ratio: 0.6, lowRevenue: true, wantFactor: 50, wantReason: ReasonThrottle},
+		{name: "deepThrottle", ratio: 0.1, lowRevenue: true, wantFactor: 90, wantReason: ReasonThrottle},
+		{name: "noThrottleOnHighRevenue", ratio: 0.1, lowRevenue: false, wantFactor: 0},
+		{name: "tightenAfterHold", state: stateAt(7, 25, ReasonThrottle), ratio: 0.3, oldFactor: 25, lowRevenue: true, wantFactor: 75, wantReason: ReasonThrottle},
+		{name: "keepDuringHold", state: stateAt(2, 25, ReasonThrottle), ratio: 0.3, oldFactor: 25, lowRevenue: true, wantFactor: 25},
+		{name: "hysteresisKeepsLevel", state: stateAt(7, 50, ReasonThrottle), ratio: 0.8, oldFactor: 50, wantFactor: 50},
+		{name: "relaxAboveHysteresis", state: stateAt(7, 50, ReasonThrottle), ratio: 0.9, oldFactor: 50, wantFactor: 25, wantReason: ReasonRelax},
+		{name: "recovered", state: stateAt(7, 25, ReasonRelax), ratio: 1.2, oldFactor: 25, wantFactor: 0, wantReason: ReasonRecovered},
+		{name: "explore", state: stateAt(24, 90, ReasonThrottle), ratio: 0.1, oldFactor: 90, lowRevenue: true, wantFactor: 25, wantReason: ReasonExplore},
+		{name: "throttleBackAfterExploration", state: stateAt(1, 25, ReasonExplore), ratio: 0.1, oldFactor: 25, lowRevenue: true, wantFactor: 90, wantReason: ReasonThrottle},
+		{name: "manualRuleIsNotHeld", state: stateAt(1, 90, ReasonThrottle), ratio: 1.5, oldFactor: 60, wantFactor: 0, wantReason: ReasonRecovered},
+		{name: "noThresholdReleases", state: nil, ratio: erpmRatio(0.5, 0), oldFactor: 90, wantFactor: 0, wantReason: ReasonRecovered},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotFactor, gotReason := nextFactor(&config, tt.state, tt.ratio, tt.oldFactor, tt.lowRevenue, now)
+			assert.Equal(t, tt.wantFactor, gotFactor)
+			assert.Equal(t, tt.wantReason, gotReason)
+		})
+	}
+}
+
+func TestWorker_LimitChanges(t *testing.T) {
+	t.Parallel()
+
+	worker := &Worker{Guardrails: automation.Guardrails{MaxDelta: 50}}
+	updates := map[string]*DpoChanges{
+		"throttle": {DP: "dp1", OldFactor: 0, NewFactor: 90},
+		"relax":    {DP: "dp2", OldFactor: 50, NewFactor: 25},
+	}
+	deletes := map[string]*DpoChanges{
+		"recovered": {DP: "dp3", OldFactor: 90, NewFactor: 0, RuleId: "rule"},
+		"released":  {DP: "dp4", OldFactor: 25, NewFactor: 0, RuleId: "rule"},
+	}
+
+	worker.LimitChanges(updates, deletes)
+
+	assert.Equal(t, 50.0, updates["throttle"].NewFactor)
+	assert.Equal(t, 25.0, updates["relax"].NewFactor)
+	assert.Equal(t, 40.0, updates["recovered"].NewFactor)
+	assert.Empty(t, updates["recovered"].RuleId)
+	assert.Len(t, deletes, 1)
+	assert.Contains(t, deletes, "released")
+}
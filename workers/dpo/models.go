@@ -5,7 +5,17 @@ import (
 	"math"
 	"time"
 
+	"github.com/m6yf/bcwork/dto"
 	"github.com/m6yf/bcwork/models"
+	"github.com/volatiletech/null/v8"
+)
+
+// Reasons of automation changes
+const (
+	ReasonThrottle  = "throttle"
+	ReasonRelax     = "relax"
+	ReasonRecovered = "recovered"
+	ReasonExplore   = "explore"
 )
 
 // Changes applied on factors struct
@@ -53,12 +63,26 @@ type DpoChanges struct {
 	NewFactor  float64   `json:"new_factor"`
 	RespStatus int       `json:"response_status"`
 	RuleId     string    `json:"rule_id"`
+	Reason     string    `json:"reason"`
 }
 
 type DemandSetup struct {
-	Name      string  `json:"name"`
-	ApiName   string  `json:"api_name"`
-	Threshold float64 `json:"threshold"`
+	Name      string                  `json:"name"`
+	ApiName   string                  `json:"api_name"`
+	Threshold float64                 `json:"threshold"`
+	Config    dto.DPOAutomationConfig `json:"config"`
+}
+
+// RuleState is the last successful automation change of a rule
+type RuleState struct {
+	Time      time.Time `boil:"time" json:"time"`
+	DP        string    `boil:"dp" json:"dp"`
+	Domain    string    `boil:"domain" json:"domain"`
+	Publisher string    `boil:"publisher" json:"publisher"`
+	Os        string    `boil:"os" json:"os"`
+	Country   string    `boil:"country" json:"country"`
+	Factor    float64   `boil:"new_factor" json:"new_factor"`
+	Reason    string    `boil:"reason" json:"reason"`
 }
 
 type DpoApi struct {
@@ -76,6 +100,7 @@ type DpoData struct {
 	PlacementReport map[string]*PlacementReport `json:"placement_report"`
 	DpReport        map[string]*DpReport        `json:"dp_report"`
 	DpoApi          map[string]*DpoApi          `json:"dpo_api"`
+	State           map[string]*RuleState       `json:"state"`
 	Error           error                       `json:"error"`
 }
 
@@ -91,6 +116,10 @@ func (record *DpoReport) ApiKey() string {
 	return fmt.Sprint(record.DpApiName, record.Domain, record.Publisher, record.Os, record.Country)
 }
 
+func (record *RuleState) Key() string {
+	return fmt.Sprint(record.DP, record.Domain, record.Publisher, record.Os, record.Country)
+}
+
 func (record *DpoChanges) Key() string {
 	return fmt.Sprint(record.DP, record.Domain, record.Publisher, record.Os, record.Country)
 }
@@ -118,11 +147,22 @@ func (record *DpoChanges) ToModel() (models.DpoAutomationLog, error) {
 		OldFactor:  record.OldFactor,
 		NewFactor:  record.NewFactor,
 		RespStatus: record.RespStatus,
+		Reason:     null.NewString(record.Reason, record.Reason != ""),
 	}
 
 	return model, nil
 }
 
+// NewDemandSetup converts automation demand partner to its setup with defaults of unset automation configuration
+func NewDemandSetup(partner *dto.DemandPartner) *DemandSetup {
+	return &DemandSetup{
+		Name:      partner.AutomationName,
+		ApiName:   partner.DemandPartnerID,
+		Threshold: partner.Threshold,
+		Config:    partner.AutomationConfig.WithDefaults(),
+	}
+}
+
 func (worker *Worker) CheckDemand(demand string) bool {
 	_, exists := worker.Demands[demand]
 
@@ -3,6 +3,7 @@ package dpo
 import (
 	"context"
 	"fmt"
+	"math"
 	"strings"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/m6yf/bcwork/config"
 	"github.com/m6yf/bcwork/core/bulk"
 	"github.com/m6yf/bcwork/models"
+	"github.com/m6yf/bcwork/modules/automation"
 	"github.com/m6yf/bcwork/modules/history"
 	httpclient "github.com/m6yf/bcwork/modules/http_client"
 	"github.com/m6yf/bcwork/modules/messager"
@@ -36,6 +38,8 @@ type Worker struct {
 	RevenueThreshold          float64                 `json:"revenue_threshold"`
 	DpRevenueThreshold        float64                 `json:"dp_revenue_threshold"`
 	PlacementRevenueThreshold float64                 `json:"placement_revenue_threshold"`
+	StateDays                 int                     `json:"state_days"`
+	Guardrails                automation.Guardrails   `json:"guardrails"`
 	Slack                     *messager.SlackModule   `json:"slack_instances"`
 	LogSeverity               int                     `json:"logsev"`
 	httpClient                httpclient.Doer
@@ -43,6 +47,15 @@ type Worker struct {
 	bulkService               *bulk.BulkService
 	dpoService                *core.DPOService
 	demandPartnerService      *core.DemandPartnerService
+	guard                     *core.AutomationGuard
+}
+
+// defaultGuardrails leave max delta to throttling levels of demand partners
+var defaultGuardrails = automation.Guardrails{
+	MaxChanges:      500,
+	MaxChangesShare: 0.2,
+	MinVolumeRatio:  0.2,
+	MaxVolumeRatio:  5,
 }
 
 type DemandItem struct {
@@ -108,7 +121,18 @@ func (worker *Worker) Do(ctx context.Context) error {
 		return errors.Wrap(data.Error, message)
 	}
 
-	ruleUpdate, ruleDelete, err = worker.calculateRules(data)
+	records, volume := InputVolume(data.DpoReport)
+	err = worker.guard.Begin(ctx, records, volume)
+	if errors.Is(err, core.ErrCircuitBreakerOpen) {
+		log.Warn().Err(err).Msg("skipping dpo automation run")
+
+		return nil
+	}
+	if err != nil {
+		return worker.alertGuardrail(err)
+	}
+
+	ruleUpdate, ruleDelete, err = worker.CalculateRules(data)
 	if err != nil {
 		message := fmt.Sprintf("failed to calculate rules. Error: %s", err.Error())
 		worker.Alert(message)
@@ -116,6 +140,12 @@ func (worker *Worker) Do(ctx context.Context) error {
 		return errors.Wrap(err, message)
 	}
 
+	worker.LimitChanges(ruleUpdate, ruleDelete)
+	err = worker.guard.CheckChanges(ctx, len(ruleUpdate)+len(ruleDelete), len(data.DpoApi))
+	if err != nil {
+		return worker.alertGuardrail(err)
+	}
+
 	err = worker.UpdateAndLogChanges(ctx, ruleUpdate, ruleDelete)
 	if err != nil {
 		message := fmt.Sprintf("Error updating and logging changes. Error: %s", err.Error())
@@ -124,9 +154,41 @@ func (worker *Worker) Do(ctx context.Context) error {
 		return errors.Wrap(err, message)
 	}
 
+	err = worker.guard.Commit(ctx, records, volume)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to commit dpo automation run")
+	}
+
 	return nil
 }
 
+func (worker *Worker) alertGuardrail(err error) error {
+	message := fmt.Sprintf("dpo automation circuit breaker tripped at %s, no rules were changed. "+
+		"Reset it via /automation/circuit_breaker/reset after checking the input: %s", worker.End.Format(constant.PostgresTimestampLayout), err.Error())
+	worker.Alert(message)
+
+	return errors.Wrap(err, message)
+}
+
+// LimitChanges clamps rule changes by the max delta guardrail, deletes which are clamped become updates
+func (worker *Worker) LimitChanges(dpoUpdates, dpoDeletes map[string]*DpoChanges) {
+	for key, change := range dpoUpdates {
+		change.NewFactor = worker.Guardrails.ClampDelta(change.OldFactor, change.NewFactor)
+		if change.NewFactor == change.OldFactor {
+			delete(dpoUpdates, key)
+		}
+	}
+
+	for key, change := range dpoDeletes {
+		change.NewFactor = worker.Guardrails.ClampDelta(change.OldFactor, change.NewFactor)
+		if change.NewFactor != 0 {
+			change.RuleId = ""
+			dpoUpdates[key] = change
+			delete(dpoDeletes, key)
+		}
+	}
+}
+
 func (worker *Worker) GetSleep() int {
 	log.Info().Msg(fmt.Sprintf("next run in: %d seconds. V1.1", bccron.Next(worker.Cron)))
 	if worker.Cron != "" {
@@ -136,7 +198,7 @@ func (worker *Worker) GetSleep() int {
 	return 0
 }
 
-func (worker *Worker) calculateRules(data DpoData) (map[string]*DpoChanges, map[string]*DpoChanges, error) {
+func (worker *Worker) CalculateRules(data DpoData) (map[string]*DpoChanges, map[string]*DpoChanges, error) {
 	var dpoUpdates = make(map[string]*DpoChanges)
 	var dpoDeletes = make(map[string]*DpoChanges)
 
@@ -145,48 +207,44 @@ func (worker *Worker) calculateRules(data DpoData) (map[string]*DpoChanges, map[
 			oldFactor := 0.0
 			key := record.Key()
 			apiKey := record.ApiKey()
+			demand := worker.Demands[record.DP]
 
 			revenueFlag := record.Revenue < worker.RevenueThreshold
 			demandFlag := record.Revenue < (worker.DpRevenueThreshold * data.DpReport[record.DP].Revenue)
 			placementFlag := record.Revenue < (worker.PlacementRevenueThreshold * data.PlacementReport[record.PlacementKey()].Revenue)
-			erpmFlag := record.Erpm < worker.Demands[record.DP].Threshold
 
 			item, exists := data.DpoApi[apiKey]
 			if exists {
 				oldFactor = item.Factor
 			}
 
-			if revenueFlag && demandFlag && placementFlag && erpmFlag && oldFactor != 90 {
-				dpoUpdates[key] = &DpoChanges{
-					Time:       record.Time,
-					EvalTime:   record.EvalTime,
-					Publisher:  record.Publisher,
-					DP:         worker.Demands[record.DP].ApiName,
-					Domain:     record.Domain,
-					Country:    record.Country,
-					Os:         record.Os,
-					Revenue:    record.Revenue,
-					BidRequest: record.BidRequest,
-					Erpm:       record.Erpm,
-					OldFactor:  oldFactor,
-					NewFactor:  90,
-				}
-			} else if exists && !erpmFlag {
-				dpoDeletes[key] = &DpoChanges{
-					Time:       record.Time,
-					EvalTime:   record.EvalTime,
-					Publisher:  record.Publisher,
-					DP:         worker.Demands[record.DP].ApiName,
-					Domain:     record.Domain,
-					Country:    record.Country,
-					Os:         record.Os,
-					Revenue:    record.Revenue,
-					BidRequest: record.BidRequest,
-					Erpm:       record.Erpm,
-					OldFactor:  oldFactor,
-					NewFactor:  0,
-					RuleId:     item.RuleId,
-				}
+			newFactor, reason := nextFactor(&demand.Config, data.State[apiKey], erpmRatio(record.Erpm, demand.Threshold),
+				oldFactor, revenueFlag && demandFlag && placementFlag, record.Time)
+			if newFactor == oldFactor {
+				continue
+			}
+
+			change := &DpoChanges{
+				Time:       record.Time,
+				EvalTime:   record.EvalTime,
+				Publisher:  record.Publisher,
+				DP:         demand.ApiName,
+				Domain:     record.Domain,
+				Country:    record.Country,
+				Os:         record.Os,
+				Revenue:    record.Revenue,
+				BidRequest: record.BidRequest,
+				Erpm:       record.Erpm,
+				OldFactor:  oldFactor,
+				NewFactor:  newFactor,
+				Reason:     reason,
+			}
+
+			if newFactor == 0 {
+				change.RuleId = item.RuleId
+				dpoDeletes[key] = change
+			} else {
+				dpoUpdates[key] = change
 			}
 		}
 	}
@@ -194,6 +252,52 @@ func (worker *Worker) calculateRules(data DpoData) (map[string]*DpoChanges, map[
 	return dpoUpdates, dpoDeletes, nil
 }
 
+// nextFactor returns the throttling level of the placement eRPM ratio. Throttling rises only while revenue is low,
+// relaxes only after eRPM passed the level boundary by the hysteresis and a rule is held for the minimal hold time
+// after an automation change. Throttling held for the exploration period is lowered for a run to let the demand partner prove recovery.
+func nextFactor(config *dto.DPOAutomationConfig, state *RuleState, ratio, oldFactor float64, lowRevenue bool, now time.Time) (float64, string) {
+	newFactor, reason := oldFactor, ""
+
+	if level := config.Level(ratio); lowRevenue && level > oldFactor {
+		newFactor, reason = level, ReasonThrottle
+	} else if level := config.Level(ratio - *config.Hysteresis); oldFactor > 0 && level < oldFactor {
+		newFactor, reason = level, ReasonRelax
+		if level == 0 {
+			reason = ReasonRecovered
+		}
+	}
+
+	// state of an automation change which was overridden since is ignored
+	if state == nil || state.Factor != oldFactor {
+		return newFactor, reason
+	}
+
+	held := now.Sub(state.Time)
+	if newFactor != oldFactor {
+		if state.Reason != ReasonExplore && held < time.Duration(*config.MinHoldHours)*time.Hour {
+			return oldFactor, ""
+		}
+
+		return newFactor, reason
+	}
+
+	if *config.ExplorationEveryHours > 0 && state.Reason != ReasonExplore && oldFactor > *config.ExplorationFactor &&
+		held >= time.Duration(*config.ExplorationEveryHours)*time.Hour {
+		return *config.ExplorationFactor, ReasonExplore
+	}
+
+	return oldFactor, ""
+}
+
+// erpmRatio returns placement eRPM relative to demand partner threshold, placements of demand partners without threshold are never throttled
+func erpmRatio(erpm, threshold float64) float64 {
+	if threshold <= 0 {
+		return math.Inf(1)
+	}
+
+	return erpm / threshold
+}
+
 // Columns variable to check conflict on the price_factor_log table
 var Columns = []string{
 	models.DpoAutomationLogColumns.Time,
@@ -291,6 +395,19 @@ func (worker *Worker) InitializeValues(ctx context.Context, conf config.StringMa
 		errSlice = append(errSlice, message)
 	}
 
+	worker.StateDays, err = conf.GetIntValueWithDefault("state_days", 30)
+	if err != nil {
+		message := fmt.Sprintf("failed to get state_days. err: %s", err)
+		errSlice = append(errSlice, message)
+	}
+
+	worker.Guardrails, err = automation.LoadGuardrails(conf, defaultGuardrails)
+	if err != nil {
+		message := fmt.Sprintf("failed to get guardrails. err: %s", err)
+		errSlice = append(errSlice, message)
+	}
+	worker.guard = core.NewAutomationGuard(dto.DPOAutomation, worker.Guardrails)
+
 	if len(errSlice) != 0 {
 		return errors.New(strings.Join(errSlice, "\n"))
 	}
@@ -302,11 +419,7 @@ func (worker *Worker) getDemandPartners(demandData []*dto.DemandPartner) (map[st
 	demands := make(map[string]*DemandSetup)
 
 	for _, partner := range demandData {
-		demands[partner.AutomationName] = &DemandSetup{
-			Name:      partner.AutomationName,
-			ApiName:   partner.DemandPartnerID,
-			Threshold: partner.Threshold,
-		}
+		demands[partner.AutomationName] = NewDemandSetup(partner)
 	}
 
 	return demands, nil
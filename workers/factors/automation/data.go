@@ -17,6 +17,7 @@ import (
 	"github.com/m6yf/bcwork/bcdb"
 	"github.com/m6yf/bcwork/config"
 	"github.com/m6yf/bcwork/core"
+	"github.com/m6yf/bcwork/modules/automation"
 	"github.com/m6yf/bcwork/quest"
 	"github.com/rs/zerolog/log"
 	"github.com/volatiletech/sqlboiler/v4/queries"
@@ -67,6 +68,12 @@ FROM (SELECT publisher, domain, country, device, SUM(CASE WHEN new_factor >= %f
 		GROUP BY 1, 2, 3, 4) AS t
 WHERE positive_cases < 1;`
 
+var factorHistoryQuery = `SELECT eval_time, publisher, domain, country, device, pubimps, revenue, gp, old_factor
+FROM public.price_factor_log
+WHERE eval_time >= TO_TIMESTAMP('%s','YYYY-MM-DDTHH24:MI:SS')
+  AND domain in('%s')
+ORDER BY eval_time;`
+
 func (worker *Worker) FetchData(ctx context.Context) (map[string]*FactorReport, map[string]*Factor, error) {
 	var recordsMap map[string]*FactorReport
 	var factors map[string]*Factor
@@ -97,6 +104,11 @@ func (worker *Worker) FetchData(ctx context.Context) (map[string]*FactorReport,
 		return nil, nil, err
 	}
 
+	worker.History, err = worker.FetchHistory(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	return recordsMap, factors, nil
 }
 
@@ -302,20 +314,78 @@ func (worker *Worker) FetchAutomationSetup(ctx context.Context) (map[string]*Dom
 		return nil, errors.Wrapf(err, "Error parsing automation setup from API")
 	}
 
-	// Append the domains to the list of active domains & get Targets
+	// Append the domains to the list of active domains & get Targets and Strategies
 	domainsMap := make(map[string]*DomainSetup)
 	for _, item := range AutomationResponse {
-		gppTarget := transformGppTarget(item.GppTarget)
+		setup, err := NewDomainSetup(item)
+		if err != nil {
+			message := fmt.Sprintf("skipping factor automation of %s: %s", item.Key(), err)
+			log.Error().Msg(message)
+			worker.Alert(message)
 
-		domainsMap[item.Key()] = &DomainSetup{
-			Domain:    item.Domain,
-			GppTarget: gppTarget,
+			continue
 		}
+
+		domainsMap[item.Key()] = setup
 	}
 
 	return domainsMap, nil
 }
 
+// NewDomainSetup creates domain strategy from its automation configuration
+func NewDomainSetup(item *AutomationApi) (*DomainSetup, error) {
+	strategyName := item.AutomationStrategy
+	if strategyName == "" {
+		strategyName = automation.DefaultStrategy
+	}
+
+	strategy, err := automation.New(strategyName, item.AutomationParams)
+	if err != nil {
+		return nil, err
+	}
+
+	bounds, err := automation.ParseBounds(item.AutomationParams)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DomainSetup{
+		Domain:       item.Domain,
+		GppTarget:    transformGppTarget(item.GppTarget),
+		StrategyName: strategyName,
+		Strategy:     strategy,
+		Bounds:       bounds,
+	}, nil
+}
+
+// FetchHistory returns previous evaluation windows of automation keys, oldest first
+func (worker *Worker) FetchHistory(ctx context.Context) (map[string][]automation.Observation, error) {
+	log.Debug().Msg("fetch factors history from postgres")
+	var records []*PriceFactorHistory
+
+	startString := worker.Start.Add(-time.Duration(worker.HistoryDays) * 24 * time.Hour).Format("2006-01-02T15:04:05Z")
+
+	query := fmt.Sprintf(factorHistoryQuery, startString, strings.Join(worker.AutomationDomains(), "', '"))
+	err := queries.Raw(query).Bind(ctx, bcdb.DB(), &records)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to fetch factors history from postgres")
+	}
+
+	history := make(map[string][]automation.Observation)
+	for _, record := range records {
+		key := record.Key()
+		history[key] = append(history[key], automation.Observation{
+			Time:                 record.EvalTime,
+			Factor:               record.OldFactor,
+			Revenue:              record.Revenue,
+			Gp:                   record.GP,
+			PublisherImpressions: record.Pubimps,
+		})
+	}
+
+	return history, nil
+}
+
 func (worker *Worker) FetchInactiveKeys(ctx context.Context) ([]string, error) {
 	log.Log().Msg("fetch inactive keys from postgres")
 	var records []*Factor
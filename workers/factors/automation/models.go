@@ -1,8 +1,11 @@
 package factors_autmation
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
+
+	"github.com/m6yf/bcwork/modules/automation"
 )
 
 // Changes applied on factors struct
@@ -57,20 +60,39 @@ type Factor struct {
 	RuleId    string  `boil:"rule_id" json:"rule_id" toml:"rule_id" yaml:"rule_id"`
 }
 
+// Previous evaluation window from price_factor_log
+type PriceFactorHistory struct {
+	EvalTime  time.Time `boil:"eval_time" json:"eval_time" toml:"eval_time" yaml:"eval_time"`
+	Publisher string    `boil:"publisher" json:"publisher" toml:"publisher" yaml:"publisher"`
+	Domain    string    `boil:"domain" json:"domain" toml:"domain" yaml:"domain"`
+	Country   string    `boil:"country" json:"country" toml:"country" yaml:"country"`
+	Device    string    `boil:"device" json:"device" toml:"device" yaml:"device"`
+	Pubimps   int       `boil:"pubimps" json:"pubimps" toml:"pubimps" yaml:"pubimps"`
+	Revenue   float64   `boil:"revenue" json:"revenue" toml:"revenue" yaml:"revenue"`
+	GP        float64   `boil:"gp" json:"gp" toml:"gp" yaml:"gp"`
+	OldFactor float64   `boil:"old_factor" json:"old_factor" toml:"old_factor" yaml:"old_factor"`
+}
+
 // Automation API Struct
 type DomainSetup struct {
-	Domain    string  `json:"domain"`
-	GppTarget float64 `json:"gpp_target"`
+	Domain       string                    `json:"domain"`
+	GppTarget    float64                   `json:"gpp_target"`
+	StrategyName string                    `json:"strategy"`
+	Strategy     automation.FactorStrategy `json:"-"`
+	Bounds       automation.Bounds         `json:"bounds"`
 }
 
 // Domain API Struct
 type AutomationApi struct {
-	PublisherId string    `json:"publisher_id"`
-	Domain      string    `json:"domain"`
-	Automation  bool      `json:"automation"`
-	GppTarget   float64   `json:"gpp_target"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	PublisherId string  `json:"publisher_id"`
+	Domain      string  `json:"domain"`
+	Automation  bool    `json:"automation"`
+	GppTarget   float64 `json:"gpp_target"`
+	// AutomationStrategy and AutomationParams select the factor strategy of the domain
+	AutomationStrategy string          `json:"automation_strategy"`
+	AutomationParams   json.RawMessage `json:"automation_params"`
+	CreatedAt          time.Time       `json:"created_at"`
+	UpdatedAt          time.Time       `json:"updated_at"`
 }
 
 // Key functions for each struct
@@ -86,6 +108,10 @@ func (rec *FactorReport) Key() string {
 	return fmt.Sprint(rec.PublisherID, rec.Domain, rec.Country, rec.DeviceType)
 }
 
+func (rec *PriceFactorHistory) Key() string {
+	return fmt.Sprint(rec.Publisher, rec.Domain, rec.Country, rec.Device)
+}
+
 func (rec *FactorReport) SetupKey() string {
 	return fmt.Sprint(rec.PublisherID, rec.Domain)
 }
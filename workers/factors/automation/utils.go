@@ -6,16 +6,14 @@ import (
 	"time"
 
 	"github.com/m6yf/bcwork/models"
+	"github.com/m6yf/bcwork/modules/automation"
 	"github.com/rs/zerolog/log"
 )
 
 // Factor strategy function
 func (worker *Worker) FactorStrategy(record *FactorReport, oldFactor float64) (float64, error) {
-	var updatedFactor float64
-	var GppOffset float64
-
 	//STOP LOSS - Higher priority rule
-	if record.Gp <= worker.StopLoss {
+	if worker.HitStopLoss(record.Gp) {
 		message := fmt.Sprintf("%s factor set to %f because GP hit stop loss. GP: %f Stoploss: %f", record.Key(), worker.DefaultFactor, record.Gp, worker.StopLoss)
 		worker.Alert(message)
 		log.Warn().Msg(message)
@@ -27,45 +25,35 @@ func (worker *Worker) FactorStrategy(record *FactorReport, oldFactor float64) (f
 		return worker.DefaultFactor, nil
 	}
 
-	//Check if the GPP Target is different for this domain
-	_, exists := worker.Domains[record.SetupKey()]
-	if exists && worker.Domains[record.SetupKey()].GppTarget != 0 {
-		GppOffset = worker.Domains[record.SetupKey()].GppTarget - worker.GppTarget
+	setup, exists := worker.Domains[record.SetupKey()]
+	if !exists || setup.Strategy == nil {
+		return oldFactor, fmt.Errorf("no factor strategy for domain %s", record.SetupKey())
 	}
 
-	//Calculate new factor
-	if record.Gpp >= (worker.GppTarget + 0.23 + GppOffset) {
-		updatedFactor = oldFactor * 1.3
-	} else if record.Gpp >= (worker.GppTarget + 0.18 + GppOffset) {
-		updatedFactor = oldFactor * 1.25
-	} else if record.Gpp >= (worker.GppTarget + 0.13 + GppOffset) {
-		updatedFactor = oldFactor * 1.2
-	} else if record.Gpp >= (worker.GppTarget + 0.06 + GppOffset) {
-		updatedFactor = oldFactor * 1.1
-	} else if record.Gpp >= (worker.GppTarget - 0.06 + +GppOffset) {
-		updatedFactor = oldFactor // KEEP
-	} else if record.Gpp >= (worker.GppTarget - 0.17 + +GppOffset) {
-		updatedFactor = oldFactor * 0.875
-	} else if record.Gpp >= (worker.GppTarget - 0.27 + +GppOffset) {
-		updatedFactor = oldFactor * 0.8
-	} else if record.Gpp >= (worker.GppTarget - 0.37 + +GppOffset) {
-		updatedFactor = oldFactor * 0.7
-	} else {
-		updatedFactor = oldFactor * 0.5
+	//Check if the GPP Target is different for this domain
+	gppTarget := worker.GppTarget
+	if setup.GppTarget != 0 {
+		gppTarget = setup.GppTarget
 	}
 
-	//Factor Ceiling
-	if updatedFactor > worker.MaxFactor {
-		updatedFactor = worker.MaxFactor
+	updatedFactor, err := setup.Strategy.Next(&automation.Input{
+		Key:                  record.Key(),
+		Domain:               record.Domain,
+		Factor:               oldFactor,
+		GppTarget:            gppTarget,
+		Revenue:              record.Revenue,
+		Gp:                   record.Gp,
+		Gpp:                  record.Gpp,
+		SoldImpressions:      record.SoldImpressions,
+		PublisherImpressions: record.PublisherImpressions,
+		History:              worker.History[record.Key()],
+	})
+	if err != nil {
+		return oldFactor, fmt.Errorf("strategy %s failed for %s: %w", setup.StrategyName, record.Key(), err)
 	}
 
-	//Factor Floor
-	if updatedFactor < worker.MinFactor {
-		updatedFactor = worker.MinFactor
-	}
-	if record.Domain == "blitz.gg" && updatedFactor < 0.5 {
-		updatedFactor = 0.5
-	}
+	//Factor Ceiling & Floor
+	updatedFactor = setup.Bounds.Apply(updatedFactor, worker.MinFactor, worker.MaxFactor)
 
 	return RoundFloat(updatedFactor), nil
 }
@@ -110,6 +98,37 @@ func (worker *Worker) GenerateTimes(minutes int) {
 	worker.Start = worker.End.Add(-time.Duration(minutes) * time.Minute)
 }
 
+// HitStopLoss returns true when GP of the key is low enough to reset its factor to default
+func (worker *Worker) HitStopLoss(gp float64) bool {
+	return gp <= worker.StopLoss
+}
+
+// LimitChanges clamps factor changes by the max delta guardrail and returns the number of changed factors.
+// Stop loss resets aren't clamped as they have to stop the losses right away.
+func (worker *Worker) LimitChanges(newFactors map[string]*FactorChanges) int {
+	changed := 0
+	for _, change := range newFactors {
+		if !worker.HitStopLoss(change.GP) {
+			change.NewFactor = RoundFloat(worker.Guardrails.ClampDelta(change.OldFactor, change.NewFactor))
+		}
+		if change.NewFactor != change.OldFactor {
+			changed++
+		}
+	}
+
+	return changed
+}
+
+// InputVolume returns number of records and their revenue
+func InputVolume(records map[string]*FactorReport) (int, float64) {
+	var revenue float64
+	for _, record := range records {
+		revenue += record.Revenue
+	}
+
+	return len(records), revenue
+}
+
 func (worker *Worker) AutomationDomains() []string {
 	var domains []string
 	for _, item := range worker.Domains {
@@ -120,6 +139,10 @@ func (worker *Worker) AutomationDomains() []string {
 }
 
 func (worker *Worker) Alert(message string) {
+	if worker.Slack == nil {
+		return
+	}
+
 	err := worker.Slack.SendMessage(message)
 	if err != nil {
 		log.Error().Msg(fmt.Sprintf("Error sending slack alert: %s", err))
@@ -7,13 +7,16 @@ import (
 	"strings"
 	"time"
 
+	"github.com/m6yf/bcwork/core"
 	"github.com/m6yf/bcwork/core/bulk"
+	"github.com/m6yf/bcwork/dto"
 	"github.com/m6yf/bcwork/modules/history"
 
 	"github.com/friendsofgo/errors"
 	"github.com/m6yf/bcwork/bcdb"
 	"github.com/m6yf/bcwork/config"
 	"github.com/m6yf/bcwork/models"
+	"github.com/m6yf/bcwork/modules/automation"
 	httpclient "github.com/m6yf/bcwork/modules/http_client"
 	"github.com/m6yf/bcwork/modules/messager"
 	"github.com/m6yf/bcwork/utils/bccron"
@@ -21,29 +24,41 @@ import (
 )
 
 type Worker struct {
-	Sleep                   time.Duration           `json:"sleep"`
-	DatabaseEnv             string                  `json:"dbenv"`
-	Cron                    string                  `json:"cron"`
-	Domains                 map[string]*DomainSetup `json:"domains"`
-	StopLoss                float64                 `json:"stop_loss"`
-	GppTarget               float64                 `json:"gpp_target"`
-	MaxFactor               float64                 `json:"max_factor"`
-	MinFactor               float64                 `json:"min_factor"`
-	InactiveDaysThreshold   int                     `json:"inactive_days"`
-	InactiveFactorThreshold float64                 `json:"inactive_factor"`
-	InactiveKeys            []string                `json:"inactive_keys"`
-	Quest                   []string                `json:"quest_instances"`
-	Start                   time.Time               `json:"start"`
-	End                     time.Time               `json:"end"`
-	Fees                    map[string]float64      `json:"fees"`
-	ConsultantFees          map[string]float64      `json:"consultant_fees"`
-	DefaultFactor           float64                 `json:"default_factor"`
-	Slack                   *messager.SlackModule   `json:"slack_instances"`
-	HttpClient              httpclient.Doer         `json:"http_client"`
+	Sleep                   time.Duration                       `json:"sleep"`
+	DatabaseEnv             string                              `json:"dbenv"`
+	Cron                    string                              `json:"cron"`
+	Domains                 map[string]*DomainSetup             `json:"domains"`
+	StopLoss                float64                             `json:"stop_loss"`
+	GppTarget               float64                             `json:"gpp_target"`
+	MaxFactor               float64                             `json:"max_factor"`
+	MinFactor               float64                             `json:"min_factor"`
+	InactiveDaysThreshold   int                                 `json:"inactive_days"`
+	InactiveFactorThreshold float64                             `json:"inactive_factor"`
+	InactiveKeys            []string                            `json:"inactive_keys"`
+	HistoryDays             int                                 `json:"history_days"`
+	History                 map[string][]automation.Observation `json:"history"`
+	Quest                   []string                            `json:"quest_instances"`
+	Start                   time.Time                           `json:"start"`
+	End                     time.Time                           `json:"end"`
+	Fees                    map[string]float64                  `json:"fees"`
+	ConsultantFees          map[string]float64                  `json:"consultant_fees"`
+	DefaultFactor           float64                             `json:"default_factor"`
+	Slack                   *messager.SlackModule               `json:"slack_instances"`
+	HttpClient              httpclient.Doer                     `json:"http_client"`
+	Guardrails              automation.Guardrails               `json:"guardrails"`
 	BulkService             *bulk.BulkService
+	guard                   *core.AutomationGuard
 	skipInitRun             bool
 }
 
+// defaultGuardrails don't limit share of changed keys as strategies move factors of most keys every run
+var defaultGuardrails = automation.Guardrails{
+	MaxChanges:     10000,
+	MaxDelta:       1,
+	MinVolumeRatio: 0.2,
+	MaxVolumeRatio: 5,
+}
+
 // Worker functions
 func (worker *Worker) Init(ctx context.Context, conf config.StringMap) error {
 	worker.skipInitRun, _ = conf.GetBoolValue("skip_init_run")
@@ -83,6 +98,17 @@ func (worker *Worker) Do(ctx context.Context) error {
 		return errors.Wrap(err, message)
 	}
 
+	records, volume := InputVolume(recordsMap)
+	err = worker.guard.Begin(ctx, records, volume)
+	if errors.Is(err, core.ErrCircuitBreakerOpen) {
+		log.Warn().Err(err).Msg("skipping factors automation run")
+
+		return nil
+	}
+	if err != nil {
+		return worker.alertGuardrail(err)
+	}
+
 	newFactors, err = worker.CalculateFactors(recordsMap, factors)
 	if err != nil {
 		message := fmt.Sprintf("failed to calculate factors at %s: %s", worker.End.Format("2006-01-02T15:04:05Z"), err.Error())
@@ -91,6 +117,12 @@ func (worker *Worker) Do(ctx context.Context) error {
 		return errors.Wrap(err, message)
 	}
 
+	changed := worker.LimitChanges(newFactors)
+	err = worker.guard.CheckChanges(ctx, changed, len(factors))
+	if err != nil {
+		return worker.alertGuardrail(err)
+	}
+
 	err = worker.UpdateAndLogChanges(ctx, newFactors)
 	if err != nil {
 		message := fmt.Sprintf("error updating and log changes at %s: %s", worker.End.Format("2006-01-02T15:04:05Z"), err.Error())
@@ -99,9 +131,22 @@ func (worker *Worker) Do(ctx context.Context) error {
 		return errors.Wrap(err, message)
 	}
 
+	err = worker.guard.Commit(ctx, records, volume)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to commit factors automation run")
+	}
+
 	return nil
 }
 
+func (worker *Worker) alertGuardrail(err error) error {
+	message := fmt.Sprintf("factors automation circuit breaker tripped at %s, no factors were changed. "+
+		"Reset it via /automation/circuit_breaker/reset after checking the input: %s", worker.End.Format("2006-01-02T15:04:05Z"), err.Error())
+	worker.Alert(message)
+
+	return errors.Wrap(err, message)
+}
+
 func (worker *Worker) GetSleep() int {
 	log.Info().Msg(fmt.Sprintf("next run in: %d seconds. V1.3.4", bccron.Next(worker.Cron)))
 	if worker.Cron != "" {
@@ -154,6 +199,12 @@ func (worker *Worker) InitializeValues(conf config.StringMap) error {
 		stringErrors = append(stringErrors, message)
 	}
 
+	worker.HistoryDays, err = conf.GetIntValueWithDefault("history_days", 7)
+	if err != nil {
+		message := fmt.Sprintf("failed to get history days value. err: %s", err)
+		stringErrors = append(stringErrors, message)
+	}
+
 	worker.MaxFactor, err = conf.GetFloat64ValueWithDefault("max_factor", 10)
 	if err != nil {
 		message := fmt.Sprintf("failed to get MaxFactor value. err: %s", err)
@@ -185,6 +236,13 @@ func (worker *Worker) InitializeValues(conf config.StringMap) error {
 		stringErrors = append(stringErrors, message)
 	}
 
+	worker.Guardrails, err = automation.LoadGuardrails(conf, defaultGuardrails)
+	if err != nil {
+		message := fmt.Sprintf("failed to get guardrails. err: %s", err)
+		stringErrors = append(stringErrors, message)
+	}
+	worker.guard = core.NewAutomationGuard(dto.FactorAutomation, worker.Guardrails)
+
 	historyModule := history.NewHistoryClient()
 	worker.BulkService = bulk.NewBulkService(historyModule)
 
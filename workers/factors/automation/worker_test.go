@@ -0,0 +1,104 @@
+package factors_autmation
+
+import (
+	"testing"
+
+	"github.com/m6yf/bcwork/modules/automation"
+	"github.com/stretchr/testify/assert"
+)
+
+type fixedStrategy float64
+
+func (s fixedStrategy) Next(input *automation.Input) (float64, error) {
+	return float64(s), nil
+}
+
+func TestWorker_LimitChanges(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		change      *FactorChanges
+		wantFactor  float64
+		wantChanged int
+	}{
+		{
+			name:        "withinDelta",
+			change:      &FactorChanges{GP: 5, OldFactor: 1, NewFactor: 1.3},
+			wantFactor:  1.3,
+			wantChanged: 1,
+		},
+		{
+			name:        "clampedIncrease",
+			change:      &FactorChanges{GP: 5, OldFactor: 1, NewFactor: 3},
+			wantFactor:  1.5,
+			wantChanged: 1,
+		},
+		{
+			name:        "clampedDecrease",
+			change:      &FactorChanges{GP: 5, OldFactor: 3, NewFactor: 1},
+			wantFactor:  2.5,
+			wantChanged: 1,
+		},
+		{
+			name:        "stopLossNotClamped",
+			change:      &FactorChanges{GP: -20, OldFactor: 5, NewFactor: 0.75},
+			wantFactor:  0.75,
+			wantChanged: 1,
+		},
+		{
+			name:        "notChanged",
+			change:      &FactorChanges{GP: 5, OldFactor: 1, NewFactor: 1},
+			wantFactor:  1,
+			wantChanged: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			worker := &Worker{
+				StopLoss:   -10,
+				Guardrails: automation.Guardrails{MaxDelta: 0.5},
+			}
+
+			changed := worker.LimitChanges(map[string]*FactorChanges{"key": tt.change})
+			assert.Equal(t, tt.wantChanged, changed)
+			assert.Equal(t, tt.wantFactor, tt.change.NewFactor)
+		})
+	}
+}
+
+func TestWorker_CalculateFactorsWithDefaultGuardrails(t *testing.T) {
+	t.Parallel()
+
+	worker := &Worker{
+		StopLoss:      -10,
+		DefaultFactor: 0.75,
+		MinFactor:     0.01,
+		MaxFactor:     10,
+		Guardrails:    defaultGuardrails,
+		Domains: map[string]*DomainSetup{
+			"pub1a.com": {Domain: "a.com", Strategy: fixedStrategy(6)},
+		},
+	}
+
+	records := map[string]*FactorReport{
+		"losing":    {PublisherID: "pub1", Domain: "a.com", Country: "us", DeviceType: "mobile", Gp: -50},
+		"improving": {PublisherID: "pub1", Domain: "a.com", Country: "il", DeviceType: "desktop", Gp: 50},
+	}
+	factors := map[string]*Factor{
+		"pub1a.comusmobile":  {Publisher: "pub1", Domain: "a.com", Country: "us", Device: "mobile", Factor: 5},
+		"pub1a.comildesktop": {Publisher: "pub1", Domain: "a.com", Country: "il", Device: "desktop", Factor: 3},
+	}
+
+	newFactors, err := worker.CalculateFactors(records, factors)
+	assert.NoError(t, err)
+
+	changed := worker.LimitChanges(newFactors)
+	assert.Equal(t, 2, changed)
+	assert.Equal(t, 0.75, newFactors["pub1a.comusmobile"].NewFactor)
+	assert.Equal(t, 4.0, newFactors["pub1a.comildesktop"].NewFactor)
+}
@@ -0,0 +1,65 @@
+package price_override_expiry
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/config"
+	"github.com/m6yf/bcwork/core"
+	"github.com/m6yf/bcwork/modules/history"
+	"github.com/m6yf/bcwork/utils/bccron"
+	"github.com/rotisserie/eris"
+	"github.com/rs/zerolog/log"
+)
+
+// Worker removes expired price overrides and republishes affected domains to metadata
+type Worker struct {
+	DatabaseEnv          string `json:"dbenv"`
+	Cron                 string `json:"cron"`
+	skipInitRun          bool
+	priceOverrideService *core.PriceOverrideService
+}
+
+func (w *Worker) Init(ctx context.Context, conf config.StringMap) error {
+	w.DatabaseEnv = conf.GetStringValueWithDefault(config.DBEnvKey, "local_prod")
+	w.skipInitRun, _ = conf.GetBoolValue("skip_init_run")
+	w.Cron, _ = conf.GetStringValue("cron")
+
+	err := bcdb.InitDB(w.DatabaseEnv)
+	if err != nil {
+		return eris.Wrapf(err, "failed to initalize DB")
+	}
+
+	w.priceOverrideService = core.NewPriceOverrideService(history.NewHistoryClient())
+
+	return nil
+}
+
+func (w *Worker) Do(ctx context.Context) error {
+	if w.skipInitRun {
+		fmt.Println("Skipping work as per the skip_init_run flag.")
+		w.skipInitRun = false
+
+		return nil
+	}
+
+	log.Info().Msg("Start to remove expired price overrides")
+
+	err := w.priceOverrideService.RemoveExpiredPriceOverrides(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to remove expired price overrides: %w", err)
+	}
+
+	log.Info().Msg("Finished expired price overrides removal")
+
+	return nil
+}
+
+func (w *Worker) GetSleep() int {
+	if w.Cron != "" {
+		return bccron.Next(w.Cron)
+	}
+
+	return 0
+}
@@ -0,0 +1,116 @@
+package publisher_lifecycle
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/config"
+	"github.com/m6yf/bcwork/core"
+	"github.com/m6yf/bcwork/core/bulk"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/models"
+	"github.com/m6yf/bcwork/modules/history"
+	"github.com/m6yf/bcwork/utils/bccron"
+	"github.com/rotisserie/eris"
+	"github.com/rs/zerolog/log"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Worker moves publishers by their scheduled pause, start and reactivation dates
+// and keeps their rules in line with the status: rules of paused and churned publishers are disabled,
+// rules disabled by pause are enabled back once publisher is active again
+type Worker struct {
+	DatabaseEnv      string `json:"dbenv"`
+	Cron             string `json:"cron"`
+	skipInitRun      bool
+	publisherService *core.PublisherService
+	bulkService      bulk.Bulker
+}
+
+func (w *Worker) Init(ctx context.Context, conf config.StringMap) error {
+	w.DatabaseEnv = conf.GetStringValueWithDefault(config.DBEnvKey, "local_prod")
+	w.skipInitRun, _ = conf.GetBoolValue("skip_init_run")
+	w.Cron, _ = conf.GetStringValue("cron")
+
+	err := bcdb.InitDB(w.DatabaseEnv)
+	if err != nil {
+		return eris.Wrapf(err, "failed to initalize DB")
+	}
+
+	historyModule := history.NewHistoryClient()
+	w.publisherService = core.NewPublisherService(historyModule, nil)
+	w.bulkService = bulk.NewBulkService(historyModule)
+
+	return nil
+}
+
+func (w *Worker) Do(ctx context.Context) error {
+	if w.skipInitRun {
+		fmt.Println("Skipping work as per the skip_init_run flag.")
+		w.skipInitRun = false
+
+		return nil
+	}
+
+	log.Info().Msg("Start to apply publishers lifecycle")
+
+	moved, err := w.publisherService.ApplyScheduledStatuses(ctx, time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("failed to apply scheduled publisher statuses: %w", err)
+	}
+
+	for _, mod := range moved {
+		log.Info().Msgf("publisher [%v] moved to status [%v] by schedule", mod.PublisherID, mod.Status.String)
+	}
+
+	pausedIDs, err := getPublisherIDs(ctx, dto.PublisherStatusPaused, dto.PublisherStatusChurned)
+	if err != nil {
+		return err
+	}
+
+	err = w.bulkService.PausePublishersRules(ctx, pausedIDs)
+	if err != nil {
+		return fmt.Errorf("failed to pause rules of publishers: %w", err)
+	}
+
+	activeIDs, err := getPublisherIDs(ctx, dto.PublisherStatusActive)
+	if err != nil {
+		return err
+	}
+
+	err = w.bulkService.ResumePublishersRules(ctx, activeIDs)
+	if err != nil {
+		return fmt.Errorf("failed to resume rules of publishers: %w", err)
+	}
+
+	log.Info().Msg("Finished publishers lifecycle")
+
+	return nil
+}
+
+func (w *Worker) GetSleep() int {
+	if w.Cron != "" {
+		return bccron.Next(w.Cron)
+	}
+
+	return 0
+}
+
+func getPublisherIDs(ctx context.Context, statuses ...string) ([]string, error) {
+	mods, err := models.Publishers(
+		qm.Select(models.PublisherColumns.PublisherID),
+		models.PublisherWhere.Status.IN(statuses),
+	).All(ctx, bcdb.DB())
+	if err != nil {
+		return nil, eris.Wrapf(err, "failed to retrieve publishers with statuses %v", statuses)
+	}
+
+	ids := make([]string, 0, len(mods))
+	for _, mod := range mods {
+		ids = append(ids, mod.PublisherID)
+	}
+
+	return ids, nil
+}
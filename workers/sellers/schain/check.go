@@ -0,0 +1,145 @@
+package schain
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/models"
+	sellersjson "github.com/m6yf/bcwork/modules/sellers_json"
+	"github.com/volatiletech/null/v8"
+)
+
+const errorMessageMaxLength = 512
+
+// schainLine is an ads.txt line of publisher which supply chain is checked
+type schainLine struct {
+	PublisherID     string      `boil:"publisher_id"`
+	DemandPartnerID null.String `boil:"demand_partner_id"`
+	SeatOwnerID     null.Int    `boil:"seat_owner_id"`
+	PartnerName     string      `boil:"partner_name"`
+	AdsTxtDomain    string      `boil:"ads_txt_domain"`
+	SellerID        string      `boil:"seller_id"`
+	IsDirect        bool        `boil:"is_direct"`
+}
+
+// sellersIndex is a sellers.json indexed by seller id
+type sellersIndex map[string]*sellersjson.Seller
+
+func newSellersIndex(file *sellersjson.File) sellersIndex {
+	index := make(sellersIndex, len(file.Sellers))
+	for _, seller := range file.Sellers {
+		index[strings.ToLower(seller.SellerID)] = seller
+	}
+
+	return index
+}
+
+func (s sellersIndex) get(sellerID string) (*sellersjson.Seller, bool) {
+	seller, ok := s[strings.ToLower(strings.TrimSpace(sellerID))]
+	return seller, ok
+}
+
+func (l *schainLine) key() string {
+	return l.PublisherID + "|" + l.AdsTxtDomain + "|" + strings.ToLower(l.SellerID)
+}
+
+func (l *schainLine) relationship() string {
+	if l.IsDirect {
+		return dto.AdsTxtTypeDirect
+	}
+
+	return dto.AdsTxtTypeReseller
+}
+
+// expectedSellerTypes are types partner should list our account with:
+// PUBLISHER for DIRECT lines and INTERMEDIARY for RESELLER lines, BOTH fits any of them
+func (l *schainLine) expectedSellerTypes() []string {
+	if l.IsDirect {
+		return []string{sellersjson.SellerTypePublisher, sellersjson.SellerTypeBoth}
+	}
+
+	return []string{sellersjson.SellerTypeIntermediary, sellersjson.SellerTypeBoth}
+}
+
+// checkPartner checks partner sellers.json lists the account of the line with the right type,
+// partner sellers.json is nil when it's not available
+func checkPartner(line *schainLine, partner sellersIndex) (string, string) {
+	if partner == nil {
+		return dto.SchainStatusUnavailable, ""
+	}
+
+	seller, ok := partner.get(line.SellerID)
+	if !ok {
+		return dto.SchainStatusMissing, ""
+	}
+
+	if !slices.Contains(line.expectedSellerTypes(), seller.SellerType) {
+		return dto.SchainStatusTypeMismatch, seller.SellerType
+	}
+
+	return dto.SchainStatusListed, seller.SellerType
+}
+
+// checkOurs checks our sellers.json lists the publisher, our sellers.json is nil when it wasn't published
+func checkOurs(publisherID string, ours sellersIndex) string {
+	if ours == nil {
+		return dto.SchainStatusUnavailable
+	}
+
+	seller, ok := ours.get(publisherID)
+	switch {
+	case !ok:
+		return dto.SchainStatusMissing
+	case seller.IsConfidential == 1:
+		return dto.SchainStatusConfidential
+	default:
+		return dto.SchainStatusListed
+	}
+}
+
+func isCompliant(partnerStatus, ourStatus string) bool {
+	return partnerStatus == dto.SchainStatusListed &&
+		(ourStatus == dto.SchainStatusListed || ourStatus == dto.SchainStatusConfidential)
+}
+
+// check returns compliance of the line
+func check(line *schainLine, url string, partner sellersIndex, partnerErr error, ours sellersIndex) *models.SchainCompliance {
+	partnerStatus, sellerType := checkPartner(line, partner)
+	ourStatus := checkOurs(line.PublisherID, ours)
+
+	mod := &models.SchainCompliance{
+		PublisherID:     line.PublisherID,
+		DemandPartnerID: line.DemandPartnerID,
+		SeatOwnerID:     line.SeatOwnerID,
+		PartnerName:     line.PartnerName,
+		AdsTXTDomain:    line.AdsTxtDomain,
+		SellerID:        line.SellerID,
+		Relationship:    line.relationship(),
+		SellersJSONURL:  null.NewString(url, url != ""),
+		DPStatus:        partnerStatus,
+		DPSellerType:    null.NewString(sellerType, sellerType != ""),
+		OurStatus:       ourStatus,
+		IsCompliant:     isCompliant(partnerStatus, ourStatus),
+	}
+
+	if partnerErr != nil {
+		message := partnerErr.Error()
+		if len(message) > errorMessageMaxLength {
+			message = message[:errorMessageMaxLength]
+		}
+		mod.ErrorMessage = null.StringFrom(message)
+	}
+
+	return mod
+}
+
+// partnerURL returns sellers.json url of the partner: configured in missing sellers by partner name,
+// otherwise the standard location on partner ads.txt domain
+func partnerURL(line *schainLine, urls map[string]string) string {
+	if url, ok := urls[strings.ToLower(line.PartnerName)]; ok && url != "" {
+		return url
+	}
+
+	return "https://" + line.AdsTxtDomain + "/sellers.json"
+}
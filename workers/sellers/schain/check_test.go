@@ -0,0 +1,141 @@
+package schain
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/m6yf/bcwork/dto"
+	sellersjson "github.com/m6yf/bcwork/modules/sellers_json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func Test_check(t *testing.T) {
+	t.Parallel()
+
+	partner := newSellersIndex(&sellersjson.File{Sellers: []*sellersjson.Seller{
+		{SellerID: "ACC-1", SellerType: sellersjson.SellerTypeIntermediary},
+		{SellerID: "acc-2", SellerType: sellersjson.SellerTypePublisher},
+		{SellerID: "99100", SellerType: sellersjson.SellerTypeBoth},
+	}})
+	ours := newSellersIndex(&sellersjson.File{Sellers: []*sellersjson.Seller{
+		{SellerID: "100", SellerType: sellersjson.SellerTypePublisher, Name: "Publisher", Domain: "publisher.com"},
+		{SellerID: "200", SellerType: sellersjson.SellerTypePublisher, IsConfidential: 1},
+	}})
+
+	tests := []struct {
+		name          string
+		line          *schainLine
+		partner       sellersIndex
+		partnerErr    error
+		ours          sellersIndex
+		wantDPStatus  string
+		wantDPType    string
+		wantOurStatus string
+		wantCompliant bool
+	}{
+		{
+			name:          "compliantReseller",
+			line:          &schainLine{PublisherID: "100", SellerID: "acc-1"},
+			partner:       partner,
+			ours:          ours,
+			wantDPStatus:  dto.SchainStatusListed,
+			wantDPType:    sellersjson.SellerTypeIntermediary,
+			wantOurStatus: dto.SchainStatusListed,
+			wantCompliant: true,
+		},
+		{
+			name:          "resellerListedAsPublisher",
+			line:          &schainLine{PublisherID: "100", SellerID: "acc-2"},
+			partner:       partner,
+			ours:          ours,
+			wantDPStatus:  dto.SchainStatusTypeMismatch,
+			wantDPType:    sellersjson.SellerTypePublisher,
+			wantOurStatus: dto.SchainStatusListed,
+		},
+		{
+			name:          "directOfConfidentialPublisher",
+			line:          &schainLine{PublisherID: "200", SellerID: "99100", IsDirect: true},
+			partner:       partner,
+			ours:          ours,
+			wantDPStatus:  dto.SchainStatusListed,
+			wantDPType:    sellersjson.SellerTypeBoth,
+			wantOurStatus: dto.SchainStatusConfidential,
+			wantCompliant: true,
+		},
+		{
+			name:          "missingInBoth",
+			line:          &schainLine{PublisherID: "300", SellerID: "acc-3"},
+			partner:       partner,
+			ours:          ours,
+			wantDPStatus:  dto.SchainStatusMissing,
+			wantOurStatus: dto.SchainStatusMissing,
+		},
+		{
+			name:          "unavailable",
+			line:          &schainLine{PublisherID: "100", SellerID: "acc-1"},
+			partnerErr:    errors.New("status code 404"),
+			wantDPStatus:  dto.SchainStatusUnavailable,
+			wantOurStatus: dto.SchainStatusUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := check(tt.line, "https://partner.com/sellers.json", tt.partner, tt.partnerErr, tt.ours)
+
+			assert.Equal(t, tt.wantDPStatus, got.DPStatus)
+			assert.Equal(t, null.NewString(tt.wantDPType, tt.wantDPType != ""), got.DPSellerType)
+			assert.Equal(t, tt.wantOurStatus, got.OurStatus)
+			assert.Equal(t, tt.wantCompliant, got.IsCompliant)
+			assert.Equal(t, tt.partnerErr != nil, got.ErrorMessage.Valid)
+		})
+	}
+}
+
+func Test_partnerURL(t *testing.T) {
+	t.Parallel()
+
+	urls := map[string]string{"getmedia": "https://getmedia.com/static/sellers.json"}
+
+	assert.Equal(t, "https://getmedia.com/static/sellers.json", partnerURL(&schainLine{PartnerName: "GetMedia", AdsTxtDomain: "getmedia.com"}, urls))
+	assert.Equal(t, "https://openx.com/sellers.json", partnerURL(&schainLine{PartnerName: "OpenX", AdsTxtDomain: "openx.com"}, urls))
+}
+
+func Test_sellersCache(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	fail := true
+	cache := newSellersCache(time.Hour, func(ctx context.Context, url string) (*sellersjson.File, error) {
+		calls++
+		if fail {
+			return nil, errors.New("timeout")
+		}
+		return &sellersjson.File{Sellers: []*sellersjson.Seller{{SellerID: "1"}}}, nil
+	})
+	now := time.Date(2025, 5, 7, 10, 0, 0, 0, time.UTC)
+
+	_, err := cache.get(context.Background(), "url", now)
+	require.Error(t, err)
+
+	fail = false
+	index, err := cache.get(context.Background(), "url", now)
+	require.NoError(t, err)
+	_, ok := index.get("1")
+	assert.True(t, ok)
+
+	_, err = cache.get(context.Background(), "url", now.Add(30*time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+
+	_, err = cache.get(context.Background(), "url", now.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 3, calls)
+}
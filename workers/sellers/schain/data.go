@@ -0,0 +1,147 @@
+package schain
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/dto"
+	"github.com/m6yf/bcwork/models"
+	sellersjson "github.com/m6yf/bcwork/modules/sellers_json"
+	"github.com/rotisserie/eris"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// schainLinesQuery returns demand partner and seat owner lines of domains which are not paused
+const schainLinesQuery = `
+	select
+		at2.publisher_id,
+		dpc.demand_partner_id,
+		null::int as seat_owner_id,
+		d.demand_partner_name as partner_name,
+		lower(dpc.dp_domain) as ads_txt_domain,
+		dpc.publisher_account as seller_id,
+		dpc.is_direct
+	from ads_txt at2
+		join demand_partner_connection dpc on dpc.id = at2.demand_partner_connection_id
+		join dpo d on d.demand_partner_id = dpc.demand_partner_id
+	where at2.domain_status <> $1 and d.active
+	union
+	select
+		at2.publisher_id,
+		null as demand_partner_id,
+		so.id as seat_owner_id,
+		so.seat_owner_name as partner_name,
+		lower(so.seat_owner_domain) as ads_txt_domain,
+		replace(so.publisher_account, '%s', at2.publisher_id) as seller_id,
+		true as is_direct
+	from ads_txt at2
+		join seat_owner so on so.id = at2.seat_owner_id
+	where at2.domain_status <> $1
+	order by publisher_id, partner_name
+`
+
+// getSchainLines returns lines to check, a line listed for several partners is checked once
+func getSchainLines(ctx context.Context) ([]*schainLine, error) {
+	var lines []*schainLine
+	err := queries.Raw(schainLinesQuery, dto.DomainStatusPaused).Bind(ctx, bcdb.DB(), &lines)
+	if err != nil {
+		return nil, eris.Wrap(err, "failed to retrieve ads.txt lines for supply chain check")
+	}
+
+	keys := make(map[string]struct{}, len(lines))
+	unique := make([]*schainLine, 0, len(lines))
+	for _, line := range lines {
+		if _, ok := keys[line.key()]; ok {
+			continue
+		}
+		keys[line.key()] = struct{}{}
+		unique = append(unique, line)
+	}
+
+	return unique, nil
+}
+
+// getPartnerURLs returns sellers.json urls of partners from missing sellers by lower cased name
+func getPartnerURLs(ctx context.Context) (map[string]string, error) {
+	mods, err := models.MissingSellers().All(ctx, bcdb.DB())
+	if err != nil {
+		return nil, eris.Wrap(err, "failed to retrieve missing sellers")
+	}
+
+	urls := make(map[string]string, len(mods))
+	for _, mod := range mods {
+		urls[strings.ToLower(mod.Name)] = mod.URL
+	}
+
+	return urls, nil
+}
+
+// getOurSellers returns the latest published sellers.json, nil when nothing was published yet
+func getOurSellers(ctx context.Context) (sellersIndex, error) {
+	mod, err := models.SellersJSONVersions(
+		qm.OrderBy(models.SellersJSONVersionColumns.ID+" DESC"),
+	).One(ctx, bcdb.DB())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, eris.Wrap(err, "failed to retrieve latest sellers.json version")
+	}
+
+	file, err := sellersjson.Parse([]byte(mod.Content))
+	if err != nil {
+		return nil, eris.Wrapf(err, "failed to parse sellers.json version [%v]", mod.ID)
+	}
+
+	return newSellersIndex(file), nil
+}
+
+// saveCompliance upserts results of the run and removes results of lines which don't exist anymore
+func saveCompliance(ctx context.Context, mods []*models.SchainCompliance, now time.Time) error {
+	tx, err := bcdb.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return eris.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	for _, mod := range mods {
+		mod.CheckedAt = now
+		mod.CreatedAt = now
+		mod.UpdatedAt = null.TimeFrom(now)
+
+		err := mod.Upsert(
+			ctx,
+			tx,
+			true,
+			[]string{
+				models.SchainComplianceColumns.PublisherID,
+				models.SchainComplianceColumns.AdsTXTDomain,
+				models.SchainComplianceColumns.SellerID,
+			},
+			boil.Blacklist(models.SchainComplianceColumns.CreatedAt),
+			boil.Infer(),
+		)
+		if err != nil {
+			return eris.Wrapf(err, "failed to save supply chain compliance of publisher [%v] line [%v, %v]", mod.PublisherID, mod.AdsTXTDomain, mod.SellerID)
+		}
+	}
+
+	_, err = models.SchainCompliances(models.SchainComplianceWhere.CheckedAt.LT(now)).DeleteAll(ctx, tx)
+	if err != nil {
+		return eris.Wrap(err, "failed to delete outdated supply chain compliance")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return eris.Wrap(err, "failed to commit supply chain compliance")
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package schain
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	sellersjson "github.com/m6yf/bcwork/modules/sellers_json"
+)
+
+const maxFileSize = 200 << 20
+
+// fetchFunc fetches sellers.json by url
+type fetchFunc func(ctx context.Context, url string) (*sellersjson.File, error)
+
+type cachedSellers struct {
+	index     sellersIndex
+	err       error
+	fetchedAt time.Time
+}
+
+// sellersCache keeps fetched sellers.json of partners between runs, failed fetches are retried on the next run
+type sellersCache struct {
+	mu    sync.Mutex
+	ttl   time.Duration
+	fetch fetchFunc
+	files map[string]*cachedSellers
+}
+
+func newSellersCache(ttl time.Duration, fetch fetchFunc) *sellersCache {
+	return &sellersCache{
+		ttl:   ttl,
+		fetch: fetch,
+		files: make(map[string]*cachedSellers),
+	}
+}
+
+func (c *sellersCache) get(ctx context.Context, url string, now time.Time) (sellersIndex, error) {
+	c.mu.Lock()
+	cached, ok := c.files[url]
+	c.mu.Unlock()
+	if ok && cached.err == nil && now.Sub(cached.fetchedAt) < c.ttl {
+		return cached.index, nil
+	}
+
+	cached = &cachedSellers{fetchedAt: now}
+	file, err := c.fetch(ctx, url)
+	if err != nil {
+		cached.err = err
+	} else {
+		cached.index = newSellersIndex(file)
+	}
+
+	c.mu.Lock()
+	c.files[url] = cached
+	c.mu.Unlock()
+
+	return cached.index, cached.err
+}
+
+func newHTTPFetch(client *http.Client) fetchFunc {
+	return func(ctx context.Context, url string) (*sellersjson.File, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request for %v: %w", url, err)
+		}
+		req.Header.Set("Accept", "application/json")
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch %v: %w", url, err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("failed to fetch %v: status code %v", url, resp.StatusCode)
+		}
+
+		content, err := io.ReadAll(io.LimitReader(resp.Body, maxFileSize))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %v: %w", url, err)
+		}
+
+		return sellersjson.Parse(content)
+	}
+}
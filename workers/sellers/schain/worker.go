@@ -0,0 +1,153 @@
+package schain
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/m6yf/bcwork/bcdb"
+	"github.com/m6yf/bcwork/config"
+	"github.com/m6yf/bcwork/models"
+	"github.com/m6yf/bcwork/utils/bccron"
+	"github.com/rotisserie/eris"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/sync/errgroup"
+)
+
+// Worker validates supply chain of publishers ads.txt lines: sellers.json of demand partners and seat owners
+// lists our account with the right seller type and our sellers.json lists the publisher
+type Worker struct {
+	DatabaseEnv string `json:"dbenv"`
+	Cron        string `json:"cron"`
+	Concurrency int    `json:"concurrency"`
+	skipInitRun bool
+	cache       *sellersCache
+}
+
+func (w *Worker) Init(ctx context.Context, conf config.StringMap) error {
+	var err error
+	w.DatabaseEnv = conf.GetStringValueWithDefault(config.DBEnvKey, "local_prod")
+	w.skipInitRun, _ = conf.GetBoolValue("skip_init_run")
+	w.Cron, _ = conf.GetStringValue("cron")
+
+	w.Concurrency, err = conf.GetIntValueWithDefault("concurrency", 8)
+	if err != nil {
+		return eris.Wrap(err, "failed to get concurrency")
+	}
+
+	timeout, err := conf.GetDurationValueWithDefault("timeout", time.Minute)
+	if err != nil {
+		return eris.Wrap(err, "failed to get timeout")
+	}
+
+	cacheTTL, err := conf.GetDurationValueWithDefault("cache_ttl", 24*time.Hour)
+	if err != nil {
+		return eris.Wrap(err, "failed to get cache ttl")
+	}
+
+	err = bcdb.InitDB(w.DatabaseEnv)
+	if err != nil {
+		return eris.Wrapf(err, "failed to initalize DB")
+	}
+
+	w.cache = newSellersCache(cacheTTL, newHTTPFetch(&http.Client{Timeout: timeout}))
+
+	return nil
+}
+
+func (w *Worker) Do(ctx context.Context) error {
+	if w.skipInitRun {
+		fmt.Println("Skipping work as per the skip_init_run flag.")
+		w.skipInitRun = false
+
+		return nil
+	}
+
+	log.Info().Msg("Start supply chain validation")
+
+	lines, err := getSchainLines(ctx)
+	if err != nil {
+		return err
+	}
+
+	urls, err := getPartnerURLs(ctx)
+	if err != nil {
+		return err
+	}
+
+	ours, err := getOurSellers(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	mods := w.checkLines(ctx, lines, urls, ours, now)
+
+	err = saveCompliance(ctx, mods, now)
+	if err != nil {
+		return err
+	}
+
+	compliant := 0
+	for _, mod := range mods {
+		if mod.IsCompliant {
+			compliant++
+		}
+	}
+	log.Info().Int("lines", len(mods)).Int("compliant", compliant).Msg("Finished supply chain validation")
+
+	return nil
+}
+
+func (w *Worker) GetSleep() int {
+	if w.Cron != "" {
+		return bccron.Next(w.Cron)
+	}
+
+	return 0
+}
+
+// checkLines fetches sellers.json of every partner once and checks the lines against it
+func (w *Worker) checkLines(
+	ctx context.Context,
+	lines []*schainLine,
+	urls map[string]string,
+	ours sellersIndex,
+	now time.Time,
+) []*models.SchainCompliance {
+	linesByURL := make(map[string][]*schainLine)
+	for _, line := range lines {
+		url := partnerURL(line, urls)
+		linesByURL[url] = append(linesByURL[url], line)
+	}
+
+	var mu sync.Mutex
+	mods := make([]*models.SchainCompliance, 0, len(lines))
+	group := errgroup.Group{}
+	group.SetLimit(w.Concurrency)
+	for url, urlLines := range linesByURL {
+		url, urlLines := url, urlLines
+		group.Go(func() error {
+			partner, err := w.cache.get(ctx, url, now)
+			if err != nil {
+				log.Debug().Err(err).Str("url", url).Msg("failed to fetch sellers.json")
+			}
+
+			results := make([]*models.SchainCompliance, 0, len(urlLines))
+			for _, line := range urlLines {
+				results = append(results, check(line, url, partner, err, ours))
+			}
+
+			mu.Lock()
+			mods = append(mods, results...)
+			mu.Unlock()
+
+			return nil
+		})
+	}
+	_ = group.Wait()
+
+	return mods
+}
@@ -5,13 +5,15 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
-	"os"
 	"time"
 
 	"github.com/m6yf/bcwork/bcdb"
 	"github.com/m6yf/bcwork/config"
+	"github.com/m6yf/bcwork/core"
+	"github.com/m6yf/bcwork/models"
 	adstxt "github.com/m6yf/bcwork/modules/ads_txt"
+	"github.com/m6yf/bcwork/modules/compass"
+	"github.com/m6yf/bcwork/modules/history"
 	"github.com/m6yf/bcwork/storage/db"
 	s3storage "github.com/m6yf/bcwork/storage/s3_storage"
 	"github.com/m6yf/bcwork/utils/bccron"
@@ -19,8 +21,16 @@ import (
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	_ "github.com/sirupsen/logrus"
+	"github.com/volatiletech/sqlboiler/v4/boil"
 )
 
+// CompassSyncer keeps publishers changed in both directions consistent with compass
+type CompassSyncer interface {
+	GetManagersMap(ctx context.Context) (map[string]string, error)
+	CheckPulledPublisher(ctx context.Context, compassMod *models.Publisher, skipColumns []string) ([]string, error)
+	PushPendingPublishers(ctx context.Context) (int, error)
+}
+
 type Worker struct {
 	File        string            `json:"file"`
 	DatabaseEnv string            `json:"dbenv"`
@@ -31,8 +41,9 @@ type Worker struct {
 	DaysBefore  int               `json:"days_before"` // From how many days before now objects will be processed
 	ManagersMap map[string]string // ids mapping from compass to NP
 
-	S3 s3storage.S3
-	DB db.PublisherSyncStorage
+	S3      s3storage.S3
+	DB      db.PublisherSyncStorage
+	Compass CompassSyncer
 }
 
 func (w *Worker) Init(ctx context.Context, conf config.StringMap) error {
@@ -41,7 +52,6 @@ func (w *Worker) Init(ctx context.Context, conf config.StringMap) error {
 		cronDefault                        = "0 0 * * *"
 		bucketDefault                      = "new-platform-data-migration"
 		prefixDefault                      = "publishers/"
-		managerMapPathDefault              = "/etc/oms/managers_map.json"
 		logSeverityDefault                 = 2
 		daysBeforeDefault                  = -2
 		isNeededToCreateAdsTxtLinesDefault = false
@@ -71,25 +81,6 @@ func (w *Worker) Init(ctx context.Context, conf config.StringMap) error {
 
 	w.DaysBefore = daysBefore
 
-	managersMapPath := conf.GetStringValueWithDefault(config.ManagersMapPathKey, managerMapPathDefault)
-	file, err := os.Open(managersMapPath)
-	if err != nil {
-		return eris.Wrapf(err, "failed to open file with managers map")
-	}
-
-	data, err := io.ReadAll(file)
-	if err != nil {
-		return eris.Wrapf(err, "failed to read file with managers map")
-	}
-
-	var managersMap map[string]string
-	err = json.Unmarshal(data, &managersMap)
-	if err != nil {
-		return eris.Wrapf(err, "failed to unmarshal managers map")
-	}
-
-	w.ManagersMap = managersMap
-
 	err = bcdb.InitDB(w.DatabaseEnv)
 	if err != nil {
 		return eris.Wrapf(err, "failed to initalize DB")
@@ -107,6 +98,7 @@ func (w *Worker) Init(ctx context.Context, conf config.StringMap) error {
 	}
 
 	w.S3 = s3Client
+	w.Compass = core.NewCompassSyncService(history.NewHistoryClient(), compass.NewCompass())
 
 	return nil
 }
@@ -114,6 +106,18 @@ func (w *Worker) Init(ctx context.Context, conf config.StringMap) error {
 func (w *Worker) Do(ctx context.Context) error {
 	log.Info().Msg("Starting publisher automation process")
 
+	managersMap, err := w.Compass.GetManagersMap(ctx)
+	if err != nil {
+		return eris.Wrapf(err, "failed to get managers map")
+	}
+
+	// without the mapping compass ids would be written as managers of publishers
+	if len(managersMap) == 0 {
+		return errors.New("compass managers mapping is empty, fill it via /compass/manager/set before syncing publishers")
+	}
+
+	w.ManagersMap = managersMap
+
 	list, err := w.S3.ListS3Objects(w.Bucket, w.Prefix)
 	if err != nil {
 		return eris.Wrapf(err, "failed to list objects")
@@ -148,7 +152,12 @@ func (w *Worker) Do(ctx context.Context) error {
 		}
 	}
 
-	log.Info().Msg("Finished publisher automation process")
+	pushed, err := w.Compass.PushPendingPublishers(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to push publishers to compass")
+	}
+
+	log.Info().Msgf("Finished publisher automation process, pushed [%v] publishers to compass", pushed)
 
 	return nil
 }
@@ -200,9 +209,20 @@ func (w *Worker) isNeededToUpdate(ctx context.Context, key string, lastModified
 
 func (w *Worker) processPublisher(ctx context.Context, loadedPub *LoadedPublisher) error {
 	modPub, modDomains, blacklist := loadedPub.ToModel(w.ManagersMap)
+
+	// columns with local changes not pushed to compass yet aren't overwritten
+	keepColumns, err := w.Compass.CheckPulledPublisher(ctx, modPub, blacklist.Cols)
+	if err != nil {
+		return err
+	}
+
+	if len(keepColumns) > 0 {
+		blacklist = boil.Blacklist(append(blacklist.Cols, keepColumns...)...)
+	}
+
 	log.Debug().Interface("pub", modPub).Interface("domain", modDomains).Msg("Updating pub and domains")
 
-	err := w.DB.UpsertPublisherAndDomains(ctx, modPub, modDomains, blacklist)
+	err = w.DB.UpsertPublisherAndDomains(ctx, modPub, modDomains, blacklist)
 	if err != nil {
 		return err
 	}
@@ -38,6 +38,7 @@ func Test_Worker_Do(t *testing.T) {
 					Bucket:     "bucket",
 					Prefix:     "prefix",
 					DaysBefore: -2,
+					Compass:    &compassSyncerStub{managersMap: getMockManagersMap()},
 					S3: func() s3storage.S3 {
 						return s3mocks.NewS3Mock(ctrl).
 							ListS3ObjectsMock.
@@ -91,6 +92,21 @@ func Test_Worker_Do(t *testing.T) {
 			}(),
 			wantErr: false,
 		},
+		{
+			name: "emptyManagersMap",
+			worker: func() *Worker {
+				ctrl := minimock.NewController(t)
+				return &Worker{
+					Bucket:     "bucket",
+					Prefix:     "prefix",
+					DaysBefore: -2,
+					Compass:    &compassSyncerStub{},
+					S3:         s3mocks.NewS3Mock(ctrl),
+					DB:         dbmocks.NewPublisherSyncStorageMock(ctrl),
+				}
+			}(),
+			wantErr: true,
+		},
 		{
 			name: "nothingToUpdate",
 			worker: func() *Worker {
@@ -99,6 +115,7 @@ func Test_Worker_Do(t *testing.T) {
 					Bucket:     "bucket",
 					Prefix:     "prefix",
 					DaysBefore: -2,
+					Compass:    &compassSyncerStub{managersMap: getMockManagersMap()},
 					S3: func() s3storage.S3 {
 						return s3mocks.NewS3Mock(ctrl).
 							ListS3ObjectsMock.
@@ -136,6 +153,7 @@ func Test_Worker_Do(t *testing.T) {
 					Bucket:     "bucket",
 					Prefix:     "prefix",
 					DaysBefore: -2,
+					Compass:    &compassSyncerStub{managersMap: getMockManagersMap()},
 					S3: func() s3storage.S3 {
 						return s3mocks.NewS3Mock(ctrl).
 							ListS3ObjectsMock.
@@ -189,6 +207,73 @@ func Test_Worker_Do(t *testing.T) {
 			}(),
 			wantErr: false,
 		},
+		{
+			name: "localChangesKept",
+			worker: func() *Worker {
+				ctrl := minimock.NewController(t)
+				return &Worker{
+					Bucket:     "bucket",
+					Prefix:     "prefix",
+					DaysBefore: -2,
+					Compass: &compassSyncerStub{
+						managersMap: getMockManagersMap(),
+						keepColumns: []string{models.PublisherColumns.Name, models.PublisherColumns.AccountManagerID},
+					},
+					S3: func() s3storage.S3 {
+						return s3mocks.NewS3Mock(ctrl).
+							ListS3ObjectsMock.
+							Expect("bucket", "prefix").
+							Return(&s3.ListObjectsV2Output{
+								Contents: []*s3.Object{
+									{
+										Key: func() *string {
+											key := keyValue
+											return &key
+										}(),
+										LastModified: &now,
+									},
+								},
+							}, nil).
+							GetObjectInputMock.
+							Expect("bucket", keyValue).
+							Return([]byte(`[{"_id":"id", "accountManager": {"id":"am_id"}, "site": ["1.com"]}]`), nil)
+					}(),
+					DB: func() db.PublisherSyncStorage {
+						return dbmocks.NewPublisherSyncStorageMock(ctrl).
+							HadLoadingErrorLastTimeMock.
+							Expect(minimock.AnyContext, keyValue).
+							Return(false).
+							UpsertPublisherAndDomainsMock.
+							Expect(
+								minimock.AnyContext,
+								&models.Publisher{
+									PublisherID:      "id",
+									AccountManagerID: null.String{Valid: true, String: "am_id"},
+								},
+								[]*models.PublisherDomain{
+									{PublisherID: "id", Domain: "1.com"},
+								},
+								boil.Blacklist(
+									models.PublisherColumns.CreatedAt,
+									models.PublisherColumns.Status,
+									models.PublisherColumns.IntegrationType,
+									models.PublisherColumns.CampaignManagerID,
+									models.PublisherColumns.OfficeLocation,
+									models.PublisherColumns.ReactivateTimestamp,
+									models.PublisherColumns.StartTimestamp,
+									models.PublisherColumns.Name,
+									models.PublisherColumns.AccountManagerID,
+								),
+							).
+							Return(nil).
+							SaveResultOfLastSyncMock.
+							Expect(minimock.AnyContext, keyValue, false).
+							Return(nil)
+					}(),
+				}
+			}(),
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -303,3 +388,20 @@ func Test_Worker_isNeededToUpdate(t *testing.T) {
 		})
 	}
 }
+
+type compassSyncerStub struct {
+	managersMap map[string]string
+	keepColumns []string
+}
+
+func (s *compassSyncerStub) GetManagersMap(ctx context.Context) (map[string]string, error) {
+	return s.managersMap, nil
+}
+
+func (s *compassSyncerStub) CheckPulledPublisher(ctx context.Context, compassMod *models.Publisher, skipColumns []string) ([]string, error) {
+	return s.keepColumns, nil
+}
+
+func (s *compassSyncerStub) PushPendingPublishers(ctx context.Context) (int, error) {
+	return 0, nil
+}